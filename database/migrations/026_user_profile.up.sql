@@ -0,0 +1,5 @@
+CREATE TYPE getstronger.sex AS ENUM ('Male', 'Female');
+
+ALTER TABLE getstronger.users ADD COLUMN sex getstronger.sex NULL;
+ALTER TABLE getstronger.users ADD COLUMN birth_date DATE NULL;
+ALTER TABLE getstronger.users ADD COLUMN bodyweight FLOAT8 NULL;
@@ -0,0 +1,13 @@
+ALTER TYPE getstronger.notification_type ADD VALUE 'YearSummary';
+
+CREATE TABLE getstronger.year_summaries
+(
+    id         UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    user_id    UUID             NOT NULL REFERENCES getstronger.users (id),
+    year       INTEGER          NOT NULL,
+    payload    JSONB            NOT NULL,
+    created_at TIMESTAMP        NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC'),
+    UNIQUE (user_id, year)
+);
+
+CREATE INDEX idx_year_summaries_created_at ON getstronger.year_summaries (created_at);
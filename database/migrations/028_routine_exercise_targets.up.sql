@@ -0,0 +1 @@
+ALTER TABLE getstronger.routines ADD COLUMN exercise_targets JSONB NOT NULL DEFAULT '[]'::jsonb;
@@ -0,0 +1,6 @@
+CREATE TYPE getstronger.routine_visibility AS ENUM ('Private', 'Followers', 'Link');
+
+ALTER TABLE getstronger.routines ADD COLUMN visibility getstronger.routine_visibility NOT NULL DEFAULT 'Private';
+ALTER TABLE getstronger.routines ADD COLUMN share_token UUID NULL UNIQUE;
+ALTER TABLE getstronger.routines ADD COLUMN cloned_from_routine_id UUID NULL REFERENCES getstronger.routines (id) ON DELETE SET NULL;
+ALTER TABLE getstronger.routines ADD COLUMN clone_count INTEGER NOT NULL DEFAULT 0;
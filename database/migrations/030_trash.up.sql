@@ -0,0 +1,4 @@
+ALTER TABLE getstronger.exercises ADD COLUMN deleted_from_routines JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+CREATE INDEX idx_exercises_deleted_at ON getstronger.exercises (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_routines_deleted_at ON getstronger.routines (deleted_at) WHERE deleted_at IS NOT NULL;
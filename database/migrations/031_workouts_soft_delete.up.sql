@@ -0,0 +1,3 @@
+ALTER TABLE getstronger.workouts ADD COLUMN deleted_at TIMESTAMP NULL;
+
+CREATE INDEX idx_workouts_deleted_at ON getstronger.workouts (deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,13 @@
+ALTER TYPE getstronger.event_topic ADD VALUE 'DataExportRequested';
+
+CREATE TABLE getstronger.data_exports
+(
+    id           UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    user_id      UUID             NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    token        UUID             NOT NULL UNIQUE DEFAULT uuid_generate_v4(),
+    archive      BYTEA            NULL,
+    created_at   TIMESTAMP        NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC'),
+    completed_at TIMESTAMP        NULL
+);
+
+CREATE INDEX idx_data_exports_created_at ON getstronger.data_exports (created_at);
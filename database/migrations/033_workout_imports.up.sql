@@ -0,0 +1,3 @@
+ALTER TABLE getstronger.workouts ADD COLUMN import_key TEXT NULL;
+
+CREATE UNIQUE INDEX uq_workouts_user_id_import_key ON getstronger.workouts (user_id, import_key) WHERE import_key IS NOT NULL;
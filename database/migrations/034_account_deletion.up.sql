@@ -0,0 +1,4 @@
+ALTER TABLE getstronger.users ADD COLUMN deletion_requested_at TIMESTAMP NULL;
+ALTER TABLE getstronger.users ADD COLUMN deleted_at TIMESTAMP NULL;
+
+CREATE INDEX idx_users_deletion_requested_at ON getstronger.users (deletion_requested_at) WHERE deletion_requested_at IS NOT NULL;
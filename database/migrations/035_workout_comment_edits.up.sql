@@ -0,0 +1 @@
+ALTER TABLE getstronger.workout_comments ADD COLUMN edited_at TIMESTAMP NULL;
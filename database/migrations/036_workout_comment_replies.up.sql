@@ -0,0 +1,5 @@
+ALTER TABLE getstronger.workout_comments ADD COLUMN parent_comment_id UUID NULL REFERENCES getstronger.workout_comments (id) ON DELETE CASCADE;
+
+CREATE INDEX idx_workout_comments_parent_comment_id ON getstronger.workout_comments (parent_comment_id);
+
+ALTER TYPE getstronger.notification_type ADD VALUE 'WorkoutCommentReply';
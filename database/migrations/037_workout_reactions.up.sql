@@ -0,0 +1,17 @@
+CREATE TYPE getstronger.reaction_type AS ENUM ('ThumbsUp', 'Fire', 'FlexedBiceps', 'ClappingHands');
+
+ALTER TYPE getstronger.event_topic ADD VALUE 'WorkoutReacted';
+
+ALTER TYPE getstronger.notification_type ADD VALUE 'WorkoutReaction';
+
+CREATE TABLE getstronger.workout_reactions
+(
+    id         UUID PRIMARY KEY          NOT NULL DEFAULT uuid_generate_v4(),
+    user_id    UUID                      NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    workout_id UUID                      NOT NULL REFERENCES getstronger.workouts (id) ON DELETE CASCADE,
+    type       getstronger.reaction_type NOT NULL,
+    created_at TIMESTAMP                 NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC'),
+    UNIQUE (user_id, workout_id)
+);
+
+CREATE INDEX idx_workout_reactions_workout_id ON getstronger.workout_reactions (workout_id);
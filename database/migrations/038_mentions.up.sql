@@ -0,0 +1,7 @@
+ALTER TABLE getstronger.workouts ADD COLUMN note_mentions JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+ALTER TABLE getstronger.workout_comments ADD COLUMN mentions JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+ALTER TYPE getstronger.event_topic ADD VALUE 'UsersMentioned';
+
+ALTER TYPE getstronger.notification_type ADD VALUE 'Mention';
@@ -0,0 +1,18 @@
+ALTER TABLE getstronger.users ADD COLUMN private BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TYPE getstronger.event_topic ADD VALUE 'FollowRequested';
+ALTER TYPE getstronger.event_topic ADD VALUE 'FollowRequestApproved';
+
+ALTER TYPE getstronger.notification_type ADD VALUE 'FollowRequest';
+ALTER TYPE getstronger.notification_type ADD VALUE 'FollowRequestApproved';
+
+CREATE TABLE getstronger.follow_requests
+(
+    id          UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    follower_id UUID             NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    followee_id UUID             NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    created_at  TIMESTAMP        NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC'),
+    UNIQUE (follower_id, followee_id)
+);
+
+CREATE INDEX idx_follow_requests_followee_id ON getstronger.follow_requests (followee_id);
@@ -0,0 +1,5 @@
+CREATE TYPE getstronger.workout_visibility AS ENUM ('Public', 'Followers', 'Private');
+
+ALTER TABLE getstronger.workouts ADD COLUMN visibility getstronger.workout_visibility NOT NULL DEFAULT 'Public';
+
+ALTER TABLE getstronger.users ADD COLUMN default_workout_visibility getstronger.workout_visibility NOT NULL DEFAULT 'Public';
@@ -0,0 +1,9 @@
+CREATE TABLE getstronger.user_blocks
+(
+    blocker_id UUID      NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    blocked_id UUID      NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC'),
+    PRIMARY KEY (blocker_id, blocked_id)
+);
+
+CREATE INDEX idx_user_blocks_blocked_id ON getstronger.user_blocks (blocked_id);
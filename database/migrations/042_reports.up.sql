@@ -0,0 +1,23 @@
+ALTER TABLE getstronger.users ADD COLUMN admin BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE getstronger.users ADD COLUMN suspended_at TIMESTAMP;
+
+ALTER TABLE getstronger.workouts ADD COLUMN hidden_at TIMESTAMP;
+ALTER TABLE getstronger.workout_comments ADD COLUMN hidden_at TIMESTAMP;
+
+CREATE TYPE getstronger.report_target AS ENUM ('Workout', 'Comment', 'User');
+CREATE TYPE getstronger.report_status AS ENUM ('Open', 'Dismissed', 'Actioned');
+
+CREATE TABLE getstronger.reports
+(
+    id          UUID PRIMARY KEY          NOT NULL DEFAULT uuid_generate_v4(),
+    reporter_id UUID                      NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    target      getstronger.report_target NOT NULL,
+    target_id   UUID                      NOT NULL,
+    reason      TEXT                      NOT NULL,
+    status      getstronger.report_status NOT NULL DEFAULT 'Open',
+    created_at  TIMESTAMP                 NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC'),
+    resolved_by UUID REFERENCES getstronger.users (id) ON DELETE SET NULL,
+    resolved_at TIMESTAMP
+);
+
+CREATE INDEX idx_reports_status_created_at ON getstronger.reports (status, created_at);
@@ -0,0 +1,34 @@
+ALTER TYPE getstronger.event_topic ADD VALUE 'ChallengeInvited';
+
+ALTER TYPE getstronger.notification_type ADD VALUE 'ChallengeInvite';
+ALTER TYPE getstronger.notification_type ADD VALUE 'ChallengeLeadTaken';
+ALTER TYPE getstronger.notification_type ADD VALUE 'ChallengeEnded';
+
+CREATE TYPE getstronger.challenge_metric AS ENUM ('TotalVolume', 'SessionCount');
+
+CREATE TABLE getstronger.challenges
+(
+    id         UUID PRIMARY KEY             NOT NULL DEFAULT uuid_generate_v4(),
+    creator_id UUID                         NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    title      VARCHAR(255)                 NOT NULL,
+    metric     getstronger.challenge_metric NOT NULL,
+    goal       FLOAT8,
+    starts_at  TIMESTAMP                    NOT NULL,
+    ends_at    TIMESTAMP                    NOT NULL,
+    leader_id  UUID REFERENCES getstronger.users (id) ON DELETE SET NULL,
+    ended_at   TIMESTAMP,
+    created_at TIMESTAMP                    NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX idx_challenges_ends_at ON getstronger.challenges (ends_at) WHERE ended_at IS NULL;
+
+CREATE TABLE getstronger.challenge_participants
+(
+    challenge_id UUID      NOT NULL REFERENCES getstronger.challenges (id) ON DELETE CASCADE,
+    user_id      UUID      NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    joined_at    TIMESTAMP,
+    created_at   TIMESTAMP NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC'),
+    PRIMARY KEY (challenge_id, user_id)
+);
+
+CREATE INDEX idx_challenge_participants_user_id ON getstronger.challenge_participants (user_id);
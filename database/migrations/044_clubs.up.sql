@@ -0,0 +1,23 @@
+CREATE TYPE getstronger.club_role AS ENUM ('Owner', 'Admin', 'Member');
+CREATE TYPE getstronger.club_member_status AS ENUM ('Invited', 'Requested', 'Active');
+
+CREATE TABLE getstronger.clubs
+(
+    id          UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    name        VARCHAR(255)     NOT NULL,
+    description TEXT,
+    created_at  TIMESTAMP        NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC')
+);
+
+CREATE TABLE getstronger.club_members
+(
+    club_id    UUID                           NOT NULL REFERENCES getstronger.clubs (id) ON DELETE CASCADE,
+    user_id    UUID                           NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    role       getstronger.club_role          NOT NULL DEFAULT 'Member',
+    status     getstronger.club_member_status NOT NULL,
+    created_at TIMESTAMP                      NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC'),
+    PRIMARY KEY (club_id, user_id)
+);
+
+CREATE INDEX idx_club_members_user_id ON getstronger.club_members (user_id);
+CREATE UNIQUE INDEX idx_club_members_owner ON getstronger.club_members (club_id) WHERE role = 'Owner';
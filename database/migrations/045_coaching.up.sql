@@ -0,0 +1,23 @@
+CREATE TYPE getstronger.coach_action_type AS ENUM ('ViewDashboard', 'ViewWorkouts', 'ViewPersonalBests', 'CreateRoutine', 'UpdateRoutine');
+
+CREATE TABLE getstronger.coaches
+(
+    coach_id   UUID      NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    athlete_id UUID      NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC'),
+    PRIMARY KEY (coach_id, athlete_id)
+);
+
+CREATE INDEX idx_coaches_athlete_id ON getstronger.coaches (athlete_id);
+
+CREATE TABLE getstronger.coach_actions
+(
+    id         UUID PRIMARY KEY              NOT NULL DEFAULT uuid_generate_v4(),
+    coach_id   UUID                          NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    athlete_id UUID                          NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    action     getstronger.coach_action_type NOT NULL,
+    target_id  UUID,
+    created_at TIMESTAMP                     NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX idx_coach_actions_athlete_id_created_at ON getstronger.coach_actions (athlete_id, created_at);
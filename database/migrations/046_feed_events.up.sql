@@ -0,0 +1,6 @@
+-- Follows made before this migration have no known time and stay out of the feed.
+ALTER TABLE getstronger.followers ADD COLUMN created_at TIMESTAMP;
+ALTER TABLE getstronger.followers ALTER COLUMN created_at SET DEFAULT (NOW() AT TIME ZONE 'UTC');
+
+CREATE INDEX idx_followers_created_at ON getstronger.followers (created_at);
+CREATE INDEX idx_sets_user_id_exercise_id ON getstronger.sets (user_id, exercise_id);
@@ -0,0 +1,15 @@
+CREATE TABLE getstronger.bodyweights
+(
+    id         UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    user_id    UUID             NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    bodyweight FLOAT8           NOT NULL,
+    created_at TIMESTAMP        NOT NULL DEFAULT (NOW() AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX idx_bodyweights_user_id_created_at ON getstronger.bodyweights (user_id, created_at);
+
+-- The time current bodyweights were recorded is unknown, so they are recorded as of now.
+INSERT INTO getstronger.bodyweights (user_id, bodyweight)
+SELECT id, bodyweight
+FROM getstronger.users
+WHERE bodyweight IS NOT NULL;
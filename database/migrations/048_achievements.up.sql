@@ -0,0 +1,20 @@
+ALTER TYPE getstronger.event_topic ADD VALUE 'WorkoutsCreated';
+
+CREATE TABLE getstronger.achievements
+(
+    id            UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    user_id       UUID             NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    workout_count INTEGER          NOT NULL,
+    created_at    TIMESTAMP        NOT NULL,
+    UNIQUE (user_id, workout_count)
+);
+
+CREATE INDEX idx_achievements_created_at ON getstronger.achievements (created_at);
+
+INSERT INTO getstronger.achievements (user_id, workout_count, created_at)
+SELECT user_id, workout_count, created_at
+FROM (SELECT user_id, created_at, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at, id) AS workout_count
+      FROM getstronger.workouts
+      WHERE deleted_at IS NULL
+        AND hidden_at IS NULL) AS workouts
+WHERE workout_count IN (10, 25, 50, 100, 250, 500, 1000);
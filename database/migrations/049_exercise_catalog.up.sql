@@ -0,0 +1,5 @@
+CREATE TYPE getstronger.catalog_exercise AS ENUM ('Squat', 'BenchPress', 'Deadlift');
+
+ALTER TABLE getstronger.exercises ADD COLUMN catalog_exercise getstronger.catalog_exercise NULL;
+
+CREATE INDEX idx_exercises_catalog_exercise ON getstronger.exercises (catalog_exercise) WHERE catalog_exercise IS NOT NULL;
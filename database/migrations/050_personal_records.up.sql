@@ -0,0 +1,38 @@
+CREATE TABLE getstronger.personal_records
+(
+    id         UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    user_id    UUID             NOT NULL REFERENCES getstronger.users (id) ON DELETE CASCADE,
+    workout_id UUID             NOT NULL REFERENCES getstronger.workouts (id) ON DELETE CASCADE,
+    set_id     UUID             NOT NULL REFERENCES getstronger.sets (id) ON DELETE CASCADE,
+    created_at TIMESTAMP        NOT NULL,
+    UNIQUE (set_id)
+);
+
+CREATE INDEX idx_personal_records_created_at ON getstronger.personal_records (created_at);
+
+INSERT INTO getstronger.personal_records (user_id, workout_id, set_id, created_at)
+SELECT sets.user_id, sets.workout_id, sets.id, sets.created_at
+FROM getstronger.sets
+         JOIN getstronger.workouts w ON w.id = sets.workout_id
+WHERE w.deleted_at IS NULL
+  AND EXISTS (SELECT 1
+              FROM getstronger.sets p
+                       JOIN getstronger.workouts pw ON pw.id = p.workout_id
+              WHERE p.user_id = sets.user_id
+                AND p.exercise_id = sets.exercise_id
+                AND pw.deleted_at IS NULL
+                AND pw.created_at < w.created_at)
+  AND NOT EXISTS (SELECT 1
+                  FROM getstronger.sets p
+                           JOIN getstronger.workouts pw ON pw.id = p.workout_id
+                  WHERE p.user_id = sets.user_id
+                    AND p.exercise_id = sets.exercise_id
+                    AND pw.deleted_at IS NULL
+                    AND pw.created_at < w.created_at
+                    AND (p.weight > sets.weight OR (p.weight = sets.weight AND p.reps >= sets.reps)))
+  AND NOT EXISTS (SELECT 1
+                  FROM getstronger.sets o
+                  WHERE o.workout_id = sets.workout_id
+                    AND o.exercise_id = sets.exercise_id
+                    AND o.id <> sets.id
+                    AND (o.weight > sets.weight OR (o.weight = sets.weight AND (o.reps > sets.reps OR (o.reps = sets.reps AND o.id < sets.id)))));
@@ -11,6 +11,9 @@ import (
 	"github.com/crlssn/getstronger/server/cookies"
 	"github.com/crlssn/getstronger/server/db"
 	"github.com/crlssn/getstronger/server/email"
+	"github.com/crlssn/getstronger/server/export"
+	"github.com/crlssn/getstronger/server/importer"
+	"github.com/crlssn/getstronger/server/jobs"
 	"github.com/crlssn/getstronger/server/jwt"
 	"github.com/crlssn/getstronger/server/logger"
 	"github.com/crlssn/getstronger/server/pubsub"
@@ -32,12 +35,15 @@ func options() []fx.Option {
 	return []fx.Option{
 		db.Module(),
 		jwt.Module(),
+		jobs.Module(),
 		logger.Module(),
 		pubsub.Module(),
 		server.Module(),
 		fx.Provide(
 			repo.New,
 			email.New,
+			export.New,
+			importer.New,
 			trace.New,
 			config.New,
 			stream.NewManager,
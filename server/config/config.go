@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strings"
+	"time"
 )
 
 func New() *Config {
@@ -28,15 +29,36 @@ func New() *Config {
 			CookieDomain:   os.Getenv("COOKIE_DOMAIN"),
 			AllowedOrigins: strings.Split(os.Getenv("CORS_ALLOWED_ORIGIN"), ","),
 		},
+		Jobs: Jobs{
+			TrashRetention:             durationFromEnv("TRASH_RETENTION", defaultTrashRetention),
+			AccountDeletionGracePeriod: durationFromEnv("ACCOUNT_DELETION_GRACE_PERIOD", defaultAccountDeletionGracePeriod),
+		},
 		Environment: Environment(os.Getenv("ENV")),
 	}
 }
 
+const (
+	defaultTrashRetention             = 30 * 24 * time.Hour
+	defaultAccountDeletionGracePeriod = 14 * 24 * time.Hour
+)
+
+// durationFromEnv parses the environment variable as a duration, falling back
+// to the default when the variable is unset or invalid.
+func durationFromEnv(key string, fallback time.Duration) time.Duration {
+	duration, err := time.ParseDuration(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+
+	return duration
+}
+
 type Config struct {
 	DB          DB
 	JWT         JWT
 	Email       Email
 	Server      Server
+	Jobs        Jobs
 	Environment Environment
 }
 
@@ -69,6 +91,14 @@ func (s Server) HasCertificate() bool {
 	return s.KeyPath != "" && s.CertPath != ""
 }
 
+type Jobs struct {
+	// TrashRetention is how long soft deleted items are kept before they are purged.
+	TrashRetention time.Duration
+	// AccountDeletionGracePeriod is how long a user can cancel the deletion of
+	// their account before it is carried out.
+	AccountDeletionGracePeriod time.Duration
+}
+
 type Email struct {
 	Provider EmailProvider
 }
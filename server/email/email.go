@@ -11,6 +11,7 @@ import (
 type Email interface {
 	SendVerification(ctx context.Context, req SendVerification) error
 	SendPasswordReset(ctx context.Context, req SendPasswordReset) error
+	SendDataExport(ctx context.Context, req SendDataExport) error
 }
 
 var ErrUnknownEmailProvider = fmt.Errorf("unknown email provider")
@@ -33,6 +34,7 @@ const (
 
 	subjectSendVerification  = "[GetStronger] Verify your email"
 	subjectSendPasswordReset = "[GetStronger] Reset your password" //nolint:gosec
+	subjectSendDataExport    = "[GetStronger] Your data export is ready"
 )
 
 func BodySendVerification(name, domain, token string) string {
@@ -52,3 +54,12 @@ Please click the link below within 24 hours to reset your password.
 %s/reset-password?token=%s
 `, name, domain, token)
 }
+
+func BodySendDataExport(name, domain, token string) string {
+	return fmt.Sprintf(`Hi %s, 
+	
+Your data export is ready. Please click the link below within 7 days to download it.
+
+%s/data-export?token=%s
+`, name, domain, token)
+}
@@ -40,6 +40,20 @@ func (m *MockEmail) EXPECT() *MockEmailMockRecorder {
 	return m.recorder
 }
 
+// SendDataExport mocks base method.
+func (m *MockEmail) SendDataExport(ctx context.Context, req SendDataExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendDataExport", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendDataExport indicates an expected call of SendDataExport.
+func (mr *MockEmailMockRecorder) SendDataExport(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDataExport", reflect.TypeOf((*MockEmail)(nil).SendDataExport), ctx, req)
+}
+
 // SendPasswordReset mocks base method.
 func (m *MockEmail) SendPasswordReset(ctx context.Context, req SendPasswordReset) error {
 	m.ctrl.T.Helper()
@@ -16,4 +16,8 @@ func (n *noop) SendPasswordReset(_ context.Context, _ SendPasswordReset) error {
 	return nil
 }
 
+func (n *noop) SendDataExport(_ context.Context, _ SendDataExport) error {
+	return nil
+}
+
 var _ Email = (*noop)(nil)
@@ -29,6 +29,16 @@ domain/reset-password?token=token
 `, email.BodySendPasswordReset("name", "domain", "token"))
 }
 
+func TestBodySendDataExport(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, `Hi name, 
+	
+Your data export is ready. Please click the link below within 7 days to download it.
+
+domain/data-export?token=token
+`, email.BodySendDataExport("name", "domain", "token"))
+}
+
 func TestNew(t *testing.T) {
 	t.Parallel()
 	c := new(config.Config)
@@ -47,3 +47,14 @@ func (l *local) SendPasswordReset(_ context.Context, req SendPasswordReset) erro
 
 	return nil
 }
+
+func (l *local) SendDataExport(_ context.Context, req SendDataExport) error {
+	body := BodySendDataExport(req.Name, l.config.Server.AllowedOrigins[0], req.Token)
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\n\n%s", fromEmail, req.Email, subjectSendDataExport, body)
+
+	if err := smtp.SendMail(l.addr, l.auth, fromEmail, []string{req.Email}, []byte(msg)); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
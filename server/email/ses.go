@@ -103,3 +103,32 @@ func (e *email) SendPasswordReset(ctx context.Context, req SendPasswordReset) er
 
 	return nil
 }
+
+type SendDataExport struct {
+	Name  string
+	Email string
+	Token string
+}
+
+func (e *email) SendDataExport(ctx context.Context, req SendDataExport) error {
+	if _, err := e.client.SendEmail(ctx, &ses.SendEmailInput{
+		Source: aws.String(fromEmail),
+		Destination: &types.Destination{
+			ToAddresses: []string{req.Email},
+		},
+		Message: &types.Message{
+			Body: &types.Body{
+				Text: &types.Content{
+					Data: aws.String(BodySendDataExport(req.Name, e.config.Server.AllowedOrigins[0], req.Token)),
+				},
+			},
+			Subject: &types.Content{
+				Data: aws.String(subjectSendDataExport),
+			},
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
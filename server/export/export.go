@@ -0,0 +1,471 @@
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+
+	"github.com/crlssn/getstronger/server/gen/orm"
+	"github.com/crlssn/getstronger/server/repo"
+)
+
+const (
+	// MaxSyncWorkouts is the number of workouts above which an account is
+	// exported asynchronously rather than streamed directly.
+	MaxSyncWorkouts = 500
+	// Expiry is how long an asynchronous export can be downloaded.
+	Expiry = 7 * 24 * time.Hour
+	// ChunkSize is the size of the chunks an archive is streamed in.
+	ChunkSize = 64 * 1024
+)
+
+// Exporter builds archives of everything belonging to a user.
+type Exporter struct {
+	repo repo.Repo
+}
+
+func New(r repo.Repo) *Exporter {
+	return &Exporter{repo: r}
+}
+
+// Archive collects the data of the user and writes it to w as a ZIP archive.
+func (e *Exporter) Archive(ctx context.Context, userID string, w io.Writer) error {
+	data, err := e.Collect(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("collect: %w", err)
+	}
+
+	if err = Write(w, data); err != nil {
+		return fmt.Errorf("write: %w", err)
+	}
+
+	return nil
+}
+
+type Data struct {
+	Profile       Profile
+	Exercises     []Exercise
+	Routines      []Routine
+	Workouts      []Workout
+	Comments      []Comment
+	Followers     []User
+	Followees     []User
+	Notifications []Notification
+}
+
+type Profile struct {
+	ID         string       `json:"id"`
+	Email      string       `json:"email"`
+	FirstName  string       `json:"firstName"`
+	LastName   string       `json:"lastName"`
+	Sex        null.String  `json:"sex"`
+	BirthDate  null.Time    `json:"birthDate"`
+	Bodyweight null.Float64 `json:"bodyweight"`
+	CreatedAt  time.Time    `json:"createdAt"`
+}
+
+type Exercise struct {
+	ID        string      `json:"id"`
+	Name      string      `json:"name"`
+	Label     null.String `json:"label"`
+	CreatedAt time.Time   `json:"createdAt"`
+	DeletedAt null.Time   `json:"deletedAt"`
+}
+
+type Routine struct {
+	ID          string                       `json:"id"`
+	Name        string                       `json:"name"`
+	Visibility  string                       `json:"visibility"`
+	ExerciseIDs []string                     `json:"exerciseIds"`
+	Targets     []repo.RoutineExerciseTarget `json:"targets"`
+	CreatedAt   time.Time                    `json:"createdAt"`
+	DeletedAt   null.Time                    `json:"deletedAt"`
+}
+
+type Workout struct {
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	Note       null.String `json:"note"`
+	StartedAt  time.Time   `json:"startedAt"`
+	FinishedAt time.Time   `json:"finishedAt"`
+	DeletedAt  null.Time   `json:"deletedAt"`
+	Sets       []Set       `json:"sets"`
+}
+
+type Set struct {
+	ID           string    `json:"id"`
+	ExerciseID   string    `json:"exerciseId"`
+	ExerciseName string    `json:"exerciseName"`
+	Weight       float64   `json:"weight"`
+	Reps         int       `json:"reps"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+type Comment struct {
+	ID        string    `json:"id"`
+	WorkoutID string    `json:"workoutId"`
+	Comment   string    `json:"comment"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type User struct {
+	ID        string `json:"id"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+}
+
+type Notification struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	Payload   json.RawMessage `json:"payload"`
+	ReadAt    null.Time       `json:"readAt"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+// Collect gathers everything belonging to the user, including items that are
+// in the trash.
+func (e *Exporter) Collect(ctx context.Context, userID string) (*Data, error) {
+	user, err := e.repo.GetUser(ctx,
+		repo.GetUserWithID(userID),
+		repo.GetUserLoadAuth(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	exercises, err := e.repo.ListExercises(ctx,
+		repo.ListExercisesWithUserID(userID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list exercises: %w", err)
+	}
+
+	routines, err := e.repo.ListRoutines(ctx,
+		repo.ListRoutinesWithUserID(userID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list routines: %w", err)
+	}
+
+	workouts, err := e.repo.ListWorkouts(ctx,
+		repo.ListWorkoutsWithUserIDs(userID),
+		repo.ListWorkoutsLoadSets(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list workouts: %w", err)
+	}
+
+	comments, err := e.repo.ListWorkoutComments(ctx,
+		repo.ListWorkoutCommentsWithUserID(userID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list workout comments: %w", err)
+	}
+
+	followers, err := e.repo.ListFollowers(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list followers: %w", err)
+	}
+
+	followees, err := e.repo.ListFollowees(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list followees: %w", err)
+	}
+
+	notifications, err := e.repo.ListNotifications(ctx,
+		repo.ListNotificationsWithUserID(userID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list notifications: %w", err)
+	}
+
+	data := &Data{
+		Profile: Profile{
+			ID:         user.ID,
+			FirstName:  user.FirstName,
+			LastName:   user.LastName,
+			BirthDate:  user.BirthDate,
+			Bodyweight: user.Bodyweight,
+			CreatedAt:  user.CreatedAt,
+		},
+		Exercises:     make([]Exercise, 0, len(exercises)),
+		Routines:      make([]Routine, 0, len(routines)),
+		Workouts:      make([]Workout, 0, len(workouts)),
+		Comments:      make([]Comment, 0, len(comments)),
+		Followers:     users(followers),
+		Followees:     users(followees),
+		Notifications: make([]Notification, 0, len(notifications)),
+	}
+
+	if user.R != nil && user.R.Auth != nil {
+		data.Profile.Email = user.R.Auth.Email
+	}
+
+	if user.Sex.Valid {
+		data.Profile.Sex = null.StringFrom(user.Sex.Val.String())
+	}
+
+	exerciseNames := make(map[string]string, len(exercises))
+	for _, exercise := range exercises {
+		exerciseNames[exercise.ID] = exercise.Title
+		data.Exercises = append(data.Exercises, Exercise{
+			ID:        exercise.ID,
+			Name:      exercise.Title,
+			Label:     exercise.SubTitle,
+			CreatedAt: exercise.CreatedAt,
+			DeletedAt: exercise.DeletedAt,
+		})
+	}
+
+	for _, routine := range routines {
+		var exerciseIDs []string
+		if err = json.Unmarshal(routine.ExerciseOrder, &exerciseIDs); err != nil {
+			return nil, fmt.Errorf("routine exercise order unmarshal: %w", err)
+		}
+
+		var targets []repo.RoutineExerciseTarget
+		if err = json.Unmarshal(routine.ExerciseTargets, &targets); err != nil {
+			return nil, fmt.Errorf("routine exercise targets unmarshal: %w", err)
+		}
+
+		data.Routines = append(data.Routines, Routine{
+			ID:          routine.ID,
+			Name:        routine.Title,
+			Visibility:  routine.Visibility.String(),
+			ExerciseIDs: exerciseIDs,
+			Targets:     targets,
+			CreatedAt:   routine.CreatedAt,
+			DeletedAt:   routine.DeletedAt,
+		})
+	}
+
+	slices.SortFunc(workouts, func(a, b *orm.Workout) int {
+		return a.StartedAt.Compare(b.StartedAt)
+	})
+
+	for _, workout := range workouts {
+		var sets orm.SetSlice
+		if workout.R != nil {
+			sets = workout.R.Sets
+		}
+
+		slices.SortFunc(sets, func(a, b *orm.Set) int {
+			return a.CreatedAt.Compare(b.CreatedAt)
+		})
+
+		w := Workout{
+			ID:         workout.ID,
+			Name:       workout.Name,
+			Note:       workout.Note,
+			StartedAt:  workout.StartedAt,
+			FinishedAt: workout.FinishedAt,
+			DeletedAt:  workout.DeletedAt,
+			Sets:       make([]Set, 0, len(sets)),
+		}
+		for _, set := range sets {
+			w.Sets = append(w.Sets, Set{
+				ID:           set.ID,
+				ExerciseID:   set.ExerciseID,
+				ExerciseName: exerciseNames[set.ExerciseID],
+				Weight:       set.Weight,
+				Reps:         set.Reps,
+				CreatedAt:    set.CreatedAt,
+			})
+		}
+
+		data.Workouts = append(data.Workouts, w)
+	}
+
+	for _, comment := range comments {
+		data.Comments = append(data.Comments, Comment{
+			ID:        comment.ID,
+			WorkoutID: comment.WorkoutID,
+			Comment:   comment.Comment,
+			CreatedAt: comment.CreatedAt,
+		})
+	}
+
+	for _, notification := range notifications {
+		data.Notifications = append(data.Notifications, Notification{
+			ID:        notification.ID,
+			Type:      notification.Type.String(),
+			Payload:   json.RawMessage(notification.Payload),
+			ReadAt:    notification.ReadAt,
+			CreatedAt: notification.CreatedAt,
+		})
+	}
+
+	return data, nil
+}
+
+func users(users orm.UserSlice) []User {
+	u := make([]User, 0, len(users))
+	for _, user := range users {
+		u = append(u, User{
+			ID:        user.ID,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+		})
+	}
+
+	return u
+}
+
+// Write writes the data to w as a ZIP archive containing one JSON file per
+// kind of data, and flat CSV files for spreadsheet friendly access.
+func Write(w io.Writer, data *Data) error {
+	z := zip.NewWriter(w)
+
+	jsonFiles := []struct {
+		name string
+		data any
+	}{
+		{"profile.json", data.Profile},
+		{"exercises.json", data.Exercises},
+		{"routines.json", data.Routines},
+		{"workouts.json", data.Workouts},
+		{"comments.json", data.Comments},
+		{"followers.json", data.Followers},
+		{"followees.json", data.Followees},
+		{"notifications.json", data.Notifications},
+	}
+	for _, file := range jsonFiles {
+		if err := writeJSON(z, file.name, file.data); err != nil {
+			return err
+		}
+	}
+
+	csvFiles := []struct {
+		name    string
+		records [][]string
+	}{
+		{"exercises.csv", exerciseRecords(data.Exercises)},
+		{"workouts.csv", workoutRecords(data.Workouts)},
+		{"sets.csv", setRecords(data.Workouts)},
+		{"comments.csv", commentRecords(data.Comments)},
+	}
+	for _, file := range csvFiles {
+		if err := writeCSV(z, file.name, file.records); err != nil {
+			return err
+		}
+	}
+
+	if err := z.Close(); err != nil {
+		return fmt.Errorf("zip close: %w", err)
+	}
+
+	return nil
+}
+
+func writeJSON(z *zip.Writer, name string, data any) error {
+	f, err := z.Create(name)
+	if err != nil {
+		return fmt.Errorf("%s create: %w", name, err)
+	}
+
+	encoder := json.NewEncoder(f)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(data); err != nil {
+		return fmt.Errorf("%s encode: %w", name, err)
+	}
+
+	return nil
+}
+
+func writeCSV(z *zip.Writer, name string, records [][]string) error {
+	f, err := z.Create(name)
+	if err != nil {
+		return fmt.Errorf("%s create: %w", name, err)
+	}
+
+	if err = csv.NewWriter(f).WriteAll(records); err != nil {
+		return fmt.Errorf("%s write: %w", name, err)
+	}
+
+	return nil
+}
+
+func exerciseRecords(exercises []Exercise) [][]string {
+	records := [][]string{{"id", "name", "label", "created_at", "deleted_at"}}
+	for _, exercise := range exercises {
+		records = append(records, []string{
+			exercise.ID,
+			exercise.Name,
+			exercise.Label.String,
+			formatTime(exercise.CreatedAt),
+			formatNullTime(exercise.DeletedAt),
+		})
+	}
+
+	return records
+}
+
+func workoutRecords(workouts []Workout) [][]string {
+	records := [][]string{{"id", "name", "note", "started_at", "finished_at", "deleted_at"}}
+	for _, workout := range workouts {
+		records = append(records, []string{
+			workout.ID,
+			workout.Name,
+			workout.Note.String,
+			formatTime(workout.StartedAt),
+			formatTime(workout.FinishedAt),
+			formatNullTime(workout.DeletedAt),
+		})
+	}
+
+	return records
+}
+
+func setRecords(workouts []Workout) [][]string {
+	records := [][]string{{"workout_id", "workout_name", "workout_started_at", "exercise_id", "exercise_name", "weight", "reps", "created_at"}}
+	for _, workout := range workouts {
+		for _, set := range workout.Sets {
+			records = append(records, []string{
+				workout.ID,
+				workout.Name,
+				formatTime(workout.StartedAt),
+				set.ExerciseID,
+				set.ExerciseName,
+				strconv.FormatFloat(set.Weight, 'f', -1, 64),
+				strconv.Itoa(set.Reps),
+				formatTime(set.CreatedAt),
+			})
+		}
+	}
+
+	return records
+}
+
+func commentRecords(comments []Comment) [][]string {
+	records := [][]string{{"id", "workout_id", "comment", "created_at"}}
+	for _, comment := range comments {
+		records = append(records, []string{
+			comment.ID,
+			comment.WorkoutID,
+			comment.Comment,
+			formatTime(comment.CreatedAt),
+		})
+	}
+
+	return records
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatNullTime(t null.Time) string {
+	if !t.Valid {
+		return ""
+	}
+
+	return formatTime(t.Time)
+}
@@ -0,0 +1,101 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+
+	"github.com/crlssn/getstronger/server/export"
+)
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	data := &export.Data{
+		Profile: export.Profile{
+			ID:        "user-id",
+			Email:     "john@doe.com",
+			FirstName: "John",
+			LastName:  "Doe",
+		},
+		Exercises: []export.Exercise{
+			{ID: "exercise-id", Name: "Bench Press", Label: null.StringFrom("Barbell"), CreatedAt: startedAt},
+		},
+		Workouts: []export.Workout{
+			{
+				ID:         "workout-id",
+				Name:       "Push",
+				StartedAt:  startedAt,
+				FinishedAt: startedAt.Add(time.Hour),
+				Sets: []export.Set{
+					{ID: "set-1", ExerciseID: "exercise-id", ExerciseName: "Bench Press", Weight: 100, Reps: 5, CreatedAt: startedAt},
+					{ID: "set-2", ExerciseID: "exercise-id", ExerciseName: "Bench Press", Weight: 102.5, Reps: 3, CreatedAt: startedAt},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, export.Write(&buf, data))
+
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte, len(reader.File))
+	for _, file := range reader.File {
+		f, err := file.Open()
+		require.NoError(t, err)
+
+		b, err := io.ReadAll(f)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		files[file.Name] = b
+	}
+
+	require.ElementsMatch(t, []string{
+		"profile.json",
+		"exercises.json",
+		"routines.json",
+		"workouts.json",
+		"comments.json",
+		"followers.json",
+		"followees.json",
+		"notifications.json",
+		"exercises.csv",
+		"workouts.csv",
+		"sets.csv",
+		"comments.csv",
+	}, func() []string {
+		names := make([]string, 0, len(files))
+		for name := range files {
+			names = append(names, name)
+		}
+		return names
+	}())
+
+	var profile export.Profile
+	require.NoError(t, json.Unmarshal(files["profile.json"], &profile))
+	require.Equal(t, data.Profile, profile)
+
+	var workouts []export.Workout
+	require.NoError(t, json.Unmarshal(files["workouts.json"], &workouts))
+	require.Len(t, workouts, 1)
+	require.Len(t, workouts[0].Sets, 2)
+
+	records, err := csv.NewReader(bytes.NewReader(files["sets.csv"])).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"workout_id", "workout_name", "workout_started_at", "exercise_id", "exercise_name", "weight", "reps", "created_at"},
+		{"workout-id", "Push", "2024-03-01T10:00:00Z", "exercise-id", "Bench Press", "100", "5", "2024-03-01T10:00:00Z"},
+		{"workout-id", "Push", "2024-03-01T10:00:00Z", "exercise-id", "Bench Press", "102.5", "3", "2024-03-01T10:00:00Z"},
+	}, records)
+}
@@ -0,0 +1,1174 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Bodyweight is an object representing the database table.
+type Bodyweight struct {
+	ID         string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID     string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Bodyweight float64   `boil:"bodyweight" json:"bodyweight" toml:"bodyweight" yaml:"bodyweight"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *bodyweightR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L bodyweightL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BodyweightColumns = struct {
+	ID         string
+	UserID     string
+	Bodyweight string
+	CreatedAt  string
+}{
+	ID:         "id",
+	UserID:     "user_id",
+	Bodyweight: "bodyweight",
+	CreatedAt:  "created_at",
+}
+
+var BodyweightTableColumns = struct {
+	ID         string
+	UserID     string
+	Bodyweight string
+	CreatedAt  string
+}{
+	ID:         "bodyweights.id",
+	UserID:     "bodyweights.user_id",
+	Bodyweight: "bodyweights.bodyweight",
+	CreatedAt:  "bodyweights.created_at",
+}
+
+// Generated where
+
+type whereHelperfloat64 struct{ field string }
+
+func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperfloat64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var BodyweightWhere = struct {
+	ID         whereHelperstring
+	UserID     whereHelperstring
+	Bodyweight whereHelperfloat64
+	CreatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperstring{field: "\"getstronger\".\"bodyweights\".\"id\""},
+	UserID:     whereHelperstring{field: "\"getstronger\".\"bodyweights\".\"user_id\""},
+	Bodyweight: whereHelperfloat64{field: "\"getstronger\".\"bodyweights\".\"bodyweight\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"getstronger\".\"bodyweights\".\"created_at\""},
+}
+
+// BodyweightRels is where relationship names are stored.
+var BodyweightRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// bodyweightR is where relationships are stored.
+type bodyweightR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*bodyweightR) NewStruct() *bodyweightR {
+	return &bodyweightR{}
+}
+
+func (r *bodyweightR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// bodyweightL is where Load methods for each relationship are stored.
+type bodyweightL struct{}
+
+var (
+	bodyweightAllColumns            = []string{"id", "user_id", "bodyweight", "created_at"}
+	bodyweightColumnsWithoutDefault = []string{"user_id", "bodyweight"}
+	bodyweightColumnsWithDefault    = []string{"id", "created_at"}
+	bodyweightPrimaryKeyColumns     = []string{"id"}
+	bodyweightGeneratedColumns      = []string{}
+)
+
+type (
+	// BodyweightSlice is an alias for a slice of pointers to Bodyweight.
+	// This should almost always be used instead of []Bodyweight.
+	BodyweightSlice []*Bodyweight
+	// BodyweightHook is the signature for custom Bodyweight hook methods
+	BodyweightHook func(context.Context, boil.ContextExecutor, *Bodyweight) error
+
+	bodyweightQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	bodyweightType                 = reflect.TypeOf(&Bodyweight{})
+	bodyweightMapping              = queries.MakeStructMapping(bodyweightType)
+	bodyweightPrimaryKeyMapping, _ = queries.BindMapping(bodyweightType, bodyweightMapping, bodyweightPrimaryKeyColumns)
+	bodyweightInsertCacheMut       sync.RWMutex
+	bodyweightInsertCache          = make(map[string]insertCache)
+	bodyweightUpdateCacheMut       sync.RWMutex
+	bodyweightUpdateCache          = make(map[string]updateCache)
+	bodyweightUpsertCacheMut       sync.RWMutex
+	bodyweightUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var bodyweightAfterSelectMu sync.Mutex
+var bodyweightAfterSelectHooks []BodyweightHook
+
+var bodyweightBeforeInsertMu sync.Mutex
+var bodyweightBeforeInsertHooks []BodyweightHook
+var bodyweightAfterInsertMu sync.Mutex
+var bodyweightAfterInsertHooks []BodyweightHook
+
+var bodyweightBeforeUpdateMu sync.Mutex
+var bodyweightBeforeUpdateHooks []BodyweightHook
+var bodyweightAfterUpdateMu sync.Mutex
+var bodyweightAfterUpdateHooks []BodyweightHook
+
+var bodyweightBeforeDeleteMu sync.Mutex
+var bodyweightBeforeDeleteHooks []BodyweightHook
+var bodyweightAfterDeleteMu sync.Mutex
+var bodyweightAfterDeleteHooks []BodyweightHook
+
+var bodyweightBeforeUpsertMu sync.Mutex
+var bodyweightBeforeUpsertHooks []BodyweightHook
+var bodyweightAfterUpsertMu sync.Mutex
+var bodyweightAfterUpsertHooks []BodyweightHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Bodyweight) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bodyweightAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Bodyweight) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bodyweightBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Bodyweight) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bodyweightAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Bodyweight) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bodyweightBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Bodyweight) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bodyweightAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Bodyweight) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bodyweightBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Bodyweight) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bodyweightAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Bodyweight) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bodyweightBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Bodyweight) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range bodyweightAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBodyweightHook registers your hook function for all future operations.
+func AddBodyweightHook(hookPoint boil.HookPoint, bodyweightHook BodyweightHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		bodyweightAfterSelectMu.Lock()
+		bodyweightAfterSelectHooks = append(bodyweightAfterSelectHooks, bodyweightHook)
+		bodyweightAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		bodyweightBeforeInsertMu.Lock()
+		bodyweightBeforeInsertHooks = append(bodyweightBeforeInsertHooks, bodyweightHook)
+		bodyweightBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		bodyweightAfterInsertMu.Lock()
+		bodyweightAfterInsertHooks = append(bodyweightAfterInsertHooks, bodyweightHook)
+		bodyweightAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		bodyweightBeforeUpdateMu.Lock()
+		bodyweightBeforeUpdateHooks = append(bodyweightBeforeUpdateHooks, bodyweightHook)
+		bodyweightBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		bodyweightAfterUpdateMu.Lock()
+		bodyweightAfterUpdateHooks = append(bodyweightAfterUpdateHooks, bodyweightHook)
+		bodyweightAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		bodyweightBeforeDeleteMu.Lock()
+		bodyweightBeforeDeleteHooks = append(bodyweightBeforeDeleteHooks, bodyweightHook)
+		bodyweightBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		bodyweightAfterDeleteMu.Lock()
+		bodyweightAfterDeleteHooks = append(bodyweightAfterDeleteHooks, bodyweightHook)
+		bodyweightAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		bodyweightBeforeUpsertMu.Lock()
+		bodyweightBeforeUpsertHooks = append(bodyweightBeforeUpsertHooks, bodyweightHook)
+		bodyweightBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		bodyweightAfterUpsertMu.Lock()
+		bodyweightAfterUpsertHooks = append(bodyweightAfterUpsertHooks, bodyweightHook)
+		bodyweightAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single bodyweight record from the query.
+func (q bodyweightQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Bodyweight, error) {
+	o := &Bodyweight{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for bodyweights")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Bodyweight records from the query.
+func (q bodyweightQuery) All(ctx context.Context, exec boil.ContextExecutor) (BodyweightSlice, error) {
+	var o []*Bodyweight
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to Bodyweight slice")
+	}
+
+	if len(bodyweightAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Bodyweight records in the query.
+func (q bodyweightQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count bodyweights rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q bodyweightQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if bodyweights exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *Bodyweight) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (bodyweightL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBodyweight interface{}, mods queries.Applicator) error {
+	var slice []*Bodyweight
+	var object *Bodyweight
+
+	if singular {
+		var ok bool
+		object, ok = maybeBodyweight.(*Bodyweight)
+		if !ok {
+			object = new(Bodyweight)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBodyweight)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBodyweight))
+			}
+		}
+	} else {
+		s, ok := maybeBodyweight.(*[]*Bodyweight)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBodyweight)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBodyweight))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &bodyweightR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &bodyweightR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.Bodyweights = append(foreign.R.Bodyweights, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Bodyweights = append(foreign.R.Bodyweights, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the bodyweight to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Bodyweights.
+func (o *Bodyweight) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"bodyweights\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, bodyweightPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &bodyweightR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Bodyweights: BodyweightSlice{o},
+		}
+	} else {
+		related.R.Bodyweights = append(related.R.Bodyweights, o)
+	}
+
+	return nil
+}
+
+// Bodyweights retrieves all the records using an executor.
+func Bodyweights(mods ...qm.QueryMod) bodyweightQuery {
+	mods = append(mods, qm.From("\"getstronger\".\"bodyweights\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"getstronger\".\"bodyweights\".*"})
+	}
+
+	return bodyweightQuery{q}
+}
+
+// FindBodyweight retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBodyweight(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Bodyweight, error) {
+	bodyweightObj := &Bodyweight{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"getstronger\".\"bodyweights\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, bodyweightObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from bodyweights")
+	}
+
+	if err = bodyweightObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return bodyweightObj, err
+	}
+
+	return bodyweightObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Bodyweight) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no bodyweights provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(bodyweightColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	bodyweightInsertCacheMut.RLock()
+	cache, cached := bodyweightInsertCache[key]
+	bodyweightInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			bodyweightAllColumns,
+			bodyweightColumnsWithDefault,
+			bodyweightColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(bodyweightType, bodyweightMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(bodyweightType, bodyweightMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"getstronger\".\"bodyweights\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"getstronger\".\"bodyweights\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into bodyweights")
+	}
+
+	if !cached {
+		bodyweightInsertCacheMut.Lock()
+		bodyweightInsertCache[key] = cache
+		bodyweightInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Bodyweight.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Bodyweight) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	bodyweightUpdateCacheMut.RLock()
+	cache, cached := bodyweightUpdateCache[key]
+	bodyweightUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			bodyweightAllColumns,
+			bodyweightPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update bodyweights, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"getstronger\".\"bodyweights\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, bodyweightPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(bodyweightType, bodyweightMapping, append(wl, bodyweightPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update bodyweights row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for bodyweights")
+	}
+
+	if !cached {
+		bodyweightUpdateCacheMut.Lock()
+		bodyweightUpdateCache[key] = cache
+		bodyweightUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q bodyweightQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for bodyweights")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for bodyweights")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BodyweightSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), bodyweightPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"getstronger\".\"bodyweights\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, bodyweightPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in bodyweight slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all bodyweight")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Bodyweight) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no bodyweights provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(bodyweightColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	bodyweightUpsertCacheMut.RLock()
+	cache, cached := bodyweightUpsertCache[key]
+	bodyweightUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			bodyweightAllColumns,
+			bodyweightColumnsWithDefault,
+			bodyweightColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			bodyweightAllColumns,
+			bodyweightPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert bodyweights, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(bodyweightAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(bodyweightPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert bodyweights, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(bodyweightPrimaryKeyColumns))
+			copy(conflict, bodyweightPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"getstronger\".\"bodyweights\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(bodyweightType, bodyweightMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(bodyweightType, bodyweightMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert bodyweights")
+	}
+
+	if !cached {
+		bodyweightUpsertCacheMut.Lock()
+		bodyweightUpsertCache[key] = cache
+		bodyweightUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Bodyweight record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Bodyweight) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no Bodyweight provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), bodyweightPrimaryKeyMapping)
+	sql := "DELETE FROM \"getstronger\".\"bodyweights\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from bodyweights")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for bodyweights")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q bodyweightQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no bodyweightQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from bodyweights")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for bodyweights")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BodyweightSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(bodyweightBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), bodyweightPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"getstronger\".\"bodyweights\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, bodyweightPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from bodyweight slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for bodyweights")
+	}
+
+	if len(bodyweightAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Bodyweight) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBodyweight(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BodyweightSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BodyweightSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), bodyweightPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"getstronger\".\"bodyweights\".* FROM \"getstronger\".\"bodyweights\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, bodyweightPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in BodyweightSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BodyweightExists checks if the Bodyweight row exists.
+func BodyweightExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"getstronger\".\"bodyweights\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if bodyweights exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Bodyweight row exists.
+func (o *Bodyweight) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BodyweightExists(ctx, exec, o.ID)
+}
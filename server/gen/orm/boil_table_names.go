@@ -4,29 +4,59 @@
 package orm
 
 var TableNames = struct {
-	Auth              string
-	Events            string
-	Exercises         string
-	ExercisesRoutines string
-	Followers         string
-	Notifications     string
-	Routines          string
-	Sets              string
-	Traces            string
-	Users             string
-	WorkoutComments   string
-	Workouts          string
+	Achievements          string
+	Auth                  string
+	Bodyweights           string
+	ChallengeParticipants string
+	Challenges            string
+	ClubMembers           string
+	Clubs                 string
+	CoachActions          string
+	Coaches               string
+	DataExports           string
+	Events                string
+	Exercises             string
+	ExercisesRoutines     string
+	FollowRequests        string
+	Followers             string
+	Notifications         string
+	PersonalRecords       string
+	Reports               string
+	Routines              string
+	Sets                  string
+	Traces                string
+	UserBlocks            string
+	Users                 string
+	WorkoutComments       string
+	WorkoutReactions      string
+	Workouts              string
+	YearSummaries         string
 }{
-	Auth:              "auth",
-	Events:            "events",
-	Exercises:         "exercises",
-	ExercisesRoutines: "exercises_routines",
-	Followers:         "followers",
-	Notifications:     "notifications",
-	Routines:          "routines",
-	Sets:              "sets",
-	Traces:            "traces",
-	Users:             "users",
-	WorkoutComments:   "workout_comments",
-	Workouts:          "workouts",
+	Achievements:          "achievements",
+	Auth:                  "auth",
+	Bodyweights:           "bodyweights",
+	ChallengeParticipants: "challenge_participants",
+	Challenges:            "challenges",
+	ClubMembers:           "club_members",
+	Clubs:                 "clubs",
+	CoachActions:          "coach_actions",
+	Coaches:               "coaches",
+	DataExports:           "data_exports",
+	Events:                "events",
+	Exercises:             "exercises",
+	ExercisesRoutines:     "exercises_routines",
+	FollowRequests:        "follow_requests",
+	Followers:             "followers",
+	Notifications:         "notifications",
+	PersonalRecords:       "personal_records",
+	Reports:               "reports",
+	Routines:              "routines",
+	Sets:                  "sets",
+	Traces:                "traces",
+	UserBlocks:            "user_blocks",
+	Users:                 "users",
+	WorkoutComments:       "workout_comments",
+	WorkoutReactions:      "workout_reactions",
+	Workouts:              "workouts",
+	YearSummaries:         "year_summaries",
 }
@@ -4,9 +4,14 @@
 package orm
 
 import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
 	"strconv"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/null/v8/convert"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/strmangle"
 )
@@ -51,13 +56,200 @@ func makeCacheKey(cols boil.Columns, nzDefaults []string) string {
 	return str
 }
 
+type ChallengeMetric string
+
+// Enum values for ChallengeMetric
+const (
+	ChallengeMetricTotalVolume  ChallengeMetric = "TotalVolume"
+	ChallengeMetricSessionCount ChallengeMetric = "SessionCount"
+)
+
+func AllChallengeMetric() []ChallengeMetric {
+	return []ChallengeMetric{
+		ChallengeMetricTotalVolume,
+		ChallengeMetricSessionCount,
+	}
+}
+
+func (e ChallengeMetric) IsValid() error {
+	switch e {
+	case ChallengeMetricTotalVolume, ChallengeMetricSessionCount:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ChallengeMetric) String() string {
+	return string(e)
+}
+
+func (e ChallengeMetric) Ordinal() int {
+	switch e {
+	case ChallengeMetricTotalVolume:
+		return 0
+	case ChallengeMetricSessionCount:
+		return 1
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type ClubRole string
+
+// Enum values for ClubRole
+const (
+	ClubRoleOwner  ClubRole = "Owner"
+	ClubRoleAdmin  ClubRole = "Admin"
+	ClubRoleMember ClubRole = "Member"
+)
+
+func AllClubRole() []ClubRole {
+	return []ClubRole{
+		ClubRoleOwner,
+		ClubRoleAdmin,
+		ClubRoleMember,
+	}
+}
+
+func (e ClubRole) IsValid() error {
+	switch e {
+	case ClubRoleOwner, ClubRoleAdmin, ClubRoleMember:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ClubRole) String() string {
+	return string(e)
+}
+
+func (e ClubRole) Ordinal() int {
+	switch e {
+	case ClubRoleOwner:
+		return 0
+	case ClubRoleAdmin:
+		return 1
+	case ClubRoleMember:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type ClubMemberStatus string
+
+// Enum values for ClubMemberStatus
+const (
+	ClubMemberStatusInvited   ClubMemberStatus = "Invited"
+	ClubMemberStatusRequested ClubMemberStatus = "Requested"
+	ClubMemberStatusActive    ClubMemberStatus = "Active"
+)
+
+func AllClubMemberStatus() []ClubMemberStatus {
+	return []ClubMemberStatus{
+		ClubMemberStatusInvited,
+		ClubMemberStatusRequested,
+		ClubMemberStatusActive,
+	}
+}
+
+func (e ClubMemberStatus) IsValid() error {
+	switch e {
+	case ClubMemberStatusInvited, ClubMemberStatusRequested, ClubMemberStatusActive:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ClubMemberStatus) String() string {
+	return string(e)
+}
+
+func (e ClubMemberStatus) Ordinal() int {
+	switch e {
+	case ClubMemberStatusInvited:
+		return 0
+	case ClubMemberStatusRequested:
+		return 1
+	case ClubMemberStatusActive:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type CoachActionType string
+
+// Enum values for CoachActionType
+const (
+	CoachActionTypeViewDashboard     CoachActionType = "ViewDashboard"
+	CoachActionTypeViewWorkouts      CoachActionType = "ViewWorkouts"
+	CoachActionTypeViewPersonalBests CoachActionType = "ViewPersonalBests"
+	CoachActionTypeCreateRoutine     CoachActionType = "CreateRoutine"
+	CoachActionTypeUpdateRoutine     CoachActionType = "UpdateRoutine"
+)
+
+func AllCoachActionType() []CoachActionType {
+	return []CoachActionType{
+		CoachActionTypeViewDashboard,
+		CoachActionTypeViewWorkouts,
+		CoachActionTypeViewPersonalBests,
+		CoachActionTypeCreateRoutine,
+		CoachActionTypeUpdateRoutine,
+	}
+}
+
+func (e CoachActionType) IsValid() error {
+	switch e {
+	case CoachActionTypeViewDashboard, CoachActionTypeViewWorkouts, CoachActionTypeViewPersonalBests, CoachActionTypeCreateRoutine, CoachActionTypeUpdateRoutine:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e CoachActionType) String() string {
+	return string(e)
+}
+
+func (e CoachActionType) Ordinal() int {
+	switch e {
+	case CoachActionTypeViewDashboard:
+		return 0
+	case CoachActionTypeViewWorkouts:
+		return 1
+	case CoachActionTypeViewPersonalBests:
+		return 2
+	case CoachActionTypeCreateRoutine:
+		return 3
+	case CoachActionTypeUpdateRoutine:
+		return 4
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
 type EventTopic string
 
 // Enum values for EventTopic
 const (
-	EventTopicFollowedUser         EventTopic = "FollowedUser"
-	EventTopicRequestTraced        EventTopic = "RequestTraced"
-	EventTopicWorkoutCommentPosted EventTopic = "WorkoutCommentPosted"
+	EventTopicFollowedUser          EventTopic = "FollowedUser"
+	EventTopicRequestTraced         EventTopic = "RequestTraced"
+	EventTopicWorkoutCommentPosted  EventTopic = "WorkoutCommentPosted"
+	EventTopicDataExportRequested   EventTopic = "DataExportRequested"
+	EventTopicWorkoutReacted        EventTopic = "WorkoutReacted"
+	EventTopicUsersMentioned        EventTopic = "UsersMentioned"
+	EventTopicFollowRequested       EventTopic = "FollowRequested"
+	EventTopicFollowRequestApproved EventTopic = "FollowRequestApproved"
+	EventTopicChallengeInvited      EventTopic = "ChallengeInvited"
+	EventTopicWorkoutsCreated       EventTopic = "WorkoutsCreated"
 )
 
 func AllEventTopic() []EventTopic {
@@ -65,12 +257,19 @@ func AllEventTopic() []EventTopic {
 		EventTopicFollowedUser,
 		EventTopicRequestTraced,
 		EventTopicWorkoutCommentPosted,
+		EventTopicDataExportRequested,
+		EventTopicWorkoutReacted,
+		EventTopicUsersMentioned,
+		EventTopicFollowRequested,
+		EventTopicFollowRequestApproved,
+		EventTopicChallengeInvited,
+		EventTopicWorkoutsCreated,
 	}
 }
 
 func (e EventTopic) IsValid() error {
 	switch e {
-	case EventTopicFollowedUser, EventTopicRequestTraced, EventTopicWorkoutCommentPosted:
+	case EventTopicFollowedUser, EventTopicRequestTraced, EventTopicWorkoutCommentPosted, EventTopicDataExportRequested, EventTopicWorkoutReacted, EventTopicUsersMentioned, EventTopicFollowRequested, EventTopicFollowRequestApproved, EventTopicChallengeInvited, EventTopicWorkoutsCreated:
 		return nil
 	default:
 		return errors.New("enum is not valid")
@@ -89,30 +288,214 @@ func (e EventTopic) Ordinal() int {
 		return 1
 	case EventTopicWorkoutCommentPosted:
 		return 2
+	case EventTopicDataExportRequested:
+		return 3
+	case EventTopicWorkoutReacted:
+		return 4
+	case EventTopicUsersMentioned:
+		return 5
+	case EventTopicFollowRequested:
+		return 6
+	case EventTopicFollowRequestApproved:
+		return 7
+	case EventTopicChallengeInvited:
+		return 8
+	case EventTopicWorkoutsCreated:
+		return 9
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type CatalogExercise string
+
+// Enum values for CatalogExercise
+const (
+	CatalogExerciseSquat      CatalogExercise = "Squat"
+	CatalogExerciseBenchPress CatalogExercise = "BenchPress"
+	CatalogExerciseDeadlift   CatalogExercise = "Deadlift"
+)
+
+func AllCatalogExercise() []CatalogExercise {
+	return []CatalogExercise{
+		CatalogExerciseSquat,
+		CatalogExerciseBenchPress,
+		CatalogExerciseDeadlift,
+	}
+}
+
+func (e CatalogExercise) IsValid() error {
+	switch e {
+	case CatalogExerciseSquat, CatalogExerciseBenchPress, CatalogExerciseDeadlift:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e CatalogExercise) String() string {
+	return string(e)
+}
+
+func (e CatalogExercise) Ordinal() int {
+	switch e {
+	case CatalogExerciseSquat:
+		return 0
+	case CatalogExerciseBenchPress:
+		return 1
+	case CatalogExerciseDeadlift:
+		return 2
 
 	default:
 		panic(errors.New("enum is not valid"))
 	}
 }
 
+// NullCatalogExercise is a nullable CatalogExercise enum type. It supports SQL and JSON serialization.
+type NullCatalogExercise struct {
+	Val   CatalogExercise
+	Valid bool
+}
+
+// NullCatalogExerciseFrom creates a new CatalogExercise that will never be blank.
+func NullCatalogExerciseFrom(v CatalogExercise) NullCatalogExercise {
+	return NewNullCatalogExercise(v, true)
+}
+
+// NullCatalogExerciseFromPtr creates a new NullCatalogExercise that be null if s is nil.
+func NullCatalogExerciseFromPtr(v *CatalogExercise) NullCatalogExercise {
+	if v == nil {
+		return NewNullCatalogExercise("", false)
+	}
+	return NewNullCatalogExercise(*v, true)
+}
+
+// NewNullCatalogExercise creates a new NullCatalogExercise
+func NewNullCatalogExercise(v CatalogExercise, valid bool) NullCatalogExercise {
+	return NullCatalogExercise{
+		Val:   v,
+		Valid: valid,
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *NullCatalogExercise) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, null.NullBytes) {
+		e.Val = ""
+		e.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &e.Val); err != nil {
+		return err
+	}
+
+	e.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e NullCatalogExercise) MarshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return null.NullBytes, nil
+	}
+	return json.Marshal(e.Val)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (e NullCatalogExercise) MarshalText() ([]byte, error) {
+	if !e.Valid {
+		return []byte{}, nil
+	}
+	return []byte(e.Val), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *NullCatalogExercise) UnmarshalText(text []byte) error {
+	if text == nil || len(text) == 0 {
+		e.Valid = false
+		return nil
+	}
+
+	e.Val = CatalogExercise(text)
+	e.Valid = true
+	return nil
+}
+
+// SetValid changes this NullCatalogExercise value and also sets it to be non-null.
+func (e *NullCatalogExercise) SetValid(v CatalogExercise) {
+	e.Val = v
+	e.Valid = true
+}
+
+// Ptr returns a pointer to this NullCatalogExercise value, or a nil pointer if this NullCatalogExercise is null.
+func (e NullCatalogExercise) Ptr() *CatalogExercise {
+	if !e.Valid {
+		return nil
+	}
+	return &e.Val
+}
+
+// IsZero returns true for null types.
+func (e NullCatalogExercise) IsZero() bool {
+	return !e.Valid
+}
+
+// Scan implements the Scanner interface.
+func (e *NullCatalogExercise) Scan(value interface{}) error {
+	if value == nil {
+		e.Val, e.Valid = "", false
+		return nil
+	}
+	e.Valid = true
+	return convert.ConvertAssign((*string)(&e.Val), value)
+}
+
+// Value implements the driver Valuer interface.
+func (e NullCatalogExercise) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+	return string(e.Val), nil
+}
+
 type NotificationType string
 
 // Enum values for NotificationType
 const (
-	NotificationTypeFollow         NotificationType = "Follow"
-	NotificationTypeWorkoutComment NotificationType = "WorkoutComment"
+	NotificationTypeFollow                NotificationType = "Follow"
+	NotificationTypeWorkoutComment        NotificationType = "WorkoutComment"
+	NotificationTypeYearSummary           NotificationType = "YearSummary"
+	NotificationTypeWorkoutCommentReply   NotificationType = "WorkoutCommentReply"
+	NotificationTypeWorkoutReaction       NotificationType = "WorkoutReaction"
+	NotificationTypeMention               NotificationType = "Mention"
+	NotificationTypeFollowRequest         NotificationType = "FollowRequest"
+	NotificationTypeFollowRequestApproved NotificationType = "FollowRequestApproved"
+	NotificationTypeChallengeInvite       NotificationType = "ChallengeInvite"
+	NotificationTypeChallengeLeadTaken    NotificationType = "ChallengeLeadTaken"
+	NotificationTypeChallengeEnded        NotificationType = "ChallengeEnded"
 )
 
 func AllNotificationType() []NotificationType {
 	return []NotificationType{
 		NotificationTypeFollow,
 		NotificationTypeWorkoutComment,
+		NotificationTypeYearSummary,
+		NotificationTypeWorkoutCommentReply,
+		NotificationTypeWorkoutReaction,
+		NotificationTypeMention,
+		NotificationTypeFollowRequest,
+		NotificationTypeFollowRequestApproved,
+		NotificationTypeChallengeInvite,
+		NotificationTypeChallengeLeadTaken,
+		NotificationTypeChallengeEnded,
 	}
 }
 
 func (e NotificationType) IsValid() error {
 	switch e {
-	case NotificationTypeFollow, NotificationTypeWorkoutComment:
+	case NotificationTypeFollow, NotificationTypeWorkoutComment, NotificationTypeYearSummary, NotificationTypeWorkoutCommentReply, NotificationTypeWorkoutReaction, NotificationTypeMention, NotificationTypeFollowRequest, NotificationTypeFollowRequestApproved, NotificationTypeChallengeInvite, NotificationTypeChallengeLeadTaken, NotificationTypeChallengeEnded:
 		return nil
 	default:
 		return errors.New("enum is not valid")
@@ -129,6 +512,396 @@ func (e NotificationType) Ordinal() int {
 		return 0
 	case NotificationTypeWorkoutComment:
 		return 1
+	case NotificationTypeYearSummary:
+		return 2
+	case NotificationTypeWorkoutCommentReply:
+		return 3
+	case NotificationTypeWorkoutReaction:
+		return 4
+	case NotificationTypeMention:
+		return 5
+	case NotificationTypeFollowRequest:
+		return 6
+	case NotificationTypeFollowRequestApproved:
+		return 7
+	case NotificationTypeChallengeInvite:
+		return 8
+	case NotificationTypeChallengeLeadTaken:
+		return 9
+	case NotificationTypeChallengeEnded:
+		return 10
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type ReportTarget string
+
+// Enum values for ReportTarget
+const (
+	ReportTargetWorkout ReportTarget = "Workout"
+	ReportTargetComment ReportTarget = "Comment"
+	ReportTargetUser    ReportTarget = "User"
+)
+
+func AllReportTarget() []ReportTarget {
+	return []ReportTarget{
+		ReportTargetWorkout,
+		ReportTargetComment,
+		ReportTargetUser,
+	}
+}
+
+func (e ReportTarget) IsValid() error {
+	switch e {
+	case ReportTargetWorkout, ReportTargetComment, ReportTargetUser:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ReportTarget) String() string {
+	return string(e)
+}
+
+func (e ReportTarget) Ordinal() int {
+	switch e {
+	case ReportTargetWorkout:
+		return 0
+	case ReportTargetComment:
+		return 1
+	case ReportTargetUser:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type ReportStatus string
+
+// Enum values for ReportStatus
+const (
+	ReportStatusOpen      ReportStatus = "Open"
+	ReportStatusDismissed ReportStatus = "Dismissed"
+	ReportStatusActioned  ReportStatus = "Actioned"
+)
+
+func AllReportStatus() []ReportStatus {
+	return []ReportStatus{
+		ReportStatusOpen,
+		ReportStatusDismissed,
+		ReportStatusActioned,
+	}
+}
+
+func (e ReportStatus) IsValid() error {
+	switch e {
+	case ReportStatusOpen, ReportStatusDismissed, ReportStatusActioned:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ReportStatus) String() string {
+	return string(e)
+}
+
+func (e ReportStatus) Ordinal() int {
+	switch e {
+	case ReportStatusOpen:
+		return 0
+	case ReportStatusDismissed:
+		return 1
+	case ReportStatusActioned:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type RoutineVisibility string
+
+// Enum values for RoutineVisibility
+const (
+	RoutineVisibilityPrivate   RoutineVisibility = "Private"
+	RoutineVisibilityFollowers RoutineVisibility = "Followers"
+	RoutineVisibilityLink      RoutineVisibility = "Link"
+)
+
+func AllRoutineVisibility() []RoutineVisibility {
+	return []RoutineVisibility{
+		RoutineVisibilityPrivate,
+		RoutineVisibilityFollowers,
+		RoutineVisibilityLink,
+	}
+}
+
+func (e RoutineVisibility) IsValid() error {
+	switch e {
+	case RoutineVisibilityPrivate, RoutineVisibilityFollowers, RoutineVisibilityLink:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e RoutineVisibility) String() string {
+	return string(e)
+}
+
+func (e RoutineVisibility) Ordinal() int {
+	switch e {
+	case RoutineVisibilityPrivate:
+		return 0
+	case RoutineVisibilityFollowers:
+		return 1
+	case RoutineVisibilityLink:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type Sex string
+
+// Enum values for Sex
+const (
+	SexMale   Sex = "Male"
+	SexFemale Sex = "Female"
+)
+
+func AllSex() []Sex {
+	return []Sex{
+		SexMale,
+		SexFemale,
+	}
+}
+
+func (e Sex) IsValid() error {
+	switch e {
+	case SexMale, SexFemale:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e Sex) String() string {
+	return string(e)
+}
+
+func (e Sex) Ordinal() int {
+	switch e {
+	case SexMale:
+		return 0
+	case SexFemale:
+		return 1
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+// NullSex is a nullable Sex enum type. It supports SQL and JSON serialization.
+type NullSex struct {
+	Val   Sex
+	Valid bool
+}
+
+// NullSexFrom creates a new Sex that will never be blank.
+func NullSexFrom(v Sex) NullSex {
+	return NewNullSex(v, true)
+}
+
+// NullSexFromPtr creates a new NullSex that be null if s is nil.
+func NullSexFromPtr(v *Sex) NullSex {
+	if v == nil {
+		return NewNullSex("", false)
+	}
+	return NewNullSex(*v, true)
+}
+
+// NewNullSex creates a new NullSex
+func NewNullSex(v Sex, valid bool) NullSex {
+	return NullSex{
+		Val:   v,
+		Valid: valid,
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *NullSex) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, null.NullBytes) {
+		e.Val = ""
+		e.Valid = false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &e.Val); err != nil {
+		return err
+	}
+
+	e.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (e NullSex) MarshalJSON() ([]byte, error) {
+	if !e.Valid {
+		return null.NullBytes, nil
+	}
+	return json.Marshal(e.Val)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (e NullSex) MarshalText() ([]byte, error) {
+	if !e.Valid {
+		return []byte{}, nil
+	}
+	return []byte(e.Val), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *NullSex) UnmarshalText(text []byte) error {
+	if text == nil || len(text) == 0 {
+		e.Valid = false
+		return nil
+	}
+
+	e.Val = Sex(text)
+	e.Valid = true
+	return nil
+}
+
+// SetValid changes this NullSex value and also sets it to be non-null.
+func (e *NullSex) SetValid(v Sex) {
+	e.Val = v
+	e.Valid = true
+}
+
+// Ptr returns a pointer to this NullSex value, or a nil pointer if this NullSex is null.
+func (e NullSex) Ptr() *Sex {
+	if !e.Valid {
+		return nil
+	}
+	return &e.Val
+}
+
+// IsZero returns true for null types.
+func (e NullSex) IsZero() bool {
+	return !e.Valid
+}
+
+// Scan implements the Scanner interface.
+func (e *NullSex) Scan(value interface{}) error {
+	if value == nil {
+		e.Val, e.Valid = "", false
+		return nil
+	}
+	e.Valid = true
+	return convert.ConvertAssign((*string)(&e.Val), value)
+}
+
+// Value implements the driver Valuer interface.
+func (e NullSex) Value() (driver.Value, error) {
+	if !e.Valid {
+		return nil, nil
+	}
+	return string(e.Val), nil
+}
+
+type WorkoutVisibility string
+
+// Enum values for WorkoutVisibility
+const (
+	WorkoutVisibilityPublic    WorkoutVisibility = "Public"
+	WorkoutVisibilityFollowers WorkoutVisibility = "Followers"
+	WorkoutVisibilityPrivate   WorkoutVisibility = "Private"
+)
+
+func AllWorkoutVisibility() []WorkoutVisibility {
+	return []WorkoutVisibility{
+		WorkoutVisibilityPublic,
+		WorkoutVisibilityFollowers,
+		WorkoutVisibilityPrivate,
+	}
+}
+
+func (e WorkoutVisibility) IsValid() error {
+	switch e {
+	case WorkoutVisibilityPublic, WorkoutVisibilityFollowers, WorkoutVisibilityPrivate:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e WorkoutVisibility) String() string {
+	return string(e)
+}
+
+func (e WorkoutVisibility) Ordinal() int {
+	switch e {
+	case WorkoutVisibilityPublic:
+		return 0
+	case WorkoutVisibilityFollowers:
+		return 1
+	case WorkoutVisibilityPrivate:
+		return 2
+
+	default:
+		panic(errors.New("enum is not valid"))
+	}
+}
+
+type ReactionType string
+
+// Enum values for ReactionType
+const (
+	ReactionTypeThumbsUp      ReactionType = "ThumbsUp"
+	ReactionTypeFire          ReactionType = "Fire"
+	ReactionTypeFlexedBiceps  ReactionType = "FlexedBiceps"
+	ReactionTypeClappingHands ReactionType = "ClappingHands"
+)
+
+func AllReactionType() []ReactionType {
+	return []ReactionType{
+		ReactionTypeThumbsUp,
+		ReactionTypeFire,
+		ReactionTypeFlexedBiceps,
+		ReactionTypeClappingHands,
+	}
+}
+
+func (e ReactionType) IsValid() error {
+	switch e {
+	case ReactionTypeThumbsUp, ReactionTypeFire, ReactionTypeFlexedBiceps, ReactionTypeClappingHands:
+		return nil
+	default:
+		return errors.New("enum is not valid")
+	}
+}
+
+func (e ReactionType) String() string {
+	return string(e)
+}
+
+func (e ReactionType) Ordinal() int {
+	switch e {
+	case ReactionTypeThumbsUp:
+		return 0
+	case ReactionTypeFire:
+		return 1
+	case ReactionTypeFlexedBiceps:
+		return 2
+	case ReactionTypeClappingHands:
+		return 3
 
 	default:
 		panic(errors.New("enum is not valid"))
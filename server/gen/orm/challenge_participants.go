@@ -0,0 +1,1334 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChallengeParticipant is an object representing the database table.
+type ChallengeParticipant struct {
+	ChallengeID string    `boil:"challenge_id" json:"challenge_id" toml:"challenge_id" yaml:"challenge_id"`
+	UserID      string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	JoinedAt    null.Time `boil:"joined_at" json:"joined_at,omitempty" toml:"joined_at" yaml:"joined_at,omitempty"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *challengeParticipantR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L challengeParticipantL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChallengeParticipantColumns = struct {
+	ChallengeID string
+	UserID      string
+	JoinedAt    string
+	CreatedAt   string
+}{
+	ChallengeID: "challenge_id",
+	UserID:      "user_id",
+	JoinedAt:    "joined_at",
+	CreatedAt:   "created_at",
+}
+
+var ChallengeParticipantTableColumns = struct {
+	ChallengeID string
+	UserID      string
+	JoinedAt    string
+	CreatedAt   string
+}{
+	ChallengeID: "challenge_participants.challenge_id",
+	UserID:      "challenge_participants.user_id",
+	JoinedAt:    "challenge_participants.joined_at",
+	CreatedAt:   "challenge_participants.created_at",
+}
+
+// Generated where
+
+var ChallengeParticipantWhere = struct {
+	ChallengeID whereHelperstring
+	UserID      whereHelperstring
+	JoinedAt    whereHelpernull_Time
+	CreatedAt   whereHelpertime_Time
+}{
+	ChallengeID: whereHelperstring{field: "\"getstronger\".\"challenge_participants\".\"challenge_id\""},
+	UserID:      whereHelperstring{field: "\"getstronger\".\"challenge_participants\".\"user_id\""},
+	JoinedAt:    whereHelpernull_Time{field: "\"getstronger\".\"challenge_participants\".\"joined_at\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"getstronger\".\"challenge_participants\".\"created_at\""},
+}
+
+// ChallengeParticipantRels is where relationship names are stored.
+var ChallengeParticipantRels = struct {
+	Challenge string
+	User      string
+}{
+	Challenge: "Challenge",
+	User:      "User",
+}
+
+// challengeParticipantR is where relationships are stored.
+type challengeParticipantR struct {
+	Challenge *Challenge `boil:"Challenge" json:"Challenge" toml:"Challenge" yaml:"Challenge"`
+	User      *User      `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*challengeParticipantR) NewStruct() *challengeParticipantR {
+	return &challengeParticipantR{}
+}
+
+func (r *challengeParticipantR) GetChallenge() *Challenge {
+	if r == nil {
+		return nil
+	}
+	return r.Challenge
+}
+
+func (r *challengeParticipantR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// challengeParticipantL is where Load methods for each relationship are stored.
+type challengeParticipantL struct{}
+
+var (
+	challengeParticipantAllColumns            = []string{"challenge_id", "user_id", "joined_at", "created_at"}
+	challengeParticipantColumnsWithoutDefault = []string{"challenge_id", "user_id"}
+	challengeParticipantColumnsWithDefault    = []string{"joined_at", "created_at"}
+	challengeParticipantPrimaryKeyColumns     = []string{"challenge_id", "user_id"}
+	challengeParticipantGeneratedColumns      = []string{}
+)
+
+type (
+	// ChallengeParticipantSlice is an alias for a slice of pointers to ChallengeParticipant.
+	// This should almost always be used instead of []ChallengeParticipant.
+	ChallengeParticipantSlice []*ChallengeParticipant
+	// ChallengeParticipantHook is the signature for custom ChallengeParticipant hook methods
+	ChallengeParticipantHook func(context.Context, boil.ContextExecutor, *ChallengeParticipant) error
+
+	challengeParticipantQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	challengeParticipantType                 = reflect.TypeOf(&ChallengeParticipant{})
+	challengeParticipantMapping              = queries.MakeStructMapping(challengeParticipantType)
+	challengeParticipantPrimaryKeyMapping, _ = queries.BindMapping(challengeParticipantType, challengeParticipantMapping, challengeParticipantPrimaryKeyColumns)
+	challengeParticipantInsertCacheMut       sync.RWMutex
+	challengeParticipantInsertCache          = make(map[string]insertCache)
+	challengeParticipantUpdateCacheMut       sync.RWMutex
+	challengeParticipantUpdateCache          = make(map[string]updateCache)
+	challengeParticipantUpsertCacheMut       sync.RWMutex
+	challengeParticipantUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var challengeParticipantAfterSelectMu sync.Mutex
+var challengeParticipantAfterSelectHooks []ChallengeParticipantHook
+
+var challengeParticipantBeforeInsertMu sync.Mutex
+var challengeParticipantBeforeInsertHooks []ChallengeParticipantHook
+var challengeParticipantAfterInsertMu sync.Mutex
+var challengeParticipantAfterInsertHooks []ChallengeParticipantHook
+
+var challengeParticipantBeforeUpdateMu sync.Mutex
+var challengeParticipantBeforeUpdateHooks []ChallengeParticipantHook
+var challengeParticipantAfterUpdateMu sync.Mutex
+var challengeParticipantAfterUpdateHooks []ChallengeParticipantHook
+
+var challengeParticipantBeforeDeleteMu sync.Mutex
+var challengeParticipantBeforeDeleteHooks []ChallengeParticipantHook
+var challengeParticipantAfterDeleteMu sync.Mutex
+var challengeParticipantAfterDeleteHooks []ChallengeParticipantHook
+
+var challengeParticipantBeforeUpsertMu sync.Mutex
+var challengeParticipantBeforeUpsertHooks []ChallengeParticipantHook
+var challengeParticipantAfterUpsertMu sync.Mutex
+var challengeParticipantAfterUpsertHooks []ChallengeParticipantHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ChallengeParticipant) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeParticipantAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ChallengeParticipant) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeParticipantBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ChallengeParticipant) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeParticipantAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ChallengeParticipant) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeParticipantBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ChallengeParticipant) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeParticipantAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ChallengeParticipant) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeParticipantBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ChallengeParticipant) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeParticipantAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ChallengeParticipant) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeParticipantBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ChallengeParticipant) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeParticipantAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddChallengeParticipantHook registers your hook function for all future operations.
+func AddChallengeParticipantHook(hookPoint boil.HookPoint, challengeParticipantHook ChallengeParticipantHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		challengeParticipantAfterSelectMu.Lock()
+		challengeParticipantAfterSelectHooks = append(challengeParticipantAfterSelectHooks, challengeParticipantHook)
+		challengeParticipantAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		challengeParticipantBeforeInsertMu.Lock()
+		challengeParticipantBeforeInsertHooks = append(challengeParticipantBeforeInsertHooks, challengeParticipantHook)
+		challengeParticipantBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		challengeParticipantAfterInsertMu.Lock()
+		challengeParticipantAfterInsertHooks = append(challengeParticipantAfterInsertHooks, challengeParticipantHook)
+		challengeParticipantAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		challengeParticipantBeforeUpdateMu.Lock()
+		challengeParticipantBeforeUpdateHooks = append(challengeParticipantBeforeUpdateHooks, challengeParticipantHook)
+		challengeParticipantBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		challengeParticipantAfterUpdateMu.Lock()
+		challengeParticipantAfterUpdateHooks = append(challengeParticipantAfterUpdateHooks, challengeParticipantHook)
+		challengeParticipantAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		challengeParticipantBeforeDeleteMu.Lock()
+		challengeParticipantBeforeDeleteHooks = append(challengeParticipantBeforeDeleteHooks, challengeParticipantHook)
+		challengeParticipantBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		challengeParticipantAfterDeleteMu.Lock()
+		challengeParticipantAfterDeleteHooks = append(challengeParticipantAfterDeleteHooks, challengeParticipantHook)
+		challengeParticipantAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		challengeParticipantBeforeUpsertMu.Lock()
+		challengeParticipantBeforeUpsertHooks = append(challengeParticipantBeforeUpsertHooks, challengeParticipantHook)
+		challengeParticipantBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		challengeParticipantAfterUpsertMu.Lock()
+		challengeParticipantAfterUpsertHooks = append(challengeParticipantAfterUpsertHooks, challengeParticipantHook)
+		challengeParticipantAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single challengeParticipant record from the query.
+func (q challengeParticipantQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChallengeParticipant, error) {
+	o := &ChallengeParticipant{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for challenge_participants")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ChallengeParticipant records from the query.
+func (q challengeParticipantQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChallengeParticipantSlice, error) {
+	var o []*ChallengeParticipant
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to ChallengeParticipant slice")
+	}
+
+	if len(challengeParticipantAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ChallengeParticipant records in the query.
+func (q challengeParticipantQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count challenge_participants rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q challengeParticipantQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if challenge_participants exists")
+	}
+
+	return count > 0, nil
+}
+
+// Challenge pointed to by the foreign key.
+func (o *ChallengeParticipant) Challenge(mods ...qm.QueryMod) challengeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChallengeID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Challenges(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *ChallengeParticipant) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadChallenge allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (challengeParticipantL) LoadChallenge(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChallengeParticipant interface{}, mods queries.Applicator) error {
+	var slice []*ChallengeParticipant
+	var object *ChallengeParticipant
+
+	if singular {
+		var ok bool
+		object, ok = maybeChallengeParticipant.(*ChallengeParticipant)
+		if !ok {
+			object = new(ChallengeParticipant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChallengeParticipant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChallengeParticipant))
+			}
+		}
+	} else {
+		s, ok := maybeChallengeParticipant.(*[]*ChallengeParticipant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChallengeParticipant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChallengeParticipant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &challengeParticipantR{}
+		}
+		args[object.ChallengeID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &challengeParticipantR{}
+			}
+
+			args[obj.ChallengeID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.challenges`),
+		qm.WhereIn(`getstronger.challenges.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Challenge")
+	}
+
+	var resultSlice []*Challenge
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Challenge")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for challenges")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for challenges")
+	}
+
+	if len(challengeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Challenge = foreign
+		if foreign.R == nil {
+			foreign.R = &challengeR{}
+		}
+		foreign.R.ChallengeParticipants = append(foreign.R.ChallengeParticipants, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ChallengeID == foreign.ID {
+				local.R.Challenge = foreign
+				if foreign.R == nil {
+					foreign.R = &challengeR{}
+				}
+				foreign.R.ChallengeParticipants = append(foreign.R.ChallengeParticipants, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (challengeParticipantL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChallengeParticipant interface{}, mods queries.Applicator) error {
+	var slice []*ChallengeParticipant
+	var object *ChallengeParticipant
+
+	if singular {
+		var ok bool
+		object, ok = maybeChallengeParticipant.(*ChallengeParticipant)
+		if !ok {
+			object = new(ChallengeParticipant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChallengeParticipant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChallengeParticipant))
+			}
+		}
+	} else {
+		s, ok := maybeChallengeParticipant.(*[]*ChallengeParticipant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChallengeParticipant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChallengeParticipant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &challengeParticipantR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &challengeParticipantR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ChallengeParticipants = append(foreign.R.ChallengeParticipants, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ChallengeParticipants = append(foreign.R.ChallengeParticipants, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetChallenge of the challengeParticipant to the related item.
+// Sets o.R.Challenge to related.
+// Adds o to related.R.ChallengeParticipants.
+func (o *ChallengeParticipant) SetChallenge(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Challenge) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"challenge_participants\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"challenge_id"}),
+		strmangle.WhereClause("\"", "\"", 2, challengeParticipantPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ChallengeID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ChallengeID = related.ID
+	if o.R == nil {
+		o.R = &challengeParticipantR{
+			Challenge: related,
+		}
+	} else {
+		o.R.Challenge = related
+	}
+
+	if related.R == nil {
+		related.R = &challengeR{
+			ChallengeParticipants: ChallengeParticipantSlice{o},
+		}
+	} else {
+		related.R.ChallengeParticipants = append(related.R.ChallengeParticipants, o)
+	}
+
+	return nil
+}
+
+// SetUser of the challengeParticipant to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ChallengeParticipants.
+func (o *ChallengeParticipant) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"challenge_participants\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, challengeParticipantPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ChallengeID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &challengeParticipantR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ChallengeParticipants: ChallengeParticipantSlice{o},
+		}
+	} else {
+		related.R.ChallengeParticipants = append(related.R.ChallengeParticipants, o)
+	}
+
+	return nil
+}
+
+// ChallengeParticipants retrieves all the records using an executor.
+func ChallengeParticipants(mods ...qm.QueryMod) challengeParticipantQuery {
+	mods = append(mods, qm.From("\"getstronger\".\"challenge_participants\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"getstronger\".\"challenge_participants\".*"})
+	}
+
+	return challengeParticipantQuery{q}
+}
+
+// FindChallengeParticipant retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChallengeParticipant(ctx context.Context, exec boil.ContextExecutor, challengeID string, userID string, selectCols ...string) (*ChallengeParticipant, error) {
+	challengeParticipantObj := &ChallengeParticipant{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"getstronger\".\"challenge_participants\" where \"challenge_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, challengeID, userID)
+
+	err := q.Bind(ctx, exec, challengeParticipantObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from challenge_participants")
+	}
+
+	if err = challengeParticipantObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return challengeParticipantObj, err
+	}
+
+	return challengeParticipantObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChallengeParticipant) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no challenge_participants provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(challengeParticipantColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	challengeParticipantInsertCacheMut.RLock()
+	cache, cached := challengeParticipantInsertCache[key]
+	challengeParticipantInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			challengeParticipantAllColumns,
+			challengeParticipantColumnsWithDefault,
+			challengeParticipantColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(challengeParticipantType, challengeParticipantMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(challengeParticipantType, challengeParticipantMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"getstronger\".\"challenge_participants\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"getstronger\".\"challenge_participants\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into challenge_participants")
+	}
+
+	if !cached {
+		challengeParticipantInsertCacheMut.Lock()
+		challengeParticipantInsertCache[key] = cache
+		challengeParticipantInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ChallengeParticipant.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChallengeParticipant) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	challengeParticipantUpdateCacheMut.RLock()
+	cache, cached := challengeParticipantUpdateCache[key]
+	challengeParticipantUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			challengeParticipantAllColumns,
+			challengeParticipantPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update challenge_participants, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"getstronger\".\"challenge_participants\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, challengeParticipantPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(challengeParticipantType, challengeParticipantMapping, append(wl, challengeParticipantPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update challenge_participants row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for challenge_participants")
+	}
+
+	if !cached {
+		challengeParticipantUpdateCacheMut.Lock()
+		challengeParticipantUpdateCache[key] = cache
+		challengeParticipantUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q challengeParticipantQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for challenge_participants")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for challenge_participants")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChallengeParticipantSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), challengeParticipantPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"getstronger\".\"challenge_participants\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, challengeParticipantPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in challengeParticipant slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all challengeParticipant")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChallengeParticipant) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no challenge_participants provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(challengeParticipantColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	challengeParticipantUpsertCacheMut.RLock()
+	cache, cached := challengeParticipantUpsertCache[key]
+	challengeParticipantUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			challengeParticipantAllColumns,
+			challengeParticipantColumnsWithDefault,
+			challengeParticipantColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			challengeParticipantAllColumns,
+			challengeParticipantPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert challenge_participants, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(challengeParticipantAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(challengeParticipantPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert challenge_participants, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(challengeParticipantPrimaryKeyColumns))
+			copy(conflict, challengeParticipantPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"getstronger\".\"challenge_participants\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(challengeParticipantType, challengeParticipantMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(challengeParticipantType, challengeParticipantMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert challenge_participants")
+	}
+
+	if !cached {
+		challengeParticipantUpsertCacheMut.Lock()
+		challengeParticipantUpsertCache[key] = cache
+		challengeParticipantUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ChallengeParticipant record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChallengeParticipant) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no ChallengeParticipant provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), challengeParticipantPrimaryKeyMapping)
+	sql := "DELETE FROM \"getstronger\".\"challenge_participants\" WHERE \"challenge_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from challenge_participants")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for challenge_participants")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q challengeParticipantQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no challengeParticipantQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from challenge_participants")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for challenge_participants")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChallengeParticipantSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(challengeParticipantBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), challengeParticipantPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"getstronger\".\"challenge_participants\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, challengeParticipantPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from challengeParticipant slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for challenge_participants")
+	}
+
+	if len(challengeParticipantAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChallengeParticipant) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChallengeParticipant(ctx, exec, o.ChallengeID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChallengeParticipantSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChallengeParticipantSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), challengeParticipantPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"getstronger\".\"challenge_participants\".* FROM \"getstronger\".\"challenge_participants\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, challengeParticipantPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in ChallengeParticipantSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChallengeParticipantExists checks if the ChallengeParticipant row exists.
+func ChallengeParticipantExists(ctx context.Context, exec boil.ContextExecutor, challengeID string, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"getstronger\".\"challenge_participants\" where \"challenge_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, challengeID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, challengeID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if challenge_participants exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChallengeParticipant row exists.
+func (o *ChallengeParticipant) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChallengeParticipantExists(ctx, exec, o.ChallengeID, o.UserID)
+}
@@ -0,0 +1,1676 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Challenge is an object representing the database table.
+type Challenge struct {
+	ID        string          `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CreatorID string          `boil:"creator_id" json:"creator_id" toml:"creator_id" yaml:"creator_id"`
+	Title     string          `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Metric    ChallengeMetric `boil:"metric" json:"metric" toml:"metric" yaml:"metric"`
+	Goal      null.Float64    `boil:"goal" json:"goal,omitempty" toml:"goal" yaml:"goal,omitempty"`
+	StartsAt  time.Time       `boil:"starts_at" json:"starts_at" toml:"starts_at" yaml:"starts_at"`
+	EndsAt    time.Time       `boil:"ends_at" json:"ends_at" toml:"ends_at" yaml:"ends_at"`
+	LeaderID  null.String     `boil:"leader_id" json:"leader_id,omitempty" toml:"leader_id" yaml:"leader_id,omitempty"`
+	EndedAt   null.Time       `boil:"ended_at" json:"ended_at,omitempty" toml:"ended_at" yaml:"ended_at,omitempty"`
+	CreatedAt time.Time       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *challengeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L challengeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChallengeColumns = struct {
+	ID        string
+	CreatorID string
+	Title     string
+	Metric    string
+	Goal      string
+	StartsAt  string
+	EndsAt    string
+	LeaderID  string
+	EndedAt   string
+	CreatedAt string
+}{
+	ID:        "id",
+	CreatorID: "creator_id",
+	Title:     "title",
+	Metric:    "metric",
+	Goal:      "goal",
+	StartsAt:  "starts_at",
+	EndsAt:    "ends_at",
+	LeaderID:  "leader_id",
+	EndedAt:   "ended_at",
+	CreatedAt: "created_at",
+}
+
+var ChallengeTableColumns = struct {
+	ID        string
+	CreatorID string
+	Title     string
+	Metric    string
+	Goal      string
+	StartsAt  string
+	EndsAt    string
+	LeaderID  string
+	EndedAt   string
+	CreatedAt string
+}{
+	ID:        "challenges.id",
+	CreatorID: "challenges.creator_id",
+	Title:     "challenges.title",
+	Metric:    "challenges.metric",
+	Goal:      "challenges.goal",
+	StartsAt:  "challenges.starts_at",
+	EndsAt:    "challenges.ends_at",
+	LeaderID:  "challenges.leader_id",
+	EndedAt:   "challenges.ended_at",
+	CreatedAt: "challenges.created_at",
+}
+
+// Generated where
+
+type whereHelperChallengeMetric struct{ field string }
+
+func (w whereHelperChallengeMetric) EQ(x ChallengeMetric) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperChallengeMetric) NEQ(x ChallengeMetric) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperChallengeMetric) LT(x ChallengeMetric) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperChallengeMetric) LTE(x ChallengeMetric) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperChallengeMetric) GT(x ChallengeMetric) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperChallengeMetric) GTE(x ChallengeMetric) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperChallengeMetric) IN(slice []ChallengeMetric) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperChallengeMetric) NIN(slice []ChallengeMetric) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpernull_Float64 struct{ field string }
+
+func (w whereHelpernull_Float64) EQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Float64) NEQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Float64) LT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Float64) LTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Float64) GT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Float64) GTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Float64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Float64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Float64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Float64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var ChallengeWhere = struct {
+	ID        whereHelperstring
+	CreatorID whereHelperstring
+	Title     whereHelperstring
+	Metric    whereHelperChallengeMetric
+	Goal      whereHelpernull_Float64
+	StartsAt  whereHelpertime_Time
+	EndsAt    whereHelpertime_Time
+	LeaderID  whereHelpernull_String
+	EndedAt   whereHelpernull_Time
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"getstronger\".\"challenges\".\"id\""},
+	CreatorID: whereHelperstring{field: "\"getstronger\".\"challenges\".\"creator_id\""},
+	Title:     whereHelperstring{field: "\"getstronger\".\"challenges\".\"title\""},
+	Metric:    whereHelperChallengeMetric{field: "\"getstronger\".\"challenges\".\"metric\""},
+	Goal:      whereHelpernull_Float64{field: "\"getstronger\".\"challenges\".\"goal\""},
+	StartsAt:  whereHelpertime_Time{field: "\"getstronger\".\"challenges\".\"starts_at\""},
+	EndsAt:    whereHelpertime_Time{field: "\"getstronger\".\"challenges\".\"ends_at\""},
+	LeaderID:  whereHelpernull_String{field: "\"getstronger\".\"challenges\".\"leader_id\""},
+	EndedAt:   whereHelpernull_Time{field: "\"getstronger\".\"challenges\".\"ended_at\""},
+	CreatedAt: whereHelpertime_Time{field: "\"getstronger\".\"challenges\".\"created_at\""},
+}
+
+// ChallengeRels is where relationship names are stored.
+var ChallengeRels = struct {
+	Creator               string
+	Leader                string
+	ChallengeParticipants string
+}{
+	Creator:               "Creator",
+	Leader:                "Leader",
+	ChallengeParticipants: "ChallengeParticipants",
+}
+
+// challengeR is where relationships are stored.
+type challengeR struct {
+	Creator               *User                     `boil:"Creator" json:"Creator" toml:"Creator" yaml:"Creator"`
+	Leader                *User                     `boil:"Leader" json:"Leader" toml:"Leader" yaml:"Leader"`
+	ChallengeParticipants ChallengeParticipantSlice `boil:"ChallengeParticipants" json:"ChallengeParticipants" toml:"ChallengeParticipants" yaml:"ChallengeParticipants"`
+}
+
+// NewStruct creates a new relationship struct
+func (*challengeR) NewStruct() *challengeR {
+	return &challengeR{}
+}
+
+func (r *challengeR) GetCreator() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Creator
+}
+
+func (r *challengeR) GetLeader() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Leader
+}
+
+func (r *challengeR) GetChallengeParticipants() ChallengeParticipantSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ChallengeParticipants
+}
+
+// challengeL is where Load methods for each relationship are stored.
+type challengeL struct{}
+
+var (
+	challengeAllColumns            = []string{"id", "creator_id", "title", "metric", "goal", "starts_at", "ends_at", "leader_id", "ended_at", "created_at"}
+	challengeColumnsWithoutDefault = []string{"creator_id", "title", "metric", "starts_at", "ends_at"}
+	challengeColumnsWithDefault    = []string{"id", "goal", "leader_id", "ended_at", "created_at"}
+	challengePrimaryKeyColumns     = []string{"id"}
+	challengeGeneratedColumns      = []string{}
+)
+
+type (
+	// ChallengeSlice is an alias for a slice of pointers to Challenge.
+	// This should almost always be used instead of []Challenge.
+	ChallengeSlice []*Challenge
+	// ChallengeHook is the signature for custom Challenge hook methods
+	ChallengeHook func(context.Context, boil.ContextExecutor, *Challenge) error
+
+	challengeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	challengeType                 = reflect.TypeOf(&Challenge{})
+	challengeMapping              = queries.MakeStructMapping(challengeType)
+	challengePrimaryKeyMapping, _ = queries.BindMapping(challengeType, challengeMapping, challengePrimaryKeyColumns)
+	challengeInsertCacheMut       sync.RWMutex
+	challengeInsertCache          = make(map[string]insertCache)
+	challengeUpdateCacheMut       sync.RWMutex
+	challengeUpdateCache          = make(map[string]updateCache)
+	challengeUpsertCacheMut       sync.RWMutex
+	challengeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var challengeAfterSelectMu sync.Mutex
+var challengeAfterSelectHooks []ChallengeHook
+
+var challengeBeforeInsertMu sync.Mutex
+var challengeBeforeInsertHooks []ChallengeHook
+var challengeAfterInsertMu sync.Mutex
+var challengeAfterInsertHooks []ChallengeHook
+
+var challengeBeforeUpdateMu sync.Mutex
+var challengeBeforeUpdateHooks []ChallengeHook
+var challengeAfterUpdateMu sync.Mutex
+var challengeAfterUpdateHooks []ChallengeHook
+
+var challengeBeforeDeleteMu sync.Mutex
+var challengeBeforeDeleteHooks []ChallengeHook
+var challengeAfterDeleteMu sync.Mutex
+var challengeAfterDeleteHooks []ChallengeHook
+
+var challengeBeforeUpsertMu sync.Mutex
+var challengeBeforeUpsertHooks []ChallengeHook
+var challengeAfterUpsertMu sync.Mutex
+var challengeAfterUpsertHooks []ChallengeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Challenge) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Challenge) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Challenge) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Challenge) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Challenge) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Challenge) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Challenge) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Challenge) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Challenge) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range challengeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddChallengeHook registers your hook function for all future operations.
+func AddChallengeHook(hookPoint boil.HookPoint, challengeHook ChallengeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		challengeAfterSelectMu.Lock()
+		challengeAfterSelectHooks = append(challengeAfterSelectHooks, challengeHook)
+		challengeAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		challengeBeforeInsertMu.Lock()
+		challengeBeforeInsertHooks = append(challengeBeforeInsertHooks, challengeHook)
+		challengeBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		challengeAfterInsertMu.Lock()
+		challengeAfterInsertHooks = append(challengeAfterInsertHooks, challengeHook)
+		challengeAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		challengeBeforeUpdateMu.Lock()
+		challengeBeforeUpdateHooks = append(challengeBeforeUpdateHooks, challengeHook)
+		challengeBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		challengeAfterUpdateMu.Lock()
+		challengeAfterUpdateHooks = append(challengeAfterUpdateHooks, challengeHook)
+		challengeAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		challengeBeforeDeleteMu.Lock()
+		challengeBeforeDeleteHooks = append(challengeBeforeDeleteHooks, challengeHook)
+		challengeBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		challengeAfterDeleteMu.Lock()
+		challengeAfterDeleteHooks = append(challengeAfterDeleteHooks, challengeHook)
+		challengeAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		challengeBeforeUpsertMu.Lock()
+		challengeBeforeUpsertHooks = append(challengeBeforeUpsertHooks, challengeHook)
+		challengeBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		challengeAfterUpsertMu.Lock()
+		challengeAfterUpsertHooks = append(challengeAfterUpsertHooks, challengeHook)
+		challengeAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single challenge record from the query.
+func (q challengeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Challenge, error) {
+	o := &Challenge{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for challenges")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Challenge records from the query.
+func (q challengeQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChallengeSlice, error) {
+	var o []*Challenge
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to Challenge slice")
+	}
+
+	if len(challengeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Challenge records in the query.
+func (q challengeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count challenges rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q challengeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if challenges exists")
+	}
+
+	return count > 0, nil
+}
+
+// Creator pointed to by the foreign key.
+func (o *Challenge) Creator(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CreatorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Leader pointed to by the foreign key.
+func (o *Challenge) Leader(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LeaderID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// ChallengeParticipants retrieves all the challenge_participant's ChallengeParticipants with an executor.
+func (o *Challenge) ChallengeParticipants(mods ...qm.QueryMod) challengeParticipantQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"challenge_participants\".\"challenge_id\"=?", o.ID),
+	)
+
+	return ChallengeParticipants(queryMods...)
+}
+
+// LoadCreator allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (challengeL) LoadCreator(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChallenge interface{}, mods queries.Applicator) error {
+	var slice []*Challenge
+	var object *Challenge
+
+	if singular {
+		var ok bool
+		object, ok = maybeChallenge.(*Challenge)
+		if !ok {
+			object = new(Challenge)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChallenge)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChallenge))
+			}
+		}
+	} else {
+		s, ok := maybeChallenge.(*[]*Challenge)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChallenge)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChallenge))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &challengeR{}
+		}
+		args[object.CreatorID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &challengeR{}
+			}
+
+			args[obj.CreatorID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Creator = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CreatorChallenges = append(foreign.R.CreatorChallenges, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CreatorID == foreign.ID {
+				local.R.Creator = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CreatorChallenges = append(foreign.R.CreatorChallenges, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLeader allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (challengeL) LoadLeader(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChallenge interface{}, mods queries.Applicator) error {
+	var slice []*Challenge
+	var object *Challenge
+
+	if singular {
+		var ok bool
+		object, ok = maybeChallenge.(*Challenge)
+		if !ok {
+			object = new(Challenge)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChallenge)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChallenge))
+			}
+		}
+	} else {
+		s, ok := maybeChallenge.(*[]*Challenge)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChallenge)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChallenge))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &challengeR{}
+		}
+		if !queries.IsNil(object.LeaderID) {
+			args[object.LeaderID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &challengeR{}
+			}
+
+			if !queries.IsNil(obj.LeaderID) {
+				args[obj.LeaderID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Leader = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.LeaderChallenges = append(foreign.R.LeaderChallenges, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.LeaderID, foreign.ID) {
+				local.R.Leader = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.LeaderChallenges = append(foreign.R.LeaderChallenges, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChallengeParticipants allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (challengeL) LoadChallengeParticipants(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChallenge interface{}, mods queries.Applicator) error {
+	var slice []*Challenge
+	var object *Challenge
+
+	if singular {
+		var ok bool
+		object, ok = maybeChallenge.(*Challenge)
+		if !ok {
+			object = new(Challenge)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChallenge)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChallenge))
+			}
+		}
+	} else {
+		s, ok := maybeChallenge.(*[]*Challenge)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChallenge)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChallenge))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &challengeR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &challengeR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.challenge_participants`),
+		qm.WhereIn(`getstronger.challenge_participants.challenge_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load challenge_participants")
+	}
+
+	var resultSlice []*ChallengeParticipant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice challenge_participants")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on challenge_participants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for challenge_participants")
+	}
+
+	if len(challengeParticipantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChallengeParticipants = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &challengeParticipantR{}
+			}
+			foreign.R.Challenge = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ChallengeID {
+				local.R.ChallengeParticipants = append(local.R.ChallengeParticipants, foreign)
+				if foreign.R == nil {
+					foreign.R = &challengeParticipantR{}
+				}
+				foreign.R.Challenge = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCreator of the challenge to the related item.
+// Sets o.R.Creator to related.
+// Adds o to related.R.CreatorChallenges.
+func (o *Challenge) SetCreator(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"challenges\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"creator_id"}),
+		strmangle.WhereClause("\"", "\"", 2, challengePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CreatorID = related.ID
+	if o.R == nil {
+		o.R = &challengeR{
+			Creator: related,
+		}
+	} else {
+		o.R.Creator = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatorChallenges: ChallengeSlice{o},
+		}
+	} else {
+		related.R.CreatorChallenges = append(related.R.CreatorChallenges, o)
+	}
+
+	return nil
+}
+
+// SetLeader of the challenge to the related item.
+// Sets o.R.Leader to related.
+// Adds o to related.R.LeaderChallenges.
+func (o *Challenge) SetLeader(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"challenges\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"leader_id"}),
+		strmangle.WhereClause("\"", "\"", 2, challengePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.LeaderID, related.ID)
+	if o.R == nil {
+		o.R = &challengeR{
+			Leader: related,
+		}
+	} else {
+		o.R.Leader = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			LeaderChallenges: ChallengeSlice{o},
+		}
+	} else {
+		related.R.LeaderChallenges = append(related.R.LeaderChallenges, o)
+	}
+
+	return nil
+}
+
+// RemoveLeader relationship.
+// Sets o.R.Leader to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Challenge) RemoveLeader(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.LeaderID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("leader_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Leader = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.LeaderChallenges {
+		if queries.Equal(o.LeaderID, ri.LeaderID) {
+			continue
+		}
+
+		ln := len(related.R.LeaderChallenges)
+		if ln > 1 && i < ln-1 {
+			related.R.LeaderChallenges[i] = related.R.LeaderChallenges[ln-1]
+		}
+		related.R.LeaderChallenges = related.R.LeaderChallenges[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddChallengeParticipants adds the given related objects to the existing relationships
+// of the challenge, optionally inserting them as new records.
+// Appends related to o.R.ChallengeParticipants.
+// Sets related.R.Challenge appropriately.
+func (o *Challenge) AddChallengeParticipants(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChallengeParticipant) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ChallengeID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"challenge_participants\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"challenge_id"}),
+				strmangle.WhereClause("\"", "\"", 2, challengeParticipantPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ChallengeID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ChallengeID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &challengeR{
+			ChallengeParticipants: related,
+		}
+	} else {
+		o.R.ChallengeParticipants = append(o.R.ChallengeParticipants, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &challengeParticipantR{
+				Challenge: o,
+			}
+		} else {
+			rel.R.Challenge = o
+		}
+	}
+	return nil
+}
+
+// Challenges retrieves all the records using an executor.
+func Challenges(mods ...qm.QueryMod) challengeQuery {
+	mods = append(mods, qm.From("\"getstronger\".\"challenges\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"getstronger\".\"challenges\".*"})
+	}
+
+	return challengeQuery{q}
+}
+
+// FindChallenge retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChallenge(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Challenge, error) {
+	challengeObj := &Challenge{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"getstronger\".\"challenges\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, challengeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from challenges")
+	}
+
+	if err = challengeObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return challengeObj, err
+	}
+
+	return challengeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Challenge) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no challenges provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(challengeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	challengeInsertCacheMut.RLock()
+	cache, cached := challengeInsertCache[key]
+	challengeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			challengeAllColumns,
+			challengeColumnsWithDefault,
+			challengeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(challengeType, challengeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(challengeType, challengeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"getstronger\".\"challenges\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"getstronger\".\"challenges\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into challenges")
+	}
+
+	if !cached {
+		challengeInsertCacheMut.Lock()
+		challengeInsertCache[key] = cache
+		challengeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Challenge.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Challenge) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	challengeUpdateCacheMut.RLock()
+	cache, cached := challengeUpdateCache[key]
+	challengeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			challengeAllColumns,
+			challengePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update challenges, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"getstronger\".\"challenges\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, challengePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(challengeType, challengeMapping, append(wl, challengePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update challenges row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for challenges")
+	}
+
+	if !cached {
+		challengeUpdateCacheMut.Lock()
+		challengeUpdateCache[key] = cache
+		challengeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q challengeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChallengeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), challengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"getstronger\".\"challenges\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, challengePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in challenge slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all challenge")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Challenge) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no challenges provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(challengeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	challengeUpsertCacheMut.RLock()
+	cache, cached := challengeUpsertCache[key]
+	challengeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			challengeAllColumns,
+			challengeColumnsWithDefault,
+			challengeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			challengeAllColumns,
+			challengePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert challenges, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(challengeAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(challengePrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert challenges, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(challengePrimaryKeyColumns))
+			copy(conflict, challengePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"getstronger\".\"challenges\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(challengeType, challengeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(challengeType, challengeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert challenges")
+	}
+
+	if !cached {
+		challengeUpsertCacheMut.Lock()
+		challengeUpsertCache[key] = cache
+		challengeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Challenge record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Challenge) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no Challenge provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), challengePrimaryKeyMapping)
+	sql := "DELETE FROM \"getstronger\".\"challenges\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for challenges")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q challengeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no challengeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from challenges")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for challenges")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChallengeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(challengeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), challengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"getstronger\".\"challenges\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, challengePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from challenge slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for challenges")
+	}
+
+	if len(challengeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Challenge) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChallenge(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChallengeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChallengeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), challengePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"getstronger\".\"challenges\".* FROM \"getstronger\".\"challenges\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, challengePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in ChallengeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChallengeExists checks if the Challenge row exists.
+func ChallengeExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"getstronger\".\"challenges\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if challenges exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Challenge row exists.
+func (o *Challenge) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChallengeExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1410 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ClubMember is an object representing the database table.
+type ClubMember struct {
+	ClubID    string           `boil:"club_id" json:"club_id" toml:"club_id" yaml:"club_id"`
+	UserID    string           `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Role      ClubRole         `boil:"role" json:"role" toml:"role" yaml:"role"`
+	Status    ClubMemberStatus `boil:"status" json:"status" toml:"status" yaml:"status"`
+	CreatedAt time.Time        `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *clubMemberR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L clubMemberL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ClubMemberColumns = struct {
+	ClubID    string
+	UserID    string
+	Role      string
+	Status    string
+	CreatedAt string
+}{
+	ClubID:    "club_id",
+	UserID:    "user_id",
+	Role:      "role",
+	Status:    "status",
+	CreatedAt: "created_at",
+}
+
+var ClubMemberTableColumns = struct {
+	ClubID    string
+	UserID    string
+	Role      string
+	Status    string
+	CreatedAt string
+}{
+	ClubID:    "club_members.club_id",
+	UserID:    "club_members.user_id",
+	Role:      "club_members.role",
+	Status:    "club_members.status",
+	CreatedAt: "club_members.created_at",
+}
+
+// Generated where
+
+type whereHelperClubRole struct{ field string }
+
+func (w whereHelperClubRole) EQ(x ClubRole) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperClubRole) NEQ(x ClubRole) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperClubRole) LT(x ClubRole) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperClubRole) LTE(x ClubRole) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperClubRole) GT(x ClubRole) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperClubRole) GTE(x ClubRole) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperClubRole) IN(slice []ClubRole) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperClubRole) NIN(slice []ClubRole) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperClubMemberStatus struct{ field string }
+
+func (w whereHelperClubMemberStatus) EQ(x ClubMemberStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperClubMemberStatus) NEQ(x ClubMemberStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperClubMemberStatus) LT(x ClubMemberStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperClubMemberStatus) LTE(x ClubMemberStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperClubMemberStatus) GT(x ClubMemberStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperClubMemberStatus) GTE(x ClubMemberStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperClubMemberStatus) IN(slice []ClubMemberStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperClubMemberStatus) NIN(slice []ClubMemberStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var ClubMemberWhere = struct {
+	ClubID    whereHelperstring
+	UserID    whereHelperstring
+	Role      whereHelperClubRole
+	Status    whereHelperClubMemberStatus
+	CreatedAt whereHelpertime_Time
+}{
+	ClubID:    whereHelperstring{field: "\"getstronger\".\"club_members\".\"club_id\""},
+	UserID:    whereHelperstring{field: "\"getstronger\".\"club_members\".\"user_id\""},
+	Role:      whereHelperClubRole{field: "\"getstronger\".\"club_members\".\"role\""},
+	Status:    whereHelperClubMemberStatus{field: "\"getstronger\".\"club_members\".\"status\""},
+	CreatedAt: whereHelpertime_Time{field: "\"getstronger\".\"club_members\".\"created_at\""},
+}
+
+// ClubMemberRels is where relationship names are stored.
+var ClubMemberRels = struct {
+	Club string
+	User string
+}{
+	Club: "Club",
+	User: "User",
+}
+
+// clubMemberR is where relationships are stored.
+type clubMemberR struct {
+	Club *Club `boil:"Club" json:"Club" toml:"Club" yaml:"Club"`
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*clubMemberR) NewStruct() *clubMemberR {
+	return &clubMemberR{}
+}
+
+func (r *clubMemberR) GetClub() *Club {
+	if r == nil {
+		return nil
+	}
+	return r.Club
+}
+
+func (r *clubMemberR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// clubMemberL is where Load methods for each relationship are stored.
+type clubMemberL struct{}
+
+var (
+	clubMemberAllColumns            = []string{"club_id", "user_id", "role", "status", "created_at"}
+	clubMemberColumnsWithoutDefault = []string{"club_id", "user_id", "status"}
+	clubMemberColumnsWithDefault    = []string{"role", "created_at"}
+	clubMemberPrimaryKeyColumns     = []string{"club_id", "user_id"}
+	clubMemberGeneratedColumns      = []string{}
+)
+
+type (
+	// ClubMemberSlice is an alias for a slice of pointers to ClubMember.
+	// This should almost always be used instead of []ClubMember.
+	ClubMemberSlice []*ClubMember
+	// ClubMemberHook is the signature for custom ClubMember hook methods
+	ClubMemberHook func(context.Context, boil.ContextExecutor, *ClubMember) error
+
+	clubMemberQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	clubMemberType                 = reflect.TypeOf(&ClubMember{})
+	clubMemberMapping              = queries.MakeStructMapping(clubMemberType)
+	clubMemberPrimaryKeyMapping, _ = queries.BindMapping(clubMemberType, clubMemberMapping, clubMemberPrimaryKeyColumns)
+	clubMemberInsertCacheMut       sync.RWMutex
+	clubMemberInsertCache          = make(map[string]insertCache)
+	clubMemberUpdateCacheMut       sync.RWMutex
+	clubMemberUpdateCache          = make(map[string]updateCache)
+	clubMemberUpsertCacheMut       sync.RWMutex
+	clubMemberUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var clubMemberAfterSelectMu sync.Mutex
+var clubMemberAfterSelectHooks []ClubMemberHook
+
+var clubMemberBeforeInsertMu sync.Mutex
+var clubMemberBeforeInsertHooks []ClubMemberHook
+var clubMemberAfterInsertMu sync.Mutex
+var clubMemberAfterInsertHooks []ClubMemberHook
+
+var clubMemberBeforeUpdateMu sync.Mutex
+var clubMemberBeforeUpdateHooks []ClubMemberHook
+var clubMemberAfterUpdateMu sync.Mutex
+var clubMemberAfterUpdateHooks []ClubMemberHook
+
+var clubMemberBeforeDeleteMu sync.Mutex
+var clubMemberBeforeDeleteHooks []ClubMemberHook
+var clubMemberAfterDeleteMu sync.Mutex
+var clubMemberAfterDeleteHooks []ClubMemberHook
+
+var clubMemberBeforeUpsertMu sync.Mutex
+var clubMemberBeforeUpsertHooks []ClubMemberHook
+var clubMemberAfterUpsertMu sync.Mutex
+var clubMemberAfterUpsertHooks []ClubMemberHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ClubMember) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubMemberAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ClubMember) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubMemberBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ClubMember) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubMemberAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ClubMember) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubMemberBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ClubMember) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubMemberAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ClubMember) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubMemberBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ClubMember) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubMemberAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ClubMember) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubMemberBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ClubMember) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubMemberAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddClubMemberHook registers your hook function for all future operations.
+func AddClubMemberHook(hookPoint boil.HookPoint, clubMemberHook ClubMemberHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		clubMemberAfterSelectMu.Lock()
+		clubMemberAfterSelectHooks = append(clubMemberAfterSelectHooks, clubMemberHook)
+		clubMemberAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		clubMemberBeforeInsertMu.Lock()
+		clubMemberBeforeInsertHooks = append(clubMemberBeforeInsertHooks, clubMemberHook)
+		clubMemberBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		clubMemberAfterInsertMu.Lock()
+		clubMemberAfterInsertHooks = append(clubMemberAfterInsertHooks, clubMemberHook)
+		clubMemberAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		clubMemberBeforeUpdateMu.Lock()
+		clubMemberBeforeUpdateHooks = append(clubMemberBeforeUpdateHooks, clubMemberHook)
+		clubMemberBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		clubMemberAfterUpdateMu.Lock()
+		clubMemberAfterUpdateHooks = append(clubMemberAfterUpdateHooks, clubMemberHook)
+		clubMemberAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		clubMemberBeforeDeleteMu.Lock()
+		clubMemberBeforeDeleteHooks = append(clubMemberBeforeDeleteHooks, clubMemberHook)
+		clubMemberBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		clubMemberAfterDeleteMu.Lock()
+		clubMemberAfterDeleteHooks = append(clubMemberAfterDeleteHooks, clubMemberHook)
+		clubMemberAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		clubMemberBeforeUpsertMu.Lock()
+		clubMemberBeforeUpsertHooks = append(clubMemberBeforeUpsertHooks, clubMemberHook)
+		clubMemberBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		clubMemberAfterUpsertMu.Lock()
+		clubMemberAfterUpsertHooks = append(clubMemberAfterUpsertHooks, clubMemberHook)
+		clubMemberAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single clubMember record from the query.
+func (q clubMemberQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ClubMember, error) {
+	o := &ClubMember{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for club_members")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ClubMember records from the query.
+func (q clubMemberQuery) All(ctx context.Context, exec boil.ContextExecutor) (ClubMemberSlice, error) {
+	var o []*ClubMember
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to ClubMember slice")
+	}
+
+	if len(clubMemberAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ClubMember records in the query.
+func (q clubMemberQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count club_members rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q clubMemberQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if club_members exists")
+	}
+
+	return count > 0, nil
+}
+
+// Club pointed to by the foreign key.
+func (o *ClubMember) Club(mods ...qm.QueryMod) clubQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ClubID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Clubs(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *ClubMember) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadClub allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (clubMemberL) LoadClub(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClubMember interface{}, mods queries.Applicator) error {
+	var slice []*ClubMember
+	var object *ClubMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeClubMember.(*ClubMember)
+		if !ok {
+			object = new(ClubMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeClubMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeClubMember))
+			}
+		}
+	} else {
+		s, ok := maybeClubMember.(*[]*ClubMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeClubMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeClubMember))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &clubMemberR{}
+		}
+		args[object.ClubID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &clubMemberR{}
+			}
+
+			args[obj.ClubID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.clubs`),
+		qm.WhereIn(`getstronger.clubs.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Club")
+	}
+
+	var resultSlice []*Club
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Club")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for clubs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for clubs")
+	}
+
+	if len(clubAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Club = foreign
+		if foreign.R == nil {
+			foreign.R = &clubR{}
+		}
+		foreign.R.ClubMembers = append(foreign.R.ClubMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ClubID == foreign.ID {
+				local.R.Club = foreign
+				if foreign.R == nil {
+					foreign.R = &clubR{}
+				}
+				foreign.R.ClubMembers = append(foreign.R.ClubMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (clubMemberL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClubMember interface{}, mods queries.Applicator) error {
+	var slice []*ClubMember
+	var object *ClubMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeClubMember.(*ClubMember)
+		if !ok {
+			object = new(ClubMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeClubMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeClubMember))
+			}
+		}
+	} else {
+		s, ok := maybeClubMember.(*[]*ClubMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeClubMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeClubMember))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &clubMemberR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &clubMemberR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ClubMembers = append(foreign.R.ClubMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ClubMembers = append(foreign.R.ClubMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetClub of the clubMember to the related item.
+// Sets o.R.Club to related.
+// Adds o to related.R.ClubMembers.
+func (o *ClubMember) SetClub(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Club) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"club_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"club_id"}),
+		strmangle.WhereClause("\"", "\"", 2, clubMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ClubID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ClubID = related.ID
+	if o.R == nil {
+		o.R = &clubMemberR{
+			Club: related,
+		}
+	} else {
+		o.R.Club = related
+	}
+
+	if related.R == nil {
+		related.R = &clubR{
+			ClubMembers: ClubMemberSlice{o},
+		}
+	} else {
+		related.R.ClubMembers = append(related.R.ClubMembers, o)
+	}
+
+	return nil
+}
+
+// SetUser of the clubMember to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ClubMembers.
+func (o *ClubMember) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"club_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, clubMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ClubID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &clubMemberR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ClubMembers: ClubMemberSlice{o},
+		}
+	} else {
+		related.R.ClubMembers = append(related.R.ClubMembers, o)
+	}
+
+	return nil
+}
+
+// ClubMembers retrieves all the records using an executor.
+func ClubMembers(mods ...qm.QueryMod) clubMemberQuery {
+	mods = append(mods, qm.From("\"getstronger\".\"club_members\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"getstronger\".\"club_members\".*"})
+	}
+
+	return clubMemberQuery{q}
+}
+
+// FindClubMember retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindClubMember(ctx context.Context, exec boil.ContextExecutor, clubID string, userID string, selectCols ...string) (*ClubMember, error) {
+	clubMemberObj := &ClubMember{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"getstronger\".\"club_members\" where \"club_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, clubID, userID)
+
+	err := q.Bind(ctx, exec, clubMemberObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from club_members")
+	}
+
+	if err = clubMemberObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return clubMemberObj, err
+	}
+
+	return clubMemberObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ClubMember) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no club_members provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(clubMemberColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	clubMemberInsertCacheMut.RLock()
+	cache, cached := clubMemberInsertCache[key]
+	clubMemberInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			clubMemberAllColumns,
+			clubMemberColumnsWithDefault,
+			clubMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(clubMemberType, clubMemberMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(clubMemberType, clubMemberMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"getstronger\".\"club_members\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"getstronger\".\"club_members\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into club_members")
+	}
+
+	if !cached {
+		clubMemberInsertCacheMut.Lock()
+		clubMemberInsertCache[key] = cache
+		clubMemberInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ClubMember.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ClubMember) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	clubMemberUpdateCacheMut.RLock()
+	cache, cached := clubMemberUpdateCache[key]
+	clubMemberUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			clubMemberAllColumns,
+			clubMemberPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update club_members, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"getstronger\".\"club_members\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, clubMemberPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(clubMemberType, clubMemberMapping, append(wl, clubMemberPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update club_members row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for club_members")
+	}
+
+	if !cached {
+		clubMemberUpdateCacheMut.Lock()
+		clubMemberUpdateCache[key] = cache
+		clubMemberUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q clubMemberQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for club_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for club_members")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ClubMemberSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), clubMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"getstronger\".\"club_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, clubMemberPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in clubMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all clubMember")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ClubMember) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no club_members provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(clubMemberColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	clubMemberUpsertCacheMut.RLock()
+	cache, cached := clubMemberUpsertCache[key]
+	clubMemberUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			clubMemberAllColumns,
+			clubMemberColumnsWithDefault,
+			clubMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			clubMemberAllColumns,
+			clubMemberPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert club_members, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(clubMemberAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(clubMemberPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert club_members, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(clubMemberPrimaryKeyColumns))
+			copy(conflict, clubMemberPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"getstronger\".\"club_members\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(clubMemberType, clubMemberMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(clubMemberType, clubMemberMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert club_members")
+	}
+
+	if !cached {
+		clubMemberUpsertCacheMut.Lock()
+		clubMemberUpsertCache[key] = cache
+		clubMemberUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single ClubMember record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ClubMember) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no ClubMember provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), clubMemberPrimaryKeyMapping)
+	sql := "DELETE FROM \"getstronger\".\"club_members\" WHERE \"club_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from club_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for club_members")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q clubMemberQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no clubMemberQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from club_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for club_members")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ClubMemberSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(clubMemberBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), clubMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"getstronger\".\"club_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, clubMemberPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from clubMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for club_members")
+	}
+
+	if len(clubMemberAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ClubMember) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindClubMember(ctx, exec, o.ClubID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ClubMemberSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ClubMemberSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), clubMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"getstronger\".\"club_members\".* FROM \"getstronger\".\"club_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, clubMemberPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in ClubMemberSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ClubMemberExists checks if the ClubMember row exists.
+func ClubMemberExists(ctx context.Context, exec boil.ContextExecutor, clubID string, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"getstronger\".\"club_members\" where \"club_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, clubID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, clubID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if club_members exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ClubMember row exists.
+func (o *ClubMember) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ClubMemberExists(ctx, exec, o.ClubID, o.UserID)
+}
@@ -0,0 +1,1148 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Club is an object representing the database table.
+type Club struct {
+	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name        string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Description null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *clubR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L clubL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ClubColumns = struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedAt   string
+}{
+	ID:          "id",
+	Name:        "name",
+	Description: "description",
+	CreatedAt:   "created_at",
+}
+
+var ClubTableColumns = struct {
+	ID          string
+	Name        string
+	Description string
+	CreatedAt   string
+}{
+	ID:          "clubs.id",
+	Name:        "clubs.name",
+	Description: "clubs.description",
+	CreatedAt:   "clubs.created_at",
+}
+
+// Generated where
+
+var ClubWhere = struct {
+	ID          whereHelperstring
+	Name        whereHelperstring
+	Description whereHelpernull_String
+	CreatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperstring{field: "\"getstronger\".\"clubs\".\"id\""},
+	Name:        whereHelperstring{field: "\"getstronger\".\"clubs\".\"name\""},
+	Description: whereHelpernull_String{field: "\"getstronger\".\"clubs\".\"description\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"getstronger\".\"clubs\".\"created_at\""},
+}
+
+// ClubRels is where relationship names are stored.
+var ClubRels = struct {
+	ClubMembers string
+}{
+	ClubMembers: "ClubMembers",
+}
+
+// clubR is where relationships are stored.
+type clubR struct {
+	ClubMembers ClubMemberSlice `boil:"ClubMembers" json:"ClubMembers" toml:"ClubMembers" yaml:"ClubMembers"`
+}
+
+// NewStruct creates a new relationship struct
+func (*clubR) NewStruct() *clubR {
+	return &clubR{}
+}
+
+func (r *clubR) GetClubMembers() ClubMemberSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ClubMembers
+}
+
+// clubL is where Load methods for each relationship are stored.
+type clubL struct{}
+
+var (
+	clubAllColumns            = []string{"id", "name", "description", "created_at"}
+	clubColumnsWithoutDefault = []string{"name"}
+	clubColumnsWithDefault    = []string{"id", "description", "created_at"}
+	clubPrimaryKeyColumns     = []string{"id"}
+	clubGeneratedColumns      = []string{}
+)
+
+type (
+	// ClubSlice is an alias for a slice of pointers to Club.
+	// This should almost always be used instead of []Club.
+	ClubSlice []*Club
+	// ClubHook is the signature for custom Club hook methods
+	ClubHook func(context.Context, boil.ContextExecutor, *Club) error
+
+	clubQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	clubType                 = reflect.TypeOf(&Club{})
+	clubMapping              = queries.MakeStructMapping(clubType)
+	clubPrimaryKeyMapping, _ = queries.BindMapping(clubType, clubMapping, clubPrimaryKeyColumns)
+	clubInsertCacheMut       sync.RWMutex
+	clubInsertCache          = make(map[string]insertCache)
+	clubUpdateCacheMut       sync.RWMutex
+	clubUpdateCache          = make(map[string]updateCache)
+	clubUpsertCacheMut       sync.RWMutex
+	clubUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var clubAfterSelectMu sync.Mutex
+var clubAfterSelectHooks []ClubHook
+
+var clubBeforeInsertMu sync.Mutex
+var clubBeforeInsertHooks []ClubHook
+var clubAfterInsertMu sync.Mutex
+var clubAfterInsertHooks []ClubHook
+
+var clubBeforeUpdateMu sync.Mutex
+var clubBeforeUpdateHooks []ClubHook
+var clubAfterUpdateMu sync.Mutex
+var clubAfterUpdateHooks []ClubHook
+
+var clubBeforeDeleteMu sync.Mutex
+var clubBeforeDeleteHooks []ClubHook
+var clubAfterDeleteMu sync.Mutex
+var clubAfterDeleteHooks []ClubHook
+
+var clubBeforeUpsertMu sync.Mutex
+var clubBeforeUpsertHooks []ClubHook
+var clubAfterUpsertMu sync.Mutex
+var clubAfterUpsertHooks []ClubHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Club) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Club) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Club) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Club) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Club) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Club) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Club) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Club) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Club) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range clubAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddClubHook registers your hook function for all future operations.
+func AddClubHook(hookPoint boil.HookPoint, clubHook ClubHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		clubAfterSelectMu.Lock()
+		clubAfterSelectHooks = append(clubAfterSelectHooks, clubHook)
+		clubAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		clubBeforeInsertMu.Lock()
+		clubBeforeInsertHooks = append(clubBeforeInsertHooks, clubHook)
+		clubBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		clubAfterInsertMu.Lock()
+		clubAfterInsertHooks = append(clubAfterInsertHooks, clubHook)
+		clubAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		clubBeforeUpdateMu.Lock()
+		clubBeforeUpdateHooks = append(clubBeforeUpdateHooks, clubHook)
+		clubBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		clubAfterUpdateMu.Lock()
+		clubAfterUpdateHooks = append(clubAfterUpdateHooks, clubHook)
+		clubAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		clubBeforeDeleteMu.Lock()
+		clubBeforeDeleteHooks = append(clubBeforeDeleteHooks, clubHook)
+		clubBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		clubAfterDeleteMu.Lock()
+		clubAfterDeleteHooks = append(clubAfterDeleteHooks, clubHook)
+		clubAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		clubBeforeUpsertMu.Lock()
+		clubBeforeUpsertHooks = append(clubBeforeUpsertHooks, clubHook)
+		clubBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		clubAfterUpsertMu.Lock()
+		clubAfterUpsertHooks = append(clubAfterUpsertHooks, clubHook)
+		clubAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single club record from the query.
+func (q clubQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Club, error) {
+	o := &Club{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for clubs")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Club records from the query.
+func (q clubQuery) All(ctx context.Context, exec boil.ContextExecutor) (ClubSlice, error) {
+	var o []*Club
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to Club slice")
+	}
+
+	if len(clubAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Club records in the query.
+func (q clubQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count clubs rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q clubQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if clubs exists")
+	}
+
+	return count > 0, nil
+}
+
+// ClubMembers retrieves all the club_member's ClubMembers with an executor.
+func (o *Club) ClubMembers(mods ...qm.QueryMod) clubMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"club_members\".\"club_id\"=?", o.ID),
+	)
+
+	return ClubMembers(queryMods...)
+}
+
+// LoadClubMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (clubL) LoadClubMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeClub interface{}, mods queries.Applicator) error {
+	var slice []*Club
+	var object *Club
+
+	if singular {
+		var ok bool
+		object, ok = maybeClub.(*Club)
+		if !ok {
+			object = new(Club)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeClub)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeClub))
+			}
+		}
+	} else {
+		s, ok := maybeClub.(*[]*Club)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeClub)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeClub))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &clubR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &clubR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.club_members`),
+		qm.WhereIn(`getstronger.club_members.club_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load club_members")
+	}
+
+	var resultSlice []*ClubMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice club_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on club_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for club_members")
+	}
+
+	if len(clubMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ClubMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &clubMemberR{}
+			}
+			foreign.R.Club = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ClubID {
+				local.R.ClubMembers = append(local.R.ClubMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &clubMemberR{}
+				}
+				foreign.R.Club = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddClubMembers adds the given related objects to the existing relationships
+// of the club, optionally inserting them as new records.
+// Appends related to o.R.ClubMembers.
+// Sets related.R.Club appropriately.
+func (o *Club) AddClubMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ClubMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ClubID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"club_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"club_id"}),
+				strmangle.WhereClause("\"", "\"", 2, clubMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ClubID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ClubID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &clubR{
+			ClubMembers: related,
+		}
+	} else {
+		o.R.ClubMembers = append(o.R.ClubMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &clubMemberR{
+				Club: o,
+			}
+		} else {
+			rel.R.Club = o
+		}
+	}
+	return nil
+}
+
+// Clubs retrieves all the records using an executor.
+func Clubs(mods ...qm.QueryMod) clubQuery {
+	mods = append(mods, qm.From("\"getstronger\".\"clubs\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"getstronger\".\"clubs\".*"})
+	}
+
+	return clubQuery{q}
+}
+
+// FindClub retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindClub(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Club, error) {
+	clubObj := &Club{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"getstronger\".\"clubs\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, clubObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from clubs")
+	}
+
+	if err = clubObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return clubObj, err
+	}
+
+	return clubObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Club) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no clubs provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(clubColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	clubInsertCacheMut.RLock()
+	cache, cached := clubInsertCache[key]
+	clubInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			clubAllColumns,
+			clubColumnsWithDefault,
+			clubColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(clubType, clubMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(clubType, clubMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"getstronger\".\"clubs\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"getstronger\".\"clubs\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into clubs")
+	}
+
+	if !cached {
+		clubInsertCacheMut.Lock()
+		clubInsertCache[key] = cache
+		clubInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Club.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Club) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	clubUpdateCacheMut.RLock()
+	cache, cached := clubUpdateCache[key]
+	clubUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			clubAllColumns,
+			clubPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update clubs, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"getstronger\".\"clubs\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, clubPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(clubType, clubMapping, append(wl, clubPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update clubs row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for clubs")
+	}
+
+	if !cached {
+		clubUpdateCacheMut.Lock()
+		clubUpdateCache[key] = cache
+		clubUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q clubQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for clubs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for clubs")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ClubSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), clubPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"getstronger\".\"clubs\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, clubPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in club slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all club")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Club) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no clubs provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(clubColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	clubUpsertCacheMut.RLock()
+	cache, cached := clubUpsertCache[key]
+	clubUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			clubAllColumns,
+			clubColumnsWithDefault,
+			clubColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			clubAllColumns,
+			clubPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert clubs, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(clubAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(clubPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert clubs, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(clubPrimaryKeyColumns))
+			copy(conflict, clubPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"getstronger\".\"clubs\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(clubType, clubMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(clubType, clubMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert clubs")
+	}
+
+	if !cached {
+		clubUpsertCacheMut.Lock()
+		clubUpsertCache[key] = cache
+		clubUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Club record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Club) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no Club provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), clubPrimaryKeyMapping)
+	sql := "DELETE FROM \"getstronger\".\"clubs\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from clubs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for clubs")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q clubQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no clubQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from clubs")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for clubs")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ClubSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(clubBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), clubPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"getstronger\".\"clubs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, clubPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from club slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for clubs")
+	}
+
+	if len(clubAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Club) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindClub(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ClubSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ClubSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), clubPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"getstronger\".\"clubs\".* FROM \"getstronger\".\"clubs\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, clubPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in ClubSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ClubExists checks if the Club row exists.
+func ClubExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"getstronger\".\"clubs\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if clubs exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Club row exists.
+func (o *Club) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ClubExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1383 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// CoachAction is an object representing the database table.
+type CoachAction struct {
+	ID        string          `boil:"id" json:"id" toml:"id" yaml:"id"`
+	CoachID   string          `boil:"coach_id" json:"coach_id" toml:"coach_id" yaml:"coach_id"`
+	AthleteID string          `boil:"athlete_id" json:"athlete_id" toml:"athlete_id" yaml:"athlete_id"`
+	Action    CoachActionType `boil:"action" json:"action" toml:"action" yaml:"action"`
+	TargetID  null.String     `boil:"target_id" json:"target_id,omitempty" toml:"target_id" yaml:"target_id,omitempty"`
+	CreatedAt time.Time       `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *coachActionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L coachActionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CoachActionColumns = struct {
+	ID        string
+	CoachID   string
+	AthleteID string
+	Action    string
+	TargetID  string
+	CreatedAt string
+}{
+	ID:        "id",
+	CoachID:   "coach_id",
+	AthleteID: "athlete_id",
+	Action:    "action",
+	TargetID:  "target_id",
+	CreatedAt: "created_at",
+}
+
+var CoachActionTableColumns = struct {
+	ID        string
+	CoachID   string
+	AthleteID string
+	Action    string
+	TargetID  string
+	CreatedAt string
+}{
+	ID:        "coach_actions.id",
+	CoachID:   "coach_actions.coach_id",
+	AthleteID: "coach_actions.athlete_id",
+	Action:    "coach_actions.action",
+	TargetID:  "coach_actions.target_id",
+	CreatedAt: "coach_actions.created_at",
+}
+
+// Generated where
+
+type whereHelperCoachActionType struct{ field string }
+
+func (w whereHelperCoachActionType) EQ(x CoachActionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperCoachActionType) NEQ(x CoachActionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperCoachActionType) LT(x CoachActionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperCoachActionType) LTE(x CoachActionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperCoachActionType) GT(x CoachActionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperCoachActionType) GTE(x CoachActionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperCoachActionType) IN(slice []CoachActionType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperCoachActionType) NIN(slice []CoachActionType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var CoachActionWhere = struct {
+	ID        whereHelperstring
+	CoachID   whereHelperstring
+	AthleteID whereHelperstring
+	Action    whereHelperCoachActionType
+	TargetID  whereHelpernull_String
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"getstronger\".\"coach_actions\".\"id\""},
+	CoachID:   whereHelperstring{field: "\"getstronger\".\"coach_actions\".\"coach_id\""},
+	AthleteID: whereHelperstring{field: "\"getstronger\".\"coach_actions\".\"athlete_id\""},
+	Action:    whereHelperCoachActionType{field: "\"getstronger\".\"coach_actions\".\"action\""},
+	TargetID:  whereHelpernull_String{field: "\"getstronger\".\"coach_actions\".\"target_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"getstronger\".\"coach_actions\".\"created_at\""},
+}
+
+// CoachActionRels is where relationship names are stored.
+var CoachActionRels = struct {
+	Athlete string
+	Coach   string
+}{
+	Athlete: "Athlete",
+	Coach:   "Coach",
+}
+
+// coachActionR is where relationships are stored.
+type coachActionR struct {
+	Athlete *User `boil:"Athlete" json:"Athlete" toml:"Athlete" yaml:"Athlete"`
+	Coach   *User `boil:"Coach" json:"Coach" toml:"Coach" yaml:"Coach"`
+}
+
+// NewStruct creates a new relationship struct
+func (*coachActionR) NewStruct() *coachActionR {
+	return &coachActionR{}
+}
+
+func (r *coachActionR) GetAthlete() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Athlete
+}
+
+func (r *coachActionR) GetCoach() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Coach
+}
+
+// coachActionL is where Load methods for each relationship are stored.
+type coachActionL struct{}
+
+var (
+	coachActionAllColumns            = []string{"id", "coach_id", "athlete_id", "action", "target_id", "created_at"}
+	coachActionColumnsWithoutDefault = []string{"coach_id", "athlete_id", "action"}
+	coachActionColumnsWithDefault    = []string{"id", "target_id", "created_at"}
+	coachActionPrimaryKeyColumns     = []string{"id"}
+	coachActionGeneratedColumns      = []string{}
+)
+
+type (
+	// CoachActionSlice is an alias for a slice of pointers to CoachAction.
+	// This should almost always be used instead of []CoachAction.
+	CoachActionSlice []*CoachAction
+	// CoachActionHook is the signature for custom CoachAction hook methods
+	CoachActionHook func(context.Context, boil.ContextExecutor, *CoachAction) error
+
+	coachActionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	coachActionType                 = reflect.TypeOf(&CoachAction{})
+	coachActionMapping              = queries.MakeStructMapping(coachActionType)
+	coachActionPrimaryKeyMapping, _ = queries.BindMapping(coachActionType, coachActionMapping, coachActionPrimaryKeyColumns)
+	coachActionInsertCacheMut       sync.RWMutex
+	coachActionInsertCache          = make(map[string]insertCache)
+	coachActionUpdateCacheMut       sync.RWMutex
+	coachActionUpdateCache          = make(map[string]updateCache)
+	coachActionUpsertCacheMut       sync.RWMutex
+	coachActionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var coachActionAfterSelectMu sync.Mutex
+var coachActionAfterSelectHooks []CoachActionHook
+
+var coachActionBeforeInsertMu sync.Mutex
+var coachActionBeforeInsertHooks []CoachActionHook
+var coachActionAfterInsertMu sync.Mutex
+var coachActionAfterInsertHooks []CoachActionHook
+
+var coachActionBeforeUpdateMu sync.Mutex
+var coachActionBeforeUpdateHooks []CoachActionHook
+var coachActionAfterUpdateMu sync.Mutex
+var coachActionAfterUpdateHooks []CoachActionHook
+
+var coachActionBeforeDeleteMu sync.Mutex
+var coachActionBeforeDeleteHooks []CoachActionHook
+var coachActionAfterDeleteMu sync.Mutex
+var coachActionAfterDeleteHooks []CoachActionHook
+
+var coachActionBeforeUpsertMu sync.Mutex
+var coachActionBeforeUpsertHooks []CoachActionHook
+var coachActionAfterUpsertMu sync.Mutex
+var coachActionAfterUpsertHooks []CoachActionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CoachAction) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachActionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CoachAction) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachActionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CoachAction) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachActionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CoachAction) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachActionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CoachAction) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachActionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CoachAction) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachActionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CoachAction) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachActionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CoachAction) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachActionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CoachAction) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachActionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCoachActionHook registers your hook function for all future operations.
+func AddCoachActionHook(hookPoint boil.HookPoint, coachActionHook CoachActionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		coachActionAfterSelectMu.Lock()
+		coachActionAfterSelectHooks = append(coachActionAfterSelectHooks, coachActionHook)
+		coachActionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		coachActionBeforeInsertMu.Lock()
+		coachActionBeforeInsertHooks = append(coachActionBeforeInsertHooks, coachActionHook)
+		coachActionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		coachActionAfterInsertMu.Lock()
+		coachActionAfterInsertHooks = append(coachActionAfterInsertHooks, coachActionHook)
+		coachActionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		coachActionBeforeUpdateMu.Lock()
+		coachActionBeforeUpdateHooks = append(coachActionBeforeUpdateHooks, coachActionHook)
+		coachActionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		coachActionAfterUpdateMu.Lock()
+		coachActionAfterUpdateHooks = append(coachActionAfterUpdateHooks, coachActionHook)
+		coachActionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		coachActionBeforeDeleteMu.Lock()
+		coachActionBeforeDeleteHooks = append(coachActionBeforeDeleteHooks, coachActionHook)
+		coachActionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		coachActionAfterDeleteMu.Lock()
+		coachActionAfterDeleteHooks = append(coachActionAfterDeleteHooks, coachActionHook)
+		coachActionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		coachActionBeforeUpsertMu.Lock()
+		coachActionBeforeUpsertHooks = append(coachActionBeforeUpsertHooks, coachActionHook)
+		coachActionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		coachActionAfterUpsertMu.Lock()
+		coachActionAfterUpsertHooks = append(coachActionAfterUpsertHooks, coachActionHook)
+		coachActionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single coachAction record from the query.
+func (q coachActionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CoachAction, error) {
+	o := &CoachAction{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for coach_actions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CoachAction records from the query.
+func (q coachActionQuery) All(ctx context.Context, exec boil.ContextExecutor) (CoachActionSlice, error) {
+	var o []*CoachAction
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to CoachAction slice")
+	}
+
+	if len(coachActionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CoachAction records in the query.
+func (q coachActionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count coach_actions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q coachActionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if coach_actions exists")
+	}
+
+	return count > 0, nil
+}
+
+// Athlete pointed to by the foreign key.
+func (o *CoachAction) Athlete(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AthleteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Coach pointed to by the foreign key.
+func (o *CoachAction) Coach(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CoachID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadAthlete allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (coachActionL) LoadAthlete(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCoachAction interface{}, mods queries.Applicator) error {
+	var slice []*CoachAction
+	var object *CoachAction
+
+	if singular {
+		var ok bool
+		object, ok = maybeCoachAction.(*CoachAction)
+		if !ok {
+			object = new(CoachAction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCoachAction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCoachAction))
+			}
+		}
+	} else {
+		s, ok := maybeCoachAction.(*[]*CoachAction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCoachAction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCoachAction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &coachActionR{}
+		}
+		args[object.AthleteID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &coachActionR{}
+			}
+
+			args[obj.AthleteID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Athlete = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AthleteCoachActions = append(foreign.R.AthleteCoachActions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AthleteID == foreign.ID {
+				local.R.Athlete = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AthleteCoachActions = append(foreign.R.AthleteCoachActions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCoach allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (coachActionL) LoadCoach(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCoachAction interface{}, mods queries.Applicator) error {
+	var slice []*CoachAction
+	var object *CoachAction
+
+	if singular {
+		var ok bool
+		object, ok = maybeCoachAction.(*CoachAction)
+		if !ok {
+			object = new(CoachAction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCoachAction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCoachAction))
+			}
+		}
+	} else {
+		s, ok := maybeCoachAction.(*[]*CoachAction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCoachAction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCoachAction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &coachActionR{}
+		}
+		args[object.CoachID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &coachActionR{}
+			}
+
+			args[obj.CoachID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Coach = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CoachCoachActions = append(foreign.R.CoachCoachActions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CoachID == foreign.ID {
+				local.R.Coach = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CoachCoachActions = append(foreign.R.CoachCoachActions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAthlete of the coachAction to the related item.
+// Sets o.R.Athlete to related.
+// Adds o to related.R.AthleteCoachActions.
+func (o *CoachAction) SetAthlete(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"coach_actions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"athlete_id"}),
+		strmangle.WhereClause("\"", "\"", 2, coachActionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AthleteID = related.ID
+	if o.R == nil {
+		o.R = &coachActionR{
+			Athlete: related,
+		}
+	} else {
+		o.R.Athlete = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AthleteCoachActions: CoachActionSlice{o},
+		}
+	} else {
+		related.R.AthleteCoachActions = append(related.R.AthleteCoachActions, o)
+	}
+
+	return nil
+}
+
+// SetCoach of the coachAction to the related item.
+// Sets o.R.Coach to related.
+// Adds o to related.R.CoachCoachActions.
+func (o *CoachAction) SetCoach(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"coach_actions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"coach_id"}),
+		strmangle.WhereClause("\"", "\"", 2, coachActionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CoachID = related.ID
+	if o.R == nil {
+		o.R = &coachActionR{
+			Coach: related,
+		}
+	} else {
+		o.R.Coach = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CoachCoachActions: CoachActionSlice{o},
+		}
+	} else {
+		related.R.CoachCoachActions = append(related.R.CoachCoachActions, o)
+	}
+
+	return nil
+}
+
+// CoachActions retrieves all the records using an executor.
+func CoachActions(mods ...qm.QueryMod) coachActionQuery {
+	mods = append(mods, qm.From("\"getstronger\".\"coach_actions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"getstronger\".\"coach_actions\".*"})
+	}
+
+	return coachActionQuery{q}
+}
+
+// FindCoachAction retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCoachAction(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*CoachAction, error) {
+	coachActionObj := &CoachAction{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"getstronger\".\"coach_actions\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, coachActionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from coach_actions")
+	}
+
+	if err = coachActionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return coachActionObj, err
+	}
+
+	return coachActionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CoachAction) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no coach_actions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(coachActionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	coachActionInsertCacheMut.RLock()
+	cache, cached := coachActionInsertCache[key]
+	coachActionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			coachActionAllColumns,
+			coachActionColumnsWithDefault,
+			coachActionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(coachActionType, coachActionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(coachActionType, coachActionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"getstronger\".\"coach_actions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"getstronger\".\"coach_actions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into coach_actions")
+	}
+
+	if !cached {
+		coachActionInsertCacheMut.Lock()
+		coachActionInsertCache[key] = cache
+		coachActionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the CoachAction.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CoachAction) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	coachActionUpdateCacheMut.RLock()
+	cache, cached := coachActionUpdateCache[key]
+	coachActionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			coachActionAllColumns,
+			coachActionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update coach_actions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"getstronger\".\"coach_actions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, coachActionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(coachActionType, coachActionMapping, append(wl, coachActionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update coach_actions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for coach_actions")
+	}
+
+	if !cached {
+		coachActionUpdateCacheMut.Lock()
+		coachActionUpdateCache[key] = cache
+		coachActionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q coachActionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for coach_actions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for coach_actions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CoachActionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), coachActionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"getstronger\".\"coach_actions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, coachActionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in coachAction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all coachAction")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *CoachAction) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no coach_actions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(coachActionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	coachActionUpsertCacheMut.RLock()
+	cache, cached := coachActionUpsertCache[key]
+	coachActionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			coachActionAllColumns,
+			coachActionColumnsWithDefault,
+			coachActionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			coachActionAllColumns,
+			coachActionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert coach_actions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(coachActionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(coachActionPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert coach_actions, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(coachActionPrimaryKeyColumns))
+			copy(conflict, coachActionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"getstronger\".\"coach_actions\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(coachActionType, coachActionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(coachActionType, coachActionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert coach_actions")
+	}
+
+	if !cached {
+		coachActionUpsertCacheMut.Lock()
+		coachActionUpsertCache[key] = cache
+		coachActionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single CoachAction record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CoachAction) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no CoachAction provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), coachActionPrimaryKeyMapping)
+	sql := "DELETE FROM \"getstronger\".\"coach_actions\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from coach_actions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for coach_actions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q coachActionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no coachActionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from coach_actions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for coach_actions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CoachActionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(coachActionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), coachActionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"getstronger\".\"coach_actions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, coachActionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from coachAction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for coach_actions")
+	}
+
+	if len(coachActionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CoachAction) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCoachAction(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CoachActionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CoachActionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), coachActionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"getstronger\".\"coach_actions\".* FROM \"getstronger\".\"coach_actions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, coachActionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in CoachActionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CoachActionExists checks if the CoachAction row exists.
+func CoachActionExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"getstronger\".\"coach_actions\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if coach_actions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the CoachAction row exists.
+func (o *CoachAction) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return CoachActionExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1326 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Coach is an object representing the database table.
+type Coach struct {
+	CoachID   string    `boil:"coach_id" json:"coach_id" toml:"coach_id" yaml:"coach_id"`
+	AthleteID string    `boil:"athlete_id" json:"athlete_id" toml:"athlete_id" yaml:"athlete_id"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *coachR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L coachL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CoachColumns = struct {
+	CoachID   string
+	AthleteID string
+	CreatedAt string
+}{
+	CoachID:   "coach_id",
+	AthleteID: "athlete_id",
+	CreatedAt: "created_at",
+}
+
+var CoachTableColumns = struct {
+	CoachID   string
+	AthleteID string
+	CreatedAt string
+}{
+	CoachID:   "coaches.coach_id",
+	AthleteID: "coaches.athlete_id",
+	CreatedAt: "coaches.created_at",
+}
+
+// Generated where
+
+var CoachWhere = struct {
+	CoachID   whereHelperstring
+	AthleteID whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	CoachID:   whereHelperstring{field: "\"getstronger\".\"coaches\".\"coach_id\""},
+	AthleteID: whereHelperstring{field: "\"getstronger\".\"coaches\".\"athlete_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"getstronger\".\"coaches\".\"created_at\""},
+}
+
+// CoachRels is where relationship names are stored.
+var CoachRels = struct {
+	Athlete string
+	Coach   string
+}{
+	Athlete: "Athlete",
+	Coach:   "Coach",
+}
+
+// coachR is where relationships are stored.
+type coachR struct {
+	Athlete *User `boil:"Athlete" json:"Athlete" toml:"Athlete" yaml:"Athlete"`
+	Coach   *User `boil:"Coach" json:"Coach" toml:"Coach" yaml:"Coach"`
+}
+
+// NewStruct creates a new relationship struct
+func (*coachR) NewStruct() *coachR {
+	return &coachR{}
+}
+
+func (r *coachR) GetAthlete() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Athlete
+}
+
+func (r *coachR) GetCoach() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Coach
+}
+
+// coachL is where Load methods for each relationship are stored.
+type coachL struct{}
+
+var (
+	coachAllColumns            = []string{"coach_id", "athlete_id", "created_at"}
+	coachColumnsWithoutDefault = []string{"coach_id", "athlete_id"}
+	coachColumnsWithDefault    = []string{"created_at"}
+	coachPrimaryKeyColumns     = []string{"coach_id", "athlete_id"}
+	coachGeneratedColumns      = []string{}
+)
+
+type (
+	// CoachSlice is an alias for a slice of pointers to Coach.
+	// This should almost always be used instead of []Coach.
+	CoachSlice []*Coach
+	// CoachHook is the signature for custom Coach hook methods
+	CoachHook func(context.Context, boil.ContextExecutor, *Coach) error
+
+	coachQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	coachType                 = reflect.TypeOf(&Coach{})
+	coachMapping              = queries.MakeStructMapping(coachType)
+	coachPrimaryKeyMapping, _ = queries.BindMapping(coachType, coachMapping, coachPrimaryKeyColumns)
+	coachInsertCacheMut       sync.RWMutex
+	coachInsertCache          = make(map[string]insertCache)
+	coachUpdateCacheMut       sync.RWMutex
+	coachUpdateCache          = make(map[string]updateCache)
+	coachUpsertCacheMut       sync.RWMutex
+	coachUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var coachAfterSelectMu sync.Mutex
+var coachAfterSelectHooks []CoachHook
+
+var coachBeforeInsertMu sync.Mutex
+var coachBeforeInsertHooks []CoachHook
+var coachAfterInsertMu sync.Mutex
+var coachAfterInsertHooks []CoachHook
+
+var coachBeforeUpdateMu sync.Mutex
+var coachBeforeUpdateHooks []CoachHook
+var coachAfterUpdateMu sync.Mutex
+var coachAfterUpdateHooks []CoachHook
+
+var coachBeforeDeleteMu sync.Mutex
+var coachBeforeDeleteHooks []CoachHook
+var coachAfterDeleteMu sync.Mutex
+var coachAfterDeleteHooks []CoachHook
+
+var coachBeforeUpsertMu sync.Mutex
+var coachBeforeUpsertHooks []CoachHook
+var coachAfterUpsertMu sync.Mutex
+var coachAfterUpsertHooks []CoachHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Coach) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Coach) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Coach) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Coach) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Coach) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Coach) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Coach) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Coach) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Coach) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range coachAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCoachHook registers your hook function for all future operations.
+func AddCoachHook(hookPoint boil.HookPoint, coachHook CoachHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		coachAfterSelectMu.Lock()
+		coachAfterSelectHooks = append(coachAfterSelectHooks, coachHook)
+		coachAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		coachBeforeInsertMu.Lock()
+		coachBeforeInsertHooks = append(coachBeforeInsertHooks, coachHook)
+		coachBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		coachAfterInsertMu.Lock()
+		coachAfterInsertHooks = append(coachAfterInsertHooks, coachHook)
+		coachAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		coachBeforeUpdateMu.Lock()
+		coachBeforeUpdateHooks = append(coachBeforeUpdateHooks, coachHook)
+		coachBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		coachAfterUpdateMu.Lock()
+		coachAfterUpdateHooks = append(coachAfterUpdateHooks, coachHook)
+		coachAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		coachBeforeDeleteMu.Lock()
+		coachBeforeDeleteHooks = append(coachBeforeDeleteHooks, coachHook)
+		coachBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		coachAfterDeleteMu.Lock()
+		coachAfterDeleteHooks = append(coachAfterDeleteHooks, coachHook)
+		coachAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		coachBeforeUpsertMu.Lock()
+		coachBeforeUpsertHooks = append(coachBeforeUpsertHooks, coachHook)
+		coachBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		coachAfterUpsertMu.Lock()
+		coachAfterUpsertHooks = append(coachAfterUpsertHooks, coachHook)
+		coachAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single coach record from the query.
+func (q coachQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Coach, error) {
+	o := &Coach{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for coaches")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Coach records from the query.
+func (q coachQuery) All(ctx context.Context, exec boil.ContextExecutor) (CoachSlice, error) {
+	var o []*Coach
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to Coach slice")
+	}
+
+	if len(coachAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Coach records in the query.
+func (q coachQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count coaches rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q coachQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if coaches exists")
+	}
+
+	return count > 0, nil
+}
+
+// Athlete pointed to by the foreign key.
+func (o *Coach) Athlete(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AthleteID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Coach pointed to by the foreign key.
+func (o *Coach) Coach(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CoachID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadAthlete allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (coachL) LoadAthlete(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCoach interface{}, mods queries.Applicator) error {
+	var slice []*Coach
+	var object *Coach
+
+	if singular {
+		var ok bool
+		object, ok = maybeCoach.(*Coach)
+		if !ok {
+			object = new(Coach)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCoach)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCoach))
+			}
+		}
+	} else {
+		s, ok := maybeCoach.(*[]*Coach)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCoach)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCoach))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &coachR{}
+		}
+		args[object.AthleteID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &coachR{}
+			}
+
+			args[obj.AthleteID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Athlete = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AthleteCoaches = append(foreign.R.AthleteCoaches, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AthleteID == foreign.ID {
+				local.R.Athlete = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AthleteCoaches = append(foreign.R.AthleteCoaches, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCoach allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (coachL) LoadCoach(ctx context.Context, e boil.ContextExecutor, singular bool, maybeCoach interface{}, mods queries.Applicator) error {
+	var slice []*Coach
+	var object *Coach
+
+	if singular {
+		var ok bool
+		object, ok = maybeCoach.(*Coach)
+		if !ok {
+			object = new(Coach)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeCoach)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeCoach))
+			}
+		}
+	} else {
+		s, ok := maybeCoach.(*[]*Coach)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeCoach)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeCoach))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &coachR{}
+		}
+		args[object.CoachID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &coachR{}
+			}
+
+			args[obj.CoachID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Coach = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CoachCoaches = append(foreign.R.CoachCoaches, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CoachID == foreign.ID {
+				local.R.Coach = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CoachCoaches = append(foreign.R.CoachCoaches, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAthlete of the coach to the related item.
+// Sets o.R.Athlete to related.
+// Adds o to related.R.AthleteCoaches.
+func (o *Coach) SetAthlete(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"coaches\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"athlete_id"}),
+		strmangle.WhereClause("\"", "\"", 2, coachPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.CoachID, o.AthleteID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AthleteID = related.ID
+	if o.R == nil {
+		o.R = &coachR{
+			Athlete: related,
+		}
+	} else {
+		o.R.Athlete = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AthleteCoaches: CoachSlice{o},
+		}
+	} else {
+		related.R.AthleteCoaches = append(related.R.AthleteCoaches, o)
+	}
+
+	return nil
+}
+
+// SetCoach of the coach to the related item.
+// Sets o.R.Coach to related.
+// Adds o to related.R.CoachCoaches.
+func (o *Coach) SetCoach(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"coaches\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"coach_id"}),
+		strmangle.WhereClause("\"", "\"", 2, coachPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.CoachID, o.AthleteID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CoachID = related.ID
+	if o.R == nil {
+		o.R = &coachR{
+			Coach: related,
+		}
+	} else {
+		o.R.Coach = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CoachCoaches: CoachSlice{o},
+		}
+	} else {
+		related.R.CoachCoaches = append(related.R.CoachCoaches, o)
+	}
+
+	return nil
+}
+
+// Coaches retrieves all the records using an executor.
+func Coaches(mods ...qm.QueryMod) coachQuery {
+	mods = append(mods, qm.From("\"getstronger\".\"coaches\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"getstronger\".\"coaches\".*"})
+	}
+
+	return coachQuery{q}
+}
+
+// FindCoach retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCoach(ctx context.Context, exec boil.ContextExecutor, coachID string, athleteID string, selectCols ...string) (*Coach, error) {
+	coachObj := &Coach{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"getstronger\".\"coaches\" where \"coach_id\"=$1 AND \"athlete_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, coachID, athleteID)
+
+	err := q.Bind(ctx, exec, coachObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from coaches")
+	}
+
+	if err = coachObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return coachObj, err
+	}
+
+	return coachObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Coach) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no coaches provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(coachColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	coachInsertCacheMut.RLock()
+	cache, cached := coachInsertCache[key]
+	coachInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			coachAllColumns,
+			coachColumnsWithDefault,
+			coachColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(coachType, coachMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(coachType, coachMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"getstronger\".\"coaches\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"getstronger\".\"coaches\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into coaches")
+	}
+
+	if !cached {
+		coachInsertCacheMut.Lock()
+		coachInsertCache[key] = cache
+		coachInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Coach.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Coach) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	coachUpdateCacheMut.RLock()
+	cache, cached := coachUpdateCache[key]
+	coachUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			coachAllColumns,
+			coachPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update coaches, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"getstronger\".\"coaches\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, coachPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(coachType, coachMapping, append(wl, coachPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update coaches row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for coaches")
+	}
+
+	if !cached {
+		coachUpdateCacheMut.Lock()
+		coachUpdateCache[key] = cache
+		coachUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q coachQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for coaches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for coaches")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CoachSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), coachPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"getstronger\".\"coaches\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, coachPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in coach slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all coach")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Coach) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no coaches provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(coachColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	coachUpsertCacheMut.RLock()
+	cache, cached := coachUpsertCache[key]
+	coachUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			coachAllColumns,
+			coachColumnsWithDefault,
+			coachColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			coachAllColumns,
+			coachPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert coaches, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(coachAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(coachPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert coaches, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(coachPrimaryKeyColumns))
+			copy(conflict, coachPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"getstronger\".\"coaches\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(coachType, coachMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(coachType, coachMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert coaches")
+	}
+
+	if !cached {
+		coachUpsertCacheMut.Lock()
+		coachUpsertCache[key] = cache
+		coachUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Coach record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Coach) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no Coach provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), coachPrimaryKeyMapping)
+	sql := "DELETE FROM \"getstronger\".\"coaches\" WHERE \"coach_id\"=$1 AND \"athlete_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from coaches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for coaches")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q coachQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no coachQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from coaches")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for coaches")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CoachSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(coachBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), coachPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"getstronger\".\"coaches\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, coachPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from coach slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for coaches")
+	}
+
+	if len(coachAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Coach) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCoach(ctx, exec, o.CoachID, o.AthleteID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CoachSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CoachSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), coachPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"getstronger\".\"coaches\".* FROM \"getstronger\".\"coaches\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, coachPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in CoachSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CoachExists checks if the Coach row exists.
+func CoachExists(ctx context.Context, exec boil.ContextExecutor, coachID string, athleteID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"getstronger\".\"coaches\" where \"coach_id\"=$1 AND \"athlete_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, coachID, athleteID)
+	}
+	row := exec.QueryRowContext(ctx, sql, coachID, athleteID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if coaches exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Coach row exists.
+func (o *Coach) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return CoachExists(ctx, exec, o.CoachID, o.AthleteID)
+}
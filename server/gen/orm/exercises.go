@@ -19,70 +19,125 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"github.com/volatiletech/strmangle"
 )
 
 // Exercise is an object representing the database table.
 type Exercise struct {
-	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID    string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	Title     string      `boil:"title" json:"title" toml:"title" yaml:"title"`
-	SubTitle  null.String `boil:"sub_title" json:"sub_title,omitempty" toml:"sub_title" yaml:"sub_title,omitempty"`
-	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	DeletedAt null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ID                  string              `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID              string              `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Title               string              `boil:"title" json:"title" toml:"title" yaml:"title"`
+	SubTitle            null.String         `boil:"sub_title" json:"sub_title,omitempty" toml:"sub_title" yaml:"sub_title,omitempty"`
+	CreatedAt           time.Time           `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	DeletedAt           null.Time           `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	DeletedFromRoutines types.JSON          `boil:"deleted_from_routines" json:"deleted_from_routines" toml:"deleted_from_routines" yaml:"deleted_from_routines"`
+	CatalogExercise     NullCatalogExercise `boil:"catalog_exercise" json:"catalog_exercise,omitempty" toml:"catalog_exercise" yaml:"catalog_exercise,omitempty"`
 
 	R *exerciseR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L exerciseL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ExerciseColumns = struct {
-	ID        string
-	UserID    string
-	Title     string
-	SubTitle  string
-	CreatedAt string
-	DeletedAt string
+	ID                  string
+	UserID              string
+	Title               string
+	SubTitle            string
+	CreatedAt           string
+	DeletedAt           string
+	DeletedFromRoutines string
+	CatalogExercise     string
 }{
-	ID:        "id",
-	UserID:    "user_id",
-	Title:     "title",
-	SubTitle:  "sub_title",
-	CreatedAt: "created_at",
-	DeletedAt: "deleted_at",
+	ID:                  "id",
+	UserID:              "user_id",
+	Title:               "title",
+	SubTitle:            "sub_title",
+	CreatedAt:           "created_at",
+	DeletedAt:           "deleted_at",
+	DeletedFromRoutines: "deleted_from_routines",
+	CatalogExercise:     "catalog_exercise",
 }
 
 var ExerciseTableColumns = struct {
-	ID        string
-	UserID    string
-	Title     string
-	SubTitle  string
-	CreatedAt string
-	DeletedAt string
+	ID                  string
+	UserID              string
+	Title               string
+	SubTitle            string
+	CreatedAt           string
+	DeletedAt           string
+	DeletedFromRoutines string
+	CatalogExercise     string
 }{
-	ID:        "exercises.id",
-	UserID:    "exercises.user_id",
-	Title:     "exercises.title",
-	SubTitle:  "exercises.sub_title",
-	CreatedAt: "exercises.created_at",
-	DeletedAt: "exercises.deleted_at",
+	ID:                  "exercises.id",
+	UserID:              "exercises.user_id",
+	Title:               "exercises.title",
+	SubTitle:            "exercises.sub_title",
+	CreatedAt:           "exercises.created_at",
+	DeletedAt:           "exercises.deleted_at",
+	DeletedFromRoutines: "exercises.deleted_from_routines",
+	CatalogExercise:     "exercises.catalog_exercise",
 }
 
 // Generated where
 
+type whereHelperNullCatalogExercise struct{ field string }
+
+func (w whereHelperNullCatalogExercise) EQ(x NullCatalogExercise) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelperNullCatalogExercise) NEQ(x NullCatalogExercise) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelperNullCatalogExercise) LT(x NullCatalogExercise) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperNullCatalogExercise) LTE(x NullCatalogExercise) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperNullCatalogExercise) GT(x NullCatalogExercise) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperNullCatalogExercise) GTE(x NullCatalogExercise) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperNullCatalogExercise) IN(slice []NullCatalogExercise) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperNullCatalogExercise) NIN(slice []NullCatalogExercise) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelperNullCatalogExercise) IsNull() qm.QueryMod { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelperNullCatalogExercise) IsNotNull() qm.QueryMod {
+	return qmhelper.WhereIsNotNull(w.field)
+}
+
 var ExerciseWhere = struct {
-	ID        whereHelperstring
-	UserID    whereHelperstring
-	Title     whereHelperstring
-	SubTitle  whereHelpernull_String
-	CreatedAt whereHelpertime_Time
-	DeletedAt whereHelpernull_Time
+	ID                  whereHelperstring
+	UserID              whereHelperstring
+	Title               whereHelperstring
+	SubTitle            whereHelpernull_String
+	CreatedAt           whereHelpertime_Time
+	DeletedAt           whereHelpernull_Time
+	DeletedFromRoutines whereHelpertypes_JSON
+	CatalogExercise     whereHelperNullCatalogExercise
 }{
-	ID:        whereHelperstring{field: "\"getstronger\".\"exercises\".\"id\""},
-	UserID:    whereHelperstring{field: "\"getstronger\".\"exercises\".\"user_id\""},
-	Title:     whereHelperstring{field: "\"getstronger\".\"exercises\".\"title\""},
-	SubTitle:  whereHelpernull_String{field: "\"getstronger\".\"exercises\".\"sub_title\""},
-	CreatedAt: whereHelpertime_Time{field: "\"getstronger\".\"exercises\".\"created_at\""},
-	DeletedAt: whereHelpernull_Time{field: "\"getstronger\".\"exercises\".\"deleted_at\""},
+	ID:                  whereHelperstring{field: "\"getstronger\".\"exercises\".\"id\""},
+	UserID:              whereHelperstring{field: "\"getstronger\".\"exercises\".\"user_id\""},
+	Title:               whereHelperstring{field: "\"getstronger\".\"exercises\".\"title\""},
+	SubTitle:            whereHelpernull_String{field: "\"getstronger\".\"exercises\".\"sub_title\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"getstronger\".\"exercises\".\"created_at\""},
+	DeletedAt:           whereHelpernull_Time{field: "\"getstronger\".\"exercises\".\"deleted_at\""},
+	DeletedFromRoutines: whereHelpertypes_JSON{field: "\"getstronger\".\"exercises\".\"deleted_from_routines\""},
+	CatalogExercise:     whereHelperNullCatalogExercise{field: "\"getstronger\".\"exercises\".\"catalog_exercise\""},
 }
 
 // ExerciseRels is where relationship names are stored.
@@ -133,9 +188,9 @@ func (r *exerciseR) GetSets() SetSlice {
 type exerciseL struct{}
 
 var (
-	exerciseAllColumns            = []string{"id", "user_id", "title", "sub_title", "created_at", "deleted_at"}
+	exerciseAllColumns            = []string{"id", "user_id", "title", "sub_title", "created_at", "deleted_at", "deleted_from_routines", "catalog_exercise"}
 	exerciseColumnsWithoutDefault = []string{"user_id", "title"}
-	exerciseColumnsWithDefault    = []string{"id", "sub_title", "created_at", "deleted_at"}
+	exerciseColumnsWithDefault    = []string{"id", "sub_title", "created_at", "deleted_at", "deleted_from_routines", "catalog_exercise"}
 	exercisePrimaryKeyColumns     = []string{"id"}
 	exerciseGeneratedColumns      = []string{}
 )
@@ -660,7 +715,7 @@ func (exerciseL) LoadRoutines(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.Select("\"getstronger\".\"routines\".\"id\", \"getstronger\".\"routines\".\"user_id\", \"getstronger\".\"routines\".\"title\", \"getstronger\".\"routines\".\"created_at\", \"getstronger\".\"routines\".\"deleted_at\", \"getstronger\".\"routines\".\"exercise_order\", \"a\".\"exercise_id\""),
+		qm.Select("\"getstronger\".\"routines\".\"id\", \"getstronger\".\"routines\".\"user_id\", \"getstronger\".\"routines\".\"title\", \"getstronger\".\"routines\".\"created_at\", \"getstronger\".\"routines\".\"deleted_at\", \"getstronger\".\"routines\".\"exercise_order\", \"getstronger\".\"routines\".\"exercise_targets\", \"getstronger\".\"routines\".\"visibility\", \"getstronger\".\"routines\".\"share_token\", \"getstronger\".\"routines\".\"cloned_from_routine_id\", \"getstronger\".\"routines\".\"clone_count\", \"a\".\"exercise_id\""),
 		qm.From("\"getstronger\".\"routines\""),
 		qm.InnerJoin("\"getstronger\".\"exercises_routines\" as \"a\" on \"getstronger\".\"routines\".\"id\" = \"a\".\"routine_id\""),
 		qm.WhereIn("\"a\".\"exercise_id\" in ?", argsSlice...),
@@ -681,7 +736,7 @@ func (exerciseL) LoadRoutines(ctx context.Context, e boil.ContextExecutor, singu
 		one := new(Routine)
 		var localJoinCol string
 
-		err = results.Scan(&one.ID, &one.UserID, &one.Title, &one.CreatedAt, &one.DeletedAt, &one.ExerciseOrder, &localJoinCol)
+		err = results.Scan(&one.ID, &one.UserID, &one.Title, &one.CreatedAt, &one.DeletedAt, &one.ExerciseOrder, &one.ExerciseTargets, &one.Visibility, &one.ShareToken, &one.ClonedFromRoutineID, &one.CloneCount, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for routines")
 		}
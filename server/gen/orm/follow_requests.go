@@ -0,0 +1,1333 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// FollowRequest is an object representing the database table.
+type FollowRequest struct {
+	ID         string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	FollowerID string    `boil:"follower_id" json:"follower_id" toml:"follower_id" yaml:"follower_id"`
+	FolloweeID string    `boil:"followee_id" json:"followee_id" toml:"followee_id" yaml:"followee_id"`
+	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *followRequestR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L followRequestL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FollowRequestColumns = struct {
+	ID         string
+	FollowerID string
+	FolloweeID string
+	CreatedAt  string
+}{
+	ID:         "id",
+	FollowerID: "follower_id",
+	FolloweeID: "followee_id",
+	CreatedAt:  "created_at",
+}
+
+var FollowRequestTableColumns = struct {
+	ID         string
+	FollowerID string
+	FolloweeID string
+	CreatedAt  string
+}{
+	ID:         "follow_requests.id",
+	FollowerID: "follow_requests.follower_id",
+	FolloweeID: "follow_requests.followee_id",
+	CreatedAt:  "follow_requests.created_at",
+}
+
+// Generated where
+
+var FollowRequestWhere = struct {
+	ID         whereHelperstring
+	FollowerID whereHelperstring
+	FolloweeID whereHelperstring
+	CreatedAt  whereHelpertime_Time
+}{
+	ID:         whereHelperstring{field: "\"getstronger\".\"follow_requests\".\"id\""},
+	FollowerID: whereHelperstring{field: "\"getstronger\".\"follow_requests\".\"follower_id\""},
+	FolloweeID: whereHelperstring{field: "\"getstronger\".\"follow_requests\".\"followee_id\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"getstronger\".\"follow_requests\".\"created_at\""},
+}
+
+// FollowRequestRels is where relationship names are stored.
+var FollowRequestRels = struct {
+	Followee string
+	Follower string
+}{
+	Followee: "Followee",
+	Follower: "Follower",
+}
+
+// followRequestR is where relationships are stored.
+type followRequestR struct {
+	Followee *User `boil:"Followee" json:"Followee" toml:"Followee" yaml:"Followee"`
+	Follower *User `boil:"Follower" json:"Follower" toml:"Follower" yaml:"Follower"`
+}
+
+// NewStruct creates a new relationship struct
+func (*followRequestR) NewStruct() *followRequestR {
+	return &followRequestR{}
+}
+
+func (r *followRequestR) GetFollowee() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Followee
+}
+
+func (r *followRequestR) GetFollower() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Follower
+}
+
+// followRequestL is where Load methods for each relationship are stored.
+type followRequestL struct{}
+
+var (
+	followRequestAllColumns            = []string{"id", "follower_id", "followee_id", "created_at"}
+	followRequestColumnsWithoutDefault = []string{"follower_id", "followee_id"}
+	followRequestColumnsWithDefault    = []string{"id", "created_at"}
+	followRequestPrimaryKeyColumns     = []string{"id"}
+	followRequestGeneratedColumns      = []string{}
+)
+
+type (
+	// FollowRequestSlice is an alias for a slice of pointers to FollowRequest.
+	// This should almost always be used instead of []FollowRequest.
+	FollowRequestSlice []*FollowRequest
+	// FollowRequestHook is the signature for custom FollowRequest hook methods
+	FollowRequestHook func(context.Context, boil.ContextExecutor, *FollowRequest) error
+
+	followRequestQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	followRequestType                 = reflect.TypeOf(&FollowRequest{})
+	followRequestMapping              = queries.MakeStructMapping(followRequestType)
+	followRequestPrimaryKeyMapping, _ = queries.BindMapping(followRequestType, followRequestMapping, followRequestPrimaryKeyColumns)
+	followRequestInsertCacheMut       sync.RWMutex
+	followRequestInsertCache          = make(map[string]insertCache)
+	followRequestUpdateCacheMut       sync.RWMutex
+	followRequestUpdateCache          = make(map[string]updateCache)
+	followRequestUpsertCacheMut       sync.RWMutex
+	followRequestUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var followRequestAfterSelectMu sync.Mutex
+var followRequestAfterSelectHooks []FollowRequestHook
+
+var followRequestBeforeInsertMu sync.Mutex
+var followRequestBeforeInsertHooks []FollowRequestHook
+var followRequestAfterInsertMu sync.Mutex
+var followRequestAfterInsertHooks []FollowRequestHook
+
+var followRequestBeforeUpdateMu sync.Mutex
+var followRequestBeforeUpdateHooks []FollowRequestHook
+var followRequestAfterUpdateMu sync.Mutex
+var followRequestAfterUpdateHooks []FollowRequestHook
+
+var followRequestBeforeDeleteMu sync.Mutex
+var followRequestBeforeDeleteHooks []FollowRequestHook
+var followRequestAfterDeleteMu sync.Mutex
+var followRequestAfterDeleteHooks []FollowRequestHook
+
+var followRequestBeforeUpsertMu sync.Mutex
+var followRequestBeforeUpsertHooks []FollowRequestHook
+var followRequestAfterUpsertMu sync.Mutex
+var followRequestAfterUpsertHooks []FollowRequestHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FollowRequest) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range followRequestAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FollowRequest) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range followRequestBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FollowRequest) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range followRequestAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FollowRequest) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range followRequestBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FollowRequest) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range followRequestAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FollowRequest) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range followRequestBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FollowRequest) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range followRequestAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FollowRequest) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range followRequestBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FollowRequest) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range followRequestAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFollowRequestHook registers your hook function for all future operations.
+func AddFollowRequestHook(hookPoint boil.HookPoint, followRequestHook FollowRequestHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		followRequestAfterSelectMu.Lock()
+		followRequestAfterSelectHooks = append(followRequestAfterSelectHooks, followRequestHook)
+		followRequestAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		followRequestBeforeInsertMu.Lock()
+		followRequestBeforeInsertHooks = append(followRequestBeforeInsertHooks, followRequestHook)
+		followRequestBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		followRequestAfterInsertMu.Lock()
+		followRequestAfterInsertHooks = append(followRequestAfterInsertHooks, followRequestHook)
+		followRequestAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		followRequestBeforeUpdateMu.Lock()
+		followRequestBeforeUpdateHooks = append(followRequestBeforeUpdateHooks, followRequestHook)
+		followRequestBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		followRequestAfterUpdateMu.Lock()
+		followRequestAfterUpdateHooks = append(followRequestAfterUpdateHooks, followRequestHook)
+		followRequestAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		followRequestBeforeDeleteMu.Lock()
+		followRequestBeforeDeleteHooks = append(followRequestBeforeDeleteHooks, followRequestHook)
+		followRequestBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		followRequestAfterDeleteMu.Lock()
+		followRequestAfterDeleteHooks = append(followRequestAfterDeleteHooks, followRequestHook)
+		followRequestAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		followRequestBeforeUpsertMu.Lock()
+		followRequestBeforeUpsertHooks = append(followRequestBeforeUpsertHooks, followRequestHook)
+		followRequestBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		followRequestAfterUpsertMu.Lock()
+		followRequestAfterUpsertHooks = append(followRequestAfterUpsertHooks, followRequestHook)
+		followRequestAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single followRequest record from the query.
+func (q followRequestQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FollowRequest, error) {
+	o := &FollowRequest{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for follow_requests")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all FollowRequest records from the query.
+func (q followRequestQuery) All(ctx context.Context, exec boil.ContextExecutor) (FollowRequestSlice, error) {
+	var o []*FollowRequest
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to FollowRequest slice")
+	}
+
+	if len(followRequestAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all FollowRequest records in the query.
+func (q followRequestQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count follow_requests rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q followRequestQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if follow_requests exists")
+	}
+
+	return count > 0, nil
+}
+
+// Followee pointed to by the foreign key.
+func (o *FollowRequest) Followee(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FolloweeID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Follower pointed to by the foreign key.
+func (o *FollowRequest) Follower(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FollowerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadFollowee allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (followRequestL) LoadFollowee(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFollowRequest interface{}, mods queries.Applicator) error {
+	var slice []*FollowRequest
+	var object *FollowRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeFollowRequest.(*FollowRequest)
+		if !ok {
+			object = new(FollowRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFollowRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFollowRequest))
+			}
+		}
+	} else {
+		s, ok := maybeFollowRequest.(*[]*FollowRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFollowRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFollowRequest))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &followRequestR{}
+		}
+		args[object.FolloweeID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &followRequestR{}
+			}
+
+			args[obj.FolloweeID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Followee = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.FolloweeFollowRequests = append(foreign.R.FolloweeFollowRequests, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FolloweeID == foreign.ID {
+				local.R.Followee = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.FolloweeFollowRequests = append(foreign.R.FolloweeFollowRequests, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFollower allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (followRequestL) LoadFollower(ctx context.Context, e boil.ContextExecutor, singular bool, maybeFollowRequest interface{}, mods queries.Applicator) error {
+	var slice []*FollowRequest
+	var object *FollowRequest
+
+	if singular {
+		var ok bool
+		object, ok = maybeFollowRequest.(*FollowRequest)
+		if !ok {
+			object = new(FollowRequest)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeFollowRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeFollowRequest))
+			}
+		}
+	} else {
+		s, ok := maybeFollowRequest.(*[]*FollowRequest)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeFollowRequest)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeFollowRequest))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &followRequestR{}
+		}
+		args[object.FollowerID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &followRequestR{}
+			}
+
+			args[obj.FollowerID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Follower = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.FollowerFollowRequests = append(foreign.R.FollowerFollowRequests, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FollowerID == foreign.ID {
+				local.R.Follower = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.FollowerFollowRequests = append(foreign.R.FollowerFollowRequests, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetFollowee of the followRequest to the related item.
+// Sets o.R.Followee to related.
+// Adds o to related.R.FolloweeFollowRequests.
+func (o *FollowRequest) SetFollowee(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"follow_requests\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"followee_id"}),
+		strmangle.WhereClause("\"", "\"", 2, followRequestPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FolloweeID = related.ID
+	if o.R == nil {
+		o.R = &followRequestR{
+			Followee: related,
+		}
+	} else {
+		o.R.Followee = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			FolloweeFollowRequests: FollowRequestSlice{o},
+		}
+	} else {
+		related.R.FolloweeFollowRequests = append(related.R.FolloweeFollowRequests, o)
+	}
+
+	return nil
+}
+
+// SetFollower of the followRequest to the related item.
+// Sets o.R.Follower to related.
+// Adds o to related.R.FollowerFollowRequests.
+func (o *FollowRequest) SetFollower(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"follow_requests\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"follower_id"}),
+		strmangle.WhereClause("\"", "\"", 2, followRequestPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FollowerID = related.ID
+	if o.R == nil {
+		o.R = &followRequestR{
+			Follower: related,
+		}
+	} else {
+		o.R.Follower = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			FollowerFollowRequests: FollowRequestSlice{o},
+		}
+	} else {
+		related.R.FollowerFollowRequests = append(related.R.FollowerFollowRequests, o)
+	}
+
+	return nil
+}
+
+// FollowRequests retrieves all the records using an executor.
+func FollowRequests(mods ...qm.QueryMod) followRequestQuery {
+	mods = append(mods, qm.From("\"getstronger\".\"follow_requests\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"getstronger\".\"follow_requests\".*"})
+	}
+
+	return followRequestQuery{q}
+}
+
+// FindFollowRequest retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFollowRequest(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*FollowRequest, error) {
+	followRequestObj := &FollowRequest{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"getstronger\".\"follow_requests\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, followRequestObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from follow_requests")
+	}
+
+	if err = followRequestObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return followRequestObj, err
+	}
+
+	return followRequestObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FollowRequest) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no follow_requests provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(followRequestColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	followRequestInsertCacheMut.RLock()
+	cache, cached := followRequestInsertCache[key]
+	followRequestInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			followRequestAllColumns,
+			followRequestColumnsWithDefault,
+			followRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(followRequestType, followRequestMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(followRequestType, followRequestMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"getstronger\".\"follow_requests\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"getstronger\".\"follow_requests\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into follow_requests")
+	}
+
+	if !cached {
+		followRequestInsertCacheMut.Lock()
+		followRequestInsertCache[key] = cache
+		followRequestInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the FollowRequest.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FollowRequest) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	followRequestUpdateCacheMut.RLock()
+	cache, cached := followRequestUpdateCache[key]
+	followRequestUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			followRequestAllColumns,
+			followRequestPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update follow_requests, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"getstronger\".\"follow_requests\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, followRequestPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(followRequestType, followRequestMapping, append(wl, followRequestPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update follow_requests row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for follow_requests")
+	}
+
+	if !cached {
+		followRequestUpdateCacheMut.Lock()
+		followRequestUpdateCache[key] = cache
+		followRequestUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q followRequestQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for follow_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for follow_requests")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FollowRequestSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), followRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"getstronger\".\"follow_requests\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, followRequestPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in followRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all followRequest")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *FollowRequest) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no follow_requests provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(followRequestColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	followRequestUpsertCacheMut.RLock()
+	cache, cached := followRequestUpsertCache[key]
+	followRequestUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			followRequestAllColumns,
+			followRequestColumnsWithDefault,
+			followRequestColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			followRequestAllColumns,
+			followRequestPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert follow_requests, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(followRequestAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(followRequestPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert follow_requests, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(followRequestPrimaryKeyColumns))
+			copy(conflict, followRequestPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"getstronger\".\"follow_requests\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(followRequestType, followRequestMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(followRequestType, followRequestMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert follow_requests")
+	}
+
+	if !cached {
+		followRequestUpsertCacheMut.Lock()
+		followRequestUpsertCache[key] = cache
+		followRequestUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single FollowRequest record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FollowRequest) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no FollowRequest provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), followRequestPrimaryKeyMapping)
+	sql := "DELETE FROM \"getstronger\".\"follow_requests\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from follow_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for follow_requests")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q followRequestQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no followRequestQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from follow_requests")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for follow_requests")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FollowRequestSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(followRequestBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), followRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"getstronger\".\"follow_requests\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, followRequestPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from followRequest slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for follow_requests")
+	}
+
+	if len(followRequestAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FollowRequest) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFollowRequest(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FollowRequestSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FollowRequestSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), followRequestPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"getstronger\".\"follow_requests\".* FROM \"getstronger\".\"follow_requests\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, followRequestPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in FollowRequestSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FollowRequestExists checks if the FollowRequest row exists.
+func FollowRequestExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"getstronger\".\"follow_requests\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if follow_requests exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the FollowRequest row exists.
+func (o *FollowRequest) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return FollowRequestExists(ctx, exec, o.ID)
+}
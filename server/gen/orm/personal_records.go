@@ -0,0 +1,1528 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// PersonalRecord is an object representing the database table.
+type PersonalRecord struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	WorkoutID string    `boil:"workout_id" json:"workout_id" toml:"workout_id" yaml:"workout_id"`
+	SetID     string    `boil:"set_id" json:"set_id" toml:"set_id" yaml:"set_id"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *personalRecordR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L personalRecordL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PersonalRecordColumns = struct {
+	ID        string
+	UserID    string
+	WorkoutID string
+	SetID     string
+	CreatedAt string
+}{
+	ID:        "id",
+	UserID:    "user_id",
+	WorkoutID: "workout_id",
+	SetID:     "set_id",
+	CreatedAt: "created_at",
+}
+
+var PersonalRecordTableColumns = struct {
+	ID        string
+	UserID    string
+	WorkoutID string
+	SetID     string
+	CreatedAt string
+}{
+	ID:        "personal_records.id",
+	UserID:    "personal_records.user_id",
+	WorkoutID: "personal_records.workout_id",
+	SetID:     "personal_records.set_id",
+	CreatedAt: "personal_records.created_at",
+}
+
+// Generated where
+
+var PersonalRecordWhere = struct {
+	ID        whereHelperstring
+	UserID    whereHelperstring
+	WorkoutID whereHelperstring
+	SetID     whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"getstronger\".\"personal_records\".\"id\""},
+	UserID:    whereHelperstring{field: "\"getstronger\".\"personal_records\".\"user_id\""},
+	WorkoutID: whereHelperstring{field: "\"getstronger\".\"personal_records\".\"workout_id\""},
+	SetID:     whereHelperstring{field: "\"getstronger\".\"personal_records\".\"set_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"getstronger\".\"personal_records\".\"created_at\""},
+}
+
+// PersonalRecordRels is where relationship names are stored.
+var PersonalRecordRels = struct {
+	Set     string
+	User    string
+	Workout string
+}{
+	Set:     "Set",
+	User:    "User",
+	Workout: "Workout",
+}
+
+// personalRecordR is where relationships are stored.
+type personalRecordR struct {
+	Set     *Set     `boil:"Set" json:"Set" toml:"Set" yaml:"Set"`
+	User    *User    `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Workout *Workout `boil:"Workout" json:"Workout" toml:"Workout" yaml:"Workout"`
+}
+
+// NewStruct creates a new relationship struct
+func (*personalRecordR) NewStruct() *personalRecordR {
+	return &personalRecordR{}
+}
+
+func (r *personalRecordR) GetSet() *Set {
+	if r == nil {
+		return nil
+	}
+	return r.Set
+}
+
+func (r *personalRecordR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *personalRecordR) GetWorkout() *Workout {
+	if r == nil {
+		return nil
+	}
+	return r.Workout
+}
+
+// personalRecordL is where Load methods for each relationship are stored.
+type personalRecordL struct{}
+
+var (
+	personalRecordAllColumns            = []string{"id", "user_id", "workout_id", "set_id", "created_at"}
+	personalRecordColumnsWithoutDefault = []string{"user_id", "workout_id", "set_id", "created_at"}
+	personalRecordColumnsWithDefault    = []string{"id"}
+	personalRecordPrimaryKeyColumns     = []string{"id"}
+	personalRecordGeneratedColumns      = []string{}
+)
+
+type (
+	// PersonalRecordSlice is an alias for a slice of pointers to PersonalRecord.
+	// This should almost always be used instead of []PersonalRecord.
+	PersonalRecordSlice []*PersonalRecord
+	// PersonalRecordHook is the signature for custom PersonalRecord hook methods
+	PersonalRecordHook func(context.Context, boil.ContextExecutor, *PersonalRecord) error
+
+	personalRecordQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	personalRecordType                 = reflect.TypeOf(&PersonalRecord{})
+	personalRecordMapping              = queries.MakeStructMapping(personalRecordType)
+	personalRecordPrimaryKeyMapping, _ = queries.BindMapping(personalRecordType, personalRecordMapping, personalRecordPrimaryKeyColumns)
+	personalRecordInsertCacheMut       sync.RWMutex
+	personalRecordInsertCache          = make(map[string]insertCache)
+	personalRecordUpdateCacheMut       sync.RWMutex
+	personalRecordUpdateCache          = make(map[string]updateCache)
+	personalRecordUpsertCacheMut       sync.RWMutex
+	personalRecordUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var personalRecordAfterSelectMu sync.Mutex
+var personalRecordAfterSelectHooks []PersonalRecordHook
+
+var personalRecordBeforeInsertMu sync.Mutex
+var personalRecordBeforeInsertHooks []PersonalRecordHook
+var personalRecordAfterInsertMu sync.Mutex
+var personalRecordAfterInsertHooks []PersonalRecordHook
+
+var personalRecordBeforeUpdateMu sync.Mutex
+var personalRecordBeforeUpdateHooks []PersonalRecordHook
+var personalRecordAfterUpdateMu sync.Mutex
+var personalRecordAfterUpdateHooks []PersonalRecordHook
+
+var personalRecordBeforeDeleteMu sync.Mutex
+var personalRecordBeforeDeleteHooks []PersonalRecordHook
+var personalRecordAfterDeleteMu sync.Mutex
+var personalRecordAfterDeleteHooks []PersonalRecordHook
+
+var personalRecordBeforeUpsertMu sync.Mutex
+var personalRecordBeforeUpsertHooks []PersonalRecordHook
+var personalRecordAfterUpsertMu sync.Mutex
+var personalRecordAfterUpsertHooks []PersonalRecordHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PersonalRecord) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range personalRecordAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PersonalRecord) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range personalRecordBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PersonalRecord) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range personalRecordAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PersonalRecord) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range personalRecordBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PersonalRecord) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range personalRecordAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PersonalRecord) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range personalRecordBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PersonalRecord) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range personalRecordAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PersonalRecord) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range personalRecordBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PersonalRecord) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range personalRecordAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPersonalRecordHook registers your hook function for all future operations.
+func AddPersonalRecordHook(hookPoint boil.HookPoint, personalRecordHook PersonalRecordHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		personalRecordAfterSelectMu.Lock()
+		personalRecordAfterSelectHooks = append(personalRecordAfterSelectHooks, personalRecordHook)
+		personalRecordAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		personalRecordBeforeInsertMu.Lock()
+		personalRecordBeforeInsertHooks = append(personalRecordBeforeInsertHooks, personalRecordHook)
+		personalRecordBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		personalRecordAfterInsertMu.Lock()
+		personalRecordAfterInsertHooks = append(personalRecordAfterInsertHooks, personalRecordHook)
+		personalRecordAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		personalRecordBeforeUpdateMu.Lock()
+		personalRecordBeforeUpdateHooks = append(personalRecordBeforeUpdateHooks, personalRecordHook)
+		personalRecordBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		personalRecordAfterUpdateMu.Lock()
+		personalRecordAfterUpdateHooks = append(personalRecordAfterUpdateHooks, personalRecordHook)
+		personalRecordAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		personalRecordBeforeDeleteMu.Lock()
+		personalRecordBeforeDeleteHooks = append(personalRecordBeforeDeleteHooks, personalRecordHook)
+		personalRecordBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		personalRecordAfterDeleteMu.Lock()
+		personalRecordAfterDeleteHooks = append(personalRecordAfterDeleteHooks, personalRecordHook)
+		personalRecordAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		personalRecordBeforeUpsertMu.Lock()
+		personalRecordBeforeUpsertHooks = append(personalRecordBeforeUpsertHooks, personalRecordHook)
+		personalRecordBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		personalRecordAfterUpsertMu.Lock()
+		personalRecordAfterUpsertHooks = append(personalRecordAfterUpsertHooks, personalRecordHook)
+		personalRecordAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single personalRecord record from the query.
+func (q personalRecordQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PersonalRecord, error) {
+	o := &PersonalRecord{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for personal_records")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PersonalRecord records from the query.
+func (q personalRecordQuery) All(ctx context.Context, exec boil.ContextExecutor) (PersonalRecordSlice, error) {
+	var o []*PersonalRecord
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to PersonalRecord slice")
+	}
+
+	if len(personalRecordAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PersonalRecord records in the query.
+func (q personalRecordQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count personal_records rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q personalRecordQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if personal_records exists")
+	}
+
+	return count > 0, nil
+}
+
+// Set pointed to by the foreign key.
+func (o *PersonalRecord) Set(mods ...qm.QueryMod) setQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SetID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Sets(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *PersonalRecord) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Workout pointed to by the foreign key.
+func (o *PersonalRecord) Workout(mods ...qm.QueryMod) workoutQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WorkoutID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Workouts(queryMods...)
+}
+
+// LoadSet allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (personalRecordL) LoadSet(ctx context.Context, e boil.ContextExecutor, singular bool, maybePersonalRecord interface{}, mods queries.Applicator) error {
+	var slice []*PersonalRecord
+	var object *PersonalRecord
+
+	if singular {
+		var ok bool
+		object, ok = maybePersonalRecord.(*PersonalRecord)
+		if !ok {
+			object = new(PersonalRecord)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePersonalRecord)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePersonalRecord))
+			}
+		}
+	} else {
+		s, ok := maybePersonalRecord.(*[]*PersonalRecord)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePersonalRecord)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePersonalRecord))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &personalRecordR{}
+		}
+		args[object.SetID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &personalRecordR{}
+			}
+
+			args[obj.SetID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.sets`),
+		qm.WhereIn(`getstronger.sets.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Set")
+	}
+
+	var resultSlice []*Set
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Set")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for sets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sets")
+	}
+
+	if len(setAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Set = foreign
+		if foreign.R == nil {
+			foreign.R = &setR{}
+		}
+		foreign.R.PersonalRecord = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.SetID == foreign.ID {
+				local.R.Set = foreign
+				if foreign.R == nil {
+					foreign.R = &setR{}
+				}
+				foreign.R.PersonalRecord = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (personalRecordL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePersonalRecord interface{}, mods queries.Applicator) error {
+	var slice []*PersonalRecord
+	var object *PersonalRecord
+
+	if singular {
+		var ok bool
+		object, ok = maybePersonalRecord.(*PersonalRecord)
+		if !ok {
+			object = new(PersonalRecord)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePersonalRecord)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePersonalRecord))
+			}
+		}
+	} else {
+		s, ok := maybePersonalRecord.(*[]*PersonalRecord)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePersonalRecord)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePersonalRecord))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &personalRecordR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &personalRecordR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.PersonalRecords = append(foreign.R.PersonalRecords, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.PersonalRecords = append(foreign.R.PersonalRecords, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWorkout allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (personalRecordL) LoadWorkout(ctx context.Context, e boil.ContextExecutor, singular bool, maybePersonalRecord interface{}, mods queries.Applicator) error {
+	var slice []*PersonalRecord
+	var object *PersonalRecord
+
+	if singular {
+		var ok bool
+		object, ok = maybePersonalRecord.(*PersonalRecord)
+		if !ok {
+			object = new(PersonalRecord)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePersonalRecord)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePersonalRecord))
+			}
+		}
+	} else {
+		s, ok := maybePersonalRecord.(*[]*PersonalRecord)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePersonalRecord)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePersonalRecord))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &personalRecordR{}
+		}
+		args[object.WorkoutID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &personalRecordR{}
+			}
+
+			args[obj.WorkoutID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.workouts`),
+		qm.WhereIn(`getstronger.workouts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Workout")
+	}
+
+	var resultSlice []*Workout
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Workout")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for workouts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workouts")
+	}
+
+	if len(workoutAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Workout = foreign
+		if foreign.R == nil {
+			foreign.R = &workoutR{}
+		}
+		foreign.R.PersonalRecords = append(foreign.R.PersonalRecords, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WorkoutID == foreign.ID {
+				local.R.Workout = foreign
+				if foreign.R == nil {
+					foreign.R = &workoutR{}
+				}
+				foreign.R.PersonalRecords = append(foreign.R.PersonalRecords, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSet of the personalRecord to the related item.
+// Sets o.R.Set to related.
+// Adds o to related.R.PersonalRecord.
+func (o *PersonalRecord) SetSet(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Set) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"personal_records\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"set_id"}),
+		strmangle.WhereClause("\"", "\"", 2, personalRecordPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.SetID = related.ID
+	if o.R == nil {
+		o.R = &personalRecordR{
+			Set: related,
+		}
+	} else {
+		o.R.Set = related
+	}
+
+	if related.R == nil {
+		related.R = &setR{
+			PersonalRecord: o,
+		}
+	} else {
+		related.R.PersonalRecord = o
+	}
+
+	return nil
+}
+
+// SetUser of the personalRecord to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.PersonalRecords.
+func (o *PersonalRecord) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"personal_records\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, personalRecordPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &personalRecordR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			PersonalRecords: PersonalRecordSlice{o},
+		}
+	} else {
+		related.R.PersonalRecords = append(related.R.PersonalRecords, o)
+	}
+
+	return nil
+}
+
+// SetWorkout of the personalRecord to the related item.
+// Sets o.R.Workout to related.
+// Adds o to related.R.PersonalRecords.
+func (o *PersonalRecord) SetWorkout(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Workout) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"personal_records\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"workout_id"}),
+		strmangle.WhereClause("\"", "\"", 2, personalRecordPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WorkoutID = related.ID
+	if o.R == nil {
+		o.R = &personalRecordR{
+			Workout: related,
+		}
+	} else {
+		o.R.Workout = related
+	}
+
+	if related.R == nil {
+		related.R = &workoutR{
+			PersonalRecords: PersonalRecordSlice{o},
+		}
+	} else {
+		related.R.PersonalRecords = append(related.R.PersonalRecords, o)
+	}
+
+	return nil
+}
+
+// PersonalRecords retrieves all the records using an executor.
+func PersonalRecords(mods ...qm.QueryMod) personalRecordQuery {
+	mods = append(mods, qm.From("\"getstronger\".\"personal_records\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"getstronger\".\"personal_records\".*"})
+	}
+
+	return personalRecordQuery{q}
+}
+
+// FindPersonalRecord retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPersonalRecord(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*PersonalRecord, error) {
+	personalRecordObj := &PersonalRecord{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"getstronger\".\"personal_records\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, personalRecordObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from personal_records")
+	}
+
+	if err = personalRecordObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return personalRecordObj, err
+	}
+
+	return personalRecordObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PersonalRecord) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no personal_records provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(personalRecordColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	personalRecordInsertCacheMut.RLock()
+	cache, cached := personalRecordInsertCache[key]
+	personalRecordInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			personalRecordAllColumns,
+			personalRecordColumnsWithDefault,
+			personalRecordColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(personalRecordType, personalRecordMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(personalRecordType, personalRecordMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"getstronger\".\"personal_records\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"getstronger\".\"personal_records\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into personal_records")
+	}
+
+	if !cached {
+		personalRecordInsertCacheMut.Lock()
+		personalRecordInsertCache[key] = cache
+		personalRecordInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PersonalRecord.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PersonalRecord) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	personalRecordUpdateCacheMut.RLock()
+	cache, cached := personalRecordUpdateCache[key]
+	personalRecordUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			personalRecordAllColumns,
+			personalRecordPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update personal_records, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"getstronger\".\"personal_records\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, personalRecordPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(personalRecordType, personalRecordMapping, append(wl, personalRecordPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update personal_records row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for personal_records")
+	}
+
+	if !cached {
+		personalRecordUpdateCacheMut.Lock()
+		personalRecordUpdateCache[key] = cache
+		personalRecordUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q personalRecordQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for personal_records")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for personal_records")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PersonalRecordSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), personalRecordPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"getstronger\".\"personal_records\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, personalRecordPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in personalRecord slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all personalRecord")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PersonalRecord) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no personal_records provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(personalRecordColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	personalRecordUpsertCacheMut.RLock()
+	cache, cached := personalRecordUpsertCache[key]
+	personalRecordUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			personalRecordAllColumns,
+			personalRecordColumnsWithDefault,
+			personalRecordColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			personalRecordAllColumns,
+			personalRecordPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert personal_records, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(personalRecordAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(personalRecordPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert personal_records, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(personalRecordPrimaryKeyColumns))
+			copy(conflict, personalRecordPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"getstronger\".\"personal_records\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(personalRecordType, personalRecordMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(personalRecordType, personalRecordMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert personal_records")
+	}
+
+	if !cached {
+		personalRecordUpsertCacheMut.Lock()
+		personalRecordUpsertCache[key] = cache
+		personalRecordUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PersonalRecord record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PersonalRecord) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no PersonalRecord provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), personalRecordPrimaryKeyMapping)
+	sql := "DELETE FROM \"getstronger\".\"personal_records\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from personal_records")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for personal_records")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q personalRecordQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no personalRecordQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from personal_records")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for personal_records")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PersonalRecordSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(personalRecordBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), personalRecordPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"getstronger\".\"personal_records\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, personalRecordPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from personalRecord slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for personal_records")
+	}
+
+	if len(personalRecordAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PersonalRecord) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPersonalRecord(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PersonalRecordSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PersonalRecordSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), personalRecordPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"getstronger\".\"personal_records\".* FROM \"getstronger\".\"personal_records\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, personalRecordPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in PersonalRecordSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PersonalRecordExists checks if the PersonalRecord row exists.
+func PersonalRecordExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"getstronger\".\"personal_records\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if personal_records exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the PersonalRecord row exists.
+func (o *PersonalRecord) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return PersonalRecordExists(ctx, exec, o.ID)
+}
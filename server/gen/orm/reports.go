@@ -0,0 +1,1476 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Report is an object representing the database table.
+type Report struct {
+	ID         string       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ReporterID string       `boil:"reporter_id" json:"reporter_id" toml:"reporter_id" yaml:"reporter_id"`
+	Target     ReportTarget `boil:"target" json:"target" toml:"target" yaml:"target"`
+	TargetID   string       `boil:"target_id" json:"target_id" toml:"target_id" yaml:"target_id"`
+	Reason     string       `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	Status     ReportStatus `boil:"status" json:"status" toml:"status" yaml:"status"`
+	CreatedAt  time.Time    `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ResolvedBy null.String  `boil:"resolved_by" json:"resolved_by,omitempty" toml:"resolved_by" yaml:"resolved_by,omitempty"`
+	ResolvedAt null.Time    `boil:"resolved_at" json:"resolved_at,omitempty" toml:"resolved_at" yaml:"resolved_at,omitempty"`
+
+	R *reportR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L reportL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ReportColumns = struct {
+	ID         string
+	ReporterID string
+	Target     string
+	TargetID   string
+	Reason     string
+	Status     string
+	CreatedAt  string
+	ResolvedBy string
+	ResolvedAt string
+}{
+	ID:         "id",
+	ReporterID: "reporter_id",
+	Target:     "target",
+	TargetID:   "target_id",
+	Reason:     "reason",
+	Status:     "status",
+	CreatedAt:  "created_at",
+	ResolvedBy: "resolved_by",
+	ResolvedAt: "resolved_at",
+}
+
+var ReportTableColumns = struct {
+	ID         string
+	ReporterID string
+	Target     string
+	TargetID   string
+	Reason     string
+	Status     string
+	CreatedAt  string
+	ResolvedBy string
+	ResolvedAt string
+}{
+	ID:         "reports.id",
+	ReporterID: "reports.reporter_id",
+	Target:     "reports.target",
+	TargetID:   "reports.target_id",
+	Reason:     "reports.reason",
+	Status:     "reports.status",
+	CreatedAt:  "reports.created_at",
+	ResolvedBy: "reports.resolved_by",
+	ResolvedAt: "reports.resolved_at",
+}
+
+// Generated where
+
+type whereHelperReportTarget struct{ field string }
+
+func (w whereHelperReportTarget) EQ(x ReportTarget) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperReportTarget) NEQ(x ReportTarget) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperReportTarget) LT(x ReportTarget) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperReportTarget) LTE(x ReportTarget) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperReportTarget) GT(x ReportTarget) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperReportTarget) GTE(x ReportTarget) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperReportTarget) IN(slice []ReportTarget) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperReportTarget) NIN(slice []ReportTarget) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelperReportStatus struct{ field string }
+
+func (w whereHelperReportStatus) EQ(x ReportStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperReportStatus) NEQ(x ReportStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperReportStatus) LT(x ReportStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperReportStatus) LTE(x ReportStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperReportStatus) GT(x ReportStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperReportStatus) GTE(x ReportStatus) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperReportStatus) IN(slice []ReportStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperReportStatus) NIN(slice []ReportStatus) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var ReportWhere = struct {
+	ID         whereHelperstring
+	ReporterID whereHelperstring
+	Target     whereHelperReportTarget
+	TargetID   whereHelperstring
+	Reason     whereHelperstring
+	Status     whereHelperReportStatus
+	CreatedAt  whereHelpertime_Time
+	ResolvedBy whereHelpernull_String
+	ResolvedAt whereHelpernull_Time
+}{
+	ID:         whereHelperstring{field: "\"getstronger\".\"reports\".\"id\""},
+	ReporterID: whereHelperstring{field: "\"getstronger\".\"reports\".\"reporter_id\""},
+	Target:     whereHelperReportTarget{field: "\"getstronger\".\"reports\".\"target\""},
+	TargetID:   whereHelperstring{field: "\"getstronger\".\"reports\".\"target_id\""},
+	Reason:     whereHelperstring{field: "\"getstronger\".\"reports\".\"reason\""},
+	Status:     whereHelperReportStatus{field: "\"getstronger\".\"reports\".\"status\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"getstronger\".\"reports\".\"created_at\""},
+	ResolvedBy: whereHelpernull_String{field: "\"getstronger\".\"reports\".\"resolved_by\""},
+	ResolvedAt: whereHelpernull_Time{field: "\"getstronger\".\"reports\".\"resolved_at\""},
+}
+
+// ReportRels is where relationship names are stored.
+var ReportRels = struct {
+	Reporter       string
+	ResolvedByUser string
+}{
+	Reporter:       "Reporter",
+	ResolvedByUser: "ResolvedByUser",
+}
+
+// reportR is where relationships are stored.
+type reportR struct {
+	Reporter       *User `boil:"Reporter" json:"Reporter" toml:"Reporter" yaml:"Reporter"`
+	ResolvedByUser *User `boil:"ResolvedByUser" json:"ResolvedByUser" toml:"ResolvedByUser" yaml:"ResolvedByUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*reportR) NewStruct() *reportR {
+	return &reportR{}
+}
+
+func (r *reportR) GetReporter() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Reporter
+}
+
+func (r *reportR) GetResolvedByUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.ResolvedByUser
+}
+
+// reportL is where Load methods for each relationship are stored.
+type reportL struct{}
+
+var (
+	reportAllColumns            = []string{"id", "reporter_id", "target", "target_id", "reason", "status", "created_at", "resolved_by", "resolved_at"}
+	reportColumnsWithoutDefault = []string{"reporter_id", "target", "target_id", "reason"}
+	reportColumnsWithDefault    = []string{"id", "status", "created_at", "resolved_by", "resolved_at"}
+	reportPrimaryKeyColumns     = []string{"id"}
+	reportGeneratedColumns      = []string{}
+)
+
+type (
+	// ReportSlice is an alias for a slice of pointers to Report.
+	// This should almost always be used instead of []Report.
+	ReportSlice []*Report
+	// ReportHook is the signature for custom Report hook methods
+	ReportHook func(context.Context, boil.ContextExecutor, *Report) error
+
+	reportQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	reportType                 = reflect.TypeOf(&Report{})
+	reportMapping              = queries.MakeStructMapping(reportType)
+	reportPrimaryKeyMapping, _ = queries.BindMapping(reportType, reportMapping, reportPrimaryKeyColumns)
+	reportInsertCacheMut       sync.RWMutex
+	reportInsertCache          = make(map[string]insertCache)
+	reportUpdateCacheMut       sync.RWMutex
+	reportUpdateCache          = make(map[string]updateCache)
+	reportUpsertCacheMut       sync.RWMutex
+	reportUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var reportAfterSelectMu sync.Mutex
+var reportAfterSelectHooks []ReportHook
+
+var reportBeforeInsertMu sync.Mutex
+var reportBeforeInsertHooks []ReportHook
+var reportAfterInsertMu sync.Mutex
+var reportAfterInsertHooks []ReportHook
+
+var reportBeforeUpdateMu sync.Mutex
+var reportBeforeUpdateHooks []ReportHook
+var reportAfterUpdateMu sync.Mutex
+var reportAfterUpdateHooks []ReportHook
+
+var reportBeforeDeleteMu sync.Mutex
+var reportBeforeDeleteHooks []ReportHook
+var reportAfterDeleteMu sync.Mutex
+var reportAfterDeleteHooks []ReportHook
+
+var reportBeforeUpsertMu sync.Mutex
+var reportBeforeUpsertHooks []ReportHook
+var reportAfterUpsertMu sync.Mutex
+var reportAfterUpsertHooks []ReportHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Report) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reportAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Report) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reportBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Report) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reportAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Report) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reportBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Report) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reportAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Report) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reportBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Report) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reportAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Report) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reportBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Report) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range reportAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddReportHook registers your hook function for all future operations.
+func AddReportHook(hookPoint boil.HookPoint, reportHook ReportHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		reportAfterSelectMu.Lock()
+		reportAfterSelectHooks = append(reportAfterSelectHooks, reportHook)
+		reportAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		reportBeforeInsertMu.Lock()
+		reportBeforeInsertHooks = append(reportBeforeInsertHooks, reportHook)
+		reportBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		reportAfterInsertMu.Lock()
+		reportAfterInsertHooks = append(reportAfterInsertHooks, reportHook)
+		reportAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		reportBeforeUpdateMu.Lock()
+		reportBeforeUpdateHooks = append(reportBeforeUpdateHooks, reportHook)
+		reportBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		reportAfterUpdateMu.Lock()
+		reportAfterUpdateHooks = append(reportAfterUpdateHooks, reportHook)
+		reportAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		reportBeforeDeleteMu.Lock()
+		reportBeforeDeleteHooks = append(reportBeforeDeleteHooks, reportHook)
+		reportBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		reportAfterDeleteMu.Lock()
+		reportAfterDeleteHooks = append(reportAfterDeleteHooks, reportHook)
+		reportAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		reportBeforeUpsertMu.Lock()
+		reportBeforeUpsertHooks = append(reportBeforeUpsertHooks, reportHook)
+		reportBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		reportAfterUpsertMu.Lock()
+		reportAfterUpsertHooks = append(reportAfterUpsertHooks, reportHook)
+		reportAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single report record from the query.
+func (q reportQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Report, error) {
+	o := &Report{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for reports")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Report records from the query.
+func (q reportQuery) All(ctx context.Context, exec boil.ContextExecutor) (ReportSlice, error) {
+	var o []*Report
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to Report slice")
+	}
+
+	if len(reportAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Report records in the query.
+func (q reportQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count reports rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q reportQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if reports exists")
+	}
+
+	return count > 0, nil
+}
+
+// Reporter pointed to by the foreign key.
+func (o *Report) Reporter(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ReporterID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// ResolvedByUser pointed to by the foreign key.
+func (o *Report) ResolvedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ResolvedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadReporter allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reportL) LoadReporter(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReport interface{}, mods queries.Applicator) error {
+	var slice []*Report
+	var object *Report
+
+	if singular {
+		var ok bool
+		object, ok = maybeReport.(*Report)
+		if !ok {
+			object = new(Report)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReport))
+			}
+		}
+	} else {
+		s, ok := maybeReport.(*[]*Report)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReport))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &reportR{}
+		}
+		args[object.ReporterID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reportR{}
+			}
+
+			args[obj.ReporterID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Reporter = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ReporterReports = append(foreign.R.ReporterReports, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ReporterID == foreign.ID {
+				local.R.Reporter = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ReporterReports = append(foreign.R.ReporterReports, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadResolvedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (reportL) LoadResolvedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeReport interface{}, mods queries.Applicator) error {
+	var slice []*Report
+	var object *Report
+
+	if singular {
+		var ok bool
+		object, ok = maybeReport.(*Report)
+		if !ok {
+			object = new(Report)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeReport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeReport))
+			}
+		}
+	} else {
+		s, ok := maybeReport.(*[]*Report)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeReport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeReport))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &reportR{}
+		}
+		if !queries.IsNil(object.ResolvedBy) {
+			args[object.ResolvedBy] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &reportR{}
+			}
+
+			if !queries.IsNil(obj.ResolvedBy) {
+				args[obj.ResolvedBy] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ResolvedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ResolvedByReports = append(foreign.R.ResolvedByReports, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ResolvedBy, foreign.ID) {
+				local.R.ResolvedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ResolvedByReports = append(foreign.R.ResolvedByReports, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetReporter of the report to the related item.
+// Sets o.R.Reporter to related.
+// Adds o to related.R.ReporterReports.
+func (o *Report) SetReporter(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"reports\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"reporter_id"}),
+		strmangle.WhereClause("\"", "\"", 2, reportPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ReporterID = related.ID
+	if o.R == nil {
+		o.R = &reportR{
+			Reporter: related,
+		}
+	} else {
+		o.R.Reporter = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ReporterReports: ReportSlice{o},
+		}
+	} else {
+		related.R.ReporterReports = append(related.R.ReporterReports, o)
+	}
+
+	return nil
+}
+
+// SetResolvedByUser of the report to the related item.
+// Sets o.R.ResolvedByUser to related.
+// Adds o to related.R.ResolvedByReports.
+func (o *Report) SetResolvedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"reports\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"resolved_by"}),
+		strmangle.WhereClause("\"", "\"", 2, reportPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ResolvedBy, related.ID)
+	if o.R == nil {
+		o.R = &reportR{
+			ResolvedByUser: related,
+		}
+	} else {
+		o.R.ResolvedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ResolvedByReports: ReportSlice{o},
+		}
+	} else {
+		related.R.ResolvedByReports = append(related.R.ResolvedByReports, o)
+	}
+
+	return nil
+}
+
+// RemoveResolvedByUser relationship.
+// Sets o.R.ResolvedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Report) RemoveResolvedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ResolvedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("resolved_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ResolvedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ResolvedByReports {
+		if queries.Equal(o.ResolvedBy, ri.ResolvedBy) {
+			continue
+		}
+
+		ln := len(related.R.ResolvedByReports)
+		if ln > 1 && i < ln-1 {
+			related.R.ResolvedByReports[i] = related.R.ResolvedByReports[ln-1]
+		}
+		related.R.ResolvedByReports = related.R.ResolvedByReports[:ln-1]
+		break
+	}
+	return nil
+}
+
+// Reports retrieves all the records using an executor.
+func Reports(mods ...qm.QueryMod) reportQuery {
+	mods = append(mods, qm.From("\"getstronger\".\"reports\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"getstronger\".\"reports\".*"})
+	}
+
+	return reportQuery{q}
+}
+
+// FindReport retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindReport(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Report, error) {
+	reportObj := &Report{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"getstronger\".\"reports\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, reportObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from reports")
+	}
+
+	if err = reportObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return reportObj, err
+	}
+
+	return reportObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Report) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no reports provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reportColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	reportInsertCacheMut.RLock()
+	cache, cached := reportInsertCache[key]
+	reportInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			reportAllColumns,
+			reportColumnsWithDefault,
+			reportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(reportType, reportMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(reportType, reportMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"getstronger\".\"reports\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"getstronger\".\"reports\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into reports")
+	}
+
+	if !cached {
+		reportInsertCacheMut.Lock()
+		reportInsertCache[key] = cache
+		reportInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the Report.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Report) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	reportUpdateCacheMut.RLock()
+	cache, cached := reportUpdateCache[key]
+	reportUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			reportAllColumns,
+			reportPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update reports, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"getstronger\".\"reports\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, reportPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(reportType, reportMapping, append(wl, reportPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update reports row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for reports")
+	}
+
+	if !cached {
+		reportUpdateCacheMut.Lock()
+		reportUpdateCache[key] = cache
+		reportUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q reportQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for reports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for reports")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ReportSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"getstronger\".\"reports\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, reportPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in report slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all report")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Report) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no reports provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(reportColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	reportUpsertCacheMut.RLock()
+	cache, cached := reportUpsertCache[key]
+	reportUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			reportAllColumns,
+			reportColumnsWithDefault,
+			reportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			reportAllColumns,
+			reportPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert reports, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(reportAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(reportPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert reports, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(reportPrimaryKeyColumns))
+			copy(conflict, reportPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"getstronger\".\"reports\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(reportType, reportMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(reportType, reportMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert reports")
+	}
+
+	if !cached {
+		reportUpsertCacheMut.Lock()
+		reportUpsertCache[key] = cache
+		reportUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single Report record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Report) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no Report provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), reportPrimaryKeyMapping)
+	sql := "DELETE FROM \"getstronger\".\"reports\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from reports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for reports")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q reportQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no reportQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from reports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for reports")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ReportSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(reportBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"getstronger\".\"reports\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reportPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from report slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for reports")
+	}
+
+	if len(reportAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Report) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindReport(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ReportSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ReportSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), reportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"getstronger\".\"reports\".* FROM \"getstronger\".\"reports\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, reportPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in ReportSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ReportExists checks if the Report row exists.
+func ReportExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"getstronger\".\"reports\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if reports exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Report row exists.
+func (o *Report) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ReportExists(ctx, exec, o.ID)
+}
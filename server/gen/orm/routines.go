@@ -25,80 +25,156 @@ import (
 
 // Routine is an object representing the database table.
 type Routine struct {
-	ID            string     `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID        string     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	Title         string     `boil:"title" json:"title" toml:"title" yaml:"title"`
-	CreatedAt     time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	DeletedAt     null.Time  `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
-	ExerciseOrder types.JSON `boil:"exercise_order" json:"exercise_order" toml:"exercise_order" yaml:"exercise_order"`
+	ID                  string            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID              string            `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Title               string            `boil:"title" json:"title" toml:"title" yaml:"title"`
+	CreatedAt           time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	DeletedAt           null.Time         `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ExerciseOrder       types.JSON        `boil:"exercise_order" json:"exercise_order" toml:"exercise_order" yaml:"exercise_order"`
+	ExerciseTargets     types.JSON        `boil:"exercise_targets" json:"exercise_targets" toml:"exercise_targets" yaml:"exercise_targets"`
+	Visibility          RoutineVisibility `boil:"visibility" json:"visibility" toml:"visibility" yaml:"visibility"`
+	ShareToken          null.String       `boil:"share_token" json:"share_token,omitempty" toml:"share_token" yaml:"share_token,omitempty"`
+	ClonedFromRoutineID null.String       `boil:"cloned_from_routine_id" json:"cloned_from_routine_id,omitempty" toml:"cloned_from_routine_id" yaml:"cloned_from_routine_id,omitempty"`
+	CloneCount          int               `boil:"clone_count" json:"clone_count" toml:"clone_count" yaml:"clone_count"`
 
 	R *routineR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L routineL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var RoutineColumns = struct {
-	ID            string
-	UserID        string
-	Title         string
-	CreatedAt     string
-	DeletedAt     string
-	ExerciseOrder string
+	ID                  string
+	UserID              string
+	Title               string
+	CreatedAt           string
+	DeletedAt           string
+	ExerciseOrder       string
+	ExerciseTargets     string
+	Visibility          string
+	ShareToken          string
+	ClonedFromRoutineID string
+	CloneCount          string
 }{
-	ID:            "id",
-	UserID:        "user_id",
-	Title:         "title",
-	CreatedAt:     "created_at",
-	DeletedAt:     "deleted_at",
-	ExerciseOrder: "exercise_order",
+	ID:                  "id",
+	UserID:              "user_id",
+	Title:               "title",
+	CreatedAt:           "created_at",
+	DeletedAt:           "deleted_at",
+	ExerciseOrder:       "exercise_order",
+	ExerciseTargets:     "exercise_targets",
+	Visibility:          "visibility",
+	ShareToken:          "share_token",
+	ClonedFromRoutineID: "cloned_from_routine_id",
+	CloneCount:          "clone_count",
 }
 
 var RoutineTableColumns = struct {
-	ID            string
-	UserID        string
-	Title         string
-	CreatedAt     string
-	DeletedAt     string
-	ExerciseOrder string
+	ID                  string
+	UserID              string
+	Title               string
+	CreatedAt           string
+	DeletedAt           string
+	ExerciseOrder       string
+	ExerciseTargets     string
+	Visibility          string
+	ShareToken          string
+	ClonedFromRoutineID string
+	CloneCount          string
 }{
-	ID:            "routines.id",
-	UserID:        "routines.user_id",
-	Title:         "routines.title",
-	CreatedAt:     "routines.created_at",
-	DeletedAt:     "routines.deleted_at",
-	ExerciseOrder: "routines.exercise_order",
+	ID:                  "routines.id",
+	UserID:              "routines.user_id",
+	Title:               "routines.title",
+	CreatedAt:           "routines.created_at",
+	DeletedAt:           "routines.deleted_at",
+	ExerciseOrder:       "routines.exercise_order",
+	ExerciseTargets:     "routines.exercise_targets",
+	Visibility:          "routines.visibility",
+	ShareToken:          "routines.share_token",
+	ClonedFromRoutineID: "routines.cloned_from_routine_id",
+	CloneCount:          "routines.clone_count",
 }
 
 // Generated where
 
+type whereHelperRoutineVisibility struct{ field string }
+
+func (w whereHelperRoutineVisibility) EQ(x RoutineVisibility) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperRoutineVisibility) NEQ(x RoutineVisibility) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperRoutineVisibility) LT(x RoutineVisibility) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperRoutineVisibility) LTE(x RoutineVisibility) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperRoutineVisibility) GT(x RoutineVisibility) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperRoutineVisibility) GTE(x RoutineVisibility) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperRoutineVisibility) IN(slice []RoutineVisibility) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperRoutineVisibility) NIN(slice []RoutineVisibility) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var RoutineWhere = struct {
-	ID            whereHelperstring
-	UserID        whereHelperstring
-	Title         whereHelperstring
-	CreatedAt     whereHelpertime_Time
-	DeletedAt     whereHelpernull_Time
-	ExerciseOrder whereHelpertypes_JSON
+	ID                  whereHelperstring
+	UserID              whereHelperstring
+	Title               whereHelperstring
+	CreatedAt           whereHelpertime_Time
+	DeletedAt           whereHelpernull_Time
+	ExerciseOrder       whereHelpertypes_JSON
+	ExerciseTargets     whereHelpertypes_JSON
+	Visibility          whereHelperRoutineVisibility
+	ShareToken          whereHelpernull_String
+	ClonedFromRoutineID whereHelpernull_String
+	CloneCount          whereHelperint
 }{
-	ID:            whereHelperstring{field: "\"getstronger\".\"routines\".\"id\""},
-	UserID:        whereHelperstring{field: "\"getstronger\".\"routines\".\"user_id\""},
-	Title:         whereHelperstring{field: "\"getstronger\".\"routines\".\"title\""},
-	CreatedAt:     whereHelpertime_Time{field: "\"getstronger\".\"routines\".\"created_at\""},
-	DeletedAt:     whereHelpernull_Time{field: "\"getstronger\".\"routines\".\"deleted_at\""},
-	ExerciseOrder: whereHelpertypes_JSON{field: "\"getstronger\".\"routines\".\"exercise_order\""},
+	ID:                  whereHelperstring{field: "\"getstronger\".\"routines\".\"id\""},
+	UserID:              whereHelperstring{field: "\"getstronger\".\"routines\".\"user_id\""},
+	Title:               whereHelperstring{field: "\"getstronger\".\"routines\".\"title\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"getstronger\".\"routines\".\"created_at\""},
+	DeletedAt:           whereHelpernull_Time{field: "\"getstronger\".\"routines\".\"deleted_at\""},
+	ExerciseOrder:       whereHelpertypes_JSON{field: "\"getstronger\".\"routines\".\"exercise_order\""},
+	ExerciseTargets:     whereHelpertypes_JSON{field: "\"getstronger\".\"routines\".\"exercise_targets\""},
+	Visibility:          whereHelperRoutineVisibility{field: "\"getstronger\".\"routines\".\"visibility\""},
+	ShareToken:          whereHelpernull_String{field: "\"getstronger\".\"routines\".\"share_token\""},
+	ClonedFromRoutineID: whereHelpernull_String{field: "\"getstronger\".\"routines\".\"cloned_from_routine_id\""},
+	CloneCount:          whereHelperint{field: "\"getstronger\".\"routines\".\"clone_count\""},
 }
 
 // RoutineRels is where relationship names are stored.
 var RoutineRels = struct {
-	User      string
-	Exercises string
+	ClonedFromRoutine         string
+	User                      string
+	Exercises                 string
+	ClonedFromRoutineRoutines string
 }{
-	User:      "User",
-	Exercises: "Exercises",
+	ClonedFromRoutine:         "ClonedFromRoutine",
+	User:                      "User",
+	Exercises:                 "Exercises",
+	ClonedFromRoutineRoutines: "ClonedFromRoutineRoutines",
 }
 
 // routineR is where relationships are stored.
 type routineR struct {
-	User      *User         `boil:"User" json:"User" toml:"User" yaml:"User"`
-	Exercises ExerciseSlice `boil:"Exercises" json:"Exercises" toml:"Exercises" yaml:"Exercises"`
+	ClonedFromRoutine         *Routine      `boil:"ClonedFromRoutine" json:"ClonedFromRoutine" toml:"ClonedFromRoutine" yaml:"ClonedFromRoutine"`
+	User                      *User         `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Exercises                 ExerciseSlice `boil:"Exercises" json:"Exercises" toml:"Exercises" yaml:"Exercises"`
+	ClonedFromRoutineRoutines RoutineSlice  `boil:"ClonedFromRoutineRoutines" json:"ClonedFromRoutineRoutines" toml:"ClonedFromRoutineRoutines" yaml:"ClonedFromRoutineRoutines"`
 }
 
 // NewStruct creates a new relationship struct
@@ -106,6 +182,13 @@ func (*routineR) NewStruct() *routineR {
 	return &routineR{}
 }
 
+func (r *routineR) GetClonedFromRoutine() *Routine {
+	if r == nil {
+		return nil
+	}
+	return r.ClonedFromRoutine
+}
+
 func (r *routineR) GetUser() *User {
 	if r == nil {
 		return nil
@@ -120,13 +203,20 @@ func (r *routineR) GetExercises() ExerciseSlice {
 	return r.Exercises
 }
 
+func (r *routineR) GetClonedFromRoutineRoutines() RoutineSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ClonedFromRoutineRoutines
+}
+
 // routineL is where Load methods for each relationship are stored.
 type routineL struct{}
 
 var (
-	routineAllColumns            = []string{"id", "user_id", "title", "created_at", "deleted_at", "exercise_order"}
+	routineAllColumns            = []string{"id", "user_id", "title", "created_at", "deleted_at", "exercise_order", "exercise_targets", "visibility", "share_token", "cloned_from_routine_id", "clone_count"}
 	routineColumnsWithoutDefault = []string{"user_id", "title"}
-	routineColumnsWithDefault    = []string{"id", "created_at", "deleted_at", "exercise_order"}
+	routineColumnsWithDefault    = []string{"id", "created_at", "deleted_at", "exercise_order", "exercise_targets", "visibility", "share_token", "cloned_from_routine_id", "clone_count"}
 	routinePrimaryKeyColumns     = []string{"id"}
 	routineGeneratedColumns      = []string{}
 )
@@ -436,6 +526,17 @@ func (q routineQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bo
 	return count > 0, nil
 }
 
+// ClonedFromRoutine pointed to by the foreign key.
+func (o *Routine) ClonedFromRoutine(mods ...qm.QueryMod) routineQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ClonedFromRoutineID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Routines(queryMods...)
+}
+
 // User pointed to by the foreign key.
 func (o *Routine) User(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -462,6 +563,144 @@ func (o *Routine) Exercises(mods ...qm.QueryMod) exerciseQuery {
 	return Exercises(queryMods...)
 }
 
+// ClonedFromRoutineRoutines retrieves all the routine's Routines with an executor via cloned_from_routine_id column.
+func (o *Routine) ClonedFromRoutineRoutines(mods ...qm.QueryMod) routineQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"routines\".\"cloned_from_routine_id\"=?", o.ID),
+	)
+
+	return Routines(queryMods...)
+}
+
+// LoadClonedFromRoutine allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (routineL) LoadClonedFromRoutine(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRoutine interface{}, mods queries.Applicator) error {
+	var slice []*Routine
+	var object *Routine
+
+	if singular {
+		var ok bool
+		object, ok = maybeRoutine.(*Routine)
+		if !ok {
+			object = new(Routine)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRoutine)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRoutine))
+			}
+		}
+	} else {
+		s, ok := maybeRoutine.(*[]*Routine)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRoutine)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRoutine))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &routineR{}
+		}
+		if !queries.IsNil(object.ClonedFromRoutineID) {
+			args[object.ClonedFromRoutineID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &routineR{}
+			}
+
+			if !queries.IsNil(obj.ClonedFromRoutineID) {
+				args[obj.ClonedFromRoutineID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.routines`),
+		qm.WhereIn(`getstronger.routines.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Routine")
+	}
+
+	var resultSlice []*Routine
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Routine")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for routines")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for routines")
+	}
+
+	if len(routineAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ClonedFromRoutine = foreign
+		if foreign.R == nil {
+			foreign.R = &routineR{}
+		}
+		foreign.R.ClonedFromRoutineRoutines = append(foreign.R.ClonedFromRoutineRoutines, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ClonedFromRoutineID, foreign.ID) {
+				local.R.ClonedFromRoutine = foreign
+				if foreign.R == nil {
+					foreign.R = &routineR{}
+				}
+				foreign.R.ClonedFromRoutineRoutines = append(foreign.R.ClonedFromRoutineRoutines, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (routineL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRoutine interface{}, mods queries.Applicator) error {
@@ -637,7 +876,7 @@ func (routineL) LoadExercises(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.Select("\"getstronger\".\"exercises\".\"id\", \"getstronger\".\"exercises\".\"user_id\", \"getstronger\".\"exercises\".\"title\", \"getstronger\".\"exercises\".\"sub_title\", \"getstronger\".\"exercises\".\"created_at\", \"getstronger\".\"exercises\".\"deleted_at\", \"a\".\"routine_id\""),
+		qm.Select("\"getstronger\".\"exercises\".\"id\", \"getstronger\".\"exercises\".\"user_id\", \"getstronger\".\"exercises\".\"title\", \"getstronger\".\"exercises\".\"sub_title\", \"getstronger\".\"exercises\".\"created_at\", \"getstronger\".\"exercises\".\"deleted_at\", \"getstronger\".\"exercises\".\"deleted_from_routines\", \"getstronger\".\"exercises\".\"catalog_exercise\", \"a\".\"routine_id\""),
 		qm.From("\"getstronger\".\"exercises\""),
 		qm.InnerJoin("\"getstronger\".\"exercises_routines\" as \"a\" on \"getstronger\".\"exercises\".\"id\" = \"a\".\"exercise_id\""),
 		qm.WhereIn("\"a\".\"routine_id\" in ?", argsSlice...),
@@ -658,7 +897,7 @@ func (routineL) LoadExercises(ctx context.Context, e boil.ContextExecutor, singu
 		one := new(Exercise)
 		var localJoinCol string
 
-		err = results.Scan(&one.ID, &one.UserID, &one.Title, &one.SubTitle, &one.CreatedAt, &one.DeletedAt, &localJoinCol)
+		err = results.Scan(&one.ID, &one.UserID, &one.Title, &one.SubTitle, &one.CreatedAt, &one.DeletedAt, &one.DeletedFromRoutines, &one.CatalogExercise, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for exercises")
 		}
@@ -712,6 +951,199 @@ func (routineL) LoadExercises(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
+// LoadClonedFromRoutineRoutines allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (routineL) LoadClonedFromRoutineRoutines(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRoutine interface{}, mods queries.Applicator) error {
+	var slice []*Routine
+	var object *Routine
+
+	if singular {
+		var ok bool
+		object, ok = maybeRoutine.(*Routine)
+		if !ok {
+			object = new(Routine)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRoutine)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRoutine))
+			}
+		}
+	} else {
+		s, ok := maybeRoutine.(*[]*Routine)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRoutine)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRoutine))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &routineR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &routineR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.routines`),
+		qm.WhereIn(`getstronger.routines.cloned_from_routine_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load routines")
+	}
+
+	var resultSlice []*Routine
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice routines")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on routines")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for routines")
+	}
+
+	if len(routineAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ClonedFromRoutineRoutines = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &routineR{}
+			}
+			foreign.R.ClonedFromRoutine = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ClonedFromRoutineID) {
+				local.R.ClonedFromRoutineRoutines = append(local.R.ClonedFromRoutineRoutines, foreign)
+				if foreign.R == nil {
+					foreign.R = &routineR{}
+				}
+				foreign.R.ClonedFromRoutine = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetClonedFromRoutine of the routine to the related item.
+// Sets o.R.ClonedFromRoutine to related.
+// Adds o to related.R.ClonedFromRoutineRoutines.
+func (o *Routine) SetClonedFromRoutine(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Routine) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"routines\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"cloned_from_routine_id"}),
+		strmangle.WhereClause("\"", "\"", 2, routinePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ClonedFromRoutineID, related.ID)
+	if o.R == nil {
+		o.R = &routineR{
+			ClonedFromRoutine: related,
+		}
+	} else {
+		o.R.ClonedFromRoutine = related
+	}
+
+	if related.R == nil {
+		related.R = &routineR{
+			ClonedFromRoutineRoutines: RoutineSlice{o},
+		}
+	} else {
+		related.R.ClonedFromRoutineRoutines = append(related.R.ClonedFromRoutineRoutines, o)
+	}
+
+	return nil
+}
+
+// RemoveClonedFromRoutine relationship.
+// Sets o.R.ClonedFromRoutine to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Routine) RemoveClonedFromRoutine(ctx context.Context, exec boil.ContextExecutor, related *Routine) error {
+	var err error
+
+	queries.SetScanner(&o.ClonedFromRoutineID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("cloned_from_routine_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ClonedFromRoutine = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ClonedFromRoutineRoutines {
+		if queries.Equal(o.ClonedFromRoutineID, ri.ClonedFromRoutineID) {
+			continue
+		}
+
+		ln := len(related.R.ClonedFromRoutineRoutines)
+		if ln > 1 && i < ln-1 {
+			related.R.ClonedFromRoutineRoutines[i] = related.R.ClonedFromRoutineRoutines[ln-1]
+		}
+		related.R.ClonedFromRoutineRoutines = related.R.ClonedFromRoutineRoutines[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetUser of the routine to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.Routines.
@@ -904,6 +1336,133 @@ func removeExercisesFromRoutinesSlice(o *Routine, related []*Exercise) {
 	}
 }
 
+// AddClonedFromRoutineRoutines adds the given related objects to the existing relationships
+// of the routine, optionally inserting them as new records.
+// Appends related to o.R.ClonedFromRoutineRoutines.
+// Sets related.R.ClonedFromRoutine appropriately.
+func (o *Routine) AddClonedFromRoutineRoutines(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Routine) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ClonedFromRoutineID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"routines\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"cloned_from_routine_id"}),
+				strmangle.WhereClause("\"", "\"", 2, routinePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ClonedFromRoutineID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &routineR{
+			ClonedFromRoutineRoutines: related,
+		}
+	} else {
+		o.R.ClonedFromRoutineRoutines = append(o.R.ClonedFromRoutineRoutines, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &routineR{
+				ClonedFromRoutine: o,
+			}
+		} else {
+			rel.R.ClonedFromRoutine = o
+		}
+	}
+	return nil
+}
+
+// SetClonedFromRoutineRoutines removes all previously related items of the
+// routine replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ClonedFromRoutine's ClonedFromRoutineRoutines accordingly.
+// Replaces o.R.ClonedFromRoutineRoutines with related.
+// Sets related.R.ClonedFromRoutine's ClonedFromRoutineRoutines accordingly.
+func (o *Routine) SetClonedFromRoutineRoutines(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Routine) error {
+	query := "update \"getstronger\".\"routines\" set \"cloned_from_routine_id\" = null where \"cloned_from_routine_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ClonedFromRoutineRoutines {
+			queries.SetScanner(&rel.ClonedFromRoutineID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ClonedFromRoutine = nil
+		}
+		o.R.ClonedFromRoutineRoutines = nil
+	}
+
+	return o.AddClonedFromRoutineRoutines(ctx, exec, insert, related...)
+}
+
+// RemoveClonedFromRoutineRoutines relationships from objects passed in.
+// Removes related items from R.ClonedFromRoutineRoutines (uses pointer comparison, removal does not keep order)
+// Sets related.R.ClonedFromRoutine.
+func (o *Routine) RemoveClonedFromRoutineRoutines(ctx context.Context, exec boil.ContextExecutor, related ...*Routine) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ClonedFromRoutineID, nil)
+		if rel.R != nil {
+			rel.R.ClonedFromRoutine = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("cloned_from_routine_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ClonedFromRoutineRoutines {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ClonedFromRoutineRoutines)
+			if ln > 1 && i < ln-1 {
+				o.R.ClonedFromRoutineRoutines[i] = o.R.ClonedFromRoutineRoutines[ln-1]
+			}
+			o.R.ClonedFromRoutineRoutines = o.R.ClonedFromRoutineRoutines[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Routines retrieves all the records using an executor.
 func Routines(mods ...qm.QueryMod) routineQuery {
 	mods = append(mods, qm.From("\"getstronger\".\"routines\""))
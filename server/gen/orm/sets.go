@@ -73,58 +73,6 @@ var SetTableColumns = struct {
 
 // Generated where
 
-type whereHelperfloat64 struct{ field string }
-
-func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelperfloat64) IN(slice []float64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperint struct{ field string }
-
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 var SetWhere = struct {
 	ID         whereHelperstring
 	WorkoutID  whereHelperstring
@@ -145,17 +93,20 @@ var SetWhere = struct {
 
 // SetRels is where relationship names are stored.
 var SetRels = struct {
-	Exercise string
-	Workout  string
+	Exercise       string
+	Workout        string
+	PersonalRecord string
 }{
-	Exercise: "Exercise",
-	Workout:  "Workout",
+	Exercise:       "Exercise",
+	Workout:        "Workout",
+	PersonalRecord: "PersonalRecord",
 }
 
 // setR is where relationships are stored.
 type setR struct {
-	Exercise *Exercise `boil:"Exercise" json:"Exercise" toml:"Exercise" yaml:"Exercise"`
-	Workout  *Workout  `boil:"Workout" json:"Workout" toml:"Workout" yaml:"Workout"`
+	Exercise       *Exercise       `boil:"Exercise" json:"Exercise" toml:"Exercise" yaml:"Exercise"`
+	Workout        *Workout        `boil:"Workout" json:"Workout" toml:"Workout" yaml:"Workout"`
+	PersonalRecord *PersonalRecord `boil:"PersonalRecord" json:"PersonalRecord" toml:"PersonalRecord" yaml:"PersonalRecord"`
 }
 
 // NewStruct creates a new relationship struct
@@ -177,6 +128,13 @@ func (r *setR) GetWorkout() *Workout {
 	return r.Workout
 }
 
+func (r *setR) GetPersonalRecord() *PersonalRecord {
+	if r == nil {
+		return nil
+	}
+	return r.PersonalRecord
+}
+
 // setL is where Load methods for each relationship are stored.
 type setL struct{}
 
@@ -515,6 +473,17 @@ func (o *Set) Workout(mods ...qm.QueryMod) workoutQuery {
 	return Workouts(queryMods...)
 }
 
+// PersonalRecord pointed to by the foreign key.
+func (o *Set) PersonalRecord(mods ...qm.QueryMod) personalRecordQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"set_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return PersonalRecords(queryMods...)
+}
+
 // LoadExercise allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (setL) LoadExercise(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSet interface{}, mods queries.Applicator) error {
@@ -755,6 +724,123 @@ func (setL) LoadWorkout(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
+// LoadPersonalRecord allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (setL) LoadPersonalRecord(ctx context.Context, e boil.ContextExecutor, singular bool, maybeSet interface{}, mods queries.Applicator) error {
+	var slice []*Set
+	var object *Set
+
+	if singular {
+		var ok bool
+		object, ok = maybeSet.(*Set)
+		if !ok {
+			object = new(Set)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeSet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeSet))
+			}
+		}
+	} else {
+		s, ok := maybeSet.(*[]*Set)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeSet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeSet))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &setR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &setR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.personal_records`),
+		qm.WhereIn(`getstronger.personal_records.set_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PersonalRecord")
+	}
+
+	var resultSlice []*PersonalRecord
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PersonalRecord")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for personal_records")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for personal_records")
+	}
+
+	if len(personalRecordAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PersonalRecord = foreign
+		if foreign.R == nil {
+			foreign.R = &personalRecordR{}
+		}
+		foreign.R.Set = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.SetID {
+				local.R.PersonalRecord = foreign
+				if foreign.R == nil {
+					foreign.R = &personalRecordR{}
+				}
+				foreign.R.Set = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetExercise of the set to the related item.
 // Sets o.R.Exercise to related.
 // Adds o to related.R.Sets.
@@ -849,6 +935,56 @@ func (o *Set) SetWorkout(ctx context.Context, exec boil.ContextExecutor, insert
 	return nil
 }
 
+// SetPersonalRecord of the set to the related item.
+// Sets o.R.PersonalRecord to related.
+// Adds o to related.R.Set.
+func (o *Set) SetPersonalRecord(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PersonalRecord) error {
+	var err error
+
+	if insert {
+		related.SetID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"getstronger\".\"personal_records\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"set_id"}),
+			strmangle.WhereClause("\"", "\"", 2, personalRecordPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.SetID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &setR{
+			PersonalRecord: related,
+		}
+	} else {
+		o.R.PersonalRecord = related
+	}
+
+	if related.R == nil {
+		related.R = &personalRecordR{
+			Set: o,
+		}
+	} else {
+		related.R.Set = o
+	}
+	return nil
+}
+
 // Sets retrieves all the records using an executor.
 func Sets(mods ...qm.QueryMod) setQuery {
 	mods = append(mods, qm.From("\"getstronger\".\"sets\""))
@@ -0,0 +1,1326 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// UserBlock is an object representing the database table.
+type UserBlock struct {
+	BlockerID string    `boil:"blocker_id" json:"blocker_id" toml:"blocker_id" yaml:"blocker_id"`
+	BlockedID string    `boil:"blocked_id" json:"blocked_id" toml:"blocked_id" yaml:"blocked_id"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *userBlockR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L userBlockL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UserBlockColumns = struct {
+	BlockerID string
+	BlockedID string
+	CreatedAt string
+}{
+	BlockerID: "blocker_id",
+	BlockedID: "blocked_id",
+	CreatedAt: "created_at",
+}
+
+var UserBlockTableColumns = struct {
+	BlockerID string
+	BlockedID string
+	CreatedAt string
+}{
+	BlockerID: "user_blocks.blocker_id",
+	BlockedID: "user_blocks.blocked_id",
+	CreatedAt: "user_blocks.created_at",
+}
+
+// Generated where
+
+var UserBlockWhere = struct {
+	BlockerID whereHelperstring
+	BlockedID whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	BlockerID: whereHelperstring{field: "\"getstronger\".\"user_blocks\".\"blocker_id\""},
+	BlockedID: whereHelperstring{field: "\"getstronger\".\"user_blocks\".\"blocked_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"getstronger\".\"user_blocks\".\"created_at\""},
+}
+
+// UserBlockRels is where relationship names are stored.
+var UserBlockRels = struct {
+	Blocked string
+	Blocker string
+}{
+	Blocked: "Blocked",
+	Blocker: "Blocker",
+}
+
+// userBlockR is where relationships are stored.
+type userBlockR struct {
+	Blocked *User `boil:"Blocked" json:"Blocked" toml:"Blocked" yaml:"Blocked"`
+	Blocker *User `boil:"Blocker" json:"Blocker" toml:"Blocker" yaml:"Blocker"`
+}
+
+// NewStruct creates a new relationship struct
+func (*userBlockR) NewStruct() *userBlockR {
+	return &userBlockR{}
+}
+
+func (r *userBlockR) GetBlocked() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Blocked
+}
+
+func (r *userBlockR) GetBlocker() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Blocker
+}
+
+// userBlockL is where Load methods for each relationship are stored.
+type userBlockL struct{}
+
+var (
+	userBlockAllColumns            = []string{"blocker_id", "blocked_id", "created_at"}
+	userBlockColumnsWithoutDefault = []string{"blocker_id", "blocked_id"}
+	userBlockColumnsWithDefault    = []string{"created_at"}
+	userBlockPrimaryKeyColumns     = []string{"blocker_id", "blocked_id"}
+	userBlockGeneratedColumns      = []string{}
+)
+
+type (
+	// UserBlockSlice is an alias for a slice of pointers to UserBlock.
+	// This should almost always be used instead of []UserBlock.
+	UserBlockSlice []*UserBlock
+	// UserBlockHook is the signature for custom UserBlock hook methods
+	UserBlockHook func(context.Context, boil.ContextExecutor, *UserBlock) error
+
+	userBlockQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	userBlockType                 = reflect.TypeOf(&UserBlock{})
+	userBlockMapping              = queries.MakeStructMapping(userBlockType)
+	userBlockPrimaryKeyMapping, _ = queries.BindMapping(userBlockType, userBlockMapping, userBlockPrimaryKeyColumns)
+	userBlockInsertCacheMut       sync.RWMutex
+	userBlockInsertCache          = make(map[string]insertCache)
+	userBlockUpdateCacheMut       sync.RWMutex
+	userBlockUpdateCache          = make(map[string]updateCache)
+	userBlockUpsertCacheMut       sync.RWMutex
+	userBlockUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var userBlockAfterSelectMu sync.Mutex
+var userBlockAfterSelectHooks []UserBlockHook
+
+var userBlockBeforeInsertMu sync.Mutex
+var userBlockBeforeInsertHooks []UserBlockHook
+var userBlockAfterInsertMu sync.Mutex
+var userBlockAfterInsertHooks []UserBlockHook
+
+var userBlockBeforeUpdateMu sync.Mutex
+var userBlockBeforeUpdateHooks []UserBlockHook
+var userBlockAfterUpdateMu sync.Mutex
+var userBlockAfterUpdateHooks []UserBlockHook
+
+var userBlockBeforeDeleteMu sync.Mutex
+var userBlockBeforeDeleteHooks []UserBlockHook
+var userBlockAfterDeleteMu sync.Mutex
+var userBlockAfterDeleteHooks []UserBlockHook
+
+var userBlockBeforeUpsertMu sync.Mutex
+var userBlockBeforeUpsertHooks []UserBlockHook
+var userBlockAfterUpsertMu sync.Mutex
+var userBlockAfterUpsertHooks []UserBlockHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UserBlock) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userBlockAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UserBlock) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userBlockBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UserBlock) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userBlockAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UserBlock) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userBlockBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UserBlock) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userBlockAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UserBlock) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userBlockBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UserBlock) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userBlockAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UserBlock) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userBlockBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UserBlock) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userBlockAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserBlockHook registers your hook function for all future operations.
+func AddUserBlockHook(hookPoint boil.HookPoint, userBlockHook UserBlockHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		userBlockAfterSelectMu.Lock()
+		userBlockAfterSelectHooks = append(userBlockAfterSelectHooks, userBlockHook)
+		userBlockAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		userBlockBeforeInsertMu.Lock()
+		userBlockBeforeInsertHooks = append(userBlockBeforeInsertHooks, userBlockHook)
+		userBlockBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		userBlockAfterInsertMu.Lock()
+		userBlockAfterInsertHooks = append(userBlockAfterInsertHooks, userBlockHook)
+		userBlockAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		userBlockBeforeUpdateMu.Lock()
+		userBlockBeforeUpdateHooks = append(userBlockBeforeUpdateHooks, userBlockHook)
+		userBlockBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		userBlockAfterUpdateMu.Lock()
+		userBlockAfterUpdateHooks = append(userBlockAfterUpdateHooks, userBlockHook)
+		userBlockAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		userBlockBeforeDeleteMu.Lock()
+		userBlockBeforeDeleteHooks = append(userBlockBeforeDeleteHooks, userBlockHook)
+		userBlockBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		userBlockAfterDeleteMu.Lock()
+		userBlockAfterDeleteHooks = append(userBlockAfterDeleteHooks, userBlockHook)
+		userBlockAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		userBlockBeforeUpsertMu.Lock()
+		userBlockBeforeUpsertHooks = append(userBlockBeforeUpsertHooks, userBlockHook)
+		userBlockBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		userBlockAfterUpsertMu.Lock()
+		userBlockAfterUpsertHooks = append(userBlockAfterUpsertHooks, userBlockHook)
+		userBlockAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single userBlock record from the query.
+func (q userBlockQuery) One(ctx context.Context, exec boil.ContextExecutor) (*UserBlock, error) {
+	o := &UserBlock{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for user_blocks")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all UserBlock records from the query.
+func (q userBlockQuery) All(ctx context.Context, exec boil.ContextExecutor) (UserBlockSlice, error) {
+	var o []*UserBlock
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to UserBlock slice")
+	}
+
+	if len(userBlockAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UserBlock records in the query.
+func (q userBlockQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count user_blocks rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q userBlockQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if user_blocks exists")
+	}
+
+	return count > 0, nil
+}
+
+// Blocked pointed to by the foreign key.
+func (o *UserBlock) Blocked(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BlockedID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Blocker pointed to by the foreign key.
+func (o *UserBlock) Blocker(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BlockerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadBlocked allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userBlockL) LoadBlocked(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserBlock interface{}, mods queries.Applicator) error {
+	var slice []*UserBlock
+	var object *UserBlock
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserBlock.(*UserBlock)
+		if !ok {
+			object = new(UserBlock)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserBlock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserBlock))
+			}
+		}
+	} else {
+		s, ok := maybeUserBlock.(*[]*UserBlock)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserBlock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserBlock))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userBlockR{}
+		}
+		args[object.BlockedID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userBlockR{}
+			}
+
+			args[obj.BlockedID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Blocked = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.BlockedUserBlocks = append(foreign.R.BlockedUserBlocks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BlockedID == foreign.ID {
+				local.R.Blocked = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.BlockedUserBlocks = append(foreign.R.BlockedUserBlocks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBlocker allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userBlockL) LoadBlocker(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserBlock interface{}, mods queries.Applicator) error {
+	var slice []*UserBlock
+	var object *UserBlock
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserBlock.(*UserBlock)
+		if !ok {
+			object = new(UserBlock)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserBlock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserBlock))
+			}
+		}
+	} else {
+		s, ok := maybeUserBlock.(*[]*UserBlock)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserBlock)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserBlock))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userBlockR{}
+		}
+		args[object.BlockerID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userBlockR{}
+			}
+
+			args[obj.BlockerID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Blocker = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.BlockerUserBlocks = append(foreign.R.BlockerUserBlocks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BlockerID == foreign.ID {
+				local.R.Blocker = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.BlockerUserBlocks = append(foreign.R.BlockerUserBlocks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBlocked of the userBlock to the related item.
+// Sets o.R.Blocked to related.
+// Adds o to related.R.BlockedUserBlocks.
+func (o *UserBlock) SetBlocked(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"user_blocks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"blocked_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userBlockPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.BlockerID, o.BlockedID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BlockedID = related.ID
+	if o.R == nil {
+		o.R = &userBlockR{
+			Blocked: related,
+		}
+	} else {
+		o.R.Blocked = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			BlockedUserBlocks: UserBlockSlice{o},
+		}
+	} else {
+		related.R.BlockedUserBlocks = append(related.R.BlockedUserBlocks, o)
+	}
+
+	return nil
+}
+
+// SetBlocker of the userBlock to the related item.
+// Sets o.R.Blocker to related.
+// Adds o to related.R.BlockerUserBlocks.
+func (o *UserBlock) SetBlocker(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"user_blocks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"blocker_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userBlockPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.BlockerID, o.BlockedID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BlockerID = related.ID
+	if o.R == nil {
+		o.R = &userBlockR{
+			Blocker: related,
+		}
+	} else {
+		o.R.Blocker = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			BlockerUserBlocks: UserBlockSlice{o},
+		}
+	} else {
+		related.R.BlockerUserBlocks = append(related.R.BlockerUserBlocks, o)
+	}
+
+	return nil
+}
+
+// UserBlocks retrieves all the records using an executor.
+func UserBlocks(mods ...qm.QueryMod) userBlockQuery {
+	mods = append(mods, qm.From("\"getstronger\".\"user_blocks\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"getstronger\".\"user_blocks\".*"})
+	}
+
+	return userBlockQuery{q}
+}
+
+// FindUserBlock retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUserBlock(ctx context.Context, exec boil.ContextExecutor, blockerID string, blockedID string, selectCols ...string) (*UserBlock, error) {
+	userBlockObj := &UserBlock{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"getstronger\".\"user_blocks\" where \"blocker_id\"=$1 AND \"blocked_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, blockerID, blockedID)
+
+	err := q.Bind(ctx, exec, userBlockObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from user_blocks")
+	}
+
+	if err = userBlockObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return userBlockObj, err
+	}
+
+	return userBlockObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UserBlock) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no user_blocks provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userBlockColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userBlockInsertCacheMut.RLock()
+	cache, cached := userBlockInsertCache[key]
+	userBlockInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userBlockAllColumns,
+			userBlockColumnsWithDefault,
+			userBlockColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userBlockType, userBlockMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userBlockType, userBlockMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"getstronger\".\"user_blocks\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"getstronger\".\"user_blocks\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into user_blocks")
+	}
+
+	if !cached {
+		userBlockInsertCacheMut.Lock()
+		userBlockInsertCache[key] = cache
+		userBlockInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the UserBlock.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UserBlock) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	userBlockUpdateCacheMut.RLock()
+	cache, cached := userBlockUpdateCache[key]
+	userBlockUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userBlockAllColumns,
+			userBlockPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update user_blocks, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"getstronger\".\"user_blocks\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, userBlockPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userBlockType, userBlockMapping, append(wl, userBlockPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update user_blocks row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for user_blocks")
+	}
+
+	if !cached {
+		userBlockUpdateCacheMut.Lock()
+		userBlockUpdateCache[key] = cache
+		userBlockUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userBlockQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for user_blocks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for user_blocks")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserBlockSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userBlockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"getstronger\".\"user_blocks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, userBlockPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in userBlock slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all userBlock")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UserBlock) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no user_blocks provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userBlockColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userBlockUpsertCacheMut.RLock()
+	cache, cached := userBlockUpsertCache[key]
+	userBlockUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			userBlockAllColumns,
+			userBlockColumnsWithDefault,
+			userBlockColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			userBlockAllColumns,
+			userBlockPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert user_blocks, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(userBlockAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(userBlockPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert user_blocks, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(userBlockPrimaryKeyColumns))
+			copy(conflict, userBlockPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"getstronger\".\"user_blocks\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(userBlockType, userBlockMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userBlockType, userBlockMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert user_blocks")
+	}
+
+	if !cached {
+		userBlockUpsertCacheMut.Lock()
+		userBlockUpsertCache[key] = cache
+		userBlockUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single UserBlock record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UserBlock) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no UserBlock provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userBlockPrimaryKeyMapping)
+	sql := "DELETE FROM \"getstronger\".\"user_blocks\" WHERE \"blocker_id\"=$1 AND \"blocked_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from user_blocks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for user_blocks")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q userBlockQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no userBlockQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from user_blocks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for user_blocks")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserBlockSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(userBlockBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userBlockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"getstronger\".\"user_blocks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userBlockPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from userBlock slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for user_blocks")
+	}
+
+	if len(userBlockAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UserBlock) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUserBlock(ctx, exec, o.BlockerID, o.BlockedID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserBlockSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserBlockSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userBlockPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"getstronger\".\"user_blocks\".* FROM \"getstronger\".\"user_blocks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userBlockPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in UserBlockSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserBlockExists checks if the UserBlock row exists.
+func UserBlockExists(ctx context.Context, exec boil.ContextExecutor, blockerID string, blockedID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"getstronger\".\"user_blocks\" where \"blocker_id\"=$1 AND \"blocked_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, blockerID, blockedID)
+	}
+	row := exec.QueryRowContext(ctx, sql, blockerID, blockedID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if user_blocks exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the UserBlock row exists.
+func (o *UserBlock) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return UserBlockExists(ctx, exec, o.BlockerID, o.BlockedID)
+}
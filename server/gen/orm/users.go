@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,98 +24,286 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID             string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	FirstName      string    `boil:"first_name" json:"first_name" toml:"first_name" yaml:"first_name"`
-	LastName       string    `boil:"last_name" json:"last_name" toml:"last_name" yaml:"last_name"`
-	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	FullNameSearch string    `boil:"full_name_search" json:"full_name_search" toml:"full_name_search" yaml:"full_name_search"`
-	AuthID         string    `boil:"auth_id" json:"auth_id" toml:"auth_id" yaml:"auth_id"`
+	ID                       string            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	FirstName                string            `boil:"first_name" json:"first_name" toml:"first_name" yaml:"first_name"`
+	LastName                 string            `boil:"last_name" json:"last_name" toml:"last_name" yaml:"last_name"`
+	CreatedAt                time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	FullNameSearch           string            `boil:"full_name_search" json:"full_name_search" toml:"full_name_search" yaml:"full_name_search"`
+	AuthID                   string            `boil:"auth_id" json:"auth_id" toml:"auth_id" yaml:"auth_id"`
+	Sex                      NullSex           `boil:"sex" json:"sex,omitempty" toml:"sex" yaml:"sex,omitempty"`
+	BirthDate                null.Time         `boil:"birth_date" json:"birth_date,omitempty" toml:"birth_date" yaml:"birth_date,omitempty"`
+	Bodyweight               null.Float64      `boil:"bodyweight" json:"bodyweight,omitempty" toml:"bodyweight" yaml:"bodyweight,omitempty"`
+	DeletionRequestedAt      null.Time         `boil:"deletion_requested_at" json:"deletion_requested_at,omitempty" toml:"deletion_requested_at" yaml:"deletion_requested_at,omitempty"`
+	DeletedAt                null.Time         `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	Private                  bool              `boil:"private" json:"private" toml:"private" yaml:"private"`
+	DefaultWorkoutVisibility WorkoutVisibility `boil:"default_workout_visibility" json:"default_workout_visibility" toml:"default_workout_visibility" yaml:"default_workout_visibility"`
+	Admin                    bool              `boil:"admin" json:"admin" toml:"admin" yaml:"admin"`
+	SuspendedAt              null.Time         `boil:"suspended_at" json:"suspended_at,omitempty" toml:"suspended_at" yaml:"suspended_at,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID             string
-	FirstName      string
-	LastName       string
-	CreatedAt      string
-	FullNameSearch string
-	AuthID         string
+	ID                       string
+	FirstName                string
+	LastName                 string
+	CreatedAt                string
+	FullNameSearch           string
+	AuthID                   string
+	Sex                      string
+	BirthDate                string
+	Bodyweight               string
+	DeletionRequestedAt      string
+	DeletedAt                string
+	Private                  string
+	DefaultWorkoutVisibility string
+	Admin                    string
+	SuspendedAt              string
 }{
-	ID:             "id",
-	FirstName:      "first_name",
-	LastName:       "last_name",
-	CreatedAt:      "created_at",
-	FullNameSearch: "full_name_search",
-	AuthID:         "auth_id",
+	ID:                       "id",
+	FirstName:                "first_name",
+	LastName:                 "last_name",
+	CreatedAt:                "created_at",
+	FullNameSearch:           "full_name_search",
+	AuthID:                   "auth_id",
+	Sex:                      "sex",
+	BirthDate:                "birth_date",
+	Bodyweight:               "bodyweight",
+	DeletionRequestedAt:      "deletion_requested_at",
+	DeletedAt:                "deleted_at",
+	Private:                  "private",
+	DefaultWorkoutVisibility: "default_workout_visibility",
+	Admin:                    "admin",
+	SuspendedAt:              "suspended_at",
 }
 
 var UserTableColumns = struct {
-	ID             string
-	FirstName      string
-	LastName       string
-	CreatedAt      string
-	FullNameSearch string
-	AuthID         string
+	ID                       string
+	FirstName                string
+	LastName                 string
+	CreatedAt                string
+	FullNameSearch           string
+	AuthID                   string
+	Sex                      string
+	BirthDate                string
+	Bodyweight               string
+	DeletionRequestedAt      string
+	DeletedAt                string
+	Private                  string
+	DefaultWorkoutVisibility string
+	Admin                    string
+	SuspendedAt              string
 }{
-	ID:             "users.id",
-	FirstName:      "users.first_name",
-	LastName:       "users.last_name",
-	CreatedAt:      "users.created_at",
-	FullNameSearch: "users.full_name_search",
-	AuthID:         "users.auth_id",
+	ID:                       "users.id",
+	FirstName:                "users.first_name",
+	LastName:                 "users.last_name",
+	CreatedAt:                "users.created_at",
+	FullNameSearch:           "users.full_name_search",
+	AuthID:                   "users.auth_id",
+	Sex:                      "users.sex",
+	BirthDate:                "users.birth_date",
+	Bodyweight:               "users.bodyweight",
+	DeletionRequestedAt:      "users.deletion_requested_at",
+	DeletedAt:                "users.deleted_at",
+	Private:                  "users.private",
+	DefaultWorkoutVisibility: "users.default_workout_visibility",
+	Admin:                    "users.admin",
+	SuspendedAt:              "users.suspended_at",
 }
 
 // Generated where
 
+type whereHelperNullSex struct{ field string }
+
+func (w whereHelperNullSex) EQ(x NullSex) qm.QueryMod  { return qmhelper.WhereNullEQ(w.field, false, x) }
+func (w whereHelperNullSex) NEQ(x NullSex) qm.QueryMod { return qmhelper.WhereNullEQ(w.field, true, x) }
+func (w whereHelperNullSex) LT(x NullSex) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperNullSex) LTE(x NullSex) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperNullSex) GT(x NullSex) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperNullSex) GTE(x NullSex) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperNullSex) IN(slice []NullSex) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperNullSex) NIN(slice []NullSex) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelperNullSex) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelperNullSex) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelperWorkoutVisibility struct{ field string }
+
+func (w whereHelperWorkoutVisibility) EQ(x WorkoutVisibility) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperWorkoutVisibility) NEQ(x WorkoutVisibility) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperWorkoutVisibility) LT(x WorkoutVisibility) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperWorkoutVisibility) LTE(x WorkoutVisibility) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperWorkoutVisibility) GT(x WorkoutVisibility) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperWorkoutVisibility) GTE(x WorkoutVisibility) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperWorkoutVisibility) IN(slice []WorkoutVisibility) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperWorkoutVisibility) NIN(slice []WorkoutVisibility) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
 var UserWhere = struct {
-	ID             whereHelperstring
-	FirstName      whereHelperstring
-	LastName       whereHelperstring
-	CreatedAt      whereHelpertime_Time
-	FullNameSearch whereHelperstring
-	AuthID         whereHelperstring
+	ID                       whereHelperstring
+	FirstName                whereHelperstring
+	LastName                 whereHelperstring
+	CreatedAt                whereHelpertime_Time
+	FullNameSearch           whereHelperstring
+	AuthID                   whereHelperstring
+	Sex                      whereHelperNullSex
+	BirthDate                whereHelpernull_Time
+	Bodyweight               whereHelpernull_Float64
+	DeletionRequestedAt      whereHelpernull_Time
+	DeletedAt                whereHelpernull_Time
+	Private                  whereHelperbool
+	DefaultWorkoutVisibility whereHelperWorkoutVisibility
+	Admin                    whereHelperbool
+	SuspendedAt              whereHelpernull_Time
 }{
-	ID:             whereHelperstring{field: "\"getstronger\".\"users\".\"id\""},
-	FirstName:      whereHelperstring{field: "\"getstronger\".\"users\".\"first_name\""},
-	LastName:       whereHelperstring{field: "\"getstronger\".\"users\".\"last_name\""},
-	CreatedAt:      whereHelpertime_Time{field: "\"getstronger\".\"users\".\"created_at\""},
-	FullNameSearch: whereHelperstring{field: "\"getstronger\".\"users\".\"full_name_search\""},
-	AuthID:         whereHelperstring{field: "\"getstronger\".\"users\".\"auth_id\""},
+	ID:                       whereHelperstring{field: "\"getstronger\".\"users\".\"id\""},
+	FirstName:                whereHelperstring{field: "\"getstronger\".\"users\".\"first_name\""},
+	LastName:                 whereHelperstring{field: "\"getstronger\".\"users\".\"last_name\""},
+	CreatedAt:                whereHelpertime_Time{field: "\"getstronger\".\"users\".\"created_at\""},
+	FullNameSearch:           whereHelperstring{field: "\"getstronger\".\"users\".\"full_name_search\""},
+	AuthID:                   whereHelperstring{field: "\"getstronger\".\"users\".\"auth_id\""},
+	Sex:                      whereHelperNullSex{field: "\"getstronger\".\"users\".\"sex\""},
+	BirthDate:                whereHelpernull_Time{field: "\"getstronger\".\"users\".\"birth_date\""},
+	Bodyweight:               whereHelpernull_Float64{field: "\"getstronger\".\"users\".\"bodyweight\""},
+	DeletionRequestedAt:      whereHelpernull_Time{field: "\"getstronger\".\"users\".\"deletion_requested_at\""},
+	DeletedAt:                whereHelpernull_Time{field: "\"getstronger\".\"users\".\"deleted_at\""},
+	Private:                  whereHelperbool{field: "\"getstronger\".\"users\".\"private\""},
+	DefaultWorkoutVisibility: whereHelperWorkoutVisibility{field: "\"getstronger\".\"users\".\"default_workout_visibility\""},
+	Admin:                    whereHelperbool{field: "\"getstronger\".\"users\".\"admin\""},
+	SuspendedAt:              whereHelpernull_Time{field: "\"getstronger\".\"users\".\"suspended_at\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	Auth            string
-	Exercises       string
-	FollowerUsers   string
-	FolloweeUsers   string
-	Notifications   string
-	Routines        string
-	WorkoutComments string
-	Workouts        string
+	Auth                   string
+	Achievements           string
+	Bodyweights            string
+	ChallengeParticipants  string
+	CreatorChallenges      string
+	LeaderChallenges       string
+	ClubMembers            string
+	AthleteCoachActions    string
+	CoachCoachActions      string
+	AthleteCoaches         string
+	CoachCoaches           string
+	DataExports            string
+	Exercises              string
+	FolloweeFollowRequests string
+	FollowerFollowRequests string
+	FolloweeFollowers      string
+	FollowerFollowers      string
+	Notifications          string
+	PersonalRecords        string
+	ReporterReports        string
+	ResolvedByReports      string
+	Routines               string
+	BlockedUserBlocks      string
+	BlockerUserBlocks      string
+	WorkoutComments        string
+	WorkoutReactions       string
+	Workouts               string
+	YearSummaries          string
 }{
-	Auth:            "Auth",
-	Exercises:       "Exercises",
-	FollowerUsers:   "FollowerUsers",
-	FolloweeUsers:   "FolloweeUsers",
-	Notifications:   "Notifications",
-	Routines:        "Routines",
-	WorkoutComments: "WorkoutComments",
-	Workouts:        "Workouts",
+	Auth:                   "Auth",
+	Achievements:           "Achievements",
+	Bodyweights:            "Bodyweights",
+	ChallengeParticipants:  "ChallengeParticipants",
+	CreatorChallenges:      "CreatorChallenges",
+	LeaderChallenges:       "LeaderChallenges",
+	ClubMembers:            "ClubMembers",
+	AthleteCoachActions:    "AthleteCoachActions",
+	CoachCoachActions:      "CoachCoachActions",
+	AthleteCoaches:         "AthleteCoaches",
+	CoachCoaches:           "CoachCoaches",
+	DataExports:            "DataExports",
+	Exercises:              "Exercises",
+	FolloweeFollowRequests: "FolloweeFollowRequests",
+	FollowerFollowRequests: "FollowerFollowRequests",
+	FolloweeFollowers:      "FolloweeFollowers",
+	FollowerFollowers:      "FollowerFollowers",
+	Notifications:          "Notifications",
+	PersonalRecords:        "PersonalRecords",
+	ReporterReports:        "ReporterReports",
+	ResolvedByReports:      "ResolvedByReports",
+	Routines:               "Routines",
+	BlockedUserBlocks:      "BlockedUserBlocks",
+	BlockerUserBlocks:      "BlockerUserBlocks",
+	WorkoutComments:        "WorkoutComments",
+	WorkoutReactions:       "WorkoutReactions",
+	Workouts:               "Workouts",
+	YearSummaries:          "YearSummaries",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	Auth            *Auth               `boil:"Auth" json:"Auth" toml:"Auth" yaml:"Auth"`
-	Exercises       ExerciseSlice       `boil:"Exercises" json:"Exercises" toml:"Exercises" yaml:"Exercises"`
-	FollowerUsers   UserSlice           `boil:"FollowerUsers" json:"FollowerUsers" toml:"FollowerUsers" yaml:"FollowerUsers"`
-	FolloweeUsers   UserSlice           `boil:"FolloweeUsers" json:"FolloweeUsers" toml:"FolloweeUsers" yaml:"FolloweeUsers"`
-	Notifications   NotificationSlice   `boil:"Notifications" json:"Notifications" toml:"Notifications" yaml:"Notifications"`
-	Routines        RoutineSlice        `boil:"Routines" json:"Routines" toml:"Routines" yaml:"Routines"`
-	WorkoutComments WorkoutCommentSlice `boil:"WorkoutComments" json:"WorkoutComments" toml:"WorkoutComments" yaml:"WorkoutComments"`
-	Workouts        WorkoutSlice        `boil:"Workouts" json:"Workouts" toml:"Workouts" yaml:"Workouts"`
+	Auth                   *Auth                     `boil:"Auth" json:"Auth" toml:"Auth" yaml:"Auth"`
+	Achievements           AchievementSlice          `boil:"Achievements" json:"Achievements" toml:"Achievements" yaml:"Achievements"`
+	Bodyweights            BodyweightSlice           `boil:"Bodyweights" json:"Bodyweights" toml:"Bodyweights" yaml:"Bodyweights"`
+	ChallengeParticipants  ChallengeParticipantSlice `boil:"ChallengeParticipants" json:"ChallengeParticipants" toml:"ChallengeParticipants" yaml:"ChallengeParticipants"`
+	CreatorChallenges      ChallengeSlice            `boil:"CreatorChallenges" json:"CreatorChallenges" toml:"CreatorChallenges" yaml:"CreatorChallenges"`
+	LeaderChallenges       ChallengeSlice            `boil:"LeaderChallenges" json:"LeaderChallenges" toml:"LeaderChallenges" yaml:"LeaderChallenges"`
+	ClubMembers            ClubMemberSlice           `boil:"ClubMembers" json:"ClubMembers" toml:"ClubMembers" yaml:"ClubMembers"`
+	AthleteCoachActions    CoachActionSlice          `boil:"AthleteCoachActions" json:"AthleteCoachActions" toml:"AthleteCoachActions" yaml:"AthleteCoachActions"`
+	CoachCoachActions      CoachActionSlice          `boil:"CoachCoachActions" json:"CoachCoachActions" toml:"CoachCoachActions" yaml:"CoachCoachActions"`
+	AthleteCoaches         CoachSlice                `boil:"AthleteCoaches" json:"AthleteCoaches" toml:"AthleteCoaches" yaml:"AthleteCoaches"`
+	CoachCoaches           CoachSlice                `boil:"CoachCoaches" json:"CoachCoaches" toml:"CoachCoaches" yaml:"CoachCoaches"`
+	DataExports            DataExportSlice           `boil:"DataExports" json:"DataExports" toml:"DataExports" yaml:"DataExports"`
+	Exercises              ExerciseSlice             `boil:"Exercises" json:"Exercises" toml:"Exercises" yaml:"Exercises"`
+	FolloweeFollowRequests FollowRequestSlice        `boil:"FolloweeFollowRequests" json:"FolloweeFollowRequests" toml:"FolloweeFollowRequests" yaml:"FolloweeFollowRequests"`
+	FollowerFollowRequests FollowRequestSlice        `boil:"FollowerFollowRequests" json:"FollowerFollowRequests" toml:"FollowerFollowRequests" yaml:"FollowerFollowRequests"`
+	FolloweeFollowers      FollowerSlice             `boil:"FolloweeFollowers" json:"FolloweeFollowers" toml:"FolloweeFollowers" yaml:"FolloweeFollowers"`
+	FollowerFollowers      FollowerSlice             `boil:"FollowerFollowers" json:"FollowerFollowers" toml:"FollowerFollowers" yaml:"FollowerFollowers"`
+	Notifications          NotificationSlice         `boil:"Notifications" json:"Notifications" toml:"Notifications" yaml:"Notifications"`
+	PersonalRecords        PersonalRecordSlice       `boil:"PersonalRecords" json:"PersonalRecords" toml:"PersonalRecords" yaml:"PersonalRecords"`
+	ReporterReports        ReportSlice               `boil:"ReporterReports" json:"ReporterReports" toml:"ReporterReports" yaml:"ReporterReports"`
+	ResolvedByReports      ReportSlice               `boil:"ResolvedByReports" json:"ResolvedByReports" toml:"ResolvedByReports" yaml:"ResolvedByReports"`
+	Routines               RoutineSlice              `boil:"Routines" json:"Routines" toml:"Routines" yaml:"Routines"`
+	BlockedUserBlocks      UserBlockSlice            `boil:"BlockedUserBlocks" json:"BlockedUserBlocks" toml:"BlockedUserBlocks" yaml:"BlockedUserBlocks"`
+	BlockerUserBlocks      UserBlockSlice            `boil:"BlockerUserBlocks" json:"BlockerUserBlocks" toml:"BlockerUserBlocks" yaml:"BlockerUserBlocks"`
+	WorkoutComments        WorkoutCommentSlice       `boil:"WorkoutComments" json:"WorkoutComments" toml:"WorkoutComments" yaml:"WorkoutComments"`
+	WorkoutReactions       WorkoutReactionSlice      `boil:"WorkoutReactions" json:"WorkoutReactions" toml:"WorkoutReactions" yaml:"WorkoutReactions"`
+	Workouts               WorkoutSlice              `boil:"Workouts" json:"Workouts" toml:"Workouts" yaml:"Workouts"`
+	YearSummaries          YearSummarySlice          `boil:"YearSummaries" json:"YearSummaries" toml:"YearSummaries" yaml:"YearSummaries"`
 }
 
 // NewStruct creates a new relationship struct
@@ -129,6 +318,83 @@ func (r *userR) GetAuth() *Auth {
 	return r.Auth
 }
 
+func (r *userR) GetAchievements() AchievementSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Achievements
+}
+
+func (r *userR) GetBodyweights() BodyweightSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Bodyweights
+}
+
+func (r *userR) GetChallengeParticipants() ChallengeParticipantSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ChallengeParticipants
+}
+
+func (r *userR) GetCreatorChallenges() ChallengeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CreatorChallenges
+}
+
+func (r *userR) GetLeaderChallenges() ChallengeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.LeaderChallenges
+}
+
+func (r *userR) GetClubMembers() ClubMemberSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ClubMembers
+}
+
+func (r *userR) GetAthleteCoachActions() CoachActionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AthleteCoachActions
+}
+
+func (r *userR) GetCoachCoachActions() CoachActionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CoachCoachActions
+}
+
+func (r *userR) GetAthleteCoaches() CoachSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AthleteCoaches
+}
+
+func (r *userR) GetCoachCoaches() CoachSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CoachCoaches
+}
+
+func (r *userR) GetDataExports() DataExportSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DataExports
+}
+
 func (r *userR) GetExercises() ExerciseSlice {
 	if r == nil {
 		return nil
@@ -136,18 +402,32 @@ func (r *userR) GetExercises() ExerciseSlice {
 	return r.Exercises
 }
 
-func (r *userR) GetFollowerUsers() UserSlice {
+func (r *userR) GetFolloweeFollowRequests() FollowRequestSlice {
+	if r == nil {
+		return nil
+	}
+	return r.FolloweeFollowRequests
+}
+
+func (r *userR) GetFollowerFollowRequests() FollowRequestSlice {
+	if r == nil {
+		return nil
+	}
+	return r.FollowerFollowRequests
+}
+
+func (r *userR) GetFolloweeFollowers() FollowerSlice {
 	if r == nil {
 		return nil
 	}
-	return r.FollowerUsers
+	return r.FolloweeFollowers
 }
 
-func (r *userR) GetFolloweeUsers() UserSlice {
+func (r *userR) GetFollowerFollowers() FollowerSlice {
 	if r == nil {
 		return nil
 	}
-	return r.FolloweeUsers
+	return r.FollowerFollowers
 }
 
 func (r *userR) GetNotifications() NotificationSlice {
@@ -157,6 +437,27 @@ func (r *userR) GetNotifications() NotificationSlice {
 	return r.Notifications
 }
 
+func (r *userR) GetPersonalRecords() PersonalRecordSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PersonalRecords
+}
+
+func (r *userR) GetReporterReports() ReportSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ReporterReports
+}
+
+func (r *userR) GetResolvedByReports() ReportSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ResolvedByReports
+}
+
 func (r *userR) GetRoutines() RoutineSlice {
 	if r == nil {
 		return nil
@@ -164,6 +465,20 @@ func (r *userR) GetRoutines() RoutineSlice {
 	return r.Routines
 }
 
+func (r *userR) GetBlockedUserBlocks() UserBlockSlice {
+	if r == nil {
+		return nil
+	}
+	return r.BlockedUserBlocks
+}
+
+func (r *userR) GetBlockerUserBlocks() UserBlockSlice {
+	if r == nil {
+		return nil
+	}
+	return r.BlockerUserBlocks
+}
+
 func (r *userR) GetWorkoutComments() WorkoutCommentSlice {
 	if r == nil {
 		return nil
@@ -171,6 +486,13 @@ func (r *userR) GetWorkoutComments() WorkoutCommentSlice {
 	return r.WorkoutComments
 }
 
+func (r *userR) GetWorkoutReactions() WorkoutReactionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.WorkoutReactions
+}
+
 func (r *userR) GetWorkouts() WorkoutSlice {
 	if r == nil {
 		return nil
@@ -178,13 +500,20 @@ func (r *userR) GetWorkouts() WorkoutSlice {
 	return r.Workouts
 }
 
+func (r *userR) GetYearSummaries() YearSummarySlice {
+	if r == nil {
+		return nil
+	}
+	return r.YearSummaries
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "first_name", "last_name", "created_at", "full_name_search", "auth_id"}
+	userAllColumns            = []string{"id", "first_name", "last_name", "created_at", "full_name_search", "auth_id", "sex", "birth_date", "bodyweight", "deletion_requested_at", "deleted_at", "private", "default_workout_visibility", "admin", "suspended_at"}
 	userColumnsWithoutDefault = []string{"first_name", "last_name", "auth_id"}
-	userColumnsWithDefault    = []string{"id", "created_at", "full_name_search"}
+	userColumnsWithDefault    = []string{"id", "created_at", "full_name_search", "sex", "birth_date", "bodyweight", "deletion_requested_at", "deleted_at", "private", "default_workout_visibility", "admin", "suspended_at"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{"full_name_search"}
 )
@@ -505,229 +834,387 @@ func (o *User) Auth(mods ...qm.QueryMod) authQuery {
 	return Auths(queryMods...)
 }
 
-// Exercises retrieves all the exercise's Exercises with an executor.
-func (o *User) Exercises(mods ...qm.QueryMod) exerciseQuery {
+// Achievements retrieves all the achievement's Achievements with an executor.
+func (o *User) Achievements(mods ...qm.QueryMod) achievementQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"getstronger\".\"exercises\".\"user_id\"=?", o.ID),
+		qm.Where("\"getstronger\".\"achievements\".\"user_id\"=?", o.ID),
 	)
 
-	return Exercises(queryMods...)
+	return Achievements(queryMods...)
 }
 
-// FollowerUsers retrieves all the user's Users with an executor via id column.
-func (o *User) FollowerUsers(mods ...qm.QueryMod) userQuery {
+// Bodyweights retrieves all the bodyweight's Bodyweights with an executor.
+func (o *User) Bodyweights(mods ...qm.QueryMod) bodyweightQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.InnerJoin("\"getstronger\".\"followers\" on \"getstronger\".\"users\".\"id\" = \"getstronger\".\"followers\".\"follower_id\""),
-		qm.Where("\"getstronger\".\"followers\".\"followee_id\"=?", o.ID),
+		qm.Where("\"getstronger\".\"bodyweights\".\"user_id\"=?", o.ID),
 	)
 
-	return Users(queryMods...)
+	return Bodyweights(queryMods...)
 }
 
-// FolloweeUsers retrieves all the user's Users with an executor via id column.
-func (o *User) FolloweeUsers(mods ...qm.QueryMod) userQuery {
+// ChallengeParticipants retrieves all the challenge_participant's ChallengeParticipants with an executor.
+func (o *User) ChallengeParticipants(mods ...qm.QueryMod) challengeParticipantQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.InnerJoin("\"getstronger\".\"followers\" on \"getstronger\".\"users\".\"id\" = \"getstronger\".\"followers\".\"followee_id\""),
-		qm.Where("\"getstronger\".\"followers\".\"follower_id\"=?", o.ID),
+		qm.Where("\"getstronger\".\"challenge_participants\".\"user_id\"=?", o.ID),
 	)
 
-	return Users(queryMods...)
+	return ChallengeParticipants(queryMods...)
 }
 
-// Notifications retrieves all the notification's Notifications with an executor.
-func (o *User) Notifications(mods ...qm.QueryMod) notificationQuery {
+// CreatorChallenges retrieves all the challenge's Challenges with an executor via creator_id column.
+func (o *User) CreatorChallenges(mods ...qm.QueryMod) challengeQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"getstronger\".\"notifications\".\"user_id\"=?", o.ID),
+		qm.Where("\"getstronger\".\"challenges\".\"creator_id\"=?", o.ID),
 	)
 
-	return Notifications(queryMods...)
+	return Challenges(queryMods...)
 }
 
-// Routines retrieves all the routine's Routines with an executor.
-func (o *User) Routines(mods ...qm.QueryMod) routineQuery {
+// LeaderChallenges retrieves all the challenge's Challenges with an executor via leader_id column.
+func (o *User) LeaderChallenges(mods ...qm.QueryMod) challengeQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"getstronger\".\"routines\".\"user_id\"=?", o.ID),
+		qm.Where("\"getstronger\".\"challenges\".\"leader_id\"=?", o.ID),
 	)
 
-	return Routines(queryMods...)
+	return Challenges(queryMods...)
 }
 
-// WorkoutComments retrieves all the workout_comment's WorkoutComments with an executor.
-func (o *User) WorkoutComments(mods ...qm.QueryMod) workoutCommentQuery {
+// ClubMembers retrieves all the club_member's ClubMembers with an executor.
+func (o *User) ClubMembers(mods ...qm.QueryMod) clubMemberQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"getstronger\".\"workout_comments\".\"user_id\"=?", o.ID),
+		qm.Where("\"getstronger\".\"club_members\".\"user_id\"=?", o.ID),
 	)
 
-	return WorkoutComments(queryMods...)
+	return ClubMembers(queryMods...)
 }
 
-// Workouts retrieves all the workout's Workouts with an executor.
-func (o *User) Workouts(mods ...qm.QueryMod) workoutQuery {
+// AthleteCoachActions retrieves all the coach_action's CoachActions with an executor via athlete_id column.
+func (o *User) AthleteCoachActions(mods ...qm.QueryMod) coachActionQuery {
 	var queryMods []qm.QueryMod
 	if len(mods) != 0 {
 		queryMods = append(queryMods, mods...)
 	}
 
 	queryMods = append(queryMods,
-		qm.Where("\"getstronger\".\"workouts\".\"user_id\"=?", o.ID),
+		qm.Where("\"getstronger\".\"coach_actions\".\"athlete_id\"=?", o.ID),
 	)
 
-	return Workouts(queryMods...)
+	return CoachActions(queryMods...)
 }
 
-// LoadAuth allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (userL) LoadAuth(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
-			}
-		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
-		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
-			}
-		}
+// CoachCoachActions retrieves all the coach_action's CoachActions with an executor via coach_id column.
+func (o *User) CoachCoachActions(mods ...qm.QueryMod) coachActionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args[object.AuthID] = struct{}{}
-
-	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"coach_actions\".\"coach_id\"=?", o.ID),
+	)
 
-			args[obj.AuthID] = struct{}{}
+	return CoachActions(queryMods...)
+}
 
-		}
+// AthleteCoaches retrieves all the coach's Coaches with an executor via athlete_id column.
+func (o *User) AthleteCoaches(mods ...qm.QueryMod) coachQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if len(args) == 0 {
-		return nil
-	}
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"coaches\".\"athlete_id\"=?", o.ID),
+	)
 
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
+	return Coaches(queryMods...)
+}
+
+// CoachCoaches retrieves all the coach's Coaches with an executor via coach_id column.
+func (o *User) CoachCoaches(mods ...qm.QueryMod) coachQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	query := NewQuery(
-		qm.From(`getstronger.auth`),
-		qm.WhereIn(`getstronger.auth.id in ?`, argsSlice...),
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"coaches\".\"coach_id\"=?", o.ID),
 	)
-	if mods != nil {
-		mods.Apply(query)
-	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load Auth")
-	}
+	return Coaches(queryMods...)
+}
 
-	var resultSlice []*Auth
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Auth")
+// DataExports retrieves all the data_export's DataExports with an executor.
+func (o *User) DataExports(mods ...qm.QueryMod) dataExportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for auth")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for auth")
-	}
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"data_exports\".\"user_id\"=?", o.ID),
+	)
 
-	if len(authAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
-		}
-	}
+	return DataExports(queryMods...)
+}
 
-	if len(resultSlice) == 0 {
-		return nil
+// Exercises retrieves all the exercise's Exercises with an executor.
+func (o *User) Exercises(mods ...qm.QueryMod) exerciseQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	if singular {
-		foreign := resultSlice[0]
-		object.R.Auth = foreign
-		if foreign.R == nil {
-			foreign.R = &authR{}
-		}
-		foreign.R.User = object
-		return nil
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"exercises\".\"user_id\"=?", o.ID),
+	)
+
+	return Exercises(queryMods...)
+}
+
+// FolloweeFollowRequests retrieves all the follow_request's FollowRequests with an executor via followee_id column.
+func (o *User) FolloweeFollowRequests(mods ...qm.QueryMod) followRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if local.AuthID == foreign.ID {
-				local.R.Auth = foreign
-				if foreign.R == nil {
-					foreign.R = &authR{}
-				}
-				foreign.R.User = local
-				break
-			}
-		}
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"follow_requests\".\"followee_id\"=?", o.ID),
+	)
+
+	return FollowRequests(queryMods...)
+}
+
+// FollowerFollowRequests retrieves all the follow_request's FollowRequests with an executor via follower_id column.
+func (o *User) FollowerFollowRequests(mods ...qm.QueryMod) followRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
 	}
 
-	return nil
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"follow_requests\".\"follower_id\"=?", o.ID),
+	)
+
+	return FollowRequests(queryMods...)
 }
 
-// LoadExercises allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadExercises(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// FolloweeFollowers retrieves all the follower's Followers with an executor via followee_id column.
+func (o *User) FolloweeFollowers(mods ...qm.QueryMod) followerQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"followers\".\"followee_id\"=?", o.ID),
+	)
+
+	return Followers(queryMods...)
+}
+
+// FollowerFollowers retrieves all the follower's Followers with an executor via follower_id column.
+func (o *User) FollowerFollowers(mods ...qm.QueryMod) followerQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"followers\".\"follower_id\"=?", o.ID),
+	)
+
+	return Followers(queryMods...)
+}
+
+// Notifications retrieves all the notification's Notifications with an executor.
+func (o *User) Notifications(mods ...qm.QueryMod) notificationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"notifications\".\"user_id\"=?", o.ID),
+	)
+
+	return Notifications(queryMods...)
+}
+
+// PersonalRecords retrieves all the personal_record's PersonalRecords with an executor.
+func (o *User) PersonalRecords(mods ...qm.QueryMod) personalRecordQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"personal_records\".\"user_id\"=?", o.ID),
+	)
+
+	return PersonalRecords(queryMods...)
+}
+
+// ReporterReports retrieves all the report's Reports with an executor via reporter_id column.
+func (o *User) ReporterReports(mods ...qm.QueryMod) reportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"reports\".\"reporter_id\"=?", o.ID),
+	)
+
+	return Reports(queryMods...)
+}
+
+// ResolvedByReports retrieves all the report's Reports with an executor via resolved_by column.
+func (o *User) ResolvedByReports(mods ...qm.QueryMod) reportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"reports\".\"resolved_by\"=?", o.ID),
+	)
+
+	return Reports(queryMods...)
+}
+
+// Routines retrieves all the routine's Routines with an executor.
+func (o *User) Routines(mods ...qm.QueryMod) routineQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"routines\".\"user_id\"=?", o.ID),
+	)
+
+	return Routines(queryMods...)
+}
+
+// BlockedUserBlocks retrieves all the user_block's UserBlocks with an executor via blocked_id column.
+func (o *User) BlockedUserBlocks(mods ...qm.QueryMod) userBlockQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"user_blocks\".\"blocked_id\"=?", o.ID),
+	)
+
+	return UserBlocks(queryMods...)
+}
+
+// BlockerUserBlocks retrieves all the user_block's UserBlocks with an executor via blocker_id column.
+func (o *User) BlockerUserBlocks(mods ...qm.QueryMod) userBlockQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"user_blocks\".\"blocker_id\"=?", o.ID),
+	)
+
+	return UserBlocks(queryMods...)
+}
+
+// WorkoutComments retrieves all the workout_comment's WorkoutComments with an executor.
+func (o *User) WorkoutComments(mods ...qm.QueryMod) workoutCommentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"workout_comments\".\"user_id\"=?", o.ID),
+	)
+
+	return WorkoutComments(queryMods...)
+}
+
+// WorkoutReactions retrieves all the workout_reaction's WorkoutReactions with an executor.
+func (o *User) WorkoutReactions(mods ...qm.QueryMod) workoutReactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"workout_reactions\".\"user_id\"=?", o.ID),
+	)
+
+	return WorkoutReactions(queryMods...)
+}
+
+// Workouts retrieves all the workout's Workouts with an executor.
+func (o *User) Workouts(mods ...qm.QueryMod) workoutQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"workouts\".\"user_id\"=?", o.ID),
+	)
+
+	return Workouts(queryMods...)
+}
+
+// YearSummaries retrieves all the year_summary's YearSummaries with an executor.
+func (o *User) YearSummaries(mods ...qm.QueryMod) yearSummaryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"year_summaries\".\"user_id\"=?", o.ID),
+	)
+
+	return YearSummaries(queryMods...)
+}
+
+// LoadAuth allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userL) LoadAuth(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -758,13 +1245,16 @@ func (userL) LoadExercises(ctx context.Context, e boil.ContextExecutor, singular
 		if object.R == nil {
 			object.R = &userR{}
 		}
-		args[object.ID] = struct{}{}
+		args[object.AuthID] = struct{}{}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &userR{}
 			}
-			args[obj.ID] = struct{}{}
+
+			args[obj.AuthID] = struct{}{}
+
 		}
 	}
 
@@ -780,8 +1270,8 @@ func (userL) LoadExercises(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`getstronger.exercises`),
-		qm.WhereIn(`getstronger.exercises.user_id in ?`, argsSlice...),
+		qm.From(`getstronger.auth`),
+		qm.WhereIn(`getstronger.auth.id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -789,45 +1279,49 @@ func (userL) LoadExercises(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load exercises")
+		return errors.Wrap(err, "failed to eager load Auth")
 	}
 
-	var resultSlice []*Exercise
+	var resultSlice []*Auth
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice exercises")
+		return errors.Wrap(err, "failed to bind eager loaded slice Auth")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on exercises")
+		return errors.Wrap(err, "failed to close results of eager load for auth")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for exercises")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for auth")
 	}
 
-	if len(exerciseAfterSelectHooks) != 0 {
+	if len(authAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Exercises = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &exerciseR{}
-			}
-			foreign.R.User = object
+		foreign := resultSlice[0]
+		object.R.Auth = foreign
+		if foreign.R == nil {
+			foreign.R = &authR{}
 		}
+		foreign.R.User = object
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.Exercises = append(local.R.Exercises, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AuthID == foreign.ID {
+				local.R.Auth = foreign
 				if foreign.R == nil {
-					foreign.R = &exerciseR{}
+					foreign.R = &authR{}
 				}
 				foreign.R.User = local
 				break
@@ -838,9 +1332,9 @@ func (userL) LoadExercises(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadFollowerUsers allows an eager lookup of values, cached into the
+// LoadAchievements allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadFollowerUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadAchievements(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -893,10 +1387,8 @@ func (userL) LoadFollowerUsers(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.Select("\"getstronger\".\"users\".\"id\", \"getstronger\".\"users\".\"first_name\", \"getstronger\".\"users\".\"last_name\", \"getstronger\".\"users\".\"created_at\", \"getstronger\".\"users\".\"full_name_search\", \"getstronger\".\"users\".\"auth_id\", \"a\".\"followee_id\""),
-		qm.From("\"getstronger\".\"users\""),
-		qm.InnerJoin("\"getstronger\".\"followers\" as \"a\" on \"getstronger\".\"users\".\"id\" = \"a\".\"follower_id\""),
-		qm.WhereIn("\"a\".\"followee_id\" in ?", argsSlice...),
+		qm.From(`getstronger.achievements`),
+		qm.WhereIn(`getstronger.achievements.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -904,36 +1396,22 @@ func (userL) LoadFollowerUsers(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load users")
+		return errors.Wrap(err, "failed to eager load achievements")
 	}
 
-	var resultSlice []*User
-
-	var localJoinCols []string
-	for results.Next() {
-		one := new(User)
-		var localJoinCol string
-
-		err = results.Scan(&one.ID, &one.FirstName, &one.LastName, &one.CreatedAt, &one.FullNameSearch, &one.AuthID, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for users")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice users")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	var resultSlice []*Achievement
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice achievements")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on users")
+		return errors.Wrap(err, "failed to close results in eager load on achievements")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for achievements")
 	}
 
-	if len(userAfterSelectHooks) != 0 {
+	if len(achievementAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -941,25 +1419,24 @@ func (userL) LoadFollowerUsers(ctx context.Context, e boil.ContextExecutor, sing
 		}
 	}
 	if singular {
-		object.R.FollowerUsers = resultSlice
+		object.R.Achievements = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userR{}
+				foreign.R = &achievementR{}
 			}
-			foreign.R.FolloweeUsers = append(foreign.R.FolloweeUsers, object)
+			foreign.R.User = object
 		}
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
+	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.FollowerUsers = append(local.R.FollowerUsers, foreign)
+			if local.ID == foreign.UserID {
+				local.R.Achievements = append(local.R.Achievements, foreign)
 				if foreign.R == nil {
-					foreign.R = &userR{}
+					foreign.R = &achievementR{}
 				}
-				foreign.R.FolloweeUsers = append(foreign.R.FolloweeUsers, local)
+				foreign.R.User = local
 				break
 			}
 		}
@@ -968,9 +1445,9 @@ func (userL) LoadFollowerUsers(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// LoadFolloweeUsers allows an eager lookup of values, cached into the
+// LoadBodyweights allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadFolloweeUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadBodyweights(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1023,10 +1500,8 @@ func (userL) LoadFolloweeUsers(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.Select("\"getstronger\".\"users\".\"id\", \"getstronger\".\"users\".\"first_name\", \"getstronger\".\"users\".\"last_name\", \"getstronger\".\"users\".\"created_at\", \"getstronger\".\"users\".\"full_name_search\", \"getstronger\".\"users\".\"auth_id\", \"a\".\"follower_id\""),
-		qm.From("\"getstronger\".\"users\""),
-		qm.InnerJoin("\"getstronger\".\"followers\" as \"a\" on \"getstronger\".\"users\".\"id\" = \"a\".\"followee_id\""),
-		qm.WhereIn("\"a\".\"follower_id\" in ?", argsSlice...),
+		qm.From(`getstronger.bodyweights`),
+		qm.WhereIn(`getstronger.bodyweights.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1034,36 +1509,22 @@ func (userL) LoadFolloweeUsers(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load users")
+		return errors.Wrap(err, "failed to eager load bodyweights")
 	}
 
-	var resultSlice []*User
-
-	var localJoinCols []string
-	for results.Next() {
-		one := new(User)
-		var localJoinCol string
-
-		err = results.Scan(&one.ID, &one.FirstName, &one.LastName, &one.CreatedAt, &one.FullNameSearch, &one.AuthID, &localJoinCol)
-		if err != nil {
-			return errors.Wrap(err, "failed to scan eager loaded results for users")
-		}
-		if err = results.Err(); err != nil {
-			return errors.Wrap(err, "failed to plebian-bind eager loaded slice users")
-		}
-
-		resultSlice = append(resultSlice, one)
-		localJoinCols = append(localJoinCols, localJoinCol)
+	var resultSlice []*Bodyweight
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice bodyweights")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on users")
+		return errors.Wrap(err, "failed to close results in eager load on bodyweights")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for bodyweights")
 	}
 
-	if len(userAfterSelectHooks) != 0 {
+	if len(bodyweightAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1071,25 +1532,24 @@ func (userL) LoadFolloweeUsers(ctx context.Context, e boil.ContextExecutor, sing
 		}
 	}
 	if singular {
-		object.R.FolloweeUsers = resultSlice
+		object.R.Bodyweights = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userR{}
+				foreign.R = &bodyweightR{}
 			}
-			foreign.R.FollowerUsers = append(foreign.R.FollowerUsers, object)
+			foreign.R.User = object
 		}
 		return nil
 	}
 
-	for i, foreign := range resultSlice {
-		localJoinCol := localJoinCols[i]
+	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == localJoinCol {
-				local.R.FolloweeUsers = append(local.R.FolloweeUsers, foreign)
+			if local.ID == foreign.UserID {
+				local.R.Bodyweights = append(local.R.Bodyweights, foreign)
 				if foreign.R == nil {
-					foreign.R = &userR{}
+					foreign.R = &bodyweightR{}
 				}
-				foreign.R.FollowerUsers = append(foreign.R.FollowerUsers, local)
+				foreign.R.User = local
 				break
 			}
 		}
@@ -1098,9 +1558,9 @@ func (userL) LoadFolloweeUsers(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// LoadNotifications allows an eager lookup of values, cached into the
+// LoadChallengeParticipants allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadChallengeParticipants(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1153,8 +1613,8 @@ func (userL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, sing
 	}
 
 	query := NewQuery(
-		qm.From(`getstronger.notifications`),
-		qm.WhereIn(`getstronger.notifications.user_id in ?`, argsSlice...),
+		qm.From(`getstronger.challenge_participants`),
+		qm.WhereIn(`getstronger.challenge_participants.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1162,22 +1622,22 @@ func (userL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, sing
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load notifications")
+		return errors.Wrap(err, "failed to eager load challenge_participants")
 	}
 
-	var resultSlice []*Notification
+	var resultSlice []*ChallengeParticipant
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
+		return errors.Wrap(err, "failed to bind eager loaded slice challenge_participants")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on notifications")
+		return errors.Wrap(err, "failed to close results in eager load on challenge_participants")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for challenge_participants")
 	}
 
-	if len(notificationAfterSelectHooks) != 0 {
+	if len(challengeParticipantAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1185,10 +1645,10 @@ func (userL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, sing
 		}
 	}
 	if singular {
-		object.R.Notifications = resultSlice
+		object.R.ChallengeParticipants = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &notificationR{}
+				foreign.R = &challengeParticipantR{}
 			}
 			foreign.R.User = object
 		}
@@ -1198,9 +1658,9 @@ func (userL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, sing
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.Notifications = append(local.R.Notifications, foreign)
+				local.R.ChallengeParticipants = append(local.R.ChallengeParticipants, foreign)
 				if foreign.R == nil {
-					foreign.R = &notificationR{}
+					foreign.R = &challengeParticipantR{}
 				}
 				foreign.R.User = local
 				break
@@ -1211,9 +1671,9 @@ func (userL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, sing
 	return nil
 }
 
-// LoadRoutines allows an eager lookup of values, cached into the
+// LoadCreatorChallenges allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadRoutines(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadCreatorChallenges(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1266,8 +1726,8 @@ func (userL) LoadRoutines(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`getstronger.routines`),
-		qm.WhereIn(`getstronger.routines.user_id in ?`, argsSlice...),
+		qm.From(`getstronger.challenges`),
+		qm.WhereIn(`getstronger.challenges.creator_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1275,22 +1735,22 @@ func (userL) LoadRoutines(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load routines")
+		return errors.Wrap(err, "failed to eager load challenges")
 	}
 
-	var resultSlice []*Routine
+	var resultSlice []*Challenge
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice routines")
+		return errors.Wrap(err, "failed to bind eager loaded slice challenges")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on routines")
+		return errors.Wrap(err, "failed to close results in eager load on challenges")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for routines")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for challenges")
 	}
 
-	if len(routineAfterSelectHooks) != 0 {
+	if len(challengeAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1298,24 +1758,24 @@ func (userL) LoadRoutines(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.Routines = resultSlice
+		object.R.CreatorChallenges = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &routineR{}
+				foreign.R = &challengeR{}
 			}
-			foreign.R.User = object
+			foreign.R.Creator = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.Routines = append(local.R.Routines, foreign)
+			if local.ID == foreign.CreatorID {
+				local.R.CreatorChallenges = append(local.R.CreatorChallenges, foreign)
 				if foreign.R == nil {
-					foreign.R = &routineR{}
+					foreign.R = &challengeR{}
 				}
-				foreign.R.User = local
+				foreign.R.Creator = local
 				break
 			}
 		}
@@ -1324,9 +1784,9 @@ func (userL) LoadRoutines(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadWorkoutComments allows an eager lookup of values, cached into the
+// LoadLeaderChallenges allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadWorkoutComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadLeaderChallenges(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1379,8 +1839,8 @@ func (userL) LoadWorkoutComments(ctx context.Context, e boil.ContextExecutor, si
 	}
 
 	query := NewQuery(
-		qm.From(`getstronger.workout_comments`),
-		qm.WhereIn(`getstronger.workout_comments.user_id in ?`, argsSlice...),
+		qm.From(`getstronger.challenges`),
+		qm.WhereIn(`getstronger.challenges.leader_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1388,22 +1848,22 @@ func (userL) LoadWorkoutComments(ctx context.Context, e boil.ContextExecutor, si
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load workout_comments")
+		return errors.Wrap(err, "failed to eager load challenges")
 	}
 
-	var resultSlice []*WorkoutComment
+	var resultSlice []*Challenge
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice workout_comments")
+		return errors.Wrap(err, "failed to bind eager loaded slice challenges")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on workout_comments")
+		return errors.Wrap(err, "failed to close results in eager load on challenges")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workout_comments")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for challenges")
 	}
 
-	if len(workoutCommentAfterSelectHooks) != 0 {
+	if len(challengeAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1411,24 +1871,24 @@ func (userL) LoadWorkoutComments(ctx context.Context, e boil.ContextExecutor, si
 		}
 	}
 	if singular {
-		object.R.WorkoutComments = resultSlice
+		object.R.LeaderChallenges = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &workoutCommentR{}
+				foreign.R = &challengeR{}
 			}
-			foreign.R.User = object
+			foreign.R.Leader = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.WorkoutComments = append(local.R.WorkoutComments, foreign)
+			if queries.Equal(local.ID, foreign.LeaderID) {
+				local.R.LeaderChallenges = append(local.R.LeaderChallenges, foreign)
 				if foreign.R == nil {
-					foreign.R = &workoutCommentR{}
+					foreign.R = &challengeR{}
 				}
-				foreign.R.User = local
+				foreign.R.Leader = local
 				break
 			}
 		}
@@ -1437,9 +1897,9 @@ func (userL) LoadWorkoutComments(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
-// LoadWorkouts allows an eager lookup of values, cached into the
+// LoadClubMembers allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadWorkouts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadClubMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -1492,8 +1952,8 @@ func (userL) LoadWorkouts(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`getstronger.workouts`),
-		qm.WhereIn(`getstronger.workouts.user_id in ?`, argsSlice...),
+		qm.From(`getstronger.club_members`),
+		qm.WhereIn(`getstronger.club_members.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1501,22 +1961,22 @@ func (userL) LoadWorkouts(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load workouts")
+		return errors.Wrap(err, "failed to eager load club_members")
 	}
 
-	var resultSlice []*Workout
+	var resultSlice []*ClubMember
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice workouts")
+		return errors.Wrap(err, "failed to bind eager loaded slice club_members")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on workouts")
+		return errors.Wrap(err, "failed to close results in eager load on club_members")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workouts")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for club_members")
 	}
 
-	if len(workoutAfterSelectHooks) != 0 {
+	if len(clubMemberAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1524,10 +1984,10 @@ func (userL) LoadWorkouts(ctx context.Context, e boil.ContextExecutor, singular
 		}
 	}
 	if singular {
-		object.R.Workouts = resultSlice
+		object.R.ClubMembers = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &workoutR{}
+				foreign.R = &clubMemberR{}
 			}
 			foreign.R.User = object
 		}
@@ -1537,9 +1997,9 @@ func (userL) LoadWorkouts(ctx context.Context, e boil.ContextExecutor, singular
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserID {
-				local.R.Workouts = append(local.R.Workouts, foreign)
+				local.R.ClubMembers = append(local.R.ClubMembers, foreign)
 				if foreign.R == nil {
-					foreign.R = &workoutR{}
+					foreign.R = &clubMemberR{}
 				}
 				foreign.R.User = local
 				break
@@ -1550,70 +2010,3471 @@ func (userL) LoadWorkouts(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// SetAuth of the user to the related item.
-// Sets o.R.Auth to related.
-// Adds o to related.R.User.
-func (o *User) SetAuth(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Auth) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+// LoadAthleteCoachActions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAthleteCoachActions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"getstronger\".\"users\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"auth_id"}),
-		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.coach_actions`),
+		qm.WhereIn(`getstronger.coach_actions.athlete_id in ?`, argsSlice...),
 	)
-	values := []interface{}{related.ID, o.ID}
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load coach_actions")
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	var resultSlice []*CoachAction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice coach_actions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on coach_actions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for coach_actions")
+	}
+
+	if len(coachActionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AthleteCoachActions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &coachActionR{}
+			}
+			foreign.R.Athlete = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AthleteID {
+				local.R.AthleteCoachActions = append(local.R.AthleteCoachActions, foreign)
+				if foreign.R == nil {
+					foreign.R = &coachActionR{}
+				}
+				foreign.R.Athlete = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCoachCoachActions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCoachCoachActions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.coach_actions`),
+		qm.WhereIn(`getstronger.coach_actions.coach_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load coach_actions")
+	}
+
+	var resultSlice []*CoachAction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice coach_actions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on coach_actions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for coach_actions")
+	}
+
+	if len(coachActionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CoachCoachActions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &coachActionR{}
+			}
+			foreign.R.Coach = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CoachID {
+				local.R.CoachCoachActions = append(local.R.CoachCoachActions, foreign)
+				if foreign.R == nil {
+					foreign.R = &coachActionR{}
+				}
+				foreign.R.Coach = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAthleteCoaches allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAthleteCoaches(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.coaches`),
+		qm.WhereIn(`getstronger.coaches.athlete_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load coaches")
+	}
+
+	var resultSlice []*Coach
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice coaches")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on coaches")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for coaches")
+	}
+
+	if len(coachAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AthleteCoaches = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &coachR{}
+			}
+			foreign.R.Athlete = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AthleteID {
+				local.R.AthleteCoaches = append(local.R.AthleteCoaches, foreign)
+				if foreign.R == nil {
+					foreign.R = &coachR{}
+				}
+				foreign.R.Athlete = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCoachCoaches allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCoachCoaches(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.coaches`),
+		qm.WhereIn(`getstronger.coaches.coach_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load coaches")
+	}
+
+	var resultSlice []*Coach
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice coaches")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on coaches")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for coaches")
+	}
+
+	if len(coachAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CoachCoaches = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &coachR{}
+			}
+			foreign.R.Coach = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CoachID {
+				local.R.CoachCoaches = append(local.R.CoachCoaches, foreign)
+				if foreign.R == nil {
+					foreign.R = &coachR{}
+				}
+				foreign.R.Coach = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDataExports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDataExports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.data_exports`),
+		qm.WhereIn(`getstronger.data_exports.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load data_exports")
+	}
+
+	var resultSlice []*DataExport
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice data_exports")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on data_exports")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_exports")
+	}
+
+	if len(dataExportAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DataExports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dataExportR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.DataExports = append(local.R.DataExports, foreign)
+				if foreign.R == nil {
+					foreign.R = &dataExportR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExercises allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadExercises(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.exercises`),
+		qm.WhereIn(`getstronger.exercises.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load exercises")
+	}
+
+	var resultSlice []*Exercise
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice exercises")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on exercises")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for exercises")
+	}
+
+	if len(exerciseAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Exercises = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &exerciseR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Exercises = append(local.R.Exercises, foreign)
+				if foreign.R == nil {
+					foreign.R = &exerciseR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFolloweeFollowRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadFolloweeFollowRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.follow_requests`),
+		qm.WhereIn(`getstronger.follow_requests.followee_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load follow_requests")
+	}
+
+	var resultSlice []*FollowRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice follow_requests")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on follow_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for follow_requests")
+	}
+
+	if len(followRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FolloweeFollowRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &followRequestR{}
+			}
+			foreign.R.Followee = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FolloweeID {
+				local.R.FolloweeFollowRequests = append(local.R.FolloweeFollowRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &followRequestR{}
+				}
+				foreign.R.Followee = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFollowerFollowRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadFollowerFollowRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.follow_requests`),
+		qm.WhereIn(`getstronger.follow_requests.follower_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load follow_requests")
+	}
+
+	var resultSlice []*FollowRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice follow_requests")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on follow_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for follow_requests")
+	}
+
+	if len(followRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FollowerFollowRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &followRequestR{}
+			}
+			foreign.R.Follower = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FollowerID {
+				local.R.FollowerFollowRequests = append(local.R.FollowerFollowRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &followRequestR{}
+				}
+				foreign.R.Follower = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFolloweeFollowers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadFolloweeFollowers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.followers`),
+		qm.WhereIn(`getstronger.followers.followee_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load followers")
+	}
+
+	var resultSlice []*Follower
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice followers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on followers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for followers")
+	}
+
+	if len(followerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FolloweeFollowers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &followerR{}
+			}
+			foreign.R.Followee = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FolloweeID {
+				local.R.FolloweeFollowers = append(local.R.FolloweeFollowers, foreign)
+				if foreign.R == nil {
+					foreign.R = &followerR{}
+				}
+				foreign.R.Followee = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFollowerFollowers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadFollowerFollowers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.followers`),
+		qm.WhereIn(`getstronger.followers.follower_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load followers")
+	}
+
+	var resultSlice []*Follower
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice followers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on followers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for followers")
+	}
+
+	if len(followerAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FollowerFollowers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &followerR{}
+			}
+			foreign.R.Follower = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FollowerID {
+				local.R.FollowerFollowers = append(local.R.FollowerFollowers, foreign)
+				if foreign.R == nil {
+					foreign.R = &followerR{}
+				}
+				foreign.R.Follower = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadNotifications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadNotifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.notifications`),
+		qm.WhereIn(`getstronger.notifications.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load notifications")
+	}
+
+	var resultSlice []*Notification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice notifications")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on notifications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for notifications")
+	}
+
+	if len(notificationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Notifications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &notificationR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Notifications = append(local.R.Notifications, foreign)
+				if foreign.R == nil {
+					foreign.R = &notificationR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPersonalRecords allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPersonalRecords(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.personal_records`),
+		qm.WhereIn(`getstronger.personal_records.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load personal_records")
+	}
+
+	var resultSlice []*PersonalRecord
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice personal_records")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on personal_records")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for personal_records")
+	}
+
+	if len(personalRecordAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PersonalRecords = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &personalRecordR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PersonalRecords = append(local.R.PersonalRecords, foreign)
+				if foreign.R == nil {
+					foreign.R = &personalRecordR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReporterReports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadReporterReports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.reports`),
+		qm.WhereIn(`getstronger.reports.reporter_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reports")
+	}
+
+	var resultSlice []*Report
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reports")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reports")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reports")
+	}
+
+	if len(reportAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReporterReports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reportR{}
+			}
+			foreign.R.Reporter = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ReporterID {
+				local.R.ReporterReports = append(local.R.ReporterReports, foreign)
+				if foreign.R == nil {
+					foreign.R = &reportR{}
+				}
+				foreign.R.Reporter = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadResolvedByReports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadResolvedByReports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.reports`),
+		qm.WhereIn(`getstronger.reports.resolved_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load reports")
+	}
+
+	var resultSlice []*Report
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice reports")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on reports")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for reports")
+	}
+
+	if len(reportAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ResolvedByReports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &reportR{}
+			}
+			foreign.R.ResolvedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ResolvedBy) {
+				local.R.ResolvedByReports = append(local.R.ResolvedByReports, foreign)
+				if foreign.R == nil {
+					foreign.R = &reportR{}
+				}
+				foreign.R.ResolvedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRoutines allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRoutines(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.routines`),
+		qm.WhereIn(`getstronger.routines.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load routines")
+	}
+
+	var resultSlice []*Routine
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice routines")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on routines")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for routines")
+	}
+
+	if len(routineAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Routines = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &routineR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Routines = append(local.R.Routines, foreign)
+				if foreign.R == nil {
+					foreign.R = &routineR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBlockedUserBlocks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadBlockedUserBlocks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.user_blocks`),
+		qm.WhereIn(`getstronger.user_blocks.blocked_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_blocks")
+	}
+
+	var resultSlice []*UserBlock
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_blocks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_blocks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_blocks")
+	}
+
+	if len(userBlockAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BlockedUserBlocks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userBlockR{}
+			}
+			foreign.R.Blocked = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BlockedID {
+				local.R.BlockedUserBlocks = append(local.R.BlockedUserBlocks, foreign)
+				if foreign.R == nil {
+					foreign.R = &userBlockR{}
+				}
+				foreign.R.Blocked = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBlockerUserBlocks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadBlockerUserBlocks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.user_blocks`),
+		qm.WhereIn(`getstronger.user_blocks.blocker_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_blocks")
+	}
+
+	var resultSlice []*UserBlock
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_blocks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_blocks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_blocks")
+	}
+
+	if len(userBlockAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BlockerUserBlocks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userBlockR{}
+			}
+			foreign.R.Blocker = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BlockerID {
+				local.R.BlockerUserBlocks = append(local.R.BlockerUserBlocks, foreign)
+				if foreign.R == nil {
+					foreign.R = &userBlockR{}
+				}
+				foreign.R.Blocker = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWorkoutComments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWorkoutComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.workout_comments`),
+		qm.WhereIn(`getstronger.workout_comments.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load workout_comments")
+	}
+
+	var resultSlice []*WorkoutComment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice workout_comments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on workout_comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workout_comments")
+	}
+
+	if len(workoutCommentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WorkoutComments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &workoutCommentR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.WorkoutComments = append(local.R.WorkoutComments, foreign)
+				if foreign.R == nil {
+					foreign.R = &workoutCommentR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWorkoutReactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWorkoutReactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.workout_reactions`),
+		qm.WhereIn(`getstronger.workout_reactions.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load workout_reactions")
+	}
+
+	var resultSlice []*WorkoutReaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice workout_reactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on workout_reactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workout_reactions")
+	}
+
+	if len(workoutReactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WorkoutReactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &workoutReactionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.WorkoutReactions = append(local.R.WorkoutReactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &workoutReactionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWorkouts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWorkouts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.workouts`),
+		qm.WhereIn(`getstronger.workouts.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load workouts")
+	}
+
+	var resultSlice []*Workout
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice workouts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on workouts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workouts")
+	}
+
+	if len(workoutAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Workouts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &workoutR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Workouts = append(local.R.Workouts, foreign)
+				if foreign.R == nil {
+					foreign.R = &workoutR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadYearSummaries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadYearSummaries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.year_summaries`),
+		qm.WhereIn(`getstronger.year_summaries.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load year_summaries")
+	}
+
+	var resultSlice []*YearSummary
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice year_summaries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on year_summaries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for year_summaries")
+	}
+
+	if len(yearSummaryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.YearSummaries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &yearSummaryR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.YearSummaries = append(local.R.YearSummaries, foreign)
+				if foreign.R == nil {
+					foreign.R = &yearSummaryR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAuth of the user to the related item.
+// Sets o.R.Auth to related.
+// Adds o to related.R.User.
+func (o *User) SetAuth(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Auth) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"auth_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AuthID = related.ID
+	if o.R == nil {
+		o.R = &userR{
+			Auth: related,
+		}
+	} else {
+		o.R.Auth = related
+	}
+
+	if related.R == nil {
+		related.R = &authR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+
+	return nil
+}
+
+// AddAchievements adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Achievements.
+// Sets related.R.User appropriately.
+func (o *User) AddAchievements(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Achievement) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"achievements\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, achievementPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Achievements: related,
+		}
+	} else {
+		o.R.Achievements = append(o.R.Achievements, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &achievementR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddBodyweights adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Bodyweights.
+// Sets related.R.User appropriately.
+func (o *User) AddBodyweights(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Bodyweight) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"bodyweights\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, bodyweightPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Bodyweights: related,
+		}
+	} else {
+		o.R.Bodyweights = append(o.R.Bodyweights, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &bodyweightR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddChallengeParticipants adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChallengeParticipants.
+// Sets related.R.User appropriately.
+func (o *User) AddChallengeParticipants(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChallengeParticipant) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"challenge_participants\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, challengeParticipantPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ChallengeID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ChallengeParticipants: related,
+		}
+	} else {
+		o.R.ChallengeParticipants = append(o.R.ChallengeParticipants, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &challengeParticipantR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddCreatorChallenges adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatorChallenges.
+// Sets related.R.Creator appropriately.
+func (o *User) AddCreatorChallenges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Challenge) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreatorID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"challenges\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"creator_id"}),
+				strmangle.WhereClause("\"", "\"", 2, challengePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreatorID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatorChallenges: related,
+		}
+	} else {
+		o.R.CreatorChallenges = append(o.R.CreatorChallenges, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &challengeR{
+				Creator: o,
+			}
+		} else {
+			rel.R.Creator = o
+		}
+	}
+	return nil
+}
+
+// AddLeaderChallenges adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.LeaderChallenges.
+// Sets related.R.Leader appropriately.
+func (o *User) AddLeaderChallenges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Challenge) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.LeaderID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"challenges\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"leader_id"}),
+				strmangle.WhereClause("\"", "\"", 2, challengePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.LeaderID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			LeaderChallenges: related,
+		}
+	} else {
+		o.R.LeaderChallenges = append(o.R.LeaderChallenges, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &challengeR{
+				Leader: o,
+			}
+		} else {
+			rel.R.Leader = o
+		}
+	}
+	return nil
+}
+
+// SetLeaderChallenges removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Leader's LeaderChallenges accordingly.
+// Replaces o.R.LeaderChallenges with related.
+// Sets related.R.Leader's LeaderChallenges accordingly.
+func (o *User) SetLeaderChallenges(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Challenge) error {
+	query := "update \"getstronger\".\"challenges\" set \"leader_id\" = null where \"leader_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.LeaderChallenges {
+			queries.SetScanner(&rel.LeaderID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Leader = nil
+		}
+		o.R.LeaderChallenges = nil
+	}
+
+	return o.AddLeaderChallenges(ctx, exec, insert, related...)
+}
+
+// RemoveLeaderChallenges relationships from objects passed in.
+// Removes related items from R.LeaderChallenges (uses pointer comparison, removal does not keep order)
+// Sets related.R.Leader.
+func (o *User) RemoveLeaderChallenges(ctx context.Context, exec boil.ContextExecutor, related ...*Challenge) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.LeaderID, nil)
+		if rel.R != nil {
+			rel.R.Leader = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("leader_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.LeaderChallenges {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.LeaderChallenges)
+			if ln > 1 && i < ln-1 {
+				o.R.LeaderChallenges[i] = o.R.LeaderChallenges[ln-1]
+			}
+			o.R.LeaderChallenges = o.R.LeaderChallenges[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddClubMembers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ClubMembers.
+// Sets related.R.User appropriately.
+func (o *User) AddClubMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ClubMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"club_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, clubMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ClubID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ClubMembers: related,
+		}
+	} else {
+		o.R.ClubMembers = append(o.R.ClubMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &clubMemberR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddAthleteCoachActions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AthleteCoachActions.
+// Sets related.R.Athlete appropriately.
+func (o *User) AddAthleteCoachActions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CoachAction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AthleteID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"coach_actions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"athlete_id"}),
+				strmangle.WhereClause("\"", "\"", 2, coachActionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AthleteID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AthleteCoachActions: related,
+		}
+	} else {
+		o.R.AthleteCoachActions = append(o.R.AthleteCoachActions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &coachActionR{
+				Athlete: o,
+			}
+		} else {
+			rel.R.Athlete = o
+		}
+	}
+	return nil
+}
+
+// AddCoachCoachActions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CoachCoachActions.
+// Sets related.R.Coach appropriately.
+func (o *User) AddCoachCoachActions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*CoachAction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CoachID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"coach_actions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"coach_id"}),
+				strmangle.WhereClause("\"", "\"", 2, coachActionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CoachID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CoachCoachActions: related,
+		}
+	} else {
+		o.R.CoachCoachActions = append(o.R.CoachCoachActions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &coachActionR{
+				Coach: o,
+			}
+		} else {
+			rel.R.Coach = o
+		}
+	}
+	return nil
+}
+
+// AddAthleteCoaches adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AthleteCoaches.
+// Sets related.R.Athlete appropriately.
+func (o *User) AddAthleteCoaches(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Coach) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AthleteID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"coaches\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"athlete_id"}),
+				strmangle.WhereClause("\"", "\"", 2, coachPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.CoachID, rel.AthleteID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AthleteID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AthleteCoaches: related,
+		}
+	} else {
+		o.R.AthleteCoaches = append(o.R.AthleteCoaches, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &coachR{
+				Athlete: o,
+			}
+		} else {
+			rel.R.Athlete = o
+		}
+	}
+	return nil
+}
+
+// AddCoachCoaches adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CoachCoaches.
+// Sets related.R.Coach appropriately.
+func (o *User) AddCoachCoaches(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Coach) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CoachID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"coaches\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"coach_id"}),
+				strmangle.WhereClause("\"", "\"", 2, coachPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.CoachID, rel.AthleteID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CoachID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CoachCoaches: related,
+		}
+	} else {
+		o.R.CoachCoaches = append(o.R.CoachCoaches, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &coachR{
+				Coach: o,
+			}
+		} else {
+			rel.R.Coach = o
+		}
+	}
+	return nil
+}
+
+// AddDataExports adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DataExports.
+// Sets related.R.User appropriately.
+func (o *User) AddDataExports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataExport) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"data_exports\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, dataExportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			DataExports: related,
+		}
+	} else {
+		o.R.DataExports = append(o.R.DataExports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataExportR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddExercises adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Exercises.
+// Sets related.R.User appropriately.
+func (o *User) AddExercises(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Exercise) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"exercises\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, exercisePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Exercises: related,
+		}
+	} else {
+		o.R.Exercises = append(o.R.Exercises, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &exerciseR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddFolloweeFollowRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.FolloweeFollowRequests.
+// Sets related.R.Followee appropriately.
+func (o *User) AddFolloweeFollowRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FollowRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FolloweeID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"follow_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"followee_id"}),
+				strmangle.WhereClause("\"", "\"", 2, followRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FolloweeID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			FolloweeFollowRequests: related,
+		}
+	} else {
+		o.R.FolloweeFollowRequests = append(o.R.FolloweeFollowRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &followRequestR{
+				Followee: o,
+			}
+		} else {
+			rel.R.Followee = o
+		}
+	}
+	return nil
+}
+
+// AddFollowerFollowRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.FollowerFollowRequests.
+// Sets related.R.Follower appropriately.
+func (o *User) AddFollowerFollowRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FollowRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FollowerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"follow_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"follower_id"}),
+				strmangle.WhereClause("\"", "\"", 2, followRequestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FollowerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			FollowerFollowRequests: related,
+		}
+	} else {
+		o.R.FollowerFollowRequests = append(o.R.FollowerFollowRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &followRequestR{
+				Follower: o,
+			}
+		} else {
+			rel.R.Follower = o
+		}
+	}
+	return nil
+}
+
+// AddFolloweeFollowers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.FolloweeFollowers.
+// Sets related.R.Followee appropriately.
+func (o *User) AddFolloweeFollowers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Follower) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FolloweeID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"followers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"followee_id"}),
+				strmangle.WhereClause("\"", "\"", 2, followerPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.FollowerID, rel.FolloweeID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FolloweeID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			FolloweeFollowers: related,
+		}
+	} else {
+		o.R.FolloweeFollowers = append(o.R.FolloweeFollowers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &followerR{
+				Followee: o,
+			}
+		} else {
+			rel.R.Followee = o
+		}
+	}
+	return nil
+}
+
+// AddFollowerFollowers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.FollowerFollowers.
+// Sets related.R.Follower appropriately.
+func (o *User) AddFollowerFollowers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Follower) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FollowerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"followers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"follower_id"}),
+				strmangle.WhereClause("\"", "\"", 2, followerPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.FollowerID, rel.FolloweeID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FollowerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			FollowerFollowers: related,
+		}
+	} else {
+		o.R.FollowerFollowers = append(o.R.FollowerFollowers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &followerR{
+				Follower: o,
+			}
+		} else {
+			rel.R.Follower = o
+		}
+	}
+	return nil
+}
+
+// AddNotifications adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Notifications.
+// Sets related.R.User appropriately.
+func (o *User) AddNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"notifications\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, notificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Notifications: related,
+		}
+	} else {
+		o.R.Notifications = append(o.R.Notifications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &notificationR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddPersonalRecords adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PersonalRecords.
+// Sets related.R.User appropriately.
+func (o *User) AddPersonalRecords(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PersonalRecord) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"personal_records\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, personalRecordPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
 	}
 
-	o.AuthID = related.ID
 	if o.R == nil {
 		o.R = &userR{
-			Auth: related,
+			PersonalRecords: related,
 		}
 	} else {
-		o.R.Auth = related
+		o.R.PersonalRecords = append(o.R.PersonalRecords, related...)
 	}
 
-	if related.R == nil {
-		related.R = &authR{
-			User: o,
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &personalRecordR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
 		}
-	} else {
-		related.R.User = o
 	}
-
 	return nil
 }
 
-// AddExercises adds the given related objects to the existing relationships
+// AddReporterReports adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.Exercises.
-// Sets related.R.User appropriately.
-func (o *User) AddExercises(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Exercise) error {
+// Appends related to o.R.ReporterReports.
+// Sets related.R.Reporter appropriately.
+func (o *User) AddReporterReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Report) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserID = o.ID
+			rel.ReporterID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"getstronger\".\"exercises\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, exercisePrimaryKeyColumns),
+				"UPDATE \"getstronger\".\"reports\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"reporter_id"}),
+				strmangle.WhereClause("\"", "\"", 2, reportPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1626,86 +5487,91 @@ func (o *User) AddExercises(ctx context.Context, exec boil.ContextExecutor, inse
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserID = o.ID
+			rel.ReporterID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			Exercises: related,
+			ReporterReports: related,
 		}
 	} else {
-		o.R.Exercises = append(o.R.Exercises, related...)
+		o.R.ReporterReports = append(o.R.ReporterReports, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &exerciseR{
-				User: o,
+			rel.R = &reportR{
+				Reporter: o,
 			}
 		} else {
-			rel.R.User = o
+			rel.R.Reporter = o
 		}
 	}
 	return nil
 }
 
-// AddFollowerUsers adds the given related objects to the existing relationships
+// AddResolvedByReports adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.FollowerUsers.
-// Sets related.R.FolloweeUsers appropriately.
-func (o *User) AddFollowerUsers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*User) error {
+// Appends related to o.R.ResolvedByReports.
+// Sets related.R.ResolvedByUser appropriately.
+func (o *User) AddResolvedByReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Report) error {
 	var err error
 	for _, rel := range related {
 		if insert {
+			queries.Assign(&rel.ResolvedBy, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"reports\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"resolved_by"}),
+				strmangle.WhereClause("\"", "\"", 2, reportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	for _, rel := range related {
-		query := "insert into \"getstronger\".\"followers\" (\"followee_id\", \"follower_id\") values ($1, $2)"
-		values := []interface{}{o.ID, rel.ID}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, query)
-			fmt.Fprintln(writer, values)
-		}
-		_, err = exec.ExecContext(ctx, query, values...)
-		if err != nil {
-			return errors.Wrap(err, "failed to insert into join table")
+			queries.Assign(&rel.ResolvedBy, o.ID)
 		}
 	}
+
 	if o.R == nil {
 		o.R = &userR{
-			FollowerUsers: related,
+			ResolvedByReports: related,
 		}
 	} else {
-		o.R.FollowerUsers = append(o.R.FollowerUsers, related...)
+		o.R.ResolvedByReports = append(o.R.ResolvedByReports, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &userR{
-				FolloweeUsers: UserSlice{o},
+			rel.R = &reportR{
+				ResolvedByUser: o,
 			}
 		} else {
-			rel.R.FolloweeUsers = append(rel.R.FolloweeUsers, o)
+			rel.R.ResolvedByUser = o
 		}
 	}
 	return nil
 }
 
-// SetFollowerUsers removes all previously related items of the
+// SetResolvedByReports removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.FolloweeUsers's FollowerUsers accordingly.
-// Replaces o.R.FollowerUsers with related.
-// Sets related.R.FolloweeUsers's FollowerUsers accordingly.
-func (o *User) SetFollowerUsers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*User) error {
-	query := "delete from \"getstronger\".\"followers\" where \"followee_id\" = $1"
+// Sets o.R.ResolvedByUser's ResolvedByReports accordingly.
+// Replaces o.R.ResolvedByReports with related.
+// Sets related.R.ResolvedByUser's ResolvedByReports accordingly.
+func (o *User) SetResolvedByReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Report) error {
+	query := "update \"getstronger\".\"reports\" set \"resolved_by\" = null where \"resolved_by\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1717,57 +5583,54 @@ func (o *User) SetFollowerUsers(ctx context.Context, exec boil.ContextExecutor,
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	removeFollowerUsersFromFolloweeUsersSlice(o, related)
 	if o.R != nil {
-		o.R.FollowerUsers = nil
+		for _, rel := range o.R.ResolvedByReports {
+			queries.SetScanner(&rel.ResolvedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ResolvedByUser = nil
+		}
+		o.R.ResolvedByReports = nil
 	}
 
-	return o.AddFollowerUsers(ctx, exec, insert, related...)
+	return o.AddResolvedByReports(ctx, exec, insert, related...)
 }
 
-// RemoveFollowerUsers relationships from objects passed in.
-// Removes related items from R.FollowerUsers (uses pointer comparison, removal does not keep order)
-// Sets related.R.FolloweeUsers.
-func (o *User) RemoveFollowerUsers(ctx context.Context, exec boil.ContextExecutor, related ...*User) error {
+// RemoveResolvedByReports relationships from objects passed in.
+// Removes related items from R.ResolvedByReports (uses pointer comparison, removal does not keep order)
+// Sets related.R.ResolvedByUser.
+func (o *User) RemoveResolvedByReports(ctx context.Context, exec boil.ContextExecutor, related ...*Report) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
-	query := fmt.Sprintf(
-		"delete from \"getstronger\".\"followers\" where \"followee_id\" = $1 and \"follower_id\" in (%s)",
-		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
-	)
-	values := []interface{}{o.ID}
 	for _, rel := range related {
-		values = append(values, rel.ID)
-	}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err = exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
+		queries.SetScanner(&rel.ResolvedBy, nil)
+		if rel.R != nil {
+			rel.R.ResolvedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("resolved_by")); err != nil {
+			return err
+		}
 	}
-	removeFollowerUsersFromFolloweeUsersSlice(o, related)
 	if o.R == nil {
 		return nil
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.FollowerUsers {
+		for i, ri := range o.R.ResolvedByReports {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.FollowerUsers)
+			ln := len(o.R.ResolvedByReports)
 			if ln > 1 && i < ln-1 {
-				o.R.FollowerUsers[i] = o.R.FollowerUsers[ln-1]
+				o.R.ResolvedByReports[i] = o.R.ResolvedByReports[ln-1]
 			}
-			o.R.FollowerUsers = o.R.FollowerUsers[:ln-1]
+			o.R.ResolvedByReports = o.R.ResolvedByReports[:ln-1]
 			break
 		}
 	}
@@ -1775,176 +5638,170 @@ func (o *User) RemoveFollowerUsers(ctx context.Context, exec boil.ContextExecuto
 	return nil
 }
 
-func removeFollowerUsersFromFolloweeUsersSlice(o *User, related []*User) {
+// AddRoutines adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Routines.
+// Sets related.R.User appropriately.
+func (o *User) AddRoutines(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Routine) error {
+	var err error
 	for _, rel := range related {
-		if rel.R == nil {
-			continue
-		}
-		for i, ri := range rel.R.FolloweeUsers {
-			if o.ID != ri.ID {
-				continue
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"routines\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, routinePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-			ln := len(rel.R.FolloweeUsers)
-			if ln > 1 && i < ln-1 {
-				rel.R.FolloweeUsers[i] = rel.R.FolloweeUsers[ln-1]
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
 			}
-			rel.R.FolloweeUsers = rel.R.FolloweeUsers[:ln-1]
-			break
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Routines: related,
+		}
+	} else {
+		o.R.Routines = append(o.R.Routines, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &routineR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
 		}
 	}
+	return nil
 }
 
-// AddFolloweeUsers adds the given related objects to the existing relationships
+// AddBlockedUserBlocks adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.FolloweeUsers.
-// Sets related.R.FollowerUsers appropriately.
-func (o *User) AddFolloweeUsers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*User) error {
+// Appends related to o.R.BlockedUserBlocks.
+// Sets related.R.Blocked appropriately.
+func (o *User) AddBlockedUserBlocks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserBlock) error {
 	var err error
 	for _, rel := range related {
 		if insert {
+			rel.BlockedID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"user_blocks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"blocked_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userBlockPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.BlockerID, rel.BlockedID}
 
-	for _, rel := range related {
-		query := "insert into \"getstronger\".\"followers\" (\"follower_id\", \"followee_id\") values ($1, $2)"
-		values := []interface{}{o.ID, rel.ID}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-		if boil.IsDebug(ctx) {
-			writer := boil.DebugWriterFrom(ctx)
-			fmt.Fprintln(writer, query)
-			fmt.Fprintln(writer, values)
-		}
-		_, err = exec.ExecContext(ctx, query, values...)
-		if err != nil {
-			return errors.Wrap(err, "failed to insert into join table")
+			rel.BlockedID = o.ID
 		}
 	}
+
 	if o.R == nil {
 		o.R = &userR{
-			FolloweeUsers: related,
+			BlockedUserBlocks: related,
 		}
 	} else {
-		o.R.FolloweeUsers = append(o.R.FolloweeUsers, related...)
+		o.R.BlockedUserBlocks = append(o.R.BlockedUserBlocks, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &userR{
-				FollowerUsers: UserSlice{o},
+			rel.R = &userBlockR{
+				Blocked: o,
 			}
 		} else {
-			rel.R.FollowerUsers = append(rel.R.FollowerUsers, o)
+			rel.R.Blocked = o
 		}
 	}
 	return nil
 }
 
-// SetFolloweeUsers removes all previously related items of the
-// user replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.FollowerUsers's FolloweeUsers accordingly.
-// Replaces o.R.FolloweeUsers with related.
-// Sets related.R.FollowerUsers's FolloweeUsers accordingly.
-func (o *User) SetFolloweeUsers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*User) error {
-	query := "delete from \"getstronger\".\"followers\" where \"follower_id\" = $1"
-	values := []interface{}{o.ID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-
-	removeFolloweeUsersFromFollowerUsersSlice(o, related)
-	if o.R != nil {
-		o.R.FolloweeUsers = nil
-	}
-
-	return o.AddFolloweeUsers(ctx, exec, insert, related...)
-}
-
-// RemoveFolloweeUsers relationships from objects passed in.
-// Removes related items from R.FolloweeUsers (uses pointer comparison, removal does not keep order)
-// Sets related.R.FollowerUsers.
-func (o *User) RemoveFolloweeUsers(ctx context.Context, exec boil.ContextExecutor, related ...*User) error {
-	if len(related) == 0 {
-		return nil
-	}
-
+// AddBlockerUserBlocks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BlockerUserBlocks.
+// Sets related.R.Blocker appropriately.
+func (o *User) AddBlockerUserBlocks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserBlock) error {
 	var err error
-	query := fmt.Sprintf(
-		"delete from \"getstronger\".\"followers\" where \"follower_id\" = $1 and \"followee_id\" in (%s)",
-		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
-	)
-	values := []interface{}{o.ID}
 	for _, rel := range related {
-		values = append(values, rel.ID)
-	}
-
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err = exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-	removeFolloweeUsersFromFollowerUsersSlice(o, related)
-	if o.R == nil {
-		return nil
-	}
-
-	for _, rel := range related {
-		for i, ri := range o.R.FolloweeUsers {
-			if rel != ri {
-				continue
+		if insert {
+			rel.BlockerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"user_blocks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"blocker_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userBlockPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.BlockerID, rel.BlockedID}
 
-			ln := len(o.R.FolloweeUsers)
-			if ln > 1 && i < ln-1 {
-				o.R.FolloweeUsers[i] = o.R.FolloweeUsers[ln-1]
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
 			}
-			o.R.FolloweeUsers = o.R.FolloweeUsers[:ln-1]
-			break
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BlockerID = o.ID
 		}
 	}
 
-	return nil
-}
+	if o.R == nil {
+		o.R = &userR{
+			BlockerUserBlocks: related,
+		}
+	} else {
+		o.R.BlockerUserBlocks = append(o.R.BlockerUserBlocks, related...)
+	}
 
-func removeFolloweeUsersFromFollowerUsersSlice(o *User, related []*User) {
 	for _, rel := range related {
 		if rel.R == nil {
-			continue
-		}
-		for i, ri := range rel.R.FollowerUsers {
-			if o.ID != ri.ID {
-				continue
-			}
-
-			ln := len(rel.R.FollowerUsers)
-			if ln > 1 && i < ln-1 {
-				rel.R.FollowerUsers[i] = rel.R.FollowerUsers[ln-1]
+			rel.R = &userBlockR{
+				Blocker: o,
 			}
-			rel.R.FollowerUsers = rel.R.FollowerUsers[:ln-1]
-			break
+		} else {
+			rel.R.Blocker = o
 		}
 	}
+	return nil
 }
 
-// AddNotifications adds the given related objects to the existing relationships
+// AddWorkoutComments adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.Notifications.
+// Appends related to o.R.WorkoutComments.
 // Sets related.R.User appropriately.
-func (o *User) AddNotifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Notification) error {
+func (o *User) AddWorkoutComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WorkoutComment) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1954,9 +5811,9 @@ func (o *User) AddNotifications(ctx context.Context, exec boil.ContextExecutor,
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"getstronger\".\"notifications\" SET %s WHERE %s",
+				"UPDATE \"getstronger\".\"workout_comments\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, notificationPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, workoutCommentPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1975,15 +5832,15 @@ func (o *User) AddNotifications(ctx context.Context, exec boil.ContextExecutor,
 
 	if o.R == nil {
 		o.R = &userR{
-			Notifications: related,
+			WorkoutComments: related,
 		}
 	} else {
-		o.R.Notifications = append(o.R.Notifications, related...)
+		o.R.WorkoutComments = append(o.R.WorkoutComments, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &notificationR{
+			rel.R = &workoutCommentR{
 				User: o,
 			}
 		} else {
@@ -1993,11 +5850,11 @@ func (o *User) AddNotifications(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
-// AddRoutines adds the given related objects to the existing relationships
+// AddWorkoutReactions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.Routines.
+// Appends related to o.R.WorkoutReactions.
 // Sets related.R.User appropriately.
-func (o *User) AddRoutines(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Routine) error {
+func (o *User) AddWorkoutReactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WorkoutReaction) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2007,9 +5864,9 @@ func (o *User) AddRoutines(ctx context.Context, exec boil.ContextExecutor, inser
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"getstronger\".\"routines\" SET %s WHERE %s",
+				"UPDATE \"getstronger\".\"workout_reactions\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, routinePrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, workoutReactionPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2028,15 +5885,15 @@ func (o *User) AddRoutines(ctx context.Context, exec boil.ContextExecutor, inser
 
 	if o.R == nil {
 		o.R = &userR{
-			Routines: related,
+			WorkoutReactions: related,
 		}
 	} else {
-		o.R.Routines = append(o.R.Routines, related...)
+		o.R.WorkoutReactions = append(o.R.WorkoutReactions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &routineR{
+			rel.R = &workoutReactionR{
 				User: o,
 			}
 		} else {
@@ -2046,11 +5903,11 @@ func (o *User) AddRoutines(ctx context.Context, exec boil.ContextExecutor, inser
 	return nil
 }
 
-// AddWorkoutComments adds the given related objects to the existing relationships
+// AddWorkouts adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.WorkoutComments.
+// Appends related to o.R.Workouts.
 // Sets related.R.User appropriately.
-func (o *User) AddWorkoutComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WorkoutComment) error {
+func (o *User) AddWorkouts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Workout) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2060,9 +5917,9 @@ func (o *User) AddWorkoutComments(ctx context.Context, exec boil.ContextExecutor
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"getstronger\".\"workout_comments\" SET %s WHERE %s",
+				"UPDATE \"getstronger\".\"workouts\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, workoutCommentPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, workoutPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2081,15 +5938,15 @@ func (o *User) AddWorkoutComments(ctx context.Context, exec boil.ContextExecutor
 
 	if o.R == nil {
 		o.R = &userR{
-			WorkoutComments: related,
+			Workouts: related,
 		}
 	} else {
-		o.R.WorkoutComments = append(o.R.WorkoutComments, related...)
+		o.R.Workouts = append(o.R.Workouts, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &workoutCommentR{
+			rel.R = &workoutR{
 				User: o,
 			}
 		} else {
@@ -2099,11 +5956,11 @@ func (o *User) AddWorkoutComments(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
-// AddWorkouts adds the given related objects to the existing relationships
+// AddYearSummaries adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.Workouts.
+// Appends related to o.R.YearSummaries.
 // Sets related.R.User appropriately.
-func (o *User) AddWorkouts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Workout) error {
+func (o *User) AddYearSummaries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*YearSummary) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2113,9 +5970,9 @@ func (o *User) AddWorkouts(ctx context.Context, exec boil.ContextExecutor, inser
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"getstronger\".\"workouts\" SET %s WHERE %s",
+				"UPDATE \"getstronger\".\"year_summaries\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, workoutPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, yearSummaryPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2134,15 +5991,15 @@ func (o *User) AddWorkouts(ctx context.Context, exec boil.ContextExecutor, inser
 
 	if o.R == nil {
 		o.R = &userR{
-			Workouts: related,
+			YearSummaries: related,
 		}
 	} else {
-		o.R.Workouts = append(o.R.Workouts, related...)
+		o.R.YearSummaries = append(o.R.YearSummaries, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &workoutR{
+			rel.R = &yearSummaryR{
 				User: o,
 			}
 		} else {
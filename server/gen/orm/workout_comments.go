@@ -14,82 +14,118 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"github.com/volatiletech/strmangle"
 )
 
 // WorkoutComment is an object representing the database table.
 type WorkoutComment struct {
-	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	WorkoutID string    `boil:"workout_id" json:"workout_id" toml:"workout_id" yaml:"workout_id"`
-	Comment   string    `boil:"comment" json:"comment" toml:"comment" yaml:"comment"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID              string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID          string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	WorkoutID       string      `boil:"workout_id" json:"workout_id" toml:"workout_id" yaml:"workout_id"`
+	Comment         string      `boil:"comment" json:"comment" toml:"comment" yaml:"comment"`
+	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	EditedAt        null.Time   `boil:"edited_at" json:"edited_at,omitempty" toml:"edited_at" yaml:"edited_at,omitempty"`
+	ParentCommentID null.String `boil:"parent_comment_id" json:"parent_comment_id,omitempty" toml:"parent_comment_id" yaml:"parent_comment_id,omitempty"`
+	Mentions        types.JSON  `boil:"mentions" json:"mentions" toml:"mentions" yaml:"mentions"`
+	HiddenAt        null.Time   `boil:"hidden_at" json:"hidden_at,omitempty" toml:"hidden_at" yaml:"hidden_at,omitempty"`
 
 	R *workoutCommentR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L workoutCommentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var WorkoutCommentColumns = struct {
-	ID        string
-	UserID    string
-	WorkoutID string
-	Comment   string
-	CreatedAt string
+	ID              string
+	UserID          string
+	WorkoutID       string
+	Comment         string
+	CreatedAt       string
+	EditedAt        string
+	ParentCommentID string
+	Mentions        string
+	HiddenAt        string
 }{
-	ID:        "id",
-	UserID:    "user_id",
-	WorkoutID: "workout_id",
-	Comment:   "comment",
-	CreatedAt: "created_at",
+	ID:              "id",
+	UserID:          "user_id",
+	WorkoutID:       "workout_id",
+	Comment:         "comment",
+	CreatedAt:       "created_at",
+	EditedAt:        "edited_at",
+	ParentCommentID: "parent_comment_id",
+	Mentions:        "mentions",
+	HiddenAt:        "hidden_at",
 }
 
 var WorkoutCommentTableColumns = struct {
-	ID        string
-	UserID    string
-	WorkoutID string
-	Comment   string
-	CreatedAt string
+	ID              string
+	UserID          string
+	WorkoutID       string
+	Comment         string
+	CreatedAt       string
+	EditedAt        string
+	ParentCommentID string
+	Mentions        string
+	HiddenAt        string
 }{
-	ID:        "workout_comments.id",
-	UserID:    "workout_comments.user_id",
-	WorkoutID: "workout_comments.workout_id",
-	Comment:   "workout_comments.comment",
-	CreatedAt: "workout_comments.created_at",
+	ID:              "workout_comments.id",
+	UserID:          "workout_comments.user_id",
+	WorkoutID:       "workout_comments.workout_id",
+	Comment:         "workout_comments.comment",
+	CreatedAt:       "workout_comments.created_at",
+	EditedAt:        "workout_comments.edited_at",
+	ParentCommentID: "workout_comments.parent_comment_id",
+	Mentions:        "workout_comments.mentions",
+	HiddenAt:        "workout_comments.hidden_at",
 }
 
 // Generated where
 
 var WorkoutCommentWhere = struct {
-	ID        whereHelperstring
-	UserID    whereHelperstring
-	WorkoutID whereHelperstring
-	Comment   whereHelperstring
-	CreatedAt whereHelpertime_Time
+	ID              whereHelperstring
+	UserID          whereHelperstring
+	WorkoutID       whereHelperstring
+	Comment         whereHelperstring
+	CreatedAt       whereHelpertime_Time
+	EditedAt        whereHelpernull_Time
+	ParentCommentID whereHelpernull_String
+	Mentions        whereHelpertypes_JSON
+	HiddenAt        whereHelpernull_Time
 }{
-	ID:        whereHelperstring{field: "\"getstronger\".\"workout_comments\".\"id\""},
-	UserID:    whereHelperstring{field: "\"getstronger\".\"workout_comments\".\"user_id\""},
-	WorkoutID: whereHelperstring{field: "\"getstronger\".\"workout_comments\".\"workout_id\""},
-	Comment:   whereHelperstring{field: "\"getstronger\".\"workout_comments\".\"comment\""},
-	CreatedAt: whereHelpertime_Time{field: "\"getstronger\".\"workout_comments\".\"created_at\""},
+	ID:              whereHelperstring{field: "\"getstronger\".\"workout_comments\".\"id\""},
+	UserID:          whereHelperstring{field: "\"getstronger\".\"workout_comments\".\"user_id\""},
+	WorkoutID:       whereHelperstring{field: "\"getstronger\".\"workout_comments\".\"workout_id\""},
+	Comment:         whereHelperstring{field: "\"getstronger\".\"workout_comments\".\"comment\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"getstronger\".\"workout_comments\".\"created_at\""},
+	EditedAt:        whereHelpernull_Time{field: "\"getstronger\".\"workout_comments\".\"edited_at\""},
+	ParentCommentID: whereHelpernull_String{field: "\"getstronger\".\"workout_comments\".\"parent_comment_id\""},
+	Mentions:        whereHelpertypes_JSON{field: "\"getstronger\".\"workout_comments\".\"mentions\""},
+	HiddenAt:        whereHelpernull_Time{field: "\"getstronger\".\"workout_comments\".\"hidden_at\""},
 }
 
 // WorkoutCommentRels is where relationship names are stored.
 var WorkoutCommentRels = struct {
-	User    string
-	Workout string
+	ParentComment                string
+	User                         string
+	Workout                      string
+	ParentCommentWorkoutComments string
 }{
-	User:    "User",
-	Workout: "Workout",
+	ParentComment:                "ParentComment",
+	User:                         "User",
+	Workout:                      "Workout",
+	ParentCommentWorkoutComments: "ParentCommentWorkoutComments",
 }
 
 // workoutCommentR is where relationships are stored.
 type workoutCommentR struct {
-	User    *User    `boil:"User" json:"User" toml:"User" yaml:"User"`
-	Workout *Workout `boil:"Workout" json:"Workout" toml:"Workout" yaml:"Workout"`
+	ParentComment                *WorkoutComment     `boil:"ParentComment" json:"ParentComment" toml:"ParentComment" yaml:"ParentComment"`
+	User                         *User               `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Workout                      *Workout            `boil:"Workout" json:"Workout" toml:"Workout" yaml:"Workout"`
+	ParentCommentWorkoutComments WorkoutCommentSlice `boil:"ParentCommentWorkoutComments" json:"ParentCommentWorkoutComments" toml:"ParentCommentWorkoutComments" yaml:"ParentCommentWorkoutComments"`
 }
 
 // NewStruct creates a new relationship struct
@@ -97,6 +133,13 @@ func (*workoutCommentR) NewStruct() *workoutCommentR {
 	return &workoutCommentR{}
 }
 
+func (r *workoutCommentR) GetParentComment() *WorkoutComment {
+	if r == nil {
+		return nil
+	}
+	return r.ParentComment
+}
+
 func (r *workoutCommentR) GetUser() *User {
 	if r == nil {
 		return nil
@@ -111,13 +154,20 @@ func (r *workoutCommentR) GetWorkout() *Workout {
 	return r.Workout
 }
 
+func (r *workoutCommentR) GetParentCommentWorkoutComments() WorkoutCommentSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ParentCommentWorkoutComments
+}
+
 // workoutCommentL is where Load methods for each relationship are stored.
 type workoutCommentL struct{}
 
 var (
-	workoutCommentAllColumns            = []string{"id", "user_id", "workout_id", "comment", "created_at"}
+	workoutCommentAllColumns            = []string{"id", "user_id", "workout_id", "comment", "created_at", "edited_at", "parent_comment_id", "mentions", "hidden_at"}
 	workoutCommentColumnsWithoutDefault = []string{"user_id", "workout_id", "comment"}
-	workoutCommentColumnsWithDefault    = []string{"id", "created_at"}
+	workoutCommentColumnsWithDefault    = []string{"id", "created_at", "edited_at", "parent_comment_id", "mentions", "hidden_at"}
 	workoutCommentPrimaryKeyColumns     = []string{"id"}
 	workoutCommentGeneratedColumns      = []string{}
 )
@@ -427,6 +477,17 @@ func (q workoutCommentQuery) Exists(ctx context.Context, exec boil.ContextExecut
 	return count > 0, nil
 }
 
+// ParentComment pointed to by the foreign key.
+func (o *WorkoutComment) ParentComment(mods ...qm.QueryMod) workoutCommentQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ParentCommentID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return WorkoutComments(queryMods...)
+}
+
 // User pointed to by the foreign key.
 func (o *WorkoutComment) User(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -449,6 +510,144 @@ func (o *WorkoutComment) Workout(mods ...qm.QueryMod) workoutQuery {
 	return Workouts(queryMods...)
 }
 
+// ParentCommentWorkoutComments retrieves all the workout_comment's WorkoutComments with an executor via parent_comment_id column.
+func (o *WorkoutComment) ParentCommentWorkoutComments(mods ...qm.QueryMod) workoutCommentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"workout_comments\".\"parent_comment_id\"=?", o.ID),
+	)
+
+	return WorkoutComments(queryMods...)
+}
+
+// LoadParentComment allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (workoutCommentL) LoadParentComment(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWorkoutComment interface{}, mods queries.Applicator) error {
+	var slice []*WorkoutComment
+	var object *WorkoutComment
+
+	if singular {
+		var ok bool
+		object, ok = maybeWorkoutComment.(*WorkoutComment)
+		if !ok {
+			object = new(WorkoutComment)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWorkoutComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWorkoutComment))
+			}
+		}
+	} else {
+		s, ok := maybeWorkoutComment.(*[]*WorkoutComment)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWorkoutComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWorkoutComment))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &workoutCommentR{}
+		}
+		if !queries.IsNil(object.ParentCommentID) {
+			args[object.ParentCommentID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &workoutCommentR{}
+			}
+
+			if !queries.IsNil(obj.ParentCommentID) {
+				args[obj.ParentCommentID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.workout_comments`),
+		qm.WhereIn(`getstronger.workout_comments.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load WorkoutComment")
+	}
+
+	var resultSlice []*WorkoutComment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice WorkoutComment")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for workout_comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workout_comments")
+	}
+
+	if len(workoutCommentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ParentComment = foreign
+		if foreign.R == nil {
+			foreign.R = &workoutCommentR{}
+		}
+		foreign.R.ParentCommentWorkoutComments = append(foreign.R.ParentCommentWorkoutComments, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ParentCommentID, foreign.ID) {
+				local.R.ParentComment = foreign
+				if foreign.R == nil {
+					foreign.R = &workoutCommentR{}
+				}
+				foreign.R.ParentCommentWorkoutComments = append(foreign.R.ParentCommentWorkoutComments, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (workoutCommentL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWorkoutComment interface{}, mods queries.Applicator) error {
@@ -689,6 +888,199 @@ func (workoutCommentL) LoadWorkout(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
+// LoadParentCommentWorkoutComments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (workoutCommentL) LoadParentCommentWorkoutComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWorkoutComment interface{}, mods queries.Applicator) error {
+	var slice []*WorkoutComment
+	var object *WorkoutComment
+
+	if singular {
+		var ok bool
+		object, ok = maybeWorkoutComment.(*WorkoutComment)
+		if !ok {
+			object = new(WorkoutComment)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWorkoutComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWorkoutComment))
+			}
+		}
+	} else {
+		s, ok := maybeWorkoutComment.(*[]*WorkoutComment)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWorkoutComment)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWorkoutComment))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &workoutCommentR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &workoutCommentR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.workout_comments`),
+		qm.WhereIn(`getstronger.workout_comments.parent_comment_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load workout_comments")
+	}
+
+	var resultSlice []*WorkoutComment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice workout_comments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on workout_comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workout_comments")
+	}
+
+	if len(workoutCommentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ParentCommentWorkoutComments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &workoutCommentR{}
+			}
+			foreign.R.ParentComment = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ParentCommentID) {
+				local.R.ParentCommentWorkoutComments = append(local.R.ParentCommentWorkoutComments, foreign)
+				if foreign.R == nil {
+					foreign.R = &workoutCommentR{}
+				}
+				foreign.R.ParentComment = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetParentComment of the workoutComment to the related item.
+// Sets o.R.ParentComment to related.
+// Adds o to related.R.ParentCommentWorkoutComments.
+func (o *WorkoutComment) SetParentComment(ctx context.Context, exec boil.ContextExecutor, insert bool, related *WorkoutComment) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"workout_comments\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"parent_comment_id"}),
+		strmangle.WhereClause("\"", "\"", 2, workoutCommentPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ParentCommentID, related.ID)
+	if o.R == nil {
+		o.R = &workoutCommentR{
+			ParentComment: related,
+		}
+	} else {
+		o.R.ParentComment = related
+	}
+
+	if related.R == nil {
+		related.R = &workoutCommentR{
+			ParentCommentWorkoutComments: WorkoutCommentSlice{o},
+		}
+	} else {
+		related.R.ParentCommentWorkoutComments = append(related.R.ParentCommentWorkoutComments, o)
+	}
+
+	return nil
+}
+
+// RemoveParentComment relationship.
+// Sets o.R.ParentComment to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *WorkoutComment) RemoveParentComment(ctx context.Context, exec boil.ContextExecutor, related *WorkoutComment) error {
+	var err error
+
+	queries.SetScanner(&o.ParentCommentID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("parent_comment_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ParentComment = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ParentCommentWorkoutComments {
+		if queries.Equal(o.ParentCommentID, ri.ParentCommentID) {
+			continue
+		}
+
+		ln := len(related.R.ParentCommentWorkoutComments)
+		if ln > 1 && i < ln-1 {
+			related.R.ParentCommentWorkoutComments[i] = related.R.ParentCommentWorkoutComments[ln-1]
+		}
+		related.R.ParentCommentWorkoutComments = related.R.ParentCommentWorkoutComments[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetUser of the workoutComment to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.WorkoutComments.
@@ -783,6 +1175,133 @@ func (o *WorkoutComment) SetWorkout(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
+// AddParentCommentWorkoutComments adds the given related objects to the existing relationships
+// of the workout_comment, optionally inserting them as new records.
+// Appends related to o.R.ParentCommentWorkoutComments.
+// Sets related.R.ParentComment appropriately.
+func (o *WorkoutComment) AddParentCommentWorkoutComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WorkoutComment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ParentCommentID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"workout_comments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"parent_comment_id"}),
+				strmangle.WhereClause("\"", "\"", 2, workoutCommentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ParentCommentID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &workoutCommentR{
+			ParentCommentWorkoutComments: related,
+		}
+	} else {
+		o.R.ParentCommentWorkoutComments = append(o.R.ParentCommentWorkoutComments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &workoutCommentR{
+				ParentComment: o,
+			}
+		} else {
+			rel.R.ParentComment = o
+		}
+	}
+	return nil
+}
+
+// SetParentCommentWorkoutComments removes all previously related items of the
+// workout_comment replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ParentComment's ParentCommentWorkoutComments accordingly.
+// Replaces o.R.ParentCommentWorkoutComments with related.
+// Sets related.R.ParentComment's ParentCommentWorkoutComments accordingly.
+func (o *WorkoutComment) SetParentCommentWorkoutComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WorkoutComment) error {
+	query := "update \"getstronger\".\"workout_comments\" set \"parent_comment_id\" = null where \"parent_comment_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ParentCommentWorkoutComments {
+			queries.SetScanner(&rel.ParentCommentID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ParentComment = nil
+		}
+		o.R.ParentCommentWorkoutComments = nil
+	}
+
+	return o.AddParentCommentWorkoutComments(ctx, exec, insert, related...)
+}
+
+// RemoveParentCommentWorkoutComments relationships from objects passed in.
+// Removes related items from R.ParentCommentWorkoutComments (uses pointer comparison, removal does not keep order)
+// Sets related.R.ParentComment.
+func (o *WorkoutComment) RemoveParentCommentWorkoutComments(ctx context.Context, exec boil.ContextExecutor, related ...*WorkoutComment) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ParentCommentID, nil)
+		if rel.R != nil {
+			rel.R.ParentComment = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("parent_comment_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ParentCommentWorkoutComments {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ParentCommentWorkoutComments)
+			if ln > 1 && i < ln-1 {
+				o.R.ParentCommentWorkoutComments[i] = o.R.ParentCommentWorkoutComments[ln-1]
+			}
+			o.R.ParentCommentWorkoutComments = o.R.ParentCommentWorkoutComments[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // WorkoutComments retrieves all the records using an executor.
 func WorkoutComments(mods ...qm.QueryMod) workoutCommentQuery {
 	mods = append(mods, qm.From("\"getstronger\".\"workout_comments\""))
@@ -0,0 +1,1375 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WorkoutReaction is an object representing the database table.
+type WorkoutReaction struct {
+	ID        string       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID    string       `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	WorkoutID string       `boil:"workout_id" json:"workout_id" toml:"workout_id" yaml:"workout_id"`
+	Type      ReactionType `boil:"type" json:"type" toml:"type" yaml:"type"`
+	CreatedAt time.Time    `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *workoutReactionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L workoutReactionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WorkoutReactionColumns = struct {
+	ID        string
+	UserID    string
+	WorkoutID string
+	Type      string
+	CreatedAt string
+}{
+	ID:        "id",
+	UserID:    "user_id",
+	WorkoutID: "workout_id",
+	Type:      "type",
+	CreatedAt: "created_at",
+}
+
+var WorkoutReactionTableColumns = struct {
+	ID        string
+	UserID    string
+	WorkoutID string
+	Type      string
+	CreatedAt string
+}{
+	ID:        "workout_reactions.id",
+	UserID:    "workout_reactions.user_id",
+	WorkoutID: "workout_reactions.workout_id",
+	Type:      "workout_reactions.type",
+	CreatedAt: "workout_reactions.created_at",
+}
+
+// Generated where
+
+type whereHelperReactionType struct{ field string }
+
+func (w whereHelperReactionType) EQ(x ReactionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelperReactionType) NEQ(x ReactionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperReactionType) LT(x ReactionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelperReactionType) LTE(x ReactionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperReactionType) GT(x ReactionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelperReactionType) GTE(x ReactionType) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperReactionType) IN(slice []ReactionType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperReactionType) NIN(slice []ReactionType) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var WorkoutReactionWhere = struct {
+	ID        whereHelperstring
+	UserID    whereHelperstring
+	WorkoutID whereHelperstring
+	Type      whereHelperReactionType
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"getstronger\".\"workout_reactions\".\"id\""},
+	UserID:    whereHelperstring{field: "\"getstronger\".\"workout_reactions\".\"user_id\""},
+	WorkoutID: whereHelperstring{field: "\"getstronger\".\"workout_reactions\".\"workout_id\""},
+	Type:      whereHelperReactionType{field: "\"getstronger\".\"workout_reactions\".\"type\""},
+	CreatedAt: whereHelpertime_Time{field: "\"getstronger\".\"workout_reactions\".\"created_at\""},
+}
+
+// WorkoutReactionRels is where relationship names are stored.
+var WorkoutReactionRels = struct {
+	User    string
+	Workout string
+}{
+	User:    "User",
+	Workout: "Workout",
+}
+
+// workoutReactionR is where relationships are stored.
+type workoutReactionR struct {
+	User    *User    `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Workout *Workout `boil:"Workout" json:"Workout" toml:"Workout" yaml:"Workout"`
+}
+
+// NewStruct creates a new relationship struct
+func (*workoutReactionR) NewStruct() *workoutReactionR {
+	return &workoutReactionR{}
+}
+
+func (r *workoutReactionR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *workoutReactionR) GetWorkout() *Workout {
+	if r == nil {
+		return nil
+	}
+	return r.Workout
+}
+
+// workoutReactionL is where Load methods for each relationship are stored.
+type workoutReactionL struct{}
+
+var (
+	workoutReactionAllColumns            = []string{"id", "user_id", "workout_id", "type", "created_at"}
+	workoutReactionColumnsWithoutDefault = []string{"user_id", "workout_id", "type"}
+	workoutReactionColumnsWithDefault    = []string{"id", "created_at"}
+	workoutReactionPrimaryKeyColumns     = []string{"id"}
+	workoutReactionGeneratedColumns      = []string{}
+)
+
+type (
+	// WorkoutReactionSlice is an alias for a slice of pointers to WorkoutReaction.
+	// This should almost always be used instead of []WorkoutReaction.
+	WorkoutReactionSlice []*WorkoutReaction
+	// WorkoutReactionHook is the signature for custom WorkoutReaction hook methods
+	WorkoutReactionHook func(context.Context, boil.ContextExecutor, *WorkoutReaction) error
+
+	workoutReactionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	workoutReactionType                 = reflect.TypeOf(&WorkoutReaction{})
+	workoutReactionMapping              = queries.MakeStructMapping(workoutReactionType)
+	workoutReactionPrimaryKeyMapping, _ = queries.BindMapping(workoutReactionType, workoutReactionMapping, workoutReactionPrimaryKeyColumns)
+	workoutReactionInsertCacheMut       sync.RWMutex
+	workoutReactionInsertCache          = make(map[string]insertCache)
+	workoutReactionUpdateCacheMut       sync.RWMutex
+	workoutReactionUpdateCache          = make(map[string]updateCache)
+	workoutReactionUpsertCacheMut       sync.RWMutex
+	workoutReactionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var workoutReactionAfterSelectMu sync.Mutex
+var workoutReactionAfterSelectHooks []WorkoutReactionHook
+
+var workoutReactionBeforeInsertMu sync.Mutex
+var workoutReactionBeforeInsertHooks []WorkoutReactionHook
+var workoutReactionAfterInsertMu sync.Mutex
+var workoutReactionAfterInsertHooks []WorkoutReactionHook
+
+var workoutReactionBeforeUpdateMu sync.Mutex
+var workoutReactionBeforeUpdateHooks []WorkoutReactionHook
+var workoutReactionAfterUpdateMu sync.Mutex
+var workoutReactionAfterUpdateHooks []WorkoutReactionHook
+
+var workoutReactionBeforeDeleteMu sync.Mutex
+var workoutReactionBeforeDeleteHooks []WorkoutReactionHook
+var workoutReactionAfterDeleteMu sync.Mutex
+var workoutReactionAfterDeleteHooks []WorkoutReactionHook
+
+var workoutReactionBeforeUpsertMu sync.Mutex
+var workoutReactionBeforeUpsertHooks []WorkoutReactionHook
+var workoutReactionAfterUpsertMu sync.Mutex
+var workoutReactionAfterUpsertHooks []WorkoutReactionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WorkoutReaction) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workoutReactionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WorkoutReaction) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workoutReactionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WorkoutReaction) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workoutReactionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WorkoutReaction) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workoutReactionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WorkoutReaction) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workoutReactionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WorkoutReaction) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workoutReactionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WorkoutReaction) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workoutReactionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WorkoutReaction) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workoutReactionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WorkoutReaction) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workoutReactionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWorkoutReactionHook registers your hook function for all future operations.
+func AddWorkoutReactionHook(hookPoint boil.HookPoint, workoutReactionHook WorkoutReactionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		workoutReactionAfterSelectMu.Lock()
+		workoutReactionAfterSelectHooks = append(workoutReactionAfterSelectHooks, workoutReactionHook)
+		workoutReactionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		workoutReactionBeforeInsertMu.Lock()
+		workoutReactionBeforeInsertHooks = append(workoutReactionBeforeInsertHooks, workoutReactionHook)
+		workoutReactionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		workoutReactionAfterInsertMu.Lock()
+		workoutReactionAfterInsertHooks = append(workoutReactionAfterInsertHooks, workoutReactionHook)
+		workoutReactionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		workoutReactionBeforeUpdateMu.Lock()
+		workoutReactionBeforeUpdateHooks = append(workoutReactionBeforeUpdateHooks, workoutReactionHook)
+		workoutReactionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		workoutReactionAfterUpdateMu.Lock()
+		workoutReactionAfterUpdateHooks = append(workoutReactionAfterUpdateHooks, workoutReactionHook)
+		workoutReactionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		workoutReactionBeforeDeleteMu.Lock()
+		workoutReactionBeforeDeleteHooks = append(workoutReactionBeforeDeleteHooks, workoutReactionHook)
+		workoutReactionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		workoutReactionAfterDeleteMu.Lock()
+		workoutReactionAfterDeleteHooks = append(workoutReactionAfterDeleteHooks, workoutReactionHook)
+		workoutReactionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		workoutReactionBeforeUpsertMu.Lock()
+		workoutReactionBeforeUpsertHooks = append(workoutReactionBeforeUpsertHooks, workoutReactionHook)
+		workoutReactionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		workoutReactionAfterUpsertMu.Lock()
+		workoutReactionAfterUpsertHooks = append(workoutReactionAfterUpsertHooks, workoutReactionHook)
+		workoutReactionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single workoutReaction record from the query.
+func (q workoutReactionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WorkoutReaction, error) {
+	o := &WorkoutReaction{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for workout_reactions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WorkoutReaction records from the query.
+func (q workoutReactionQuery) All(ctx context.Context, exec boil.ContextExecutor) (WorkoutReactionSlice, error) {
+	var o []*WorkoutReaction
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to WorkoutReaction slice")
+	}
+
+	if len(workoutReactionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WorkoutReaction records in the query.
+func (q workoutReactionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count workout_reactions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q workoutReactionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if workout_reactions exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *WorkoutReaction) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Workout pointed to by the foreign key.
+func (o *WorkoutReaction) Workout(mods ...qm.QueryMod) workoutQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WorkoutID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Workouts(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (workoutReactionL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWorkoutReaction interface{}, mods queries.Applicator) error {
+	var slice []*WorkoutReaction
+	var object *WorkoutReaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeWorkoutReaction.(*WorkoutReaction)
+		if !ok {
+			object = new(WorkoutReaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWorkoutReaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWorkoutReaction))
+			}
+		}
+	} else {
+		s, ok := maybeWorkoutReaction.(*[]*WorkoutReaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWorkoutReaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWorkoutReaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &workoutReactionR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &workoutReactionR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.WorkoutReactions = append(foreign.R.WorkoutReactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.WorkoutReactions = append(foreign.R.WorkoutReactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWorkout allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (workoutReactionL) LoadWorkout(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWorkoutReaction interface{}, mods queries.Applicator) error {
+	var slice []*WorkoutReaction
+	var object *WorkoutReaction
+
+	if singular {
+		var ok bool
+		object, ok = maybeWorkoutReaction.(*WorkoutReaction)
+		if !ok {
+			object = new(WorkoutReaction)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWorkoutReaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWorkoutReaction))
+			}
+		}
+	} else {
+		s, ok := maybeWorkoutReaction.(*[]*WorkoutReaction)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWorkoutReaction)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWorkoutReaction))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &workoutReactionR{}
+		}
+		args[object.WorkoutID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &workoutReactionR{}
+			}
+
+			args[obj.WorkoutID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.workouts`),
+		qm.WhereIn(`getstronger.workouts.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Workout")
+	}
+
+	var resultSlice []*Workout
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Workout")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for workouts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workouts")
+	}
+
+	if len(workoutAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Workout = foreign
+		if foreign.R == nil {
+			foreign.R = &workoutR{}
+		}
+		foreign.R.WorkoutReactions = append(foreign.R.WorkoutReactions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WorkoutID == foreign.ID {
+				local.R.Workout = foreign
+				if foreign.R == nil {
+					foreign.R = &workoutR{}
+				}
+				foreign.R.WorkoutReactions = append(foreign.R.WorkoutReactions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the workoutReaction to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.WorkoutReactions.
+func (o *WorkoutReaction) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"workout_reactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, workoutReactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &workoutReactionR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			WorkoutReactions: WorkoutReactionSlice{o},
+		}
+	} else {
+		related.R.WorkoutReactions = append(related.R.WorkoutReactions, o)
+	}
+
+	return nil
+}
+
+// SetWorkout of the workoutReaction to the related item.
+// Sets o.R.Workout to related.
+// Adds o to related.R.WorkoutReactions.
+func (o *WorkoutReaction) SetWorkout(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Workout) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"workout_reactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"workout_id"}),
+		strmangle.WhereClause("\"", "\"", 2, workoutReactionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WorkoutID = related.ID
+	if o.R == nil {
+		o.R = &workoutReactionR{
+			Workout: related,
+		}
+	} else {
+		o.R.Workout = related
+	}
+
+	if related.R == nil {
+		related.R = &workoutR{
+			WorkoutReactions: WorkoutReactionSlice{o},
+		}
+	} else {
+		related.R.WorkoutReactions = append(related.R.WorkoutReactions, o)
+	}
+
+	return nil
+}
+
+// WorkoutReactions retrieves all the records using an executor.
+func WorkoutReactions(mods ...qm.QueryMod) workoutReactionQuery {
+	mods = append(mods, qm.From("\"getstronger\".\"workout_reactions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"getstronger\".\"workout_reactions\".*"})
+	}
+
+	return workoutReactionQuery{q}
+}
+
+// FindWorkoutReaction retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWorkoutReaction(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*WorkoutReaction, error) {
+	workoutReactionObj := &WorkoutReaction{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"getstronger\".\"workout_reactions\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, workoutReactionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from workout_reactions")
+	}
+
+	if err = workoutReactionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return workoutReactionObj, err
+	}
+
+	return workoutReactionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WorkoutReaction) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no workout_reactions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(workoutReactionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	workoutReactionInsertCacheMut.RLock()
+	cache, cached := workoutReactionInsertCache[key]
+	workoutReactionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			workoutReactionAllColumns,
+			workoutReactionColumnsWithDefault,
+			workoutReactionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(workoutReactionType, workoutReactionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(workoutReactionType, workoutReactionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"getstronger\".\"workout_reactions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"getstronger\".\"workout_reactions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into workout_reactions")
+	}
+
+	if !cached {
+		workoutReactionInsertCacheMut.Lock()
+		workoutReactionInsertCache[key] = cache
+		workoutReactionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WorkoutReaction.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WorkoutReaction) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	workoutReactionUpdateCacheMut.RLock()
+	cache, cached := workoutReactionUpdateCache[key]
+	workoutReactionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			workoutReactionAllColumns,
+			workoutReactionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update workout_reactions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"getstronger\".\"workout_reactions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, workoutReactionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(workoutReactionType, workoutReactionMapping, append(wl, workoutReactionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update workout_reactions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for workout_reactions")
+	}
+
+	if !cached {
+		workoutReactionUpdateCacheMut.Lock()
+		workoutReactionUpdateCache[key] = cache
+		workoutReactionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q workoutReactionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for workout_reactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for workout_reactions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WorkoutReactionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), workoutReactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"getstronger\".\"workout_reactions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, workoutReactionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in workoutReaction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all workoutReaction")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WorkoutReaction) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no workout_reactions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(workoutReactionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	workoutReactionUpsertCacheMut.RLock()
+	cache, cached := workoutReactionUpsertCache[key]
+	workoutReactionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			workoutReactionAllColumns,
+			workoutReactionColumnsWithDefault,
+			workoutReactionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			workoutReactionAllColumns,
+			workoutReactionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert workout_reactions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(workoutReactionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(workoutReactionPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert workout_reactions, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(workoutReactionPrimaryKeyColumns))
+			copy(conflict, workoutReactionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"getstronger\".\"workout_reactions\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(workoutReactionType, workoutReactionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(workoutReactionType, workoutReactionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert workout_reactions")
+	}
+
+	if !cached {
+		workoutReactionUpsertCacheMut.Lock()
+		workoutReactionUpsertCache[key] = cache
+		workoutReactionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WorkoutReaction record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WorkoutReaction) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no WorkoutReaction provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), workoutReactionPrimaryKeyMapping)
+	sql := "DELETE FROM \"getstronger\".\"workout_reactions\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from workout_reactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for workout_reactions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q workoutReactionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no workoutReactionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from workout_reactions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for workout_reactions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WorkoutReactionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(workoutReactionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), workoutReactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"getstronger\".\"workout_reactions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, workoutReactionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from workoutReaction slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for workout_reactions")
+	}
+
+	if len(workoutReactionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WorkoutReaction) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWorkoutReaction(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WorkoutReactionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WorkoutReactionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), workoutReactionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"getstronger\".\"workout_reactions\".* FROM \"getstronger\".\"workout_reactions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, workoutReactionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in WorkoutReactionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WorkoutReactionExists checks if the WorkoutReaction row exists.
+func WorkoutReactionExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"getstronger\".\"workout_reactions\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if workout_reactions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WorkoutReaction row exists.
+func (o *WorkoutReaction) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WorkoutReactionExists(ctx, exec, o.ID)
+}
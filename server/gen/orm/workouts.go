@@ -19,95 +19,137 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"github.com/volatiletech/strmangle"
 )
 
 // Workout is an object representing the database table.
 type Workout struct {
-	ID         string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID     string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	FinishedAt time.Time   `boil:"finished_at" json:"finished_at" toml:"finished_at" yaml:"finished_at"`
-	CreatedAt  time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	Name       string      `boil:"name" json:"name" toml:"name" yaml:"name"`
-	StartedAt  time.Time   `boil:"started_at" json:"started_at" toml:"started_at" yaml:"started_at"`
-	Note       null.String `boil:"note" json:"note,omitempty" toml:"note" yaml:"note,omitempty"`
+	ID           string            `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID       string            `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	FinishedAt   time.Time         `boil:"finished_at" json:"finished_at" toml:"finished_at" yaml:"finished_at"`
+	CreatedAt    time.Time         `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	Name         string            `boil:"name" json:"name" toml:"name" yaml:"name"`
+	StartedAt    time.Time         `boil:"started_at" json:"started_at" toml:"started_at" yaml:"started_at"`
+	Note         null.String       `boil:"note" json:"note,omitempty" toml:"note" yaml:"note,omitempty"`
+	DeletedAt    null.Time         `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ImportKey    null.String       `boil:"import_key" json:"import_key,omitempty" toml:"import_key" yaml:"import_key,omitempty"`
+	NoteMentions types.JSON        `boil:"note_mentions" json:"note_mentions" toml:"note_mentions" yaml:"note_mentions"`
+	Visibility   WorkoutVisibility `boil:"visibility" json:"visibility" toml:"visibility" yaml:"visibility"`
+	HiddenAt     null.Time         `boil:"hidden_at" json:"hidden_at,omitempty" toml:"hidden_at" yaml:"hidden_at,omitempty"`
 
 	R *workoutR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L workoutL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var WorkoutColumns = struct {
-	ID         string
-	UserID     string
-	FinishedAt string
-	CreatedAt  string
-	Name       string
-	StartedAt  string
-	Note       string
+	ID           string
+	UserID       string
+	FinishedAt   string
+	CreatedAt    string
+	Name         string
+	StartedAt    string
+	Note         string
+	DeletedAt    string
+	ImportKey    string
+	NoteMentions string
+	Visibility   string
+	HiddenAt     string
 }{
-	ID:         "id",
-	UserID:     "user_id",
-	FinishedAt: "finished_at",
-	CreatedAt:  "created_at",
-	Name:       "name",
-	StartedAt:  "started_at",
-	Note:       "note",
+	ID:           "id",
+	UserID:       "user_id",
+	FinishedAt:   "finished_at",
+	CreatedAt:    "created_at",
+	Name:         "name",
+	StartedAt:    "started_at",
+	Note:         "note",
+	DeletedAt:    "deleted_at",
+	ImportKey:    "import_key",
+	NoteMentions: "note_mentions",
+	Visibility:   "visibility",
+	HiddenAt:     "hidden_at",
 }
 
 var WorkoutTableColumns = struct {
-	ID         string
-	UserID     string
-	FinishedAt string
-	CreatedAt  string
-	Name       string
-	StartedAt  string
-	Note       string
+	ID           string
+	UserID       string
+	FinishedAt   string
+	CreatedAt    string
+	Name         string
+	StartedAt    string
+	Note         string
+	DeletedAt    string
+	ImportKey    string
+	NoteMentions string
+	Visibility   string
+	HiddenAt     string
 }{
-	ID:         "workouts.id",
-	UserID:     "workouts.user_id",
-	FinishedAt: "workouts.finished_at",
-	CreatedAt:  "workouts.created_at",
-	Name:       "workouts.name",
-	StartedAt:  "workouts.started_at",
-	Note:       "workouts.note",
+	ID:           "workouts.id",
+	UserID:       "workouts.user_id",
+	FinishedAt:   "workouts.finished_at",
+	CreatedAt:    "workouts.created_at",
+	Name:         "workouts.name",
+	StartedAt:    "workouts.started_at",
+	Note:         "workouts.note",
+	DeletedAt:    "workouts.deleted_at",
+	ImportKey:    "workouts.import_key",
+	NoteMentions: "workouts.note_mentions",
+	Visibility:   "workouts.visibility",
+	HiddenAt:     "workouts.hidden_at",
 }
 
 // Generated where
 
 var WorkoutWhere = struct {
-	ID         whereHelperstring
-	UserID     whereHelperstring
-	FinishedAt whereHelpertime_Time
-	CreatedAt  whereHelpertime_Time
-	Name       whereHelperstring
-	StartedAt  whereHelpertime_Time
-	Note       whereHelpernull_String
+	ID           whereHelperstring
+	UserID       whereHelperstring
+	FinishedAt   whereHelpertime_Time
+	CreatedAt    whereHelpertime_Time
+	Name         whereHelperstring
+	StartedAt    whereHelpertime_Time
+	Note         whereHelpernull_String
+	DeletedAt    whereHelpernull_Time
+	ImportKey    whereHelpernull_String
+	NoteMentions whereHelpertypes_JSON
+	Visibility   whereHelperWorkoutVisibility
+	HiddenAt     whereHelpernull_Time
 }{
-	ID:         whereHelperstring{field: "\"getstronger\".\"workouts\".\"id\""},
-	UserID:     whereHelperstring{field: "\"getstronger\".\"workouts\".\"user_id\""},
-	FinishedAt: whereHelpertime_Time{field: "\"getstronger\".\"workouts\".\"finished_at\""},
-	CreatedAt:  whereHelpertime_Time{field: "\"getstronger\".\"workouts\".\"created_at\""},
-	Name:       whereHelperstring{field: "\"getstronger\".\"workouts\".\"name\""},
-	StartedAt:  whereHelpertime_Time{field: "\"getstronger\".\"workouts\".\"started_at\""},
-	Note:       whereHelpernull_String{field: "\"getstronger\".\"workouts\".\"note\""},
+	ID:           whereHelperstring{field: "\"getstronger\".\"workouts\".\"id\""},
+	UserID:       whereHelperstring{field: "\"getstronger\".\"workouts\".\"user_id\""},
+	FinishedAt:   whereHelpertime_Time{field: "\"getstronger\".\"workouts\".\"finished_at\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"getstronger\".\"workouts\".\"created_at\""},
+	Name:         whereHelperstring{field: "\"getstronger\".\"workouts\".\"name\""},
+	StartedAt:    whereHelpertime_Time{field: "\"getstronger\".\"workouts\".\"started_at\""},
+	Note:         whereHelpernull_String{field: "\"getstronger\".\"workouts\".\"note\""},
+	DeletedAt:    whereHelpernull_Time{field: "\"getstronger\".\"workouts\".\"deleted_at\""},
+	ImportKey:    whereHelpernull_String{field: "\"getstronger\".\"workouts\".\"import_key\""},
+	NoteMentions: whereHelpertypes_JSON{field: "\"getstronger\".\"workouts\".\"note_mentions\""},
+	Visibility:   whereHelperWorkoutVisibility{field: "\"getstronger\".\"workouts\".\"visibility\""},
+	HiddenAt:     whereHelpernull_Time{field: "\"getstronger\".\"workouts\".\"hidden_at\""},
 }
 
 // WorkoutRels is where relationship names are stored.
 var WorkoutRels = struct {
-	User            string
-	Sets            string
-	WorkoutComments string
+	User             string
+	PersonalRecords  string
+	Sets             string
+	WorkoutComments  string
+	WorkoutReactions string
 }{
-	User:            "User",
-	Sets:            "Sets",
-	WorkoutComments: "WorkoutComments",
+	User:             "User",
+	PersonalRecords:  "PersonalRecords",
+	Sets:             "Sets",
+	WorkoutComments:  "WorkoutComments",
+	WorkoutReactions: "WorkoutReactions",
 }
 
 // workoutR is where relationships are stored.
 type workoutR struct {
-	User            *User               `boil:"User" json:"User" toml:"User" yaml:"User"`
-	Sets            SetSlice            `boil:"Sets" json:"Sets" toml:"Sets" yaml:"Sets"`
-	WorkoutComments WorkoutCommentSlice `boil:"WorkoutComments" json:"WorkoutComments" toml:"WorkoutComments" yaml:"WorkoutComments"`
+	User             *User                `boil:"User" json:"User" toml:"User" yaml:"User"`
+	PersonalRecords  PersonalRecordSlice  `boil:"PersonalRecords" json:"PersonalRecords" toml:"PersonalRecords" yaml:"PersonalRecords"`
+	Sets             SetSlice             `boil:"Sets" json:"Sets" toml:"Sets" yaml:"Sets"`
+	WorkoutComments  WorkoutCommentSlice  `boil:"WorkoutComments" json:"WorkoutComments" toml:"WorkoutComments" yaml:"WorkoutComments"`
+	WorkoutReactions WorkoutReactionSlice `boil:"WorkoutReactions" json:"WorkoutReactions" toml:"WorkoutReactions" yaml:"WorkoutReactions"`
 }
 
 // NewStruct creates a new relationship struct
@@ -122,6 +164,13 @@ func (r *workoutR) GetUser() *User {
 	return r.User
 }
 
+func (r *workoutR) GetPersonalRecords() PersonalRecordSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PersonalRecords
+}
+
 func (r *workoutR) GetSets() SetSlice {
 	if r == nil {
 		return nil
@@ -136,13 +185,20 @@ func (r *workoutR) GetWorkoutComments() WorkoutCommentSlice {
 	return r.WorkoutComments
 }
 
+func (r *workoutR) GetWorkoutReactions() WorkoutReactionSlice {
+	if r == nil {
+		return nil
+	}
+	return r.WorkoutReactions
+}
+
 // workoutL is where Load methods for each relationship are stored.
 type workoutL struct{}
 
 var (
-	workoutAllColumns            = []string{"id", "user_id", "finished_at", "created_at", "name", "started_at", "note"}
+	workoutAllColumns            = []string{"id", "user_id", "finished_at", "created_at", "name", "started_at", "note", "deleted_at", "import_key", "note_mentions", "visibility", "hidden_at"}
 	workoutColumnsWithoutDefault = []string{"user_id", "finished_at", "name", "started_at"}
-	workoutColumnsWithDefault    = []string{"id", "created_at", "note"}
+	workoutColumnsWithDefault    = []string{"id", "created_at", "note", "deleted_at", "import_key", "note_mentions", "visibility", "hidden_at"}
 	workoutPrimaryKeyColumns     = []string{"id"}
 	workoutGeneratedColumns      = []string{}
 )
@@ -463,6 +519,20 @@ func (o *Workout) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// PersonalRecords retrieves all the personal_record's PersonalRecords with an executor.
+func (o *Workout) PersonalRecords(mods ...qm.QueryMod) personalRecordQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"personal_records\".\"workout_id\"=?", o.ID),
+	)
+
+	return PersonalRecords(queryMods...)
+}
+
 // Sets retrieves all the set's Sets with an executor.
 func (o *Workout) Sets(mods ...qm.QueryMod) setQuery {
 	var queryMods []qm.QueryMod
@@ -491,6 +561,20 @@ func (o *Workout) WorkoutComments(mods ...qm.QueryMod) workoutCommentQuery {
 	return WorkoutComments(queryMods...)
 }
 
+// WorkoutReactions retrieves all the workout_reaction's WorkoutReactions with an executor.
+func (o *Workout) WorkoutReactions(mods ...qm.QueryMod) workoutReactionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"getstronger\".\"workout_reactions\".\"workout_id\"=?", o.ID),
+	)
+
+	return WorkoutReactions(queryMods...)
+}
+
 // LoadUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (workoutL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWorkout interface{}, mods queries.Applicator) error {
@@ -611,6 +695,119 @@ func (workoutL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular b
 	return nil
 }
 
+// LoadPersonalRecords allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (workoutL) LoadPersonalRecords(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWorkout interface{}, mods queries.Applicator) error {
+	var slice []*Workout
+	var object *Workout
+
+	if singular {
+		var ok bool
+		object, ok = maybeWorkout.(*Workout)
+		if !ok {
+			object = new(Workout)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWorkout)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWorkout))
+			}
+		}
+	} else {
+		s, ok := maybeWorkout.(*[]*Workout)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWorkout)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWorkout))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &workoutR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &workoutR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.personal_records`),
+		qm.WhereIn(`getstronger.personal_records.workout_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load personal_records")
+	}
+
+	var resultSlice []*PersonalRecord
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice personal_records")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on personal_records")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for personal_records")
+	}
+
+	if len(personalRecordAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PersonalRecords = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &personalRecordR{}
+			}
+			foreign.R.Workout = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WorkoutID {
+				local.R.PersonalRecords = append(local.R.PersonalRecords, foreign)
+				if foreign.R == nil {
+					foreign.R = &personalRecordR{}
+				}
+				foreign.R.Workout = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadSets allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (workoutL) LoadSets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWorkout interface{}, mods queries.Applicator) error {
@@ -837,6 +1034,119 @@ func (workoutL) LoadWorkoutComments(ctx context.Context, e boil.ContextExecutor,
 	return nil
 }
 
+// LoadWorkoutReactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (workoutL) LoadWorkoutReactions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWorkout interface{}, mods queries.Applicator) error {
+	var slice []*Workout
+	var object *Workout
+
+	if singular {
+		var ok bool
+		object, ok = maybeWorkout.(*Workout)
+		if !ok {
+			object = new(Workout)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWorkout)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWorkout))
+			}
+		}
+	} else {
+		s, ok := maybeWorkout.(*[]*Workout)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWorkout)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWorkout))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &workoutR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &workoutR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.workout_reactions`),
+		qm.WhereIn(`getstronger.workout_reactions.workout_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load workout_reactions")
+	}
+
+	var resultSlice []*WorkoutReaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice workout_reactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on workout_reactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workout_reactions")
+	}
+
+	if len(workoutReactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WorkoutReactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &workoutReactionR{}
+			}
+			foreign.R.Workout = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WorkoutID {
+				local.R.WorkoutReactions = append(local.R.WorkoutReactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &workoutReactionR{}
+				}
+				foreign.R.Workout = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetUser of the workout to the related item.
 // Sets o.R.User to related.
 // Adds o to related.R.Workouts.
@@ -884,6 +1194,59 @@ func (o *Workout) SetUser(ctx context.Context, exec boil.ContextExecutor, insert
 	return nil
 }
 
+// AddPersonalRecords adds the given related objects to the existing relationships
+// of the workout, optionally inserting them as new records.
+// Appends related to o.R.PersonalRecords.
+// Sets related.R.Workout appropriately.
+func (o *Workout) AddPersonalRecords(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PersonalRecord) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WorkoutID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"personal_records\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"workout_id"}),
+				strmangle.WhereClause("\"", "\"", 2, personalRecordPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WorkoutID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &workoutR{
+			PersonalRecords: related,
+		}
+	} else {
+		o.R.PersonalRecords = append(o.R.PersonalRecords, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &personalRecordR{
+				Workout: o,
+			}
+		} else {
+			rel.R.Workout = o
+		}
+	}
+	return nil
+}
+
 // AddSets adds the given related objects to the existing relationships
 // of the workout, optionally inserting them as new records.
 // Appends related to o.R.Sets.
@@ -990,6 +1353,59 @@ func (o *Workout) AddWorkoutComments(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
+// AddWorkoutReactions adds the given related objects to the existing relationships
+// of the workout, optionally inserting them as new records.
+// Appends related to o.R.WorkoutReactions.
+// Sets related.R.Workout appropriately.
+func (o *Workout) AddWorkoutReactions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WorkoutReaction) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WorkoutID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"getstronger\".\"workout_reactions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"workout_id"}),
+				strmangle.WhereClause("\"", "\"", 2, workoutReactionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WorkoutID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &workoutR{
+			WorkoutReactions: related,
+		}
+	} else {
+		o.R.WorkoutReactions = append(o.R.WorkoutReactions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &workoutReactionR{
+				Workout: o,
+			}
+		} else {
+			rel.R.Workout = o
+		}
+	}
+	return nil
+}
+
 // Workouts retrieves all the records using an executor.
 func Workouts(mods ...qm.QueryMod) workoutQuery {
 	mods = append(mods, qm.From("\"getstronger\".\"workouts\""))
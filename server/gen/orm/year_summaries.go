@@ -0,0 +1,1153 @@
+// Code generated by SQLBoiler 4.18.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package orm
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// YearSummary is an object representing the database table.
+type YearSummary struct {
+	ID        string     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID    string     `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Year      int        `boil:"year" json:"year" toml:"year" yaml:"year"`
+	Payload   types.JSON `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	CreatedAt time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *yearSummaryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L yearSummaryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var YearSummaryColumns = struct {
+	ID        string
+	UserID    string
+	Year      string
+	Payload   string
+	CreatedAt string
+}{
+	ID:        "id",
+	UserID:    "user_id",
+	Year:      "year",
+	Payload:   "payload",
+	CreatedAt: "created_at",
+}
+
+var YearSummaryTableColumns = struct {
+	ID        string
+	UserID    string
+	Year      string
+	Payload   string
+	CreatedAt string
+}{
+	ID:        "year_summaries.id",
+	UserID:    "year_summaries.user_id",
+	Year:      "year_summaries.year",
+	Payload:   "year_summaries.payload",
+	CreatedAt: "year_summaries.created_at",
+}
+
+// Generated where
+
+var YearSummaryWhere = struct {
+	ID        whereHelperstring
+	UserID    whereHelperstring
+	Year      whereHelperint
+	Payload   whereHelpertypes_JSON
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"getstronger\".\"year_summaries\".\"id\""},
+	UserID:    whereHelperstring{field: "\"getstronger\".\"year_summaries\".\"user_id\""},
+	Year:      whereHelperint{field: "\"getstronger\".\"year_summaries\".\"year\""},
+	Payload:   whereHelpertypes_JSON{field: "\"getstronger\".\"year_summaries\".\"payload\""},
+	CreatedAt: whereHelpertime_Time{field: "\"getstronger\".\"year_summaries\".\"created_at\""},
+}
+
+// YearSummaryRels is where relationship names are stored.
+var YearSummaryRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// yearSummaryR is where relationships are stored.
+type yearSummaryR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*yearSummaryR) NewStruct() *yearSummaryR {
+	return &yearSummaryR{}
+}
+
+func (r *yearSummaryR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// yearSummaryL is where Load methods for each relationship are stored.
+type yearSummaryL struct{}
+
+var (
+	yearSummaryAllColumns            = []string{"id", "user_id", "year", "payload", "created_at"}
+	yearSummaryColumnsWithoutDefault = []string{"user_id", "year", "payload"}
+	yearSummaryColumnsWithDefault    = []string{"id", "created_at"}
+	yearSummaryPrimaryKeyColumns     = []string{"id"}
+	yearSummaryGeneratedColumns      = []string{}
+)
+
+type (
+	// YearSummarySlice is an alias for a slice of pointers to YearSummary.
+	// This should almost always be used instead of []YearSummary.
+	YearSummarySlice []*YearSummary
+	// YearSummaryHook is the signature for custom YearSummary hook methods
+	YearSummaryHook func(context.Context, boil.ContextExecutor, *YearSummary) error
+
+	yearSummaryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	yearSummaryType                 = reflect.TypeOf(&YearSummary{})
+	yearSummaryMapping              = queries.MakeStructMapping(yearSummaryType)
+	yearSummaryPrimaryKeyMapping, _ = queries.BindMapping(yearSummaryType, yearSummaryMapping, yearSummaryPrimaryKeyColumns)
+	yearSummaryInsertCacheMut       sync.RWMutex
+	yearSummaryInsertCache          = make(map[string]insertCache)
+	yearSummaryUpdateCacheMut       sync.RWMutex
+	yearSummaryUpdateCache          = make(map[string]updateCache)
+	yearSummaryUpsertCacheMut       sync.RWMutex
+	yearSummaryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var yearSummaryAfterSelectMu sync.Mutex
+var yearSummaryAfterSelectHooks []YearSummaryHook
+
+var yearSummaryBeforeInsertMu sync.Mutex
+var yearSummaryBeforeInsertHooks []YearSummaryHook
+var yearSummaryAfterInsertMu sync.Mutex
+var yearSummaryAfterInsertHooks []YearSummaryHook
+
+var yearSummaryBeforeUpdateMu sync.Mutex
+var yearSummaryBeforeUpdateHooks []YearSummaryHook
+var yearSummaryAfterUpdateMu sync.Mutex
+var yearSummaryAfterUpdateHooks []YearSummaryHook
+
+var yearSummaryBeforeDeleteMu sync.Mutex
+var yearSummaryBeforeDeleteHooks []YearSummaryHook
+var yearSummaryAfterDeleteMu sync.Mutex
+var yearSummaryAfterDeleteHooks []YearSummaryHook
+
+var yearSummaryBeforeUpsertMu sync.Mutex
+var yearSummaryBeforeUpsertHooks []YearSummaryHook
+var yearSummaryAfterUpsertMu sync.Mutex
+var yearSummaryAfterUpsertHooks []YearSummaryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *YearSummary) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearSummaryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *YearSummary) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearSummaryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *YearSummary) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearSummaryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *YearSummary) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearSummaryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *YearSummary) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearSummaryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *YearSummary) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearSummaryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *YearSummary) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearSummaryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *YearSummary) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearSummaryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *YearSummary) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range yearSummaryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddYearSummaryHook registers your hook function for all future operations.
+func AddYearSummaryHook(hookPoint boil.HookPoint, yearSummaryHook YearSummaryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		yearSummaryAfterSelectMu.Lock()
+		yearSummaryAfterSelectHooks = append(yearSummaryAfterSelectHooks, yearSummaryHook)
+		yearSummaryAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		yearSummaryBeforeInsertMu.Lock()
+		yearSummaryBeforeInsertHooks = append(yearSummaryBeforeInsertHooks, yearSummaryHook)
+		yearSummaryBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		yearSummaryAfterInsertMu.Lock()
+		yearSummaryAfterInsertHooks = append(yearSummaryAfterInsertHooks, yearSummaryHook)
+		yearSummaryAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		yearSummaryBeforeUpdateMu.Lock()
+		yearSummaryBeforeUpdateHooks = append(yearSummaryBeforeUpdateHooks, yearSummaryHook)
+		yearSummaryBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		yearSummaryAfterUpdateMu.Lock()
+		yearSummaryAfterUpdateHooks = append(yearSummaryAfterUpdateHooks, yearSummaryHook)
+		yearSummaryAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		yearSummaryBeforeDeleteMu.Lock()
+		yearSummaryBeforeDeleteHooks = append(yearSummaryBeforeDeleteHooks, yearSummaryHook)
+		yearSummaryBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		yearSummaryAfterDeleteMu.Lock()
+		yearSummaryAfterDeleteHooks = append(yearSummaryAfterDeleteHooks, yearSummaryHook)
+		yearSummaryAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		yearSummaryBeforeUpsertMu.Lock()
+		yearSummaryBeforeUpsertHooks = append(yearSummaryBeforeUpsertHooks, yearSummaryHook)
+		yearSummaryBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		yearSummaryAfterUpsertMu.Lock()
+		yearSummaryAfterUpsertHooks = append(yearSummaryAfterUpsertHooks, yearSummaryHook)
+		yearSummaryAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single yearSummary record from the query.
+func (q yearSummaryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*YearSummary, error) {
+	o := &YearSummary{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: failed to execute a one query for year_summaries")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all YearSummary records from the query.
+func (q yearSummaryQuery) All(ctx context.Context, exec boil.ContextExecutor) (YearSummarySlice, error) {
+	var o []*YearSummary
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "orm: failed to assign all query results to YearSummary slice")
+	}
+
+	if len(yearSummaryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all YearSummary records in the query.
+func (q yearSummaryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to count year_summaries rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q yearSummaryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: failed to check if year_summaries exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *YearSummary) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (yearSummaryL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeYearSummary interface{}, mods queries.Applicator) error {
+	var slice []*YearSummary
+	var object *YearSummary
+
+	if singular {
+		var ok bool
+		object, ok = maybeYearSummary.(*YearSummary)
+		if !ok {
+			object = new(YearSummary)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeYearSummary)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeYearSummary))
+			}
+		}
+	} else {
+		s, ok := maybeYearSummary.(*[]*YearSummary)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeYearSummary)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeYearSummary))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &yearSummaryR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &yearSummaryR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`getstronger.users`),
+		qm.WhereIn(`getstronger.users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.YearSummaries = append(foreign.R.YearSummaries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.YearSummaries = append(foreign.R.YearSummaries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the yearSummary to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.YearSummaries.
+func (o *YearSummary) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"getstronger\".\"year_summaries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, yearSummaryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &yearSummaryR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			YearSummaries: YearSummarySlice{o},
+		}
+	} else {
+		related.R.YearSummaries = append(related.R.YearSummaries, o)
+	}
+
+	return nil
+}
+
+// YearSummaries retrieves all the records using an executor.
+func YearSummaries(mods ...qm.QueryMod) yearSummaryQuery {
+	mods = append(mods, qm.From("\"getstronger\".\"year_summaries\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"getstronger\".\"year_summaries\".*"})
+	}
+
+	return yearSummaryQuery{q}
+}
+
+// FindYearSummary retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindYearSummary(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*YearSummary, error) {
+	yearSummaryObj := &YearSummary{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"getstronger\".\"year_summaries\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, yearSummaryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "orm: unable to select from year_summaries")
+	}
+
+	if err = yearSummaryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return yearSummaryObj, err
+	}
+
+	return yearSummaryObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *YearSummary) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("orm: no year_summaries provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(yearSummaryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	yearSummaryInsertCacheMut.RLock()
+	cache, cached := yearSummaryInsertCache[key]
+	yearSummaryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			yearSummaryAllColumns,
+			yearSummaryColumnsWithDefault,
+			yearSummaryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(yearSummaryType, yearSummaryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(yearSummaryType, yearSummaryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"getstronger\".\"year_summaries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"getstronger\".\"year_summaries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to insert into year_summaries")
+	}
+
+	if !cached {
+		yearSummaryInsertCacheMut.Lock()
+		yearSummaryInsertCache[key] = cache
+		yearSummaryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the YearSummary.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *YearSummary) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	yearSummaryUpdateCacheMut.RLock()
+	cache, cached := yearSummaryUpdateCache[key]
+	yearSummaryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			yearSummaryAllColumns,
+			yearSummaryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("orm: unable to update year_summaries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"getstronger\".\"year_summaries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, yearSummaryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(yearSummaryType, yearSummaryMapping, append(wl, yearSummaryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update year_summaries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by update for year_summaries")
+	}
+
+	if !cached {
+		yearSummaryUpdateCacheMut.Lock()
+		yearSummaryUpdateCache[key] = cache
+		yearSummaryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q yearSummaryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all for year_summaries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected for year_summaries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o YearSummarySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("orm: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), yearSummaryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"getstronger\".\"year_summaries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, yearSummaryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to update all in yearSummary slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to retrieve rows affected all in update all yearSummary")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *YearSummary) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("orm: no year_summaries provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(yearSummaryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	yearSummaryUpsertCacheMut.RLock()
+	cache, cached := yearSummaryUpsertCache[key]
+	yearSummaryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			yearSummaryAllColumns,
+			yearSummaryColumnsWithDefault,
+			yearSummaryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			yearSummaryAllColumns,
+			yearSummaryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("orm: unable to upsert year_summaries, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(yearSummaryAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(yearSummaryPrimaryKeyColumns) == 0 {
+				return errors.New("orm: unable to upsert year_summaries, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(yearSummaryPrimaryKeyColumns))
+			copy(conflict, yearSummaryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"getstronger\".\"year_summaries\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(yearSummaryType, yearSummaryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(yearSummaryType, yearSummaryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to upsert year_summaries")
+	}
+
+	if !cached {
+		yearSummaryUpsertCacheMut.Lock()
+		yearSummaryUpsertCache[key] = cache
+		yearSummaryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single YearSummary record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *YearSummary) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("orm: no YearSummary provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), yearSummaryPrimaryKeyMapping)
+	sql := "DELETE FROM \"getstronger\".\"year_summaries\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete from year_summaries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by delete for year_summaries")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q yearSummaryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("orm: no yearSummaryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from year_summaries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for year_summaries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o YearSummarySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(yearSummaryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), yearSummaryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"getstronger\".\"year_summaries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, yearSummaryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: unable to delete all from yearSummary slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "orm: failed to get rows affected by deleteall for year_summaries")
+	}
+
+	if len(yearSummaryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *YearSummary) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindYearSummary(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *YearSummarySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := YearSummarySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), yearSummaryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"getstronger\".\"year_summaries\".* FROM \"getstronger\".\"year_summaries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, yearSummaryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "orm: unable to reload all in YearSummarySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// YearSummaryExists checks if the YearSummary row exists.
+func YearSummaryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"getstronger\".\"year_summaries\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "orm: unable to check if year_summaries exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the YearSummary row exists.
+func (o *YearSummary) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return YearSummaryExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,197 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/admin_service.proto
+
+package apiv1connect
+
+import (
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "api.v1.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServiceListReportsProcedure is the fully-qualified name of the AdminService's ListReports
+	// RPC.
+	AdminServiceListReportsProcedure = "/api.v1.AdminService/ListReports"
+	// AdminServiceHideContentProcedure is the fully-qualified name of the AdminService's HideContent
+	// RPC.
+	AdminServiceHideContentProcedure = "/api.v1.AdminService/HideContent"
+	// AdminServiceDismissReportProcedure is the fully-qualified name of the AdminService's
+	// DismissReport RPC.
+	AdminServiceDismissReportProcedure = "/api.v1.AdminService/DismissReport"
+	// AdminServiceSuspendUserProcedure is the fully-qualified name of the AdminService's SuspendUser
+	// RPC.
+	AdminServiceSuspendUserProcedure = "/api.v1.AdminService/SuspendUser"
+)
+
+// AdminServiceClient is a client for the api.v1.AdminService service.
+type AdminServiceClient interface {
+	ListReports(context.Context, *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error)
+	HideContent(context.Context, *connect.Request[v1.HideContentRequest]) (*connect.Response[v1.HideContentResponse], error)
+	DismissReport(context.Context, *connect.Request[v1.DismissReportRequest]) (*connect.Response[v1.DismissReportResponse], error)
+	SuspendUser(context.Context, *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.SuspendUserResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the api.v1.AdminService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	adminServiceMethods := v1.File_api_v1_admin_service_proto.Services().ByName("AdminService").Methods()
+	return &adminServiceClient{
+		listReports: connect.NewClient[v1.ListReportsRequest, v1.ListReportsResponse](
+			httpClient,
+			baseURL+AdminServiceListReportsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListReports")),
+			connect.WithClientOptions(opts...),
+		),
+		hideContent: connect.NewClient[v1.HideContentRequest, v1.HideContentResponse](
+			httpClient,
+			baseURL+AdminServiceHideContentProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("HideContent")),
+			connect.WithClientOptions(opts...),
+		),
+		dismissReport: connect.NewClient[v1.DismissReportRequest, v1.DismissReportResponse](
+			httpClient,
+			baseURL+AdminServiceDismissReportProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("DismissReport")),
+			connect.WithClientOptions(opts...),
+		),
+		suspendUser: connect.NewClient[v1.SuspendUserRequest, v1.SuspendUserResponse](
+			httpClient,
+			baseURL+AdminServiceSuspendUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SuspendUser")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	listReports   *connect.Client[v1.ListReportsRequest, v1.ListReportsResponse]
+	hideContent   *connect.Client[v1.HideContentRequest, v1.HideContentResponse]
+	dismissReport *connect.Client[v1.DismissReportRequest, v1.DismissReportResponse]
+	suspendUser   *connect.Client[v1.SuspendUserRequest, v1.SuspendUserResponse]
+}
+
+// ListReports calls api.v1.AdminService.ListReports.
+func (c *adminServiceClient) ListReports(ctx context.Context, req *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error) {
+	return c.listReports.CallUnary(ctx, req)
+}
+
+// HideContent calls api.v1.AdminService.HideContent.
+func (c *adminServiceClient) HideContent(ctx context.Context, req *connect.Request[v1.HideContentRequest]) (*connect.Response[v1.HideContentResponse], error) {
+	return c.hideContent.CallUnary(ctx, req)
+}
+
+// DismissReport calls api.v1.AdminService.DismissReport.
+func (c *adminServiceClient) DismissReport(ctx context.Context, req *connect.Request[v1.DismissReportRequest]) (*connect.Response[v1.DismissReportResponse], error) {
+	return c.dismissReport.CallUnary(ctx, req)
+}
+
+// SuspendUser calls api.v1.AdminService.SuspendUser.
+func (c *adminServiceClient) SuspendUser(ctx context.Context, req *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.SuspendUserResponse], error) {
+	return c.suspendUser.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the api.v1.AdminService service.
+type AdminServiceHandler interface {
+	ListReports(context.Context, *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error)
+	HideContent(context.Context, *connect.Request[v1.HideContentRequest]) (*connect.Response[v1.HideContentResponse], error)
+	DismissReport(context.Context, *connect.Request[v1.DismissReportRequest]) (*connect.Response[v1.DismissReportResponse], error)
+	SuspendUser(context.Context, *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.SuspendUserResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceMethods := v1.File_api_v1_admin_service_proto.Services().ByName("AdminService").Methods()
+	adminServiceListReportsHandler := connect.NewUnaryHandler(
+		AdminServiceListReportsProcedure,
+		svc.ListReports,
+		connect.WithSchema(adminServiceMethods.ByName("ListReports")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceHideContentHandler := connect.NewUnaryHandler(
+		AdminServiceHideContentProcedure,
+		svc.HideContent,
+		connect.WithSchema(adminServiceMethods.ByName("HideContent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceDismissReportHandler := connect.NewUnaryHandler(
+		AdminServiceDismissReportProcedure,
+		svc.DismissReport,
+		connect.WithSchema(adminServiceMethods.ByName("DismissReport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSuspendUserHandler := connect.NewUnaryHandler(
+		AdminServiceSuspendUserProcedure,
+		svc.SuspendUser,
+		connect.WithSchema(adminServiceMethods.ByName("SuspendUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceListReportsProcedure:
+			adminServiceListReportsHandler.ServeHTTP(w, r)
+		case AdminServiceHideContentProcedure:
+			adminServiceHideContentHandler.ServeHTTP(w, r)
+		case AdminServiceDismissReportProcedure:
+			adminServiceDismissReportHandler.ServeHTTP(w, r)
+		case AdminServiceSuspendUserProcedure:
+			adminServiceSuspendUserHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) ListReports(context.Context, *connect.Request[v1.ListReportsRequest]) (*connect.Response[v1.ListReportsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AdminService.ListReports is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) HideContent(context.Context, *connect.Request[v1.HideContentRequest]) (*connect.Response[v1.HideContentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AdminService.HideContent is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DismissReport(context.Context, *connect.Request[v1.DismissReportRequest]) (*connect.Response[v1.DismissReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AdminService.DismissReport is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SuspendUser(context.Context, *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.SuspendUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.AdminService.SuspendUser is not implemented"))
+}
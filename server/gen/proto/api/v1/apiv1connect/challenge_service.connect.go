@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/challenge_service.proto
+
+package apiv1connect
+
+import (
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ChallengeServiceName is the fully-qualified name of the ChallengeService service.
+	ChallengeServiceName = "api.v1.ChallengeService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ChallengeServiceCreateChallengeProcedure is the fully-qualified name of the ChallengeService's
+	// CreateChallenge RPC.
+	ChallengeServiceCreateChallengeProcedure = "/api.v1.ChallengeService/CreateChallenge"
+	// ChallengeServiceInviteToChallengeProcedure is the fully-qualified name of the ChallengeService's
+	// InviteToChallenge RPC.
+	ChallengeServiceInviteToChallengeProcedure = "/api.v1.ChallengeService/InviteToChallenge"
+	// ChallengeServiceJoinChallengeProcedure is the fully-qualified name of the ChallengeService's
+	// JoinChallenge RPC.
+	ChallengeServiceJoinChallengeProcedure = "/api.v1.ChallengeService/JoinChallenge"
+	// ChallengeServiceLeaveChallengeProcedure is the fully-qualified name of the ChallengeService's
+	// LeaveChallenge RPC.
+	ChallengeServiceLeaveChallengeProcedure = "/api.v1.ChallengeService/LeaveChallenge"
+	// ChallengeServiceListChallengesProcedure is the fully-qualified name of the ChallengeService's
+	// ListChallenges RPC.
+	ChallengeServiceListChallengesProcedure = "/api.v1.ChallengeService/ListChallenges"
+	// ChallengeServiceGetChallengeStandingsProcedure is the fully-qualified name of the
+	// ChallengeService's GetChallengeStandings RPC.
+	ChallengeServiceGetChallengeStandingsProcedure = "/api.v1.ChallengeService/GetChallengeStandings"
+)
+
+// ChallengeServiceClient is a client for the api.v1.ChallengeService service.
+type ChallengeServiceClient interface {
+	CreateChallenge(context.Context, *connect.Request[v1.CreateChallengeRequest]) (*connect.Response[v1.CreateChallengeResponse], error)
+	InviteToChallenge(context.Context, *connect.Request[v1.InviteToChallengeRequest]) (*connect.Response[v1.InviteToChallengeResponse], error)
+	JoinChallenge(context.Context, *connect.Request[v1.JoinChallengeRequest]) (*connect.Response[v1.JoinChallengeResponse], error)
+	LeaveChallenge(context.Context, *connect.Request[v1.LeaveChallengeRequest]) (*connect.Response[v1.LeaveChallengeResponse], error)
+	ListChallenges(context.Context, *connect.Request[v1.ListChallengesRequest]) (*connect.Response[v1.ListChallengesResponse], error)
+	GetChallengeStandings(context.Context, *connect.Request[v1.GetChallengeStandingsRequest]) (*connect.Response[v1.GetChallengeStandingsResponse], error)
+}
+
+// NewChallengeServiceClient constructs a client for the api.v1.ChallengeService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewChallengeServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ChallengeServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	challengeServiceMethods := v1.File_api_v1_challenge_service_proto.Services().ByName("ChallengeService").Methods()
+	return &challengeServiceClient{
+		createChallenge: connect.NewClient[v1.CreateChallengeRequest, v1.CreateChallengeResponse](
+			httpClient,
+			baseURL+ChallengeServiceCreateChallengeProcedure,
+			connect.WithSchema(challengeServiceMethods.ByName("CreateChallenge")),
+			connect.WithClientOptions(opts...),
+		),
+		inviteToChallenge: connect.NewClient[v1.InviteToChallengeRequest, v1.InviteToChallengeResponse](
+			httpClient,
+			baseURL+ChallengeServiceInviteToChallengeProcedure,
+			connect.WithSchema(challengeServiceMethods.ByName("InviteToChallenge")),
+			connect.WithClientOptions(opts...),
+		),
+		joinChallenge: connect.NewClient[v1.JoinChallengeRequest, v1.JoinChallengeResponse](
+			httpClient,
+			baseURL+ChallengeServiceJoinChallengeProcedure,
+			connect.WithSchema(challengeServiceMethods.ByName("JoinChallenge")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveChallenge: connect.NewClient[v1.LeaveChallengeRequest, v1.LeaveChallengeResponse](
+			httpClient,
+			baseURL+ChallengeServiceLeaveChallengeProcedure,
+			connect.WithSchema(challengeServiceMethods.ByName("LeaveChallenge")),
+			connect.WithClientOptions(opts...),
+		),
+		listChallenges: connect.NewClient[v1.ListChallengesRequest, v1.ListChallengesResponse](
+			httpClient,
+			baseURL+ChallengeServiceListChallengesProcedure,
+			connect.WithSchema(challengeServiceMethods.ByName("ListChallenges")),
+			connect.WithClientOptions(opts...),
+		),
+		getChallengeStandings: connect.NewClient[v1.GetChallengeStandingsRequest, v1.GetChallengeStandingsResponse](
+			httpClient,
+			baseURL+ChallengeServiceGetChallengeStandingsProcedure,
+			connect.WithSchema(challengeServiceMethods.ByName("GetChallengeStandings")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// challengeServiceClient implements ChallengeServiceClient.
+type challengeServiceClient struct {
+	createChallenge       *connect.Client[v1.CreateChallengeRequest, v1.CreateChallengeResponse]
+	inviteToChallenge     *connect.Client[v1.InviteToChallengeRequest, v1.InviteToChallengeResponse]
+	joinChallenge         *connect.Client[v1.JoinChallengeRequest, v1.JoinChallengeResponse]
+	leaveChallenge        *connect.Client[v1.LeaveChallengeRequest, v1.LeaveChallengeResponse]
+	listChallenges        *connect.Client[v1.ListChallengesRequest, v1.ListChallengesResponse]
+	getChallengeStandings *connect.Client[v1.GetChallengeStandingsRequest, v1.GetChallengeStandingsResponse]
+}
+
+// CreateChallenge calls api.v1.ChallengeService.CreateChallenge.
+func (c *challengeServiceClient) CreateChallenge(ctx context.Context, req *connect.Request[v1.CreateChallengeRequest]) (*connect.Response[v1.CreateChallengeResponse], error) {
+	return c.createChallenge.CallUnary(ctx, req)
+}
+
+// InviteToChallenge calls api.v1.ChallengeService.InviteToChallenge.
+func (c *challengeServiceClient) InviteToChallenge(ctx context.Context, req *connect.Request[v1.InviteToChallengeRequest]) (*connect.Response[v1.InviteToChallengeResponse], error) {
+	return c.inviteToChallenge.CallUnary(ctx, req)
+}
+
+// JoinChallenge calls api.v1.ChallengeService.JoinChallenge.
+func (c *challengeServiceClient) JoinChallenge(ctx context.Context, req *connect.Request[v1.JoinChallengeRequest]) (*connect.Response[v1.JoinChallengeResponse], error) {
+	return c.joinChallenge.CallUnary(ctx, req)
+}
+
+// LeaveChallenge calls api.v1.ChallengeService.LeaveChallenge.
+func (c *challengeServiceClient) LeaveChallenge(ctx context.Context, req *connect.Request[v1.LeaveChallengeRequest]) (*connect.Response[v1.LeaveChallengeResponse], error) {
+	return c.leaveChallenge.CallUnary(ctx, req)
+}
+
+// ListChallenges calls api.v1.ChallengeService.ListChallenges.
+func (c *challengeServiceClient) ListChallenges(ctx context.Context, req *connect.Request[v1.ListChallengesRequest]) (*connect.Response[v1.ListChallengesResponse], error) {
+	return c.listChallenges.CallUnary(ctx, req)
+}
+
+// GetChallengeStandings calls api.v1.ChallengeService.GetChallengeStandings.
+func (c *challengeServiceClient) GetChallengeStandings(ctx context.Context, req *connect.Request[v1.GetChallengeStandingsRequest]) (*connect.Response[v1.GetChallengeStandingsResponse], error) {
+	return c.getChallengeStandings.CallUnary(ctx, req)
+}
+
+// ChallengeServiceHandler is an implementation of the api.v1.ChallengeService service.
+type ChallengeServiceHandler interface {
+	CreateChallenge(context.Context, *connect.Request[v1.CreateChallengeRequest]) (*connect.Response[v1.CreateChallengeResponse], error)
+	InviteToChallenge(context.Context, *connect.Request[v1.InviteToChallengeRequest]) (*connect.Response[v1.InviteToChallengeResponse], error)
+	JoinChallenge(context.Context, *connect.Request[v1.JoinChallengeRequest]) (*connect.Response[v1.JoinChallengeResponse], error)
+	LeaveChallenge(context.Context, *connect.Request[v1.LeaveChallengeRequest]) (*connect.Response[v1.LeaveChallengeResponse], error)
+	ListChallenges(context.Context, *connect.Request[v1.ListChallengesRequest]) (*connect.Response[v1.ListChallengesResponse], error)
+	GetChallengeStandings(context.Context, *connect.Request[v1.GetChallengeStandingsRequest]) (*connect.Response[v1.GetChallengeStandingsResponse], error)
+}
+
+// NewChallengeServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewChallengeServiceHandler(svc ChallengeServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	challengeServiceMethods := v1.File_api_v1_challenge_service_proto.Services().ByName("ChallengeService").Methods()
+	challengeServiceCreateChallengeHandler := connect.NewUnaryHandler(
+		ChallengeServiceCreateChallengeProcedure,
+		svc.CreateChallenge,
+		connect.WithSchema(challengeServiceMethods.ByName("CreateChallenge")),
+		connect.WithHandlerOptions(opts...),
+	)
+	challengeServiceInviteToChallengeHandler := connect.NewUnaryHandler(
+		ChallengeServiceInviteToChallengeProcedure,
+		svc.InviteToChallenge,
+		connect.WithSchema(challengeServiceMethods.ByName("InviteToChallenge")),
+		connect.WithHandlerOptions(opts...),
+	)
+	challengeServiceJoinChallengeHandler := connect.NewUnaryHandler(
+		ChallengeServiceJoinChallengeProcedure,
+		svc.JoinChallenge,
+		connect.WithSchema(challengeServiceMethods.ByName("JoinChallenge")),
+		connect.WithHandlerOptions(opts...),
+	)
+	challengeServiceLeaveChallengeHandler := connect.NewUnaryHandler(
+		ChallengeServiceLeaveChallengeProcedure,
+		svc.LeaveChallenge,
+		connect.WithSchema(challengeServiceMethods.ByName("LeaveChallenge")),
+		connect.WithHandlerOptions(opts...),
+	)
+	challengeServiceListChallengesHandler := connect.NewUnaryHandler(
+		ChallengeServiceListChallengesProcedure,
+		svc.ListChallenges,
+		connect.WithSchema(challengeServiceMethods.ByName("ListChallenges")),
+		connect.WithHandlerOptions(opts...),
+	)
+	challengeServiceGetChallengeStandingsHandler := connect.NewUnaryHandler(
+		ChallengeServiceGetChallengeStandingsProcedure,
+		svc.GetChallengeStandings,
+		connect.WithSchema(challengeServiceMethods.ByName("GetChallengeStandings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.ChallengeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ChallengeServiceCreateChallengeProcedure:
+			challengeServiceCreateChallengeHandler.ServeHTTP(w, r)
+		case ChallengeServiceInviteToChallengeProcedure:
+			challengeServiceInviteToChallengeHandler.ServeHTTP(w, r)
+		case ChallengeServiceJoinChallengeProcedure:
+			challengeServiceJoinChallengeHandler.ServeHTTP(w, r)
+		case ChallengeServiceLeaveChallengeProcedure:
+			challengeServiceLeaveChallengeHandler.ServeHTTP(w, r)
+		case ChallengeServiceListChallengesProcedure:
+			challengeServiceListChallengesHandler.ServeHTTP(w, r)
+		case ChallengeServiceGetChallengeStandingsProcedure:
+			challengeServiceGetChallengeStandingsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedChallengeServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedChallengeServiceHandler struct{}
+
+func (UnimplementedChallengeServiceHandler) CreateChallenge(context.Context, *connect.Request[v1.CreateChallengeRequest]) (*connect.Response[v1.CreateChallengeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ChallengeService.CreateChallenge is not implemented"))
+}
+
+func (UnimplementedChallengeServiceHandler) InviteToChallenge(context.Context, *connect.Request[v1.InviteToChallengeRequest]) (*connect.Response[v1.InviteToChallengeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ChallengeService.InviteToChallenge is not implemented"))
+}
+
+func (UnimplementedChallengeServiceHandler) JoinChallenge(context.Context, *connect.Request[v1.JoinChallengeRequest]) (*connect.Response[v1.JoinChallengeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ChallengeService.JoinChallenge is not implemented"))
+}
+
+func (UnimplementedChallengeServiceHandler) LeaveChallenge(context.Context, *connect.Request[v1.LeaveChallengeRequest]) (*connect.Response[v1.LeaveChallengeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ChallengeService.LeaveChallenge is not implemented"))
+}
+
+func (UnimplementedChallengeServiceHandler) ListChallenges(context.Context, *connect.Request[v1.ListChallengesRequest]) (*connect.Response[v1.ListChallengesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ChallengeService.ListChallenges is not implemented"))
+}
+
+func (UnimplementedChallengeServiceHandler) GetChallengeStandings(context.Context, *connect.Request[v1.GetChallengeStandingsRequest]) (*connect.Response[v1.GetChallengeStandingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ChallengeService.GetChallengeStandings is not implemented"))
+}
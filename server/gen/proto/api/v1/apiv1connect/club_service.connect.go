@@ -0,0 +1,366 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/club_service.proto
+
+package apiv1connect
+
+import (
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ClubServiceName is the fully-qualified name of the ClubService service.
+	ClubServiceName = "api.v1.ClubService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ClubServiceCreateClubProcedure is the fully-qualified name of the ClubService's CreateClub RPC.
+	ClubServiceCreateClubProcedure = "/api.v1.ClubService/CreateClub"
+	// ClubServiceGetClubProcedure is the fully-qualified name of the ClubService's GetClub RPC.
+	ClubServiceGetClubProcedure = "/api.v1.ClubService/GetClub"
+	// ClubServiceListClubsProcedure is the fully-qualified name of the ClubService's ListClubs RPC.
+	ClubServiceListClubsProcedure = "/api.v1.ClubService/ListClubs"
+	// ClubServiceJoinClubProcedure is the fully-qualified name of the ClubService's JoinClub RPC.
+	ClubServiceJoinClubProcedure = "/api.v1.ClubService/JoinClub"
+	// ClubServiceLeaveClubProcedure is the fully-qualified name of the ClubService's LeaveClub RPC.
+	ClubServiceLeaveClubProcedure = "/api.v1.ClubService/LeaveClub"
+	// ClubServiceInviteClubMemberProcedure is the fully-qualified name of the ClubService's
+	// InviteClubMember RPC.
+	ClubServiceInviteClubMemberProcedure = "/api.v1.ClubService/InviteClubMember"
+	// ClubServiceApproveClubMemberProcedure is the fully-qualified name of the ClubService's
+	// ApproveClubMember RPC.
+	ClubServiceApproveClubMemberProcedure = "/api.v1.ClubService/ApproveClubMember"
+	// ClubServiceRemoveClubMemberProcedure is the fully-qualified name of the ClubService's
+	// RemoveClubMember RPC.
+	ClubServiceRemoveClubMemberProcedure = "/api.v1.ClubService/RemoveClubMember"
+	// ClubServiceSetClubMemberRoleProcedure is the fully-qualified name of the ClubService's
+	// SetClubMemberRole RPC.
+	ClubServiceSetClubMemberRoleProcedure = "/api.v1.ClubService/SetClubMemberRole"
+	// ClubServiceListClubMembersProcedure is the fully-qualified name of the ClubService's
+	// ListClubMembers RPC.
+	ClubServiceListClubMembersProcedure = "/api.v1.ClubService/ListClubMembers"
+)
+
+// ClubServiceClient is a client for the api.v1.ClubService service.
+type ClubServiceClient interface {
+	CreateClub(context.Context, *connect.Request[v1.CreateClubRequest]) (*connect.Response[v1.CreateClubResponse], error)
+	GetClub(context.Context, *connect.Request[v1.GetClubRequest]) (*connect.Response[v1.GetClubResponse], error)
+	ListClubs(context.Context, *connect.Request[v1.ListClubsRequest]) (*connect.Response[v1.ListClubsResponse], error)
+	JoinClub(context.Context, *connect.Request[v1.JoinClubRequest]) (*connect.Response[v1.JoinClubResponse], error)
+	LeaveClub(context.Context, *connect.Request[v1.LeaveClubRequest]) (*connect.Response[v1.LeaveClubResponse], error)
+	InviteClubMember(context.Context, *connect.Request[v1.InviteClubMemberRequest]) (*connect.Response[v1.InviteClubMemberResponse], error)
+	ApproveClubMember(context.Context, *connect.Request[v1.ApproveClubMemberRequest]) (*connect.Response[v1.ApproveClubMemberResponse], error)
+	RemoveClubMember(context.Context, *connect.Request[v1.RemoveClubMemberRequest]) (*connect.Response[v1.RemoveClubMemberResponse], error)
+	SetClubMemberRole(context.Context, *connect.Request[v1.SetClubMemberRoleRequest]) (*connect.Response[v1.SetClubMemberRoleResponse], error)
+	ListClubMembers(context.Context, *connect.Request[v1.ListClubMembersRequest]) (*connect.Response[v1.ListClubMembersResponse], error)
+}
+
+// NewClubServiceClient constructs a client for the api.v1.ClubService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewClubServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ClubServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	clubServiceMethods := v1.File_api_v1_club_service_proto.Services().ByName("ClubService").Methods()
+	return &clubServiceClient{
+		createClub: connect.NewClient[v1.CreateClubRequest, v1.CreateClubResponse](
+			httpClient,
+			baseURL+ClubServiceCreateClubProcedure,
+			connect.WithSchema(clubServiceMethods.ByName("CreateClub")),
+			connect.WithClientOptions(opts...),
+		),
+		getClub: connect.NewClient[v1.GetClubRequest, v1.GetClubResponse](
+			httpClient,
+			baseURL+ClubServiceGetClubProcedure,
+			connect.WithSchema(clubServiceMethods.ByName("GetClub")),
+			connect.WithClientOptions(opts...),
+		),
+		listClubs: connect.NewClient[v1.ListClubsRequest, v1.ListClubsResponse](
+			httpClient,
+			baseURL+ClubServiceListClubsProcedure,
+			connect.WithSchema(clubServiceMethods.ByName("ListClubs")),
+			connect.WithClientOptions(opts...),
+		),
+		joinClub: connect.NewClient[v1.JoinClubRequest, v1.JoinClubResponse](
+			httpClient,
+			baseURL+ClubServiceJoinClubProcedure,
+			connect.WithSchema(clubServiceMethods.ByName("JoinClub")),
+			connect.WithClientOptions(opts...),
+		),
+		leaveClub: connect.NewClient[v1.LeaveClubRequest, v1.LeaveClubResponse](
+			httpClient,
+			baseURL+ClubServiceLeaveClubProcedure,
+			connect.WithSchema(clubServiceMethods.ByName("LeaveClub")),
+			connect.WithClientOptions(opts...),
+		),
+		inviteClubMember: connect.NewClient[v1.InviteClubMemberRequest, v1.InviteClubMemberResponse](
+			httpClient,
+			baseURL+ClubServiceInviteClubMemberProcedure,
+			connect.WithSchema(clubServiceMethods.ByName("InviteClubMember")),
+			connect.WithClientOptions(opts...),
+		),
+		approveClubMember: connect.NewClient[v1.ApproveClubMemberRequest, v1.ApproveClubMemberResponse](
+			httpClient,
+			baseURL+ClubServiceApproveClubMemberProcedure,
+			connect.WithSchema(clubServiceMethods.ByName("ApproveClubMember")),
+			connect.WithClientOptions(opts...),
+		),
+		removeClubMember: connect.NewClient[v1.RemoveClubMemberRequest, v1.RemoveClubMemberResponse](
+			httpClient,
+			baseURL+ClubServiceRemoveClubMemberProcedure,
+			connect.WithSchema(clubServiceMethods.ByName("RemoveClubMember")),
+			connect.WithClientOptions(opts...),
+		),
+		setClubMemberRole: connect.NewClient[v1.SetClubMemberRoleRequest, v1.SetClubMemberRoleResponse](
+			httpClient,
+			baseURL+ClubServiceSetClubMemberRoleProcedure,
+			connect.WithSchema(clubServiceMethods.ByName("SetClubMemberRole")),
+			connect.WithClientOptions(opts...),
+		),
+		listClubMembers: connect.NewClient[v1.ListClubMembersRequest, v1.ListClubMembersResponse](
+			httpClient,
+			baseURL+ClubServiceListClubMembersProcedure,
+			connect.WithSchema(clubServiceMethods.ByName("ListClubMembers")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// clubServiceClient implements ClubServiceClient.
+type clubServiceClient struct {
+	createClub        *connect.Client[v1.CreateClubRequest, v1.CreateClubResponse]
+	getClub           *connect.Client[v1.GetClubRequest, v1.GetClubResponse]
+	listClubs         *connect.Client[v1.ListClubsRequest, v1.ListClubsResponse]
+	joinClub          *connect.Client[v1.JoinClubRequest, v1.JoinClubResponse]
+	leaveClub         *connect.Client[v1.LeaveClubRequest, v1.LeaveClubResponse]
+	inviteClubMember  *connect.Client[v1.InviteClubMemberRequest, v1.InviteClubMemberResponse]
+	approveClubMember *connect.Client[v1.ApproveClubMemberRequest, v1.ApproveClubMemberResponse]
+	removeClubMember  *connect.Client[v1.RemoveClubMemberRequest, v1.RemoveClubMemberResponse]
+	setClubMemberRole *connect.Client[v1.SetClubMemberRoleRequest, v1.SetClubMemberRoleResponse]
+	listClubMembers   *connect.Client[v1.ListClubMembersRequest, v1.ListClubMembersResponse]
+}
+
+// CreateClub calls api.v1.ClubService.CreateClub.
+func (c *clubServiceClient) CreateClub(ctx context.Context, req *connect.Request[v1.CreateClubRequest]) (*connect.Response[v1.CreateClubResponse], error) {
+	return c.createClub.CallUnary(ctx, req)
+}
+
+// GetClub calls api.v1.ClubService.GetClub.
+func (c *clubServiceClient) GetClub(ctx context.Context, req *connect.Request[v1.GetClubRequest]) (*connect.Response[v1.GetClubResponse], error) {
+	return c.getClub.CallUnary(ctx, req)
+}
+
+// ListClubs calls api.v1.ClubService.ListClubs.
+func (c *clubServiceClient) ListClubs(ctx context.Context, req *connect.Request[v1.ListClubsRequest]) (*connect.Response[v1.ListClubsResponse], error) {
+	return c.listClubs.CallUnary(ctx, req)
+}
+
+// JoinClub calls api.v1.ClubService.JoinClub.
+func (c *clubServiceClient) JoinClub(ctx context.Context, req *connect.Request[v1.JoinClubRequest]) (*connect.Response[v1.JoinClubResponse], error) {
+	return c.joinClub.CallUnary(ctx, req)
+}
+
+// LeaveClub calls api.v1.ClubService.LeaveClub.
+func (c *clubServiceClient) LeaveClub(ctx context.Context, req *connect.Request[v1.LeaveClubRequest]) (*connect.Response[v1.LeaveClubResponse], error) {
+	return c.leaveClub.CallUnary(ctx, req)
+}
+
+// InviteClubMember calls api.v1.ClubService.InviteClubMember.
+func (c *clubServiceClient) InviteClubMember(ctx context.Context, req *connect.Request[v1.InviteClubMemberRequest]) (*connect.Response[v1.InviteClubMemberResponse], error) {
+	return c.inviteClubMember.CallUnary(ctx, req)
+}
+
+// ApproveClubMember calls api.v1.ClubService.ApproveClubMember.
+func (c *clubServiceClient) ApproveClubMember(ctx context.Context, req *connect.Request[v1.ApproveClubMemberRequest]) (*connect.Response[v1.ApproveClubMemberResponse], error) {
+	return c.approveClubMember.CallUnary(ctx, req)
+}
+
+// RemoveClubMember calls api.v1.ClubService.RemoveClubMember.
+func (c *clubServiceClient) RemoveClubMember(ctx context.Context, req *connect.Request[v1.RemoveClubMemberRequest]) (*connect.Response[v1.RemoveClubMemberResponse], error) {
+	return c.removeClubMember.CallUnary(ctx, req)
+}
+
+// SetClubMemberRole calls api.v1.ClubService.SetClubMemberRole.
+func (c *clubServiceClient) SetClubMemberRole(ctx context.Context, req *connect.Request[v1.SetClubMemberRoleRequest]) (*connect.Response[v1.SetClubMemberRoleResponse], error) {
+	return c.setClubMemberRole.CallUnary(ctx, req)
+}
+
+// ListClubMembers calls api.v1.ClubService.ListClubMembers.
+func (c *clubServiceClient) ListClubMembers(ctx context.Context, req *connect.Request[v1.ListClubMembersRequest]) (*connect.Response[v1.ListClubMembersResponse], error) {
+	return c.listClubMembers.CallUnary(ctx, req)
+}
+
+// ClubServiceHandler is an implementation of the api.v1.ClubService service.
+type ClubServiceHandler interface {
+	CreateClub(context.Context, *connect.Request[v1.CreateClubRequest]) (*connect.Response[v1.CreateClubResponse], error)
+	GetClub(context.Context, *connect.Request[v1.GetClubRequest]) (*connect.Response[v1.GetClubResponse], error)
+	ListClubs(context.Context, *connect.Request[v1.ListClubsRequest]) (*connect.Response[v1.ListClubsResponse], error)
+	JoinClub(context.Context, *connect.Request[v1.JoinClubRequest]) (*connect.Response[v1.JoinClubResponse], error)
+	LeaveClub(context.Context, *connect.Request[v1.LeaveClubRequest]) (*connect.Response[v1.LeaveClubResponse], error)
+	InviteClubMember(context.Context, *connect.Request[v1.InviteClubMemberRequest]) (*connect.Response[v1.InviteClubMemberResponse], error)
+	ApproveClubMember(context.Context, *connect.Request[v1.ApproveClubMemberRequest]) (*connect.Response[v1.ApproveClubMemberResponse], error)
+	RemoveClubMember(context.Context, *connect.Request[v1.RemoveClubMemberRequest]) (*connect.Response[v1.RemoveClubMemberResponse], error)
+	SetClubMemberRole(context.Context, *connect.Request[v1.SetClubMemberRoleRequest]) (*connect.Response[v1.SetClubMemberRoleResponse], error)
+	ListClubMembers(context.Context, *connect.Request[v1.ListClubMembersRequest]) (*connect.Response[v1.ListClubMembersResponse], error)
+}
+
+// NewClubServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewClubServiceHandler(svc ClubServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	clubServiceMethods := v1.File_api_v1_club_service_proto.Services().ByName("ClubService").Methods()
+	clubServiceCreateClubHandler := connect.NewUnaryHandler(
+		ClubServiceCreateClubProcedure,
+		svc.CreateClub,
+		connect.WithSchema(clubServiceMethods.ByName("CreateClub")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clubServiceGetClubHandler := connect.NewUnaryHandler(
+		ClubServiceGetClubProcedure,
+		svc.GetClub,
+		connect.WithSchema(clubServiceMethods.ByName("GetClub")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clubServiceListClubsHandler := connect.NewUnaryHandler(
+		ClubServiceListClubsProcedure,
+		svc.ListClubs,
+		connect.WithSchema(clubServiceMethods.ByName("ListClubs")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clubServiceJoinClubHandler := connect.NewUnaryHandler(
+		ClubServiceJoinClubProcedure,
+		svc.JoinClub,
+		connect.WithSchema(clubServiceMethods.ByName("JoinClub")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clubServiceLeaveClubHandler := connect.NewUnaryHandler(
+		ClubServiceLeaveClubProcedure,
+		svc.LeaveClub,
+		connect.WithSchema(clubServiceMethods.ByName("LeaveClub")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clubServiceInviteClubMemberHandler := connect.NewUnaryHandler(
+		ClubServiceInviteClubMemberProcedure,
+		svc.InviteClubMember,
+		connect.WithSchema(clubServiceMethods.ByName("InviteClubMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clubServiceApproveClubMemberHandler := connect.NewUnaryHandler(
+		ClubServiceApproveClubMemberProcedure,
+		svc.ApproveClubMember,
+		connect.WithSchema(clubServiceMethods.ByName("ApproveClubMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clubServiceRemoveClubMemberHandler := connect.NewUnaryHandler(
+		ClubServiceRemoveClubMemberProcedure,
+		svc.RemoveClubMember,
+		connect.WithSchema(clubServiceMethods.ByName("RemoveClubMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clubServiceSetClubMemberRoleHandler := connect.NewUnaryHandler(
+		ClubServiceSetClubMemberRoleProcedure,
+		svc.SetClubMemberRole,
+		connect.WithSchema(clubServiceMethods.ByName("SetClubMemberRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clubServiceListClubMembersHandler := connect.NewUnaryHandler(
+		ClubServiceListClubMembersProcedure,
+		svc.ListClubMembers,
+		connect.WithSchema(clubServiceMethods.ByName("ListClubMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.ClubService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ClubServiceCreateClubProcedure:
+			clubServiceCreateClubHandler.ServeHTTP(w, r)
+		case ClubServiceGetClubProcedure:
+			clubServiceGetClubHandler.ServeHTTP(w, r)
+		case ClubServiceListClubsProcedure:
+			clubServiceListClubsHandler.ServeHTTP(w, r)
+		case ClubServiceJoinClubProcedure:
+			clubServiceJoinClubHandler.ServeHTTP(w, r)
+		case ClubServiceLeaveClubProcedure:
+			clubServiceLeaveClubHandler.ServeHTTP(w, r)
+		case ClubServiceInviteClubMemberProcedure:
+			clubServiceInviteClubMemberHandler.ServeHTTP(w, r)
+		case ClubServiceApproveClubMemberProcedure:
+			clubServiceApproveClubMemberHandler.ServeHTTP(w, r)
+		case ClubServiceRemoveClubMemberProcedure:
+			clubServiceRemoveClubMemberHandler.ServeHTTP(w, r)
+		case ClubServiceSetClubMemberRoleProcedure:
+			clubServiceSetClubMemberRoleHandler.ServeHTTP(w, r)
+		case ClubServiceListClubMembersProcedure:
+			clubServiceListClubMembersHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedClubServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedClubServiceHandler struct{}
+
+func (UnimplementedClubServiceHandler) CreateClub(context.Context, *connect.Request[v1.CreateClubRequest]) (*connect.Response[v1.CreateClubResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ClubService.CreateClub is not implemented"))
+}
+
+func (UnimplementedClubServiceHandler) GetClub(context.Context, *connect.Request[v1.GetClubRequest]) (*connect.Response[v1.GetClubResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ClubService.GetClub is not implemented"))
+}
+
+func (UnimplementedClubServiceHandler) ListClubs(context.Context, *connect.Request[v1.ListClubsRequest]) (*connect.Response[v1.ListClubsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ClubService.ListClubs is not implemented"))
+}
+
+func (UnimplementedClubServiceHandler) JoinClub(context.Context, *connect.Request[v1.JoinClubRequest]) (*connect.Response[v1.JoinClubResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ClubService.JoinClub is not implemented"))
+}
+
+func (UnimplementedClubServiceHandler) LeaveClub(context.Context, *connect.Request[v1.LeaveClubRequest]) (*connect.Response[v1.LeaveClubResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ClubService.LeaveClub is not implemented"))
+}
+
+func (UnimplementedClubServiceHandler) InviteClubMember(context.Context, *connect.Request[v1.InviteClubMemberRequest]) (*connect.Response[v1.InviteClubMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ClubService.InviteClubMember is not implemented"))
+}
+
+func (UnimplementedClubServiceHandler) ApproveClubMember(context.Context, *connect.Request[v1.ApproveClubMemberRequest]) (*connect.Response[v1.ApproveClubMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ClubService.ApproveClubMember is not implemented"))
+}
+
+func (UnimplementedClubServiceHandler) RemoveClubMember(context.Context, *connect.Request[v1.RemoveClubMemberRequest]) (*connect.Response[v1.RemoveClubMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ClubService.RemoveClubMember is not implemented"))
+}
+
+func (UnimplementedClubServiceHandler) SetClubMemberRole(context.Context, *connect.Request[v1.SetClubMemberRoleRequest]) (*connect.Response[v1.SetClubMemberRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ClubService.SetClubMemberRole is not implemented"))
+}
+
+func (UnimplementedClubServiceHandler) ListClubMembers(context.Context, *connect.Request[v1.ListClubMembersRequest]) (*connect.Response[v1.ListClubMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ClubService.ListClubMembers is not implemented"))
+}
@@ -0,0 +1,342 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/coach_service.proto
+
+package apiv1connect
+
+import (
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CoachServiceName is the fully-qualified name of the CoachService service.
+	CoachServiceName = "api.v1.CoachService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CoachServiceGrantCoachAccessProcedure is the fully-qualified name of the CoachService's
+	// GrantCoachAccess RPC.
+	CoachServiceGrantCoachAccessProcedure = "/api.v1.CoachService/GrantCoachAccess"
+	// CoachServiceRevokeCoachAccessProcedure is the fully-qualified name of the CoachService's
+	// RevokeCoachAccess RPC.
+	CoachServiceRevokeCoachAccessProcedure = "/api.v1.CoachService/RevokeCoachAccess"
+	// CoachServiceListCoachesProcedure is the fully-qualified name of the CoachService's ListCoaches
+	// RPC.
+	CoachServiceListCoachesProcedure = "/api.v1.CoachService/ListCoaches"
+	// CoachServiceListCoachActionsProcedure is the fully-qualified name of the CoachService's
+	// ListCoachActions RPC.
+	CoachServiceListCoachActionsProcedure = "/api.v1.CoachService/ListCoachActions"
+	// CoachServiceGetCoachDashboardProcedure is the fully-qualified name of the CoachService's
+	// GetCoachDashboard RPC.
+	CoachServiceGetCoachDashboardProcedure = "/api.v1.CoachService/GetCoachDashboard"
+	// CoachServiceListAthleteWorkoutsProcedure is the fully-qualified name of the CoachService's
+	// ListAthleteWorkouts RPC.
+	CoachServiceListAthleteWorkoutsProcedure = "/api.v1.CoachService/ListAthleteWorkouts"
+	// CoachServiceGetAthletePersonalBestsProcedure is the fully-qualified name of the CoachService's
+	// GetAthletePersonalBests RPC.
+	CoachServiceGetAthletePersonalBestsProcedure = "/api.v1.CoachService/GetAthletePersonalBests"
+	// CoachServiceCreateAthleteRoutineProcedure is the fully-qualified name of the CoachService's
+	// CreateAthleteRoutine RPC.
+	CoachServiceCreateAthleteRoutineProcedure = "/api.v1.CoachService/CreateAthleteRoutine"
+	// CoachServiceUpdateAthleteRoutineProcedure is the fully-qualified name of the CoachService's
+	// UpdateAthleteRoutine RPC.
+	CoachServiceUpdateAthleteRoutineProcedure = "/api.v1.CoachService/UpdateAthleteRoutine"
+)
+
+// CoachServiceClient is a client for the api.v1.CoachService service.
+type CoachServiceClient interface {
+	GrantCoachAccess(context.Context, *connect.Request[v1.GrantCoachAccessRequest]) (*connect.Response[v1.GrantCoachAccessResponse], error)
+	RevokeCoachAccess(context.Context, *connect.Request[v1.RevokeCoachAccessRequest]) (*connect.Response[v1.RevokeCoachAccessResponse], error)
+	ListCoaches(context.Context, *connect.Request[v1.ListCoachesRequest]) (*connect.Response[v1.ListCoachesResponse], error)
+	ListCoachActions(context.Context, *connect.Request[v1.ListCoachActionsRequest]) (*connect.Response[v1.ListCoachActionsResponse], error)
+	GetCoachDashboard(context.Context, *connect.Request[v1.GetCoachDashboardRequest]) (*connect.Response[v1.GetCoachDashboardResponse], error)
+	ListAthleteWorkouts(context.Context, *connect.Request[v1.ListAthleteWorkoutsRequest]) (*connect.Response[v1.ListAthleteWorkoutsResponse], error)
+	GetAthletePersonalBests(context.Context, *connect.Request[v1.GetAthletePersonalBestsRequest]) (*connect.Response[v1.GetAthletePersonalBestsResponse], error)
+	CreateAthleteRoutine(context.Context, *connect.Request[v1.CreateAthleteRoutineRequest]) (*connect.Response[v1.CreateAthleteRoutineResponse], error)
+	UpdateAthleteRoutine(context.Context, *connect.Request[v1.UpdateAthleteRoutineRequest]) (*connect.Response[v1.UpdateAthleteRoutineResponse], error)
+}
+
+// NewCoachServiceClient constructs a client for the api.v1.CoachService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCoachServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CoachServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	coachServiceMethods := v1.File_api_v1_coach_service_proto.Services().ByName("CoachService").Methods()
+	return &coachServiceClient{
+		grantCoachAccess: connect.NewClient[v1.GrantCoachAccessRequest, v1.GrantCoachAccessResponse](
+			httpClient,
+			baseURL+CoachServiceGrantCoachAccessProcedure,
+			connect.WithSchema(coachServiceMethods.ByName("GrantCoachAccess")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeCoachAccess: connect.NewClient[v1.RevokeCoachAccessRequest, v1.RevokeCoachAccessResponse](
+			httpClient,
+			baseURL+CoachServiceRevokeCoachAccessProcedure,
+			connect.WithSchema(coachServiceMethods.ByName("RevokeCoachAccess")),
+			connect.WithClientOptions(opts...),
+		),
+		listCoaches: connect.NewClient[v1.ListCoachesRequest, v1.ListCoachesResponse](
+			httpClient,
+			baseURL+CoachServiceListCoachesProcedure,
+			connect.WithSchema(coachServiceMethods.ByName("ListCoaches")),
+			connect.WithClientOptions(opts...),
+		),
+		listCoachActions: connect.NewClient[v1.ListCoachActionsRequest, v1.ListCoachActionsResponse](
+			httpClient,
+			baseURL+CoachServiceListCoachActionsProcedure,
+			connect.WithSchema(coachServiceMethods.ByName("ListCoachActions")),
+			connect.WithClientOptions(opts...),
+		),
+		getCoachDashboard: connect.NewClient[v1.GetCoachDashboardRequest, v1.GetCoachDashboardResponse](
+			httpClient,
+			baseURL+CoachServiceGetCoachDashboardProcedure,
+			connect.WithSchema(coachServiceMethods.ByName("GetCoachDashboard")),
+			connect.WithClientOptions(opts...),
+		),
+		listAthleteWorkouts: connect.NewClient[v1.ListAthleteWorkoutsRequest, v1.ListAthleteWorkoutsResponse](
+			httpClient,
+			baseURL+CoachServiceListAthleteWorkoutsProcedure,
+			connect.WithSchema(coachServiceMethods.ByName("ListAthleteWorkouts")),
+			connect.WithClientOptions(opts...),
+		),
+		getAthletePersonalBests: connect.NewClient[v1.GetAthletePersonalBestsRequest, v1.GetAthletePersonalBestsResponse](
+			httpClient,
+			baseURL+CoachServiceGetAthletePersonalBestsProcedure,
+			connect.WithSchema(coachServiceMethods.ByName("GetAthletePersonalBests")),
+			connect.WithClientOptions(opts...),
+		),
+		createAthleteRoutine: connect.NewClient[v1.CreateAthleteRoutineRequest, v1.CreateAthleteRoutineResponse](
+			httpClient,
+			baseURL+CoachServiceCreateAthleteRoutineProcedure,
+			connect.WithSchema(coachServiceMethods.ByName("CreateAthleteRoutine")),
+			connect.WithClientOptions(opts...),
+		),
+		updateAthleteRoutine: connect.NewClient[v1.UpdateAthleteRoutineRequest, v1.UpdateAthleteRoutineResponse](
+			httpClient,
+			baseURL+CoachServiceUpdateAthleteRoutineProcedure,
+			connect.WithSchema(coachServiceMethods.ByName("UpdateAthleteRoutine")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// coachServiceClient implements CoachServiceClient.
+type coachServiceClient struct {
+	grantCoachAccess        *connect.Client[v1.GrantCoachAccessRequest, v1.GrantCoachAccessResponse]
+	revokeCoachAccess       *connect.Client[v1.RevokeCoachAccessRequest, v1.RevokeCoachAccessResponse]
+	listCoaches             *connect.Client[v1.ListCoachesRequest, v1.ListCoachesResponse]
+	listCoachActions        *connect.Client[v1.ListCoachActionsRequest, v1.ListCoachActionsResponse]
+	getCoachDashboard       *connect.Client[v1.GetCoachDashboardRequest, v1.GetCoachDashboardResponse]
+	listAthleteWorkouts     *connect.Client[v1.ListAthleteWorkoutsRequest, v1.ListAthleteWorkoutsResponse]
+	getAthletePersonalBests *connect.Client[v1.GetAthletePersonalBestsRequest, v1.GetAthletePersonalBestsResponse]
+	createAthleteRoutine    *connect.Client[v1.CreateAthleteRoutineRequest, v1.CreateAthleteRoutineResponse]
+	updateAthleteRoutine    *connect.Client[v1.UpdateAthleteRoutineRequest, v1.UpdateAthleteRoutineResponse]
+}
+
+// GrantCoachAccess calls api.v1.CoachService.GrantCoachAccess.
+func (c *coachServiceClient) GrantCoachAccess(ctx context.Context, req *connect.Request[v1.GrantCoachAccessRequest]) (*connect.Response[v1.GrantCoachAccessResponse], error) {
+	return c.grantCoachAccess.CallUnary(ctx, req)
+}
+
+// RevokeCoachAccess calls api.v1.CoachService.RevokeCoachAccess.
+func (c *coachServiceClient) RevokeCoachAccess(ctx context.Context, req *connect.Request[v1.RevokeCoachAccessRequest]) (*connect.Response[v1.RevokeCoachAccessResponse], error) {
+	return c.revokeCoachAccess.CallUnary(ctx, req)
+}
+
+// ListCoaches calls api.v1.CoachService.ListCoaches.
+func (c *coachServiceClient) ListCoaches(ctx context.Context, req *connect.Request[v1.ListCoachesRequest]) (*connect.Response[v1.ListCoachesResponse], error) {
+	return c.listCoaches.CallUnary(ctx, req)
+}
+
+// ListCoachActions calls api.v1.CoachService.ListCoachActions.
+func (c *coachServiceClient) ListCoachActions(ctx context.Context, req *connect.Request[v1.ListCoachActionsRequest]) (*connect.Response[v1.ListCoachActionsResponse], error) {
+	return c.listCoachActions.CallUnary(ctx, req)
+}
+
+// GetCoachDashboard calls api.v1.CoachService.GetCoachDashboard.
+func (c *coachServiceClient) GetCoachDashboard(ctx context.Context, req *connect.Request[v1.GetCoachDashboardRequest]) (*connect.Response[v1.GetCoachDashboardResponse], error) {
+	return c.getCoachDashboard.CallUnary(ctx, req)
+}
+
+// ListAthleteWorkouts calls api.v1.CoachService.ListAthleteWorkouts.
+func (c *coachServiceClient) ListAthleteWorkouts(ctx context.Context, req *connect.Request[v1.ListAthleteWorkoutsRequest]) (*connect.Response[v1.ListAthleteWorkoutsResponse], error) {
+	return c.listAthleteWorkouts.CallUnary(ctx, req)
+}
+
+// GetAthletePersonalBests calls api.v1.CoachService.GetAthletePersonalBests.
+func (c *coachServiceClient) GetAthletePersonalBests(ctx context.Context, req *connect.Request[v1.GetAthletePersonalBestsRequest]) (*connect.Response[v1.GetAthletePersonalBestsResponse], error) {
+	return c.getAthletePersonalBests.CallUnary(ctx, req)
+}
+
+// CreateAthleteRoutine calls api.v1.CoachService.CreateAthleteRoutine.
+func (c *coachServiceClient) CreateAthleteRoutine(ctx context.Context, req *connect.Request[v1.CreateAthleteRoutineRequest]) (*connect.Response[v1.CreateAthleteRoutineResponse], error) {
+	return c.createAthleteRoutine.CallUnary(ctx, req)
+}
+
+// UpdateAthleteRoutine calls api.v1.CoachService.UpdateAthleteRoutine.
+func (c *coachServiceClient) UpdateAthleteRoutine(ctx context.Context, req *connect.Request[v1.UpdateAthleteRoutineRequest]) (*connect.Response[v1.UpdateAthleteRoutineResponse], error) {
+	return c.updateAthleteRoutine.CallUnary(ctx, req)
+}
+
+// CoachServiceHandler is an implementation of the api.v1.CoachService service.
+type CoachServiceHandler interface {
+	GrantCoachAccess(context.Context, *connect.Request[v1.GrantCoachAccessRequest]) (*connect.Response[v1.GrantCoachAccessResponse], error)
+	RevokeCoachAccess(context.Context, *connect.Request[v1.RevokeCoachAccessRequest]) (*connect.Response[v1.RevokeCoachAccessResponse], error)
+	ListCoaches(context.Context, *connect.Request[v1.ListCoachesRequest]) (*connect.Response[v1.ListCoachesResponse], error)
+	ListCoachActions(context.Context, *connect.Request[v1.ListCoachActionsRequest]) (*connect.Response[v1.ListCoachActionsResponse], error)
+	GetCoachDashboard(context.Context, *connect.Request[v1.GetCoachDashboardRequest]) (*connect.Response[v1.GetCoachDashboardResponse], error)
+	ListAthleteWorkouts(context.Context, *connect.Request[v1.ListAthleteWorkoutsRequest]) (*connect.Response[v1.ListAthleteWorkoutsResponse], error)
+	GetAthletePersonalBests(context.Context, *connect.Request[v1.GetAthletePersonalBestsRequest]) (*connect.Response[v1.GetAthletePersonalBestsResponse], error)
+	CreateAthleteRoutine(context.Context, *connect.Request[v1.CreateAthleteRoutineRequest]) (*connect.Response[v1.CreateAthleteRoutineResponse], error)
+	UpdateAthleteRoutine(context.Context, *connect.Request[v1.UpdateAthleteRoutineRequest]) (*connect.Response[v1.UpdateAthleteRoutineResponse], error)
+}
+
+// NewCoachServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCoachServiceHandler(svc CoachServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	coachServiceMethods := v1.File_api_v1_coach_service_proto.Services().ByName("CoachService").Methods()
+	coachServiceGrantCoachAccessHandler := connect.NewUnaryHandler(
+		CoachServiceGrantCoachAccessProcedure,
+		svc.GrantCoachAccess,
+		connect.WithSchema(coachServiceMethods.ByName("GrantCoachAccess")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coachServiceRevokeCoachAccessHandler := connect.NewUnaryHandler(
+		CoachServiceRevokeCoachAccessProcedure,
+		svc.RevokeCoachAccess,
+		connect.WithSchema(coachServiceMethods.ByName("RevokeCoachAccess")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coachServiceListCoachesHandler := connect.NewUnaryHandler(
+		CoachServiceListCoachesProcedure,
+		svc.ListCoaches,
+		connect.WithSchema(coachServiceMethods.ByName("ListCoaches")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coachServiceListCoachActionsHandler := connect.NewUnaryHandler(
+		CoachServiceListCoachActionsProcedure,
+		svc.ListCoachActions,
+		connect.WithSchema(coachServiceMethods.ByName("ListCoachActions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coachServiceGetCoachDashboardHandler := connect.NewUnaryHandler(
+		CoachServiceGetCoachDashboardProcedure,
+		svc.GetCoachDashboard,
+		connect.WithSchema(coachServiceMethods.ByName("GetCoachDashboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coachServiceListAthleteWorkoutsHandler := connect.NewUnaryHandler(
+		CoachServiceListAthleteWorkoutsProcedure,
+		svc.ListAthleteWorkouts,
+		connect.WithSchema(coachServiceMethods.ByName("ListAthleteWorkouts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coachServiceGetAthletePersonalBestsHandler := connect.NewUnaryHandler(
+		CoachServiceGetAthletePersonalBestsProcedure,
+		svc.GetAthletePersonalBests,
+		connect.WithSchema(coachServiceMethods.ByName("GetAthletePersonalBests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coachServiceCreateAthleteRoutineHandler := connect.NewUnaryHandler(
+		CoachServiceCreateAthleteRoutineProcedure,
+		svc.CreateAthleteRoutine,
+		connect.WithSchema(coachServiceMethods.ByName("CreateAthleteRoutine")),
+		connect.WithHandlerOptions(opts...),
+	)
+	coachServiceUpdateAthleteRoutineHandler := connect.NewUnaryHandler(
+		CoachServiceUpdateAthleteRoutineProcedure,
+		svc.UpdateAthleteRoutine,
+		connect.WithSchema(coachServiceMethods.ByName("UpdateAthleteRoutine")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.CoachService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CoachServiceGrantCoachAccessProcedure:
+			coachServiceGrantCoachAccessHandler.ServeHTTP(w, r)
+		case CoachServiceRevokeCoachAccessProcedure:
+			coachServiceRevokeCoachAccessHandler.ServeHTTP(w, r)
+		case CoachServiceListCoachesProcedure:
+			coachServiceListCoachesHandler.ServeHTTP(w, r)
+		case CoachServiceListCoachActionsProcedure:
+			coachServiceListCoachActionsHandler.ServeHTTP(w, r)
+		case CoachServiceGetCoachDashboardProcedure:
+			coachServiceGetCoachDashboardHandler.ServeHTTP(w, r)
+		case CoachServiceListAthleteWorkoutsProcedure:
+			coachServiceListAthleteWorkoutsHandler.ServeHTTP(w, r)
+		case CoachServiceGetAthletePersonalBestsProcedure:
+			coachServiceGetAthletePersonalBestsHandler.ServeHTTP(w, r)
+		case CoachServiceCreateAthleteRoutineProcedure:
+			coachServiceCreateAthleteRoutineHandler.ServeHTTP(w, r)
+		case CoachServiceUpdateAthleteRoutineProcedure:
+			coachServiceUpdateAthleteRoutineHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCoachServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCoachServiceHandler struct{}
+
+func (UnimplementedCoachServiceHandler) GrantCoachAccess(context.Context, *connect.Request[v1.GrantCoachAccessRequest]) (*connect.Response[v1.GrantCoachAccessResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CoachService.GrantCoachAccess is not implemented"))
+}
+
+func (UnimplementedCoachServiceHandler) RevokeCoachAccess(context.Context, *connect.Request[v1.RevokeCoachAccessRequest]) (*connect.Response[v1.RevokeCoachAccessResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CoachService.RevokeCoachAccess is not implemented"))
+}
+
+func (UnimplementedCoachServiceHandler) ListCoaches(context.Context, *connect.Request[v1.ListCoachesRequest]) (*connect.Response[v1.ListCoachesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CoachService.ListCoaches is not implemented"))
+}
+
+func (UnimplementedCoachServiceHandler) ListCoachActions(context.Context, *connect.Request[v1.ListCoachActionsRequest]) (*connect.Response[v1.ListCoachActionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CoachService.ListCoachActions is not implemented"))
+}
+
+func (UnimplementedCoachServiceHandler) GetCoachDashboard(context.Context, *connect.Request[v1.GetCoachDashboardRequest]) (*connect.Response[v1.GetCoachDashboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CoachService.GetCoachDashboard is not implemented"))
+}
+
+func (UnimplementedCoachServiceHandler) ListAthleteWorkouts(context.Context, *connect.Request[v1.ListAthleteWorkoutsRequest]) (*connect.Response[v1.ListAthleteWorkoutsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CoachService.ListAthleteWorkouts is not implemented"))
+}
+
+func (UnimplementedCoachServiceHandler) GetAthletePersonalBests(context.Context, *connect.Request[v1.GetAthletePersonalBestsRequest]) (*connect.Response[v1.GetAthletePersonalBestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CoachService.GetAthletePersonalBests is not implemented"))
+}
+
+func (UnimplementedCoachServiceHandler) CreateAthleteRoutine(context.Context, *connect.Request[v1.CreateAthleteRoutineRequest]) (*connect.Response[v1.CreateAthleteRoutineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CoachService.CreateAthleteRoutine is not implemented"))
+}
+
+func (UnimplementedCoachServiceHandler) UpdateAthleteRoutine(context.Context, *connect.Request[v1.UpdateAthleteRoutineRequest]) (*connect.Response[v1.UpdateAthleteRoutineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.CoachService.UpdateAthleteRoutine is not implemented"))
+}
@@ -58,6 +58,21 @@ const (
 	// ExerciseServiceListSetsProcedure is the fully-qualified name of the ExerciseService's ListSets
 	// RPC.
 	ExerciseServiceListSetsProcedure = "/api.v1.ExerciseService/ListSets"
+	// ExerciseServiceGetExerciseProgressProcedure is the fully-qualified name of the ExerciseService's
+	// GetExerciseProgress RPC.
+	ExerciseServiceGetExerciseProgressProcedure = "/api.v1.ExerciseService/GetExerciseProgress"
+	// ExerciseServiceMergeExercisesProcedure is the fully-qualified name of the ExerciseService's
+	// MergeExercises RPC.
+	ExerciseServiceMergeExercisesProcedure = "/api.v1.ExerciseService/MergeExercises"
+	// ExerciseServiceListDeletedExercisesProcedure is the fully-qualified name of the ExerciseService's
+	// ListDeletedExercises RPC.
+	ExerciseServiceListDeletedExercisesProcedure = "/api.v1.ExerciseService/ListDeletedExercises"
+	// ExerciseServiceRestoreExerciseProcedure is the fully-qualified name of the ExerciseService's
+	// RestoreExercise RPC.
+	ExerciseServiceRestoreExerciseProcedure = "/api.v1.ExerciseService/RestoreExercise"
+	// ExerciseServiceGetLeaderboardProcedure is the fully-qualified name of the ExerciseService's
+	// GetLeaderboard RPC.
+	ExerciseServiceGetLeaderboardProcedure = "/api.v1.ExerciseService/GetLeaderboard"
 )
 
 // ExerciseServiceClient is a client for the api.v1.ExerciseService service.
@@ -70,6 +85,11 @@ type ExerciseServiceClient interface {
 	GetPreviousWorkoutSets(context.Context, *connect.Request[v1.GetPreviousWorkoutSetsRequest]) (*connect.Response[v1.GetPreviousWorkoutSetsResponse], error)
 	GetPersonalBests(context.Context, *connect.Request[v1.GetPersonalBestsRequest]) (*connect.Response[v1.GetPersonalBestsResponse], error)
 	ListSets(context.Context, *connect.Request[v1.ListSetsRequest]) (*connect.Response[v1.ListSetsResponse], error)
+	GetExerciseProgress(context.Context, *connect.Request[v1.GetExerciseProgressRequest]) (*connect.Response[v1.GetExerciseProgressResponse], error)
+	MergeExercises(context.Context, *connect.Request[v1.MergeExercisesRequest]) (*connect.Response[v1.MergeExercisesResponse], error)
+	ListDeletedExercises(context.Context, *connect.Request[v1.ListDeletedExercisesRequest]) (*connect.Response[v1.ListDeletedExercisesResponse], error)
+	RestoreExercise(context.Context, *connect.Request[v1.RestoreExerciseRequest]) (*connect.Response[v1.RestoreExerciseResponse], error)
+	GetLeaderboard(context.Context, *connect.Request[v1.GetLeaderboardRequest]) (*connect.Response[v1.GetLeaderboardResponse], error)
 }
 
 // NewExerciseServiceClient constructs a client for the api.v1.ExerciseService service. By default,
@@ -131,6 +151,36 @@ func NewExerciseServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(exerciseServiceMethods.ByName("ListSets")),
 			connect.WithClientOptions(opts...),
 		),
+		getExerciseProgress: connect.NewClient[v1.GetExerciseProgressRequest, v1.GetExerciseProgressResponse](
+			httpClient,
+			baseURL+ExerciseServiceGetExerciseProgressProcedure,
+			connect.WithSchema(exerciseServiceMethods.ByName("GetExerciseProgress")),
+			connect.WithClientOptions(opts...),
+		),
+		mergeExercises: connect.NewClient[v1.MergeExercisesRequest, v1.MergeExercisesResponse](
+			httpClient,
+			baseURL+ExerciseServiceMergeExercisesProcedure,
+			connect.WithSchema(exerciseServiceMethods.ByName("MergeExercises")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedExercises: connect.NewClient[v1.ListDeletedExercisesRequest, v1.ListDeletedExercisesResponse](
+			httpClient,
+			baseURL+ExerciseServiceListDeletedExercisesProcedure,
+			connect.WithSchema(exerciseServiceMethods.ByName("ListDeletedExercises")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreExercise: connect.NewClient[v1.RestoreExerciseRequest, v1.RestoreExerciseResponse](
+			httpClient,
+			baseURL+ExerciseServiceRestoreExerciseProcedure,
+			connect.WithSchema(exerciseServiceMethods.ByName("RestoreExercise")),
+			connect.WithClientOptions(opts...),
+		),
+		getLeaderboard: connect.NewClient[v1.GetLeaderboardRequest, v1.GetLeaderboardResponse](
+			httpClient,
+			baseURL+ExerciseServiceGetLeaderboardProcedure,
+			connect.WithSchema(exerciseServiceMethods.ByName("GetLeaderboard")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -144,6 +194,11 @@ type exerciseServiceClient struct {
 	getPreviousWorkoutSets *connect.Client[v1.GetPreviousWorkoutSetsRequest, v1.GetPreviousWorkoutSetsResponse]
 	getPersonalBests       *connect.Client[v1.GetPersonalBestsRequest, v1.GetPersonalBestsResponse]
 	listSets               *connect.Client[v1.ListSetsRequest, v1.ListSetsResponse]
+	getExerciseProgress    *connect.Client[v1.GetExerciseProgressRequest, v1.GetExerciseProgressResponse]
+	mergeExercises         *connect.Client[v1.MergeExercisesRequest, v1.MergeExercisesResponse]
+	listDeletedExercises   *connect.Client[v1.ListDeletedExercisesRequest, v1.ListDeletedExercisesResponse]
+	restoreExercise        *connect.Client[v1.RestoreExerciseRequest, v1.RestoreExerciseResponse]
+	getLeaderboard         *connect.Client[v1.GetLeaderboardRequest, v1.GetLeaderboardResponse]
 }
 
 // CreateExercise calls api.v1.ExerciseService.CreateExercise.
@@ -186,6 +241,31 @@ func (c *exerciseServiceClient) ListSets(ctx context.Context, req *connect.Reque
 	return c.listSets.CallUnary(ctx, req)
 }
 
+// GetExerciseProgress calls api.v1.ExerciseService.GetExerciseProgress.
+func (c *exerciseServiceClient) GetExerciseProgress(ctx context.Context, req *connect.Request[v1.GetExerciseProgressRequest]) (*connect.Response[v1.GetExerciseProgressResponse], error) {
+	return c.getExerciseProgress.CallUnary(ctx, req)
+}
+
+// MergeExercises calls api.v1.ExerciseService.MergeExercises.
+func (c *exerciseServiceClient) MergeExercises(ctx context.Context, req *connect.Request[v1.MergeExercisesRequest]) (*connect.Response[v1.MergeExercisesResponse], error) {
+	return c.mergeExercises.CallUnary(ctx, req)
+}
+
+// ListDeletedExercises calls api.v1.ExerciseService.ListDeletedExercises.
+func (c *exerciseServiceClient) ListDeletedExercises(ctx context.Context, req *connect.Request[v1.ListDeletedExercisesRequest]) (*connect.Response[v1.ListDeletedExercisesResponse], error) {
+	return c.listDeletedExercises.CallUnary(ctx, req)
+}
+
+// RestoreExercise calls api.v1.ExerciseService.RestoreExercise.
+func (c *exerciseServiceClient) RestoreExercise(ctx context.Context, req *connect.Request[v1.RestoreExerciseRequest]) (*connect.Response[v1.RestoreExerciseResponse], error) {
+	return c.restoreExercise.CallUnary(ctx, req)
+}
+
+// GetLeaderboard calls api.v1.ExerciseService.GetLeaderboard.
+func (c *exerciseServiceClient) GetLeaderboard(ctx context.Context, req *connect.Request[v1.GetLeaderboardRequest]) (*connect.Response[v1.GetLeaderboardResponse], error) {
+	return c.getLeaderboard.CallUnary(ctx, req)
+}
+
 // ExerciseServiceHandler is an implementation of the api.v1.ExerciseService service.
 type ExerciseServiceHandler interface {
 	CreateExercise(context.Context, *connect.Request[v1.CreateExerciseRequest]) (*connect.Response[v1.CreateExerciseResponse], error)
@@ -196,6 +276,11 @@ type ExerciseServiceHandler interface {
 	GetPreviousWorkoutSets(context.Context, *connect.Request[v1.GetPreviousWorkoutSetsRequest]) (*connect.Response[v1.GetPreviousWorkoutSetsResponse], error)
 	GetPersonalBests(context.Context, *connect.Request[v1.GetPersonalBestsRequest]) (*connect.Response[v1.GetPersonalBestsResponse], error)
 	ListSets(context.Context, *connect.Request[v1.ListSetsRequest]) (*connect.Response[v1.ListSetsResponse], error)
+	GetExerciseProgress(context.Context, *connect.Request[v1.GetExerciseProgressRequest]) (*connect.Response[v1.GetExerciseProgressResponse], error)
+	MergeExercises(context.Context, *connect.Request[v1.MergeExercisesRequest]) (*connect.Response[v1.MergeExercisesResponse], error)
+	ListDeletedExercises(context.Context, *connect.Request[v1.ListDeletedExercisesRequest]) (*connect.Response[v1.ListDeletedExercisesResponse], error)
+	RestoreExercise(context.Context, *connect.Request[v1.RestoreExerciseRequest]) (*connect.Response[v1.RestoreExerciseResponse], error)
+	GetLeaderboard(context.Context, *connect.Request[v1.GetLeaderboardRequest]) (*connect.Response[v1.GetLeaderboardResponse], error)
 }
 
 // NewExerciseServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -253,6 +338,36 @@ func NewExerciseServiceHandler(svc ExerciseServiceHandler, opts ...connect.Handl
 		connect.WithSchema(exerciseServiceMethods.ByName("ListSets")),
 		connect.WithHandlerOptions(opts...),
 	)
+	exerciseServiceGetExerciseProgressHandler := connect.NewUnaryHandler(
+		ExerciseServiceGetExerciseProgressProcedure,
+		svc.GetExerciseProgress,
+		connect.WithSchema(exerciseServiceMethods.ByName("GetExerciseProgress")),
+		connect.WithHandlerOptions(opts...),
+	)
+	exerciseServiceMergeExercisesHandler := connect.NewUnaryHandler(
+		ExerciseServiceMergeExercisesProcedure,
+		svc.MergeExercises,
+		connect.WithSchema(exerciseServiceMethods.ByName("MergeExercises")),
+		connect.WithHandlerOptions(opts...),
+	)
+	exerciseServiceListDeletedExercisesHandler := connect.NewUnaryHandler(
+		ExerciseServiceListDeletedExercisesProcedure,
+		svc.ListDeletedExercises,
+		connect.WithSchema(exerciseServiceMethods.ByName("ListDeletedExercises")),
+		connect.WithHandlerOptions(opts...),
+	)
+	exerciseServiceRestoreExerciseHandler := connect.NewUnaryHandler(
+		ExerciseServiceRestoreExerciseProcedure,
+		svc.RestoreExercise,
+		connect.WithSchema(exerciseServiceMethods.ByName("RestoreExercise")),
+		connect.WithHandlerOptions(opts...),
+	)
+	exerciseServiceGetLeaderboardHandler := connect.NewUnaryHandler(
+		ExerciseServiceGetLeaderboardProcedure,
+		svc.GetLeaderboard,
+		connect.WithSchema(exerciseServiceMethods.ByName("GetLeaderboard")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.v1.ExerciseService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ExerciseServiceCreateExerciseProcedure:
@@ -271,6 +386,16 @@ func NewExerciseServiceHandler(svc ExerciseServiceHandler, opts ...connect.Handl
 			exerciseServiceGetPersonalBestsHandler.ServeHTTP(w, r)
 		case ExerciseServiceListSetsProcedure:
 			exerciseServiceListSetsHandler.ServeHTTP(w, r)
+		case ExerciseServiceGetExerciseProgressProcedure:
+			exerciseServiceGetExerciseProgressHandler.ServeHTTP(w, r)
+		case ExerciseServiceMergeExercisesProcedure:
+			exerciseServiceMergeExercisesHandler.ServeHTTP(w, r)
+		case ExerciseServiceListDeletedExercisesProcedure:
+			exerciseServiceListDeletedExercisesHandler.ServeHTTP(w, r)
+		case ExerciseServiceRestoreExerciseProcedure:
+			exerciseServiceRestoreExerciseHandler.ServeHTTP(w, r)
+		case ExerciseServiceGetLeaderboardProcedure:
+			exerciseServiceGetLeaderboardHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -311,3 +436,23 @@ func (UnimplementedExerciseServiceHandler) GetPersonalBests(context.Context, *co
 func (UnimplementedExerciseServiceHandler) ListSets(context.Context, *connect.Request[v1.ListSetsRequest]) (*connect.Response[v1.ListSetsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ExerciseService.ListSets is not implemented"))
 }
+
+func (UnimplementedExerciseServiceHandler) GetExerciseProgress(context.Context, *connect.Request[v1.GetExerciseProgressRequest]) (*connect.Response[v1.GetExerciseProgressResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ExerciseService.GetExerciseProgress is not implemented"))
+}
+
+func (UnimplementedExerciseServiceHandler) MergeExercises(context.Context, *connect.Request[v1.MergeExercisesRequest]) (*connect.Response[v1.MergeExercisesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ExerciseService.MergeExercises is not implemented"))
+}
+
+func (UnimplementedExerciseServiceHandler) ListDeletedExercises(context.Context, *connect.Request[v1.ListDeletedExercisesRequest]) (*connect.Response[v1.ListDeletedExercisesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ExerciseService.ListDeletedExercises is not implemented"))
+}
+
+func (UnimplementedExerciseServiceHandler) RestoreExercise(context.Context, *connect.Request[v1.RestoreExerciseRequest]) (*connect.Response[v1.RestoreExerciseResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ExerciseService.RestoreExercise is not implemented"))
+}
+
+func (UnimplementedExerciseServiceHandler) GetLeaderboard(context.Context, *connect.Request[v1.GetLeaderboardRequest]) (*connect.Response[v1.GetLeaderboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ExerciseService.GetLeaderboard is not implemented"))
+}
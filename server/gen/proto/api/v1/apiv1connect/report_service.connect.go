@@ -0,0 +1,110 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: api/v1/report_service.proto
+
+package apiv1connect
+
+import (
+	context "context"
+	errors "errors"
+	http "net/http"
+	strings "strings"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ReportServiceName is the fully-qualified name of the ReportService service.
+	ReportServiceName = "api.v1.ReportService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ReportServiceReportContentProcedure is the fully-qualified name of the ReportService's
+	// ReportContent RPC.
+	ReportServiceReportContentProcedure = "/api.v1.ReportService/ReportContent"
+)
+
+// ReportServiceClient is a client for the api.v1.ReportService service.
+type ReportServiceClient interface {
+	ReportContent(context.Context, *connect.Request[v1.ReportContentRequest]) (*connect.Response[v1.ReportContentResponse], error)
+}
+
+// NewReportServiceClient constructs a client for the api.v1.ReportService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewReportServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ReportServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	reportServiceMethods := v1.File_api_v1_report_service_proto.Services().ByName("ReportService").Methods()
+	return &reportServiceClient{
+		reportContent: connect.NewClient[v1.ReportContentRequest, v1.ReportContentResponse](
+			httpClient,
+			baseURL+ReportServiceReportContentProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("ReportContent")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// reportServiceClient implements ReportServiceClient.
+type reportServiceClient struct {
+	reportContent *connect.Client[v1.ReportContentRequest, v1.ReportContentResponse]
+}
+
+// ReportContent calls api.v1.ReportService.ReportContent.
+func (c *reportServiceClient) ReportContent(ctx context.Context, req *connect.Request[v1.ReportContentRequest]) (*connect.Response[v1.ReportContentResponse], error) {
+	return c.reportContent.CallUnary(ctx, req)
+}
+
+// ReportServiceHandler is an implementation of the api.v1.ReportService service.
+type ReportServiceHandler interface {
+	ReportContent(context.Context, *connect.Request[v1.ReportContentRequest]) (*connect.Response[v1.ReportContentResponse], error)
+}
+
+// NewReportServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewReportServiceHandler(svc ReportServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	reportServiceMethods := v1.File_api_v1_report_service_proto.Services().ByName("ReportService").Methods()
+	reportServiceReportContentHandler := connect.NewUnaryHandler(
+		ReportServiceReportContentProcedure,
+		svc.ReportContent,
+		connect.WithSchema(reportServiceMethods.ByName("ReportContent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/api.v1.ReportService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ReportServiceReportContentProcedure:
+			reportServiceReportContentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedReportServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedReportServiceHandler struct{}
+
+func (UnimplementedReportServiceHandler) ReportContent(context.Context, *connect.Request[v1.ReportContentRequest]) (*connect.Response[v1.ReportContentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.ReportService.ReportContent is not implemented"))
+}
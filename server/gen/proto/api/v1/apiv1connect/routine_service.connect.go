@@ -58,6 +58,21 @@ const (
 	// RoutineServiceUpdateExerciseOrderProcedure is the fully-qualified name of the RoutineService's
 	// UpdateExerciseOrder RPC.
 	RoutineServiceUpdateExerciseOrderProcedure = "/api.v1.RoutineService/UpdateExerciseOrder"
+	// RoutineServiceCreateRoutineFromWorkoutProcedure is the fully-qualified name of the
+	// RoutineService's CreateRoutineFromWorkout RPC.
+	RoutineServiceCreateRoutineFromWorkoutProcedure = "/api.v1.RoutineService/CreateRoutineFromWorkout"
+	// RoutineServiceShareRoutineProcedure is the fully-qualified name of the RoutineService's
+	// ShareRoutine RPC.
+	RoutineServiceShareRoutineProcedure = "/api.v1.RoutineService/ShareRoutine"
+	// RoutineServiceCloneRoutineProcedure is the fully-qualified name of the RoutineService's
+	// CloneRoutine RPC.
+	RoutineServiceCloneRoutineProcedure = "/api.v1.RoutineService/CloneRoutine"
+	// RoutineServiceListDeletedRoutinesProcedure is the fully-qualified name of the RoutineService's
+	// ListDeletedRoutines RPC.
+	RoutineServiceListDeletedRoutinesProcedure = "/api.v1.RoutineService/ListDeletedRoutines"
+	// RoutineServiceRestoreRoutineProcedure is the fully-qualified name of the RoutineService's
+	// RestoreRoutine RPC.
+	RoutineServiceRestoreRoutineProcedure = "/api.v1.RoutineService/RestoreRoutine"
 )
 
 // RoutineServiceClient is a client for the api.v1.RoutineService service.
@@ -70,6 +85,11 @@ type RoutineServiceClient interface {
 	AddExercise(context.Context, *connect.Request[v1.AddExerciseRequest]) (*connect.Response[v1.AddExerciseResponse], error)
 	RemoveExercise(context.Context, *connect.Request[v1.RemoveExerciseRequest]) (*connect.Response[v1.RemoveExerciseResponse], error)
 	UpdateExerciseOrder(context.Context, *connect.Request[v1.UpdateExerciseOrderRequest]) (*connect.Response[v1.UpdateExerciseOrderResponse], error)
+	CreateRoutineFromWorkout(context.Context, *connect.Request[v1.CreateRoutineFromWorkoutRequest]) (*connect.Response[v1.CreateRoutineFromWorkoutResponse], error)
+	ShareRoutine(context.Context, *connect.Request[v1.ShareRoutineRequest]) (*connect.Response[v1.ShareRoutineResponse], error)
+	CloneRoutine(context.Context, *connect.Request[v1.CloneRoutineRequest]) (*connect.Response[v1.CloneRoutineResponse], error)
+	ListDeletedRoutines(context.Context, *connect.Request[v1.ListDeletedRoutinesRequest]) (*connect.Response[v1.ListDeletedRoutinesResponse], error)
+	RestoreRoutine(context.Context, *connect.Request[v1.RestoreRoutineRequest]) (*connect.Response[v1.RestoreRoutineResponse], error)
 }
 
 // NewRoutineServiceClient constructs a client for the api.v1.RoutineService service. By default, it
@@ -131,19 +151,54 @@ func NewRoutineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(routineServiceMethods.ByName("UpdateExerciseOrder")),
 			connect.WithClientOptions(opts...),
 		),
+		createRoutineFromWorkout: connect.NewClient[v1.CreateRoutineFromWorkoutRequest, v1.CreateRoutineFromWorkoutResponse](
+			httpClient,
+			baseURL+RoutineServiceCreateRoutineFromWorkoutProcedure,
+			connect.WithSchema(routineServiceMethods.ByName("CreateRoutineFromWorkout")),
+			connect.WithClientOptions(opts...),
+		),
+		shareRoutine: connect.NewClient[v1.ShareRoutineRequest, v1.ShareRoutineResponse](
+			httpClient,
+			baseURL+RoutineServiceShareRoutineProcedure,
+			connect.WithSchema(routineServiceMethods.ByName("ShareRoutine")),
+			connect.WithClientOptions(opts...),
+		),
+		cloneRoutine: connect.NewClient[v1.CloneRoutineRequest, v1.CloneRoutineResponse](
+			httpClient,
+			baseURL+RoutineServiceCloneRoutineProcedure,
+			connect.WithSchema(routineServiceMethods.ByName("CloneRoutine")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedRoutines: connect.NewClient[v1.ListDeletedRoutinesRequest, v1.ListDeletedRoutinesResponse](
+			httpClient,
+			baseURL+RoutineServiceListDeletedRoutinesProcedure,
+			connect.WithSchema(routineServiceMethods.ByName("ListDeletedRoutines")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreRoutine: connect.NewClient[v1.RestoreRoutineRequest, v1.RestoreRoutineResponse](
+			httpClient,
+			baseURL+RoutineServiceRestoreRoutineProcedure,
+			connect.WithSchema(routineServiceMethods.ByName("RestoreRoutine")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // routineServiceClient implements RoutineServiceClient.
 type routineServiceClient struct {
-	createRoutine       *connect.Client[v1.CreateRoutineRequest, v1.CreateRoutineResponse]
-	getRoutine          *connect.Client[v1.GetRoutineRequest, v1.GetRoutineResponse]
-	updateRoutine       *connect.Client[v1.UpdateRoutineRequest, v1.UpdateRoutineResponse]
-	deleteRoutine       *connect.Client[v1.DeleteRoutineRequest, v1.DeleteRoutineResponse]
-	listRoutines        *connect.Client[v1.ListRoutinesRequest, v1.ListRoutinesResponse]
-	addExercise         *connect.Client[v1.AddExerciseRequest, v1.AddExerciseResponse]
-	removeExercise      *connect.Client[v1.RemoveExerciseRequest, v1.RemoveExerciseResponse]
-	updateExerciseOrder *connect.Client[v1.UpdateExerciseOrderRequest, v1.UpdateExerciseOrderResponse]
+	createRoutine            *connect.Client[v1.CreateRoutineRequest, v1.CreateRoutineResponse]
+	getRoutine               *connect.Client[v1.GetRoutineRequest, v1.GetRoutineResponse]
+	updateRoutine            *connect.Client[v1.UpdateRoutineRequest, v1.UpdateRoutineResponse]
+	deleteRoutine            *connect.Client[v1.DeleteRoutineRequest, v1.DeleteRoutineResponse]
+	listRoutines             *connect.Client[v1.ListRoutinesRequest, v1.ListRoutinesResponse]
+	addExercise              *connect.Client[v1.AddExerciseRequest, v1.AddExerciseResponse]
+	removeExercise           *connect.Client[v1.RemoveExerciseRequest, v1.RemoveExerciseResponse]
+	updateExerciseOrder      *connect.Client[v1.UpdateExerciseOrderRequest, v1.UpdateExerciseOrderResponse]
+	createRoutineFromWorkout *connect.Client[v1.CreateRoutineFromWorkoutRequest, v1.CreateRoutineFromWorkoutResponse]
+	shareRoutine             *connect.Client[v1.ShareRoutineRequest, v1.ShareRoutineResponse]
+	cloneRoutine             *connect.Client[v1.CloneRoutineRequest, v1.CloneRoutineResponse]
+	listDeletedRoutines      *connect.Client[v1.ListDeletedRoutinesRequest, v1.ListDeletedRoutinesResponse]
+	restoreRoutine           *connect.Client[v1.RestoreRoutineRequest, v1.RestoreRoutineResponse]
 }
 
 // CreateRoutine calls api.v1.RoutineService.CreateRoutine.
@@ -186,6 +241,31 @@ func (c *routineServiceClient) UpdateExerciseOrder(ctx context.Context, req *con
 	return c.updateExerciseOrder.CallUnary(ctx, req)
 }
 
+// CreateRoutineFromWorkout calls api.v1.RoutineService.CreateRoutineFromWorkout.
+func (c *routineServiceClient) CreateRoutineFromWorkout(ctx context.Context, req *connect.Request[v1.CreateRoutineFromWorkoutRequest]) (*connect.Response[v1.CreateRoutineFromWorkoutResponse], error) {
+	return c.createRoutineFromWorkout.CallUnary(ctx, req)
+}
+
+// ShareRoutine calls api.v1.RoutineService.ShareRoutine.
+func (c *routineServiceClient) ShareRoutine(ctx context.Context, req *connect.Request[v1.ShareRoutineRequest]) (*connect.Response[v1.ShareRoutineResponse], error) {
+	return c.shareRoutine.CallUnary(ctx, req)
+}
+
+// CloneRoutine calls api.v1.RoutineService.CloneRoutine.
+func (c *routineServiceClient) CloneRoutine(ctx context.Context, req *connect.Request[v1.CloneRoutineRequest]) (*connect.Response[v1.CloneRoutineResponse], error) {
+	return c.cloneRoutine.CallUnary(ctx, req)
+}
+
+// ListDeletedRoutines calls api.v1.RoutineService.ListDeletedRoutines.
+func (c *routineServiceClient) ListDeletedRoutines(ctx context.Context, req *connect.Request[v1.ListDeletedRoutinesRequest]) (*connect.Response[v1.ListDeletedRoutinesResponse], error) {
+	return c.listDeletedRoutines.CallUnary(ctx, req)
+}
+
+// RestoreRoutine calls api.v1.RoutineService.RestoreRoutine.
+func (c *routineServiceClient) RestoreRoutine(ctx context.Context, req *connect.Request[v1.RestoreRoutineRequest]) (*connect.Response[v1.RestoreRoutineResponse], error) {
+	return c.restoreRoutine.CallUnary(ctx, req)
+}
+
 // RoutineServiceHandler is an implementation of the api.v1.RoutineService service.
 type RoutineServiceHandler interface {
 	CreateRoutine(context.Context, *connect.Request[v1.CreateRoutineRequest]) (*connect.Response[v1.CreateRoutineResponse], error)
@@ -196,6 +276,11 @@ type RoutineServiceHandler interface {
 	AddExercise(context.Context, *connect.Request[v1.AddExerciseRequest]) (*connect.Response[v1.AddExerciseResponse], error)
 	RemoveExercise(context.Context, *connect.Request[v1.RemoveExerciseRequest]) (*connect.Response[v1.RemoveExerciseResponse], error)
 	UpdateExerciseOrder(context.Context, *connect.Request[v1.UpdateExerciseOrderRequest]) (*connect.Response[v1.UpdateExerciseOrderResponse], error)
+	CreateRoutineFromWorkout(context.Context, *connect.Request[v1.CreateRoutineFromWorkoutRequest]) (*connect.Response[v1.CreateRoutineFromWorkoutResponse], error)
+	ShareRoutine(context.Context, *connect.Request[v1.ShareRoutineRequest]) (*connect.Response[v1.ShareRoutineResponse], error)
+	CloneRoutine(context.Context, *connect.Request[v1.CloneRoutineRequest]) (*connect.Response[v1.CloneRoutineResponse], error)
+	ListDeletedRoutines(context.Context, *connect.Request[v1.ListDeletedRoutinesRequest]) (*connect.Response[v1.ListDeletedRoutinesResponse], error)
+	RestoreRoutine(context.Context, *connect.Request[v1.RestoreRoutineRequest]) (*connect.Response[v1.RestoreRoutineResponse], error)
 }
 
 // NewRoutineServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -253,6 +338,36 @@ func NewRoutineServiceHandler(svc RoutineServiceHandler, opts ...connect.Handler
 		connect.WithSchema(routineServiceMethods.ByName("UpdateExerciseOrder")),
 		connect.WithHandlerOptions(opts...),
 	)
+	routineServiceCreateRoutineFromWorkoutHandler := connect.NewUnaryHandler(
+		RoutineServiceCreateRoutineFromWorkoutProcedure,
+		svc.CreateRoutineFromWorkout,
+		connect.WithSchema(routineServiceMethods.ByName("CreateRoutineFromWorkout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	routineServiceShareRoutineHandler := connect.NewUnaryHandler(
+		RoutineServiceShareRoutineProcedure,
+		svc.ShareRoutine,
+		connect.WithSchema(routineServiceMethods.ByName("ShareRoutine")),
+		connect.WithHandlerOptions(opts...),
+	)
+	routineServiceCloneRoutineHandler := connect.NewUnaryHandler(
+		RoutineServiceCloneRoutineProcedure,
+		svc.CloneRoutine,
+		connect.WithSchema(routineServiceMethods.ByName("CloneRoutine")),
+		connect.WithHandlerOptions(opts...),
+	)
+	routineServiceListDeletedRoutinesHandler := connect.NewUnaryHandler(
+		RoutineServiceListDeletedRoutinesProcedure,
+		svc.ListDeletedRoutines,
+		connect.WithSchema(routineServiceMethods.ByName("ListDeletedRoutines")),
+		connect.WithHandlerOptions(opts...),
+	)
+	routineServiceRestoreRoutineHandler := connect.NewUnaryHandler(
+		RoutineServiceRestoreRoutineProcedure,
+		svc.RestoreRoutine,
+		connect.WithSchema(routineServiceMethods.ByName("RestoreRoutine")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.v1.RoutineService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case RoutineServiceCreateRoutineProcedure:
@@ -271,6 +386,16 @@ func NewRoutineServiceHandler(svc RoutineServiceHandler, opts ...connect.Handler
 			routineServiceRemoveExerciseHandler.ServeHTTP(w, r)
 		case RoutineServiceUpdateExerciseOrderProcedure:
 			routineServiceUpdateExerciseOrderHandler.ServeHTTP(w, r)
+		case RoutineServiceCreateRoutineFromWorkoutProcedure:
+			routineServiceCreateRoutineFromWorkoutHandler.ServeHTTP(w, r)
+		case RoutineServiceShareRoutineProcedure:
+			routineServiceShareRoutineHandler.ServeHTTP(w, r)
+		case RoutineServiceCloneRoutineProcedure:
+			routineServiceCloneRoutineHandler.ServeHTTP(w, r)
+		case RoutineServiceListDeletedRoutinesProcedure:
+			routineServiceListDeletedRoutinesHandler.ServeHTTP(w, r)
+		case RoutineServiceRestoreRoutineProcedure:
+			routineServiceRestoreRoutineHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -311,3 +436,23 @@ func (UnimplementedRoutineServiceHandler) RemoveExercise(context.Context, *conne
 func (UnimplementedRoutineServiceHandler) UpdateExerciseOrder(context.Context, *connect.Request[v1.UpdateExerciseOrderRequest]) (*connect.Response[v1.UpdateExerciseOrderResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.RoutineService.UpdateExerciseOrder is not implemented"))
 }
+
+func (UnimplementedRoutineServiceHandler) CreateRoutineFromWorkout(context.Context, *connect.Request[v1.CreateRoutineFromWorkoutRequest]) (*connect.Response[v1.CreateRoutineFromWorkoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.RoutineService.CreateRoutineFromWorkout is not implemented"))
+}
+
+func (UnimplementedRoutineServiceHandler) ShareRoutine(context.Context, *connect.Request[v1.ShareRoutineRequest]) (*connect.Response[v1.ShareRoutineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.RoutineService.ShareRoutine is not implemented"))
+}
+
+func (UnimplementedRoutineServiceHandler) CloneRoutine(context.Context, *connect.Request[v1.CloneRoutineRequest]) (*connect.Response[v1.CloneRoutineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.RoutineService.CloneRoutine is not implemented"))
+}
+
+func (UnimplementedRoutineServiceHandler) ListDeletedRoutines(context.Context, *connect.Request[v1.ListDeletedRoutinesRequest]) (*connect.Response[v1.ListDeletedRoutinesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.RoutineService.ListDeletedRoutines is not implemented"))
+}
+
+func (UnimplementedRoutineServiceHandler) RestoreRoutine(context.Context, *connect.Request[v1.RestoreRoutineRequest]) (*connect.Response[v1.RestoreRoutineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.RoutineService.RestoreRoutine is not implemented"))
+}
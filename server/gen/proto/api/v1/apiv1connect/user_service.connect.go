@@ -41,6 +41,22 @@ const (
 	// UserServiceUnfollowUserProcedure is the fully-qualified name of the UserService's UnfollowUser
 	// RPC.
 	UserServiceUnfollowUserProcedure = "/api.v1.UserService/UnfollowUser"
+	// UserServiceListFollowRequestsProcedure is the fully-qualified name of the UserService's
+	// ListFollowRequests RPC.
+	UserServiceListFollowRequestsProcedure = "/api.v1.UserService/ListFollowRequests"
+	// UserServiceApproveFollowRequestProcedure is the fully-qualified name of the UserService's
+	// ApproveFollowRequest RPC.
+	UserServiceApproveFollowRequestProcedure = "/api.v1.UserService/ApproveFollowRequest"
+	// UserServiceRejectFollowRequestProcedure is the fully-qualified name of the UserService's
+	// RejectFollowRequest RPC.
+	UserServiceRejectFollowRequestProcedure = "/api.v1.UserService/RejectFollowRequest"
+	// UserServiceBlockUserProcedure is the fully-qualified name of the UserService's BlockUser RPC.
+	UserServiceBlockUserProcedure = "/api.v1.UserService/BlockUser"
+	// UserServiceUnblockUserProcedure is the fully-qualified name of the UserService's UnblockUser RPC.
+	UserServiceUnblockUserProcedure = "/api.v1.UserService/UnblockUser"
+	// UserServiceListBlockedUsersProcedure is the fully-qualified name of the UserService's
+	// ListBlockedUsers RPC.
+	UserServiceListBlockedUsersProcedure = "/api.v1.UserService/ListBlockedUsers"
 	// UserServiceListFollowersProcedure is the fully-qualified name of the UserService's ListFollowers
 	// RPC.
 	UserServiceListFollowersProcedure = "/api.v1.UserService/ListFollowers"
@@ -49,6 +65,28 @@ const (
 	UserServiceListFolloweesProcedure = "/api.v1.UserService/ListFollowees"
 	// UserServiceSearchUsersProcedure is the fully-qualified name of the UserService's SearchUsers RPC.
 	UserServiceSearchUsersProcedure = "/api.v1.UserService/SearchUsers"
+	// UserServiceGetProfileProcedure is the fully-qualified name of the UserService's GetProfile RPC.
+	UserServiceGetProfileProcedure = "/api.v1.UserService/GetProfile"
+	// UserServiceUpdateProfileProcedure is the fully-qualified name of the UserService's UpdateProfile
+	// RPC.
+	UserServiceUpdateProfileProcedure = "/api.v1.UserService/UpdateProfile"
+	// UserServiceGetStrengthScoresProcedure is the fully-qualified name of the UserService's
+	// GetStrengthScores RPC.
+	UserServiceGetStrengthScoresProcedure = "/api.v1.UserService/GetStrengthScores"
+	// UserServiceGetYearSummaryProcedure is the fully-qualified name of the UserService's
+	// GetYearSummary RPC.
+	UserServiceGetYearSummaryProcedure = "/api.v1.UserService/GetYearSummary"
+	// UserServiceExportDataProcedure is the fully-qualified name of the UserService's ExportData RPC.
+	UserServiceExportDataProcedure = "/api.v1.UserService/ExportData"
+	// UserServiceDownloadDataExportProcedure is the fully-qualified name of the UserService's
+	// DownloadDataExport RPC.
+	UserServiceDownloadDataExportProcedure = "/api.v1.UserService/DownloadDataExport"
+	// UserServiceDeleteAccountProcedure is the fully-qualified name of the UserService's DeleteAccount
+	// RPC.
+	UserServiceDeleteAccountProcedure = "/api.v1.UserService/DeleteAccount"
+	// UserServiceCancelAccountDeletionProcedure is the fully-qualified name of the UserService's
+	// CancelAccountDeletion RPC.
+	UserServiceCancelAccountDeletionProcedure = "/api.v1.UserService/CancelAccountDeletion"
 )
 
 // UserServiceClient is a client for the api.v1.UserService service.
@@ -56,9 +94,23 @@ type UserServiceClient interface {
 	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
 	FollowUser(context.Context, *connect.Request[v1.FollowUserRequest]) (*connect.Response[v1.FollowUserResponse], error)
 	UnfollowUser(context.Context, *connect.Request[v1.UnfollowUserRequest]) (*connect.Response[v1.UnfollowUserResponse], error)
+	ListFollowRequests(context.Context, *connect.Request[v1.ListFollowRequestsRequest]) (*connect.Response[v1.ListFollowRequestsResponse], error)
+	ApproveFollowRequest(context.Context, *connect.Request[v1.ApproveFollowRequestRequest]) (*connect.Response[v1.ApproveFollowRequestResponse], error)
+	RejectFollowRequest(context.Context, *connect.Request[v1.RejectFollowRequestRequest]) (*connect.Response[v1.RejectFollowRequestResponse], error)
+	BlockUser(context.Context, *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error)
+	UnblockUser(context.Context, *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error)
+	ListBlockedUsers(context.Context, *connect.Request[v1.ListBlockedUsersRequest]) (*connect.Response[v1.ListBlockedUsersResponse], error)
 	ListFollowers(context.Context, *connect.Request[v1.ListFollowersRequest]) (*connect.Response[v1.ListFollowersResponse], error)
 	ListFollowees(context.Context, *connect.Request[v1.ListFolloweesRequest]) (*connect.Response[v1.ListFolloweesResponse], error)
 	SearchUsers(context.Context, *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error)
+	GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error)
+	UpdateProfile(context.Context, *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error)
+	GetStrengthScores(context.Context, *connect.Request[v1.GetStrengthScoresRequest]) (*connect.Response[v1.GetStrengthScoresResponse], error)
+	GetYearSummary(context.Context, *connect.Request[v1.GetYearSummaryRequest]) (*connect.Response[v1.GetYearSummaryResponse], error)
+	ExportData(context.Context, *connect.Request[v1.ExportDataRequest]) (*connect.ServerStreamForClient[v1.ExportDataResponse], error)
+	DownloadDataExport(context.Context, *connect.Request[v1.DownloadDataExportRequest]) (*connect.ServerStreamForClient[v1.DownloadDataExportResponse], error)
+	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
+	CancelAccountDeletion(context.Context, *connect.Request[v1.CancelAccountDeletionRequest]) (*connect.Response[v1.CancelAccountDeletionResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the api.v1.UserService service. By default, it uses
@@ -90,6 +142,42 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("UnfollowUser")),
 			connect.WithClientOptions(opts...),
 		),
+		listFollowRequests: connect.NewClient[v1.ListFollowRequestsRequest, v1.ListFollowRequestsResponse](
+			httpClient,
+			baseURL+UserServiceListFollowRequestsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListFollowRequests")),
+			connect.WithClientOptions(opts...),
+		),
+		approveFollowRequest: connect.NewClient[v1.ApproveFollowRequestRequest, v1.ApproveFollowRequestResponse](
+			httpClient,
+			baseURL+UserServiceApproveFollowRequestProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ApproveFollowRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		rejectFollowRequest: connect.NewClient[v1.RejectFollowRequestRequest, v1.RejectFollowRequestResponse](
+			httpClient,
+			baseURL+UserServiceRejectFollowRequestProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RejectFollowRequest")),
+			connect.WithClientOptions(opts...),
+		),
+		blockUser: connect.NewClient[v1.BlockUserRequest, v1.BlockUserResponse](
+			httpClient,
+			baseURL+UserServiceBlockUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("BlockUser")),
+			connect.WithClientOptions(opts...),
+		),
+		unblockUser: connect.NewClient[v1.UnblockUserRequest, v1.UnblockUserResponse](
+			httpClient,
+			baseURL+UserServiceUnblockUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UnblockUser")),
+			connect.WithClientOptions(opts...),
+		),
+		listBlockedUsers: connect.NewClient[v1.ListBlockedUsersRequest, v1.ListBlockedUsersResponse](
+			httpClient,
+			baseURL+UserServiceListBlockedUsersProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListBlockedUsers")),
+			connect.WithClientOptions(opts...),
+		),
 		listFollowers: connect.NewClient[v1.ListFollowersRequest, v1.ListFollowersResponse](
 			httpClient,
 			baseURL+UserServiceListFollowersProcedure,
@@ -108,17 +196,79 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("SearchUsers")),
 			connect.WithClientOptions(opts...),
 		),
+		getProfile: connect.NewClient[v1.GetProfileRequest, v1.GetProfileResponse](
+			httpClient,
+			baseURL+UserServiceGetProfileProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		updateProfile: connect.NewClient[v1.UpdateProfileRequest, v1.UpdateProfileResponse](
+			httpClient,
+			baseURL+UserServiceUpdateProfileProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UpdateProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		getStrengthScores: connect.NewClient[v1.GetStrengthScoresRequest, v1.GetStrengthScoresResponse](
+			httpClient,
+			baseURL+UserServiceGetStrengthScoresProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetStrengthScores")),
+			connect.WithClientOptions(opts...),
+		),
+		getYearSummary: connect.NewClient[v1.GetYearSummaryRequest, v1.GetYearSummaryResponse](
+			httpClient,
+			baseURL+UserServiceGetYearSummaryProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetYearSummary")),
+			connect.WithClientOptions(opts...),
+		),
+		exportData: connect.NewClient[v1.ExportDataRequest, v1.ExportDataResponse](
+			httpClient,
+			baseURL+UserServiceExportDataProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ExportData")),
+			connect.WithClientOptions(opts...),
+		),
+		downloadDataExport: connect.NewClient[v1.DownloadDataExportRequest, v1.DownloadDataExportResponse](
+			httpClient,
+			baseURL+UserServiceDownloadDataExportProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DownloadDataExport")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAccount: connect.NewClient[v1.DeleteAccountRequest, v1.DeleteAccountResponse](
+			httpClient,
+			baseURL+UserServiceDeleteAccountProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelAccountDeletion: connect.NewClient[v1.CancelAccountDeletionRequest, v1.CancelAccountDeletionResponse](
+			httpClient,
+			baseURL+UserServiceCancelAccountDeletionProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CancelAccountDeletion")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	getUser       *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
-	followUser    *connect.Client[v1.FollowUserRequest, v1.FollowUserResponse]
-	unfollowUser  *connect.Client[v1.UnfollowUserRequest, v1.UnfollowUserResponse]
-	listFollowers *connect.Client[v1.ListFollowersRequest, v1.ListFollowersResponse]
-	listFollowees *connect.Client[v1.ListFolloweesRequest, v1.ListFolloweesResponse]
-	searchUsers   *connect.Client[v1.SearchUsersRequest, v1.SearchUsersResponse]
+	getUser               *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	followUser            *connect.Client[v1.FollowUserRequest, v1.FollowUserResponse]
+	unfollowUser          *connect.Client[v1.UnfollowUserRequest, v1.UnfollowUserResponse]
+	listFollowRequests    *connect.Client[v1.ListFollowRequestsRequest, v1.ListFollowRequestsResponse]
+	approveFollowRequest  *connect.Client[v1.ApproveFollowRequestRequest, v1.ApproveFollowRequestResponse]
+	rejectFollowRequest   *connect.Client[v1.RejectFollowRequestRequest, v1.RejectFollowRequestResponse]
+	blockUser             *connect.Client[v1.BlockUserRequest, v1.BlockUserResponse]
+	unblockUser           *connect.Client[v1.UnblockUserRequest, v1.UnblockUserResponse]
+	listBlockedUsers      *connect.Client[v1.ListBlockedUsersRequest, v1.ListBlockedUsersResponse]
+	listFollowers         *connect.Client[v1.ListFollowersRequest, v1.ListFollowersResponse]
+	listFollowees         *connect.Client[v1.ListFolloweesRequest, v1.ListFolloweesResponse]
+	searchUsers           *connect.Client[v1.SearchUsersRequest, v1.SearchUsersResponse]
+	getProfile            *connect.Client[v1.GetProfileRequest, v1.GetProfileResponse]
+	updateProfile         *connect.Client[v1.UpdateProfileRequest, v1.UpdateProfileResponse]
+	getStrengthScores     *connect.Client[v1.GetStrengthScoresRequest, v1.GetStrengthScoresResponse]
+	getYearSummary        *connect.Client[v1.GetYearSummaryRequest, v1.GetYearSummaryResponse]
+	exportData            *connect.Client[v1.ExportDataRequest, v1.ExportDataResponse]
+	downloadDataExport    *connect.Client[v1.DownloadDataExportRequest, v1.DownloadDataExportResponse]
+	deleteAccount         *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
+	cancelAccountDeletion *connect.Client[v1.CancelAccountDeletionRequest, v1.CancelAccountDeletionResponse]
 }
 
 // GetUser calls api.v1.UserService.GetUser.
@@ -136,6 +286,36 @@ func (c *userServiceClient) UnfollowUser(ctx context.Context, req *connect.Reque
 	return c.unfollowUser.CallUnary(ctx, req)
 }
 
+// ListFollowRequests calls api.v1.UserService.ListFollowRequests.
+func (c *userServiceClient) ListFollowRequests(ctx context.Context, req *connect.Request[v1.ListFollowRequestsRequest]) (*connect.Response[v1.ListFollowRequestsResponse], error) {
+	return c.listFollowRequests.CallUnary(ctx, req)
+}
+
+// ApproveFollowRequest calls api.v1.UserService.ApproveFollowRequest.
+func (c *userServiceClient) ApproveFollowRequest(ctx context.Context, req *connect.Request[v1.ApproveFollowRequestRequest]) (*connect.Response[v1.ApproveFollowRequestResponse], error) {
+	return c.approveFollowRequest.CallUnary(ctx, req)
+}
+
+// RejectFollowRequest calls api.v1.UserService.RejectFollowRequest.
+func (c *userServiceClient) RejectFollowRequest(ctx context.Context, req *connect.Request[v1.RejectFollowRequestRequest]) (*connect.Response[v1.RejectFollowRequestResponse], error) {
+	return c.rejectFollowRequest.CallUnary(ctx, req)
+}
+
+// BlockUser calls api.v1.UserService.BlockUser.
+func (c *userServiceClient) BlockUser(ctx context.Context, req *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error) {
+	return c.blockUser.CallUnary(ctx, req)
+}
+
+// UnblockUser calls api.v1.UserService.UnblockUser.
+func (c *userServiceClient) UnblockUser(ctx context.Context, req *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error) {
+	return c.unblockUser.CallUnary(ctx, req)
+}
+
+// ListBlockedUsers calls api.v1.UserService.ListBlockedUsers.
+func (c *userServiceClient) ListBlockedUsers(ctx context.Context, req *connect.Request[v1.ListBlockedUsersRequest]) (*connect.Response[v1.ListBlockedUsersResponse], error) {
+	return c.listBlockedUsers.CallUnary(ctx, req)
+}
+
 // ListFollowers calls api.v1.UserService.ListFollowers.
 func (c *userServiceClient) ListFollowers(ctx context.Context, req *connect.Request[v1.ListFollowersRequest]) (*connect.Response[v1.ListFollowersResponse], error) {
 	return c.listFollowers.CallUnary(ctx, req)
@@ -151,14 +331,68 @@ func (c *userServiceClient) SearchUsers(ctx context.Context, req *connect.Reques
 	return c.searchUsers.CallUnary(ctx, req)
 }
 
+// GetProfile calls api.v1.UserService.GetProfile.
+func (c *userServiceClient) GetProfile(ctx context.Context, req *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error) {
+	return c.getProfile.CallUnary(ctx, req)
+}
+
+// UpdateProfile calls api.v1.UserService.UpdateProfile.
+func (c *userServiceClient) UpdateProfile(ctx context.Context, req *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error) {
+	return c.updateProfile.CallUnary(ctx, req)
+}
+
+// GetStrengthScores calls api.v1.UserService.GetStrengthScores.
+func (c *userServiceClient) GetStrengthScores(ctx context.Context, req *connect.Request[v1.GetStrengthScoresRequest]) (*connect.Response[v1.GetStrengthScoresResponse], error) {
+	return c.getStrengthScores.CallUnary(ctx, req)
+}
+
+// GetYearSummary calls api.v1.UserService.GetYearSummary.
+func (c *userServiceClient) GetYearSummary(ctx context.Context, req *connect.Request[v1.GetYearSummaryRequest]) (*connect.Response[v1.GetYearSummaryResponse], error) {
+	return c.getYearSummary.CallUnary(ctx, req)
+}
+
+// ExportData calls api.v1.UserService.ExportData.
+func (c *userServiceClient) ExportData(ctx context.Context, req *connect.Request[v1.ExportDataRequest]) (*connect.ServerStreamForClient[v1.ExportDataResponse], error) {
+	return c.exportData.CallServerStream(ctx, req)
+}
+
+// DownloadDataExport calls api.v1.UserService.DownloadDataExport.
+func (c *userServiceClient) DownloadDataExport(ctx context.Context, req *connect.Request[v1.DownloadDataExportRequest]) (*connect.ServerStreamForClient[v1.DownloadDataExportResponse], error) {
+	return c.downloadDataExport.CallServerStream(ctx, req)
+}
+
+// DeleteAccount calls api.v1.UserService.DeleteAccount.
+func (c *userServiceClient) DeleteAccount(ctx context.Context, req *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
+	return c.deleteAccount.CallUnary(ctx, req)
+}
+
+// CancelAccountDeletion calls api.v1.UserService.CancelAccountDeletion.
+func (c *userServiceClient) CancelAccountDeletion(ctx context.Context, req *connect.Request[v1.CancelAccountDeletionRequest]) (*connect.Response[v1.CancelAccountDeletionResponse], error) {
+	return c.cancelAccountDeletion.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the api.v1.UserService service.
 type UserServiceHandler interface {
 	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
 	FollowUser(context.Context, *connect.Request[v1.FollowUserRequest]) (*connect.Response[v1.FollowUserResponse], error)
 	UnfollowUser(context.Context, *connect.Request[v1.UnfollowUserRequest]) (*connect.Response[v1.UnfollowUserResponse], error)
+	ListFollowRequests(context.Context, *connect.Request[v1.ListFollowRequestsRequest]) (*connect.Response[v1.ListFollowRequestsResponse], error)
+	ApproveFollowRequest(context.Context, *connect.Request[v1.ApproveFollowRequestRequest]) (*connect.Response[v1.ApproveFollowRequestResponse], error)
+	RejectFollowRequest(context.Context, *connect.Request[v1.RejectFollowRequestRequest]) (*connect.Response[v1.RejectFollowRequestResponse], error)
+	BlockUser(context.Context, *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error)
+	UnblockUser(context.Context, *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error)
+	ListBlockedUsers(context.Context, *connect.Request[v1.ListBlockedUsersRequest]) (*connect.Response[v1.ListBlockedUsersResponse], error)
 	ListFollowers(context.Context, *connect.Request[v1.ListFollowersRequest]) (*connect.Response[v1.ListFollowersResponse], error)
 	ListFollowees(context.Context, *connect.Request[v1.ListFolloweesRequest]) (*connect.Response[v1.ListFolloweesResponse], error)
 	SearchUsers(context.Context, *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error)
+	GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error)
+	UpdateProfile(context.Context, *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error)
+	GetStrengthScores(context.Context, *connect.Request[v1.GetStrengthScoresRequest]) (*connect.Response[v1.GetStrengthScoresResponse], error)
+	GetYearSummary(context.Context, *connect.Request[v1.GetYearSummaryRequest]) (*connect.Response[v1.GetYearSummaryResponse], error)
+	ExportData(context.Context, *connect.Request[v1.ExportDataRequest], *connect.ServerStream[v1.ExportDataResponse]) error
+	DownloadDataExport(context.Context, *connect.Request[v1.DownloadDataExportRequest], *connect.ServerStream[v1.DownloadDataExportResponse]) error
+	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
+	CancelAccountDeletion(context.Context, *connect.Request[v1.CancelAccountDeletionRequest]) (*connect.Response[v1.CancelAccountDeletionResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -186,6 +420,42 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("UnfollowUser")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceListFollowRequestsHandler := connect.NewUnaryHandler(
+		UserServiceListFollowRequestsProcedure,
+		svc.ListFollowRequests,
+		connect.WithSchema(userServiceMethods.ByName("ListFollowRequests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceApproveFollowRequestHandler := connect.NewUnaryHandler(
+		UserServiceApproveFollowRequestProcedure,
+		svc.ApproveFollowRequest,
+		connect.WithSchema(userServiceMethods.ByName("ApproveFollowRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceRejectFollowRequestHandler := connect.NewUnaryHandler(
+		UserServiceRejectFollowRequestProcedure,
+		svc.RejectFollowRequest,
+		connect.WithSchema(userServiceMethods.ByName("RejectFollowRequest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceBlockUserHandler := connect.NewUnaryHandler(
+		UserServiceBlockUserProcedure,
+		svc.BlockUser,
+		connect.WithSchema(userServiceMethods.ByName("BlockUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUnblockUserHandler := connect.NewUnaryHandler(
+		UserServiceUnblockUserProcedure,
+		svc.UnblockUser,
+		connect.WithSchema(userServiceMethods.ByName("UnblockUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListBlockedUsersHandler := connect.NewUnaryHandler(
+		UserServiceListBlockedUsersProcedure,
+		svc.ListBlockedUsers,
+		connect.WithSchema(userServiceMethods.ByName("ListBlockedUsers")),
+		connect.WithHandlerOptions(opts...),
+	)
 	userServiceListFollowersHandler := connect.NewUnaryHandler(
 		UserServiceListFollowersProcedure,
 		svc.ListFollowers,
@@ -204,6 +474,54 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("SearchUsers")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceGetProfileHandler := connect.NewUnaryHandler(
+		UserServiceGetProfileProcedure,
+		svc.GetProfile,
+		connect.WithSchema(userServiceMethods.ByName("GetProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUpdateProfileHandler := connect.NewUnaryHandler(
+		UserServiceUpdateProfileProcedure,
+		svc.UpdateProfile,
+		connect.WithSchema(userServiceMethods.ByName("UpdateProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetStrengthScoresHandler := connect.NewUnaryHandler(
+		UserServiceGetStrengthScoresProcedure,
+		svc.GetStrengthScores,
+		connect.WithSchema(userServiceMethods.ByName("GetStrengthScores")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetYearSummaryHandler := connect.NewUnaryHandler(
+		UserServiceGetYearSummaryProcedure,
+		svc.GetYearSummary,
+		connect.WithSchema(userServiceMethods.ByName("GetYearSummary")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceExportDataHandler := connect.NewServerStreamHandler(
+		UserServiceExportDataProcedure,
+		svc.ExportData,
+		connect.WithSchema(userServiceMethods.ByName("ExportData")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDownloadDataExportHandler := connect.NewServerStreamHandler(
+		UserServiceDownloadDataExportProcedure,
+		svc.DownloadDataExport,
+		connect.WithSchema(userServiceMethods.ByName("DownloadDataExport")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteAccountHandler := connect.NewUnaryHandler(
+		UserServiceDeleteAccountProcedure,
+		svc.DeleteAccount,
+		connect.WithSchema(userServiceMethods.ByName("DeleteAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCancelAccountDeletionHandler := connect.NewUnaryHandler(
+		UserServiceCancelAccountDeletionProcedure,
+		svc.CancelAccountDeletion,
+		connect.WithSchema(userServiceMethods.ByName("CancelAccountDeletion")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceGetUserProcedure:
@@ -212,12 +530,40 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceFollowUserHandler.ServeHTTP(w, r)
 		case UserServiceUnfollowUserProcedure:
 			userServiceUnfollowUserHandler.ServeHTTP(w, r)
+		case UserServiceListFollowRequestsProcedure:
+			userServiceListFollowRequestsHandler.ServeHTTP(w, r)
+		case UserServiceApproveFollowRequestProcedure:
+			userServiceApproveFollowRequestHandler.ServeHTTP(w, r)
+		case UserServiceRejectFollowRequestProcedure:
+			userServiceRejectFollowRequestHandler.ServeHTTP(w, r)
+		case UserServiceBlockUserProcedure:
+			userServiceBlockUserHandler.ServeHTTP(w, r)
+		case UserServiceUnblockUserProcedure:
+			userServiceUnblockUserHandler.ServeHTTP(w, r)
+		case UserServiceListBlockedUsersProcedure:
+			userServiceListBlockedUsersHandler.ServeHTTP(w, r)
 		case UserServiceListFollowersProcedure:
 			userServiceListFollowersHandler.ServeHTTP(w, r)
 		case UserServiceListFolloweesProcedure:
 			userServiceListFolloweesHandler.ServeHTTP(w, r)
 		case UserServiceSearchUsersProcedure:
 			userServiceSearchUsersHandler.ServeHTTP(w, r)
+		case UserServiceGetProfileProcedure:
+			userServiceGetProfileHandler.ServeHTTP(w, r)
+		case UserServiceUpdateProfileProcedure:
+			userServiceUpdateProfileHandler.ServeHTTP(w, r)
+		case UserServiceGetStrengthScoresProcedure:
+			userServiceGetStrengthScoresHandler.ServeHTTP(w, r)
+		case UserServiceGetYearSummaryProcedure:
+			userServiceGetYearSummaryHandler.ServeHTTP(w, r)
+		case UserServiceExportDataProcedure:
+			userServiceExportDataHandler.ServeHTTP(w, r)
+		case UserServiceDownloadDataExportProcedure:
+			userServiceDownloadDataExportHandler.ServeHTTP(w, r)
+		case UserServiceDeleteAccountProcedure:
+			userServiceDeleteAccountHandler.ServeHTTP(w, r)
+		case UserServiceCancelAccountDeletionProcedure:
+			userServiceCancelAccountDeletionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -239,6 +585,30 @@ func (UnimplementedUserServiceHandler) UnfollowUser(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.UnfollowUser is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) ListFollowRequests(context.Context, *connect.Request[v1.ListFollowRequestsRequest]) (*connect.Response[v1.ListFollowRequestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.ListFollowRequests is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ApproveFollowRequest(context.Context, *connect.Request[v1.ApproveFollowRequestRequest]) (*connect.Response[v1.ApproveFollowRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.ApproveFollowRequest is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) RejectFollowRequest(context.Context, *connect.Request[v1.RejectFollowRequestRequest]) (*connect.Response[v1.RejectFollowRequestResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.RejectFollowRequest is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) BlockUser(context.Context, *connect.Request[v1.BlockUserRequest]) (*connect.Response[v1.BlockUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.BlockUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UnblockUser(context.Context, *connect.Request[v1.UnblockUserRequest]) (*connect.Response[v1.UnblockUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.UnblockUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListBlockedUsers(context.Context, *connect.Request[v1.ListBlockedUsersRequest]) (*connect.Response[v1.ListBlockedUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.ListBlockedUsers is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) ListFollowers(context.Context, *connect.Request[v1.ListFollowersRequest]) (*connect.Response[v1.ListFollowersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.ListFollowers is not implemented"))
 }
@@ -250,3 +620,35 @@ func (UnimplementedUserServiceHandler) ListFollowees(context.Context, *connect.R
 func (UnimplementedUserServiceHandler) SearchUsers(context.Context, *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.SearchUsers is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) GetProfile(context.Context, *connect.Request[v1.GetProfileRequest]) (*connect.Response[v1.GetProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.GetProfile is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateProfile(context.Context, *connect.Request[v1.UpdateProfileRequest]) (*connect.Response[v1.UpdateProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.UpdateProfile is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetStrengthScores(context.Context, *connect.Request[v1.GetStrengthScoresRequest]) (*connect.Response[v1.GetStrengthScoresResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.GetStrengthScores is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetYearSummary(context.Context, *connect.Request[v1.GetYearSummaryRequest]) (*connect.Response[v1.GetYearSummaryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.GetYearSummary is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ExportData(context.Context, *connect.Request[v1.ExportDataRequest], *connect.ServerStream[v1.ExportDataResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.ExportData is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DownloadDataExport(context.Context, *connect.Request[v1.DownloadDataExportRequest], *connect.ServerStream[v1.DownloadDataExportResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.DownloadDataExport is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.DeleteAccount is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CancelAccountDeletion(context.Context, *connect.Request[v1.CancelAccountDeletionRequest]) (*connect.Response[v1.CancelAccountDeletionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.UserService.CancelAccountDeletion is not implemented"))
+}
@@ -46,12 +46,36 @@ const (
 	// WorkoutServiceDeleteWorkoutProcedure is the fully-qualified name of the WorkoutService's
 	// DeleteWorkout RPC.
 	WorkoutServiceDeleteWorkoutProcedure = "/api.v1.WorkoutService/DeleteWorkout"
+	// WorkoutServiceRestoreWorkoutProcedure is the fully-qualified name of the WorkoutService's
+	// RestoreWorkout RPC.
+	WorkoutServiceRestoreWorkoutProcedure = "/api.v1.WorkoutService/RestoreWorkout"
 	// WorkoutServicePostCommentProcedure is the fully-qualified name of the WorkoutService's
 	// PostComment RPC.
 	WorkoutServicePostCommentProcedure = "/api.v1.WorkoutService/PostComment"
+	// WorkoutServiceUpdateCommentProcedure is the fully-qualified name of the WorkoutService's
+	// UpdateComment RPC.
+	WorkoutServiceUpdateCommentProcedure = "/api.v1.WorkoutService/UpdateComment"
+	// WorkoutServiceDeleteCommentProcedure is the fully-qualified name of the WorkoutService's
+	// DeleteComment RPC.
+	WorkoutServiceDeleteCommentProcedure = "/api.v1.WorkoutService/DeleteComment"
 	// WorkoutServiceUpdateWorkoutProcedure is the fully-qualified name of the WorkoutService's
 	// UpdateWorkout RPC.
 	WorkoutServiceUpdateWorkoutProcedure = "/api.v1.WorkoutService/UpdateWorkout"
+	// WorkoutServiceCompareWorkoutsProcedure is the fully-qualified name of the WorkoutService's
+	// CompareWorkouts RPC.
+	WorkoutServiceCompareWorkoutsProcedure = "/api.v1.WorkoutService/CompareWorkouts"
+	// WorkoutServiceImportWorkoutsProcedure is the fully-qualified name of the WorkoutService's
+	// ImportWorkouts RPC.
+	WorkoutServiceImportWorkoutsProcedure = "/api.v1.WorkoutService/ImportWorkouts"
+	// WorkoutServiceReactToWorkoutProcedure is the fully-qualified name of the WorkoutService's
+	// ReactToWorkout RPC.
+	WorkoutServiceReactToWorkoutProcedure = "/api.v1.WorkoutService/ReactToWorkout"
+	// WorkoutServiceRemoveReactionProcedure is the fully-qualified name of the WorkoutService's
+	// RemoveReaction RPC.
+	WorkoutServiceRemoveReactionProcedure = "/api.v1.WorkoutService/RemoveReaction"
+	// WorkoutServiceListReactionsProcedure is the fully-qualified name of the WorkoutService's
+	// ListReactions RPC.
+	WorkoutServiceListReactionsProcedure = "/api.v1.WorkoutService/ListReactions"
 )
 
 // WorkoutServiceClient is a client for the api.v1.WorkoutService service.
@@ -60,8 +84,16 @@ type WorkoutServiceClient interface {
 	GetWorkout(context.Context, *connect.Request[v1.GetWorkoutRequest]) (*connect.Response[v1.GetWorkoutResponse], error)
 	ListWorkouts(context.Context, *connect.Request[v1.ListWorkoutsRequest]) (*connect.Response[v1.ListWorkoutsResponse], error)
 	DeleteWorkout(context.Context, *connect.Request[v1.DeleteWorkoutRequest]) (*connect.Response[v1.DeleteWorkoutResponse], error)
+	RestoreWorkout(context.Context, *connect.Request[v1.RestoreWorkoutRequest]) (*connect.Response[v1.RestoreWorkoutResponse], error)
 	PostComment(context.Context, *connect.Request[v1.PostCommentRequest]) (*connect.Response[v1.PostCommentResponse], error)
+	UpdateComment(context.Context, *connect.Request[v1.UpdateCommentRequest]) (*connect.Response[v1.UpdateCommentResponse], error)
+	DeleteComment(context.Context, *connect.Request[v1.DeleteCommentRequest]) (*connect.Response[v1.DeleteCommentResponse], error)
 	UpdateWorkout(context.Context, *connect.Request[v1.UpdateWorkoutRequest]) (*connect.Response[v1.UpdateWorkoutResponse], error)
+	CompareWorkouts(context.Context, *connect.Request[v1.CompareWorkoutsRequest]) (*connect.Response[v1.CompareWorkoutsResponse], error)
+	ImportWorkouts(context.Context, *connect.Request[v1.ImportWorkoutsRequest]) (*connect.Response[v1.ImportWorkoutsResponse], error)
+	ReactToWorkout(context.Context, *connect.Request[v1.ReactToWorkoutRequest]) (*connect.Response[v1.ReactToWorkoutResponse], error)
+	RemoveReaction(context.Context, *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error)
+	ListReactions(context.Context, *connect.Request[v1.ListReactionsRequest]) (*connect.Response[v1.ListReactionsResponse], error)
 }
 
 // NewWorkoutServiceClient constructs a client for the api.v1.WorkoutService service. By default, it
@@ -99,29 +131,85 @@ func NewWorkoutServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(workoutServiceMethods.ByName("DeleteWorkout")),
 			connect.WithClientOptions(opts...),
 		),
+		restoreWorkout: connect.NewClient[v1.RestoreWorkoutRequest, v1.RestoreWorkoutResponse](
+			httpClient,
+			baseURL+WorkoutServiceRestoreWorkoutProcedure,
+			connect.WithSchema(workoutServiceMethods.ByName("RestoreWorkout")),
+			connect.WithClientOptions(opts...),
+		),
 		postComment: connect.NewClient[v1.PostCommentRequest, v1.PostCommentResponse](
 			httpClient,
 			baseURL+WorkoutServicePostCommentProcedure,
 			connect.WithSchema(workoutServiceMethods.ByName("PostComment")),
 			connect.WithClientOptions(opts...),
 		),
+		updateComment: connect.NewClient[v1.UpdateCommentRequest, v1.UpdateCommentResponse](
+			httpClient,
+			baseURL+WorkoutServiceUpdateCommentProcedure,
+			connect.WithSchema(workoutServiceMethods.ByName("UpdateComment")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteComment: connect.NewClient[v1.DeleteCommentRequest, v1.DeleteCommentResponse](
+			httpClient,
+			baseURL+WorkoutServiceDeleteCommentProcedure,
+			connect.WithSchema(workoutServiceMethods.ByName("DeleteComment")),
+			connect.WithClientOptions(opts...),
+		),
 		updateWorkout: connect.NewClient[v1.UpdateWorkoutRequest, v1.UpdateWorkoutResponse](
 			httpClient,
 			baseURL+WorkoutServiceUpdateWorkoutProcedure,
 			connect.WithSchema(workoutServiceMethods.ByName("UpdateWorkout")),
 			connect.WithClientOptions(opts...),
 		),
+		compareWorkouts: connect.NewClient[v1.CompareWorkoutsRequest, v1.CompareWorkoutsResponse](
+			httpClient,
+			baseURL+WorkoutServiceCompareWorkoutsProcedure,
+			connect.WithSchema(workoutServiceMethods.ByName("CompareWorkouts")),
+			connect.WithClientOptions(opts...),
+		),
+		importWorkouts: connect.NewClient[v1.ImportWorkoutsRequest, v1.ImportWorkoutsResponse](
+			httpClient,
+			baseURL+WorkoutServiceImportWorkoutsProcedure,
+			connect.WithSchema(workoutServiceMethods.ByName("ImportWorkouts")),
+			connect.WithClientOptions(opts...),
+		),
+		reactToWorkout: connect.NewClient[v1.ReactToWorkoutRequest, v1.ReactToWorkoutResponse](
+			httpClient,
+			baseURL+WorkoutServiceReactToWorkoutProcedure,
+			connect.WithSchema(workoutServiceMethods.ByName("ReactToWorkout")),
+			connect.WithClientOptions(opts...),
+		),
+		removeReaction: connect.NewClient[v1.RemoveReactionRequest, v1.RemoveReactionResponse](
+			httpClient,
+			baseURL+WorkoutServiceRemoveReactionProcedure,
+			connect.WithSchema(workoutServiceMethods.ByName("RemoveReaction")),
+			connect.WithClientOptions(opts...),
+		),
+		listReactions: connect.NewClient[v1.ListReactionsRequest, v1.ListReactionsResponse](
+			httpClient,
+			baseURL+WorkoutServiceListReactionsProcedure,
+			connect.WithSchema(workoutServiceMethods.ByName("ListReactions")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // workoutServiceClient implements WorkoutServiceClient.
 type workoutServiceClient struct {
-	createWorkout *connect.Client[v1.CreateWorkoutRequest, v1.CreateWorkoutResponse]
-	getWorkout    *connect.Client[v1.GetWorkoutRequest, v1.GetWorkoutResponse]
-	listWorkouts  *connect.Client[v1.ListWorkoutsRequest, v1.ListWorkoutsResponse]
-	deleteWorkout *connect.Client[v1.DeleteWorkoutRequest, v1.DeleteWorkoutResponse]
-	postComment   *connect.Client[v1.PostCommentRequest, v1.PostCommentResponse]
-	updateWorkout *connect.Client[v1.UpdateWorkoutRequest, v1.UpdateWorkoutResponse]
+	createWorkout   *connect.Client[v1.CreateWorkoutRequest, v1.CreateWorkoutResponse]
+	getWorkout      *connect.Client[v1.GetWorkoutRequest, v1.GetWorkoutResponse]
+	listWorkouts    *connect.Client[v1.ListWorkoutsRequest, v1.ListWorkoutsResponse]
+	deleteWorkout   *connect.Client[v1.DeleteWorkoutRequest, v1.DeleteWorkoutResponse]
+	restoreWorkout  *connect.Client[v1.RestoreWorkoutRequest, v1.RestoreWorkoutResponse]
+	postComment     *connect.Client[v1.PostCommentRequest, v1.PostCommentResponse]
+	updateComment   *connect.Client[v1.UpdateCommentRequest, v1.UpdateCommentResponse]
+	deleteComment   *connect.Client[v1.DeleteCommentRequest, v1.DeleteCommentResponse]
+	updateWorkout   *connect.Client[v1.UpdateWorkoutRequest, v1.UpdateWorkoutResponse]
+	compareWorkouts *connect.Client[v1.CompareWorkoutsRequest, v1.CompareWorkoutsResponse]
+	importWorkouts  *connect.Client[v1.ImportWorkoutsRequest, v1.ImportWorkoutsResponse]
+	reactToWorkout  *connect.Client[v1.ReactToWorkoutRequest, v1.ReactToWorkoutResponse]
+	removeReaction  *connect.Client[v1.RemoveReactionRequest, v1.RemoveReactionResponse]
+	listReactions   *connect.Client[v1.ListReactionsRequest, v1.ListReactionsResponse]
 }
 
 // CreateWorkout calls api.v1.WorkoutService.CreateWorkout.
@@ -144,24 +232,72 @@ func (c *workoutServiceClient) DeleteWorkout(ctx context.Context, req *connect.R
 	return c.deleteWorkout.CallUnary(ctx, req)
 }
 
+// RestoreWorkout calls api.v1.WorkoutService.RestoreWorkout.
+func (c *workoutServiceClient) RestoreWorkout(ctx context.Context, req *connect.Request[v1.RestoreWorkoutRequest]) (*connect.Response[v1.RestoreWorkoutResponse], error) {
+	return c.restoreWorkout.CallUnary(ctx, req)
+}
+
 // PostComment calls api.v1.WorkoutService.PostComment.
 func (c *workoutServiceClient) PostComment(ctx context.Context, req *connect.Request[v1.PostCommentRequest]) (*connect.Response[v1.PostCommentResponse], error) {
 	return c.postComment.CallUnary(ctx, req)
 }
 
+// UpdateComment calls api.v1.WorkoutService.UpdateComment.
+func (c *workoutServiceClient) UpdateComment(ctx context.Context, req *connect.Request[v1.UpdateCommentRequest]) (*connect.Response[v1.UpdateCommentResponse], error) {
+	return c.updateComment.CallUnary(ctx, req)
+}
+
+// DeleteComment calls api.v1.WorkoutService.DeleteComment.
+func (c *workoutServiceClient) DeleteComment(ctx context.Context, req *connect.Request[v1.DeleteCommentRequest]) (*connect.Response[v1.DeleteCommentResponse], error) {
+	return c.deleteComment.CallUnary(ctx, req)
+}
+
 // UpdateWorkout calls api.v1.WorkoutService.UpdateWorkout.
 func (c *workoutServiceClient) UpdateWorkout(ctx context.Context, req *connect.Request[v1.UpdateWorkoutRequest]) (*connect.Response[v1.UpdateWorkoutResponse], error) {
 	return c.updateWorkout.CallUnary(ctx, req)
 }
 
+// CompareWorkouts calls api.v1.WorkoutService.CompareWorkouts.
+func (c *workoutServiceClient) CompareWorkouts(ctx context.Context, req *connect.Request[v1.CompareWorkoutsRequest]) (*connect.Response[v1.CompareWorkoutsResponse], error) {
+	return c.compareWorkouts.CallUnary(ctx, req)
+}
+
+// ImportWorkouts calls api.v1.WorkoutService.ImportWorkouts.
+func (c *workoutServiceClient) ImportWorkouts(ctx context.Context, req *connect.Request[v1.ImportWorkoutsRequest]) (*connect.Response[v1.ImportWorkoutsResponse], error) {
+	return c.importWorkouts.CallUnary(ctx, req)
+}
+
+// ReactToWorkout calls api.v1.WorkoutService.ReactToWorkout.
+func (c *workoutServiceClient) ReactToWorkout(ctx context.Context, req *connect.Request[v1.ReactToWorkoutRequest]) (*connect.Response[v1.ReactToWorkoutResponse], error) {
+	return c.reactToWorkout.CallUnary(ctx, req)
+}
+
+// RemoveReaction calls api.v1.WorkoutService.RemoveReaction.
+func (c *workoutServiceClient) RemoveReaction(ctx context.Context, req *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error) {
+	return c.removeReaction.CallUnary(ctx, req)
+}
+
+// ListReactions calls api.v1.WorkoutService.ListReactions.
+func (c *workoutServiceClient) ListReactions(ctx context.Context, req *connect.Request[v1.ListReactionsRequest]) (*connect.Response[v1.ListReactionsResponse], error) {
+	return c.listReactions.CallUnary(ctx, req)
+}
+
 // WorkoutServiceHandler is an implementation of the api.v1.WorkoutService service.
 type WorkoutServiceHandler interface {
 	CreateWorkout(context.Context, *connect.Request[v1.CreateWorkoutRequest]) (*connect.Response[v1.CreateWorkoutResponse], error)
 	GetWorkout(context.Context, *connect.Request[v1.GetWorkoutRequest]) (*connect.Response[v1.GetWorkoutResponse], error)
 	ListWorkouts(context.Context, *connect.Request[v1.ListWorkoutsRequest]) (*connect.Response[v1.ListWorkoutsResponse], error)
 	DeleteWorkout(context.Context, *connect.Request[v1.DeleteWorkoutRequest]) (*connect.Response[v1.DeleteWorkoutResponse], error)
+	RestoreWorkout(context.Context, *connect.Request[v1.RestoreWorkoutRequest]) (*connect.Response[v1.RestoreWorkoutResponse], error)
 	PostComment(context.Context, *connect.Request[v1.PostCommentRequest]) (*connect.Response[v1.PostCommentResponse], error)
+	UpdateComment(context.Context, *connect.Request[v1.UpdateCommentRequest]) (*connect.Response[v1.UpdateCommentResponse], error)
+	DeleteComment(context.Context, *connect.Request[v1.DeleteCommentRequest]) (*connect.Response[v1.DeleteCommentResponse], error)
 	UpdateWorkout(context.Context, *connect.Request[v1.UpdateWorkoutRequest]) (*connect.Response[v1.UpdateWorkoutResponse], error)
+	CompareWorkouts(context.Context, *connect.Request[v1.CompareWorkoutsRequest]) (*connect.Response[v1.CompareWorkoutsResponse], error)
+	ImportWorkouts(context.Context, *connect.Request[v1.ImportWorkoutsRequest]) (*connect.Response[v1.ImportWorkoutsResponse], error)
+	ReactToWorkout(context.Context, *connect.Request[v1.ReactToWorkoutRequest]) (*connect.Response[v1.ReactToWorkoutResponse], error)
+	RemoveReaction(context.Context, *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error)
+	ListReactions(context.Context, *connect.Request[v1.ListReactionsRequest]) (*connect.Response[v1.ListReactionsResponse], error)
 }
 
 // NewWorkoutServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -195,18 +331,66 @@ func NewWorkoutServiceHandler(svc WorkoutServiceHandler, opts ...connect.Handler
 		connect.WithSchema(workoutServiceMethods.ByName("DeleteWorkout")),
 		connect.WithHandlerOptions(opts...),
 	)
+	workoutServiceRestoreWorkoutHandler := connect.NewUnaryHandler(
+		WorkoutServiceRestoreWorkoutProcedure,
+		svc.RestoreWorkout,
+		connect.WithSchema(workoutServiceMethods.ByName("RestoreWorkout")),
+		connect.WithHandlerOptions(opts...),
+	)
 	workoutServicePostCommentHandler := connect.NewUnaryHandler(
 		WorkoutServicePostCommentProcedure,
 		svc.PostComment,
 		connect.WithSchema(workoutServiceMethods.ByName("PostComment")),
 		connect.WithHandlerOptions(opts...),
 	)
+	workoutServiceUpdateCommentHandler := connect.NewUnaryHandler(
+		WorkoutServiceUpdateCommentProcedure,
+		svc.UpdateComment,
+		connect.WithSchema(workoutServiceMethods.ByName("UpdateComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workoutServiceDeleteCommentHandler := connect.NewUnaryHandler(
+		WorkoutServiceDeleteCommentProcedure,
+		svc.DeleteComment,
+		connect.WithSchema(workoutServiceMethods.ByName("DeleteComment")),
+		connect.WithHandlerOptions(opts...),
+	)
 	workoutServiceUpdateWorkoutHandler := connect.NewUnaryHandler(
 		WorkoutServiceUpdateWorkoutProcedure,
 		svc.UpdateWorkout,
 		connect.WithSchema(workoutServiceMethods.ByName("UpdateWorkout")),
 		connect.WithHandlerOptions(opts...),
 	)
+	workoutServiceCompareWorkoutsHandler := connect.NewUnaryHandler(
+		WorkoutServiceCompareWorkoutsProcedure,
+		svc.CompareWorkouts,
+		connect.WithSchema(workoutServiceMethods.ByName("CompareWorkouts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workoutServiceImportWorkoutsHandler := connect.NewUnaryHandler(
+		WorkoutServiceImportWorkoutsProcedure,
+		svc.ImportWorkouts,
+		connect.WithSchema(workoutServiceMethods.ByName("ImportWorkouts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workoutServiceReactToWorkoutHandler := connect.NewUnaryHandler(
+		WorkoutServiceReactToWorkoutProcedure,
+		svc.ReactToWorkout,
+		connect.WithSchema(workoutServiceMethods.ByName("ReactToWorkout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workoutServiceRemoveReactionHandler := connect.NewUnaryHandler(
+		WorkoutServiceRemoveReactionProcedure,
+		svc.RemoveReaction,
+		connect.WithSchema(workoutServiceMethods.ByName("RemoveReaction")),
+		connect.WithHandlerOptions(opts...),
+	)
+	workoutServiceListReactionsHandler := connect.NewUnaryHandler(
+		WorkoutServiceListReactionsProcedure,
+		svc.ListReactions,
+		connect.WithSchema(workoutServiceMethods.ByName("ListReactions")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.v1.WorkoutService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case WorkoutServiceCreateWorkoutProcedure:
@@ -217,10 +401,26 @@ func NewWorkoutServiceHandler(svc WorkoutServiceHandler, opts ...connect.Handler
 			workoutServiceListWorkoutsHandler.ServeHTTP(w, r)
 		case WorkoutServiceDeleteWorkoutProcedure:
 			workoutServiceDeleteWorkoutHandler.ServeHTTP(w, r)
+		case WorkoutServiceRestoreWorkoutProcedure:
+			workoutServiceRestoreWorkoutHandler.ServeHTTP(w, r)
 		case WorkoutServicePostCommentProcedure:
 			workoutServicePostCommentHandler.ServeHTTP(w, r)
+		case WorkoutServiceUpdateCommentProcedure:
+			workoutServiceUpdateCommentHandler.ServeHTTP(w, r)
+		case WorkoutServiceDeleteCommentProcedure:
+			workoutServiceDeleteCommentHandler.ServeHTTP(w, r)
 		case WorkoutServiceUpdateWorkoutProcedure:
 			workoutServiceUpdateWorkoutHandler.ServeHTTP(w, r)
+		case WorkoutServiceCompareWorkoutsProcedure:
+			workoutServiceCompareWorkoutsHandler.ServeHTTP(w, r)
+		case WorkoutServiceImportWorkoutsProcedure:
+			workoutServiceImportWorkoutsHandler.ServeHTTP(w, r)
+		case WorkoutServiceReactToWorkoutProcedure:
+			workoutServiceReactToWorkoutHandler.ServeHTTP(w, r)
+		case WorkoutServiceRemoveReactionProcedure:
+			workoutServiceRemoveReactionHandler.ServeHTTP(w, r)
+		case WorkoutServiceListReactionsProcedure:
+			workoutServiceListReactionsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -246,10 +446,42 @@ func (UnimplementedWorkoutServiceHandler) DeleteWorkout(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.WorkoutService.DeleteWorkout is not implemented"))
 }
 
+func (UnimplementedWorkoutServiceHandler) RestoreWorkout(context.Context, *connect.Request[v1.RestoreWorkoutRequest]) (*connect.Response[v1.RestoreWorkoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.WorkoutService.RestoreWorkout is not implemented"))
+}
+
 func (UnimplementedWorkoutServiceHandler) PostComment(context.Context, *connect.Request[v1.PostCommentRequest]) (*connect.Response[v1.PostCommentResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.WorkoutService.PostComment is not implemented"))
 }
 
+func (UnimplementedWorkoutServiceHandler) UpdateComment(context.Context, *connect.Request[v1.UpdateCommentRequest]) (*connect.Response[v1.UpdateCommentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.WorkoutService.UpdateComment is not implemented"))
+}
+
+func (UnimplementedWorkoutServiceHandler) DeleteComment(context.Context, *connect.Request[v1.DeleteCommentRequest]) (*connect.Response[v1.DeleteCommentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.WorkoutService.DeleteComment is not implemented"))
+}
+
 func (UnimplementedWorkoutServiceHandler) UpdateWorkout(context.Context, *connect.Request[v1.UpdateWorkoutRequest]) (*connect.Response[v1.UpdateWorkoutResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.WorkoutService.UpdateWorkout is not implemented"))
 }
+
+func (UnimplementedWorkoutServiceHandler) CompareWorkouts(context.Context, *connect.Request[v1.CompareWorkoutsRequest]) (*connect.Response[v1.CompareWorkoutsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.WorkoutService.CompareWorkouts is not implemented"))
+}
+
+func (UnimplementedWorkoutServiceHandler) ImportWorkouts(context.Context, *connect.Request[v1.ImportWorkoutsRequest]) (*connect.Response[v1.ImportWorkoutsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.WorkoutService.ImportWorkouts is not implemented"))
+}
+
+func (UnimplementedWorkoutServiceHandler) ReactToWorkout(context.Context, *connect.Request[v1.ReactToWorkoutRequest]) (*connect.Response[v1.ReactToWorkoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.WorkoutService.ReactToWorkout is not implemented"))
+}
+
+func (UnimplementedWorkoutServiceHandler) RemoveReaction(context.Context, *connect.Request[v1.RemoveReactionRequest]) (*connect.Response[v1.RemoveReactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.WorkoutService.RemoveReaction is not implemented"))
+}
+
+func (UnimplementedWorkoutServiceHandler) ListReactions(context.Context, *connect.Request[v1.ListReactionsRequest]) (*connect.Response[v1.ListReactionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.WorkoutService.ListReactions is not implemented"))
+}
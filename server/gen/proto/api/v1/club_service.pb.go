@@ -0,0 +1,1593 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: api/v1/club_service.proto
+
+package apiv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ClubRole int32
+
+const (
+	ClubRole_CLUB_ROLE_UNSPECIFIED ClubRole = 0
+	ClubRole_CLUB_ROLE_OWNER       ClubRole = 1
+	ClubRole_CLUB_ROLE_ADMIN       ClubRole = 2
+	ClubRole_CLUB_ROLE_MEMBER      ClubRole = 3
+)
+
+// Enum value maps for ClubRole.
+var (
+	ClubRole_name = map[int32]string{
+		0: "CLUB_ROLE_UNSPECIFIED",
+		1: "CLUB_ROLE_OWNER",
+		2: "CLUB_ROLE_ADMIN",
+		3: "CLUB_ROLE_MEMBER",
+	}
+	ClubRole_value = map[string]int32{
+		"CLUB_ROLE_UNSPECIFIED": 0,
+		"CLUB_ROLE_OWNER":       1,
+		"CLUB_ROLE_ADMIN":       2,
+		"CLUB_ROLE_MEMBER":      3,
+	}
+)
+
+func (x ClubRole) Enum() *ClubRole {
+	p := new(ClubRole)
+	*p = x
+	return p
+}
+
+func (x ClubRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClubRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_club_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ClubRole) Type() protoreflect.EnumType {
+	return &file_api_v1_club_service_proto_enumTypes[0]
+}
+
+func (x ClubRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClubRole.Descriptor instead.
+func (ClubRole) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{0}
+}
+
+type ClubMemberStatus int32
+
+const (
+	ClubMemberStatus_CLUB_MEMBER_STATUS_UNSPECIFIED ClubMemberStatus = 0
+	ClubMemberStatus_CLUB_MEMBER_STATUS_INVITED     ClubMemberStatus = 1
+	ClubMemberStatus_CLUB_MEMBER_STATUS_REQUESTED   ClubMemberStatus = 2
+	ClubMemberStatus_CLUB_MEMBER_STATUS_ACTIVE      ClubMemberStatus = 3
+)
+
+// Enum value maps for ClubMemberStatus.
+var (
+	ClubMemberStatus_name = map[int32]string{
+		0: "CLUB_MEMBER_STATUS_UNSPECIFIED",
+		1: "CLUB_MEMBER_STATUS_INVITED",
+		2: "CLUB_MEMBER_STATUS_REQUESTED",
+		3: "CLUB_MEMBER_STATUS_ACTIVE",
+	}
+	ClubMemberStatus_value = map[string]int32{
+		"CLUB_MEMBER_STATUS_UNSPECIFIED": 0,
+		"CLUB_MEMBER_STATUS_INVITED":     1,
+		"CLUB_MEMBER_STATUS_REQUESTED":   2,
+		"CLUB_MEMBER_STATUS_ACTIVE":      3,
+	}
+)
+
+func (x ClubMemberStatus) Enum() *ClubMemberStatus {
+	p := new(ClubMemberStatus)
+	*p = x
+	return p
+}
+
+func (x ClubMemberStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClubMemberStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_club_service_proto_enumTypes[1].Descriptor()
+}
+
+func (ClubMemberStatus) Type() protoreflect.EnumType {
+	return &file_api_v1_club_service_proto_enumTypes[1]
+}
+
+func (x ClubMemberStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClubMemberStatus.Descriptor instead.
+func (ClubMemberStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{1}
+}
+
+type CreateClubRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClubRequest) Reset() {
+	*x = CreateClubRequest{}
+	mi := &file_api_v1_club_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClubRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClubRequest) ProtoMessage() {}
+
+func (x *CreateClubRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClubRequest.ProtoReflect.Descriptor instead.
+func (*CreateClubRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateClubRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateClubRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateClubResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Club          *Club                  `protobuf:"bytes,1,opt,name=club,proto3" json:"club,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClubResponse) Reset() {
+	*x = CreateClubResponse{}
+	mi := &file_api_v1_club_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClubResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClubResponse) ProtoMessage() {}
+
+func (x *CreateClubResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClubResponse.ProtoReflect.Descriptor instead.
+func (*CreateClubResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateClubResponse) GetClub() *Club {
+	if x != nil {
+		return x.Club
+	}
+	return nil
+}
+
+type GetClubRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClubRequest) Reset() {
+	*x = GetClubRequest{}
+	mi := &file_api_v1_club_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClubRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClubRequest) ProtoMessage() {}
+
+func (x *GetClubRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClubRequest.ProtoReflect.Descriptor instead.
+func (*GetClubRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetClubRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetClubResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Club          *Club                  `protobuf:"bytes,1,opt,name=club,proto3" json:"club,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClubResponse) Reset() {
+	*x = GetClubResponse{}
+	mi := &file_api_v1_club_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClubResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClubResponse) ProtoMessage() {}
+
+func (x *GetClubResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClubResponse.ProtoReflect.Descriptor instead.
+func (*GetClubResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetClubResponse) GetClub() *Club {
+	if x != nil {
+		return x.Club
+	}
+	return nil
+}
+
+// ListClubs lists the clubs the user is a member of, or has a pending
+// invitation or request to join.
+type ListClubsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClubsRequest) Reset() {
+	*x = ListClubsRequest{}
+	mi := &file_api_v1_club_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClubsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClubsRequest) ProtoMessage() {}
+
+func (x *ListClubsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClubsRequest.ProtoReflect.Descriptor instead.
+func (*ListClubsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListClubsRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListClubsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clubs         []*Club                `protobuf:"bytes,1,rep,name=clubs,proto3" json:"clubs,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClubsResponse) Reset() {
+	*x = ListClubsResponse{}
+	mi := &file_api_v1_club_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClubsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClubsResponse) ProtoMessage() {}
+
+func (x *ListClubsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClubsResponse.ProtoReflect.Descriptor instead.
+func (*ListClubsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListClubsResponse) GetClubs() []*Club {
+	if x != nil {
+		return x.Clubs
+	}
+	return nil
+}
+
+func (x *ListClubsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// JoinClub accepts an invitation to the club, or requests to join it if the
+// user has not been invited.
+type JoinClubRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        string                 `protobuf:"bytes,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinClubRequest) Reset() {
+	*x = JoinClubRequest{}
+	mi := &file_api_v1_club_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinClubRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinClubRequest) ProtoMessage() {}
+
+func (x *JoinClubRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinClubRequest.ProtoReflect.Descriptor instead.
+func (*JoinClubRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *JoinClubRequest) GetClubId() string {
+	if x != nil {
+		return x.ClubId
+	}
+	return ""
+}
+
+type JoinClubResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        ClubMemberStatus       `protobuf:"varint,1,opt,name=status,proto3,enum=api.v1.ClubMemberStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinClubResponse) Reset() {
+	*x = JoinClubResponse{}
+	mi := &file_api_v1_club_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinClubResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinClubResponse) ProtoMessage() {}
+
+func (x *JoinClubResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinClubResponse.ProtoReflect.Descriptor instead.
+func (*JoinClubResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *JoinClubResponse) GetStatus() ClubMemberStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ClubMemberStatus_CLUB_MEMBER_STATUS_UNSPECIFIED
+}
+
+// LeaveClub leaves the club, or declines an invitation or withdraws a request
+// to join it. The owner must transfer ownership before leaving.
+type LeaveClubRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        string                 `protobuf:"bytes,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveClubRequest) Reset() {
+	*x = LeaveClubRequest{}
+	mi := &file_api_v1_club_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveClubRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveClubRequest) ProtoMessage() {}
+
+func (x *LeaveClubRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveClubRequest.ProtoReflect.Descriptor instead.
+func (*LeaveClubRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LeaveClubRequest) GetClubId() string {
+	if x != nil {
+		return x.ClubId
+	}
+	return ""
+}
+
+type LeaveClubResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveClubResponse) Reset() {
+	*x = LeaveClubResponse{}
+	mi := &file_api_v1_club_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveClubResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveClubResponse) ProtoMessage() {}
+
+func (x *LeaveClubResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveClubResponse.ProtoReflect.Descriptor instead.
+func (*LeaveClubResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{9}
+}
+
+// InviteClubMember invites the user to the club. Only owners and admins can
+// invite, and inviting a user who has requested to join approves them.
+type InviteClubMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        string                 `protobuf:"bytes,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteClubMemberRequest) Reset() {
+	*x = InviteClubMemberRequest{}
+	mi := &file_api_v1_club_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteClubMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteClubMemberRequest) ProtoMessage() {}
+
+func (x *InviteClubMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteClubMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteClubMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *InviteClubMemberRequest) GetClubId() string {
+	if x != nil {
+		return x.ClubId
+	}
+	return ""
+}
+
+func (x *InviteClubMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type InviteClubMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteClubMemberResponse) Reset() {
+	*x = InviteClubMemberResponse{}
+	mi := &file_api_v1_club_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteClubMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteClubMemberResponse) ProtoMessage() {}
+
+func (x *InviteClubMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteClubMemberResponse.ProtoReflect.Descriptor instead.
+func (*InviteClubMemberResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{11}
+}
+
+// ApproveClubMember approves a request to join the club. Only owners and
+// admins can approve.
+type ApproveClubMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        string                 `protobuf:"bytes,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveClubMemberRequest) Reset() {
+	*x = ApproveClubMemberRequest{}
+	mi := &file_api_v1_club_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveClubMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveClubMemberRequest) ProtoMessage() {}
+
+func (x *ApproveClubMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveClubMemberRequest.ProtoReflect.Descriptor instead.
+func (*ApproveClubMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ApproveClubMemberRequest) GetClubId() string {
+	if x != nil {
+		return x.ClubId
+	}
+	return ""
+}
+
+func (x *ApproveClubMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ApproveClubMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveClubMemberResponse) Reset() {
+	*x = ApproveClubMemberResponse{}
+	mi := &file_api_v1_club_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveClubMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveClubMemberResponse) ProtoMessage() {}
+
+func (x *ApproveClubMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveClubMemberResponse.ProtoReflect.Descriptor instead.
+func (*ApproveClubMemberResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{13}
+}
+
+// RemoveClubMember removes a member, or cancels an invitation or rejects a
+// request to join. Admins can only remove members, and the owner cannot be
+// removed.
+type RemoveClubMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        string                 `protobuf:"bytes,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveClubMemberRequest) Reset() {
+	*x = RemoveClubMemberRequest{}
+	mi := &file_api_v1_club_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveClubMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveClubMemberRequest) ProtoMessage() {}
+
+func (x *RemoveClubMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveClubMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveClubMemberRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveClubMemberRequest) GetClubId() string {
+	if x != nil {
+		return x.ClubId
+	}
+	return ""
+}
+
+func (x *RemoveClubMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveClubMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveClubMemberResponse) Reset() {
+	*x = RemoveClubMemberResponse{}
+	mi := &file_api_v1_club_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveClubMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveClubMemberResponse) ProtoMessage() {}
+
+func (x *RemoveClubMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveClubMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveClubMemberResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{15}
+}
+
+// SetClubMemberRole changes the role of an active member. Only the owner can
+// change roles, and making another member the owner demotes the current owner
+// to admin.
+type SetClubMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClubId        string                 `protobuf:"bytes,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ClubRole               `protobuf:"varint,3,opt,name=role,proto3,enum=api.v1.ClubRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetClubMemberRoleRequest) Reset() {
+	*x = SetClubMemberRoleRequest{}
+	mi := &file_api_v1_club_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetClubMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClubMemberRoleRequest) ProtoMessage() {}
+
+func (x *SetClubMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClubMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetClubMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetClubMemberRoleRequest) GetClubId() string {
+	if x != nil {
+		return x.ClubId
+	}
+	return ""
+}
+
+func (x *SetClubMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetClubMemberRoleRequest) GetRole() ClubRole {
+	if x != nil {
+		return x.Role
+	}
+	return ClubRole_CLUB_ROLE_UNSPECIFIED
+}
+
+type SetClubMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetClubMemberRoleResponse) Reset() {
+	*x = SetClubMemberRoleResponse{}
+	mi := &file_api_v1_club_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetClubMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClubMemberRoleResponse) ProtoMessage() {}
+
+func (x *SetClubMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClubMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*SetClubMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{17}
+}
+
+// ListClubMembers lists the members of the club with the status. Only members
+// can list active members, and only owners and admins can list pending ones.
+type ListClubMembersRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ClubId string                 `protobuf:"bytes,1,opt,name=club_id,json=clubId,proto3" json:"club_id,omitempty"`
+	// Lists active members if unspecified.
+	Status        ClubMemberStatus   `protobuf:"varint,2,opt,name=status,proto3,enum=api.v1.ClubMemberStatus" json:"status,omitempty"`
+	Pagination    *PaginationRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClubMembersRequest) Reset() {
+	*x = ListClubMembersRequest{}
+	mi := &file_api_v1_club_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClubMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClubMembersRequest) ProtoMessage() {}
+
+func (x *ListClubMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClubMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListClubMembersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListClubMembersRequest) GetClubId() string {
+	if x != nil {
+		return x.ClubId
+	}
+	return ""
+}
+
+func (x *ListClubMembersRequest) GetStatus() ClubMemberStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ClubMemberStatus_CLUB_MEMBER_STATUS_UNSPECIFIED
+}
+
+func (x *ListClubMembersRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListClubMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*ClubMember          `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClubMembersResponse) Reset() {
+	*x = ListClubMembersResponse{}
+	mi := &file_api_v1_club_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClubMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClubMembersResponse) ProtoMessage() {}
+
+func (x *ListClubMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClubMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListClubMembersResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListClubMembersResponse) GetMembers() []*ClubMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListClubMembersResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type Club struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The membership of the user, unset if the user is not a member.
+	Membership    *ClubMembership `protobuf:"bytes,5,opt,name=membership,proto3" json:"membership,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Club) Reset() {
+	*x = Club{}
+	mi := &file_api_v1_club_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Club) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Club) ProtoMessage() {}
+
+func (x *Club) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Club.ProtoReflect.Descriptor instead.
+func (*Club) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Club) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Club) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Club) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Club) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Club) GetMembership() *ClubMembership {
+	if x != nil {
+		return x.Membership
+	}
+	return nil
+}
+
+type ClubMembership struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          ClubRole               `protobuf:"varint,1,opt,name=role,proto3,enum=api.v1.ClubRole" json:"role,omitempty"`
+	Status        ClubMemberStatus       `protobuf:"varint,2,opt,name=status,proto3,enum=api.v1.ClubMemberStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClubMembership) Reset() {
+	*x = ClubMembership{}
+	mi := &file_api_v1_club_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClubMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClubMembership) ProtoMessage() {}
+
+func (x *ClubMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClubMembership.ProtoReflect.Descriptor instead.
+func (*ClubMembership) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ClubMembership) GetRole() ClubRole {
+	if x != nil {
+		return x.Role
+	}
+	return ClubRole_CLUB_ROLE_UNSPECIFIED
+}
+
+func (x *ClubMembership) GetStatus() ClubMemberStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ClubMemberStatus_CLUB_MEMBER_STATUS_UNSPECIFIED
+}
+
+type ClubMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Role          ClubRole               `protobuf:"varint,2,opt,name=role,proto3,enum=api.v1.ClubRole" json:"role,omitempty"`
+	Status        ClubMemberStatus       `protobuf:"varint,3,opt,name=status,proto3,enum=api.v1.ClubMemberStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClubMember) Reset() {
+	*x = ClubMember{}
+	mi := &file_api_v1_club_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClubMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClubMember) ProtoMessage() {}
+
+func (x *ClubMember) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_club_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClubMember.ProtoReflect.Descriptor instead.
+func (*ClubMember) Descriptor() ([]byte, []int) {
+	return file_api_v1_club_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ClubMember) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ClubMember) GetRole() ClubRole {
+	if x != nil {
+		return x.Role
+	}
+	return ClubRole_CLUB_ROLE_UNSPECIFIED
+}
+
+func (x *ClubMember) GetStatus() ClubMemberStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ClubMemberStatus_CLUB_MEMBER_STATUS_UNSPECIFIED
+}
+
+var File_api_v1_club_service_proto protoreflect.FileDescriptor
+
+var file_api_v1_club_service_proto_rawDesc = string([]byte{
+	0x0a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6c, 0x75, 0x62, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x1a, 0x14, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5f, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
+	0x0a, 0x04, 0x63, 0x6c, 0x75, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x04, 0x63, 0x6c, 0x75, 0x62,
+	0x22, 0x2a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x20, 0x0a, 0x04, 0x63, 0x6c, 0x75, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x04, 0x63, 0x6c, 0x75,
+	0x62, 0x22, 0x55, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6c, 0x75, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
+	0x05, 0x63, 0x6c, 0x75, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x05, 0x63, 0x6c, 0x75, 0x62,
+	0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x34, 0x0a,
+	0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x63, 0x6c, 0x75,
+	0x62, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x10, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x75, 0x62, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x35, 0x0a, 0x10, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x07, 0x63, 0x6c, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x63, 0x6c, 0x75, 0x62, 0x49, 0x64,
+	0x22, 0x13, 0x0a, 0x11, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f, 0x0a, 0x17, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x63, 0x6c, 0x75,
+	0x62, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x60, 0x0a, 0x18, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x75,
+	0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x07, 0x63, 0x6c, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x63, 0x6c, 0x75, 0x62, 0x49,
+	0x64, 0x12, 0x21, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x43,
+	0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x5f, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x62, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07,
+	0x63, 0x6c, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba,
+	0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x63, 0x6c, 0x75, 0x62, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x62,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92,
+	0x01, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x63,
+	0x6c, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x63, 0x6c, 0x75, 0x62, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x30, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x6f, 0x6c,
+	0x65, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xba, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x63,
+	0x6c, 0x75, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x63, 0x6c, 0x75, 0x62, 0x49, 0x64, 0x12, 0x3a,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xbf, 0x01, 0x0a, 0x04, 0x43, 0x6c, 0x75, 0x62, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a,
+	0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x62, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x22, 0x68, 0x0a, 0x0e, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6c, 0x75, 0x62, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x30, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x86, 0x01, 0x0a, 0x0a, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x24, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x65, 0x0a, 0x08, 0x43, 0x6c, 0x75, 0x62,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4c, 0x55, 0x42, 0x5f, 0x52, 0x4f, 0x4c,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x13, 0x0a, 0x0f, 0x43, 0x4c, 0x55, 0x42, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4f, 0x57, 0x4e,
+	0x45, 0x52, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4c, 0x55, 0x42, 0x5f, 0x52, 0x4f, 0x4c,
+	0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4c, 0x55,
+	0x42, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x03, 0x2a,
+	0x97, 0x01, 0x0a, 0x10, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x1e, 0x43, 0x4c, 0x55, 0x42, 0x5f, 0x4d, 0x45, 0x4d,
+	0x42, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4c, 0x55, 0x42,
+	0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49,
+	0x4e, 0x56, 0x49, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4c, 0x55, 0x42,
+	0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x4c,
+	0x55, 0x42, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x03, 0x32, 0xc3, 0x06, 0x0a, 0x0b, 0x43, 0x6c,
+	0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x62, 0x12, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04,
+	0x88, 0xb5, 0x18, 0x01, 0x12, 0x40, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x12,
+	0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x62,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x46, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c,
+	0x75, 0x62, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6c, 0x75, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x43,
+	0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x75, 0x62, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x6f, 0x69,
+	0x6e, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x88,
+	0xb5, 0x18, 0x01, 0x12, 0x46, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x62,
+	0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43,
+	0x6c, 0x75, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x62, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x5b, 0x0a, 0x10, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x5e, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x43, 0x6c,
+	0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x5b, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x62,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x75,
+	0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x5e, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x62,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x58, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75,
+	0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x62, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x42,
+	0x94, 0x01, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x10,
+	0x43, 0x6c, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x72, 0x6c, 0x73, 0x73, 0x6e, 0x2f, 0x67, 0x65, 0x74, 0x73, 0x74, 0x72, 0x6f, 0x6e, 0x67, 0x65,
+	0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x41, 0x58, 0x58, 0xaa, 0x02, 0x06, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x06, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x12, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x41,
+	0x70, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_api_v1_club_service_proto_rawDescOnce sync.Once
+	file_api_v1_club_service_proto_rawDescData []byte
+)
+
+func file_api_v1_club_service_proto_rawDescGZIP() []byte {
+	file_api_v1_club_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_club_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_club_service_proto_rawDesc), len(file_api_v1_club_service_proto_rawDesc)))
+	})
+	return file_api_v1_club_service_proto_rawDescData
+}
+
+var file_api_v1_club_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_v1_club_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_api_v1_club_service_proto_goTypes = []any{
+	(ClubRole)(0),                     // 0: api.v1.ClubRole
+	(ClubMemberStatus)(0),             // 1: api.v1.ClubMemberStatus
+	(*CreateClubRequest)(nil),         // 2: api.v1.CreateClubRequest
+	(*CreateClubResponse)(nil),        // 3: api.v1.CreateClubResponse
+	(*GetClubRequest)(nil),            // 4: api.v1.GetClubRequest
+	(*GetClubResponse)(nil),           // 5: api.v1.GetClubResponse
+	(*ListClubsRequest)(nil),          // 6: api.v1.ListClubsRequest
+	(*ListClubsResponse)(nil),         // 7: api.v1.ListClubsResponse
+	(*JoinClubRequest)(nil),           // 8: api.v1.JoinClubRequest
+	(*JoinClubResponse)(nil),          // 9: api.v1.JoinClubResponse
+	(*LeaveClubRequest)(nil),          // 10: api.v1.LeaveClubRequest
+	(*LeaveClubResponse)(nil),         // 11: api.v1.LeaveClubResponse
+	(*InviteClubMemberRequest)(nil),   // 12: api.v1.InviteClubMemberRequest
+	(*InviteClubMemberResponse)(nil),  // 13: api.v1.InviteClubMemberResponse
+	(*ApproveClubMemberRequest)(nil),  // 14: api.v1.ApproveClubMemberRequest
+	(*ApproveClubMemberResponse)(nil), // 15: api.v1.ApproveClubMemberResponse
+	(*RemoveClubMemberRequest)(nil),   // 16: api.v1.RemoveClubMemberRequest
+	(*RemoveClubMemberResponse)(nil),  // 17: api.v1.RemoveClubMemberResponse
+	(*SetClubMemberRoleRequest)(nil),  // 18: api.v1.SetClubMemberRoleRequest
+	(*SetClubMemberRoleResponse)(nil), // 19: api.v1.SetClubMemberRoleResponse
+	(*ListClubMembersRequest)(nil),    // 20: api.v1.ListClubMembersRequest
+	(*ListClubMembersResponse)(nil),   // 21: api.v1.ListClubMembersResponse
+	(*Club)(nil),                      // 22: api.v1.Club
+	(*ClubMembership)(nil),            // 23: api.v1.ClubMembership
+	(*ClubMember)(nil),                // 24: api.v1.ClubMember
+	(*PaginationRequest)(nil),         // 25: api.v1.PaginationRequest
+	(*PaginationResponse)(nil),        // 26: api.v1.PaginationResponse
+	(*timestamppb.Timestamp)(nil),     // 27: google.protobuf.Timestamp
+	(*User)(nil),                      // 28: api.v1.User
+}
+var file_api_v1_club_service_proto_depIdxs = []int32{
+	22, // 0: api.v1.CreateClubResponse.club:type_name -> api.v1.Club
+	22, // 1: api.v1.GetClubResponse.club:type_name -> api.v1.Club
+	25, // 2: api.v1.ListClubsRequest.pagination:type_name -> api.v1.PaginationRequest
+	22, // 3: api.v1.ListClubsResponse.clubs:type_name -> api.v1.Club
+	26, // 4: api.v1.ListClubsResponse.pagination:type_name -> api.v1.PaginationResponse
+	1,  // 5: api.v1.JoinClubResponse.status:type_name -> api.v1.ClubMemberStatus
+	0,  // 6: api.v1.SetClubMemberRoleRequest.role:type_name -> api.v1.ClubRole
+	1,  // 7: api.v1.ListClubMembersRequest.status:type_name -> api.v1.ClubMemberStatus
+	25, // 8: api.v1.ListClubMembersRequest.pagination:type_name -> api.v1.PaginationRequest
+	24, // 9: api.v1.ListClubMembersResponse.members:type_name -> api.v1.ClubMember
+	26, // 10: api.v1.ListClubMembersResponse.pagination:type_name -> api.v1.PaginationResponse
+	27, // 11: api.v1.Club.created_at:type_name -> google.protobuf.Timestamp
+	23, // 12: api.v1.Club.membership:type_name -> api.v1.ClubMembership
+	0,  // 13: api.v1.ClubMembership.role:type_name -> api.v1.ClubRole
+	1,  // 14: api.v1.ClubMembership.status:type_name -> api.v1.ClubMemberStatus
+	28, // 15: api.v1.ClubMember.user:type_name -> api.v1.User
+	0,  // 16: api.v1.ClubMember.role:type_name -> api.v1.ClubRole
+	1,  // 17: api.v1.ClubMember.status:type_name -> api.v1.ClubMemberStatus
+	2,  // 18: api.v1.ClubService.CreateClub:input_type -> api.v1.CreateClubRequest
+	4,  // 19: api.v1.ClubService.GetClub:input_type -> api.v1.GetClubRequest
+	6,  // 20: api.v1.ClubService.ListClubs:input_type -> api.v1.ListClubsRequest
+	8,  // 21: api.v1.ClubService.JoinClub:input_type -> api.v1.JoinClubRequest
+	10, // 22: api.v1.ClubService.LeaveClub:input_type -> api.v1.LeaveClubRequest
+	12, // 23: api.v1.ClubService.InviteClubMember:input_type -> api.v1.InviteClubMemberRequest
+	14, // 24: api.v1.ClubService.ApproveClubMember:input_type -> api.v1.ApproveClubMemberRequest
+	16, // 25: api.v1.ClubService.RemoveClubMember:input_type -> api.v1.RemoveClubMemberRequest
+	18, // 26: api.v1.ClubService.SetClubMemberRole:input_type -> api.v1.SetClubMemberRoleRequest
+	20, // 27: api.v1.ClubService.ListClubMembers:input_type -> api.v1.ListClubMembersRequest
+	3,  // 28: api.v1.ClubService.CreateClub:output_type -> api.v1.CreateClubResponse
+	5,  // 29: api.v1.ClubService.GetClub:output_type -> api.v1.GetClubResponse
+	7,  // 30: api.v1.ClubService.ListClubs:output_type -> api.v1.ListClubsResponse
+	9,  // 31: api.v1.ClubService.JoinClub:output_type -> api.v1.JoinClubResponse
+	11, // 32: api.v1.ClubService.LeaveClub:output_type -> api.v1.LeaveClubResponse
+	13, // 33: api.v1.ClubService.InviteClubMember:output_type -> api.v1.InviteClubMemberResponse
+	15, // 34: api.v1.ClubService.ApproveClubMember:output_type -> api.v1.ApproveClubMemberResponse
+	17, // 35: api.v1.ClubService.RemoveClubMember:output_type -> api.v1.RemoveClubMemberResponse
+	19, // 36: api.v1.ClubService.SetClubMemberRole:output_type -> api.v1.SetClubMemberRoleResponse
+	21, // 37: api.v1.ClubService.ListClubMembers:output_type -> api.v1.ListClubMembersResponse
+	28, // [28:38] is the sub-list for method output_type
+	18, // [18:28] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_club_service_proto_init() }
+func file_api_v1_club_service_proto_init() {
+	if File_api_v1_club_service_proto != nil {
+		return
+	}
+	file_api_v1_options_proto_init()
+	file_api_v1_shared_proto_init()
+	file_api_v1_user_service_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_club_service_proto_rawDesc), len(file_api_v1_club_service_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   23,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_club_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_club_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_club_service_proto_enumTypes,
+		MessageInfos:      file_api_v1_club_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_club_service_proto = out.File
+	file_api_v1_club_service_proto_goTypes = nil
+	file_api_v1_club_service_proto_depIdxs = nil
+}
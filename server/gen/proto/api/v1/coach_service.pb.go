@@ -0,0 +1,1387 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: api/v1/coach_service.proto
+
+package apiv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CoachActionType int32
+
+const (
+	CoachActionType_COACH_ACTION_TYPE_UNSPECIFIED         CoachActionType = 0
+	CoachActionType_COACH_ACTION_TYPE_VIEW_DASHBOARD      CoachActionType = 1
+	CoachActionType_COACH_ACTION_TYPE_VIEW_WORKOUTS       CoachActionType = 2
+	CoachActionType_COACH_ACTION_TYPE_VIEW_PERSONAL_BESTS CoachActionType = 3
+	CoachActionType_COACH_ACTION_TYPE_CREATE_ROUTINE      CoachActionType = 4
+	CoachActionType_COACH_ACTION_TYPE_UPDATE_ROUTINE      CoachActionType = 5
+)
+
+// Enum value maps for CoachActionType.
+var (
+	CoachActionType_name = map[int32]string{
+		0: "COACH_ACTION_TYPE_UNSPECIFIED",
+		1: "COACH_ACTION_TYPE_VIEW_DASHBOARD",
+		2: "COACH_ACTION_TYPE_VIEW_WORKOUTS",
+		3: "COACH_ACTION_TYPE_VIEW_PERSONAL_BESTS",
+		4: "COACH_ACTION_TYPE_CREATE_ROUTINE",
+		5: "COACH_ACTION_TYPE_UPDATE_ROUTINE",
+	}
+	CoachActionType_value = map[string]int32{
+		"COACH_ACTION_TYPE_UNSPECIFIED":         0,
+		"COACH_ACTION_TYPE_VIEW_DASHBOARD":      1,
+		"COACH_ACTION_TYPE_VIEW_WORKOUTS":       2,
+		"COACH_ACTION_TYPE_VIEW_PERSONAL_BESTS": 3,
+		"COACH_ACTION_TYPE_CREATE_ROUTINE":      4,
+		"COACH_ACTION_TYPE_UPDATE_ROUTINE":      5,
+	}
+)
+
+func (x CoachActionType) Enum() *CoachActionType {
+	p := new(CoachActionType)
+	*p = x
+	return p
+}
+
+func (x CoachActionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CoachActionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_coach_service_proto_enumTypes[0].Descriptor()
+}
+
+func (CoachActionType) Type() protoreflect.EnumType {
+	return &file_api_v1_coach_service_proto_enumTypes[0]
+}
+
+func (x CoachActionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CoachActionType.Descriptor instead.
+func (CoachActionType) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{0}
+}
+
+// GrantCoachAccess lets the coach view the user's workouts and personal bests
+// regardless of their visibility, and create or edit routines on their behalf.
+type GrantCoachAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CoachId       string                 `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantCoachAccessRequest) Reset() {
+	*x = GrantCoachAccessRequest{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantCoachAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantCoachAccessRequest) ProtoMessage() {}
+
+func (x *GrantCoachAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantCoachAccessRequest.ProtoReflect.Descriptor instead.
+func (*GrantCoachAccessRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GrantCoachAccessRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+type GrantCoachAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrantCoachAccessResponse) Reset() {
+	*x = GrantCoachAccessResponse{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrantCoachAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrantCoachAccessResponse) ProtoMessage() {}
+
+func (x *GrantCoachAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrantCoachAccessResponse.ProtoReflect.Descriptor instead.
+func (*GrantCoachAccessResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{1}
+}
+
+type RevokeCoachAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CoachId       string                 `protobuf:"bytes,1,opt,name=coach_id,json=coachId,proto3" json:"coach_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCoachAccessRequest) Reset() {
+	*x = RevokeCoachAccessRequest{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCoachAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCoachAccessRequest) ProtoMessage() {}
+
+func (x *RevokeCoachAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCoachAccessRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCoachAccessRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RevokeCoachAccessRequest) GetCoachId() string {
+	if x != nil {
+		return x.CoachId
+	}
+	return ""
+}
+
+type RevokeCoachAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeCoachAccessResponse) Reset() {
+	*x = RevokeCoachAccessResponse{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCoachAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCoachAccessResponse) ProtoMessage() {}
+
+func (x *RevokeCoachAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCoachAccessResponse.ProtoReflect.Descriptor instead.
+func (*RevokeCoachAccessResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{3}
+}
+
+// ListCoaches lists the coaches with access to the user's account.
+type ListCoachesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoachesRequest) Reset() {
+	*x = ListCoachesRequest{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoachesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoachesRequest) ProtoMessage() {}
+
+func (x *ListCoachesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoachesRequest.ProtoReflect.Descriptor instead.
+func (*ListCoachesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{4}
+}
+
+type ListCoachesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coaches       []*User                `protobuf:"bytes,1,rep,name=coaches,proto3" json:"coaches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoachesResponse) Reset() {
+	*x = ListCoachesResponse{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoachesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoachesResponse) ProtoMessage() {}
+
+func (x *ListCoachesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoachesResponse.ProtoReflect.Descriptor instead.
+func (*ListCoachesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCoachesResponse) GetCoaches() []*User {
+	if x != nil {
+		return x.Coaches
+	}
+	return nil
+}
+
+// ListCoachActions lists the audit trail of everything the user's coaches have
+// done in their account, newest first.
+type ListCoachActionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoachActionsRequest) Reset() {
+	*x = ListCoachActionsRequest{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoachActionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoachActionsRequest) ProtoMessage() {}
+
+func (x *ListCoachActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoachActionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCoachActionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListCoachActionsRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListCoachActionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actions       []*CoachAction         `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCoachActionsResponse) Reset() {
+	*x = ListCoachActionsResponse{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCoachActionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCoachActionsResponse) ProtoMessage() {}
+
+func (x *ListCoachActionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCoachActionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCoachActionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListCoachActionsResponse) GetActions() []*CoachAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *ListCoachActionsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// GetCoachDashboard lists the athletes that have granted the user access.
+type GetCoachDashboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoachDashboardRequest) Reset() {
+	*x = GetCoachDashboardRequest{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachDashboardRequest) ProtoMessage() {}
+
+func (x *GetCoachDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachDashboardRequest.ProtoReflect.Descriptor instead.
+func (*GetCoachDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{8}
+}
+
+type GetCoachDashboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Athletes      []*AthleteSummary      `protobuf:"bytes,1,rep,name=athletes,proto3" json:"athletes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCoachDashboardResponse) Reset() {
+	*x = GetCoachDashboardResponse{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoachDashboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoachDashboardResponse) ProtoMessage() {}
+
+func (x *GetCoachDashboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoachDashboardResponse.ProtoReflect.Descriptor instead.
+func (*GetCoachDashboardResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetCoachDashboardResponse) GetAthletes() []*AthleteSummary {
+	if x != nil {
+		return x.Athletes
+	}
+	return nil
+}
+
+// ListAthleteWorkouts lists the athlete's workouts regardless of their
+// visibility. Workouts hidden by moderation are excluded.
+type ListAthleteWorkoutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AthleteId     string                 `protobuf:"bytes,1,opt,name=athlete_id,json=athleteId,proto3" json:"athlete_id,omitempty"`
+	Pagination    *PaginationRequest     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAthleteWorkoutsRequest) Reset() {
+	*x = ListAthleteWorkoutsRequest{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAthleteWorkoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAthleteWorkoutsRequest) ProtoMessage() {}
+
+func (x *ListAthleteWorkoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAthleteWorkoutsRequest.ProtoReflect.Descriptor instead.
+func (*ListAthleteWorkoutsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAthleteWorkoutsRequest) GetAthleteId() string {
+	if x != nil {
+		return x.AthleteId
+	}
+	return ""
+}
+
+func (x *ListAthleteWorkoutsRequest) GetPagination() *PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListAthleteWorkoutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workouts      []*Workout             `protobuf:"bytes,1,rep,name=workouts,proto3" json:"workouts,omitempty"`
+	Pagination    *PaginationResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAthleteWorkoutsResponse) Reset() {
+	*x = ListAthleteWorkoutsResponse{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAthleteWorkoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAthleteWorkoutsResponse) ProtoMessage() {}
+
+func (x *ListAthleteWorkoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAthleteWorkoutsResponse.ProtoReflect.Descriptor instead.
+func (*ListAthleteWorkoutsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListAthleteWorkoutsResponse) GetWorkouts() []*Workout {
+	if x != nil {
+		return x.Workouts
+	}
+	return nil
+}
+
+func (x *ListAthleteWorkoutsResponse) GetPagination() *PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetAthletePersonalBestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AthleteId     string                 `protobuf:"bytes,1,opt,name=athlete_id,json=athleteId,proto3" json:"athlete_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAthletePersonalBestsRequest) Reset() {
+	*x = GetAthletePersonalBestsRequest{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAthletePersonalBestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAthletePersonalBestsRequest) ProtoMessage() {}
+
+func (x *GetAthletePersonalBestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAthletePersonalBestsRequest.ProtoReflect.Descriptor instead.
+func (*GetAthletePersonalBestsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAthletePersonalBestsRequest) GetAthleteId() string {
+	if x != nil {
+		return x.AthleteId
+	}
+	return ""
+}
+
+type GetAthletePersonalBestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PersonalBests []*ExerciseSet         `protobuf:"bytes,1,rep,name=personal_bests,json=personalBests,proto3" json:"personal_bests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAthletePersonalBestsResponse) Reset() {
+	*x = GetAthletePersonalBestsResponse{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAthletePersonalBestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAthletePersonalBestsResponse) ProtoMessage() {}
+
+func (x *GetAthletePersonalBestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAthletePersonalBestsResponse.ProtoReflect.Descriptor instead.
+func (*GetAthletePersonalBestsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetAthletePersonalBestsResponse) GetPersonalBests() []*ExerciseSet {
+	if x != nil {
+		return x.PersonalBests
+	}
+	return nil
+}
+
+// CreateAthleteRoutine creates a routine in the athlete's account. The
+// exercises must belong to the athlete.
+type CreateAthleteRoutineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AthleteId     string                 `protobuf:"bytes,1,opt,name=athlete_id,json=athleteId,proto3" json:"athlete_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ExerciseIds   []string               `protobuf:"bytes,3,rep,name=exercise_ids,json=exerciseIds,proto3" json:"exercise_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAthleteRoutineRequest) Reset() {
+	*x = CreateAthleteRoutineRequest{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAthleteRoutineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAthleteRoutineRequest) ProtoMessage() {}
+
+func (x *CreateAthleteRoutineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAthleteRoutineRequest.ProtoReflect.Descriptor instead.
+func (*CreateAthleteRoutineRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateAthleteRoutineRequest) GetAthleteId() string {
+	if x != nil {
+		return x.AthleteId
+	}
+	return ""
+}
+
+func (x *CreateAthleteRoutineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAthleteRoutineRequest) GetExerciseIds() []string {
+	if x != nil {
+		return x.ExerciseIds
+	}
+	return nil
+}
+
+type CreateAthleteRoutineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAthleteRoutineResponse) Reset() {
+	*x = CreateAthleteRoutineResponse{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAthleteRoutineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAthleteRoutineResponse) ProtoMessage() {}
+
+func (x *CreateAthleteRoutineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAthleteRoutineResponse.ProtoReflect.Descriptor instead.
+func (*CreateAthleteRoutineResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateAthleteRoutineResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateAthleteRoutineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AthleteId     string                 `protobuf:"bytes,1,opt,name=athlete_id,json=athleteId,proto3" json:"athlete_id,omitempty"`
+	Routine       *Routine               `protobuf:"bytes,2,opt,name=routine,proto3" json:"routine,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAthleteRoutineRequest) Reset() {
+	*x = UpdateAthleteRoutineRequest{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAthleteRoutineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAthleteRoutineRequest) ProtoMessage() {}
+
+func (x *UpdateAthleteRoutineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAthleteRoutineRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAthleteRoutineRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateAthleteRoutineRequest) GetAthleteId() string {
+	if x != nil {
+		return x.AthleteId
+	}
+	return ""
+}
+
+func (x *UpdateAthleteRoutineRequest) GetRoutine() *Routine {
+	if x != nil {
+		return x.Routine
+	}
+	return nil
+}
+
+type UpdateAthleteRoutineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Routine       *Routine               `protobuf:"bytes,1,opt,name=routine,proto3" json:"routine,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAthleteRoutineResponse) Reset() {
+	*x = UpdateAthleteRoutineResponse{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAthleteRoutineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAthleteRoutineResponse) ProtoMessage() {}
+
+func (x *UpdateAthleteRoutineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAthleteRoutineResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAthleteRoutineResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateAthleteRoutineResponse) GetRoutine() *Routine {
+	if x != nil {
+		return x.Routine
+	}
+	return nil
+}
+
+type AthleteSummary struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Athlete *User                  `protobuf:"bytes,1,opt,name=athlete,proto3" json:"athlete,omitempty"`
+	// When the athlete last finished a workout, if ever.
+	LastSessionAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_session_at,json=lastSessionAt,proto3,oneof" json:"last_session_at,omitempty"`
+	// Volume lifted over the last seven days.
+	AcuteLoad float64 `protobuf:"fixed64,3,opt,name=acute_load,json=acuteLoad,proto3" json:"acute_load,omitempty"`
+	// Average weekly volume lifted over the last 28 days.
+	ChronicLoad   float64 `protobuf:"fixed64,4,opt,name=chronic_load,json=chronicLoad,proto3" json:"chronic_load,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AthleteSummary) Reset() {
+	*x = AthleteSummary{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AthleteSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AthleteSummary) ProtoMessage() {}
+
+func (x *AthleteSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AthleteSummary.ProtoReflect.Descriptor instead.
+func (*AthleteSummary) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AthleteSummary) GetAthlete() *User {
+	if x != nil {
+		return x.Athlete
+	}
+	return nil
+}
+
+func (x *AthleteSummary) GetLastSessionAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSessionAt
+	}
+	return nil
+}
+
+func (x *AthleteSummary) GetAcuteLoad() float64 {
+	if x != nil {
+		return x.AcuteLoad
+	}
+	return 0
+}
+
+func (x *AthleteSummary) GetChronicLoad() float64 {
+	if x != nil {
+		return x.ChronicLoad
+	}
+	return 0
+}
+
+type CoachAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Coach *User                  `protobuf:"bytes,2,opt,name=coach,proto3" json:"coach,omitempty"`
+	Type  CoachActionType        `protobuf:"varint,3,opt,name=type,proto3,enum=api.v1.CoachActionType" json:"type,omitempty"`
+	// The routine created or updated, if any.
+	TargetId      *string                `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3,oneof" json:"target_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoachAction) Reset() {
+	*x = CoachAction{}
+	mi := &file_api_v1_coach_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoachAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachAction) ProtoMessage() {}
+
+func (x *CoachAction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_coach_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachAction.ProtoReflect.Descriptor instead.
+func (*CoachAction) Descriptor() ([]byte, []int) {
+	return file_api_v1_coach_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CoachAction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CoachAction) GetCoach() *User {
+	if x != nil {
+		return x.Coach
+	}
+	return nil
+}
+
+func (x *CoachAction) GetType() CoachActionType {
+	if x != nil {
+		return x.Type
+	}
+	return CoachActionType_COACH_ACTION_TYPE_UNSPECIFIED
+}
+
+func (x *CoachAction) GetTargetId() string {
+	if x != nil && x.TargetId != nil {
+		return *x.TargetId
+	}
+	return ""
+}
+
+func (x *CoachAction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_api_v1_coach_service_proto protoreflect.FileDescriptor
+
+var file_api_v1_coach_service_proto_rawDesc = string([]byte{
+	0x0a, 0x1a, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1d, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x65,
+	0x72, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x3e, 0x0a, 0x17, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
+	0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x08, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x07, 0x63, 0x6f,
+	0x61, 0x63, 0x68, 0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x07, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07,
+	0x63, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4f, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x61, 0x63, 0x68, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x74, 0x68, 0x6c, 0x65, 0x74,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x52, 0x08, 0x61, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x1a, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0a, 0x61, 0x74, 0x68,
+	0x6c, 0x65, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba,
+	0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x61, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65,
+	0x49, 0x64, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74,
+	0x68, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x49,
+	0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x0a, 0x61, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09,
+	0x61, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x64, 0x22, 0x5d, 0x0a, 0x1f, 0x47, 0x65, 0x74,
+	0x41, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x42,
+	0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78,
+	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x53, 0x65, 0x74, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x6c, 0x42, 0x65, 0x73, 0x74, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0a, 0x61, 0x74, 0x68, 0x6c,
+	0x65, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x61, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32,
+	0x0a, 0x0c, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xba, 0x48, 0x0c, 0x92, 0x01, 0x09, 0x08, 0x01, 0x22, 0x05,
+	0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0b, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x49,
+	0x64, 0x73, 0x22, 0x2e, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x68, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x79, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x68, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x0a, 0x61, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
+	0x09, 0x61, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x42, 0x06, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x22, 0x49, 0x0a,
+	0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a,
+	0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x52,
+	0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x22, 0xd7, 0x01, 0x0a, 0x0e, 0x41, 0x74, 0x68,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x07, 0x61,
+	0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x07, 0x61, 0x74, 0x68, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x61, 0x63, 0x75, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x68, 0x72, 0x6f, 0x6e, 0x69, 0x63, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x69, 0x63, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x61, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x05, 0x63, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x2a, 0xf6,
+	0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x41, 0x43, 0x48, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x4f, 0x41, 0x43, 0x48, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x5f,
+	0x44, 0x41, 0x53, 0x48, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x43,
+	0x4f, 0x41, 0x43, 0x48, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x4f, 0x55, 0x54, 0x53, 0x10, 0x02,
+	0x12, 0x29, 0x0a, 0x25, 0x43, 0x4f, 0x41, 0x43, 0x48, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x50, 0x45, 0x52, 0x53, 0x4f,
+	0x4e, 0x41, 0x4c, 0x5f, 0x42, 0x45, 0x53, 0x54, 0x53, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x43,
+	0x4f, 0x41, 0x43, 0x48, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x45, 0x10,
+	0x04, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x4f, 0x41, 0x43, 0x48, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f,
+	0x55, 0x54, 0x49, 0x4e, 0x45, 0x10, 0x05, 0x32, 0x80, 0x07, 0x0a, 0x0c, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x10, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x5e, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x4c, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x88,
+	0xb5, 0x18, 0x01, 0x12, 0x5b, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18, 0x01,
+	0x12, 0x5e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x44, 0x61, 0x73, 0x68,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18, 0x01,
+	0x12, 0x64, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x70, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x74, 0x68,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x65, 0x73, 0x74,
+	0x73, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74,
+	0x68, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x65, 0x73,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x67, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65,
+	0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18,
+	0x01, 0x12, 0x67, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x68, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x12, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x68, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x68, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x42, 0x95, 0x01, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x11, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x72, 0x6c, 0x73, 0x73,
+	0x6e, 0x2f, 0x67, 0x65, 0x74, 0x73, 0x74, 0x72, 0x6f, 0x6e, 0x67, 0x65, 0x72, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x58,
+	0x58, 0xaa, 0x02, 0x06, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x41, 0x70, 0x69,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x12, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x41, 0x70, 0x69, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_api_v1_coach_service_proto_rawDescOnce sync.Once
+	file_api_v1_coach_service_proto_rawDescData []byte
+)
+
+func file_api_v1_coach_service_proto_rawDescGZIP() []byte {
+	file_api_v1_coach_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_coach_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_coach_service_proto_rawDesc), len(file_api_v1_coach_service_proto_rawDesc)))
+	})
+	return file_api_v1_coach_service_proto_rawDescData
+}
+
+var file_api_v1_coach_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_v1_coach_service_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_api_v1_coach_service_proto_goTypes = []any{
+	(CoachActionType)(0),                    // 0: api.v1.CoachActionType
+	(*GrantCoachAccessRequest)(nil),         // 1: api.v1.GrantCoachAccessRequest
+	(*GrantCoachAccessResponse)(nil),        // 2: api.v1.GrantCoachAccessResponse
+	(*RevokeCoachAccessRequest)(nil),        // 3: api.v1.RevokeCoachAccessRequest
+	(*RevokeCoachAccessResponse)(nil),       // 4: api.v1.RevokeCoachAccessResponse
+	(*ListCoachesRequest)(nil),              // 5: api.v1.ListCoachesRequest
+	(*ListCoachesResponse)(nil),             // 6: api.v1.ListCoachesResponse
+	(*ListCoachActionsRequest)(nil),         // 7: api.v1.ListCoachActionsRequest
+	(*ListCoachActionsResponse)(nil),        // 8: api.v1.ListCoachActionsResponse
+	(*GetCoachDashboardRequest)(nil),        // 9: api.v1.GetCoachDashboardRequest
+	(*GetCoachDashboardResponse)(nil),       // 10: api.v1.GetCoachDashboardResponse
+	(*ListAthleteWorkoutsRequest)(nil),      // 11: api.v1.ListAthleteWorkoutsRequest
+	(*ListAthleteWorkoutsResponse)(nil),     // 12: api.v1.ListAthleteWorkoutsResponse
+	(*GetAthletePersonalBestsRequest)(nil),  // 13: api.v1.GetAthletePersonalBestsRequest
+	(*GetAthletePersonalBestsResponse)(nil), // 14: api.v1.GetAthletePersonalBestsResponse
+	(*CreateAthleteRoutineRequest)(nil),     // 15: api.v1.CreateAthleteRoutineRequest
+	(*CreateAthleteRoutineResponse)(nil),    // 16: api.v1.CreateAthleteRoutineResponse
+	(*UpdateAthleteRoutineRequest)(nil),     // 17: api.v1.UpdateAthleteRoutineRequest
+	(*UpdateAthleteRoutineResponse)(nil),    // 18: api.v1.UpdateAthleteRoutineResponse
+	(*AthleteSummary)(nil),                  // 19: api.v1.AthleteSummary
+	(*CoachAction)(nil),                     // 20: api.v1.CoachAction
+	(*User)(nil),                            // 21: api.v1.User
+	(*PaginationRequest)(nil),               // 22: api.v1.PaginationRequest
+	(*PaginationResponse)(nil),              // 23: api.v1.PaginationResponse
+	(*Workout)(nil),                         // 24: api.v1.Workout
+	(*ExerciseSet)(nil),                     // 25: api.v1.ExerciseSet
+	(*Routine)(nil),                         // 26: api.v1.Routine
+	(*timestamppb.Timestamp)(nil),           // 27: google.protobuf.Timestamp
+}
+var file_api_v1_coach_service_proto_depIdxs = []int32{
+	21, // 0: api.v1.ListCoachesResponse.coaches:type_name -> api.v1.User
+	22, // 1: api.v1.ListCoachActionsRequest.pagination:type_name -> api.v1.PaginationRequest
+	20, // 2: api.v1.ListCoachActionsResponse.actions:type_name -> api.v1.CoachAction
+	23, // 3: api.v1.ListCoachActionsResponse.pagination:type_name -> api.v1.PaginationResponse
+	19, // 4: api.v1.GetCoachDashboardResponse.athletes:type_name -> api.v1.AthleteSummary
+	22, // 5: api.v1.ListAthleteWorkoutsRequest.pagination:type_name -> api.v1.PaginationRequest
+	24, // 6: api.v1.ListAthleteWorkoutsResponse.workouts:type_name -> api.v1.Workout
+	23, // 7: api.v1.ListAthleteWorkoutsResponse.pagination:type_name -> api.v1.PaginationResponse
+	25, // 8: api.v1.GetAthletePersonalBestsResponse.personal_bests:type_name -> api.v1.ExerciseSet
+	26, // 9: api.v1.UpdateAthleteRoutineRequest.routine:type_name -> api.v1.Routine
+	26, // 10: api.v1.UpdateAthleteRoutineResponse.routine:type_name -> api.v1.Routine
+	21, // 11: api.v1.AthleteSummary.athlete:type_name -> api.v1.User
+	27, // 12: api.v1.AthleteSummary.last_session_at:type_name -> google.protobuf.Timestamp
+	21, // 13: api.v1.CoachAction.coach:type_name -> api.v1.User
+	0,  // 14: api.v1.CoachAction.type:type_name -> api.v1.CoachActionType
+	27, // 15: api.v1.CoachAction.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 16: api.v1.CoachService.GrantCoachAccess:input_type -> api.v1.GrantCoachAccessRequest
+	3,  // 17: api.v1.CoachService.RevokeCoachAccess:input_type -> api.v1.RevokeCoachAccessRequest
+	5,  // 18: api.v1.CoachService.ListCoaches:input_type -> api.v1.ListCoachesRequest
+	7,  // 19: api.v1.CoachService.ListCoachActions:input_type -> api.v1.ListCoachActionsRequest
+	9,  // 20: api.v1.CoachService.GetCoachDashboard:input_type -> api.v1.GetCoachDashboardRequest
+	11, // 21: api.v1.CoachService.ListAthleteWorkouts:input_type -> api.v1.ListAthleteWorkoutsRequest
+	13, // 22: api.v1.CoachService.GetAthletePersonalBests:input_type -> api.v1.GetAthletePersonalBestsRequest
+	15, // 23: api.v1.CoachService.CreateAthleteRoutine:input_type -> api.v1.CreateAthleteRoutineRequest
+	17, // 24: api.v1.CoachService.UpdateAthleteRoutine:input_type -> api.v1.UpdateAthleteRoutineRequest
+	2,  // 25: api.v1.CoachService.GrantCoachAccess:output_type -> api.v1.GrantCoachAccessResponse
+	4,  // 26: api.v1.CoachService.RevokeCoachAccess:output_type -> api.v1.RevokeCoachAccessResponse
+	6,  // 27: api.v1.CoachService.ListCoaches:output_type -> api.v1.ListCoachesResponse
+	8,  // 28: api.v1.CoachService.ListCoachActions:output_type -> api.v1.ListCoachActionsResponse
+	10, // 29: api.v1.CoachService.GetCoachDashboard:output_type -> api.v1.GetCoachDashboardResponse
+	12, // 30: api.v1.CoachService.ListAthleteWorkouts:output_type -> api.v1.ListAthleteWorkoutsResponse
+	14, // 31: api.v1.CoachService.GetAthletePersonalBests:output_type -> api.v1.GetAthletePersonalBestsResponse
+	16, // 32: api.v1.CoachService.CreateAthleteRoutine:output_type -> api.v1.CreateAthleteRoutineResponse
+	18, // 33: api.v1.CoachService.UpdateAthleteRoutine:output_type -> api.v1.UpdateAthleteRoutineResponse
+	25, // [25:34] is the sub-list for method output_type
+	16, // [16:25] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_coach_service_proto_init() }
+func file_api_v1_coach_service_proto_init() {
+	if File_api_v1_coach_service_proto != nil {
+		return
+	}
+	file_api_v1_exercise_service_proto_init()
+	file_api_v1_options_proto_init()
+	file_api_v1_routine_service_proto_init()
+	file_api_v1_shared_proto_init()
+	file_api_v1_workout_service_proto_init()
+	file_api_v1_coach_service_proto_msgTypes[18].OneofWrappers = []any{}
+	file_api_v1_coach_service_proto_msgTypes[19].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_coach_service_proto_rawDesc), len(file_api_v1_coach_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_coach_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_coach_service_proto_depIdxs,
+		EnumInfos:         file_api_v1_coach_service_proto_enumTypes,
+		MessageInfos:      file_api_v1_coach_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_coach_service_proto = out.File
+	file_api_v1_coach_service_proto_goTypes = nil
+	file_api_v1_coach_service_proto_depIdxs = nil
+}
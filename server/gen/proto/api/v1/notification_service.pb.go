@@ -280,6 +280,15 @@ type Notification struct {
 	//
 	//	*Notification_UserFollowed_
 	//	*Notification_WorkoutComment_
+	//	*Notification_YearSummary_
+	//	*Notification_WorkoutCommentReply_
+	//	*Notification_WorkoutReactions_
+	//	*Notification_Mention_
+	//	*Notification_FollowRequest_
+	//	*Notification_FollowRequestApproved_
+	//	*Notification_ChallengeInvite_
+	//	*Notification_ChallengeLeadTaken_
+	//	*Notification_ChallengeEnded_
 	Type          isNotification_Type `protobuf_oneof:"type"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -354,6 +363,87 @@ func (x *Notification) GetWorkoutComment() *Notification_WorkoutComment {
 	return nil
 }
 
+func (x *Notification) GetYearSummary() *Notification_YearSummary {
+	if x != nil {
+		if x, ok := x.Type.(*Notification_YearSummary_); ok {
+			return x.YearSummary
+		}
+	}
+	return nil
+}
+
+func (x *Notification) GetWorkoutCommentReply() *Notification_WorkoutCommentReply {
+	if x != nil {
+		if x, ok := x.Type.(*Notification_WorkoutCommentReply_); ok {
+			return x.WorkoutCommentReply
+		}
+	}
+	return nil
+}
+
+func (x *Notification) GetWorkoutReactions() *Notification_WorkoutReactions {
+	if x != nil {
+		if x, ok := x.Type.(*Notification_WorkoutReactions_); ok {
+			return x.WorkoutReactions
+		}
+	}
+	return nil
+}
+
+func (x *Notification) GetMention() *Notification_Mention {
+	if x != nil {
+		if x, ok := x.Type.(*Notification_Mention_); ok {
+			return x.Mention
+		}
+	}
+	return nil
+}
+
+func (x *Notification) GetFollowRequest() *Notification_FollowRequest {
+	if x != nil {
+		if x, ok := x.Type.(*Notification_FollowRequest_); ok {
+			return x.FollowRequest
+		}
+	}
+	return nil
+}
+
+func (x *Notification) GetFollowRequestApproved() *Notification_FollowRequestApproved {
+	if x != nil {
+		if x, ok := x.Type.(*Notification_FollowRequestApproved_); ok {
+			return x.FollowRequestApproved
+		}
+	}
+	return nil
+}
+
+func (x *Notification) GetChallengeInvite() *Notification_ChallengeInvite {
+	if x != nil {
+		if x, ok := x.Type.(*Notification_ChallengeInvite_); ok {
+			return x.ChallengeInvite
+		}
+	}
+	return nil
+}
+
+func (x *Notification) GetChallengeLeadTaken() *Notification_ChallengeLeadTaken {
+	if x != nil {
+		if x, ok := x.Type.(*Notification_ChallengeLeadTaken_); ok {
+			return x.ChallengeLeadTaken
+		}
+	}
+	return nil
+}
+
+func (x *Notification) GetChallengeEnded() *Notification_ChallengeEnded {
+	if x != nil {
+		if x, ok := x.Type.(*Notification_ChallengeEnded_); ok {
+			return x.ChallengeEnded
+		}
+	}
+	return nil
+}
+
 type isNotification_Type interface {
 	isNotification_Type()
 }
@@ -366,10 +456,64 @@ type Notification_WorkoutComment_ struct {
 	WorkoutComment *Notification_WorkoutComment `protobuf:"bytes,4,opt,name=workout_comment,json=workoutComment,proto3,oneof"`
 }
 
+type Notification_YearSummary_ struct {
+	YearSummary *Notification_YearSummary `protobuf:"bytes,5,opt,name=year_summary,json=yearSummary,proto3,oneof"`
+}
+
+type Notification_WorkoutCommentReply_ struct {
+	WorkoutCommentReply *Notification_WorkoutCommentReply `protobuf:"bytes,6,opt,name=workout_comment_reply,json=workoutCommentReply,proto3,oneof"`
+}
+
+type Notification_WorkoutReactions_ struct {
+	WorkoutReactions *Notification_WorkoutReactions `protobuf:"bytes,7,opt,name=workout_reactions,json=workoutReactions,proto3,oneof"`
+}
+
+type Notification_Mention_ struct {
+	Mention *Notification_Mention `protobuf:"bytes,8,opt,name=mention,proto3,oneof"`
+}
+
+type Notification_FollowRequest_ struct {
+	FollowRequest *Notification_FollowRequest `protobuf:"bytes,9,opt,name=follow_request,json=followRequest,proto3,oneof"`
+}
+
+type Notification_FollowRequestApproved_ struct {
+	FollowRequestApproved *Notification_FollowRequestApproved `protobuf:"bytes,10,opt,name=follow_request_approved,json=followRequestApproved,proto3,oneof"`
+}
+
+type Notification_ChallengeInvite_ struct {
+	ChallengeInvite *Notification_ChallengeInvite `protobuf:"bytes,11,opt,name=challenge_invite,json=challengeInvite,proto3,oneof"`
+}
+
+type Notification_ChallengeLeadTaken_ struct {
+	ChallengeLeadTaken *Notification_ChallengeLeadTaken `protobuf:"bytes,12,opt,name=challenge_lead_taken,json=challengeLeadTaken,proto3,oneof"`
+}
+
+type Notification_ChallengeEnded_ struct {
+	ChallengeEnded *Notification_ChallengeEnded `protobuf:"bytes,13,opt,name=challenge_ended,json=challengeEnded,proto3,oneof"`
+}
+
 func (*Notification_UserFollowed_) isNotification_Type() {}
 
 func (*Notification_WorkoutComment_) isNotification_Type() {}
 
+func (*Notification_YearSummary_) isNotification_Type() {}
+
+func (*Notification_WorkoutCommentReply_) isNotification_Type() {}
+
+func (*Notification_WorkoutReactions_) isNotification_Type() {}
+
+func (*Notification_Mention_) isNotification_Type() {}
+
+func (*Notification_FollowRequest_) isNotification_Type() {}
+
+func (*Notification_FollowRequestApproved_) isNotification_Type() {}
+
+func (*Notification_ChallengeInvite_) isNotification_Type() {}
+
+func (*Notification_ChallengeLeadTaken_) isNotification_Type() {}
+
+func (*Notification_ChallengeEnded_) isNotification_Type() {}
+
 type Notification_UserFollowed struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Actor         *User                  `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
@@ -466,99 +610,656 @@ func (x *Notification_WorkoutComment) GetWorkout() *Workout {
 	return nil
 }
 
+type Notification_YearSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *YearSummary           `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification_YearSummary) Reset() {
+	*x = Notification_YearSummary{}
+	mi := &file_api_v1_notification_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification_YearSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification_YearSummary) ProtoMessage() {}
+
+func (x *Notification_YearSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notification_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification_YearSummary.ProtoReflect.Descriptor instead.
+func (*Notification_YearSummary) Descriptor() ([]byte, []int) {
+	return file_api_v1_notification_service_proto_rawDescGZIP(), []int{6, 2}
+}
+
+func (x *Notification_YearSummary) GetSummary() *YearSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type Notification_WorkoutCommentReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *User                  `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Workout       *Workout               `protobuf:"bytes,2,opt,name=workout,proto3" json:"workout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification_WorkoutCommentReply) Reset() {
+	*x = Notification_WorkoutCommentReply{}
+	mi := &file_api_v1_notification_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification_WorkoutCommentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification_WorkoutCommentReply) ProtoMessage() {}
+
+func (x *Notification_WorkoutCommentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notification_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification_WorkoutCommentReply.ProtoReflect.Descriptor instead.
+func (*Notification_WorkoutCommentReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_notification_service_proto_rawDescGZIP(), []int{6, 3}
+}
+
+func (x *Notification_WorkoutCommentReply) GetActor() *User {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *Notification_WorkoutCommentReply) GetWorkout() *Workout {
+	if x != nil {
+		return x.Workout
+	}
+	return nil
+}
+
+// Reactions to the same workout are grouped into a single notification
+// where the actor is the latest user to react.
+type Notification_WorkoutReactions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *User                  `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Workout       *Workout               `protobuf:"bytes,2,opt,name=workout,proto3" json:"workout,omitempty"`
+	Reactions     int32                  `protobuf:"varint,3,opt,name=reactions,proto3" json:"reactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification_WorkoutReactions) Reset() {
+	*x = Notification_WorkoutReactions{}
+	mi := &file_api_v1_notification_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification_WorkoutReactions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification_WorkoutReactions) ProtoMessage() {}
+
+func (x *Notification_WorkoutReactions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notification_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification_WorkoutReactions.ProtoReflect.Descriptor instead.
+func (*Notification_WorkoutReactions) Descriptor() ([]byte, []int) {
+	return file_api_v1_notification_service_proto_rawDescGZIP(), []int{6, 4}
+}
+
+func (x *Notification_WorkoutReactions) GetActor() *User {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *Notification_WorkoutReactions) GetWorkout() *Workout {
+	if x != nil {
+		return x.Workout
+	}
+	return nil
+}
+
+func (x *Notification_WorkoutReactions) GetReactions() int32 {
+	if x != nil {
+		return x.Reactions
+	}
+	return 0
+}
+
+type Notification_Mention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *User                  `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Workout       *Workout               `protobuf:"bytes,2,opt,name=workout,proto3" json:"workout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification_Mention) Reset() {
+	*x = Notification_Mention{}
+	mi := &file_api_v1_notification_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification_Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification_Mention) ProtoMessage() {}
+
+func (x *Notification_Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notification_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification_Mention.ProtoReflect.Descriptor instead.
+func (*Notification_Mention) Descriptor() ([]byte, []int) {
+	return file_api_v1_notification_service_proto_rawDescGZIP(), []int{6, 5}
+}
+
+func (x *Notification_Mention) GetActor() *User {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *Notification_Mention) GetWorkout() *Workout {
+	if x != nil {
+		return x.Workout
+	}
+	return nil
+}
+
+type Notification_FollowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *User                  `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification_FollowRequest) Reset() {
+	*x = Notification_FollowRequest{}
+	mi := &file_api_v1_notification_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification_FollowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification_FollowRequest) ProtoMessage() {}
+
+func (x *Notification_FollowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notification_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification_FollowRequest.ProtoReflect.Descriptor instead.
+func (*Notification_FollowRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_notification_service_proto_rawDescGZIP(), []int{6, 6}
+}
+
+func (x *Notification_FollowRequest) GetActor() *User {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+type Notification_FollowRequestApproved struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *User                  `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification_FollowRequestApproved) Reset() {
+	*x = Notification_FollowRequestApproved{}
+	mi := &file_api_v1_notification_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification_FollowRequestApproved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification_FollowRequestApproved) ProtoMessage() {}
+
+func (x *Notification_FollowRequestApproved) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notification_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification_FollowRequestApproved.ProtoReflect.Descriptor instead.
+func (*Notification_FollowRequestApproved) Descriptor() ([]byte, []int) {
+	return file_api_v1_notification_service_proto_rawDescGZIP(), []int{6, 7}
+}
+
+func (x *Notification_FollowRequestApproved) GetActor() *User {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+type Notification_ChallengeInvite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *User                  `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Challenge     *Challenge             `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification_ChallengeInvite) Reset() {
+	*x = Notification_ChallengeInvite{}
+	mi := &file_api_v1_notification_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification_ChallengeInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification_ChallengeInvite) ProtoMessage() {}
+
+func (x *Notification_ChallengeInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notification_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification_ChallengeInvite.ProtoReflect.Descriptor instead.
+func (*Notification_ChallengeInvite) Descriptor() ([]byte, []int) {
+	return file_api_v1_notification_service_proto_rawDescGZIP(), []int{6, 8}
+}
+
+func (x *Notification_ChallengeInvite) GetActor() *User {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *Notification_ChallengeInvite) GetChallenge() *Challenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+type Notification_ChallengeLeadTaken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         *User                  `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Challenge     *Challenge             `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification_ChallengeLeadTaken) Reset() {
+	*x = Notification_ChallengeLeadTaken{}
+	mi := &file_api_v1_notification_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification_ChallengeLeadTaken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification_ChallengeLeadTaken) ProtoMessage() {}
+
+func (x *Notification_ChallengeLeadTaken) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notification_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification_ChallengeLeadTaken.ProtoReflect.Descriptor instead.
+func (*Notification_ChallengeLeadTaken) Descriptor() ([]byte, []int) {
+	return file_api_v1_notification_service_proto_rawDescGZIP(), []int{6, 9}
+}
+
+func (x *Notification_ChallengeLeadTaken) GetActor() *User {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *Notification_ChallengeLeadTaken) GetChallenge() *Challenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
+// The winner is unset if no participant made any progress or the lead was
+// shared when the challenge ended.
+type Notification_ChallengeEnded struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Winner        *User                  `protobuf:"bytes,1,opt,name=winner,proto3" json:"winner,omitempty"`
+	Challenge     *Challenge             `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification_ChallengeEnded) Reset() {
+	*x = Notification_ChallengeEnded{}
+	mi := &file_api_v1_notification_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification_ChallengeEnded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification_ChallengeEnded) ProtoMessage() {}
+
+func (x *Notification_ChallengeEnded) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_notification_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification_ChallengeEnded.ProtoReflect.Descriptor instead.
+func (*Notification_ChallengeEnded) Descriptor() ([]byte, []int) {
+	return file_api_v1_notification_service_proto_rawDescGZIP(), []int{6, 10}
+}
+
+func (x *Notification_ChallengeEnded) GetWinner() *User {
+	if x != nil {
+		return x.Winner
+	}
+	return nil
+}
+
+func (x *Notification_ChallengeEnded) GetChallenge() *Challenge {
+	if x != nil {
+		return x.Challenge
+	}
+	return nil
+}
+
 var File_api_v1_notification_service_proto protoreflect.FileDescriptor
 
 var file_api_v1_notification_service_proto_rawDesc = string([]byte{
 	0x0a, 0x21, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x06, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x61, 0x70, 0x69,
+	0x6f, 0x74, 0x6f, 0x12, 0x06, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x61, 0x70, 0x69,
 	0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x13, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77,
-	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x5d, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x06, 0xba, 0x48,
-	0x03, 0xc8, 0x01, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x93, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a,
-	0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x61, 0x72, 0x6b,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x73, 0x52,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x55,
-	0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x1b, 0x55, 0x6e, 0x72,
-	0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xff,
-	0x02, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x28, 0x0a, 0x10, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75,
-	0x6e, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x12, 0x48, 0x0a, 0x0d, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x64, 0x12, 0x4e, 0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5d, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x1b, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x96, 0x0f, 0x0a, 0x0c, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74,
+	0x55, 0x6e, 0x69, 0x78, 0x12, 0x48, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x48, 0x00,
+	0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x4e,
+	0x0a, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e,
+	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x45,
+	0x0a, 0x0c, 0x79, 0x65, 0x61, 0x72, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x59, 0x65, 0x61, 0x72, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x79, 0x65, 0x61, 0x72, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x5e, 0x0a, 0x15, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x48, 0x00,
+	0x52, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x54, 0x0a, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x5f, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x6d,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x48, 0x00, 0x52, 0x0e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x1a, 0x32, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x5f, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x6f,
-	0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x61, 0x63, 0x74,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a,
-	0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52,
-	0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x32, 0xcf, 0x02, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x70, 0x0a, 0x17, 0x4d, 0x61, 0x72, 0x6b,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x73, 0x52,
-	0x65, 0x61, 0x64, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72,
-	0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x73,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x66, 0x0a, 0x13, 0x55, 0x6e,
-	0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x72, 0x65, 0x61,
-	0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55,
-	0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18, 0x01,
-	0x30, 0x01, 0x42, 0x9c, 0x01, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
-	0x31, 0x42, 0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3b, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x72, 0x6c, 0x73, 0x73, 0x6e,
-	0x2f, 0x67, 0x65, 0x74, 0x73, 0x74, 0x72, 0x6f, 0x6e, 0x67, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41, 0x58, 0x58,
-	0xaa, 0x02, 0x06, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x41, 0x70, 0x69, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x12, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x2e, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x0e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x48, 0x00, 0x52, 0x0d, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x64, 0x0a, 0x17, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x15, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x5b, 0x0a, 0x14, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x61,
+	0x6b, 0x65, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x54, 0x61, 0x6b,
+	0x65, 0x6e, 0x48, 0x00, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4c,
+	0x65, 0x61, 0x64, 0x54, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x4e, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x1a, 0x32, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x5f, 0x0a, 0x0e,
+	0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22,
+	0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x1a, 0x3c, 0x0a,
+	0x0b, 0x59, 0x65, 0x61, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x07,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x59, 0x65, 0x61, 0x72, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x1a, 0x64, 0x0a, 0x13, 0x57,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x1a, 0x7f, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x1a, 0x58, 0x0a, 0x07, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x29, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x1a, 0x33, 0x0a, 0x0d,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x1a, 0x3b, 0x0a, 0x15, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x66,
+	0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x12, 0x22, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x1a, 0x69, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x54, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x05,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x2f, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x1a, 0x67, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x45, 0x6e,
+	0x64, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x32, 0xcf, 0x02, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5e, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x70, 0x0a, 0x17, 0x4d, 0x61,
+	0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x26, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x88, 0xb5, 0x18, 0x01, 0x12, 0x66, 0x0a, 0x13,
+	0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x88, 0xb5,
+	0x18, 0x01, 0x30, 0x01, 0x42, 0x9c, 0x01, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x31, 0x42, 0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x72, 0x6c, 0x73,
+	0x73, 0x6e, 0x2f, 0x67, 0x65, 0x74, 0x73, 0x74, 0x72, 0x6f, 0x6e, 0x67, 0x65, 0x72, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x70, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x41,
+	0x58, 0x58, 0xaa, 0x02, 0x06, 0x41, 0x70, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x06, 0x41, 0x70,
+	0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x12, 0x41, 0x70, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x07, 0x41, 0x70, 0x69, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -573,42 +1274,77 @@ func file_api_v1_notification_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_notification_service_proto_rawDescData
 }
 
-var file_api_v1_notification_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_v1_notification_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_api_v1_notification_service_proto_goTypes = []any{
-	(*ListNotificationsRequest)(nil),        // 0: api.v1.ListNotificationsRequest
-	(*ListNotificationsResponse)(nil),       // 1: api.v1.ListNotificationsResponse
-	(*MarkNotificationsAsReadRequest)(nil),  // 2: api.v1.MarkNotificationsAsReadRequest
-	(*MarkNotificationsAsReadResponse)(nil), // 3: api.v1.MarkNotificationsAsReadResponse
-	(*UnreadNotificationsRequest)(nil),      // 4: api.v1.UnreadNotificationsRequest
-	(*UnreadNotificationsResponse)(nil),     // 5: api.v1.UnreadNotificationsResponse
-	(*Notification)(nil),                    // 6: api.v1.Notification
-	(*Notification_UserFollowed)(nil),       // 7: api.v1.Notification.UserFollowed
-	(*Notification_WorkoutComment)(nil),     // 8: api.v1.Notification.WorkoutComment
-	(*PaginationRequest)(nil),               // 9: api.v1.PaginationRequest
-	(*PaginationResponse)(nil),              // 10: api.v1.PaginationResponse
-	(*User)(nil),                            // 11: api.v1.User
-	(*Workout)(nil),                         // 12: api.v1.Workout
+	(*ListNotificationsRequest)(nil),           // 0: api.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),          // 1: api.v1.ListNotificationsResponse
+	(*MarkNotificationsAsReadRequest)(nil),     // 2: api.v1.MarkNotificationsAsReadRequest
+	(*MarkNotificationsAsReadResponse)(nil),    // 3: api.v1.MarkNotificationsAsReadResponse
+	(*UnreadNotificationsRequest)(nil),         // 4: api.v1.UnreadNotificationsRequest
+	(*UnreadNotificationsResponse)(nil),        // 5: api.v1.UnreadNotificationsResponse
+	(*Notification)(nil),                       // 6: api.v1.Notification
+	(*Notification_UserFollowed)(nil),          // 7: api.v1.Notification.UserFollowed
+	(*Notification_WorkoutComment)(nil),        // 8: api.v1.Notification.WorkoutComment
+	(*Notification_YearSummary)(nil),           // 9: api.v1.Notification.YearSummary
+	(*Notification_WorkoutCommentReply)(nil),   // 10: api.v1.Notification.WorkoutCommentReply
+	(*Notification_WorkoutReactions)(nil),      // 11: api.v1.Notification.WorkoutReactions
+	(*Notification_Mention)(nil),               // 12: api.v1.Notification.Mention
+	(*Notification_FollowRequest)(nil),         // 13: api.v1.Notification.FollowRequest
+	(*Notification_FollowRequestApproved)(nil), // 14: api.v1.Notification.FollowRequestApproved
+	(*Notification_ChallengeInvite)(nil),       // 15: api.v1.Notification.ChallengeInvite
+	(*Notification_ChallengeLeadTaken)(nil),    // 16: api.v1.Notification.ChallengeLeadTaken
+	(*Notification_ChallengeEnded)(nil),        // 17: api.v1.Notification.ChallengeEnded
+	(*PaginationRequest)(nil),                  // 18: api.v1.PaginationRequest
+	(*PaginationResponse)(nil),                 // 19: api.v1.PaginationResponse
+	(*User)(nil),                               // 20: api.v1.User
+	(*Workout)(nil),                            // 21: api.v1.Workout
+	(*YearSummary)(nil),                        // 22: api.v1.YearSummary
+	(*Challenge)(nil),                          // 23: api.v1.Challenge
 }
 var file_api_v1_notification_service_proto_depIdxs = []int32{
-	9,  // 0: api.v1.ListNotificationsRequest.pagination:type_name -> api.v1.PaginationRequest
+	18, // 0: api.v1.ListNotificationsRequest.pagination:type_name -> api.v1.PaginationRequest
 	6,  // 1: api.v1.ListNotificationsResponse.notifications:type_name -> api.v1.Notification
-	10, // 2: api.v1.ListNotificationsResponse.pagination:type_name -> api.v1.PaginationResponse
+	19, // 2: api.v1.ListNotificationsResponse.pagination:type_name -> api.v1.PaginationResponse
 	7,  // 3: api.v1.Notification.user_followed:type_name -> api.v1.Notification.UserFollowed
 	8,  // 4: api.v1.Notification.workout_comment:type_name -> api.v1.Notification.WorkoutComment
-	11, // 5: api.v1.Notification.UserFollowed.actor:type_name -> api.v1.User
-	11, // 6: api.v1.Notification.WorkoutComment.actor:type_name -> api.v1.User
-	12, // 7: api.v1.Notification.WorkoutComment.workout:type_name -> api.v1.Workout
-	0,  // 8: api.v1.NotificationService.ListNotifications:input_type -> api.v1.ListNotificationsRequest
-	2,  // 9: api.v1.NotificationService.MarkNotificationsAsRead:input_type -> api.v1.MarkNotificationsAsReadRequest
-	4,  // 10: api.v1.NotificationService.UnreadNotifications:input_type -> api.v1.UnreadNotificationsRequest
-	1,  // 11: api.v1.NotificationService.ListNotifications:output_type -> api.v1.ListNotificationsResponse
-	3,  // 12: api.v1.NotificationService.MarkNotificationsAsRead:output_type -> api.v1.MarkNotificationsAsReadResponse
-	5,  // 13: api.v1.NotificationService.UnreadNotifications:output_type -> api.v1.UnreadNotificationsResponse
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	9,  // 5: api.v1.Notification.year_summary:type_name -> api.v1.Notification.YearSummary
+	10, // 6: api.v1.Notification.workout_comment_reply:type_name -> api.v1.Notification.WorkoutCommentReply
+	11, // 7: api.v1.Notification.workout_reactions:type_name -> api.v1.Notification.WorkoutReactions
+	12, // 8: api.v1.Notification.mention:type_name -> api.v1.Notification.Mention
+	13, // 9: api.v1.Notification.follow_request:type_name -> api.v1.Notification.FollowRequest
+	14, // 10: api.v1.Notification.follow_request_approved:type_name -> api.v1.Notification.FollowRequestApproved
+	15, // 11: api.v1.Notification.challenge_invite:type_name -> api.v1.Notification.ChallengeInvite
+	16, // 12: api.v1.Notification.challenge_lead_taken:type_name -> api.v1.Notification.ChallengeLeadTaken
+	17, // 13: api.v1.Notification.challenge_ended:type_name -> api.v1.Notification.ChallengeEnded
+	20, // 14: api.v1.Notification.UserFollowed.actor:type_name -> api.v1.User
+	20, // 15: api.v1.Notification.WorkoutComment.actor:type_name -> api.v1.User
+	21, // 16: api.v1.Notification.WorkoutComment.workout:type_name -> api.v1.Workout
+	22, // 17: api.v1.Notification.YearSummary.summary:type_name -> api.v1.YearSummary
+	20, // 18: api.v1.Notification.WorkoutCommentReply.actor:type_name -> api.v1.User
+	21, // 19: api.v1.Notification.WorkoutCommentReply.workout:type_name -> api.v1.Workout
+	20, // 20: api.v1.Notification.WorkoutReactions.actor:type_name -> api.v1.User
+	21, // 21: api.v1.Notification.WorkoutReactions.workout:type_name -> api.v1.Workout
+	20, // 22: api.v1.Notification.Mention.actor:type_name -> api.v1.User
+	21, // 23: api.v1.Notification.Mention.workout:type_name -> api.v1.Workout
+	20, // 24: api.v1.Notification.FollowRequest.actor:type_name -> api.v1.User
+	20, // 25: api.v1.Notification.FollowRequestApproved.actor:type_name -> api.v1.User
+	20, // 26: api.v1.Notification.ChallengeInvite.actor:type_name -> api.v1.User
+	23, // 27: api.v1.Notification.ChallengeInvite.challenge:type_name -> api.v1.Challenge
+	20, // 28: api.v1.Notification.ChallengeLeadTaken.actor:type_name -> api.v1.User
+	23, // 29: api.v1.Notification.ChallengeLeadTaken.challenge:type_name -> api.v1.Challenge
+	20, // 30: api.v1.Notification.ChallengeEnded.winner:type_name -> api.v1.User
+	23, // 31: api.v1.Notification.ChallengeEnded.challenge:type_name -> api.v1.Challenge
+	0,  // 32: api.v1.NotificationService.ListNotifications:input_type -> api.v1.ListNotificationsRequest
+	2,  // 33: api.v1.NotificationService.MarkNotificationsAsRead:input_type -> api.v1.MarkNotificationsAsReadRequest
+	4,  // 34: api.v1.NotificationService.UnreadNotifications:input_type -> api.v1.UnreadNotificationsRequest
+	1,  // 35: api.v1.NotificationService.ListNotifications:output_type -> api.v1.ListNotificationsResponse
+	3,  // 36: api.v1.NotificationService.MarkNotificationsAsRead:output_type -> api.v1.MarkNotificationsAsReadResponse
+	5,  // 37: api.v1.NotificationService.UnreadNotifications:output_type -> api.v1.UnreadNotificationsResponse
+	35, // [35:38] is the sub-list for method output_type
+	32, // [32:35] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_notification_service_proto_init() }
@@ -616,12 +1352,23 @@ func file_api_v1_notification_service_proto_init() {
 	if File_api_v1_notification_service_proto != nil {
 		return
 	}
+	file_api_v1_challenge_service_proto_init()
 	file_api_v1_options_proto_init()
 	file_api_v1_shared_proto_init()
+	file_api_v1_user_service_proto_init()
 	file_api_v1_workout_service_proto_init()
 	file_api_v1_notification_service_proto_msgTypes[6].OneofWrappers = []any{
 		(*Notification_UserFollowed_)(nil),
 		(*Notification_WorkoutComment_)(nil),
+		(*Notification_YearSummary_)(nil),
+		(*Notification_WorkoutCommentReply_)(nil),
+		(*Notification_WorkoutReactions_)(nil),
+		(*Notification_Mention_)(nil),
+		(*Notification_FollowRequest_)(nil),
+		(*Notification_FollowRequestApproved_)(nil),
+		(*Notification_ChallengeInvite_)(nil),
+		(*Notification_ChallengeLeadTaken_)(nil),
+		(*Notification_ChallengeEnded_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -629,7 +1376,7 @@ func file_api_v1_notification_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_notification_service_proto_rawDesc), len(file_api_v1_notification_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
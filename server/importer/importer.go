@@ -0,0 +1,232 @@
+package importer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/crlssn/getstronger/server/gen/orm"
+	"github.com/crlssn/getstronger/server/repo"
+)
+
+// Importer creates workouts, and the exercises they reference, from workouts
+// parsed from other apps' exports.
+type Importer struct {
+	repo repo.Repo
+}
+
+func New(r repo.Repo) *Importer {
+	return &Importer{repo: r}
+}
+
+type ConflictReason int
+
+const (
+	// ConflictAlreadyImported means the workout was imported before.
+	ConflictAlreadyImported ConflictReason = iota + 1
+	// ConflictOverlapsWorkout means the workout overlaps a workout that was
+	// logged in the app.
+	ConflictOverlapsWorkout
+	// ConflictWithoutSets means the workout has no sets that can be imported,
+	// e.g. because it only contains cardio.
+	ConflictWithoutSets
+)
+
+// Conflict is a workout that is skipped by the import.
+type Conflict struct {
+	Workout Workout
+	Reason  ConflictReason
+}
+
+type Result struct {
+	// Workouts are the workouts that were, or would be, created.
+	Workouts []Workout
+	// NewExercises are the names of the exercises that were, or would be,
+	// created since the user has no exercise with the same name.
+	NewExercises []string
+	// Conflicts are the workouts that are skipped.
+	Conflicts []Conflict
+}
+
+// Sets returns the number of sets across the imported workouts.
+func (r *Result) Sets() int {
+	var sets int
+	for _, workout := range r.Workouts {
+		sets += workout.Sets()
+	}
+
+	return sets
+}
+
+// Import imports the workouts for the user. Workouts that were imported before
+// are skipped, which makes it safe to import the same file more than once. No
+// changes are made when dryRun is set, but the result is the same as if they
+// were.
+func (i *Importer) Import(ctx context.Context, userID string, workouts []Workout, dryRun bool) (*Result, error) {
+	var result *Result
+	if err := i.repo.NewTx(ctx, func(tx repo.Tx) error {
+		var err error
+		if result, err = plan(ctx, tx, userID, workouts); err != nil {
+			return err
+		}
+
+		if dryRun {
+			return nil
+		}
+
+		return apply(ctx, tx, userID, result)
+	}); err != nil {
+		return nil, fmt.Errorf("import tx: %w", err)
+	}
+
+	return result, nil
+}
+
+func plan(ctx context.Context, tx repo.Tx, userID string, workouts []Workout) (*Result, error) {
+	keys := make([]string, 0, len(workouts))
+	for _, workout := range workouts {
+		keys = append(keys, workout.Key())
+	}
+
+	imported, err := tx.ListWorkouts(ctx,
+		repo.ListWorkoutsWithUserIDs(userID),
+		repo.ListWorkoutsWithImportKeys(keys),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list imported workouts: %w", err)
+	}
+
+	importedKeys := make(map[string]struct{}, len(imported))
+	for _, workout := range imported {
+		importedKeys[workout.ImportKey.String] = struct{}{}
+	}
+
+	existing, err := tx.ListWorkouts(ctx,
+		repo.ListWorkoutsWithUserIDs(userID),
+		repo.ListWorkoutsWithoutDeleted(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list workouts: %w", err)
+	}
+
+	exercises, err := tx.ListExercises(ctx,
+		repo.ListExercisesWithUserID(userID),
+		repo.ListExercisesWithoutDeleted(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list exercises: %w", err)
+	}
+
+	exerciseNames := make(map[string]struct{}, len(exercises))
+	for _, exercise := range exercises {
+		exerciseNames[nameKey(exercise.Title)] = struct{}{}
+	}
+
+	result := new(Result)
+	for _, workout := range workouts {
+		if _, ok := importedKeys[workout.Key()]; ok {
+			result.Conflicts = append(result.Conflicts, Conflict{Workout: workout, Reason: ConflictAlreadyImported})
+			continue
+		}
+
+		if workout.Sets() == 0 {
+			result.Conflicts = append(result.Conflicts, Conflict{Workout: workout, Reason: ConflictWithoutSets})
+			continue
+		}
+
+		if overlaps(workout, existing) {
+			result.Conflicts = append(result.Conflicts, Conflict{Workout: workout, Reason: ConflictOverlapsWorkout})
+			continue
+		}
+
+		// Guard against the same workout appearing twice in the file.
+		importedKeys[workout.Key()] = struct{}{}
+		result.Workouts = append(result.Workouts, workout)
+
+		for _, exercise := range workout.Exercises {
+			if _, ok := exerciseNames[nameKey(exercise.Name)]; ok {
+				continue
+			}
+
+			exerciseNames[nameKey(exercise.Name)] = struct{}{}
+			result.NewExercises = append(result.NewExercises, strings.TrimSpace(exercise.Name))
+		}
+	}
+
+	return result, nil
+}
+
+func apply(ctx context.Context, tx repo.Tx, userID string, result *Result) error {
+	for _, name := range result.NewExercises {
+		if _, err := tx.CreateExercise(ctx, repo.CreateExerciseParams{
+			UserID: userID,
+			Name:   name,
+		}); err != nil {
+			return fmt.Errorf("create exercise: %w", err)
+		}
+	}
+
+	exercises, err := tx.ListExercises(ctx,
+		repo.ListExercisesWithUserID(userID),
+		repo.ListExercisesWithoutDeleted(),
+	)
+	if err != nil {
+		return fmt.Errorf("list exercises: %w", err)
+	}
+
+	exerciseIDs := make(map[string]string, len(exercises))
+	for _, exercise := range exercises {
+		exerciseIDs[nameKey(exercise.Title)] = exercise.ID
+	}
+
+	for _, workout := range result.Workouts {
+		exerciseSets := make([]repo.ExerciseSet, 0, len(workout.Exercises))
+		for _, exercise := range workout.Exercises {
+			sets := make([]repo.Set, 0, len(exercise.Sets))
+			for _, set := range exercise.Sets {
+				sets = append(sets, repo.Set{
+					Reps:   set.Reps,
+					Weight: set.Weight,
+				})
+			}
+
+			exerciseSets = append(exerciseSets, repo.ExerciseSet{
+				ExerciseID: exerciseIDs[nameKey(exercise.Name)],
+				Sets:       sets,
+			})
+		}
+
+		if _, err = tx.CreateWorkout(ctx, repo.CreateWorkoutParams{
+			Name:         workout.Name,
+			Note:         workout.Note,
+			UserID:       userID,
+			ExerciseSets: exerciseSets,
+			StartedAt:    workout.StartedAt,
+			FinishedAt:   workout.FinishedAt,
+			ImportKey:    workout.Key(),
+			CreatedAt:    workout.FinishedAt,
+		}); err != nil {
+			return fmt.Errorf("create workout: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func overlaps(workout Workout, existing orm.WorkoutSlice) bool {
+	for _, e := range existing {
+		if e.ImportKey.Valid {
+			continue
+		}
+
+		if workout.StartedAt.Before(e.FinishedAt) && e.StartedAt.Before(workout.FinishedAt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func nameKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
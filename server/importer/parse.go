@@ -0,0 +1,308 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Format int
+
+const (
+	FormatStrong Format = iota + 1
+	FormatHevy
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown import format")
+	ErrMissingColumn = errors.New("missing column")
+	ErrInvalidRow    = errors.New("invalid row")
+)
+
+type Workout struct {
+	Name       string
+	Note       string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Exercises  []Exercise
+}
+
+// Key identifies the workout across imports. It is derived from the start
+// time and name since neither Strong nor Hevy export workout identifiers. The
+// start time is keyed on its wall clock in the time zone it was parsed in, as
+// written in the export, so the key doesn't depend on the time zone.
+func (w Workout) Key() string {
+	wall := time.Date(
+		w.StartedAt.Year(), w.StartedAt.Month(), w.StartedAt.Day(),
+		w.StartedAt.Hour(), w.StartedAt.Minute(), w.StartedAt.Second(), w.StartedAt.Nanosecond(),
+		time.UTC,
+	)
+	sum := sha256.Sum256([]byte(wall.Format(time.RFC3339) + "|" + w.Name))
+	return hex.EncodeToString(sum[:])
+}
+
+// Sets returns the number of sets in the workout.
+func (w Workout) Sets() int {
+	var sets int
+	for _, exercise := range w.Exercises {
+		sets += len(exercise.Sets)
+	}
+
+	return sets
+}
+
+type Exercise struct {
+	Name string
+	Sets []Set
+}
+
+type Set struct {
+	Weight float64
+	Reps   int
+}
+
+// Parse reads a CSV export in the given format and returns its workouts in
+// the order they first appear in the file. Strong and Hevy export local times,
+// which are read in the given location.
+func Parse(format Format, r io.Reader, loc *time.Location) ([]Workout, error) {
+	switch format {
+	case FormatStrong:
+		return parseStrong(r, loc)
+	case FormatHevy:
+		return parseHevy(r, loc)
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownFormat, format)
+	}
+}
+
+const (
+	strongDate         = "Date"
+	strongWorkoutName  = "Workout Name"
+	strongDuration     = "Duration"
+	strongExerciseName = "Exercise Name"
+	strongWeight       = "Weight"
+	strongReps         = "Reps"
+	strongWorkoutNotes = "Workout Notes"
+
+	strongDateLayout = "2006-01-02 15:04:05"
+)
+
+func parseStrong(r io.Reader, loc *time.Location) ([]Workout, error) {
+	rows, err := readRows(r, strongDate, strongWorkoutName, strongDuration, strongExerciseName, strongWeight, strongReps)
+	if err != nil {
+		return nil, err
+	}
+
+	var b builder
+	for i, row := range rows {
+		startedAt, err := time.ParseInLocation(strongDateLayout, row[strongDate], loc)
+		if err != nil {
+			return nil, fmt.Errorf("%w %d: date: %w", ErrInvalidRow, i+2, err)
+		}
+
+		duration, err := parseStrongDuration(row[strongDuration])
+		if err != nil {
+			return nil, fmt.Errorf("%w %d: duration: %w", ErrInvalidRow, i+2, err)
+		}
+
+		set, ok, err := parseSet(row[strongWeight], row[strongReps])
+		if err != nil {
+			return nil, fmt.Errorf("%w %d: %w", ErrInvalidRow, i+2, err)
+		}
+
+		workout := b.workout(row[strongWorkoutName], startedAt, startedAt.Add(duration), row[strongWorkoutNotes])
+		if ok {
+			b.addSet(workout, row[strongExerciseName], set)
+		}
+	}
+
+	return b.workouts, nil
+}
+
+// parseStrongDuration parses durations such as "1h 5m", "45m" or "30s".
+func parseStrongDuration(s string) (time.Duration, error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), " ", "")
+	if s == "" {
+		return 0, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("parse duration: %w", err)
+	}
+
+	return d, nil
+}
+
+const (
+	hevyTitle         = "title"
+	hevyStartTime     = "start_time"
+	hevyEndTime       = "end_time"
+	hevyDescription   = "description"
+	hevyExerciseTitle = "exercise_title"
+	hevyWeight        = "weight_kg"
+	hevyReps          = "reps"
+
+	hevyDateLayout = "2 Jan 2006, 15:04"
+)
+
+func parseHevy(r io.Reader, loc *time.Location) ([]Workout, error) {
+	rows, err := readRows(r, hevyTitle, hevyStartTime, hevyEndTime, hevyExerciseTitle, hevyWeight, hevyReps)
+	if err != nil {
+		return nil, err
+	}
+
+	var b builder
+	for i, row := range rows {
+		startedAt, err := time.ParseInLocation(hevyDateLayout, row[hevyStartTime], loc)
+		if err != nil {
+			return nil, fmt.Errorf("%w %d: start time: %w", ErrInvalidRow, i+2, err)
+		}
+
+		finishedAt, err := time.ParseInLocation(hevyDateLayout, row[hevyEndTime], loc)
+		if err != nil {
+			return nil, fmt.Errorf("%w %d: end time: %w", ErrInvalidRow, i+2, err)
+		}
+
+		set, ok, err := parseSet(row[hevyWeight], row[hevyReps])
+		if err != nil {
+			return nil, fmt.Errorf("%w %d: %w", ErrInvalidRow, i+2, err)
+		}
+
+		workout := b.workout(row[hevyTitle], startedAt, finishedAt, row[hevyDescription])
+		if ok {
+			b.addSet(workout, row[hevyExerciseTitle], set)
+		}
+	}
+
+	return b.workouts, nil
+}
+
+// parseSet parses the weight and reps of a set. Rows without reps, such as
+// cardio or rest timer rows, are reported as not ok and should be skipped.
+func parseSet(weight, reps string) (Set, bool, error) {
+	weight = strings.TrimSpace(weight)
+	reps = strings.TrimSpace(reps)
+	if reps == "" {
+		return Set{}, false, nil
+	}
+
+	r, err := strconv.ParseFloat(reps, 64)
+	if err != nil {
+		return Set{}, false, fmt.Errorf("reps: %w", err)
+	}
+
+	if r <= 0 {
+		return Set{}, false, nil
+	}
+
+	var w float64
+	if weight != "" {
+		if w, err = strconv.ParseFloat(weight, 64); err != nil {
+			return Set{}, false, fmt.Errorf("weight: %w", err)
+		}
+	}
+
+	return Set{Weight: w, Reps: int(r)}, true, nil
+}
+
+// readRows reads the CSV and returns its rows keyed by column name. Both comma
+// and semicolon separated files are supported since Strong uses the latter in
+// some locales.
+func readRows(r io.Reader, required ...string) ([]map[string]string, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(br.Size())
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return nil, fmt.Errorf("peek header: %w", err)
+	}
+
+	reader := csv.NewReader(br)
+	reader.FieldsPerRecord = -1
+	if line, _, _ := bytes.Cut(header, []byte("\n")); bytes.Count(line, []byte(";")) > bytes.Count(line, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("read csv: %w", err)
+	}
+
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMissingColumn, strings.Join(required, ", "))
+	}
+
+	columns := make(map[string]int, len(records[0]))
+	for i, column := range records[0] {
+		columns[strings.TrimSpace(strings.TrimPrefix(column, "\ufeff"))] = i
+	}
+
+	for _, column := range required {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingColumn, column)
+		}
+	}
+
+	rows := make([]map[string]string, 0, len(records)-1)
+	for _, record := range records[1:] {
+		row := make(map[string]string, len(columns))
+		for column, i := range columns {
+			if i < len(record) {
+				row[column] = strings.TrimSpace(record[i])
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// builder groups rows into workouts and exercises by first appearance.
+type builder struct {
+	workouts []Workout
+	indexes  map[string]int
+}
+
+func (b *builder) workout(name string, startedAt, finishedAt time.Time, note string) int {
+	if b.indexes == nil {
+		b.indexes = make(map[string]int)
+	}
+
+	w := Workout{
+		Name:       name,
+		Note:       note,
+		StartedAt:  startedAt,
+		FinishedAt: finishedAt,
+	}
+
+	key := w.Key()
+	if i, ok := b.indexes[key]; ok {
+		return i
+	}
+
+	b.workouts = append(b.workouts, w)
+	b.indexes[key] = len(b.workouts) - 1
+	return len(b.workouts) - 1
+}
+
+func (b *builder) addSet(workout int, exercise string, set Set) {
+	w := &b.workouts[workout]
+	for i := range w.Exercises {
+		if w.Exercises[i].Name == exercise {
+			w.Exercises[i].Sets = append(w.Exercises[i].Sets, set)
+			return
+		}
+	}
+
+	w.Exercises = append(w.Exercises, Exercise{
+		Name: exercise,
+		Sets: []Set{set},
+	})
+}
@@ -0,0 +1,204 @@
+package importer_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/crlssn/getstronger/server/importer"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	type expected struct {
+		workouts []importer.Workout
+		err      error
+	}
+
+	type test struct {
+		name     string
+		format   importer.Format
+		file     string
+		loc      *time.Location
+		expected expected
+	}
+
+	startedAt := time.Date(2024, time.January, 2, 8, 0, 0, 0, time.UTC)
+
+	stockholm, err := time.LoadLocation("Europe/Stockholm")
+	require.NoError(t, err)
+
+	tests := []test{
+		{
+			name:   "ok_strong",
+			format: importer.FormatStrong,
+			loc:    time.UTC,
+			file: `Date,Workout Name,Duration,Exercise Name,Set Order,Weight,Reps,Distance,Seconds,Notes,Workout Notes,RPE
+2024-01-02 08:00:00,Push,1h 5m,Bench Press,1,100,5,0,0,,Felt good,
+2024-01-02 08:00:00,Push,1h 5m,Overhead Press,1,60,8,0,0,,Felt good,
+2024-01-02 08:00:00,Push,1h 5m,Bench Press,2,102.5,3,0,0,,Felt good,
+2024-01-02 08:00:00,Push,1h 5m,Rest Timer,Rest Timer,,,,90,,Felt good,
+`,
+			expected: expected{
+				workouts: []importer.Workout{
+					{
+						Name:       "Push",
+						Note:       "Felt good",
+						StartedAt:  startedAt,
+						FinishedAt: startedAt.Add(time.Hour + 5*time.Minute),
+						Exercises: []importer.Exercise{
+							{Name: "Bench Press", Sets: []importer.Set{{Weight: 100, Reps: 5}, {Weight: 102.5, Reps: 3}}},
+							{Name: "Overhead Press", Sets: []importer.Set{{Weight: 60, Reps: 8}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "ok_strong_semicolon_separated",
+			format: importer.FormatStrong,
+			loc:    time.UTC,
+			file: `Date;Workout Name;Duration;Exercise Name;Set Order;Weight;Reps
+2024-01-02 08:00:00;Push;45m;Bench Press;1;100;5
+`,
+			expected: expected{
+				workouts: []importer.Workout{
+					{
+						Name:       "Push",
+						StartedAt:  startedAt,
+						FinishedAt: startedAt.Add(45 * time.Minute),
+						Exercises: []importer.Exercise{
+							{Name: "Bench Press", Sets: []importer.Set{{Weight: 100, Reps: 5}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "ok_hevy",
+			format: importer.FormatHevy,
+			loc:    time.UTC,
+			file: `"title","start_time","end_time","description","exercise_title","superset_id","exercise_notes","set_index","set_type","weight_kg","reps","distance_km","duration_seconds","rpe"
+"Legs","2 Jan 2024, 08:00","2 Jan 2024, 09:15","","Squat (Barbell)","","","0","warmup","60","10","","",""
+"Legs","2 Jan 2024, 08:00","2 Jan 2024, 09:15","","Squat (Barbell)","","","1","normal","140","5","","",""
+"Legs","2 Jan 2024, 08:00","2 Jan 2024, 09:15","","Pull Up","","","0","normal","","12","","",""
+`,
+			expected: expected{
+				workouts: []importer.Workout{
+					{
+						Name:       "Legs",
+						StartedAt:  startedAt,
+						FinishedAt: startedAt.Add(time.Hour + 15*time.Minute),
+						Exercises: []importer.Exercise{
+							{Name: "Squat (Barbell)", Sets: []importer.Set{{Weight: 60, Reps: 10}, {Weight: 140, Reps: 5}}},
+							{Name: "Pull Up", Sets: []importer.Set{{Weight: 0, Reps: 12}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "ok_strong_in_location",
+			format: importer.FormatStrong,
+			loc:    stockholm,
+			file: `Date,Workout Name,Duration,Exercise Name,Set Order,Weight,Reps
+2024-01-02 08:00:00,Push,45m,Bench Press,1,100,5
+`,
+			expected: expected{
+				workouts: []importer.Workout{
+					{
+						Name:       "Push",
+						StartedAt:  time.Date(2024, time.January, 2, 8, 0, 0, 0, stockholm),
+						FinishedAt: time.Date(2024, time.January, 2, 8, 45, 0, 0, stockholm),
+						Exercises: []importer.Exercise{
+							{Name: "Bench Press", Sets: []importer.Set{{Weight: 100, Reps: 5}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "ok_hevy_in_location",
+			format: importer.FormatHevy,
+			loc:    stockholm,
+			file: `"title","start_time","end_time","description","exercise_title","superset_id","exercise_notes","set_index","set_type","weight_kg","reps","distance_km","duration_seconds","rpe"
+"Legs","2 Jan 2024, 08:00","2 Jan 2024, 09:15","","Squat (Barbell)","","","0","normal","140","5","","",""
+`,
+			expected: expected{
+				workouts: []importer.Workout{
+					{
+						Name:       "Legs",
+						StartedAt:  time.Date(2024, time.January, 2, 8, 0, 0, 0, stockholm),
+						FinishedAt: time.Date(2024, time.January, 2, 9, 15, 0, 0, stockholm),
+						Exercises: []importer.Exercise{
+							{Name: "Squat (Barbell)", Sets: []importer.Set{{Weight: 140, Reps: 5}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "err_missing_column",
+			format: importer.FormatHevy,
+			loc:    time.UTC,
+			file: `Date,Workout Name,Duration,Exercise Name,Set Order,Weight,Reps
+2024-01-02 08:00:00,Push,45m,Bench Press,1,100,5
+`,
+			expected: expected{
+				err: importer.ErrMissingColumn,
+			},
+		},
+		{
+			name:   "err_invalid_row",
+			format: importer.FormatStrong,
+			loc:    time.UTC,
+			file: `Date,Workout Name,Duration,Exercise Name,Set Order,Weight,Reps
+yesterday,Push,45m,Bench Press,1,100,5
+`,
+			expected: expected{
+				err: importer.ErrInvalidRow,
+			},
+		},
+		{
+			name:     "err_unknown_format",
+			format:   0,
+			loc:      time.UTC,
+			file:     "",
+			expected: expected{err: importer.ErrUnknownFormat},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			workouts, err := importer.Parse(tt.format, strings.NewReader(tt.file), tt.loc)
+			if tt.expected.err != nil {
+				require.ErrorIs(t, err, tt.expected.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected.workouts, workouts)
+		})
+	}
+}
+
+func TestWorkout_Key(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2024, time.January, 2, 8, 0, 0, 0, time.UTC)
+	workout := importer.Workout{Name: "Push", StartedAt: startedAt}
+
+	require.Equal(t, workout.Key(), importer.Workout{Name: "Push", StartedAt: startedAt, Note: "Note"}.Key())
+	require.NotEqual(t, workout.Key(), importer.Workout{Name: "Pull", StartedAt: startedAt}.Key())
+	require.NotEqual(t, workout.Key(), importer.Workout{Name: "Push", StartedAt: startedAt.Add(time.Minute)}.Key())
+
+	// The key follows the wall clock the workout was parsed with, regardless
+	// of the time zone.
+	stockholm, err := time.LoadLocation("Europe/Stockholm")
+	require.NoError(t, err)
+	require.Equal(t, workout.Key(), importer.Workout{Name: "Push", StartedAt: time.Date(2024, time.January, 2, 8, 0, 0, 0, stockholm)}.Key())
+}
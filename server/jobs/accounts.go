@@ -0,0 +1,53 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/config"
+	"github.com/crlssn/getstronger/server/repo"
+	"github.com/crlssn/getstronger/server/xzap"
+)
+
+var _ Job = (*AccountDeletion)(nil)
+
+// AccountDeletion deletes the accounts of users whose deletion request is older
+// than the configured grace period.
+type AccountDeletion struct {
+	log         *zap.Logger
+	repo        repo.Repo
+	gracePeriod time.Duration
+}
+
+func NewAccountDeletion(log *zap.Logger, r repo.Repo, c *config.Config) *AccountDeletion {
+	return &AccountDeletion{log, r, c.Jobs.AccountDeletionGracePeriod}
+}
+
+func (j *AccountDeletion) Run(ctx context.Context) error {
+	users, err := j.repo.ListUsers(ctx,
+		repo.ListUsersWithDeletionRequestedBefore(time.Now().UTC().Add(-j.gracePeriod)),
+	)
+	if err != nil {
+		return fmt.Errorf("list users: %w", err)
+	}
+
+	// A failing account is retried on the next run and doesn't hold up the
+	// deletion of the others.
+	for _, user := range users {
+		if err = ctx.Err(); err != nil {
+			return fmt.Errorf("delete accounts: %w", err)
+		}
+
+		if err = j.repo.DeleteAccount(ctx, user.ID); err != nil {
+			j.log.Error("account deletion failed", xzap.FieldUserID(user.ID), zap.Error(err))
+			continue
+		}
+
+		j.log.Info("account deleted", xzap.FieldUserID(user.ID))
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+//nolint:contextcheck
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/config"
+	"github.com/crlssn/getstronger/server/gen/orm"
+	"github.com/crlssn/getstronger/server/jobs"
+	"github.com/crlssn/getstronger/server/repo"
+	"github.com/crlssn/getstronger/server/testing/container"
+	"github.com/crlssn/getstronger/server/testing/factory"
+)
+
+func TestAccountDeletion_Run(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := container.NewContainer(ctx)
+	f := factory.NewFactory(c.DB)
+	gracePeriod := 24 * time.Hour
+	job := jobs.NewAccountDeletion(zap.NewExample(), repo.New(c.DB), &config.Config{
+		Jobs: config.Jobs{AccountDeletionGracePeriod: gracePeriod},
+	})
+
+	now := time.Now().UTC()
+	expired := f.NewUser()
+	withinGracePeriod := f.NewUser()
+	cancelled := f.NewUser()
+	notRequested := f.NewUser()
+
+	requests := map[*orm.User]null.Time{
+		expired:           null.TimeFrom(now.Add(-gracePeriod - time.Minute)),
+		withinGracePeriod: null.TimeFrom(now.Add(-gracePeriod + time.Minute)),
+		// Cancelling a deletion request clears the time it was requested.
+		cancelled: {},
+	}
+	for user, requestedAt := range requests {
+		user.DeletionRequestedAt = requestedAt
+		_, err := user.Update(ctx, c.DB, boil.Whitelist(orm.UserColumns.DeletionRequestedAt))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, job.Run(ctx))
+
+	for user, deleted := range map[*orm.User]bool{
+		expired:           true,
+		withinGracePeriod: false,
+		cancelled:         false,
+		notRequested:      false,
+	} {
+		require.NoError(t, user.Reload(ctx, c.DB))
+		require.Equal(t, deleted, user.DeletedAt.Valid, user.ID)
+	}
+
+	t.Cleanup(func() {
+		if err := c.Terminate(ctx); err != nil {
+			t.Fatal(fmt.Errorf("failed to terminate container: %w", err))
+		}
+	})
+}
+
+func TestAccountDeletion_RunContinuesAfterFailure(t *testing.T) {
+	t.Parallel()
+
+	errDelete := errors.New("delete error")
+	users := orm.UserSlice{{ID: "failing"}, {ID: "succeeding"}}
+
+	r := repo.NewMockRepo(gomock.NewController(t))
+	r.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(users, nil)
+	r.EXPECT().DeleteAccount(gomock.Any(), "failing").Return(errDelete)
+	r.EXPECT().DeleteAccount(gomock.Any(), "succeeding").Return(nil)
+
+	job := jobs.NewAccountDeletion(zap.NewExample(), r, &config.Config{
+		Jobs: config.Jobs{AccountDeletionGracePeriod: time.Hour},
+	})
+	require.NoError(t, job.Run(context.Background()))
+}
+
+func TestAccountDeletion_RunStopsWhenCancelled(t *testing.T) {
+	t.Parallel()
+
+	r := repo.NewMockRepo(gomock.NewController(t))
+	r.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(orm.UserSlice{{ID: "user"}}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	job := jobs.NewAccountDeletion(zap.NewExample(), r, &config.Config{
+		Jobs: config.Jobs{AccountDeletionGracePeriod: time.Hour},
+	})
+	require.ErrorIs(t, job.Run(ctx), context.Canceled)
+}
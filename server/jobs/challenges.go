@@ -0,0 +1,107 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/gen/orm"
+	"github.com/crlssn/getstronger/server/repo"
+	"github.com/crlssn/getstronger/server/xzap"
+)
+
+var _ Job = (*ChallengeStandings)(nil)
+
+// ChallengeStandings tracks the leader of every running challenge and notifies
+// the participants when someone takes the lead. Challenges past their end are
+// marked as ended and the participants are notified of the winner.
+type ChallengeStandings struct {
+	log  *zap.Logger
+	repo repo.Repo
+}
+
+func NewChallengeStandings(log *zap.Logger, r repo.Repo) *ChallengeStandings {
+	return &ChallengeStandings{log, r}
+}
+
+func (j *ChallengeStandings) Run(ctx context.Context) error {
+	now := time.Now().UTC()
+
+	challenges, err := j.repo.ListChallenges(ctx, repo.ListChallengesWithoutEnded(now))
+	if err != nil {
+		return fmt.Errorf("list challenges: %w", err)
+	}
+
+	for _, challenge := range challenges {
+		if err = j.update(ctx, challenge, now); err != nil {
+			j.log.Error("challenge standings failed", xzap.FieldChallengeID(challenge.ID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+func (j *ChallengeStandings) update(ctx context.Context, challenge *orm.Challenge, now time.Time) error {
+	standings, err := j.repo.GetChallengeStandings(ctx, challenge.ID)
+	if err != nil {
+		return fmt.Errorf("get challenge standings: %w", err)
+	}
+
+	leaderID := soleLeaderID(standings)
+	ended := !challenge.EndsAt.After(now)
+	if !ended && (leaderID == "" || leaderID == challenge.LeaderID.String) {
+		// The lead is unchanged, or shared, while the challenge is running.
+		return nil
+	}
+
+	return j.repo.NewTx(ctx, func(tx repo.Tx) error {
+		opts := []repo.UpdateChallengeOpt{repo.UpdateChallengeLeaderID(leaderID)}
+		if ended {
+			opts = append(opts, repo.UpdateChallengeEndedAt(now))
+		}
+
+		if err = tx.UpdateChallenge(ctx, challenge.ID, opts...); err != nil {
+			return fmt.Errorf("update challenge: %w", err)
+		}
+
+		nType := orm.NotificationTypeChallengeLeadTaken
+		if ended {
+			nType = orm.NotificationTypeChallengeEnded
+		}
+
+		for _, standing := range standings {
+			if !ended && standing.UserID == leaderID {
+				continue
+			}
+
+			if err = tx.CreateNotification(ctx, repo.CreateNotificationParams{
+				Type:   nType,
+				UserID: standing.UserID,
+				Payload: repo.NotificationPayload{
+					ActorID:     leaderID,
+					ChallengeID: challenge.ID,
+				},
+			}); err != nil {
+				return fmt.Errorf("create notification: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// soleLeaderID returns the id of the participant that leads the standings on
+// their own with some progress, or an empty string if there is no such leader.
+func soleLeaderID(standings []repo.ChallengeStanding) string {
+	if len(standings) == 0 || standings[0].Value <= 0 {
+		return ""
+	}
+
+	if len(standings) > 1 && standings[1].Rank == standings[0].Rank {
+		return ""
+	}
+
+	return standings[0].UserID
+}
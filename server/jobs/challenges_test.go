@@ -0,0 +1,91 @@
+//nolint:contextcheck
+package jobs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/gen/orm"
+	"github.com/crlssn/getstronger/server/jobs"
+	"github.com/crlssn/getstronger/server/repo"
+	"github.com/crlssn/getstronger/server/testing/container"
+	"github.com/crlssn/getstronger/server/testing/factory"
+)
+
+func TestChallengeStandings_Run(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := container.NewContainer(ctx)
+	f := factory.NewFactory(c.DB)
+	r := repo.New(c.DB)
+	job := jobs.NewChallengeStandings(zap.NewExample(), r)
+
+	now := time.Now().UTC()
+	creator := f.NewUser()
+	participant := f.NewUser()
+
+	challenge, err := r.CreateChallenge(ctx, repo.CreateChallengeParams{
+		CreatorID: creator.ID,
+		Title:     "Most sessions",
+		Metric:    orm.ChallengeMetricSessionCount,
+		Goal:      null.Float64From(2),
+		StartsAt:  now.Add(-24 * time.Hour),
+		EndsAt:    now.Add(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	_, err = r.InviteToChallenge(ctx, challenge.ID, []string{participant.ID})
+	require.NoError(t, err)
+	require.NoError(t, r.JoinChallenge(ctx, challenge.ID, participant.ID))
+
+	f.NewWorkout(factory.WorkoutUserID(participant.ID), factory.WorkoutFinishedAt(now.Add(-time.Hour)))
+	require.NoError(t, job.Run(ctx))
+
+	challenge, err = r.GetChallenge(ctx, repo.GetChallengeWithID(challenge.ID))
+	require.NoError(t, err)
+	require.Equal(t, participant.ID, challenge.LeaderID.String)
+	require.False(t, challenge.EndedAt.Valid)
+
+	notifications, err := r.ListNotifications(ctx, repo.ListNotificationsWithUserID(creator.ID))
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	require.Equal(t, orm.NotificationTypeChallengeLeadTaken, notifications[0].Type)
+
+	// The lead is unchanged, so no further notifications are created.
+	require.NoError(t, job.Run(ctx))
+	notifications, err = r.ListNotifications(ctx, repo.ListNotificationsWithUserID(creator.ID))
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+
+	challenge.EndsAt = now.Add(-time.Minute)
+	_, err = challenge.Update(ctx, c.DB, boil.Whitelist(orm.ChallengeColumns.EndsAt))
+	require.NoError(t, err)
+	require.NoError(t, job.Run(ctx))
+
+	challenge, err = r.GetChallenge(ctx, repo.GetChallengeWithID(challenge.ID))
+	require.NoError(t, err)
+	require.True(t, challenge.EndedAt.Valid)
+
+	for _, userID := range []string{creator.ID, participant.ID} {
+		notifications, err = r.ListNotifications(ctx,
+			repo.ListNotificationsWithUserID(userID),
+			repo.ListNotificationsWithPageToken(nil),
+		)
+		require.NoError(t, err)
+		require.Equal(t, orm.NotificationTypeChallengeEnded, notifications[0].Type)
+	}
+
+	t.Cleanup(func() {
+		if err := c.Terminate(ctx); err != nil {
+			t.Fatal(fmt.Errorf("failed to terminate container: %w", err))
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/export"
+	"github.com/crlssn/getstronger/server/repo"
+)
+
+var _ Job = (*DataExportPurge)(nil)
+
+// DataExportPurge deletes data exports that can no longer be downloaded.
+type DataExportPurge struct {
+	log  *zap.Logger
+	repo repo.Repo
+}
+
+func NewDataExportPurge(log *zap.Logger, r repo.Repo) *DataExportPurge {
+	return &DataExportPurge{log, r}
+}
+
+func (j *DataExportPurge) Run(ctx context.Context) error {
+	exports, err := j.repo.PurgeDataExports(ctx, time.Now().UTC().Add(-export.Expiry))
+	if err != nil {
+		return fmt.Errorf("purge data exports: %w", err)
+	}
+
+	j.log.Info("data exports purged", zap.Int64("exports", exports))
+	return nil
+}
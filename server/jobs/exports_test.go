@@ -0,0 +1,57 @@
+//nolint:contextcheck
+package jobs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/export"
+	"github.com/crlssn/getstronger/server/gen/orm"
+	"github.com/crlssn/getstronger/server/jobs"
+	"github.com/crlssn/getstronger/server/repo"
+	"github.com/crlssn/getstronger/server/testing/container"
+	"github.com/crlssn/getstronger/server/testing/factory"
+)
+
+func TestDataExportPurge_Run(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := container.NewContainer(ctx)
+	f := factory.NewFactory(c.DB)
+	job := jobs.NewDataExportPurge(zap.NewExample(), repo.New(c.DB))
+
+	expired := &orm.DataExport{
+		UserID:    f.NewUser().ID,
+		CreatedAt: time.Now().UTC().Add(-export.Expiry - time.Hour),
+	}
+	recent := &orm.DataExport{
+		UserID:    f.NewUser().ID,
+		CreatedAt: time.Now().UTC().Add(-time.Hour),
+	}
+	for _, dataExport := range []*orm.DataExport{expired, recent} {
+		require.NoError(t, dataExport.Insert(ctx, c.DB, boil.Infer()))
+	}
+
+	require.NoError(t, job.Run(ctx))
+
+	exists, err := orm.DataExportExists(ctx, c.DB, expired.ID)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	exists, err = orm.DataExportExists(ctx, c.DB, recent.ID)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	t.Cleanup(func() {
+		if err := c.Terminate(ctx); err != nil {
+			t.Fatal(fmt.Errorf("failed to terminate container: %w", err))
+		}
+	})
+}
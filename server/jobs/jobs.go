@@ -0,0 +1,82 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const timeout = 5 * time.Minute
+
+type Job interface {
+	Run(ctx context.Context) error
+}
+
+type Schedule struct {
+	Name     string
+	Interval time.Duration
+	Job      Job
+}
+
+type Scheduler struct {
+	log  *zap.Logger
+	wg   sync.WaitGroup
+	stop chan struct{}
+}
+
+func New(log *zap.Logger) *Scheduler {
+	return &Scheduler{
+		log:  log,
+		wg:   sync.WaitGroup{},
+		stop: make(chan struct{}),
+	}
+}
+
+// Start runs every job once immediately and then on its interval until the
+// scheduler is stopped.
+func (s *Scheduler) Start(schedules []Schedule) {
+	for _, schedule := range schedules {
+		s.wg.Add(1)
+		go s.run(schedule)
+	}
+}
+
+func (s *Scheduler) run(schedule Schedule) {
+	defer s.wg.Done()
+
+	log := s.log.With(zap.String("job", schedule.Name))
+	log.Info("job scheduled", zap.Duration("interval", schedule.Interval))
+
+	ticker := time.NewTicker(schedule.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.execute(log, schedule.Job)
+
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) execute(log *zap.Logger, job Job) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	start := time.Now()
+	if err := job.Run(ctx); err != nil {
+		log.Error("job failed", zap.Error(err))
+		return
+	}
+
+	log.Info("job finished", zap.Duration("duration", time.Since(start)))
+}
+
+func (s *Scheduler) Stop() {
+	close(s.stop)
+	s.wg.Wait()
+}
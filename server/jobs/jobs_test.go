@@ -0,0 +1,49 @@
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/jobs"
+)
+
+type job struct {
+	runs atomic.Int32
+	err  error
+}
+
+func (j *job) Run(_ context.Context) error {
+	j.runs.Add(1)
+	return j.err
+}
+
+var errJob = errors.New("job error")
+
+func TestScheduler(t *testing.T) {
+	t.Parallel()
+
+	succeeding := &job{}
+	failing := &job{err: errJob}
+
+	scheduler := jobs.New(zap.NewExample())
+	scheduler.Start([]jobs.Schedule{
+		{Name: "succeeding", Interval: 10 * time.Millisecond, Job: succeeding},
+		{Name: "failing", Interval: time.Hour, Job: failing},
+	})
+
+	require.Eventually(t, func() bool {
+		return succeeding.runs.Load() >= 2 && failing.runs.Load() == 1
+	}, time.Second, 5*time.Millisecond)
+
+	scheduler.Stop()
+	runs := succeeding.runs.Load()
+	time.Sleep(30 * time.Millisecond)
+	require.Equal(t, runs, succeeding.runs.Load())
+	require.Equal(t, int32(1), failing.runs.Load())
+}
@@ -0,0 +1,35 @@
+package jobs
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+func Module() fx.Option {
+	return fx.Module("jobs", fx.Options(
+		fx.Provide(
+			New,
+			NewRegistry,
+			NewYearSummaries,
+			NewTrashPurge,
+			NewDataExportPurge,
+			NewAccountDeletion,
+			NewChallengeStandings,
+		),
+		fx.Invoke(
+			func(lc fx.Lifecycle, scheduler *Scheduler, registry *Registry) {
+				lc.Append(fx.Hook{
+					OnStart: func(_ context.Context) error {
+						scheduler.Start(registry.Schedules())
+						return nil
+					},
+					OnStop: func(_ context.Context) error {
+						scheduler.Stop()
+						return nil
+					},
+				})
+			},
+		),
+	))
+}
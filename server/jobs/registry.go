@@ -0,0 +1,37 @@
+package jobs
+
+import (
+	"time"
+
+	"go.uber.org/fx"
+)
+
+type Registry struct {
+	schedules []Schedule
+}
+
+type RegistryParams struct {
+	fx.In
+
+	YearSummaries      *YearSummaries
+	TrashPurge         *TrashPurge
+	DataExportPurge    *DataExportPurge
+	AccountDeletion    *AccountDeletion
+	ChallengeStandings *ChallengeStandings
+}
+
+func NewRegistry(p RegistryParams) *Registry {
+	return &Registry{
+		schedules: []Schedule{
+			{Name: "year_summaries", Interval: time.Hour, Job: p.YearSummaries},
+			{Name: "trash_purge", Interval: time.Hour, Job: p.TrashPurge},
+			{Name: "data_export_purge", Interval: time.Hour, Job: p.DataExportPurge},
+			{Name: "account_deletion", Interval: time.Hour, Job: p.AccountDeletion},
+			{Name: "challenge_standings", Interval: 5 * time.Minute, Job: p.ChallengeStandings},
+		},
+	}
+}
+
+func (r *Registry) Schedules() []Schedule {
+	return r.schedules
+}
@@ -0,0 +1,52 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/config"
+	"github.com/crlssn/getstronger/server/repo"
+)
+
+var _ Job = (*TrashPurge)(nil)
+
+// TrashPurge permanently deletes workouts, exercises and routines that have
+// been in the trash for longer than the configured retention.
+type TrashPurge struct {
+	log       *zap.Logger
+	repo      repo.Repo
+	retention time.Duration
+}
+
+func NewTrashPurge(log *zap.Logger, r repo.Repo, c *config.Config) *TrashPurge {
+	return &TrashPurge{log, r, c.Jobs.TrashRetention}
+}
+
+func (j *TrashPurge) Run(ctx context.Context) error {
+	deletedBefore := time.Now().UTC().Add(-j.retention)
+
+	workouts, err := j.repo.PurgeWorkouts(ctx, deletedBefore)
+	if err != nil {
+		return fmt.Errorf("purge workouts: %w", err)
+	}
+
+	routines, err := j.repo.PurgeRoutines(ctx, deletedBefore)
+	if err != nil {
+		return fmt.Errorf("purge routines: %w", err)
+	}
+
+	exercises, err := j.repo.PurgeExercises(ctx, deletedBefore)
+	if err != nil {
+		return fmt.Errorf("purge exercises: %w", err)
+	}
+
+	j.log.Info("trash purged",
+		zap.Int64("workouts", workouts),
+		zap.Int64("routines", routines),
+		zap.Int64("exercises", exercises),
+	)
+	return nil
+}
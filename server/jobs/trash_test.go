@@ -0,0 +1,80 @@
+//nolint:contextcheck
+package jobs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/config"
+	"github.com/crlssn/getstronger/server/gen/orm"
+	"github.com/crlssn/getstronger/server/jobs"
+	"github.com/crlssn/getstronger/server/repo"
+	"github.com/crlssn/getstronger/server/testing/container"
+	"github.com/crlssn/getstronger/server/testing/factory"
+)
+
+func TestTrashPurge_Run(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := container.NewContainer(ctx)
+	f := factory.NewFactory(c.DB)
+	job := jobs.NewTrashPurge(zap.NewExample(), repo.New(c.DB), &config.Config{
+		Jobs: config.Jobs{TrashRetention: 24 * time.Hour},
+	})
+
+	expired := null.TimeFrom(time.Now().UTC().Add(-48 * time.Hour))
+	recent := null.TimeFrom(time.Now().UTC().Add(-time.Hour))
+
+	expiredRoutine := f.NewRoutine()
+	recentRoutine := f.NewRoutine()
+	expiredExercise := f.NewExercise()
+	expiredExerciseWithSets := f.NewExercise()
+	f.NewSet(factory.SetExerciseID(expiredExerciseWithSets.ID))
+	f.AddRoutineExercise(expiredRoutine, f.NewExercise())
+
+	routines := map[*orm.Routine]null.Time{expiredRoutine: expired, recentRoutine: recent}
+	for routine, deletedAt := range routines {
+		routine.DeletedAt = deletedAt
+		_, err := routine.Update(ctx, c.DB, boil.Whitelist(orm.RoutineColumns.DeletedAt))
+		require.NoError(t, err)
+	}
+
+	exercises := orm.ExerciseSlice{expiredExercise, expiredExerciseWithSets}
+	for _, exercise := range exercises {
+		exercise.DeletedAt = expired
+		_, err := exercise.Update(ctx, c.DB, boil.Whitelist(orm.ExerciseColumns.DeletedAt))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, job.Run(ctx))
+
+	exists, err := orm.RoutineExists(ctx, c.DB, expiredRoutine.ID)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	exists, err = orm.RoutineExists(ctx, c.DB, recentRoutine.ID)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = orm.ExerciseExists(ctx, c.DB, expiredExercise.ID)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	exists, err = orm.ExerciseExists(ctx, c.DB, expiredExerciseWithSets.ID)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	t.Cleanup(func() {
+		if err := c.Terminate(ctx); err != nil {
+			t.Fatal(fmt.Errorf("failed to terminate container: %w", err))
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/gen/orm"
+	"github.com/crlssn/getstronger/server/repo"
+)
+
+var _ Job = (*YearSummaries)(nil)
+
+// YearSummaries builds a summary of the previous year for every user who
+// trained during it, and notifies them once it is ready.
+type YearSummaries struct {
+	log  *zap.Logger
+	repo repo.Repo
+}
+
+func NewYearSummaries(log *zap.Logger, r repo.Repo) *YearSummaries {
+	return &YearSummaries{log, r}
+}
+
+func (j *YearSummaries) Run(ctx context.Context) error {
+	year := time.Now().UTC().Year() - 1
+
+	userIDs, err := j.repo.ListYearSummaryCandidates(ctx, year)
+	if err != nil {
+		return fmt.Errorf("list year summary candidates: %w", err)
+	}
+
+	for _, userID := range userIDs {
+		if err = j.repo.NewTx(ctx, func(tx repo.Tx) error {
+			payload, err := tx.BuildYearSummary(ctx, userID, year)
+			if err != nil {
+				return fmt.Errorf("build year summary: %w", err)
+			}
+
+			summary, err := tx.CreateYearSummary(ctx, repo.CreateYearSummaryParams{
+				UserID:  userID,
+				Year:    year,
+				Payload: *payload,
+			})
+			if err != nil {
+				return fmt.Errorf("create year summary: %w", err)
+			}
+
+			if err = tx.CreateNotification(ctx, repo.CreateNotificationParams{
+				Type:   orm.NotificationTypeYearSummary,
+				UserID: userID,
+				Payload: repo.NotificationPayload{
+					YearSummaryID: summary.ID,
+				},
+			}); err != nil {
+				return fmt.Errorf("create notification: %w", err)
+			}
+
+			return nil
+		}); err != nil {
+			j.log.Error("year summary failed", zap.String("user_id", userID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
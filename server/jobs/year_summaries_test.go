@@ -0,0 +1,100 @@
+//nolint:contextcheck
+package jobs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/gen/orm"
+	"github.com/crlssn/getstronger/server/jobs"
+	"github.com/crlssn/getstronger/server/repo"
+	"github.com/crlssn/getstronger/server/testing/container"
+	"github.com/crlssn/getstronger/server/testing/factory"
+)
+
+func TestYearSummaries_Run(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := container.NewContainer(ctx)
+	f := factory.NewFactory(c.DB)
+	r := repo.New(c.DB)
+	job := jobs.NewYearSummaries(zap.NewExample(), r)
+
+	lastYear := time.Now().UTC().Year() - 1
+	midLastYear := time.Date(lastYear, time.June, 3, 12, 0, 0, 0, time.UTC)
+
+	user := f.NewUser()
+	followee := f.NewUser()
+	inactive := f.NewUser()
+	f.NewWorkout(factory.WorkoutUserID(inactive.ID))
+	require.NoError(t, r.Follow(ctx, repo.FollowParams{FollowerID: user.ID, FolloweeID: followee.ID}))
+
+	exercise := f.NewExercise(factory.ExerciseUserID(user.ID))
+	for i, weight := range []float64{100, 110, 105} {
+		workout := f.NewWorkout(
+			factory.WorkoutUserID(user.ID),
+			factory.WorkoutFinishedAt(midLastYear.AddDate(0, 0, 7*i)),
+		)
+		f.NewSet(
+			factory.SetUserID(user.ID),
+			factory.SetWorkoutID(workout.ID),
+			factory.SetExerciseID(exercise.ID),
+			factory.SetWeight(weight),
+			factory.SetReps(5),
+		)
+	}
+	f.NewWorkout(factory.WorkoutUserID(followee.ID), factory.WorkoutFinishedAt(midLastYear))
+
+	t.Run("ok_summaries_created", func(t *testing.T) {
+		require.NoError(t, job.Run(ctx))
+
+		summary, err := orm.YearSummaries(
+			orm.YearSummaryWhere.UserID.EQ(user.ID),
+			orm.YearSummaryWhere.Year.EQ(lastYear),
+		).One(ctx, c.DB)
+		require.NoError(t, err)
+
+		var payload repo.YearSummaryPayload
+		require.NoError(t, summary.Payload.Unmarshal(&payload))
+		require.Equal(t, 3, payload.Sessions)
+		require.InDelta(t, 1575, payload.Tonnage, 0.01)
+		require.Len(t, payload.MostTrainedExercises, 1)
+		require.Equal(t, exercise.ID, payload.MostTrainedExercises[0].ExerciseID)
+		require.Equal(t, 3, payload.MostTrainedExercises[0].Sets)
+		require.Equal(t, 1, payload.PersonalBests)
+		require.Equal(t, 3, payload.LongestStreakWeeks)
+		require.NotNil(t, payload.MostActiveFollowee)
+		require.Equal(t, followee.ID, payload.MostActiveFollowee.UserID)
+
+		exists, err := orm.Notifications(
+			orm.NotificationWhere.UserID.EQ(user.ID),
+			orm.NotificationWhere.Type.EQ(orm.NotificationTypeYearSummary),
+		).Exists(ctx, c.DB)
+		require.NoError(t, err)
+		require.True(t, exists)
+
+		exists, err = orm.YearSummaries(orm.YearSummaryWhere.UserID.EQ(inactive.ID)).Exists(ctx, c.DB)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	t.Run("ok_idempotent", func(t *testing.T) {
+		require.NoError(t, job.Run(ctx))
+
+		count, err := orm.YearSummaries(orm.YearSummaryWhere.UserID.EQ(user.ID)).Count(ctx, c.DB)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count)
+	})
+
+	t.Cleanup(func() {
+		if err := c.Terminate(ctx); err != nil {
+			t.Fatal(fmt.Errorf("failed to terminate container: %w", err))
+		}
+	})
+}
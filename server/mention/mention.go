@@ -0,0 +1,116 @@
+package mention
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf16"
+)
+
+// Mention is a reference to a user in a text. Start and End are offsets in
+// UTF-16 code units so that clients can use them to slice JavaScript strings
+// directly.
+type Mention struct {
+	UserID string `json:"userId"`
+	Start  int    `json:"start"`
+	End    int    `json:"end"`
+}
+
+// User is a user that can be mentioned.
+type User struct {
+	ID        string
+	FirstName string
+	LastName  string
+}
+
+// Parse finds the mentions in the text and resolves them against the users. A
+// mention is an @ followed by either the full name of a user without spaces,
+// e.g. @JaneDoe, or their first name if it is unique among the users, e.g.
+// @Jane. Mentions that can't be resolved are ignored.
+func Parse(text string, users []User) []Mention {
+	if !strings.Contains(text, "@") {
+		return nil
+	}
+
+	runes := []rune(text)
+
+	var mentions []Mention
+	var offset int
+	for i := 0; i < len(runes); i++ {
+		start := offset
+		offset += utf16.RuneLen(runes[i])
+
+		if runes[i] != '@' || (i > 0 && isNameRune(runes[i-1])) {
+			continue
+		}
+
+		j := i + 1
+		for j < len(runes) && isNameRune(runes[j]) {
+			j++
+		}
+
+		name := string(runes[i+1 : j])
+		end := offset
+		for _, r := range runes[i+1 : j] {
+			end += utf16.RuneLen(r)
+		}
+
+		userID, ok := resolve(name, users)
+		if !ok {
+			continue
+		}
+
+		mentions = append(mentions, Mention{
+			UserID: userID,
+			Start:  start,
+			End:    end,
+		})
+
+		i = j - 1
+		offset = end
+	}
+
+	return mentions
+}
+
+// UserIDs returns the distinct ids of the mentioned users.
+func UserIDs(mentions []Mention) []string {
+	seen := make(map[string]struct{}, len(mentions))
+	userIDs := make([]string, 0, len(mentions))
+	for _, m := range mentions {
+		if _, ok := seen[m.UserID]; ok {
+			continue
+		}
+
+		seen[m.UserID] = struct{}{}
+		userIDs = append(userIDs, m.UserID)
+	}
+
+	return userIDs
+}
+
+func resolve(name string, users []User) (string, bool) {
+	if name == "" {
+		return "", false
+	}
+
+	var firstNameMatches []string
+	for _, user := range users {
+		if strings.EqualFold(name, strings.ReplaceAll(user.FirstName+user.LastName, " ", "")) {
+			return user.ID, true
+		}
+
+		if strings.EqualFold(name, strings.ReplaceAll(user.FirstName, " ", "")) {
+			firstNameMatches = append(firstNameMatches, user.ID)
+		}
+	}
+
+	if len(firstNameMatches) != 1 {
+		return "", false
+	}
+
+	return firstNameMatches[0], true
+}
+
+func isNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-'
+}
@@ -0,0 +1,87 @@
+package mention_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/crlssn/getstronger/server/mention"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	users := []mention.User{
+		{ID: "1", FirstName: "Jane", LastName: "Doe"},
+		{ID: "2", FirstName: "John", LastName: "Doe"},
+		{ID: "3", FirstName: "John", LastName: "Smith"},
+		{ID: "4", FirstName: "Åsa", LastName: "Berg"},
+	}
+
+	tests := []struct {
+		name     string
+		text     string
+		expected []mention.Mention
+	}{
+		{
+			name:     "ok_without_mentions",
+			text:     "Great session",
+			expected: nil,
+		},
+		{
+			name: "ok_full_name",
+			text: "Nice one @JohnSmith!",
+			expected: []mention.Mention{
+				{UserID: "3", Start: 9, End: 19},
+			},
+		},
+		{
+			name: "ok_unique_first_name",
+			text: "@jane and @JaneDoe",
+			expected: []mention.Mention{
+				{UserID: "1", Start: 0, End: 5},
+				{UserID: "1", Start: 10, End: 18},
+			},
+		},
+		{
+			name:     "ok_ambiguous_first_name",
+			text:     "@John",
+			expected: nil,
+		},
+		{
+			name:     "ok_unknown_user",
+			text:     "@Bob",
+			expected: nil,
+		},
+		{
+			name:     "ok_email_address",
+			text:     "jane@doe.com",
+			expected: nil,
+		},
+		{
+			name: "ok_utf16_offsets",
+			text: "💪 @Åsa",
+			expected: []mention.Mention{
+				{UserID: "4", Start: 3, End: 7},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, test.expected, mention.Parse(test.text, users))
+		})
+	}
+}
+
+func TestUserIDs(t *testing.T) {
+	t.Parallel()
+
+	userIDs := mention.UserIDs([]mention.Mention{
+		{UserID: "1", Start: 0, End: 5},
+		{UserID: "2", Start: 6, End: 11},
+		{UserID: "1", Start: 12, End: 17},
+	})
+	require.Equal(t, []string{"1", "2"}, userIDs)
+}
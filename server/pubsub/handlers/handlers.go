@@ -1,12 +1,15 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"time"
 
 	"go.uber.org/zap"
 
+	"github.com/crlssn/getstronger/server/email"
+	"github.com/crlssn/getstronger/server/export"
 	"github.com/crlssn/getstronger/server/gen/orm"
 	"github.com/crlssn/getstronger/server/pubsub/payloads"
 	"github.com/crlssn/getstronger/server/repo"
@@ -23,6 +26,13 @@ var (
 	_ Handler = (*FollowedUser)(nil)
 	_ Handler = (*RequestTraced)(nil)
 	_ Handler = (*WorkoutCommentPosted)(nil)
+	_ Handler = (*DataExportRequested)(nil)
+	_ Handler = (*WorkoutReacted)(nil)
+	_ Handler = (*UsersMentioned)(nil)
+	_ Handler = (*FollowRequested)(nil)
+	_ Handler = (*FollowRequestApproved)(nil)
+	_ Handler = (*ChallengeInvited)(nil)
+	_ Handler = (*WorkoutsCreated)(nil)
 )
 
 type RequestTraced struct {
@@ -82,7 +92,8 @@ func (w *WorkoutCommentPosted) HandlePayload(payload string) {
 
 	workout, err := w.repo.GetWorkout(ctx,
 		repo.GetWorkoutWithID(comment.WorkoutID),
-		repo.GetWorkoutLoadComments(),
+		repo.GetWorkoutWithoutDeleted(),
+		repo.GetWorkoutLoadCommentsVisibleTo(comment.UserID),
 	)
 	if err != nil {
 		w.log.Error("get workout", zap.Error(err))
@@ -101,6 +112,30 @@ func (w *WorkoutCommentPosted) HandlePayload(payload string) {
 		mapUserIDs[c.UserID] = struct{}{}
 	}
 
+	if comment.ParentCommentID.Valid {
+		for _, c := range workout.R.WorkoutComments {
+			if c.ID != comment.ParentCommentID.String || c.UserID == comment.UserID {
+				continue
+			}
+
+			// The author of the parent comment is notified about the reply
+			// instead of the comment.
+			delete(mapUserIDs, c.UserID)
+
+			if err = w.repo.CreateNotification(ctx, repo.CreateNotificationParams{
+				Type:   orm.NotificationTypeWorkoutCommentReply,
+				UserID: c.UserID,
+				Payload: repo.NotificationPayload{
+					ActorID:   comment.UserID,
+					WorkoutID: comment.WorkoutID,
+					CommentID: comment.ID,
+				},
+			}); err != nil {
+				w.log.Error("create notification", zap.Error(err))
+			}
+		}
+	}
+
 	for userID := range mapUserIDs {
 		if err = w.repo.CreateNotification(ctx, repo.CreateNotificationParams{
 			Type:   orm.NotificationTypeWorkoutComment,
@@ -108,6 +143,7 @@ func (w *WorkoutCommentPosted) HandlePayload(payload string) {
 			Payload: repo.NotificationPayload{
 				ActorID:   comment.UserID,
 				WorkoutID: comment.WorkoutID,
+				CommentID: comment.ID,
 			},
 		}); err != nil {
 			w.log.Error("create notification", zap.Error(err))
@@ -115,6 +151,107 @@ func (w *WorkoutCommentPosted) HandlePayload(payload string) {
 	}
 }
 
+type WorkoutReacted struct {
+	log  *zap.Logger
+	repo repo.Repo
+}
+
+func NewWorkoutReacted(log *zap.Logger, repo repo.Repo) *WorkoutReacted {
+	return &WorkoutReacted{log, repo}
+}
+
+func (w *WorkoutReacted) HandlePayload(payload string) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var p payloads.WorkoutReacted
+	if err := json.Unmarshal([]byte(payload), &p); err != nil {
+		w.log.Error("unmarshal payload", zap.Error(err))
+		return
+	}
+
+	reaction, err := w.repo.GetWorkoutReaction(ctx,
+		repo.GetWorkoutReactionWithID(p.ReactionID),
+		repo.GetWorkoutReactionLoadWorkout(),
+	)
+	if err != nil {
+		w.log.Error("get workout reaction", zap.Error(err))
+		return
+	}
+
+	workout := reaction.R.GetWorkout()
+	if workout.UserID == reaction.UserID {
+		// Don't notify own reactions.
+		return
+	}
+
+	reactions, err := w.repo.ListWorkoutReactions(ctx,
+		repo.ListWorkoutReactionsWithWorkoutID(workout.ID),
+	)
+	if err != nil {
+		w.log.Error("list workout reactions", zap.Error(err))
+		return
+	}
+
+	var count int
+	for _, r := range reactions {
+		if r.UserID != workout.UserID {
+			count++
+		}
+	}
+
+	if err = w.repo.CreateGroupedNotification(ctx, repo.CreateNotificationParams{
+		Type:   orm.NotificationTypeWorkoutReaction,
+		UserID: workout.UserID,
+		Payload: repo.NotificationPayload{
+			ActorID:   reaction.UserID,
+			WorkoutID: workout.ID,
+			Reactions: count,
+		},
+	}); err != nil {
+		w.log.Error("create notification", zap.Error(err))
+	}
+}
+
+type UsersMentioned struct {
+	log  *zap.Logger
+	repo repo.Repo
+}
+
+func NewUsersMentioned(log *zap.Logger, repo repo.Repo) *UsersMentioned {
+	return &UsersMentioned{log, repo}
+}
+
+func (u *UsersMentioned) HandlePayload(payload string) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var p payloads.UsersMentioned
+	if err := json.Unmarshal([]byte(payload), &p); err != nil {
+		u.log.Error("unmarshal payload", zap.Error(err))
+		return
+	}
+
+	for _, userID := range p.UserIDs {
+		if userID == p.ActorID {
+			// Don't notify own mentions.
+			continue
+		}
+
+		if err := u.repo.CreateNotification(ctx, repo.CreateNotificationParams{
+			Type:   orm.NotificationTypeMention,
+			UserID: userID,
+			Payload: repo.NotificationPayload{
+				ActorID:   p.ActorID,
+				WorkoutID: p.WorkoutID,
+				CommentID: p.CommentID,
+			},
+		}); err != nil {
+			u.log.Error("create notification", zap.Error(err))
+		}
+	}
+}
+
 type FollowedUser struct {
 	log  *zap.Logger
 	repo repo.Repo
@@ -144,3 +281,186 @@ func (u *FollowedUser) HandlePayload(payload string) {
 		u.log.Error("create notification", zap.Error(err))
 	}
 }
+
+type FollowRequested struct {
+	log  *zap.Logger
+	repo repo.Repo
+}
+
+func NewFollowRequested(log *zap.Logger, repo repo.Repo) *FollowRequested {
+	return &FollowRequested{log, repo}
+}
+
+func (f *FollowRequested) HandlePayload(payload string) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var p payloads.FollowRequested
+	if err := json.Unmarshal([]byte(payload), &p); err != nil {
+		f.log.Error("unmarshal payload", zap.Error(err))
+		return
+	}
+
+	if err := f.repo.CreateNotification(ctx, repo.CreateNotificationParams{
+		Type:   orm.NotificationTypeFollowRequest,
+		UserID: p.FolloweeID,
+		Payload: repo.NotificationPayload{
+			ActorID: p.FollowerID,
+		},
+	}); err != nil {
+		f.log.Error("create notification", zap.Error(err))
+	}
+}
+
+type FollowRequestApproved struct {
+	log  *zap.Logger
+	repo repo.Repo
+}
+
+func NewFollowRequestApproved(log *zap.Logger, repo repo.Repo) *FollowRequestApproved {
+	return &FollowRequestApproved{log, repo}
+}
+
+func (f *FollowRequestApproved) HandlePayload(payload string) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var p payloads.FollowRequestApproved
+	if err := json.Unmarshal([]byte(payload), &p); err != nil {
+		f.log.Error("unmarshal payload", zap.Error(err))
+		return
+	}
+
+	if err := f.repo.CreateNotification(ctx, repo.CreateNotificationParams{
+		Type:   orm.NotificationTypeFollowRequestApproved,
+		UserID: p.FollowerID,
+		Payload: repo.NotificationPayload{
+			ActorID: p.FolloweeID,
+		},
+	}); err != nil {
+		f.log.Error("create notification", zap.Error(err))
+	}
+}
+
+type DataExportRequested struct {
+	log      *zap.Logger
+	repo     repo.Repo
+	email    email.Email
+	exporter *export.Exporter
+}
+
+func NewDataExportRequested(log *zap.Logger, repo repo.Repo, email email.Email, exporter *export.Exporter) *DataExportRequested {
+	return &DataExportRequested{log, repo, email, exporter}
+}
+
+// exportTimeout is longer than the default timeout since large accounts are
+// exported through this handler.
+const exportTimeout = 5 * time.Minute
+
+func (d *DataExportRequested) HandlePayload(payload string) {
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+
+	var p payloads.DataExportRequested
+	if err := json.Unmarshal([]byte(payload), &p); err != nil {
+		d.log.Error("unmarshal payload", zap.Error(err))
+		return
+	}
+
+	dataExport, err := d.repo.GetDataExport(ctx,
+		repo.GetDataExportWithID(p.ExportID),
+	)
+	if err != nil {
+		d.log.Error("get data export", zap.Error(err))
+		return
+	}
+
+	var buf bytes.Buffer
+	if err = d.exporter.Archive(ctx, dataExport.UserID, &buf); err != nil {
+		d.log.Error("archive data export", zap.Error(err))
+		return
+	}
+
+	if err = d.repo.CompleteDataExport(ctx, dataExport.ID, buf.Bytes()); err != nil {
+		d.log.Error("complete data export", zap.Error(err))
+		return
+	}
+
+	user, err := d.repo.GetUser(ctx,
+		repo.GetUserWithID(dataExport.UserID),
+		repo.GetUserLoadAuth(),
+	)
+	if err != nil {
+		d.log.Error("get user", zap.Error(err))
+		return
+	}
+
+	if err = d.email.SendDataExport(ctx, email.SendDataExport{
+		Name:  user.FirstName,
+		Email: user.R.Auth.Email,
+		Token: dataExport.Token,
+	}); err != nil {
+		d.log.Error("send data export email", zap.Error(err))
+	}
+}
+
+type ChallengeInvited struct {
+	log  *zap.Logger
+	repo repo.Repo
+}
+
+func NewChallengeInvited(log *zap.Logger, repo repo.Repo) *ChallengeInvited {
+	return &ChallengeInvited{log, repo}
+}
+
+func (c *ChallengeInvited) HandlePayload(payload string) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var p payloads.ChallengeInvited
+	if err := json.Unmarshal([]byte(payload), &p); err != nil {
+		c.log.Error("unmarshal payload", zap.Error(err))
+		return
+	}
+
+	for _, userID := range p.UserIDs {
+		if err := c.repo.CreateNotification(ctx, repo.CreateNotificationParams{
+			Type:   orm.NotificationTypeChallengeInvite,
+			UserID: userID,
+			Payload: repo.NotificationPayload{
+				ActorID:     p.ActorID,
+				ChallengeID: p.ChallengeID,
+			},
+		}); err != nil {
+			c.log.Error("create notification", zap.Error(err))
+		}
+	}
+}
+
+type WorkoutsCreated struct {
+	log  *zap.Logger
+	repo repo.Repo
+}
+
+func NewWorkoutsCreated(log *zap.Logger, repo repo.Repo) *WorkoutsCreated {
+	return &WorkoutsCreated{log, repo}
+}
+
+func (w *WorkoutsCreated) HandlePayload(payload string) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var p payloads.WorkoutsCreated
+	if err := json.Unmarshal([]byte(payload), &p); err != nil {
+		w.log.Error("unmarshal payload", zap.Error(err))
+		return
+	}
+
+	if err := w.repo.CreateAchievements(ctx, p.UserID); err != nil {
+		w.log.Error("create achievements", zap.Error(err))
+	}
+
+	if err := w.repo.CreatePersonalRecords(ctx, p.UserID); err != nil {
+		w.log.Error("create personal records", zap.Error(err))
+	}
+}
@@ -113,6 +113,52 @@ func TestWorkoutCommentPosted_HandlePayload(t *testing.T) {
 		require.False(t, exists)
 	})
 
+	t.Run("ok_workout_comment_reply_posted", func(t *testing.T) {
+		t.Parallel()
+		payload := payloads.WorkoutCommentPosted{
+			CommentID: uuid.NewString(),
+		}
+
+		f.NewUser(factory.UserID(factory.UUID(4)))
+		f.NewUser(factory.UserID(factory.UUID(5)))
+		f.NewUser(factory.UserID(factory.UUID(6)))
+
+		workout := f.NewWorkout(
+			factory.WorkoutUserID(factory.UUID(4)),
+		)
+		parent := f.NewWorkoutComment(
+			factory.WorkoutCommentUserID(factory.UUID(5)),
+			factory.WorkoutCommentWorkoutID(workout.ID),
+		)
+		f.NewWorkoutComment(
+			factory.WorkoutCommentID(payload.CommentID),
+			factory.WorkoutCommentUserID(factory.UUID(6)),
+			factory.WorkoutCommentWorkoutID(workout.ID),
+			factory.WorkoutCommentParentCommentID(parent.ID),
+		)
+
+		bytes, err := json.Marshal(payload)
+		require.NoError(t, err)
+
+		handler.HandlePayload(string(bytes))
+
+		notification, err := orm.Notifications(orm.NotificationWhere.UserID.EQ(factory.UUID(4))).One(ctx, c.DB)
+		require.NoError(t, err)
+		require.Equal(t, orm.NotificationTypeWorkoutComment, notification.Type)
+
+		notification, err = orm.Notifications(orm.NotificationWhere.UserID.EQ(factory.UUID(5))).One(ctx, c.DB)
+		require.NoError(t, err)
+		require.Equal(t, orm.NotificationTypeWorkoutCommentReply, notification.Type)
+
+		count, err := orm.Notifications(orm.NotificationWhere.UserID.EQ(factory.UUID(5))).Count(ctx, c.DB)
+		require.NoError(t, err)
+		require.Equal(t, 1, int(count))
+
+		exists, err := orm.Notifications(orm.NotificationWhere.UserID.EQ(factory.UUID(6))).Exists(ctx, c.DB)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
 	t.Run("ok_invalid_payload", func(t *testing.T) {
 		t.Parallel()
 		handler.HandlePayload("invalid_payload")
@@ -125,6 +171,102 @@ func TestWorkoutCommentPosted_HandlePayload(t *testing.T) {
 	})
 }
 
+func TestWorkoutReacted_HandlePayload(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := container.NewContainer(ctx)
+	f := factory.NewFactory(c.DB)
+	handler := handlers.NewWorkoutReacted(zap.NewExample(), repo.New(c.DB))
+
+	t.Run("ok_workout_reacted", func(t *testing.T) {
+		t.Parallel()
+		owner := f.NewUser()
+		workout := f.NewWorkout(factory.WorkoutUserID(owner.ID))
+
+		for range 2 {
+			reaction := f.NewWorkoutReaction(factory.WorkoutReactionWorkoutID(workout.ID))
+			bytes, err := json.Marshal(payloads.WorkoutReacted{ReactionID: reaction.ID})
+			require.NoError(t, err)
+
+			handler.HandlePayload(string(bytes))
+		}
+
+		notifications, err := orm.Notifications(orm.NotificationWhere.UserID.EQ(owner.ID)).All(ctx, c.DB)
+		require.NoError(t, err)
+		require.Len(t, notifications, 1)
+		require.Equal(t, orm.NotificationTypeWorkoutReaction, notifications[0].Type)
+
+		var p repo.NotificationPayload
+		require.NoError(t, notifications[0].Payload.Unmarshal(&p))
+		require.Equal(t, workout.ID, p.WorkoutID)
+		require.Equal(t, 2, p.Reactions)
+	})
+
+	t.Run("ok_own_workout_reacted", func(t *testing.T) {
+		t.Parallel()
+		owner := f.NewUser()
+		reaction := f.NewWorkoutReaction(
+			factory.WorkoutReactionUserID(owner.ID),
+			factory.WorkoutReactionWorkoutID(f.NewWorkout(factory.WorkoutUserID(owner.ID)).ID),
+		)
+
+		bytes, err := json.Marshal(payloads.WorkoutReacted{ReactionID: reaction.ID})
+		require.NoError(t, err)
+
+		handler.HandlePayload(string(bytes))
+
+		exists, err := orm.Notifications(orm.NotificationWhere.UserID.EQ(owner.ID)).Exists(ctx, c.DB)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	t.Cleanup(func() {
+		if err := c.Terminate(ctx); err != nil {
+			t.Fatal(fmt.Errorf("failed to terminate container: %w", err))
+		}
+	})
+}
+
+func TestUsersMentioned_HandlePayload(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := container.NewContainer(ctx)
+	f := factory.NewFactory(c.DB)
+	handler := handlers.NewUsersMentioned(zap.NewExample(), repo.New(c.DB))
+
+	t.Run("ok_users_mentioned", func(t *testing.T) {
+		t.Parallel()
+		actor := f.NewUser()
+		mentioned := f.NewUser()
+		workout := f.NewWorkout(factory.WorkoutUserID(actor.ID))
+
+		bytes, err := json.Marshal(payloads.UsersMentioned{
+			ActorID:   actor.ID,
+			WorkoutID: workout.ID,
+			UserIDs:   []string{actor.ID, mentioned.ID},
+		})
+		require.NoError(t, err)
+
+		handler.HandlePayload(string(bytes))
+
+		notification, err := orm.Notifications(orm.NotificationWhere.UserID.EQ(mentioned.ID)).One(ctx, c.DB)
+		require.NoError(t, err)
+		require.Equal(t, orm.NotificationTypeMention, notification.Type)
+
+		exists, err := orm.Notifications(orm.NotificationWhere.UserID.EQ(actor.ID)).Exists(ctx, c.DB)
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	t.Cleanup(func() {
+		if err := c.Terminate(ctx); err != nil {
+			t.Fatal(fmt.Errorf("failed to terminate container: %w", err))
+		}
+	})
+}
+
 func TestFollowedUser_HandlePayload(t *testing.T) {
 	t.Parallel()
 
@@ -163,3 +305,133 @@ func TestFollowedUser_HandlePayload(t *testing.T) {
 		controller.Finish()
 	})
 }
+
+func TestFollowRequested_HandlePayload(t *testing.T) {
+	t.Parallel()
+
+	controller := gomock.NewController(t)
+	repoMock := repo.NewMockRepo(controller)
+	handler := handlers.NewFollowRequested(zap.NewExample(), repoMock)
+
+	t.Run("ok_follow_requested", func(t *testing.T) {
+		t.Parallel()
+		payload := payloads.FollowRequested{
+			FollowerID: "follower_id",
+			FolloweeID: "followee_id",
+		}
+
+		repoMock.EXPECT().CreateNotification(gomock.Any(), repo.CreateNotificationParams{
+			Type:   orm.NotificationTypeFollowRequest,
+			UserID: payload.FolloweeID,
+			Payload: repo.NotificationPayload{
+				ActorID: payload.FollowerID,
+			},
+		})
+
+		bytes, err := json.Marshal(payload)
+		require.NoError(t, err)
+
+		handler.HandlePayload(string(bytes))
+	})
+
+	t.Cleanup(func() {
+		controller.Finish()
+	})
+}
+
+func TestFollowRequestApproved_HandlePayload(t *testing.T) {
+	t.Parallel()
+
+	controller := gomock.NewController(t)
+	repoMock := repo.NewMockRepo(controller)
+	handler := handlers.NewFollowRequestApproved(zap.NewExample(), repoMock)
+
+	t.Run("ok_follow_request_approved", func(t *testing.T) {
+		t.Parallel()
+		payload := payloads.FollowRequestApproved{
+			FollowerID: "follower_id",
+			FolloweeID: "followee_id",
+		}
+
+		repoMock.EXPECT().CreateNotification(gomock.Any(), repo.CreateNotificationParams{
+			Type:   orm.NotificationTypeFollowRequestApproved,
+			UserID: payload.FollowerID,
+			Payload: repo.NotificationPayload{
+				ActorID: payload.FolloweeID,
+			},
+		})
+
+		bytes, err := json.Marshal(payload)
+		require.NoError(t, err)
+
+		handler.HandlePayload(string(bytes))
+	})
+
+	t.Cleanup(func() {
+		controller.Finish()
+	})
+}
+
+func TestChallengeInvited_HandlePayload(t *testing.T) {
+	t.Parallel()
+
+	controller := gomock.NewController(t)
+	repoMock := repo.NewMockRepo(controller)
+	handler := handlers.NewChallengeInvited(zap.NewExample(), repoMock)
+
+	t.Run("ok_challenge_invited", func(t *testing.T) {
+		t.Parallel()
+		payload := payloads.ChallengeInvited{
+			ActorID:     "actor_id",
+			ChallengeID: "challenge_id",
+			UserIDs:     []string{"user_id_1", "user_id_2"},
+		}
+
+		for _, userID := range payload.UserIDs {
+			repoMock.EXPECT().CreateNotification(gomock.Any(), repo.CreateNotificationParams{
+				Type:   orm.NotificationTypeChallengeInvite,
+				UserID: userID,
+				Payload: repo.NotificationPayload{
+					ActorID:     payload.ActorID,
+					ChallengeID: payload.ChallengeID,
+				},
+			})
+		}
+
+		bytes, err := json.Marshal(payload)
+		require.NoError(t, err)
+
+		handler.HandlePayload(string(bytes))
+	})
+
+	t.Cleanup(func() {
+		controller.Finish()
+	})
+}
+
+func TestWorkoutsCreated_HandlePayload(t *testing.T) {
+	t.Parallel()
+
+	controller := gomock.NewController(t)
+	repoMock := repo.NewMockRepo(controller)
+	handler := handlers.NewWorkoutsCreated(zap.NewExample(), repoMock)
+
+	t.Run("ok_workouts_created", func(t *testing.T) {
+		t.Parallel()
+		payload := payloads.WorkoutsCreated{
+			UserID: "user_id",
+		}
+
+		repoMock.EXPECT().CreateAchievements(gomock.Any(), payload.UserID)
+		repoMock.EXPECT().CreatePersonalRecords(gomock.Any(), payload.UserID)
+
+		bytes, err := json.Marshal(payload)
+		require.NoError(t, err)
+
+		handler.HandlePayload(string(bytes))
+	})
+
+	t.Cleanup(func() {
+		controller.Finish()
+	})
+}
@@ -13,17 +13,31 @@ type Registry struct {
 type RegistryParams struct {
 	fx.In
 
-	FollowedUser         *FollowedUser
-	RequestTraced        *RequestTraced
-	WorkoutCommentPosted *WorkoutCommentPosted
+	FollowedUser          *FollowedUser
+	RequestTraced         *RequestTraced
+	WorkoutCommentPosted  *WorkoutCommentPosted
+	DataExportRequested   *DataExportRequested
+	WorkoutReacted        *WorkoutReacted
+	UsersMentioned        *UsersMentioned
+	FollowRequested       *FollowRequested
+	FollowRequestApproved *FollowRequestApproved
+	ChallengeInvited      *ChallengeInvited
+	WorkoutsCreated       *WorkoutsCreated
 }
 
 func NewRegistry(p RegistryParams) *Registry {
 	return &Registry{
 		handlers: map[orm.EventTopic]Handler{
-			orm.EventTopicFollowedUser:         p.FollowedUser,
-			orm.EventTopicRequestTraced:        p.RequestTraced,
-			orm.EventTopicWorkoutCommentPosted: p.WorkoutCommentPosted,
+			orm.EventTopicFollowedUser:          p.FollowedUser,
+			orm.EventTopicRequestTraced:         p.RequestTraced,
+			orm.EventTopicWorkoutCommentPosted:  p.WorkoutCommentPosted,
+			orm.EventTopicDataExportRequested:   p.DataExportRequested,
+			orm.EventTopicWorkoutReacted:        p.WorkoutReacted,
+			orm.EventTopicUsersMentioned:        p.UsersMentioned,
+			orm.EventTopicFollowRequested:       p.FollowRequested,
+			orm.EventTopicFollowRequestApproved: p.FollowRequestApproved,
+			orm.EventTopicChallengeInvited:      p.ChallengeInvited,
+			orm.EventTopicWorkoutsCreated:       p.WorkoutsCreated,
 		},
 	}
 }
@@ -16,6 +16,13 @@ func Module() fx.Option {
 			handlers.NewFollowedUser,
 			handlers.NewRequestTraced,
 			handlers.NewWorkoutCommentPosted,
+			handlers.NewDataExportRequested,
+			handlers.NewWorkoutReacted,
+			handlers.NewUsersMentioned,
+			handlers.NewFollowRequested,
+			handlers.NewFollowRequestApproved,
+			handlers.NewChallengeInvited,
+			handlers.NewWorkoutsCreated,
 		),
 		fx.Invoke(
 			func(lc fx.Lifecycle, pubSub *PubSub, registry *handlers.Registry) {
@@ -14,3 +14,41 @@ type UserFollowed struct {
 	FollowerID string `json:"followerId"`
 	FolloweeID string `json:"followeeId"`
 }
+
+type FollowRequested struct {
+	FollowerID string `json:"followerId"`
+	FolloweeID string `json:"followeeId"`
+}
+
+type FollowRequestApproved struct {
+	FollowerID string `json:"followerId"`
+	FolloweeID string `json:"followeeId"`
+}
+
+type DataExportRequested struct {
+	ExportID string `json:"exportId"`
+}
+
+type WorkoutReacted struct {
+	ReactionID string `json:"reactionId"`
+}
+
+// UsersMentioned is published with the users that were mentioned for the first
+// time in a workout note, when CommentID is empty, or in a comment.
+type UsersMentioned struct {
+	ActorID   string   `json:"actorId"`
+	WorkoutID string   `json:"workoutId"`
+	CommentID string   `json:"commentId,omitempty"`
+	UserIDs   []string `json:"userIds"`
+}
+
+type ChallengeInvited struct {
+	ActorID     string   `json:"actorId"`
+	ChallengeID string   `json:"challengeId"`
+	UserIDs     []string `json:"userIds"`
+}
+
+// WorkoutsCreated is published when the user logged or imported workouts.
+type WorkoutsCreated struct {
+	UserID string `json:"userId"`
+}
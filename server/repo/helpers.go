@@ -3,15 +3,18 @@ package repo
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/crlssn/getstronger/server/gen/orm"
+	"github.com/crlssn/getstronger/server/mention"
 )
 
 type ModelItem interface {
-	*orm.Workout | *orm.Exercise | *orm.User | *orm.Routine | *orm.Set | *orm.WorkoutComment | *orm.Notification
+	*orm.Workout | *orm.Exercise | *orm.User | *orm.Routine | *orm.Set | *orm.WorkoutComment | *orm.Notification | *orm.Report | *orm.Challenge | *orm.Club | *orm.ClubMember | *orm.CoachAction
 }
 
 type ModelSlice[T any] interface {
@@ -45,19 +48,54 @@ func PaginateSlice[Item ModelItem, Slice ModelSlice[Item]](
 	}, nil
 }
 
-func PageTokenCreatedAt(t time.Time) PageToken {
+// PageTokenCreatedAt returns a page token after the item created at the given
+// time. The item's keys tell it apart from other items created at the same time.
+func PageTokenCreatedAt(t time.Time, keys ...string) PageToken {
 	return PageToken{
 		// Truncate to microseconds to unify precision across different databases.
 		CreatedAt: t.Truncate(time.Microsecond),
+		Keys:      keys,
 	}
 }
 
 type PageToken struct {
 	CreatedAt time.Time `json:"createdAt"`
+	Keys      []string  `json:"keys,omitempty"`
+}
+
+// after selects the rows past the page token, with rows ordered descending by
+// the creation time and then the key columns. Tokens without keys select by
+// the creation time alone.
+func (pt PageToken) after(createdAtColumn string, keyColumns ...string) qm.QueryMod {
+	if len(pt.Keys) == 0 || len(pt.Keys) != len(keyColumns) {
+		return qm.Where(fmt.Sprintf("%s < ?", createdAtColumn), pt.CreatedAt)
+	}
+
+	args := make([]any, 0, len(pt.Keys)+1)
+	args = append(args, pt.CreatedAt)
+	for _, key := range pt.Keys {
+		args = append(args, key)
+	}
+
+	columns := append([]string{createdAtColumn}, keyColumns...)
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+	return qm.Where(fmt.Sprintf("(%s) < (%s)", strings.Join(columns, ", "), placeholders), args...)
+}
+
+// FeedPageToken paginates a timeline merged from several sources. Each source
+// keeps its own page token, since items of different sources can share a
+// creation time. A source without a token has had no items on earlier pages.
+type FeedPageToken struct {
+	Workouts        json.RawMessage `json:"workouts,omitempty"`
+	YearSummaries   json.RawMessage `json:"yearSummaries,omitempty"`
+	PersonalRecords json.RawMessage `json:"personalRecords,omitempty"`
+	Follows         json.RawMessage `json:"follows,omitempty"`
+	Achievements    json.RawMessage `json:"achievements,omitempty"`
+	Challenges      json.RawMessage `json:"challenges,omitempty"`
 }
 
 type updateOpt interface {
-	UpdateRoutineOpt | UpdateAuthOpt | UpdateExerciseOpt | UpdateWorkoutOpt
+	UpdateRoutineOpt | UpdateAuthOpt | UpdateExerciseOpt | UpdateWorkoutOpt | UpdateUserOpt | UpdateWorkoutCommentOpt | UpdateChallengeOpt | UpdateClubMemberOpt
 }
 
 var (
@@ -107,3 +145,18 @@ func MustHashPassword(password string) []byte {
 
 	return hash
 }
+
+// marshalMentions marshals the mentions for a JSONB column, which expects an
+// empty array rather than null when there are none.
+func marshalMentions(mentions []mention.Mention) ([]byte, error) {
+	if mentions == nil {
+		mentions = []mention.Mention{}
+	}
+
+	bytes, err := json.Marshal(mentions)
+	if err != nil {
+		return nil, fmt.Errorf("mentions marshal: %w", err)
+	}
+
+	return bytes, nil
+}
@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/crlssn/getstronger/server/gen/orm"
 )
@@ -28,12 +29,24 @@ type methods interface {
 	workoutMethods
 	exerciseMethods
 	notificationMethods
+	yearSummaryMethods
+	dataExportMethods
+	reactionMethods
+	followRequestMethods
+	blockMethods
+	reportMethods
+	challengeMethods
+	clubMethods
+	coachMethods
 }
 
 type setMethods interface {
 	ListSets(ctx context.Context, opts ...ListSetsOpt) (orm.SetSlice, error)
 	GetPersonalBests(ctx context.Context, userIDs ...string) (orm.SetSlice, error)
+	GetVisiblePersonalBests(ctx context.Context, viewerID string, userIDs ...string) (orm.SetSlice, error)
 	GetPreviousWorkoutSets(ctx context.Context, exerciseIDs []string) (orm.SetSlice, error)
+	GetExerciseProgress(ctx context.Context, p GetExerciseProgressParams) ([]ExerciseProgress, error)
+	GetLeaderboard(ctx context.Context, p GetLeaderboardParams) ([]LeaderboardEntry, error)
 }
 
 type authMethods interface {
@@ -50,9 +63,15 @@ type userMethods interface {
 	Unfollow(ctx context.Context, p UnfollowParams) error
 	ListUsers(ctx context.Context, opts ...ListUsersOpt) (orm.UserSlice, error)
 	CreateUser(ctx context.Context, p CreateUserParams) (*orm.User, error)
+	UpdateUser(ctx context.Context, userID string, opts ...UpdateUserOpt) error
+	CreateBodyweight(ctx context.Context, userID string, bodyweight float64) error
+	GetBodyweightAt(ctx context.Context, userID string, at time.Time) (*orm.Bodyweight, error)
+	DeleteAccount(ctx context.Context, userID string) error
 	ListFollowers(ctx context.Context, userID string, opts ...ListFollowersOpt) (orm.UserSlice, error)
 	ListFollowees(ctx context.Context, userID string, opts ...ListFolloweesOpt) (orm.UserSlice, error)
+	ListFollows(ctx context.Context, opts ...ListFollowsOpt) (orm.FollowerSlice, error)
 	IsUserFollowedByUserID(ctx context.Context, user *orm.User, userID string) (bool, error)
+	CanViewUser(ctx context.Context, viewerID, userID string) (bool, error)
 }
 
 type traceMethods interface {
@@ -64,7 +83,10 @@ type routineMethods interface {
 	ListRoutines(ctx context.Context, opts ...ListRoutineOpt) (orm.RoutineSlice, error)
 	CreateRoutine(ctx context.Context, p CreateRoutineParams) (*orm.Routine, error)
 	DeleteRoutine(ctx context.Context, routineID string) error
+	RestoreRoutine(ctx context.Context, routineID string) error
+	PurgeRoutines(ctx context.Context, deletedBefore time.Time) (int64, error)
 	UpdateRoutine(ctx context.Context, routineID string, opts ...UpdateRoutineOpt) error
+	CloneRoutine(ctx context.Context, p CloneRoutineParams) (*orm.Routine, error)
 	SetRoutineExercises(ctx context.Context, routine *orm.Routine, exercises orm.ExerciseSlice) error
 	AddExerciseToRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error
 	RemoveExerciseFromRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error
@@ -72,13 +94,23 @@ type routineMethods interface {
 
 type workoutMethods interface {
 	GetWorkout(ctx context.Context, opts ...GetWorkoutOpt) (*orm.Workout, error)
+	CanViewWorkout(ctx context.Context, viewerID string, workout *orm.Workout) (bool, error)
 	ListWorkouts(ctx context.Context, opts ...ListWorkoutsOpt) (orm.WorkoutSlice, error)
+	CreateAchievements(ctx context.Context, userID string) error
+	ListAchievements(ctx context.Context, opts ...ListAchievementsOpt) (orm.AchievementSlice, error)
+	CreatePersonalRecords(ctx context.Context, userID string) error
+	ListPersonalRecords(ctx context.Context, opts ...ListPersonalRecordsOpt) (orm.PersonalRecordSlice, error)
 	CreateWorkout(ctx context.Context, p CreateWorkoutParams) (*orm.Workout, error)
 	DeleteWorkout(ctx context.Context, opts ...DeleteWorkoutOpt) error
+	RestoreWorkout(ctx context.Context, workoutID string) error
+	PurgeWorkouts(ctx context.Context, deletedBefore time.Time) (int64, error)
 	UpdateWorkout(ctx context.Context, workoutID string, opts ...UpdateWorkoutOpt) error
 	GetWorkoutComment(ctx context.Context, opts ...GetWorkoutCommentOpt) (*orm.WorkoutComment, error)
 	UpdateWorkoutSets(ctx context.Context, p UpdateWorkoutSetsParams) error
 	CreateWorkoutComment(ctx context.Context, p CreateWorkoutCommentParams, opts ...CreateWorkoutCommentOpts) (*orm.WorkoutComment, error)
+	ListWorkoutComments(ctx context.Context, opts ...ListWorkoutCommentsOpt) (orm.WorkoutCommentSlice, error)
+	UpdateWorkoutComment(ctx context.Context, commentID string, opts ...UpdateWorkoutCommentOpt) error
+	DeleteWorkoutComment(ctx context.Context, commentID string) error
 	PostCreateWorkoutCommentLoadUser(ctx context.Context) CreateWorkoutCommentOpts
 }
 
@@ -88,15 +120,101 @@ type exerciseMethods interface {
 	CreateExercise(ctx context.Context, p CreateExerciseParams) (*orm.Exercise, error)
 	UpdateExercise(ctx context.Context, exerciseID string, opts ...UpdateExerciseOpt) error
 	SoftDeleteExercise(ctx context.Context, p SoftDeleteExerciseParams) error
+	MergeExercises(ctx context.Context, p MergeExercisesParams) error
+	RestoreExercise(ctx context.Context, p RestoreExerciseParams) error
+	PurgeExercises(ctx context.Context, deletedBefore time.Time) (int64, error)
 }
 
 type notificationMethods interface {
 	ListNotifications(ctx context.Context, opts ...ListNotificationsOpt) (orm.NotificationSlice, error)
 	CreateNotification(ctx context.Context, p CreateNotificationParams) error
+	CreateGroupedNotification(ctx context.Context, p CreateNotificationParams) error
+	UpdateGroupedNotification(ctx context.Context, p CreateNotificationParams) error
+	DeleteGroupedNotifications(ctx context.Context, userID string, notificationType orm.NotificationType, workoutID string) error
 	CountNotifications(ctx context.Context, opts ...CountNotificationsOpt) (int64, error)
 	MarkNotificationsAsRead(ctx context.Context, userID string) error
 }
 
+type yearSummaryMethods interface {
+	GetYearSummary(ctx context.Context, opts ...GetYearSummaryOpt) (*orm.YearSummary, error)
+	BuildYearSummary(ctx context.Context, userID string, year int) (*YearSummaryPayload, error)
+	CreateYearSummary(ctx context.Context, p CreateYearSummaryParams) (*orm.YearSummary, error)
+	ListYearSummaries(ctx context.Context, opts ...ListYearSummariesOpt) (orm.YearSummarySlice, error)
+	ListYearSummaryCandidates(ctx context.Context, year int) ([]string, error)
+}
+
+type dataExportMethods interface {
+	GetDataExport(ctx context.Context, opts ...GetDataExportOpt) (*orm.DataExport, error)
+	CreateDataExport(ctx context.Context, userID string) (*orm.DataExport, error)
+	CompleteDataExport(ctx context.Context, exportID string, archive []byte) error
+	PurgeDataExports(ctx context.Context, createdBefore time.Time) (int64, error)
+}
+
+type reactionMethods interface {
+	GetWorkoutReaction(ctx context.Context, opts ...GetWorkoutReactionOpt) (*orm.WorkoutReaction, error)
+	ListWorkoutReactions(ctx context.Context, opts ...ListWorkoutReactionsOpt) (orm.WorkoutReactionSlice, error)
+	UpsertWorkoutReaction(ctx context.Context, p UpsertWorkoutReactionParams) (*orm.WorkoutReaction, error)
+	DeleteWorkoutReaction(ctx context.Context, userID, workoutID string) error
+}
+
+type followRequestMethods interface {
+	CreateFollowRequest(ctx context.Context, p FollowParams) error
+	ListFollowRequests(ctx context.Context, opts ...ListFollowRequestsOpt) (orm.FollowRequestSlice, error)
+	ApproveFollowRequest(ctx context.Context, p FollowParams) error
+	ApproveFollowRequests(ctx context.Context, followeeID string) (orm.FollowRequestSlice, error)
+	DeleteFollowRequest(ctx context.Context, p FollowParams) error
+}
+
+type blockMethods interface {
+	BlockUser(ctx context.Context, p BlockParams) error
+	UnblockUser(ctx context.Context, p BlockParams) error
+	ListBlockedUsers(ctx context.Context, userID string) (orm.UserSlice, error)
+	IsBlocked(ctx context.Context, userID string, otherUserIDs ...string) (bool, error)
+}
+
+type reportMethods interface {
+	CreateReport(ctx context.Context, p CreateReportParams) (*orm.Report, error)
+	GetReport(ctx context.Context, opts ...GetReportOpt) (*orm.Report, error)
+	ListReports(ctx context.Context, opts ...ListReportsOpt) (orm.ReportSlice, error)
+	ResolveReport(ctx context.Context, p ResolveReportParams) error
+	HideWorkout(ctx context.Context, workoutID string) error
+	HideWorkoutComment(ctx context.Context, commentID string) error
+	SuspendUser(ctx context.Context, userID string) error
+}
+
+type challengeMethods interface {
+	CreateChallenge(ctx context.Context, p CreateChallengeParams) (*orm.Challenge, error)
+	GetChallenge(ctx context.Context, opts ...GetChallengeOpt) (*orm.Challenge, error)
+	ListChallenges(ctx context.Context, opts ...ListChallengesOpt) (orm.ChallengeSlice, error)
+	UpdateChallenge(ctx context.Context, challengeID string, opts ...UpdateChallengeOpt) error
+	InviteToChallenge(ctx context.Context, challengeID string, userIDs []string) ([]string, error)
+	JoinChallenge(ctx context.Context, challengeID, userID string) error
+	LeaveChallenge(ctx context.Context, challengeID, userID string) error
+	GetChallengeStandings(ctx context.Context, challengeID string) ([]ChallengeStanding, error)
+}
+
+type clubMethods interface {
+	CreateClub(ctx context.Context, p CreateClubParams) (*orm.Club, error)
+	GetClub(ctx context.Context, opts ...GetClubOpt) (*orm.Club, error)
+	ListClubs(ctx context.Context, opts ...ListClubsOpt) (orm.ClubSlice, error)
+	GetClubMember(ctx context.Context, clubID, userID string) (*orm.ClubMember, error)
+	ListClubMembers(ctx context.Context, opts ...ListClubMembersOpt) (orm.ClubMemberSlice, error)
+	CreateClubMember(ctx context.Context, p CreateClubMemberParams) error
+	UpdateClubMember(ctx context.Context, clubID, userID string, opts ...UpdateClubMemberOpt) error
+	DeleteClubMember(ctx context.Context, clubID, userID string) error
+	TransferClubOwnership(ctx context.Context, p TransferClubOwnershipParams) error
+}
+
+type coachMethods interface {
+	GrantCoachAccess(ctx context.Context, p CoachingParams) error
+	RevokeCoachAccess(ctx context.Context, p CoachingParams) error
+	IsCoach(ctx context.Context, p CoachingParams) (bool, error)
+	ListCoaches(ctx context.Context, athleteID string) (orm.UserSlice, error)
+	CreateCoachAction(ctx context.Context, p CreateCoachActionParams) error
+	ListCoachActions(ctx context.Context, opts ...ListCoachActionsOpt) (orm.CoachActionSlice, error)
+	ListAthleteSummaries(ctx context.Context, coachID string, now time.Time) ([]AthleteSummary, error)
+}
+
 type pubSubMethods interface {
 	PublishEvent(ctx context.Context, topic orm.EventTopic, payload []byte) error
 }
@@ -13,6 +13,7 @@ import (
 	context "context"
 	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	orm "github.com/crlssn/getstronger/server/gen/orm"
 	gomock "go.uber.org/mock/gomock"
@@ -56,6 +57,109 @@ func (mr *MockRepoMockRecorder) AddExerciseToRoutine(ctx, exercise, routine any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExerciseToRoutine", reflect.TypeOf((*MockRepo)(nil).AddExerciseToRoutine), ctx, exercise, routine)
 }
 
+// ApproveFollowRequest mocks base method.
+func (m *MockRepo) ApproveFollowRequest(ctx context.Context, p FollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveFollowRequest", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveFollowRequest indicates an expected call of ApproveFollowRequest.
+func (mr *MockRepoMockRecorder) ApproveFollowRequest(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveFollowRequest", reflect.TypeOf((*MockRepo)(nil).ApproveFollowRequest), ctx, p)
+}
+
+// ApproveFollowRequests mocks base method.
+func (m *MockRepo) ApproveFollowRequests(ctx context.Context, followeeID string) (orm.FollowRequestSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveFollowRequests", ctx, followeeID)
+	ret0, _ := ret[0].(orm.FollowRequestSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveFollowRequests indicates an expected call of ApproveFollowRequests.
+func (mr *MockRepoMockRecorder) ApproveFollowRequests(ctx, followeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveFollowRequests", reflect.TypeOf((*MockRepo)(nil).ApproveFollowRequests), ctx, followeeID)
+}
+
+// BlockUser mocks base method.
+func (m *MockRepo) BlockUser(ctx context.Context, p BlockParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUser", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockRepoMockRecorder) BlockUser(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockRepo)(nil).BlockUser), ctx, p)
+}
+
+// BuildYearSummary mocks base method.
+func (m *MockRepo) BuildYearSummary(ctx context.Context, userID string, year int) (*YearSummaryPayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildYearSummary", ctx, userID, year)
+	ret0, _ := ret[0].(*YearSummaryPayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildYearSummary indicates an expected call of BuildYearSummary.
+func (mr *MockRepoMockRecorder) BuildYearSummary(ctx, userID, year any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildYearSummary", reflect.TypeOf((*MockRepo)(nil).BuildYearSummary), ctx, userID, year)
+}
+
+// CanViewUser mocks base method.
+func (m *MockRepo) CanViewUser(ctx context.Context, viewerID, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanViewUser", ctx, viewerID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanViewUser indicates an expected call of CanViewUser.
+func (mr *MockRepoMockRecorder) CanViewUser(ctx, viewerID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewUser", reflect.TypeOf((*MockRepo)(nil).CanViewUser), ctx, viewerID, userID)
+}
+
+// CanViewWorkout mocks base method.
+func (m *MockRepo) CanViewWorkout(ctx context.Context, viewerID string, workout *orm.Workout) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanViewWorkout", ctx, viewerID, workout)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanViewWorkout indicates an expected call of CanViewWorkout.
+func (mr *MockRepoMockRecorder) CanViewWorkout(ctx, viewerID, workout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewWorkout", reflect.TypeOf((*MockRepo)(nil).CanViewWorkout), ctx, viewerID, workout)
+}
+
+// CloneRoutine mocks base method.
+func (m *MockRepo) CloneRoutine(ctx context.Context, p CloneRoutineParams) (*orm.Routine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneRoutine", ctx, p)
+	ret0, _ := ret[0].(*orm.Routine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneRoutine indicates an expected call of CloneRoutine.
+func (mr *MockRepoMockRecorder) CloneRoutine(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneRoutine", reflect.TypeOf((*MockRepo)(nil).CloneRoutine), ctx, p)
+}
+
 // CompareEmailAndPassword mocks base method.
 func (m *MockRepo) CompareEmailAndPassword(ctx context.Context, email, password string) error {
 	m.ctrl.T.Helper()
@@ -70,6 +174,20 @@ func (mr *MockRepoMockRecorder) CompareEmailAndPassword(ctx, email, password any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareEmailAndPassword", reflect.TypeOf((*MockRepo)(nil).CompareEmailAndPassword), ctx, email, password)
 }
 
+// CompleteDataExport mocks base method.
+func (m *MockRepo) CompleteDataExport(ctx context.Context, exportID string, archive []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteDataExport", ctx, exportID, archive)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteDataExport indicates an expected call of CompleteDataExport.
+func (mr *MockRepoMockRecorder) CompleteDataExport(ctx, exportID, archive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteDataExport", reflect.TypeOf((*MockRepo)(nil).CompleteDataExport), ctx, exportID, archive)
+}
+
 // CountNotifications mocks base method.
 func (m *MockRepo) CountNotifications(ctx context.Context, opts ...CountNotificationsOpt) (int64, error) {
 	m.ctrl.T.Helper()
@@ -90,6 +208,20 @@ func (mr *MockRepoMockRecorder) CountNotifications(ctx any, opts ...any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNotifications", reflect.TypeOf((*MockRepo)(nil).CountNotifications), varargs...)
 }
 
+// CreateAchievements mocks base method.
+func (m *MockRepo) CreateAchievements(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAchievements", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAchievements indicates an expected call of CreateAchievements.
+func (mr *MockRepoMockRecorder) CreateAchievements(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAchievements", reflect.TypeOf((*MockRepo)(nil).CreateAchievements), ctx, userID)
+}
+
 // CreateAuth mocks base method.
 func (m *MockRepo) CreateAuth(ctx context.Context, email, password string) (*orm.Auth, error) {
 	m.ctrl.T.Helper()
@@ -105,6 +237,93 @@ func (mr *MockRepoMockRecorder) CreateAuth(ctx, email, password any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuth", reflect.TypeOf((*MockRepo)(nil).CreateAuth), ctx, email, password)
 }
 
+// CreateBodyweight mocks base method.
+func (m *MockRepo) CreateBodyweight(ctx context.Context, userID string, bodyweight float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBodyweight", ctx, userID, bodyweight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBodyweight indicates an expected call of CreateBodyweight.
+func (mr *MockRepoMockRecorder) CreateBodyweight(ctx, userID, bodyweight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBodyweight", reflect.TypeOf((*MockRepo)(nil).CreateBodyweight), ctx, userID, bodyweight)
+}
+
+// CreateChallenge mocks base method.
+func (m *MockRepo) CreateChallenge(ctx context.Context, p CreateChallengeParams) (*orm.Challenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChallenge", ctx, p)
+	ret0, _ := ret[0].(*orm.Challenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChallenge indicates an expected call of CreateChallenge.
+func (mr *MockRepoMockRecorder) CreateChallenge(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChallenge", reflect.TypeOf((*MockRepo)(nil).CreateChallenge), ctx, p)
+}
+
+// CreateClub mocks base method.
+func (m *MockRepo) CreateClub(ctx context.Context, p CreateClubParams) (*orm.Club, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClub", ctx, p)
+	ret0, _ := ret[0].(*orm.Club)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClub indicates an expected call of CreateClub.
+func (mr *MockRepoMockRecorder) CreateClub(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClub", reflect.TypeOf((*MockRepo)(nil).CreateClub), ctx, p)
+}
+
+// CreateClubMember mocks base method.
+func (m *MockRepo) CreateClubMember(ctx context.Context, p CreateClubMemberParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClubMember", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateClubMember indicates an expected call of CreateClubMember.
+func (mr *MockRepoMockRecorder) CreateClubMember(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClubMember", reflect.TypeOf((*MockRepo)(nil).CreateClubMember), ctx, p)
+}
+
+// CreateCoachAction mocks base method.
+func (m *MockRepo) CreateCoachAction(ctx context.Context, p CreateCoachActionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCoachAction", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCoachAction indicates an expected call of CreateCoachAction.
+func (mr *MockRepoMockRecorder) CreateCoachAction(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCoachAction", reflect.TypeOf((*MockRepo)(nil).CreateCoachAction), ctx, p)
+}
+
+// CreateDataExport mocks base method.
+func (m *MockRepo) CreateDataExport(ctx context.Context, userID string) (*orm.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDataExport", ctx, userID)
+	ret0, _ := ret[0].(*orm.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDataExport indicates an expected call of CreateDataExport.
+func (mr *MockRepoMockRecorder) CreateDataExport(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataExport", reflect.TypeOf((*MockRepo)(nil).CreateDataExport), ctx, userID)
+}
+
 // CreateExercise mocks base method.
 func (m *MockRepo) CreateExercise(ctx context.Context, p CreateExerciseParams) (*orm.Exercise, error) {
 	m.ctrl.T.Helper()
@@ -120,6 +339,34 @@ func (mr *MockRepoMockRecorder) CreateExercise(ctx, p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExercise", reflect.TypeOf((*MockRepo)(nil).CreateExercise), ctx, p)
 }
 
+// CreateFollowRequest mocks base method.
+func (m *MockRepo) CreateFollowRequest(ctx context.Context, p FollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFollowRequest", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFollowRequest indicates an expected call of CreateFollowRequest.
+func (mr *MockRepoMockRecorder) CreateFollowRequest(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFollowRequest", reflect.TypeOf((*MockRepo)(nil).CreateFollowRequest), ctx, p)
+}
+
+// CreateGroupedNotification mocks base method.
+func (m *MockRepo) CreateGroupedNotification(ctx context.Context, p CreateNotificationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupedNotification", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGroupedNotification indicates an expected call of CreateGroupedNotification.
+func (mr *MockRepoMockRecorder) CreateGroupedNotification(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupedNotification", reflect.TypeOf((*MockRepo)(nil).CreateGroupedNotification), ctx, p)
+}
+
 // CreateNotification mocks base method.
 func (m *MockRepo) CreateNotification(ctx context.Context, p CreateNotificationParams) error {
 	m.ctrl.T.Helper()
@@ -134,6 +381,35 @@ func (mr *MockRepoMockRecorder) CreateNotification(ctx, p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockRepo)(nil).CreateNotification), ctx, p)
 }
 
+// CreatePersonalRecords mocks base method.
+func (m *MockRepo) CreatePersonalRecords(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonalRecords", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePersonalRecords indicates an expected call of CreatePersonalRecords.
+func (mr *MockRepoMockRecorder) CreatePersonalRecords(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalRecords", reflect.TypeOf((*MockRepo)(nil).CreatePersonalRecords), ctx, userID)
+}
+
+// CreateReport mocks base method.
+func (m *MockRepo) CreateReport(ctx context.Context, p CreateReportParams) (*orm.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", ctx, p)
+	ret0, _ := ret[0].(*orm.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockRepoMockRecorder) CreateReport(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockRepo)(nil).CreateReport), ctx, p)
+}
+
 // CreateRoutine mocks base method.
 func (m *MockRepo) CreateRoutine(ctx context.Context, p CreateRoutineParams) (*orm.Routine, error) {
 	m.ctrl.T.Helper()
@@ -199,6 +475,77 @@ func (mr *MockRepoMockRecorder) CreateWorkoutComment(ctx, p any, opts ...any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkoutComment", reflect.TypeOf((*MockRepo)(nil).CreateWorkoutComment), varargs...)
 }
 
+// CreateYearSummary mocks base method.
+func (m *MockRepo) CreateYearSummary(ctx context.Context, p CreateYearSummaryParams) (*orm.YearSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateYearSummary", ctx, p)
+	ret0, _ := ret[0].(*orm.YearSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateYearSummary indicates an expected call of CreateYearSummary.
+func (mr *MockRepoMockRecorder) CreateYearSummary(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateYearSummary", reflect.TypeOf((*MockRepo)(nil).CreateYearSummary), ctx, p)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockRepo) DeleteAccount(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockRepoMockRecorder) DeleteAccount(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockRepo)(nil).DeleteAccount), ctx, userID)
+}
+
+// DeleteClubMember mocks base method.
+func (m *MockRepo) DeleteClubMember(ctx context.Context, clubID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClubMember", ctx, clubID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClubMember indicates an expected call of DeleteClubMember.
+func (mr *MockRepoMockRecorder) DeleteClubMember(ctx, clubID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClubMember", reflect.TypeOf((*MockRepo)(nil).DeleteClubMember), ctx, clubID, userID)
+}
+
+// DeleteFollowRequest mocks base method.
+func (m *MockRepo) DeleteFollowRequest(ctx context.Context, p FollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFollowRequest", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFollowRequest indicates an expected call of DeleteFollowRequest.
+func (mr *MockRepoMockRecorder) DeleteFollowRequest(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFollowRequest", reflect.TypeOf((*MockRepo)(nil).DeleteFollowRequest), ctx, p)
+}
+
+// DeleteGroupedNotifications mocks base method.
+func (m *MockRepo) DeleteGroupedNotifications(ctx context.Context, userID string, notificationType orm.NotificationType, workoutID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupedNotifications", ctx, userID, notificationType, workoutID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroupedNotifications indicates an expected call of DeleteGroupedNotifications.
+func (mr *MockRepoMockRecorder) DeleteGroupedNotifications(ctx, userID, notificationType, workoutID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupedNotifications", reflect.TypeOf((*MockRepo)(nil).DeleteGroupedNotifications), ctx, userID, notificationType, workoutID)
+}
+
 // DeleteRoutine mocks base method.
 func (m *MockRepo) DeleteRoutine(ctx context.Context, routineID string) error {
 	m.ctrl.T.Helper()
@@ -232,6 +579,34 @@ func (mr *MockRepoMockRecorder) DeleteWorkout(ctx any, opts ...any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkout", reflect.TypeOf((*MockRepo)(nil).DeleteWorkout), varargs...)
 }
 
+// DeleteWorkoutComment mocks base method.
+func (m *MockRepo) DeleteWorkoutComment(ctx context.Context, commentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkoutComment", ctx, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkoutComment indicates an expected call of DeleteWorkoutComment.
+func (mr *MockRepoMockRecorder) DeleteWorkoutComment(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkoutComment", reflect.TypeOf((*MockRepo)(nil).DeleteWorkoutComment), ctx, commentID)
+}
+
+// DeleteWorkoutReaction mocks base method.
+func (m *MockRepo) DeleteWorkoutReaction(ctx context.Context, userID, workoutID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkoutReaction", ctx, userID, workoutID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkoutReaction indicates an expected call of DeleteWorkoutReaction.
+func (mr *MockRepoMockRecorder) DeleteWorkoutReaction(ctx, userID, workoutID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkoutReaction", reflect.TypeOf((*MockRepo)(nil).DeleteWorkoutReaction), ctx, userID, workoutID)
+}
+
 // Follow mocks base method.
 func (m *MockRepo) Follow(ctx context.Context, p FollowParams) error {
 	m.ctrl.T.Helper()
@@ -266,1178 +641,1182 @@ func (mr *MockRepoMockRecorder) GetAuth(ctx any, opts ...any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuth", reflect.TypeOf((*MockRepo)(nil).GetAuth), varargs...)
 }
 
-// GetExercise mocks base method.
-func (m *MockRepo) GetExercise(ctx context.Context, opts ...GetExerciseOpt) (*orm.Exercise, error) {
+// GetBodyweightAt mocks base method.
+func (m *MockRepo) GetBodyweightAt(ctx context.Context, userID string, at time.Time) (*orm.Bodyweight, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetExercise", varargs...)
-	ret0, _ := ret[0].(*orm.Exercise)
+	ret := m.ctrl.Call(m, "GetBodyweightAt", ctx, userID, at)
+	ret0, _ := ret[0].(*orm.Bodyweight)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetExercise indicates an expected call of GetExercise.
-func (mr *MockRepoMockRecorder) GetExercise(ctx any, opts ...any) *gomock.Call {
+// GetBodyweightAt indicates an expected call of GetBodyweightAt.
+func (mr *MockRepoMockRecorder) GetBodyweightAt(ctx, userID, at any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExercise", reflect.TypeOf((*MockRepo)(nil).GetExercise), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBodyweightAt", reflect.TypeOf((*MockRepo)(nil).GetBodyweightAt), ctx, userID, at)
 }
 
-// GetPersonalBests mocks base method.
-func (m *MockRepo) GetPersonalBests(ctx context.Context, userIDs ...string) (orm.SetSlice, error) {
+// GetChallenge mocks base method.
+func (m *MockRepo) GetChallenge(ctx context.Context, opts ...GetChallengeOpt) (*orm.Challenge, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range userIDs {
+	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetPersonalBests", varargs...)
-	ret0, _ := ret[0].(orm.SetSlice)
+	ret := m.ctrl.Call(m, "GetChallenge", varargs...)
+	ret0, _ := ret[0].(*orm.Challenge)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPersonalBests indicates an expected call of GetPersonalBests.
-func (mr *MockRepoMockRecorder) GetPersonalBests(ctx any, userIDs ...any) *gomock.Call {
+// GetChallenge indicates an expected call of GetChallenge.
+func (mr *MockRepoMockRecorder) GetChallenge(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, userIDs...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalBests", reflect.TypeOf((*MockRepo)(nil).GetPersonalBests), varargs...)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChallenge", reflect.TypeOf((*MockRepo)(nil).GetChallenge), varargs...)
 }
 
-// GetPreviousWorkoutSets mocks base method.
-func (m *MockRepo) GetPreviousWorkoutSets(ctx context.Context, exerciseIDs []string) (orm.SetSlice, error) {
+// GetChallengeStandings mocks base method.
+func (m *MockRepo) GetChallengeStandings(ctx context.Context, challengeID string) ([]ChallengeStanding, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPreviousWorkoutSets", ctx, exerciseIDs)
-	ret0, _ := ret[0].(orm.SetSlice)
+	ret := m.ctrl.Call(m, "GetChallengeStandings", ctx, challengeID)
+	ret0, _ := ret[0].([]ChallengeStanding)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPreviousWorkoutSets indicates an expected call of GetPreviousWorkoutSets.
-func (mr *MockRepoMockRecorder) GetPreviousWorkoutSets(ctx, exerciseIDs any) *gomock.Call {
+// GetChallengeStandings indicates an expected call of GetChallengeStandings.
+func (mr *MockRepoMockRecorder) GetChallengeStandings(ctx, challengeID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviousWorkoutSets", reflect.TypeOf((*MockRepo)(nil).GetPreviousWorkoutSets), ctx, exerciseIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChallengeStandings", reflect.TypeOf((*MockRepo)(nil).GetChallengeStandings), ctx, challengeID)
 }
 
-// GetRoutine mocks base method.
-func (m *MockRepo) GetRoutine(ctx context.Context, opts ...GetRoutineOpt) (*orm.Routine, error) {
+// GetClub mocks base method.
+func (m *MockRepo) GetClub(ctx context.Context, opts ...GetClubOpt) (*orm.Club, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetRoutine", varargs...)
-	ret0, _ := ret[0].(*orm.Routine)
+	ret := m.ctrl.Call(m, "GetClub", varargs...)
+	ret0, _ := ret[0].(*orm.Club)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRoutine indicates an expected call of GetRoutine.
-func (mr *MockRepoMockRecorder) GetRoutine(ctx any, opts ...any) *gomock.Call {
+// GetClub indicates an expected call of GetClub.
+func (mr *MockRepoMockRecorder) GetClub(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutine", reflect.TypeOf((*MockRepo)(nil).GetRoutine), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClub", reflect.TypeOf((*MockRepo)(nil).GetClub), varargs...)
 }
 
-// GetUser mocks base method.
-func (m *MockRepo) GetUser(ctx context.Context, opts ...GetUserOpt) (*orm.User, error) {
+// GetClubMember mocks base method.
+func (m *MockRepo) GetClubMember(ctx context.Context, clubID, userID string) (*orm.ClubMember, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetUser", varargs...)
-	ret0, _ := ret[0].(*orm.User)
+	ret := m.ctrl.Call(m, "GetClubMember", ctx, clubID, userID)
+	ret0, _ := ret[0].(*orm.ClubMember)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockRepoMockRecorder) GetUser(ctx any, opts ...any) *gomock.Call {
+// GetClubMember indicates an expected call of GetClubMember.
+func (mr *MockRepoMockRecorder) GetClubMember(ctx, clubID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepo)(nil).GetUser), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClubMember", reflect.TypeOf((*MockRepo)(nil).GetClubMember), ctx, clubID, userID)
 }
 
-// GetWorkout mocks base method.
-func (m *MockRepo) GetWorkout(ctx context.Context, opts ...GetWorkoutOpt) (*orm.Workout, error) {
+// GetDataExport mocks base method.
+func (m *MockRepo) GetDataExport(ctx context.Context, opts ...GetDataExportOpt) (*orm.DataExport, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetWorkout", varargs...)
-	ret0, _ := ret[0].(*orm.Workout)
+	ret := m.ctrl.Call(m, "GetDataExport", varargs...)
+	ret0, _ := ret[0].(*orm.DataExport)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWorkout indicates an expected call of GetWorkout.
-func (mr *MockRepoMockRecorder) GetWorkout(ctx any, opts ...any) *gomock.Call {
+// GetDataExport indicates an expected call of GetDataExport.
+func (mr *MockRepoMockRecorder) GetDataExport(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkout", reflect.TypeOf((*MockRepo)(nil).GetWorkout), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataExport", reflect.TypeOf((*MockRepo)(nil).GetDataExport), varargs...)
 }
 
-// GetWorkoutComment mocks base method.
-func (m *MockRepo) GetWorkoutComment(ctx context.Context, opts ...GetWorkoutCommentOpt) (*orm.WorkoutComment, error) {
+// GetExercise mocks base method.
+func (m *MockRepo) GetExercise(ctx context.Context, opts ...GetExerciseOpt) (*orm.Exercise, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetWorkoutComment", varargs...)
-	ret0, _ := ret[0].(*orm.WorkoutComment)
+	ret := m.ctrl.Call(m, "GetExercise", varargs...)
+	ret0, _ := ret[0].(*orm.Exercise)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWorkoutComment indicates an expected call of GetWorkoutComment.
-func (mr *MockRepoMockRecorder) GetWorkoutComment(ctx any, opts ...any) *gomock.Call {
+// GetExercise indicates an expected call of GetExercise.
+func (mr *MockRepoMockRecorder) GetExercise(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkoutComment", reflect.TypeOf((*MockRepo)(nil).GetWorkoutComment), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExercise", reflect.TypeOf((*MockRepo)(nil).GetExercise), varargs...)
 }
 
-// IsUserFollowedByUserID mocks base method.
-func (m *MockRepo) IsUserFollowedByUserID(ctx context.Context, user *orm.User, userID string) (bool, error) {
+// GetExerciseProgress mocks base method.
+func (m *MockRepo) GetExerciseProgress(ctx context.Context, p GetExerciseProgressParams) ([]ExerciseProgress, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsUserFollowedByUserID", ctx, user, userID)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "GetExerciseProgress", ctx, p)
+	ret0, _ := ret[0].([]ExerciseProgress)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsUserFollowedByUserID indicates an expected call of IsUserFollowedByUserID.
-func (mr *MockRepoMockRecorder) IsUserFollowedByUserID(ctx, user, userID any) *gomock.Call {
+// GetExerciseProgress indicates an expected call of GetExerciseProgress.
+func (mr *MockRepoMockRecorder) GetExerciseProgress(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserFollowedByUserID", reflect.TypeOf((*MockRepo)(nil).IsUserFollowedByUserID), ctx, user, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExerciseProgress", reflect.TypeOf((*MockRepo)(nil).GetExerciseProgress), ctx, p)
 }
 
-// ListExercises mocks base method.
-func (m *MockRepo) ListExercises(ctx context.Context, opts ...ListExercisesOpt) (orm.ExerciseSlice, error) {
+// GetLeaderboard mocks base method.
+func (m *MockRepo) GetLeaderboard(ctx context.Context, p GetLeaderboardParams) ([]LeaderboardEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderboard", ctx, p)
+	ret0, _ := ret[0].([]LeaderboardEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderboard indicates an expected call of GetLeaderboard.
+func (mr *MockRepoMockRecorder) GetLeaderboard(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboard", reflect.TypeOf((*MockRepo)(nil).GetLeaderboard), ctx, p)
+}
+
+// GetPersonalBests mocks base method.
+func (m *MockRepo) GetPersonalBests(ctx context.Context, userIDs ...string) (orm.SetSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range opts {
+	for _, a := range userIDs {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListExercises", varargs...)
-	ret0, _ := ret[0].(orm.ExerciseSlice)
+	ret := m.ctrl.Call(m, "GetPersonalBests", varargs...)
+	ret0, _ := ret[0].(orm.SetSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListExercises indicates an expected call of ListExercises.
-func (mr *MockRepoMockRecorder) ListExercises(ctx any, opts ...any) *gomock.Call {
+// GetPersonalBests indicates an expected call of GetPersonalBests.
+func (mr *MockRepoMockRecorder) GetPersonalBests(ctx any, userIDs ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExercises", reflect.TypeOf((*MockRepo)(nil).ListExercises), varargs...)
+	varargs := append([]any{ctx}, userIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalBests", reflect.TypeOf((*MockRepo)(nil).GetPersonalBests), varargs...)
 }
 
-// ListFollowees mocks base method.
-func (m *MockRepo) ListFollowees(ctx context.Context, userID string, opts ...ListFolloweesOpt) (orm.UserSlice, error) {
+// GetPreviousWorkoutSets mocks base method.
+func (m *MockRepo) GetPreviousWorkoutSets(ctx context.Context, exerciseIDs []string) (orm.SetSlice, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, userID}
+	ret := m.ctrl.Call(m, "GetPreviousWorkoutSets", ctx, exerciseIDs)
+	ret0, _ := ret[0].(orm.SetSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreviousWorkoutSets indicates an expected call of GetPreviousWorkoutSets.
+func (mr *MockRepoMockRecorder) GetPreviousWorkoutSets(ctx, exerciseIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviousWorkoutSets", reflect.TypeOf((*MockRepo)(nil).GetPreviousWorkoutSets), ctx, exerciseIDs)
+}
+
+// GetReport mocks base method.
+func (m *MockRepo) GetReport(ctx context.Context, opts ...GetReportOpt) (*orm.Report, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListFollowees", varargs...)
-	ret0, _ := ret[0].(orm.UserSlice)
+	ret := m.ctrl.Call(m, "GetReport", varargs...)
+	ret0, _ := ret[0].(*orm.Report)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListFollowees indicates an expected call of ListFollowees.
-func (mr *MockRepoMockRecorder) ListFollowees(ctx, userID any, opts ...any) *gomock.Call {
+// GetReport indicates an expected call of GetReport.
+func (mr *MockRepoMockRecorder) GetReport(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, userID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowees", reflect.TypeOf((*MockRepo)(nil).ListFollowees), varargs...)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockRepo)(nil).GetReport), varargs...)
 }
 
-// ListFollowers mocks base method.
-func (m *MockRepo) ListFollowers(ctx context.Context, userID string, opts ...ListFollowersOpt) (orm.UserSlice, error) {
+// GetRoutine mocks base method.
+func (m *MockRepo) GetRoutine(ctx context.Context, opts ...GetRoutineOpt) (*orm.Routine, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, userID}
+	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListFollowers", varargs...)
-	ret0, _ := ret[0].(orm.UserSlice)
+	ret := m.ctrl.Call(m, "GetRoutine", varargs...)
+	ret0, _ := ret[0].(*orm.Routine)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListFollowers indicates an expected call of ListFollowers.
-func (mr *MockRepoMockRecorder) ListFollowers(ctx, userID any, opts ...any) *gomock.Call {
+// GetRoutine indicates an expected call of GetRoutine.
+func (mr *MockRepoMockRecorder) GetRoutine(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, userID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowers", reflect.TypeOf((*MockRepo)(nil).ListFollowers), varargs...)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutine", reflect.TypeOf((*MockRepo)(nil).GetRoutine), varargs...)
 }
 
-// ListNotifications mocks base method.
-func (m *MockRepo) ListNotifications(ctx context.Context, opts ...ListNotificationsOpt) (orm.NotificationSlice, error) {
+// GetUser mocks base method.
+func (m *MockRepo) GetUser(ctx context.Context, opts ...GetUserOpt) (*orm.User, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListNotifications", varargs...)
-	ret0, _ := ret[0].(orm.NotificationSlice)
+	ret := m.ctrl.Call(m, "GetUser", varargs...)
+	ret0, _ := ret[0].(*orm.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListNotifications indicates an expected call of ListNotifications.
-func (mr *MockRepoMockRecorder) ListNotifications(ctx any, opts ...any) *gomock.Call {
+// GetUser indicates an expected call of GetUser.
+func (mr *MockRepoMockRecorder) GetUser(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockRepo)(nil).ListNotifications), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepo)(nil).GetUser), varargs...)
 }
 
-// ListRoutines mocks base method.
-func (m *MockRepo) ListRoutines(ctx context.Context, opts ...ListRoutineOpt) (orm.RoutineSlice, error) {
+// GetVisiblePersonalBests mocks base method.
+func (m *MockRepo) GetVisiblePersonalBests(ctx context.Context, viewerID string, userIDs ...string) (orm.SetSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, viewerID}
+	for _, a := range userIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVisiblePersonalBests", varargs...)
+	ret0, _ := ret[0].(orm.SetSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVisiblePersonalBests indicates an expected call of GetVisiblePersonalBests.
+func (mr *MockRepoMockRecorder) GetVisiblePersonalBests(ctx, viewerID any, userIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, viewerID}, userIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisiblePersonalBests", reflect.TypeOf((*MockRepo)(nil).GetVisiblePersonalBests), varargs...)
+}
+
+// GetWorkout mocks base method.
+func (m *MockRepo) GetWorkout(ctx context.Context, opts ...GetWorkoutOpt) (*orm.Workout, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListRoutines", varargs...)
-	ret0, _ := ret[0].(orm.RoutineSlice)
+	ret := m.ctrl.Call(m, "GetWorkout", varargs...)
+	ret0, _ := ret[0].(*orm.Workout)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRoutines indicates an expected call of ListRoutines.
-func (mr *MockRepoMockRecorder) ListRoutines(ctx any, opts ...any) *gomock.Call {
+// GetWorkout indicates an expected call of GetWorkout.
+func (mr *MockRepoMockRecorder) GetWorkout(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutines", reflect.TypeOf((*MockRepo)(nil).ListRoutines), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkout", reflect.TypeOf((*MockRepo)(nil).GetWorkout), varargs...)
 }
 
-// ListSets mocks base method.
-func (m *MockRepo) ListSets(ctx context.Context, opts ...ListSetsOpt) (orm.SetSlice, error) {
+// GetWorkoutComment mocks base method.
+func (m *MockRepo) GetWorkoutComment(ctx context.Context, opts ...GetWorkoutCommentOpt) (*orm.WorkoutComment, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListSets", varargs...)
-	ret0, _ := ret[0].(orm.SetSlice)
+	ret := m.ctrl.Call(m, "GetWorkoutComment", varargs...)
+	ret0, _ := ret[0].(*orm.WorkoutComment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListSets indicates an expected call of ListSets.
-func (mr *MockRepoMockRecorder) ListSets(ctx any, opts ...any) *gomock.Call {
+// GetWorkoutComment indicates an expected call of GetWorkoutComment.
+func (mr *MockRepoMockRecorder) GetWorkoutComment(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSets", reflect.TypeOf((*MockRepo)(nil).ListSets), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkoutComment", reflect.TypeOf((*MockRepo)(nil).GetWorkoutComment), varargs...)
 }
 
-// ListUsers mocks base method.
-func (m *MockRepo) ListUsers(ctx context.Context, opts ...ListUsersOpt) (orm.UserSlice, error) {
+// GetWorkoutReaction mocks base method.
+func (m *MockRepo) GetWorkoutReaction(ctx context.Context, opts ...GetWorkoutReactionOpt) (*orm.WorkoutReaction, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListUsers", varargs...)
-	ret0, _ := ret[0].(orm.UserSlice)
+	ret := m.ctrl.Call(m, "GetWorkoutReaction", varargs...)
+	ret0, _ := ret[0].(*orm.WorkoutReaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListUsers indicates an expected call of ListUsers.
-func (mr *MockRepoMockRecorder) ListUsers(ctx any, opts ...any) *gomock.Call {
+// GetWorkoutReaction indicates an expected call of GetWorkoutReaction.
+func (mr *MockRepoMockRecorder) GetWorkoutReaction(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockRepo)(nil).ListUsers), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkoutReaction", reflect.TypeOf((*MockRepo)(nil).GetWorkoutReaction), varargs...)
 }
 
-// ListWorkouts mocks base method.
-func (m *MockRepo) ListWorkouts(ctx context.Context, opts ...ListWorkoutsOpt) (orm.WorkoutSlice, error) {
+// GetYearSummary mocks base method.
+func (m *MockRepo) GetYearSummary(ctx context.Context, opts ...GetYearSummaryOpt) (*orm.YearSummary, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListWorkouts", varargs...)
-	ret0, _ := ret[0].(orm.WorkoutSlice)
+	ret := m.ctrl.Call(m, "GetYearSummary", varargs...)
+	ret0, _ := ret[0].(*orm.YearSummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListWorkouts indicates an expected call of ListWorkouts.
-func (mr *MockRepoMockRecorder) ListWorkouts(ctx any, opts ...any) *gomock.Call {
+// GetYearSummary indicates an expected call of GetYearSummary.
+func (mr *MockRepoMockRecorder) GetYearSummary(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkouts", reflect.TypeOf((*MockRepo)(nil).ListWorkouts), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearSummary", reflect.TypeOf((*MockRepo)(nil).GetYearSummary), varargs...)
 }
 
-// MarkNotificationsAsRead mocks base method.
-func (m *MockRepo) MarkNotificationsAsRead(ctx context.Context, userID string) error {
+// GrantCoachAccess mocks base method.
+func (m *MockRepo) GrantCoachAccess(ctx context.Context, p CoachingParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkNotificationsAsRead", ctx, userID)
+	ret := m.ctrl.Call(m, "GrantCoachAccess", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MarkNotificationsAsRead indicates an expected call of MarkNotificationsAsRead.
-func (mr *MockRepoMockRecorder) MarkNotificationsAsRead(ctx, userID any) *gomock.Call {
+// GrantCoachAccess indicates an expected call of GrantCoachAccess.
+func (mr *MockRepoMockRecorder) GrantCoachAccess(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsAsRead", reflect.TypeOf((*MockRepo)(nil).MarkNotificationsAsRead), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantCoachAccess", reflect.TypeOf((*MockRepo)(nil).GrantCoachAccess), ctx, p)
 }
 
-// NewTx mocks base method.
-func (m *MockRepo) NewTx(ctx context.Context, f func(Tx) error) error {
+// HideWorkout mocks base method.
+func (m *MockRepo) HideWorkout(ctx context.Context, workoutID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewTx", ctx, f)
+	ret := m.ctrl.Call(m, "HideWorkout", ctx, workoutID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// NewTx indicates an expected call of NewTx.
-func (mr *MockRepoMockRecorder) NewTx(ctx, f any) *gomock.Call {
+// HideWorkout indicates an expected call of HideWorkout.
+func (mr *MockRepoMockRecorder) HideWorkout(ctx, workoutID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTx", reflect.TypeOf((*MockRepo)(nil).NewTx), ctx, f)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideWorkout", reflect.TypeOf((*MockRepo)(nil).HideWorkout), ctx, workoutID)
 }
 
-// PostCreateWorkoutCommentLoadUser mocks base method.
-func (m *MockRepo) PostCreateWorkoutCommentLoadUser(ctx context.Context) CreateWorkoutCommentOpts {
+// HideWorkoutComment mocks base method.
+func (m *MockRepo) HideWorkoutComment(ctx context.Context, commentID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PostCreateWorkoutCommentLoadUser", ctx)
-	ret0, _ := ret[0].(CreateWorkoutCommentOpts)
+	ret := m.ctrl.Call(m, "HideWorkoutComment", ctx, commentID)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PostCreateWorkoutCommentLoadUser indicates an expected call of PostCreateWorkoutCommentLoadUser.
-func (mr *MockRepoMockRecorder) PostCreateWorkoutCommentLoadUser(ctx any) *gomock.Call {
+// HideWorkoutComment indicates an expected call of HideWorkoutComment.
+func (mr *MockRepoMockRecorder) HideWorkoutComment(ctx, commentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostCreateWorkoutCommentLoadUser", reflect.TypeOf((*MockRepo)(nil).PostCreateWorkoutCommentLoadUser), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideWorkoutComment", reflect.TypeOf((*MockRepo)(nil).HideWorkoutComment), ctx, commentID)
 }
 
-// PublishEvent mocks base method.
-func (m *MockRepo) PublishEvent(ctx context.Context, topic orm.EventTopic, payload []byte) error {
+// InviteToChallenge mocks base method.
+func (m *MockRepo) InviteToChallenge(ctx context.Context, challengeID string, userIDs []string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublishEvent", ctx, topic, payload)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "InviteToChallenge", ctx, challengeID, userIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// PublishEvent indicates an expected call of PublishEvent.
-func (mr *MockRepoMockRecorder) PublishEvent(ctx, topic, payload any) *gomock.Call {
+// InviteToChallenge indicates an expected call of InviteToChallenge.
+func (mr *MockRepoMockRecorder) InviteToChallenge(ctx, challengeID, userIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvent", reflect.TypeOf((*MockRepo)(nil).PublishEvent), ctx, topic, payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteToChallenge", reflect.TypeOf((*MockRepo)(nil).InviteToChallenge), ctx, challengeID, userIDs)
 }
 
-// RefreshTokenExists mocks base method.
-func (m *MockRepo) RefreshTokenExists(ctx context.Context, refreshToken string) (bool, error) {
+// IsBlocked mocks base method.
+func (m *MockRepo) IsBlocked(ctx context.Context, userID string, otherUserIDs ...string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefreshTokenExists", ctx, refreshToken)
+	varargs := []any{ctx, userID}
+	for _, a := range otherUserIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IsBlocked", varargs...)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RefreshTokenExists indicates an expected call of RefreshTokenExists.
-func (mr *MockRepoMockRecorder) RefreshTokenExists(ctx, refreshToken any) *gomock.Call {
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockRepoMockRecorder) IsBlocked(ctx, userID any, otherUserIDs ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokenExists", reflect.TypeOf((*MockRepo)(nil).RefreshTokenExists), ctx, refreshToken)
+	varargs := append([]any{ctx, userID}, otherUserIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockRepo)(nil).IsBlocked), varargs...)
 }
 
-// RemoveExerciseFromRoutine mocks base method.
-func (m *MockRepo) RemoveExerciseFromRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
+// IsCoach mocks base method.
+func (m *MockRepo) IsCoach(ctx context.Context, p CoachingParams) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveExerciseFromRoutine", ctx, exercise, routine)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "IsCoach", ctx, p)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RemoveExerciseFromRoutine indicates an expected call of RemoveExerciseFromRoutine.
-func (mr *MockRepoMockRecorder) RemoveExerciseFromRoutine(ctx, exercise, routine any) *gomock.Call {
+// IsCoach indicates an expected call of IsCoach.
+func (mr *MockRepoMockRecorder) IsCoach(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExerciseFromRoutine", reflect.TypeOf((*MockRepo)(nil).RemoveExerciseFromRoutine), ctx, exercise, routine)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCoach", reflect.TypeOf((*MockRepo)(nil).IsCoach), ctx, p)
 }
 
-// SetRoutineExercises mocks base method.
-func (m *MockRepo) SetRoutineExercises(ctx context.Context, routine *orm.Routine, exercises orm.ExerciseSlice) error {
+// IsUserFollowedByUserID mocks base method.
+func (m *MockRepo) IsUserFollowedByUserID(ctx context.Context, user *orm.User, userID string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetRoutineExercises", ctx, routine, exercises)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "IsUserFollowedByUserID", ctx, user, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SetRoutineExercises indicates an expected call of SetRoutineExercises.
-func (mr *MockRepoMockRecorder) SetRoutineExercises(ctx, routine, exercises any) *gomock.Call {
+// IsUserFollowedByUserID indicates an expected call of IsUserFollowedByUserID.
+func (mr *MockRepoMockRecorder) IsUserFollowedByUserID(ctx, user, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoutineExercises", reflect.TypeOf((*MockRepo)(nil).SetRoutineExercises), ctx, routine, exercises)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserFollowedByUserID", reflect.TypeOf((*MockRepo)(nil).IsUserFollowedByUserID), ctx, user, userID)
 }
 
-// SoftDeleteExercise mocks base method.
-func (m *MockRepo) SoftDeleteExercise(ctx context.Context, p SoftDeleteExerciseParams) error {
+// JoinChallenge mocks base method.
+func (m *MockRepo) JoinChallenge(ctx context.Context, challengeID, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SoftDeleteExercise", ctx, p)
+	ret := m.ctrl.Call(m, "JoinChallenge", ctx, challengeID, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SoftDeleteExercise indicates an expected call of SoftDeleteExercise.
-func (mr *MockRepoMockRecorder) SoftDeleteExercise(ctx, p any) *gomock.Call {
+// JoinChallenge indicates an expected call of JoinChallenge.
+func (mr *MockRepoMockRecorder) JoinChallenge(ctx, challengeID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteExercise", reflect.TypeOf((*MockRepo)(nil).SoftDeleteExercise), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChallenge", reflect.TypeOf((*MockRepo)(nil).JoinChallenge), ctx, challengeID, userID)
 }
 
-// StoreTrace mocks base method.
-func (m *MockRepo) StoreTrace(ctx context.Context, p StoreTraceParams) error {
+// LeaveChallenge mocks base method.
+func (m *MockRepo) LeaveChallenge(ctx context.Context, challengeID, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StoreTrace", ctx, p)
+	ret := m.ctrl.Call(m, "LeaveChallenge", ctx, challengeID, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// StoreTrace indicates an expected call of StoreTrace.
-func (mr *MockRepoMockRecorder) StoreTrace(ctx, p any) *gomock.Call {
+// LeaveChallenge indicates an expected call of LeaveChallenge.
+func (mr *MockRepoMockRecorder) LeaveChallenge(ctx, challengeID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreTrace", reflect.TypeOf((*MockRepo)(nil).StoreTrace), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveChallenge", reflect.TypeOf((*MockRepo)(nil).LeaveChallenge), ctx, challengeID, userID)
 }
 
-// Unfollow mocks base method.
-func (m *MockRepo) Unfollow(ctx context.Context, p UnfollowParams) error {
+// ListAchievements mocks base method.
+func (m *MockRepo) ListAchievements(ctx context.Context, opts ...ListAchievementsOpt) (orm.AchievementSlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Unfollow", ctx, p)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Unfollow indicates an expected call of Unfollow.
-func (mr *MockRepoMockRecorder) Unfollow(ctx, p any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockRepo)(nil).Unfollow), ctx, p)
-}
-
-// UpdateAuth mocks base method.
-func (m *MockRepo) UpdateAuth(ctx context.Context, authID string, opts ...UpdateAuthOpt) error {
-	m.ctrl.T.Helper()
-	varargs := []any{ctx, authID}
+	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateAuth", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListAchievements", varargs...)
+	ret0, _ := ret[0].(orm.AchievementSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateAuth indicates an expected call of UpdateAuth.
-func (mr *MockRepoMockRecorder) UpdateAuth(ctx, authID any, opts ...any) *gomock.Call {
+// ListAchievements indicates an expected call of ListAchievements.
+func (mr *MockRepoMockRecorder) ListAchievements(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, authID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuth", reflect.TypeOf((*MockRepo)(nil).UpdateAuth), varargs...)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAchievements", reflect.TypeOf((*MockRepo)(nil).ListAchievements), varargs...)
 }
 
-// UpdateExercise mocks base method.
-func (m *MockRepo) UpdateExercise(ctx context.Context, exerciseID string, opts ...UpdateExerciseOpt) error {
+// ListAthleteSummaries mocks base method.
+func (m *MockRepo) ListAthleteSummaries(ctx context.Context, coachID string, now time.Time) ([]AthleteSummary, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, exerciseID}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "UpdateExercise", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListAthleteSummaries", ctx, coachID, now)
+	ret0, _ := ret[0].([]AthleteSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateExercise indicates an expected call of UpdateExercise.
-func (mr *MockRepoMockRecorder) UpdateExercise(ctx, exerciseID any, opts ...any) *gomock.Call {
+// ListAthleteSummaries indicates an expected call of ListAthleteSummaries.
+func (mr *MockRepoMockRecorder) ListAthleteSummaries(ctx, coachID, now any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, exerciseID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExercise", reflect.TypeOf((*MockRepo)(nil).UpdateExercise), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAthleteSummaries", reflect.TypeOf((*MockRepo)(nil).ListAthleteSummaries), ctx, coachID, now)
 }
 
-// UpdateRoutine mocks base method.
-func (m *MockRepo) UpdateRoutine(ctx context.Context, routineID string, opts ...UpdateRoutineOpt) error {
+// ListBlockedUsers mocks base method.
+func (m *MockRepo) ListBlockedUsers(ctx context.Context, userID string) (orm.UserSlice, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, routineID}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "UpdateRoutine", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListBlockedUsers", ctx, userID)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateRoutine indicates an expected call of UpdateRoutine.
-func (mr *MockRepoMockRecorder) UpdateRoutine(ctx, routineID any, opts ...any) *gomock.Call {
+// ListBlockedUsers indicates an expected call of ListBlockedUsers.
+func (mr *MockRepoMockRecorder) ListBlockedUsers(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, routineID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoutine", reflect.TypeOf((*MockRepo)(nil).UpdateRoutine), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlockedUsers", reflect.TypeOf((*MockRepo)(nil).ListBlockedUsers), ctx, userID)
 }
 
-// UpdateWorkout mocks base method.
-func (m *MockRepo) UpdateWorkout(ctx context.Context, workoutID string, opts ...UpdateWorkoutOpt) error {
+// ListChallenges mocks base method.
+func (m *MockRepo) ListChallenges(ctx context.Context, opts ...ListChallengesOpt) (orm.ChallengeSlice, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, workoutID}
+	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateWorkout", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListChallenges", varargs...)
+	ret0, _ := ret[0].(orm.ChallengeSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateWorkout indicates an expected call of UpdateWorkout.
-func (mr *MockRepoMockRecorder) UpdateWorkout(ctx, workoutID any, opts ...any) *gomock.Call {
+// ListChallenges indicates an expected call of ListChallenges.
+func (mr *MockRepoMockRecorder) ListChallenges(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, workoutID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkout", reflect.TypeOf((*MockRepo)(nil).UpdateWorkout), varargs...)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChallenges", reflect.TypeOf((*MockRepo)(nil).ListChallenges), varargs...)
 }
 
-// UpdateWorkoutSets mocks base method.
-func (m *MockRepo) UpdateWorkoutSets(ctx context.Context, p UpdateWorkoutSetsParams) error {
+// ListClubMembers mocks base method.
+func (m *MockRepo) ListClubMembers(ctx context.Context, opts ...ListClubMembersOpt) (orm.ClubMemberSlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateWorkoutSets", ctx, p)
-	ret0, _ := ret[0].(error)
-	return ret0
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClubMembers", varargs...)
+	ret0, _ := ret[0].(orm.ClubMemberSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateWorkoutSets indicates an expected call of UpdateWorkoutSets.
-func (mr *MockRepoMockRecorder) UpdateWorkoutSets(ctx, p any) *gomock.Call {
+// ListClubMembers indicates an expected call of ListClubMembers.
+func (mr *MockRepoMockRecorder) ListClubMembers(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkoutSets", reflect.TypeOf((*MockRepo)(nil).UpdateWorkoutSets), ctx, p)
-}
-
-// MockTx is a mock of Tx interface.
-type MockTx struct {
-	ctrl     *gomock.Controller
-	recorder *MockTxMockRecorder
-	isgomock struct{}
-}
-
-// MockTxMockRecorder is the mock recorder for MockTx.
-type MockTxMockRecorder struct {
-	mock *MockTx
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClubMembers", reflect.TypeOf((*MockRepo)(nil).ListClubMembers), varargs...)
 }
 
-// NewMockTx creates a new mock instance.
-func NewMockTx(ctrl *gomock.Controller) *MockTx {
-	mock := &MockTx{ctrl: ctrl}
-	mock.recorder = &MockTxMockRecorder{mock}
-	return mock
+// ListClubs mocks base method.
+func (m *MockRepo) ListClubs(ctx context.Context, opts ...ListClubsOpt) (orm.ClubSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClubs", varargs...)
+	ret0, _ := ret[0].(orm.ClubSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockTx) EXPECT() *MockTxMockRecorder {
-	return m.recorder
+// ListClubs indicates an expected call of ListClubs.
+func (mr *MockRepoMockRecorder) ListClubs(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClubs", reflect.TypeOf((*MockRepo)(nil).ListClubs), varargs...)
 }
 
-// AddExerciseToRoutine mocks base method.
-func (m *MockTx) AddExerciseToRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
+// ListCoachActions mocks base method.
+func (m *MockRepo) ListCoachActions(ctx context.Context, opts ...ListCoachActionsOpt) (orm.CoachActionSlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddExerciseToRoutine", ctx, exercise, routine)
-	ret0, _ := ret[0].(error)
-	return ret0
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCoachActions", varargs...)
+	ret0, _ := ret[0].(orm.CoachActionSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// AddExerciseToRoutine indicates an expected call of AddExerciseToRoutine.
-func (mr *MockTxMockRecorder) AddExerciseToRoutine(ctx, exercise, routine any) *gomock.Call {
+// ListCoachActions indicates an expected call of ListCoachActions.
+func (mr *MockRepoMockRecorder) ListCoachActions(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExerciseToRoutine", reflect.TypeOf((*MockTx)(nil).AddExerciseToRoutine), ctx, exercise, routine)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCoachActions", reflect.TypeOf((*MockRepo)(nil).ListCoachActions), varargs...)
 }
 
-// CompareEmailAndPassword mocks base method.
-func (m *MockTx) CompareEmailAndPassword(ctx context.Context, email, password string) error {
+// ListCoaches mocks base method.
+func (m *MockRepo) ListCoaches(ctx context.Context, athleteID string) (orm.UserSlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CompareEmailAndPassword", ctx, email, password)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListCoaches", ctx, athleteID)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CompareEmailAndPassword indicates an expected call of CompareEmailAndPassword.
-func (mr *MockTxMockRecorder) CompareEmailAndPassword(ctx, email, password any) *gomock.Call {
+// ListCoaches indicates an expected call of ListCoaches.
+func (mr *MockRepoMockRecorder) ListCoaches(ctx, athleteID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareEmailAndPassword", reflect.TypeOf((*MockTx)(nil).CompareEmailAndPassword), ctx, email, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCoaches", reflect.TypeOf((*MockRepo)(nil).ListCoaches), ctx, athleteID)
 }
 
-// CountNotifications mocks base method.
-func (m *MockTx) CountNotifications(ctx context.Context, opts ...CountNotificationsOpt) (int64, error) {
+// ListExercises mocks base method.
+func (m *MockRepo) ListExercises(ctx context.Context, opts ...ListExercisesOpt) (orm.ExerciseSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CountNotifications", varargs...)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "ListExercises", varargs...)
+	ret0, _ := ret[0].(orm.ExerciseSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CountNotifications indicates an expected call of CountNotifications.
-func (mr *MockTxMockRecorder) CountNotifications(ctx any, opts ...any) *gomock.Call {
+// ListExercises indicates an expected call of ListExercises.
+func (mr *MockRepoMockRecorder) ListExercises(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNotifications", reflect.TypeOf((*MockTx)(nil).CountNotifications), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExercises", reflect.TypeOf((*MockRepo)(nil).ListExercises), varargs...)
 }
 
-// CreateAuth mocks base method.
-func (m *MockTx) CreateAuth(ctx context.Context, email, password string) (*orm.Auth, error) {
+// ListFollowRequests mocks base method.
+func (m *MockRepo) ListFollowRequests(ctx context.Context, opts ...ListFollowRequestsOpt) (orm.FollowRequestSlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAuth", ctx, email, password)
-	ret0, _ := ret[0].(*orm.Auth)
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollowRequests", varargs...)
+	ret0, _ := ret[0].(orm.FollowRequestSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateAuth indicates an expected call of CreateAuth.
-func (mr *MockTxMockRecorder) CreateAuth(ctx, email, password any) *gomock.Call {
+// ListFollowRequests indicates an expected call of ListFollowRequests.
+func (mr *MockRepoMockRecorder) ListFollowRequests(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuth", reflect.TypeOf((*MockTx)(nil).CreateAuth), ctx, email, password)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowRequests", reflect.TypeOf((*MockRepo)(nil).ListFollowRequests), varargs...)
 }
 
-// CreateExercise mocks base method.
-func (m *MockTx) CreateExercise(ctx context.Context, p CreateExerciseParams) (*orm.Exercise, error) {
+// ListFollowees mocks base method.
+func (m *MockRepo) ListFollowees(ctx context.Context, userID string, opts ...ListFolloweesOpt) (orm.UserSlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateExercise", ctx, p)
-	ret0, _ := ret[0].(*orm.Exercise)
+	varargs := []any{ctx, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollowees", varargs...)
+	ret0, _ := ret[0].(orm.UserSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateExercise indicates an expected call of CreateExercise.
-func (mr *MockTxMockRecorder) CreateExercise(ctx, p any) *gomock.Call {
+// ListFollowees indicates an expected call of ListFollowees.
+func (mr *MockRepoMockRecorder) ListFollowees(ctx, userID any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExercise", reflect.TypeOf((*MockTx)(nil).CreateExercise), ctx, p)
+	varargs := append([]any{ctx, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowees", reflect.TypeOf((*MockRepo)(nil).ListFollowees), varargs...)
 }
 
-// CreateNotification mocks base method.
-func (m *MockTx) CreateNotification(ctx context.Context, p CreateNotificationParams) error {
+// ListFollowers mocks base method.
+func (m *MockRepo) ListFollowers(ctx context.Context, userID string, opts ...ListFollowersOpt) (orm.UserSlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNotification", ctx, p)
-	ret0, _ := ret[0].(error)
-	return ret0
+	varargs := []any{ctx, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollowers", varargs...)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateNotification indicates an expected call of CreateNotification.
-func (mr *MockTxMockRecorder) CreateNotification(ctx, p any) *gomock.Call {
+// ListFollowers indicates an expected call of ListFollowers.
+func (mr *MockRepoMockRecorder) ListFollowers(ctx, userID any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockTx)(nil).CreateNotification), ctx, p)
+	varargs := append([]any{ctx, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowers", reflect.TypeOf((*MockRepo)(nil).ListFollowers), varargs...)
 }
 
-// CreateRoutine mocks base method.
-func (m *MockTx) CreateRoutine(ctx context.Context, p CreateRoutineParams) (*orm.Routine, error) {
+// ListFollows mocks base method.
+func (m *MockRepo) ListFollows(ctx context.Context, opts ...ListFollowsOpt) (orm.FollowerSlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRoutine", ctx, p)
-	ret0, _ := ret[0].(*orm.Routine)
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollows", varargs...)
+	ret0, _ := ret[0].(orm.FollowerSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateRoutine indicates an expected call of CreateRoutine.
-func (mr *MockTxMockRecorder) CreateRoutine(ctx, p any) *gomock.Call {
+// ListFollows indicates an expected call of ListFollows.
+func (mr *MockRepoMockRecorder) ListFollows(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoutine", reflect.TypeOf((*MockTx)(nil).CreateRoutine), ctx, p)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollows", reflect.TypeOf((*MockRepo)(nil).ListFollows), varargs...)
 }
 
-// CreateUser mocks base method.
-func (m *MockTx) CreateUser(ctx context.Context, p CreateUserParams) (*orm.User, error) {
+// ListNotifications mocks base method.
+func (m *MockRepo) ListNotifications(ctx context.Context, opts ...ListNotificationsOpt) (orm.NotificationSlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, p)
-	ret0, _ := ret[0].(*orm.User)
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNotifications", varargs...)
+	ret0, _ := ret[0].(orm.NotificationSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockTxMockRecorder) CreateUser(ctx, p any) *gomock.Call {
+// ListNotifications indicates an expected call of ListNotifications.
+func (mr *MockRepoMockRecorder) ListNotifications(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockTx)(nil).CreateUser), ctx, p)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockRepo)(nil).ListNotifications), varargs...)
 }
 
-// CreateWorkout mocks base method.
-func (m *MockTx) CreateWorkout(ctx context.Context, p CreateWorkoutParams) (*orm.Workout, error) {
+// ListPersonalRecords mocks base method.
+func (m *MockRepo) ListPersonalRecords(ctx context.Context, opts ...ListPersonalRecordsOpt) (orm.PersonalRecordSlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateWorkout", ctx, p)
-	ret0, _ := ret[0].(*orm.Workout)
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPersonalRecords", varargs...)
+	ret0, _ := ret[0].(orm.PersonalRecordSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateWorkout indicates an expected call of CreateWorkout.
-func (mr *MockTxMockRecorder) CreateWorkout(ctx, p any) *gomock.Call {
+// ListPersonalRecords indicates an expected call of ListPersonalRecords.
+func (mr *MockRepoMockRecorder) ListPersonalRecords(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkout", reflect.TypeOf((*MockTx)(nil).CreateWorkout), ctx, p)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPersonalRecords", reflect.TypeOf((*MockRepo)(nil).ListPersonalRecords), varargs...)
 }
 
-// CreateWorkoutComment mocks base method.
-func (m *MockTx) CreateWorkoutComment(ctx context.Context, p CreateWorkoutCommentParams, opts ...CreateWorkoutCommentOpts) (*orm.WorkoutComment, error) {
+// ListReports mocks base method.
+func (m *MockRepo) ListReports(ctx context.Context, opts ...ListReportsOpt) (orm.ReportSlice, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, p}
+	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CreateWorkoutComment", varargs...)
-	ret0, _ := ret[0].(*orm.WorkoutComment)
+	ret := m.ctrl.Call(m, "ListReports", varargs...)
+	ret0, _ := ret[0].(orm.ReportSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateWorkoutComment indicates an expected call of CreateWorkoutComment.
-func (mr *MockTxMockRecorder) CreateWorkoutComment(ctx, p any, opts ...any) *gomock.Call {
+// ListReports indicates an expected call of ListReports.
+func (mr *MockRepoMockRecorder) ListReports(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, p}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkoutComment", reflect.TypeOf((*MockTx)(nil).CreateWorkoutComment), varargs...)
-}
-
-// DeleteRoutine mocks base method.
-func (m *MockTx) DeleteRoutine(ctx context.Context, routineID string) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRoutine", ctx, routineID)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// DeleteRoutine indicates an expected call of DeleteRoutine.
-func (mr *MockTxMockRecorder) DeleteRoutine(ctx, routineID any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoutine", reflect.TypeOf((*MockTx)(nil).DeleteRoutine), ctx, routineID)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReports", reflect.TypeOf((*MockRepo)(nil).ListReports), varargs...)
 }
 
-// DeleteWorkout mocks base method.
-func (m *MockTx) DeleteWorkout(ctx context.Context, opts ...DeleteWorkoutOpt) error {
+// ListRoutines mocks base method.
+func (m *MockRepo) ListRoutines(ctx context.Context, opts ...ListRoutineOpt) (orm.RoutineSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteWorkout", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListRoutines", varargs...)
+	ret0, _ := ret[0].(orm.RoutineSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteWorkout indicates an expected call of DeleteWorkout.
-func (mr *MockTxMockRecorder) DeleteWorkout(ctx any, opts ...any) *gomock.Call {
+// ListRoutines indicates an expected call of ListRoutines.
+func (mr *MockRepoMockRecorder) ListRoutines(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkout", reflect.TypeOf((*MockTx)(nil).DeleteWorkout), varargs...)
-}
-
-// Follow mocks base method.
-func (m *MockTx) Follow(ctx context.Context, p FollowParams) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Follow", ctx, p)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Follow indicates an expected call of Follow.
-func (mr *MockTxMockRecorder) Follow(ctx, p any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockTx)(nil).Follow), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutines", reflect.TypeOf((*MockRepo)(nil).ListRoutines), varargs...)
 }
 
-// GetAuth mocks base method.
-func (m *MockTx) GetAuth(ctx context.Context, opts ...GetAuthOpt) (*orm.Auth, error) {
+// ListSets mocks base method.
+func (m *MockRepo) ListSets(ctx context.Context, opts ...ListSetsOpt) (orm.SetSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetAuth", varargs...)
-	ret0, _ := ret[0].(*orm.Auth)
+	ret := m.ctrl.Call(m, "ListSets", varargs...)
+	ret0, _ := ret[0].(orm.SetSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAuth indicates an expected call of GetAuth.
-func (mr *MockTxMockRecorder) GetAuth(ctx any, opts ...any) *gomock.Call {
+// ListSets indicates an expected call of ListSets.
+func (mr *MockRepoMockRecorder) ListSets(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuth", reflect.TypeOf((*MockTx)(nil).GetAuth), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSets", reflect.TypeOf((*MockRepo)(nil).ListSets), varargs...)
 }
 
-// GetExercise mocks base method.
-func (m *MockTx) GetExercise(ctx context.Context, opts ...GetExerciseOpt) (*orm.Exercise, error) {
+// ListUsers mocks base method.
+func (m *MockRepo) ListUsers(ctx context.Context, opts ...ListUsersOpt) (orm.UserSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetExercise", varargs...)
-	ret0, _ := ret[0].(*orm.Exercise)
+	ret := m.ctrl.Call(m, "ListUsers", varargs...)
+	ret0, _ := ret[0].(orm.UserSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetExercise indicates an expected call of GetExercise.
-func (mr *MockTxMockRecorder) GetExercise(ctx any, opts ...any) *gomock.Call {
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockRepoMockRecorder) ListUsers(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExercise", reflect.TypeOf((*MockTx)(nil).GetExercise), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockRepo)(nil).ListUsers), varargs...)
 }
 
-// GetPersonalBests mocks base method.
-func (m *MockTx) GetPersonalBests(ctx context.Context, userIDs ...string) (orm.SetSlice, error) {
+// ListWorkoutComments mocks base method.
+func (m *MockRepo) ListWorkoutComments(ctx context.Context, opts ...ListWorkoutCommentsOpt) (orm.WorkoutCommentSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range userIDs {
+	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetPersonalBests", varargs...)
-	ret0, _ := ret[0].(orm.SetSlice)
+	ret := m.ctrl.Call(m, "ListWorkoutComments", varargs...)
+	ret0, _ := ret[0].(orm.WorkoutCommentSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPersonalBests indicates an expected call of GetPersonalBests.
-func (mr *MockTxMockRecorder) GetPersonalBests(ctx any, userIDs ...any) *gomock.Call {
+// ListWorkoutComments indicates an expected call of ListWorkoutComments.
+func (mr *MockRepoMockRecorder) ListWorkoutComments(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, userIDs...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalBests", reflect.TypeOf((*MockTx)(nil).GetPersonalBests), varargs...)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkoutComments", reflect.TypeOf((*MockRepo)(nil).ListWorkoutComments), varargs...)
 }
 
-// GetPreviousWorkoutSets mocks base method.
-func (m *MockTx) GetPreviousWorkoutSets(ctx context.Context, exerciseIDs []string) (orm.SetSlice, error) {
+// ListWorkoutReactions mocks base method.
+func (m *MockRepo) ListWorkoutReactions(ctx context.Context, opts ...ListWorkoutReactionsOpt) (orm.WorkoutReactionSlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPreviousWorkoutSets", ctx, exerciseIDs)
-	ret0, _ := ret[0].(orm.SetSlice)
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkoutReactions", varargs...)
+	ret0, _ := ret[0].(orm.WorkoutReactionSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPreviousWorkoutSets indicates an expected call of GetPreviousWorkoutSets.
-func (mr *MockTxMockRecorder) GetPreviousWorkoutSets(ctx, exerciseIDs any) *gomock.Call {
+// ListWorkoutReactions indicates an expected call of ListWorkoutReactions.
+func (mr *MockRepoMockRecorder) ListWorkoutReactions(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviousWorkoutSets", reflect.TypeOf((*MockTx)(nil).GetPreviousWorkoutSets), ctx, exerciseIDs)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkoutReactions", reflect.TypeOf((*MockRepo)(nil).ListWorkoutReactions), varargs...)
 }
 
-// GetRoutine mocks base method.
-func (m *MockTx) GetRoutine(ctx context.Context, opts ...GetRoutineOpt) (*orm.Routine, error) {
+// ListWorkouts mocks base method.
+func (m *MockRepo) ListWorkouts(ctx context.Context, opts ...ListWorkoutsOpt) (orm.WorkoutSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetRoutine", varargs...)
-	ret0, _ := ret[0].(*orm.Routine)
+	ret := m.ctrl.Call(m, "ListWorkouts", varargs...)
+	ret0, _ := ret[0].(orm.WorkoutSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRoutine indicates an expected call of GetRoutine.
-func (mr *MockTxMockRecorder) GetRoutine(ctx any, opts ...any) *gomock.Call {
+// ListWorkouts indicates an expected call of ListWorkouts.
+func (mr *MockRepoMockRecorder) ListWorkouts(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutine", reflect.TypeOf((*MockTx)(nil).GetRoutine), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkouts", reflect.TypeOf((*MockRepo)(nil).ListWorkouts), varargs...)
 }
 
-// GetUser mocks base method.
-func (m *MockTx) GetUser(ctx context.Context, opts ...GetUserOpt) (*orm.User, error) {
+// ListYearSummaries mocks base method.
+func (m *MockRepo) ListYearSummaries(ctx context.Context, opts ...ListYearSummariesOpt) (orm.YearSummarySlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetUser", varargs...)
-	ret0, _ := ret[0].(*orm.User)
+	ret := m.ctrl.Call(m, "ListYearSummaries", varargs...)
+	ret0, _ := ret[0].(orm.YearSummarySlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockTxMockRecorder) GetUser(ctx any, opts ...any) *gomock.Call {
+// ListYearSummaries indicates an expected call of ListYearSummaries.
+func (mr *MockRepoMockRecorder) ListYearSummaries(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockTx)(nil).GetUser), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListYearSummaries", reflect.TypeOf((*MockRepo)(nil).ListYearSummaries), varargs...)
 }
 
-// GetWorkout mocks base method.
-func (m *MockTx) GetWorkout(ctx context.Context, opts ...GetWorkoutOpt) (*orm.Workout, error) {
+// ListYearSummaryCandidates mocks base method.
+func (m *MockRepo) ListYearSummaryCandidates(ctx context.Context, year int) ([]string, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetWorkout", varargs...)
-	ret0, _ := ret[0].(*orm.Workout)
+	ret := m.ctrl.Call(m, "ListYearSummaryCandidates", ctx, year)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWorkout indicates an expected call of GetWorkout.
-func (mr *MockTxMockRecorder) GetWorkout(ctx any, opts ...any) *gomock.Call {
+// ListYearSummaryCandidates indicates an expected call of ListYearSummaryCandidates.
+func (mr *MockRepoMockRecorder) ListYearSummaryCandidates(ctx, year any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkout", reflect.TypeOf((*MockTx)(nil).GetWorkout), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListYearSummaryCandidates", reflect.TypeOf((*MockRepo)(nil).ListYearSummaryCandidates), ctx, year)
 }
 
-// GetWorkoutComment mocks base method.
-func (m *MockTx) GetWorkoutComment(ctx context.Context, opts ...GetWorkoutCommentOpt) (*orm.WorkoutComment, error) {
+// MarkNotificationsAsRead mocks base method.
+func (m *MockRepo) MarkNotificationsAsRead(ctx context.Context, userID string) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetWorkoutComment", varargs...)
-	ret0, _ := ret[0].(*orm.WorkoutComment)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "MarkNotificationsAsRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetWorkoutComment indicates an expected call of GetWorkoutComment.
-func (mr *MockTxMockRecorder) GetWorkoutComment(ctx any, opts ...any) *gomock.Call {
+// MarkNotificationsAsRead indicates an expected call of MarkNotificationsAsRead.
+func (mr *MockRepoMockRecorder) MarkNotificationsAsRead(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkoutComment", reflect.TypeOf((*MockTx)(nil).GetWorkoutComment), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsAsRead", reflect.TypeOf((*MockRepo)(nil).MarkNotificationsAsRead), ctx, userID)
 }
 
-// IsUserFollowedByUserID mocks base method.
-func (m *MockTx) IsUserFollowedByUserID(ctx context.Context, user *orm.User, userID string) (bool, error) {
+// MergeExercises mocks base method.
+func (m *MockRepo) MergeExercises(ctx context.Context, p MergeExercisesParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsUserFollowedByUserID", ctx, user, userID)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "MergeExercises", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// IsUserFollowedByUserID indicates an expected call of IsUserFollowedByUserID.
-func (mr *MockTxMockRecorder) IsUserFollowedByUserID(ctx, user, userID any) *gomock.Call {
+// MergeExercises indicates an expected call of MergeExercises.
+func (mr *MockRepoMockRecorder) MergeExercises(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserFollowedByUserID", reflect.TypeOf((*MockTx)(nil).IsUserFollowedByUserID), ctx, user, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeExercises", reflect.TypeOf((*MockRepo)(nil).MergeExercises), ctx, p)
 }
 
-// ListExercises mocks base method.
-func (m *MockTx) ListExercises(ctx context.Context, opts ...ListExercisesOpt) (orm.ExerciseSlice, error) {
+// NewTx mocks base method.
+func (m *MockRepo) NewTx(ctx context.Context, f func(Tx) error) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ListExercises", varargs...)
-	ret0, _ := ret[0].(orm.ExerciseSlice)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "NewTx", ctx, f)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListExercises indicates an expected call of ListExercises.
-func (mr *MockTxMockRecorder) ListExercises(ctx any, opts ...any) *gomock.Call {
+// NewTx indicates an expected call of NewTx.
+func (mr *MockRepoMockRecorder) NewTx(ctx, f any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExercises", reflect.TypeOf((*MockTx)(nil).ListExercises), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTx", reflect.TypeOf((*MockRepo)(nil).NewTx), ctx, f)
 }
 
-// ListFollowees mocks base method.
-func (m *MockTx) ListFollowees(ctx context.Context, userID string, opts ...ListFolloweesOpt) (orm.UserSlice, error) {
+// PostCreateWorkoutCommentLoadUser mocks base method.
+func (m *MockRepo) PostCreateWorkoutCommentLoadUser(ctx context.Context) CreateWorkoutCommentOpts {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, userID}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ListFollowees", varargs...)
-	ret0, _ := ret[0].(orm.UserSlice)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "PostCreateWorkoutCommentLoadUser", ctx)
+	ret0, _ := ret[0].(CreateWorkoutCommentOpts)
+	return ret0
 }
 
-// ListFollowees indicates an expected call of ListFollowees.
-func (mr *MockTxMockRecorder) ListFollowees(ctx, userID any, opts ...any) *gomock.Call {
+// PostCreateWorkoutCommentLoadUser indicates an expected call of PostCreateWorkoutCommentLoadUser.
+func (mr *MockRepoMockRecorder) PostCreateWorkoutCommentLoadUser(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, userID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowees", reflect.TypeOf((*MockTx)(nil).ListFollowees), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostCreateWorkoutCommentLoadUser", reflect.TypeOf((*MockRepo)(nil).PostCreateWorkoutCommentLoadUser), ctx)
 }
 
-// ListFollowers mocks base method.
-func (m *MockTx) ListFollowers(ctx context.Context, userID string, opts ...ListFollowersOpt) (orm.UserSlice, error) {
+// PublishEvent mocks base method.
+func (m *MockRepo) PublishEvent(ctx context.Context, topic orm.EventTopic, payload []byte) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, userID}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ListFollowers", varargs...)
-	ret0, _ := ret[0].(orm.UserSlice)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "PublishEvent", ctx, topic, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListFollowers indicates an expected call of ListFollowers.
-func (mr *MockTxMockRecorder) ListFollowers(ctx, userID any, opts ...any) *gomock.Call {
+// PublishEvent indicates an expected call of PublishEvent.
+func (mr *MockRepoMockRecorder) PublishEvent(ctx, topic, payload any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, userID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowers", reflect.TypeOf((*MockTx)(nil).ListFollowers), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvent", reflect.TypeOf((*MockRepo)(nil).PublishEvent), ctx, topic, payload)
 }
 
-// ListNotifications mocks base method.
-func (m *MockTx) ListNotifications(ctx context.Context, opts ...ListNotificationsOpt) (orm.NotificationSlice, error) {
+// PurgeDataExports mocks base method.
+func (m *MockRepo) PurgeDataExports(ctx context.Context, createdBefore time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ListNotifications", varargs...)
-	ret0, _ := ret[0].(orm.NotificationSlice)
+	ret := m.ctrl.Call(m, "PurgeDataExports", ctx, createdBefore)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListNotifications indicates an expected call of ListNotifications.
-func (mr *MockTxMockRecorder) ListNotifications(ctx any, opts ...any) *gomock.Call {
+// PurgeDataExports indicates an expected call of PurgeDataExports.
+func (mr *MockRepoMockRecorder) PurgeDataExports(ctx, createdBefore any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockTx)(nil).ListNotifications), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDataExports", reflect.TypeOf((*MockRepo)(nil).PurgeDataExports), ctx, createdBefore)
 }
 
-// ListRoutines mocks base method.
-func (m *MockTx) ListRoutines(ctx context.Context, opts ...ListRoutineOpt) (orm.RoutineSlice, error) {
+// PurgeExercises mocks base method.
+func (m *MockRepo) PurgeExercises(ctx context.Context, deletedBefore time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ListRoutines", varargs...)
-	ret0, _ := ret[0].(orm.RoutineSlice)
+	ret := m.ctrl.Call(m, "PurgeExercises", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRoutines indicates an expected call of ListRoutines.
-func (mr *MockTxMockRecorder) ListRoutines(ctx any, opts ...any) *gomock.Call {
+// PurgeExercises indicates an expected call of PurgeExercises.
+func (mr *MockRepoMockRecorder) PurgeExercises(ctx, deletedBefore any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutines", reflect.TypeOf((*MockTx)(nil).ListRoutines), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExercises", reflect.TypeOf((*MockRepo)(nil).PurgeExercises), ctx, deletedBefore)
 }
 
-// ListSets mocks base method.
-func (m *MockTx) ListSets(ctx context.Context, opts ...ListSetsOpt) (orm.SetSlice, error) {
+// PurgeRoutines mocks base method.
+func (m *MockRepo) PurgeRoutines(ctx context.Context, deletedBefore time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ListSets", varargs...)
-	ret0, _ := ret[0].(orm.SetSlice)
+	ret := m.ctrl.Call(m, "PurgeRoutines", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListSets indicates an expected call of ListSets.
-func (mr *MockTxMockRecorder) ListSets(ctx any, opts ...any) *gomock.Call {
+// PurgeRoutines indicates an expected call of PurgeRoutines.
+func (mr *MockRepoMockRecorder) PurgeRoutines(ctx, deletedBefore any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSets", reflect.TypeOf((*MockTx)(nil).ListSets), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeRoutines", reflect.TypeOf((*MockRepo)(nil).PurgeRoutines), ctx, deletedBefore)
 }
 
-// ListUsers mocks base method.
-func (m *MockTx) ListUsers(ctx context.Context, opts ...ListUsersOpt) (orm.UserSlice, error) {
+// PurgeWorkouts mocks base method.
+func (m *MockRepo) PurgeWorkouts(ctx context.Context, deletedBefore time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ListUsers", varargs...)
-	ret0, _ := ret[0].(orm.UserSlice)
+	ret := m.ctrl.Call(m, "PurgeWorkouts", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListUsers indicates an expected call of ListUsers.
-func (mr *MockTxMockRecorder) ListUsers(ctx any, opts ...any) *gomock.Call {
+// PurgeWorkouts indicates an expected call of PurgeWorkouts.
+func (mr *MockRepoMockRecorder) PurgeWorkouts(ctx, deletedBefore any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockTx)(nil).ListUsers), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeWorkouts", reflect.TypeOf((*MockRepo)(nil).PurgeWorkouts), ctx, deletedBefore)
 }
 
-// ListWorkouts mocks base method.
-func (m *MockTx) ListWorkouts(ctx context.Context, opts ...ListWorkoutsOpt) (orm.WorkoutSlice, error) {
+// RefreshTokenExists mocks base method.
+func (m *MockRepo) RefreshTokenExists(ctx context.Context, refreshToken string) (bool, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ListWorkouts", varargs...)
-	ret0, _ := ret[0].(orm.WorkoutSlice)
+	ret := m.ctrl.Call(m, "RefreshTokenExists", ctx, refreshToken)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListWorkouts indicates an expected call of ListWorkouts.
-func (mr *MockTxMockRecorder) ListWorkouts(ctx any, opts ...any) *gomock.Call {
+// RefreshTokenExists indicates an expected call of RefreshTokenExists.
+func (mr *MockRepoMockRecorder) RefreshTokenExists(ctx, refreshToken any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkouts", reflect.TypeOf((*MockTx)(nil).ListWorkouts), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokenExists", reflect.TypeOf((*MockRepo)(nil).RefreshTokenExists), ctx, refreshToken)
 }
 
-// MarkNotificationsAsRead mocks base method.
-func (m *MockTx) MarkNotificationsAsRead(ctx context.Context, userID string) error {
+// RemoveExerciseFromRoutine mocks base method.
+func (m *MockRepo) RemoveExerciseFromRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkNotificationsAsRead", ctx, userID)
+	ret := m.ctrl.Call(m, "RemoveExerciseFromRoutine", ctx, exercise, routine)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MarkNotificationsAsRead indicates an expected call of MarkNotificationsAsRead.
-func (mr *MockTxMockRecorder) MarkNotificationsAsRead(ctx, userID any) *gomock.Call {
+// RemoveExerciseFromRoutine indicates an expected call of RemoveExerciseFromRoutine.
+func (mr *MockRepoMockRecorder) RemoveExerciseFromRoutine(ctx, exercise, routine any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsAsRead", reflect.TypeOf((*MockTx)(nil).MarkNotificationsAsRead), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExerciseFromRoutine", reflect.TypeOf((*MockRepo)(nil).RemoveExerciseFromRoutine), ctx, exercise, routine)
 }
 
-// PostCreateWorkoutCommentLoadUser mocks base method.
-func (m *MockTx) PostCreateWorkoutCommentLoadUser(ctx context.Context) CreateWorkoutCommentOpts {
+// ResolveReport mocks base method.
+func (m *MockRepo) ResolveReport(ctx context.Context, p ResolveReportParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PostCreateWorkoutCommentLoadUser", ctx)
-	ret0, _ := ret[0].(CreateWorkoutCommentOpts)
+	ret := m.ctrl.Call(m, "ResolveReport", ctx, p)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PostCreateWorkoutCommentLoadUser indicates an expected call of PostCreateWorkoutCommentLoadUser.
-func (mr *MockTxMockRecorder) PostCreateWorkoutCommentLoadUser(ctx any) *gomock.Call {
+// ResolveReport indicates an expected call of ResolveReport.
+func (mr *MockRepoMockRecorder) ResolveReport(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostCreateWorkoutCommentLoadUser", reflect.TypeOf((*MockTx)(nil).PostCreateWorkoutCommentLoadUser), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReport", reflect.TypeOf((*MockRepo)(nil).ResolveReport), ctx, p)
 }
 
-// PublishEvent mocks base method.
-func (m *MockTx) PublishEvent(ctx context.Context, topic orm.EventTopic, payload []byte) error {
+// RestoreExercise mocks base method.
+func (m *MockRepo) RestoreExercise(ctx context.Context, p RestoreExerciseParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublishEvent", ctx, topic, payload)
+	ret := m.ctrl.Call(m, "RestoreExercise", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PublishEvent indicates an expected call of PublishEvent.
-func (mr *MockTxMockRecorder) PublishEvent(ctx, topic, payload any) *gomock.Call {
+// RestoreExercise indicates an expected call of RestoreExercise.
+func (mr *MockRepoMockRecorder) RestoreExercise(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvent", reflect.TypeOf((*MockTx)(nil).PublishEvent), ctx, topic, payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreExercise", reflect.TypeOf((*MockRepo)(nil).RestoreExercise), ctx, p)
 }
 
-// RefreshTokenExists mocks base method.
-func (m *MockTx) RefreshTokenExists(ctx context.Context, refreshToken string) (bool, error) {
+// RestoreRoutine mocks base method.
+func (m *MockRepo) RestoreRoutine(ctx context.Context, routineID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefreshTokenExists", ctx, refreshToken)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RestoreRoutine", ctx, routineID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RefreshTokenExists indicates an expected call of RefreshTokenExists.
-func (mr *MockTxMockRecorder) RefreshTokenExists(ctx, refreshToken any) *gomock.Call {
+// RestoreRoutine indicates an expected call of RestoreRoutine.
+func (mr *MockRepoMockRecorder) RestoreRoutine(ctx, routineID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokenExists", reflect.TypeOf((*MockTx)(nil).RefreshTokenExists), ctx, refreshToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRoutine", reflect.TypeOf((*MockRepo)(nil).RestoreRoutine), ctx, routineID)
 }
 
-// RemoveExerciseFromRoutine mocks base method.
-func (m *MockTx) RemoveExerciseFromRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
+// RestoreWorkout mocks base method.
+func (m *MockRepo) RestoreWorkout(ctx context.Context, workoutID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveExerciseFromRoutine", ctx, exercise, routine)
+	ret := m.ctrl.Call(m, "RestoreWorkout", ctx, workoutID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RemoveExerciseFromRoutine indicates an expected call of RemoveExerciseFromRoutine.
-func (mr *MockTxMockRecorder) RemoveExerciseFromRoutine(ctx, exercise, routine any) *gomock.Call {
+// RestoreWorkout indicates an expected call of RestoreWorkout.
+func (mr *MockRepoMockRecorder) RestoreWorkout(ctx, workoutID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExerciseFromRoutine", reflect.TypeOf((*MockTx)(nil).RemoveExerciseFromRoutine), ctx, exercise, routine)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkout", reflect.TypeOf((*MockRepo)(nil).RestoreWorkout), ctx, workoutID)
+}
+
+// RevokeCoachAccess mocks base method.
+func (m *MockRepo) RevokeCoachAccess(ctx context.Context, p CoachingParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCoachAccess", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeCoachAccess indicates an expected call of RevokeCoachAccess.
+func (mr *MockRepoMockRecorder) RevokeCoachAccess(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCoachAccess", reflect.TypeOf((*MockRepo)(nil).RevokeCoachAccess), ctx, p)
 }
 
 // SetRoutineExercises mocks base method.
-func (m *MockTx) SetRoutineExercises(ctx context.Context, routine *orm.Routine, exercises orm.ExerciseSlice) error {
+func (m *MockRepo) SetRoutineExercises(ctx context.Context, routine *orm.Routine, exercises orm.ExerciseSlice) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetRoutineExercises", ctx, routine, exercises)
 	ret0, _ := ret[0].(error)
@@ -1445,13 +1824,13 @@ func (m *MockTx) SetRoutineExercises(ctx context.Context, routine *orm.Routine,
 }
 
 // SetRoutineExercises indicates an expected call of SetRoutineExercises.
-func (mr *MockTxMockRecorder) SetRoutineExercises(ctx, routine, exercises any) *gomock.Call {
+func (mr *MockRepoMockRecorder) SetRoutineExercises(ctx, routine, exercises any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoutineExercises", reflect.TypeOf((*MockTx)(nil).SetRoutineExercises), ctx, routine, exercises)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoutineExercises", reflect.TypeOf((*MockRepo)(nil).SetRoutineExercises), ctx, routine, exercises)
 }
 
 // SoftDeleteExercise mocks base method.
-func (m *MockTx) SoftDeleteExercise(ctx context.Context, p SoftDeleteExerciseParams) error {
+func (m *MockRepo) SoftDeleteExercise(ctx context.Context, p SoftDeleteExerciseParams) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SoftDeleteExercise", ctx, p)
 	ret0, _ := ret[0].(error)
@@ -1459,13 +1838,13 @@ func (m *MockTx) SoftDeleteExercise(ctx context.Context, p SoftDeleteExercisePar
 }
 
 // SoftDeleteExercise indicates an expected call of SoftDeleteExercise.
-func (mr *MockTxMockRecorder) SoftDeleteExercise(ctx, p any) *gomock.Call {
+func (mr *MockRepoMockRecorder) SoftDeleteExercise(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteExercise", reflect.TypeOf((*MockTx)(nil).SoftDeleteExercise), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteExercise", reflect.TypeOf((*MockRepo)(nil).SoftDeleteExercise), ctx, p)
 }
 
 // StoreTrace mocks base method.
-func (m *MockTx) StoreTrace(ctx context.Context, p StoreTraceParams) error {
+func (m *MockRepo) StoreTrace(ctx context.Context, p StoreTraceParams) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "StoreTrace", ctx, p)
 	ret0, _ := ret[0].(error)
@@ -1473,13 +1852,55 @@ func (m *MockTx) StoreTrace(ctx context.Context, p StoreTraceParams) error {
 }
 
 // StoreTrace indicates an expected call of StoreTrace.
-func (mr *MockTxMockRecorder) StoreTrace(ctx, p any) *gomock.Call {
+func (mr *MockRepoMockRecorder) StoreTrace(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreTrace", reflect.TypeOf((*MockTx)(nil).StoreTrace), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreTrace", reflect.TypeOf((*MockRepo)(nil).StoreTrace), ctx, p)
+}
+
+// SuspendUser mocks base method.
+func (m *MockRepo) SuspendUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendUser indicates an expected call of SuspendUser.
+func (mr *MockRepoMockRecorder) SuspendUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUser", reflect.TypeOf((*MockRepo)(nil).SuspendUser), ctx, userID)
+}
+
+// TransferClubOwnership mocks base method.
+func (m *MockRepo) TransferClubOwnership(ctx context.Context, p TransferClubOwnershipParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferClubOwnership", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferClubOwnership indicates an expected call of TransferClubOwnership.
+func (mr *MockRepoMockRecorder) TransferClubOwnership(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferClubOwnership", reflect.TypeOf((*MockRepo)(nil).TransferClubOwnership), ctx, p)
+}
+
+// UnblockUser mocks base method.
+func (m *MockRepo) UnblockUser(ctx context.Context, p BlockParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockUser", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockRepoMockRecorder) UnblockUser(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockRepo)(nil).UnblockUser), ctx, p)
 }
 
 // Unfollow mocks base method.
-func (m *MockTx) Unfollow(ctx context.Context, p UnfollowParams) error {
+func (m *MockRepo) Unfollow(ctx context.Context, p UnfollowParams) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Unfollow", ctx, p)
 	ret0, _ := ret[0].(error)
@@ -1487,13 +1908,13 @@ func (m *MockTx) Unfollow(ctx context.Context, p UnfollowParams) error {
 }
 
 // Unfollow indicates an expected call of Unfollow.
-func (mr *MockTxMockRecorder) Unfollow(ctx, p any) *gomock.Call {
+func (mr *MockRepoMockRecorder) Unfollow(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockTx)(nil).Unfollow), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockRepo)(nil).Unfollow), ctx, p)
 }
 
 // UpdateAuth mocks base method.
-func (m *MockTx) UpdateAuth(ctx context.Context, authID string, opts ...UpdateAuthOpt) error {
+func (m *MockRepo) UpdateAuth(ctx context.Context, authID string, opts ...UpdateAuthOpt) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, authID}
 	for _, a := range opts {
@@ -1505,14 +1926,52 @@ func (m *MockTx) UpdateAuth(ctx context.Context, authID string, opts ...UpdateAu
 }
 
 // UpdateAuth indicates an expected call of UpdateAuth.
-func (mr *MockTxMockRecorder) UpdateAuth(ctx, authID any, opts ...any) *gomock.Call {
+func (mr *MockRepoMockRecorder) UpdateAuth(ctx, authID any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, authID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuth", reflect.TypeOf((*MockTx)(nil).UpdateAuth), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuth", reflect.TypeOf((*MockRepo)(nil).UpdateAuth), varargs...)
+}
+
+// UpdateChallenge mocks base method.
+func (m *MockRepo) UpdateChallenge(ctx context.Context, challengeID string, opts ...UpdateChallengeOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, challengeID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateChallenge", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChallenge indicates an expected call of UpdateChallenge.
+func (mr *MockRepoMockRecorder) UpdateChallenge(ctx, challengeID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, challengeID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChallenge", reflect.TypeOf((*MockRepo)(nil).UpdateChallenge), varargs...)
+}
+
+// UpdateClubMember mocks base method.
+func (m *MockRepo) UpdateClubMember(ctx context.Context, clubID, userID string, opts ...UpdateClubMemberOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clubID, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateClubMember", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClubMember indicates an expected call of UpdateClubMember.
+func (mr *MockRepoMockRecorder) UpdateClubMember(ctx, clubID, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clubID, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClubMember", reflect.TypeOf((*MockRepo)(nil).UpdateClubMember), varargs...)
 }
 
 // UpdateExercise mocks base method.
-func (m *MockTx) UpdateExercise(ctx context.Context, exerciseID string, opts ...UpdateExerciseOpt) error {
+func (m *MockRepo) UpdateExercise(ctx context.Context, exerciseID string, opts ...UpdateExerciseOpt) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, exerciseID}
 	for _, a := range opts {
@@ -1524,14 +1983,28 @@ func (m *MockTx) UpdateExercise(ctx context.Context, exerciseID string, opts ...
 }
 
 // UpdateExercise indicates an expected call of UpdateExercise.
-func (mr *MockTxMockRecorder) UpdateExercise(ctx, exerciseID any, opts ...any) *gomock.Call {
+func (mr *MockRepoMockRecorder) UpdateExercise(ctx, exerciseID any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, exerciseID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExercise", reflect.TypeOf((*MockTx)(nil).UpdateExercise), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExercise", reflect.TypeOf((*MockRepo)(nil).UpdateExercise), varargs...)
+}
+
+// UpdateGroupedNotification mocks base method.
+func (m *MockRepo) UpdateGroupedNotification(ctx context.Context, p CreateNotificationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroupedNotification", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGroupedNotification indicates an expected call of UpdateGroupedNotification.
+func (mr *MockRepoMockRecorder) UpdateGroupedNotification(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupedNotification", reflect.TypeOf((*MockRepo)(nil).UpdateGroupedNotification), ctx, p)
 }
 
 // UpdateRoutine mocks base method.
-func (m *MockTx) UpdateRoutine(ctx context.Context, routineID string, opts ...UpdateRoutineOpt) error {
+func (m *MockRepo) UpdateRoutine(ctx context.Context, routineID string, opts ...UpdateRoutineOpt) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, routineID}
 	for _, a := range opts {
@@ -1543,14 +2016,33 @@ func (m *MockTx) UpdateRoutine(ctx context.Context, routineID string, opts ...Up
 }
 
 // UpdateRoutine indicates an expected call of UpdateRoutine.
-func (mr *MockTxMockRecorder) UpdateRoutine(ctx, routineID any, opts ...any) *gomock.Call {
+func (mr *MockRepoMockRecorder) UpdateRoutine(ctx, routineID any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, routineID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoutine", reflect.TypeOf((*MockTx)(nil).UpdateRoutine), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoutine", reflect.TypeOf((*MockRepo)(nil).UpdateRoutine), varargs...)
+}
+
+// UpdateUser mocks base method.
+func (m *MockRepo) UpdateUser(ctx context.Context, userID string, opts ...UpdateUserOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUser", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockRepoMockRecorder) UpdateUser(ctx, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockRepo)(nil).UpdateUser), varargs...)
 }
 
 // UpdateWorkout mocks base method.
-func (m *MockTx) UpdateWorkout(ctx context.Context, workoutID string, opts ...UpdateWorkoutOpt) error {
+func (m *MockRepo) UpdateWorkout(ctx context.Context, workoutID string, opts ...UpdateWorkoutOpt) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, workoutID}
 	for _, a := range opts {
@@ -1562,14 +2054,33 @@ func (m *MockTx) UpdateWorkout(ctx context.Context, workoutID string, opts ...Up
 }
 
 // UpdateWorkout indicates an expected call of UpdateWorkout.
-func (mr *MockTxMockRecorder) UpdateWorkout(ctx, workoutID any, opts ...any) *gomock.Call {
+func (mr *MockRepoMockRecorder) UpdateWorkout(ctx, workoutID any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, workoutID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkout", reflect.TypeOf((*MockTx)(nil).UpdateWorkout), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkout", reflect.TypeOf((*MockRepo)(nil).UpdateWorkout), varargs...)
+}
+
+// UpdateWorkoutComment mocks base method.
+func (m *MockRepo) UpdateWorkoutComment(ctx context.Context, commentID string, opts ...UpdateWorkoutCommentOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, commentID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkoutComment", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkoutComment indicates an expected call of UpdateWorkoutComment.
+func (mr *MockRepoMockRecorder) UpdateWorkoutComment(ctx, commentID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, commentID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkoutComment", reflect.TypeOf((*MockRepo)(nil).UpdateWorkoutComment), varargs...)
 }
 
 // UpdateWorkoutSets mocks base method.
-func (m *MockTx) UpdateWorkoutSets(ctx context.Context, p UpdateWorkoutSetsParams) error {
+func (m *MockRepo) UpdateWorkoutSets(ctx context.Context, p UpdateWorkoutSetsParams) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateWorkoutSets", ctx, p)
 	ret0, _ := ret[0].(error)
@@ -1577,51 +2088,52 @@ func (m *MockTx) UpdateWorkoutSets(ctx context.Context, p UpdateWorkoutSetsParam
 }
 
 // UpdateWorkoutSets indicates an expected call of UpdateWorkoutSets.
-func (mr *MockTxMockRecorder) UpdateWorkoutSets(ctx, p any) *gomock.Call {
+func (mr *MockRepoMockRecorder) UpdateWorkoutSets(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkoutSets", reflect.TypeOf((*MockTx)(nil).UpdateWorkoutSets), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkoutSets", reflect.TypeOf((*MockRepo)(nil).UpdateWorkoutSets), ctx, p)
 }
 
-// exec mocks base method.
-func (m *MockTx) exec() *sql.Tx {
+// UpsertWorkoutReaction mocks base method.
+func (m *MockRepo) UpsertWorkoutReaction(ctx context.Context, p UpsertWorkoutReactionParams) (*orm.WorkoutReaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "exec")
-	ret0, _ := ret[0].(*sql.Tx)
-	return ret0
+	ret := m.ctrl.Call(m, "UpsertWorkoutReaction", ctx, p)
+	ret0, _ := ret[0].(*orm.WorkoutReaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// exec indicates an expected call of exec.
-func (mr *MockTxMockRecorder) exec() *gomock.Call {
+// UpsertWorkoutReaction indicates an expected call of UpsertWorkoutReaction.
+func (mr *MockRepoMockRecorder) UpsertWorkoutReaction(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "exec", reflect.TypeOf((*MockTx)(nil).exec))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkoutReaction", reflect.TypeOf((*MockRepo)(nil).UpsertWorkoutReaction), ctx, p)
 }
 
-// Mockmethods is a mock of methods interface.
-type Mockmethods struct {
+// MockTx is a mock of Tx interface.
+type MockTx struct {
 	ctrl     *gomock.Controller
-	recorder *MockmethodsMockRecorder
+	recorder *MockTxMockRecorder
 	isgomock struct{}
 }
 
-// MockmethodsMockRecorder is the mock recorder for Mockmethods.
-type MockmethodsMockRecorder struct {
-	mock *Mockmethods
-}
-
-// NewMockmethods creates a new mock instance.
-func NewMockmethods(ctrl *gomock.Controller) *Mockmethods {
-	mock := &Mockmethods{ctrl: ctrl}
-	mock.recorder = &MockmethodsMockRecorder{mock}
-	return mock
+// MockTxMockRecorder is the mock recorder for MockTx.
+type MockTxMockRecorder struct {
+	mock *MockTx
+}
+
+// NewMockTx creates a new mock instance.
+func NewMockTx(ctrl *gomock.Controller) *MockTx {
+	mock := &MockTx{ctrl: ctrl}
+	mock.recorder = &MockTxMockRecorder{mock}
+	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *Mockmethods) EXPECT() *MockmethodsMockRecorder {
+func (m *MockTx) EXPECT() *MockTxMockRecorder {
 	return m.recorder
 }
 
 // AddExerciseToRoutine mocks base method.
-func (m *Mockmethods) AddExerciseToRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
+func (m *MockTx) AddExerciseToRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddExerciseToRoutine", ctx, exercise, routine)
 	ret0, _ := ret[0].(error)
@@ -1629,513 +2141,5105 @@ func (m *Mockmethods) AddExerciseToRoutine(ctx context.Context, exercise *orm.Ex
 }
 
 // AddExerciseToRoutine indicates an expected call of AddExerciseToRoutine.
-func (mr *MockmethodsMockRecorder) AddExerciseToRoutine(ctx, exercise, routine any) *gomock.Call {
+func (mr *MockTxMockRecorder) AddExerciseToRoutine(ctx, exercise, routine any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExerciseToRoutine", reflect.TypeOf((*Mockmethods)(nil).AddExerciseToRoutine), ctx, exercise, routine)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExerciseToRoutine", reflect.TypeOf((*MockTx)(nil).AddExerciseToRoutine), ctx, exercise, routine)
 }
 
-// CompareEmailAndPassword mocks base method.
-func (m *Mockmethods) CompareEmailAndPassword(ctx context.Context, email, password string) error {
+// ApproveFollowRequest mocks base method.
+func (m *MockTx) ApproveFollowRequest(ctx context.Context, p FollowParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CompareEmailAndPassword", ctx, email, password)
+	ret := m.ctrl.Call(m, "ApproveFollowRequest", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CompareEmailAndPassword indicates an expected call of CompareEmailAndPassword.
-func (mr *MockmethodsMockRecorder) CompareEmailAndPassword(ctx, email, password any) *gomock.Call {
+// ApproveFollowRequest indicates an expected call of ApproveFollowRequest.
+func (mr *MockTxMockRecorder) ApproveFollowRequest(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareEmailAndPassword", reflect.TypeOf((*Mockmethods)(nil).CompareEmailAndPassword), ctx, email, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveFollowRequest", reflect.TypeOf((*MockTx)(nil).ApproveFollowRequest), ctx, p)
 }
 
-// CountNotifications mocks base method.
-func (m *Mockmethods) CountNotifications(ctx context.Context, opts ...CountNotificationsOpt) (int64, error) {
+// ApproveFollowRequests mocks base method.
+func (m *MockTx) ApproveFollowRequests(ctx context.Context, followeeID string) (orm.FollowRequestSlice, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "CountNotifications", varargs...)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "ApproveFollowRequests", ctx, followeeID)
+	ret0, _ := ret[0].(orm.FollowRequestSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CountNotifications indicates an expected call of CountNotifications.
-func (mr *MockmethodsMockRecorder) CountNotifications(ctx any, opts ...any) *gomock.Call {
+// ApproveFollowRequests indicates an expected call of ApproveFollowRequests.
+func (mr *MockTxMockRecorder) ApproveFollowRequests(ctx, followeeID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNotifications", reflect.TypeOf((*Mockmethods)(nil).CountNotifications), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveFollowRequests", reflect.TypeOf((*MockTx)(nil).ApproveFollowRequests), ctx, followeeID)
 }
 
-// CreateAuth mocks base method.
-func (m *Mockmethods) CreateAuth(ctx context.Context, email, password string) (*orm.Auth, error) {
+// BlockUser mocks base method.
+func (m *MockTx) BlockUser(ctx context.Context, p BlockParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAuth", ctx, email, password)
-	ret0, _ := ret[0].(*orm.Auth)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "BlockUser", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateAuth indicates an expected call of CreateAuth.
-func (mr *MockmethodsMockRecorder) CreateAuth(ctx, email, password any) *gomock.Call {
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockTxMockRecorder) BlockUser(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuth", reflect.TypeOf((*Mockmethods)(nil).CreateAuth), ctx, email, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockTx)(nil).BlockUser), ctx, p)
 }
 
-// CreateExercise mocks base method.
-func (m *Mockmethods) CreateExercise(ctx context.Context, p CreateExerciseParams) (*orm.Exercise, error) {
+// BuildYearSummary mocks base method.
+func (m *MockTx) BuildYearSummary(ctx context.Context, userID string, year int) (*YearSummaryPayload, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateExercise", ctx, p)
-	ret0, _ := ret[0].(*orm.Exercise)
+	ret := m.ctrl.Call(m, "BuildYearSummary", ctx, userID, year)
+	ret0, _ := ret[0].(*YearSummaryPayload)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateExercise indicates an expected call of CreateExercise.
-func (mr *MockmethodsMockRecorder) CreateExercise(ctx, p any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExercise", reflect.TypeOf((*Mockmethods)(nil).CreateExercise), ctx, p)
-}
-
-// CreateNotification mocks base method.
-func (m *Mockmethods) CreateNotification(ctx context.Context, p CreateNotificationParams) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNotification", ctx, p)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// CreateNotification indicates an expected call of CreateNotification.
-func (mr *MockmethodsMockRecorder) CreateNotification(ctx, p any) *gomock.Call {
+// BuildYearSummary indicates an expected call of BuildYearSummary.
+func (mr *MockTxMockRecorder) BuildYearSummary(ctx, userID, year any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*Mockmethods)(nil).CreateNotification), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildYearSummary", reflect.TypeOf((*MockTx)(nil).BuildYearSummary), ctx, userID, year)
 }
 
-// CreateRoutine mocks base method.
-func (m *Mockmethods) CreateRoutine(ctx context.Context, p CreateRoutineParams) (*orm.Routine, error) {
+// CanViewUser mocks base method.
+func (m *MockTx) CanViewUser(ctx context.Context, viewerID, userID string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRoutine", ctx, p)
-	ret0, _ := ret[0].(*orm.Routine)
+	ret := m.ctrl.Call(m, "CanViewUser", ctx, viewerID, userID)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateRoutine indicates an expected call of CreateRoutine.
-func (mr *MockmethodsMockRecorder) CreateRoutine(ctx, p any) *gomock.Call {
+// CanViewUser indicates an expected call of CanViewUser.
+func (mr *MockTxMockRecorder) CanViewUser(ctx, viewerID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoutine", reflect.TypeOf((*Mockmethods)(nil).CreateRoutine), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewUser", reflect.TypeOf((*MockTx)(nil).CanViewUser), ctx, viewerID, userID)
 }
 
-// CreateUser mocks base method.
-func (m *Mockmethods) CreateUser(ctx context.Context, p CreateUserParams) (*orm.User, error) {
+// CanViewWorkout mocks base method.
+func (m *MockTx) CanViewWorkout(ctx context.Context, viewerID string, workout *orm.Workout) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, p)
-	ret0, _ := ret[0].(*orm.User)
+	ret := m.ctrl.Call(m, "CanViewWorkout", ctx, viewerID, workout)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockmethodsMockRecorder) CreateUser(ctx, p any) *gomock.Call {
+// CanViewWorkout indicates an expected call of CanViewWorkout.
+func (mr *MockTxMockRecorder) CanViewWorkout(ctx, viewerID, workout any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*Mockmethods)(nil).CreateUser), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewWorkout", reflect.TypeOf((*MockTx)(nil).CanViewWorkout), ctx, viewerID, workout)
 }
 
-// CreateWorkout mocks base method.
-func (m *Mockmethods) CreateWorkout(ctx context.Context, p CreateWorkoutParams) (*orm.Workout, error) {
+// CloneRoutine mocks base method.
+func (m *MockTx) CloneRoutine(ctx context.Context, p CloneRoutineParams) (*orm.Routine, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateWorkout", ctx, p)
-	ret0, _ := ret[0].(*orm.Workout)
+	ret := m.ctrl.Call(m, "CloneRoutine", ctx, p)
+	ret0, _ := ret[0].(*orm.Routine)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateWorkout indicates an expected call of CreateWorkout.
-func (mr *MockmethodsMockRecorder) CreateWorkout(ctx, p any) *gomock.Call {
+// CloneRoutine indicates an expected call of CloneRoutine.
+func (mr *MockTxMockRecorder) CloneRoutine(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkout", reflect.TypeOf((*Mockmethods)(nil).CreateWorkout), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneRoutine", reflect.TypeOf((*MockTx)(nil).CloneRoutine), ctx, p)
 }
 
-// CreateWorkoutComment mocks base method.
-func (m *Mockmethods) CreateWorkoutComment(ctx context.Context, p CreateWorkoutCommentParams, opts ...CreateWorkoutCommentOpts) (*orm.WorkoutComment, error) {
+// CompareEmailAndPassword mocks base method.
+func (m *MockTx) CompareEmailAndPassword(ctx context.Context, email, password string) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, p}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "CreateWorkoutComment", varargs...)
-	ret0, _ := ret[0].(*orm.WorkoutComment)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CompareEmailAndPassword", ctx, email, password)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateWorkoutComment indicates an expected call of CreateWorkoutComment.
-func (mr *MockmethodsMockRecorder) CreateWorkoutComment(ctx, p any, opts ...any) *gomock.Call {
+// CompareEmailAndPassword indicates an expected call of CompareEmailAndPassword.
+func (mr *MockTxMockRecorder) CompareEmailAndPassword(ctx, email, password any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, p}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkoutComment", reflect.TypeOf((*Mockmethods)(nil).CreateWorkoutComment), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareEmailAndPassword", reflect.TypeOf((*MockTx)(nil).CompareEmailAndPassword), ctx, email, password)
 }
 
-// DeleteRoutine mocks base method.
-func (m *Mockmethods) DeleteRoutine(ctx context.Context, routineID string) error {
+// CompleteDataExport mocks base method.
+func (m *MockTx) CompleteDataExport(ctx context.Context, exportID string, archive []byte) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRoutine", ctx, routineID)
+	ret := m.ctrl.Call(m, "CompleteDataExport", ctx, exportID, archive)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteRoutine indicates an expected call of DeleteRoutine.
-func (mr *MockmethodsMockRecorder) DeleteRoutine(ctx, routineID any) *gomock.Call {
+// CompleteDataExport indicates an expected call of CompleteDataExport.
+func (mr *MockTxMockRecorder) CompleteDataExport(ctx, exportID, archive any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoutine", reflect.TypeOf((*Mockmethods)(nil).DeleteRoutine), ctx, routineID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteDataExport", reflect.TypeOf((*MockTx)(nil).CompleteDataExport), ctx, exportID, archive)
 }
 
-// DeleteWorkout mocks base method.
-func (m *Mockmethods) DeleteWorkout(ctx context.Context, opts ...DeleteWorkoutOpt) error {
+// CountNotifications mocks base method.
+func (m *MockTx) CountNotifications(ctx context.Context, opts ...CountNotificationsOpt) (int64, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteWorkout", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CountNotifications", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteWorkout indicates an expected call of DeleteWorkout.
-func (mr *MockmethodsMockRecorder) DeleteWorkout(ctx any, opts ...any) *gomock.Call {
+// CountNotifications indicates an expected call of CountNotifications.
+func (mr *MockTxMockRecorder) CountNotifications(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkout", reflect.TypeOf((*Mockmethods)(nil).DeleteWorkout), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNotifications", reflect.TypeOf((*MockTx)(nil).CountNotifications), varargs...)
 }
 
-// Follow mocks base method.
-func (m *Mockmethods) Follow(ctx context.Context, p FollowParams) error {
+// CreateAchievements mocks base method.
+func (m *MockTx) CreateAchievements(ctx context.Context, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Follow", ctx, p)
+	ret := m.ctrl.Call(m, "CreateAchievements", ctx, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Follow indicates an expected call of Follow.
-func (mr *MockmethodsMockRecorder) Follow(ctx, p any) *gomock.Call {
+// CreateAchievements indicates an expected call of CreateAchievements.
+func (mr *MockTxMockRecorder) CreateAchievements(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*Mockmethods)(nil).Follow), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAchievements", reflect.TypeOf((*MockTx)(nil).CreateAchievements), ctx, userID)
 }
 
-// GetAuth mocks base method.
-func (m *Mockmethods) GetAuth(ctx context.Context, opts ...GetAuthOpt) (*orm.Auth, error) {
+// CreateAuth mocks base method.
+func (m *MockTx) CreateAuth(ctx context.Context, email, password string) (*orm.Auth, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetAuth", varargs...)
+	ret := m.ctrl.Call(m, "CreateAuth", ctx, email, password)
 	ret0, _ := ret[0].(*orm.Auth)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAuth indicates an expected call of GetAuth.
-func (mr *MockmethodsMockRecorder) GetAuth(ctx any, opts ...any) *gomock.Call {
+// CreateAuth indicates an expected call of CreateAuth.
+func (mr *MockTxMockRecorder) CreateAuth(ctx, email, password any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuth", reflect.TypeOf((*Mockmethods)(nil).GetAuth), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuth", reflect.TypeOf((*MockTx)(nil).CreateAuth), ctx, email, password)
 }
 
-// GetExercise mocks base method.
-func (m *Mockmethods) GetExercise(ctx context.Context, opts ...GetExerciseOpt) (*orm.Exercise, error) {
+// CreateBodyweight mocks base method.
+func (m *MockTx) CreateBodyweight(ctx context.Context, userID string, bodyweight float64) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetExercise", varargs...)
-	ret0, _ := ret[0].(*orm.Exercise)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CreateBodyweight", ctx, userID, bodyweight)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetExercise indicates an expected call of GetExercise.
-func (mr *MockmethodsMockRecorder) GetExercise(ctx any, opts ...any) *gomock.Call {
+// CreateBodyweight indicates an expected call of CreateBodyweight.
+func (mr *MockTxMockRecorder) CreateBodyweight(ctx, userID, bodyweight any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExercise", reflect.TypeOf((*Mockmethods)(nil).GetExercise), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBodyweight", reflect.TypeOf((*MockTx)(nil).CreateBodyweight), ctx, userID, bodyweight)
 }
 
-// GetPersonalBests mocks base method.
-func (m *Mockmethods) GetPersonalBests(ctx context.Context, userIDs ...string) (orm.SetSlice, error) {
+// CreateChallenge mocks base method.
+func (m *MockTx) CreateChallenge(ctx context.Context, p CreateChallengeParams) (*orm.Challenge, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range userIDs {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetPersonalBests", varargs...)
-	ret0, _ := ret[0].(orm.SetSlice)
+	ret := m.ctrl.Call(m, "CreateChallenge", ctx, p)
+	ret0, _ := ret[0].(*orm.Challenge)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPersonalBests indicates an expected call of GetPersonalBests.
-func (mr *MockmethodsMockRecorder) GetPersonalBests(ctx any, userIDs ...any) *gomock.Call {
+// CreateChallenge indicates an expected call of CreateChallenge.
+func (mr *MockTxMockRecorder) CreateChallenge(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, userIDs...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalBests", reflect.TypeOf((*Mockmethods)(nil).GetPersonalBests), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChallenge", reflect.TypeOf((*MockTx)(nil).CreateChallenge), ctx, p)
 }
 
-// GetPreviousWorkoutSets mocks base method.
-func (m *Mockmethods) GetPreviousWorkoutSets(ctx context.Context, exerciseIDs []string) (orm.SetSlice, error) {
+// CreateClub mocks base method.
+func (m *MockTx) CreateClub(ctx context.Context, p CreateClubParams) (*orm.Club, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPreviousWorkoutSets", ctx, exerciseIDs)
-	ret0, _ := ret[0].(orm.SetSlice)
+	ret := m.ctrl.Call(m, "CreateClub", ctx, p)
+	ret0, _ := ret[0].(*orm.Club)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPreviousWorkoutSets indicates an expected call of GetPreviousWorkoutSets.
-func (mr *MockmethodsMockRecorder) GetPreviousWorkoutSets(ctx, exerciseIDs any) *gomock.Call {
+// CreateClub indicates an expected call of CreateClub.
+func (mr *MockTxMockRecorder) CreateClub(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviousWorkoutSets", reflect.TypeOf((*Mockmethods)(nil).GetPreviousWorkoutSets), ctx, exerciseIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClub", reflect.TypeOf((*MockTx)(nil).CreateClub), ctx, p)
 }
 
-// GetRoutine mocks base method.
-func (m *Mockmethods) GetRoutine(ctx context.Context, opts ...GetRoutineOpt) (*orm.Routine, error) {
+// CreateClubMember mocks base method.
+func (m *MockTx) CreateClubMember(ctx context.Context, p CreateClubMemberParams) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetRoutine", varargs...)
-	ret0, _ := ret[0].(*orm.Routine)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CreateClubMember", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetRoutine indicates an expected call of GetRoutine.
-func (mr *MockmethodsMockRecorder) GetRoutine(ctx any, opts ...any) *gomock.Call {
+// CreateClubMember indicates an expected call of CreateClubMember.
+func (mr *MockTxMockRecorder) CreateClubMember(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutine", reflect.TypeOf((*Mockmethods)(nil).GetRoutine), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClubMember", reflect.TypeOf((*MockTx)(nil).CreateClubMember), ctx, p)
 }
 
-// GetUser mocks base method.
-func (m *Mockmethods) GetUser(ctx context.Context, opts ...GetUserOpt) (*orm.User, error) {
+// CreateCoachAction mocks base method.
+func (m *MockTx) CreateCoachAction(ctx context.Context, p CreateCoachActionParams) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetUser", varargs...)
-	ret0, _ := ret[0].(*orm.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CreateCoachAction", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetUser indicates an expected call of GetUser.
-func (mr *MockmethodsMockRecorder) GetUser(ctx any, opts ...any) *gomock.Call {
+// CreateCoachAction indicates an expected call of CreateCoachAction.
+func (mr *MockTxMockRecorder) CreateCoachAction(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*Mockmethods)(nil).GetUser), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCoachAction", reflect.TypeOf((*MockTx)(nil).CreateCoachAction), ctx, p)
 }
 
-// GetWorkout mocks base method.
-func (m *Mockmethods) GetWorkout(ctx context.Context, opts ...GetWorkoutOpt) (*orm.Workout, error) {
+// CreateDataExport mocks base method.
+func (m *MockTx) CreateDataExport(ctx context.Context, userID string) (*orm.DataExport, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetWorkout", varargs...)
-	ret0, _ := ret[0].(*orm.Workout)
+	ret := m.ctrl.Call(m, "CreateDataExport", ctx, userID)
+	ret0, _ := ret[0].(*orm.DataExport)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWorkout indicates an expected call of GetWorkout.
-func (mr *MockmethodsMockRecorder) GetWorkout(ctx any, opts ...any) *gomock.Call {
+// CreateDataExport indicates an expected call of CreateDataExport.
+func (mr *MockTxMockRecorder) CreateDataExport(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkout", reflect.TypeOf((*Mockmethods)(nil).GetWorkout), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataExport", reflect.TypeOf((*MockTx)(nil).CreateDataExport), ctx, userID)
 }
 
-// GetWorkoutComment mocks base method.
-func (m *Mockmethods) GetWorkoutComment(ctx context.Context, opts ...GetWorkoutCommentOpt) (*orm.WorkoutComment, error) {
+// CreateExercise mocks base method.
+func (m *MockTx) CreateExercise(ctx context.Context, p CreateExerciseParams) (*orm.Exercise, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetWorkoutComment", varargs...)
-	ret0, _ := ret[0].(*orm.WorkoutComment)
+	ret := m.ctrl.Call(m, "CreateExercise", ctx, p)
+	ret0, _ := ret[0].(*orm.Exercise)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWorkoutComment indicates an expected call of GetWorkoutComment.
-func (mr *MockmethodsMockRecorder) GetWorkoutComment(ctx any, opts ...any) *gomock.Call {
+// CreateExercise indicates an expected call of CreateExercise.
+func (mr *MockTxMockRecorder) CreateExercise(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkoutComment", reflect.TypeOf((*Mockmethods)(nil).GetWorkoutComment), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExercise", reflect.TypeOf((*MockTx)(nil).CreateExercise), ctx, p)
 }
 
-// IsUserFollowedByUserID mocks base method.
+// CreateFollowRequest mocks base method.
+func (m *MockTx) CreateFollowRequest(ctx context.Context, p FollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFollowRequest", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFollowRequest indicates an expected call of CreateFollowRequest.
+func (mr *MockTxMockRecorder) CreateFollowRequest(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFollowRequest", reflect.TypeOf((*MockTx)(nil).CreateFollowRequest), ctx, p)
+}
+
+// CreateGroupedNotification mocks base method.
+func (m *MockTx) CreateGroupedNotification(ctx context.Context, p CreateNotificationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupedNotification", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGroupedNotification indicates an expected call of CreateGroupedNotification.
+func (mr *MockTxMockRecorder) CreateGroupedNotification(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupedNotification", reflect.TypeOf((*MockTx)(nil).CreateGroupedNotification), ctx, p)
+}
+
+// CreateNotification mocks base method.
+func (m *MockTx) CreateNotification(ctx context.Context, p CreateNotificationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MockTxMockRecorder) CreateNotification(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockTx)(nil).CreateNotification), ctx, p)
+}
+
+// CreatePersonalRecords mocks base method.
+func (m *MockTx) CreatePersonalRecords(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonalRecords", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePersonalRecords indicates an expected call of CreatePersonalRecords.
+func (mr *MockTxMockRecorder) CreatePersonalRecords(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalRecords", reflect.TypeOf((*MockTx)(nil).CreatePersonalRecords), ctx, userID)
+}
+
+// CreateReport mocks base method.
+func (m *MockTx) CreateReport(ctx context.Context, p CreateReportParams) (*orm.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", ctx, p)
+	ret0, _ := ret[0].(*orm.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockTxMockRecorder) CreateReport(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockTx)(nil).CreateReport), ctx, p)
+}
+
+// CreateRoutine mocks base method.
+func (m *MockTx) CreateRoutine(ctx context.Context, p CreateRoutineParams) (*orm.Routine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoutine", ctx, p)
+	ret0, _ := ret[0].(*orm.Routine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoutine indicates an expected call of CreateRoutine.
+func (mr *MockTxMockRecorder) CreateRoutine(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoutine", reflect.TypeOf((*MockTx)(nil).CreateRoutine), ctx, p)
+}
+
+// CreateUser mocks base method.
+func (m *MockTx) CreateUser(ctx context.Context, p CreateUserParams) (*orm.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, p)
+	ret0, _ := ret[0].(*orm.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockTxMockRecorder) CreateUser(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockTx)(nil).CreateUser), ctx, p)
+}
+
+// CreateWorkout mocks base method.
+func (m *MockTx) CreateWorkout(ctx context.Context, p CreateWorkoutParams) (*orm.Workout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkout", ctx, p)
+	ret0, _ := ret[0].(*orm.Workout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkout indicates an expected call of CreateWorkout.
+func (mr *MockTxMockRecorder) CreateWorkout(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkout", reflect.TypeOf((*MockTx)(nil).CreateWorkout), ctx, p)
+}
+
+// CreateWorkoutComment mocks base method.
+func (m *MockTx) CreateWorkoutComment(ctx context.Context, p CreateWorkoutCommentParams, opts ...CreateWorkoutCommentOpts) (*orm.WorkoutComment, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWorkoutComment", varargs...)
+	ret0, _ := ret[0].(*orm.WorkoutComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkoutComment indicates an expected call of CreateWorkoutComment.
+func (mr *MockTxMockRecorder) CreateWorkoutComment(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkoutComment", reflect.TypeOf((*MockTx)(nil).CreateWorkoutComment), varargs...)
+}
+
+// CreateYearSummary mocks base method.
+func (m *MockTx) CreateYearSummary(ctx context.Context, p CreateYearSummaryParams) (*orm.YearSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateYearSummary", ctx, p)
+	ret0, _ := ret[0].(*orm.YearSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateYearSummary indicates an expected call of CreateYearSummary.
+func (mr *MockTxMockRecorder) CreateYearSummary(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateYearSummary", reflect.TypeOf((*MockTx)(nil).CreateYearSummary), ctx, p)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockTx) DeleteAccount(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockTxMockRecorder) DeleteAccount(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockTx)(nil).DeleteAccount), ctx, userID)
+}
+
+// DeleteClubMember mocks base method.
+func (m *MockTx) DeleteClubMember(ctx context.Context, clubID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClubMember", ctx, clubID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClubMember indicates an expected call of DeleteClubMember.
+func (mr *MockTxMockRecorder) DeleteClubMember(ctx, clubID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClubMember", reflect.TypeOf((*MockTx)(nil).DeleteClubMember), ctx, clubID, userID)
+}
+
+// DeleteFollowRequest mocks base method.
+func (m *MockTx) DeleteFollowRequest(ctx context.Context, p FollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFollowRequest", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFollowRequest indicates an expected call of DeleteFollowRequest.
+func (mr *MockTxMockRecorder) DeleteFollowRequest(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFollowRequest", reflect.TypeOf((*MockTx)(nil).DeleteFollowRequest), ctx, p)
+}
+
+// DeleteGroupedNotifications mocks base method.
+func (m *MockTx) DeleteGroupedNotifications(ctx context.Context, userID string, notificationType orm.NotificationType, workoutID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupedNotifications", ctx, userID, notificationType, workoutID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroupedNotifications indicates an expected call of DeleteGroupedNotifications.
+func (mr *MockTxMockRecorder) DeleteGroupedNotifications(ctx, userID, notificationType, workoutID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupedNotifications", reflect.TypeOf((*MockTx)(nil).DeleteGroupedNotifications), ctx, userID, notificationType, workoutID)
+}
+
+// DeleteRoutine mocks base method.
+func (m *MockTx) DeleteRoutine(ctx context.Context, routineID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoutine", ctx, routineID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoutine indicates an expected call of DeleteRoutine.
+func (mr *MockTxMockRecorder) DeleteRoutine(ctx, routineID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoutine", reflect.TypeOf((*MockTx)(nil).DeleteRoutine), ctx, routineID)
+}
+
+// DeleteWorkout mocks base method.
+func (m *MockTx) DeleteWorkout(ctx context.Context, opts ...DeleteWorkoutOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWorkout", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkout indicates an expected call of DeleteWorkout.
+func (mr *MockTxMockRecorder) DeleteWorkout(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkout", reflect.TypeOf((*MockTx)(nil).DeleteWorkout), varargs...)
+}
+
+// DeleteWorkoutComment mocks base method.
+func (m *MockTx) DeleteWorkoutComment(ctx context.Context, commentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkoutComment", ctx, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkoutComment indicates an expected call of DeleteWorkoutComment.
+func (mr *MockTxMockRecorder) DeleteWorkoutComment(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkoutComment", reflect.TypeOf((*MockTx)(nil).DeleteWorkoutComment), ctx, commentID)
+}
+
+// DeleteWorkoutReaction mocks base method.
+func (m *MockTx) DeleteWorkoutReaction(ctx context.Context, userID, workoutID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkoutReaction", ctx, userID, workoutID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkoutReaction indicates an expected call of DeleteWorkoutReaction.
+func (mr *MockTxMockRecorder) DeleteWorkoutReaction(ctx, userID, workoutID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkoutReaction", reflect.TypeOf((*MockTx)(nil).DeleteWorkoutReaction), ctx, userID, workoutID)
+}
+
+// Follow mocks base method.
+func (m *MockTx) Follow(ctx context.Context, p FollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockTxMockRecorder) Follow(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockTx)(nil).Follow), ctx, p)
+}
+
+// GetAuth mocks base method.
+func (m *MockTx) GetAuth(ctx context.Context, opts ...GetAuthOpt) (*orm.Auth, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAuth", varargs...)
+	ret0, _ := ret[0].(*orm.Auth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuth indicates an expected call of GetAuth.
+func (mr *MockTxMockRecorder) GetAuth(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuth", reflect.TypeOf((*MockTx)(nil).GetAuth), varargs...)
+}
+
+// GetBodyweightAt mocks base method.
+func (m *MockTx) GetBodyweightAt(ctx context.Context, userID string, at time.Time) (*orm.Bodyweight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBodyweightAt", ctx, userID, at)
+	ret0, _ := ret[0].(*orm.Bodyweight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBodyweightAt indicates an expected call of GetBodyweightAt.
+func (mr *MockTxMockRecorder) GetBodyweightAt(ctx, userID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBodyweightAt", reflect.TypeOf((*MockTx)(nil).GetBodyweightAt), ctx, userID, at)
+}
+
+// GetChallenge mocks base method.
+func (m *MockTx) GetChallenge(ctx context.Context, opts ...GetChallengeOpt) (*orm.Challenge, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChallenge", varargs...)
+	ret0, _ := ret[0].(*orm.Challenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChallenge indicates an expected call of GetChallenge.
+func (mr *MockTxMockRecorder) GetChallenge(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChallenge", reflect.TypeOf((*MockTx)(nil).GetChallenge), varargs...)
+}
+
+// GetChallengeStandings mocks base method.
+func (m *MockTx) GetChallengeStandings(ctx context.Context, challengeID string) ([]ChallengeStanding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChallengeStandings", ctx, challengeID)
+	ret0, _ := ret[0].([]ChallengeStanding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChallengeStandings indicates an expected call of GetChallengeStandings.
+func (mr *MockTxMockRecorder) GetChallengeStandings(ctx, challengeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChallengeStandings", reflect.TypeOf((*MockTx)(nil).GetChallengeStandings), ctx, challengeID)
+}
+
+// GetClub mocks base method.
+func (m *MockTx) GetClub(ctx context.Context, opts ...GetClubOpt) (*orm.Club, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClub", varargs...)
+	ret0, _ := ret[0].(*orm.Club)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClub indicates an expected call of GetClub.
+func (mr *MockTxMockRecorder) GetClub(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClub", reflect.TypeOf((*MockTx)(nil).GetClub), varargs...)
+}
+
+// GetClubMember mocks base method.
+func (m *MockTx) GetClubMember(ctx context.Context, clubID, userID string) (*orm.ClubMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClubMember", ctx, clubID, userID)
+	ret0, _ := ret[0].(*orm.ClubMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClubMember indicates an expected call of GetClubMember.
+func (mr *MockTxMockRecorder) GetClubMember(ctx, clubID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClubMember", reflect.TypeOf((*MockTx)(nil).GetClubMember), ctx, clubID, userID)
+}
+
+// GetDataExport mocks base method.
+func (m *MockTx) GetDataExport(ctx context.Context, opts ...GetDataExportOpt) (*orm.DataExport, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDataExport", varargs...)
+	ret0, _ := ret[0].(*orm.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataExport indicates an expected call of GetDataExport.
+func (mr *MockTxMockRecorder) GetDataExport(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataExport", reflect.TypeOf((*MockTx)(nil).GetDataExport), varargs...)
+}
+
+// GetExercise mocks base method.
+func (m *MockTx) GetExercise(ctx context.Context, opts ...GetExerciseOpt) (*orm.Exercise, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetExercise", varargs...)
+	ret0, _ := ret[0].(*orm.Exercise)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExercise indicates an expected call of GetExercise.
+func (mr *MockTxMockRecorder) GetExercise(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExercise", reflect.TypeOf((*MockTx)(nil).GetExercise), varargs...)
+}
+
+// GetExerciseProgress mocks base method.
+func (m *MockTx) GetExerciseProgress(ctx context.Context, p GetExerciseProgressParams) ([]ExerciseProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExerciseProgress", ctx, p)
+	ret0, _ := ret[0].([]ExerciseProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExerciseProgress indicates an expected call of GetExerciseProgress.
+func (mr *MockTxMockRecorder) GetExerciseProgress(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExerciseProgress", reflect.TypeOf((*MockTx)(nil).GetExerciseProgress), ctx, p)
+}
+
+// GetLeaderboard mocks base method.
+func (m *MockTx) GetLeaderboard(ctx context.Context, p GetLeaderboardParams) ([]LeaderboardEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderboard", ctx, p)
+	ret0, _ := ret[0].([]LeaderboardEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderboard indicates an expected call of GetLeaderboard.
+func (mr *MockTxMockRecorder) GetLeaderboard(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboard", reflect.TypeOf((*MockTx)(nil).GetLeaderboard), ctx, p)
+}
+
+// GetPersonalBests mocks base method.
+func (m *MockTx) GetPersonalBests(ctx context.Context, userIDs ...string) (orm.SetSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range userIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPersonalBests", varargs...)
+	ret0, _ := ret[0].(orm.SetSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalBests indicates an expected call of GetPersonalBests.
+func (mr *MockTxMockRecorder) GetPersonalBests(ctx any, userIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, userIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalBests", reflect.TypeOf((*MockTx)(nil).GetPersonalBests), varargs...)
+}
+
+// GetPreviousWorkoutSets mocks base method.
+func (m *MockTx) GetPreviousWorkoutSets(ctx context.Context, exerciseIDs []string) (orm.SetSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreviousWorkoutSets", ctx, exerciseIDs)
+	ret0, _ := ret[0].(orm.SetSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreviousWorkoutSets indicates an expected call of GetPreviousWorkoutSets.
+func (mr *MockTxMockRecorder) GetPreviousWorkoutSets(ctx, exerciseIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviousWorkoutSets", reflect.TypeOf((*MockTx)(nil).GetPreviousWorkoutSets), ctx, exerciseIDs)
+}
+
+// GetReport mocks base method.
+func (m *MockTx) GetReport(ctx context.Context, opts ...GetReportOpt) (*orm.Report, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReport", varargs...)
+	ret0, _ := ret[0].(*orm.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockTxMockRecorder) GetReport(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockTx)(nil).GetReport), varargs...)
+}
+
+// GetRoutine mocks base method.
+func (m *MockTx) GetRoutine(ctx context.Context, opts ...GetRoutineOpt) (*orm.Routine, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRoutine", varargs...)
+	ret0, _ := ret[0].(*orm.Routine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoutine indicates an expected call of GetRoutine.
+func (mr *MockTxMockRecorder) GetRoutine(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutine", reflect.TypeOf((*MockTx)(nil).GetRoutine), varargs...)
+}
+
+// GetUser mocks base method.
+func (m *MockTx) GetUser(ctx context.Context, opts ...GetUserOpt) (*orm.User, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUser", varargs...)
+	ret0, _ := ret[0].(*orm.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockTxMockRecorder) GetUser(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockTx)(nil).GetUser), varargs...)
+}
+
+// GetVisiblePersonalBests mocks base method.
+func (m *MockTx) GetVisiblePersonalBests(ctx context.Context, viewerID string, userIDs ...string) (orm.SetSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, viewerID}
+	for _, a := range userIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVisiblePersonalBests", varargs...)
+	ret0, _ := ret[0].(orm.SetSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVisiblePersonalBests indicates an expected call of GetVisiblePersonalBests.
+func (mr *MockTxMockRecorder) GetVisiblePersonalBests(ctx, viewerID any, userIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, viewerID}, userIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisiblePersonalBests", reflect.TypeOf((*MockTx)(nil).GetVisiblePersonalBests), varargs...)
+}
+
+// GetWorkout mocks base method.
+func (m *MockTx) GetWorkout(ctx context.Context, opts ...GetWorkoutOpt) (*orm.Workout, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkout", varargs...)
+	ret0, _ := ret[0].(*orm.Workout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkout indicates an expected call of GetWorkout.
+func (mr *MockTxMockRecorder) GetWorkout(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkout", reflect.TypeOf((*MockTx)(nil).GetWorkout), varargs...)
+}
+
+// GetWorkoutComment mocks base method.
+func (m *MockTx) GetWorkoutComment(ctx context.Context, opts ...GetWorkoutCommentOpt) (*orm.WorkoutComment, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkoutComment", varargs...)
+	ret0, _ := ret[0].(*orm.WorkoutComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkoutComment indicates an expected call of GetWorkoutComment.
+func (mr *MockTxMockRecorder) GetWorkoutComment(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkoutComment", reflect.TypeOf((*MockTx)(nil).GetWorkoutComment), varargs...)
+}
+
+// GetWorkoutReaction mocks base method.
+func (m *MockTx) GetWorkoutReaction(ctx context.Context, opts ...GetWorkoutReactionOpt) (*orm.WorkoutReaction, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkoutReaction", varargs...)
+	ret0, _ := ret[0].(*orm.WorkoutReaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkoutReaction indicates an expected call of GetWorkoutReaction.
+func (mr *MockTxMockRecorder) GetWorkoutReaction(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkoutReaction", reflect.TypeOf((*MockTx)(nil).GetWorkoutReaction), varargs...)
+}
+
+// GetYearSummary mocks base method.
+func (m *MockTx) GetYearSummary(ctx context.Context, opts ...GetYearSummaryOpt) (*orm.YearSummary, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetYearSummary", varargs...)
+	ret0, _ := ret[0].(*orm.YearSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetYearSummary indicates an expected call of GetYearSummary.
+func (mr *MockTxMockRecorder) GetYearSummary(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearSummary", reflect.TypeOf((*MockTx)(nil).GetYearSummary), varargs...)
+}
+
+// GrantCoachAccess mocks base method.
+func (m *MockTx) GrantCoachAccess(ctx context.Context, p CoachingParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantCoachAccess", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantCoachAccess indicates an expected call of GrantCoachAccess.
+func (mr *MockTxMockRecorder) GrantCoachAccess(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantCoachAccess", reflect.TypeOf((*MockTx)(nil).GrantCoachAccess), ctx, p)
+}
+
+// HideWorkout mocks base method.
+func (m *MockTx) HideWorkout(ctx context.Context, workoutID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideWorkout", ctx, workoutID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HideWorkout indicates an expected call of HideWorkout.
+func (mr *MockTxMockRecorder) HideWorkout(ctx, workoutID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideWorkout", reflect.TypeOf((*MockTx)(nil).HideWorkout), ctx, workoutID)
+}
+
+// HideWorkoutComment mocks base method.
+func (m *MockTx) HideWorkoutComment(ctx context.Context, commentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideWorkoutComment", ctx, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HideWorkoutComment indicates an expected call of HideWorkoutComment.
+func (mr *MockTxMockRecorder) HideWorkoutComment(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideWorkoutComment", reflect.TypeOf((*MockTx)(nil).HideWorkoutComment), ctx, commentID)
+}
+
+// InviteToChallenge mocks base method.
+func (m *MockTx) InviteToChallenge(ctx context.Context, challengeID string, userIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteToChallenge", ctx, challengeID, userIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteToChallenge indicates an expected call of InviteToChallenge.
+func (mr *MockTxMockRecorder) InviteToChallenge(ctx, challengeID, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteToChallenge", reflect.TypeOf((*MockTx)(nil).InviteToChallenge), ctx, challengeID, userIDs)
+}
+
+// IsBlocked mocks base method.
+func (m *MockTx) IsBlocked(ctx context.Context, userID string, otherUserIDs ...string) (bool, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range otherUserIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IsBlocked", varargs...)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockTxMockRecorder) IsBlocked(ctx, userID any, otherUserIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, otherUserIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockTx)(nil).IsBlocked), varargs...)
+}
+
+// IsCoach mocks base method.
+func (m *MockTx) IsCoach(ctx context.Context, p CoachingParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCoach", ctx, p)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsCoach indicates an expected call of IsCoach.
+func (mr *MockTxMockRecorder) IsCoach(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCoach", reflect.TypeOf((*MockTx)(nil).IsCoach), ctx, p)
+}
+
+// IsUserFollowedByUserID mocks base method.
+func (m *MockTx) IsUserFollowedByUserID(ctx context.Context, user *orm.User, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserFollowedByUserID", ctx, user, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserFollowedByUserID indicates an expected call of IsUserFollowedByUserID.
+func (mr *MockTxMockRecorder) IsUserFollowedByUserID(ctx, user, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserFollowedByUserID", reflect.TypeOf((*MockTx)(nil).IsUserFollowedByUserID), ctx, user, userID)
+}
+
+// JoinChallenge mocks base method.
+func (m *MockTx) JoinChallenge(ctx context.Context, challengeID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinChallenge", ctx, challengeID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JoinChallenge indicates an expected call of JoinChallenge.
+func (mr *MockTxMockRecorder) JoinChallenge(ctx, challengeID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChallenge", reflect.TypeOf((*MockTx)(nil).JoinChallenge), ctx, challengeID, userID)
+}
+
+// LeaveChallenge mocks base method.
+func (m *MockTx) LeaveChallenge(ctx context.Context, challengeID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveChallenge", ctx, challengeID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveChallenge indicates an expected call of LeaveChallenge.
+func (mr *MockTxMockRecorder) LeaveChallenge(ctx, challengeID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveChallenge", reflect.TypeOf((*MockTx)(nil).LeaveChallenge), ctx, challengeID, userID)
+}
+
+// ListAchievements mocks base method.
+func (m *MockTx) ListAchievements(ctx context.Context, opts ...ListAchievementsOpt) (orm.AchievementSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAchievements", varargs...)
+	ret0, _ := ret[0].(orm.AchievementSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAchievements indicates an expected call of ListAchievements.
+func (mr *MockTxMockRecorder) ListAchievements(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAchievements", reflect.TypeOf((*MockTx)(nil).ListAchievements), varargs...)
+}
+
+// ListAthleteSummaries mocks base method.
+func (m *MockTx) ListAthleteSummaries(ctx context.Context, coachID string, now time.Time) ([]AthleteSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAthleteSummaries", ctx, coachID, now)
+	ret0, _ := ret[0].([]AthleteSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAthleteSummaries indicates an expected call of ListAthleteSummaries.
+func (mr *MockTxMockRecorder) ListAthleteSummaries(ctx, coachID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAthleteSummaries", reflect.TypeOf((*MockTx)(nil).ListAthleteSummaries), ctx, coachID, now)
+}
+
+// ListBlockedUsers mocks base method.
+func (m *MockTx) ListBlockedUsers(ctx context.Context, userID string) (orm.UserSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlockedUsers", ctx, userID)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlockedUsers indicates an expected call of ListBlockedUsers.
+func (mr *MockTxMockRecorder) ListBlockedUsers(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlockedUsers", reflect.TypeOf((*MockTx)(nil).ListBlockedUsers), ctx, userID)
+}
+
+// ListChallenges mocks base method.
+func (m *MockTx) ListChallenges(ctx context.Context, opts ...ListChallengesOpt) (orm.ChallengeSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListChallenges", varargs...)
+	ret0, _ := ret[0].(orm.ChallengeSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChallenges indicates an expected call of ListChallenges.
+func (mr *MockTxMockRecorder) ListChallenges(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChallenges", reflect.TypeOf((*MockTx)(nil).ListChallenges), varargs...)
+}
+
+// ListClubMembers mocks base method.
+func (m *MockTx) ListClubMembers(ctx context.Context, opts ...ListClubMembersOpt) (orm.ClubMemberSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClubMembers", varargs...)
+	ret0, _ := ret[0].(orm.ClubMemberSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClubMembers indicates an expected call of ListClubMembers.
+func (mr *MockTxMockRecorder) ListClubMembers(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClubMembers", reflect.TypeOf((*MockTx)(nil).ListClubMembers), varargs...)
+}
+
+// ListClubs mocks base method.
+func (m *MockTx) ListClubs(ctx context.Context, opts ...ListClubsOpt) (orm.ClubSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClubs", varargs...)
+	ret0, _ := ret[0].(orm.ClubSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClubs indicates an expected call of ListClubs.
+func (mr *MockTxMockRecorder) ListClubs(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClubs", reflect.TypeOf((*MockTx)(nil).ListClubs), varargs...)
+}
+
+// ListCoachActions mocks base method.
+func (m *MockTx) ListCoachActions(ctx context.Context, opts ...ListCoachActionsOpt) (orm.CoachActionSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCoachActions", varargs...)
+	ret0, _ := ret[0].(orm.CoachActionSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCoachActions indicates an expected call of ListCoachActions.
+func (mr *MockTxMockRecorder) ListCoachActions(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCoachActions", reflect.TypeOf((*MockTx)(nil).ListCoachActions), varargs...)
+}
+
+// ListCoaches mocks base method.
+func (m *MockTx) ListCoaches(ctx context.Context, athleteID string) (orm.UserSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCoaches", ctx, athleteID)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCoaches indicates an expected call of ListCoaches.
+func (mr *MockTxMockRecorder) ListCoaches(ctx, athleteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCoaches", reflect.TypeOf((*MockTx)(nil).ListCoaches), ctx, athleteID)
+}
+
+// ListExercises mocks base method.
+func (m *MockTx) ListExercises(ctx context.Context, opts ...ListExercisesOpt) (orm.ExerciseSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListExercises", varargs...)
+	ret0, _ := ret[0].(orm.ExerciseSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExercises indicates an expected call of ListExercises.
+func (mr *MockTxMockRecorder) ListExercises(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExercises", reflect.TypeOf((*MockTx)(nil).ListExercises), varargs...)
+}
+
+// ListFollowRequests mocks base method.
+func (m *MockTx) ListFollowRequests(ctx context.Context, opts ...ListFollowRequestsOpt) (orm.FollowRequestSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollowRequests", varargs...)
+	ret0, _ := ret[0].(orm.FollowRequestSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollowRequests indicates an expected call of ListFollowRequests.
+func (mr *MockTxMockRecorder) ListFollowRequests(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowRequests", reflect.TypeOf((*MockTx)(nil).ListFollowRequests), varargs...)
+}
+
+// ListFollowees mocks base method.
+func (m *MockTx) ListFollowees(ctx context.Context, userID string, opts ...ListFolloweesOpt) (orm.UserSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollowees", varargs...)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollowees indicates an expected call of ListFollowees.
+func (mr *MockTxMockRecorder) ListFollowees(ctx, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowees", reflect.TypeOf((*MockTx)(nil).ListFollowees), varargs...)
+}
+
+// ListFollowers mocks base method.
+func (m *MockTx) ListFollowers(ctx context.Context, userID string, opts ...ListFollowersOpt) (orm.UserSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollowers", varargs...)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollowers indicates an expected call of ListFollowers.
+func (mr *MockTxMockRecorder) ListFollowers(ctx, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowers", reflect.TypeOf((*MockTx)(nil).ListFollowers), varargs...)
+}
+
+// ListFollows mocks base method.
+func (m *MockTx) ListFollows(ctx context.Context, opts ...ListFollowsOpt) (orm.FollowerSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollows", varargs...)
+	ret0, _ := ret[0].(orm.FollowerSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollows indicates an expected call of ListFollows.
+func (mr *MockTxMockRecorder) ListFollows(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollows", reflect.TypeOf((*MockTx)(nil).ListFollows), varargs...)
+}
+
+// ListNotifications mocks base method.
+func (m *MockTx) ListNotifications(ctx context.Context, opts ...ListNotificationsOpt) (orm.NotificationSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNotifications", varargs...)
+	ret0, _ := ret[0].(orm.NotificationSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotifications indicates an expected call of ListNotifications.
+func (mr *MockTxMockRecorder) ListNotifications(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MockTx)(nil).ListNotifications), varargs...)
+}
+
+// ListPersonalRecords mocks base method.
+func (m *MockTx) ListPersonalRecords(ctx context.Context, opts ...ListPersonalRecordsOpt) (orm.PersonalRecordSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPersonalRecords", varargs...)
+	ret0, _ := ret[0].(orm.PersonalRecordSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPersonalRecords indicates an expected call of ListPersonalRecords.
+func (mr *MockTxMockRecorder) ListPersonalRecords(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPersonalRecords", reflect.TypeOf((*MockTx)(nil).ListPersonalRecords), varargs...)
+}
+
+// ListReports mocks base method.
+func (m *MockTx) ListReports(ctx context.Context, opts ...ListReportsOpt) (orm.ReportSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListReports", varargs...)
+	ret0, _ := ret[0].(orm.ReportSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReports indicates an expected call of ListReports.
+func (mr *MockTxMockRecorder) ListReports(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReports", reflect.TypeOf((*MockTx)(nil).ListReports), varargs...)
+}
+
+// ListRoutines mocks base method.
+func (m *MockTx) ListRoutines(ctx context.Context, opts ...ListRoutineOpt) (orm.RoutineSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoutines", varargs...)
+	ret0, _ := ret[0].(orm.RoutineSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoutines indicates an expected call of ListRoutines.
+func (mr *MockTxMockRecorder) ListRoutines(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutines", reflect.TypeOf((*MockTx)(nil).ListRoutines), varargs...)
+}
+
+// ListSets mocks base method.
+func (m *MockTx) ListSets(ctx context.Context, opts ...ListSetsOpt) (orm.SetSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSets", varargs...)
+	ret0, _ := ret[0].(orm.SetSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSets indicates an expected call of ListSets.
+func (mr *MockTxMockRecorder) ListSets(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSets", reflect.TypeOf((*MockTx)(nil).ListSets), varargs...)
+}
+
+// ListUsers mocks base method.
+func (m *MockTx) ListUsers(ctx context.Context, opts ...ListUsersOpt) (orm.UserSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsers", varargs...)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockTxMockRecorder) ListUsers(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockTx)(nil).ListUsers), varargs...)
+}
+
+// ListWorkoutComments mocks base method.
+func (m *MockTx) ListWorkoutComments(ctx context.Context, opts ...ListWorkoutCommentsOpt) (orm.WorkoutCommentSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkoutComments", varargs...)
+	ret0, _ := ret[0].(orm.WorkoutCommentSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkoutComments indicates an expected call of ListWorkoutComments.
+func (mr *MockTxMockRecorder) ListWorkoutComments(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkoutComments", reflect.TypeOf((*MockTx)(nil).ListWorkoutComments), varargs...)
+}
+
+// ListWorkoutReactions mocks base method.
+func (m *MockTx) ListWorkoutReactions(ctx context.Context, opts ...ListWorkoutReactionsOpt) (orm.WorkoutReactionSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkoutReactions", varargs...)
+	ret0, _ := ret[0].(orm.WorkoutReactionSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkoutReactions indicates an expected call of ListWorkoutReactions.
+func (mr *MockTxMockRecorder) ListWorkoutReactions(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkoutReactions", reflect.TypeOf((*MockTx)(nil).ListWorkoutReactions), varargs...)
+}
+
+// ListWorkouts mocks base method.
+func (m *MockTx) ListWorkouts(ctx context.Context, opts ...ListWorkoutsOpt) (orm.WorkoutSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkouts", varargs...)
+	ret0, _ := ret[0].(orm.WorkoutSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkouts indicates an expected call of ListWorkouts.
+func (mr *MockTxMockRecorder) ListWorkouts(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkouts", reflect.TypeOf((*MockTx)(nil).ListWorkouts), varargs...)
+}
+
+// ListYearSummaries mocks base method.
+func (m *MockTx) ListYearSummaries(ctx context.Context, opts ...ListYearSummariesOpt) (orm.YearSummarySlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListYearSummaries", varargs...)
+	ret0, _ := ret[0].(orm.YearSummarySlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListYearSummaries indicates an expected call of ListYearSummaries.
+func (mr *MockTxMockRecorder) ListYearSummaries(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListYearSummaries", reflect.TypeOf((*MockTx)(nil).ListYearSummaries), varargs...)
+}
+
+// ListYearSummaryCandidates mocks base method.
+func (m *MockTx) ListYearSummaryCandidates(ctx context.Context, year int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListYearSummaryCandidates", ctx, year)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListYearSummaryCandidates indicates an expected call of ListYearSummaryCandidates.
+func (mr *MockTxMockRecorder) ListYearSummaryCandidates(ctx, year any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListYearSummaryCandidates", reflect.TypeOf((*MockTx)(nil).ListYearSummaryCandidates), ctx, year)
+}
+
+// MarkNotificationsAsRead mocks base method.
+func (m *MockTx) MarkNotificationsAsRead(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsAsRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationsAsRead indicates an expected call of MarkNotificationsAsRead.
+func (mr *MockTxMockRecorder) MarkNotificationsAsRead(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsAsRead", reflect.TypeOf((*MockTx)(nil).MarkNotificationsAsRead), ctx, userID)
+}
+
+// MergeExercises mocks base method.
+func (m *MockTx) MergeExercises(ctx context.Context, p MergeExercisesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeExercises", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeExercises indicates an expected call of MergeExercises.
+func (mr *MockTxMockRecorder) MergeExercises(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeExercises", reflect.TypeOf((*MockTx)(nil).MergeExercises), ctx, p)
+}
+
+// PostCreateWorkoutCommentLoadUser mocks base method.
+func (m *MockTx) PostCreateWorkoutCommentLoadUser(ctx context.Context) CreateWorkoutCommentOpts {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostCreateWorkoutCommentLoadUser", ctx)
+	ret0, _ := ret[0].(CreateWorkoutCommentOpts)
+	return ret0
+}
+
+// PostCreateWorkoutCommentLoadUser indicates an expected call of PostCreateWorkoutCommentLoadUser.
+func (mr *MockTxMockRecorder) PostCreateWorkoutCommentLoadUser(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostCreateWorkoutCommentLoadUser", reflect.TypeOf((*MockTx)(nil).PostCreateWorkoutCommentLoadUser), ctx)
+}
+
+// PublishEvent mocks base method.
+func (m *MockTx) PublishEvent(ctx context.Context, topic orm.EventTopic, payload []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishEvent", ctx, topic, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishEvent indicates an expected call of PublishEvent.
+func (mr *MockTxMockRecorder) PublishEvent(ctx, topic, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvent", reflect.TypeOf((*MockTx)(nil).PublishEvent), ctx, topic, payload)
+}
+
+// PurgeDataExports mocks base method.
+func (m *MockTx) PurgeDataExports(ctx context.Context, createdBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDataExports", ctx, createdBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDataExports indicates an expected call of PurgeDataExports.
+func (mr *MockTxMockRecorder) PurgeDataExports(ctx, createdBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDataExports", reflect.TypeOf((*MockTx)(nil).PurgeDataExports), ctx, createdBefore)
+}
+
+// PurgeExercises mocks base method.
+func (m *MockTx) PurgeExercises(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExercises", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExercises indicates an expected call of PurgeExercises.
+func (mr *MockTxMockRecorder) PurgeExercises(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExercises", reflect.TypeOf((*MockTx)(nil).PurgeExercises), ctx, deletedBefore)
+}
+
+// PurgeRoutines mocks base method.
+func (m *MockTx) PurgeRoutines(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeRoutines", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeRoutines indicates an expected call of PurgeRoutines.
+func (mr *MockTxMockRecorder) PurgeRoutines(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeRoutines", reflect.TypeOf((*MockTx)(nil).PurgeRoutines), ctx, deletedBefore)
+}
+
+// PurgeWorkouts mocks base method.
+func (m *MockTx) PurgeWorkouts(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeWorkouts", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeWorkouts indicates an expected call of PurgeWorkouts.
+func (mr *MockTxMockRecorder) PurgeWorkouts(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeWorkouts", reflect.TypeOf((*MockTx)(nil).PurgeWorkouts), ctx, deletedBefore)
+}
+
+// RefreshTokenExists mocks base method.
+func (m *MockTx) RefreshTokenExists(ctx context.Context, refreshToken string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTokenExists", ctx, refreshToken)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTokenExists indicates an expected call of RefreshTokenExists.
+func (mr *MockTxMockRecorder) RefreshTokenExists(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokenExists", reflect.TypeOf((*MockTx)(nil).RefreshTokenExists), ctx, refreshToken)
+}
+
+// RemoveExerciseFromRoutine mocks base method.
+func (m *MockTx) RemoveExerciseFromRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveExerciseFromRoutine", ctx, exercise, routine)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveExerciseFromRoutine indicates an expected call of RemoveExerciseFromRoutine.
+func (mr *MockTxMockRecorder) RemoveExerciseFromRoutine(ctx, exercise, routine any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExerciseFromRoutine", reflect.TypeOf((*MockTx)(nil).RemoveExerciseFromRoutine), ctx, exercise, routine)
+}
+
+// ResolveReport mocks base method.
+func (m *MockTx) ResolveReport(ctx context.Context, p ResolveReportParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReport", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveReport indicates an expected call of ResolveReport.
+func (mr *MockTxMockRecorder) ResolveReport(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReport", reflect.TypeOf((*MockTx)(nil).ResolveReport), ctx, p)
+}
+
+// RestoreExercise mocks base method.
+func (m *MockTx) RestoreExercise(ctx context.Context, p RestoreExerciseParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreExercise", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreExercise indicates an expected call of RestoreExercise.
+func (mr *MockTxMockRecorder) RestoreExercise(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreExercise", reflect.TypeOf((*MockTx)(nil).RestoreExercise), ctx, p)
+}
+
+// RestoreRoutine mocks base method.
+func (m *MockTx) RestoreRoutine(ctx context.Context, routineID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRoutine", ctx, routineID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreRoutine indicates an expected call of RestoreRoutine.
+func (mr *MockTxMockRecorder) RestoreRoutine(ctx, routineID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRoutine", reflect.TypeOf((*MockTx)(nil).RestoreRoutine), ctx, routineID)
+}
+
+// RestoreWorkout mocks base method.
+func (m *MockTx) RestoreWorkout(ctx context.Context, workoutID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreWorkout", ctx, workoutID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreWorkout indicates an expected call of RestoreWorkout.
+func (mr *MockTxMockRecorder) RestoreWorkout(ctx, workoutID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkout", reflect.TypeOf((*MockTx)(nil).RestoreWorkout), ctx, workoutID)
+}
+
+// RevokeCoachAccess mocks base method.
+func (m *MockTx) RevokeCoachAccess(ctx context.Context, p CoachingParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCoachAccess", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeCoachAccess indicates an expected call of RevokeCoachAccess.
+func (mr *MockTxMockRecorder) RevokeCoachAccess(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCoachAccess", reflect.TypeOf((*MockTx)(nil).RevokeCoachAccess), ctx, p)
+}
+
+// SetRoutineExercises mocks base method.
+func (m *MockTx) SetRoutineExercises(ctx context.Context, routine *orm.Routine, exercises orm.ExerciseSlice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoutineExercises", ctx, routine, exercises)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRoutineExercises indicates an expected call of SetRoutineExercises.
+func (mr *MockTxMockRecorder) SetRoutineExercises(ctx, routine, exercises any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoutineExercises", reflect.TypeOf((*MockTx)(nil).SetRoutineExercises), ctx, routine, exercises)
+}
+
+// SoftDeleteExercise mocks base method.
+func (m *MockTx) SoftDeleteExercise(ctx context.Context, p SoftDeleteExerciseParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteExercise", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeleteExercise indicates an expected call of SoftDeleteExercise.
+func (mr *MockTxMockRecorder) SoftDeleteExercise(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteExercise", reflect.TypeOf((*MockTx)(nil).SoftDeleteExercise), ctx, p)
+}
+
+// StoreTrace mocks base method.
+func (m *MockTx) StoreTrace(ctx context.Context, p StoreTraceParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreTrace", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreTrace indicates an expected call of StoreTrace.
+func (mr *MockTxMockRecorder) StoreTrace(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreTrace", reflect.TypeOf((*MockTx)(nil).StoreTrace), ctx, p)
+}
+
+// SuspendUser mocks base method.
+func (m *MockTx) SuspendUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendUser indicates an expected call of SuspendUser.
+func (mr *MockTxMockRecorder) SuspendUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUser", reflect.TypeOf((*MockTx)(nil).SuspendUser), ctx, userID)
+}
+
+// TransferClubOwnership mocks base method.
+func (m *MockTx) TransferClubOwnership(ctx context.Context, p TransferClubOwnershipParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferClubOwnership", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferClubOwnership indicates an expected call of TransferClubOwnership.
+func (mr *MockTxMockRecorder) TransferClubOwnership(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferClubOwnership", reflect.TypeOf((*MockTx)(nil).TransferClubOwnership), ctx, p)
+}
+
+// UnblockUser mocks base method.
+func (m *MockTx) UnblockUser(ctx context.Context, p BlockParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockUser", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockTxMockRecorder) UnblockUser(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockTx)(nil).UnblockUser), ctx, p)
+}
+
+// Unfollow mocks base method.
+func (m *MockTx) Unfollow(ctx context.Context, p UnfollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfollow indicates an expected call of Unfollow.
+func (mr *MockTxMockRecorder) Unfollow(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockTx)(nil).Unfollow), ctx, p)
+}
+
+// UpdateAuth mocks base method.
+func (m *MockTx) UpdateAuth(ctx context.Context, authID string, opts ...UpdateAuthOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, authID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAuth", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAuth indicates an expected call of UpdateAuth.
+func (mr *MockTxMockRecorder) UpdateAuth(ctx, authID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, authID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuth", reflect.TypeOf((*MockTx)(nil).UpdateAuth), varargs...)
+}
+
+// UpdateChallenge mocks base method.
+func (m *MockTx) UpdateChallenge(ctx context.Context, challengeID string, opts ...UpdateChallengeOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, challengeID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateChallenge", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChallenge indicates an expected call of UpdateChallenge.
+func (mr *MockTxMockRecorder) UpdateChallenge(ctx, challengeID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, challengeID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChallenge", reflect.TypeOf((*MockTx)(nil).UpdateChallenge), varargs...)
+}
+
+// UpdateClubMember mocks base method.
+func (m *MockTx) UpdateClubMember(ctx context.Context, clubID, userID string, opts ...UpdateClubMemberOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clubID, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateClubMember", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClubMember indicates an expected call of UpdateClubMember.
+func (mr *MockTxMockRecorder) UpdateClubMember(ctx, clubID, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clubID, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClubMember", reflect.TypeOf((*MockTx)(nil).UpdateClubMember), varargs...)
+}
+
+// UpdateExercise mocks base method.
+func (m *MockTx) UpdateExercise(ctx context.Context, exerciseID string, opts ...UpdateExerciseOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, exerciseID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateExercise", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateExercise indicates an expected call of UpdateExercise.
+func (mr *MockTxMockRecorder) UpdateExercise(ctx, exerciseID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, exerciseID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExercise", reflect.TypeOf((*MockTx)(nil).UpdateExercise), varargs...)
+}
+
+// UpdateGroupedNotification mocks base method.
+func (m *MockTx) UpdateGroupedNotification(ctx context.Context, p CreateNotificationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroupedNotification", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGroupedNotification indicates an expected call of UpdateGroupedNotification.
+func (mr *MockTxMockRecorder) UpdateGroupedNotification(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupedNotification", reflect.TypeOf((*MockTx)(nil).UpdateGroupedNotification), ctx, p)
+}
+
+// UpdateRoutine mocks base method.
+func (m *MockTx) UpdateRoutine(ctx context.Context, routineID string, opts ...UpdateRoutineOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, routineID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRoutine", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRoutine indicates an expected call of UpdateRoutine.
+func (mr *MockTxMockRecorder) UpdateRoutine(ctx, routineID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, routineID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoutine", reflect.TypeOf((*MockTx)(nil).UpdateRoutine), varargs...)
+}
+
+// UpdateUser mocks base method.
+func (m *MockTx) UpdateUser(ctx context.Context, userID string, opts ...UpdateUserOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUser", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockTxMockRecorder) UpdateUser(ctx, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockTx)(nil).UpdateUser), varargs...)
+}
+
+// UpdateWorkout mocks base method.
+func (m *MockTx) UpdateWorkout(ctx context.Context, workoutID string, opts ...UpdateWorkoutOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, workoutID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkout", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkout indicates an expected call of UpdateWorkout.
+func (mr *MockTxMockRecorder) UpdateWorkout(ctx, workoutID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, workoutID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkout", reflect.TypeOf((*MockTx)(nil).UpdateWorkout), varargs...)
+}
+
+// UpdateWorkoutComment mocks base method.
+func (m *MockTx) UpdateWorkoutComment(ctx context.Context, commentID string, opts ...UpdateWorkoutCommentOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, commentID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkoutComment", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkoutComment indicates an expected call of UpdateWorkoutComment.
+func (mr *MockTxMockRecorder) UpdateWorkoutComment(ctx, commentID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, commentID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkoutComment", reflect.TypeOf((*MockTx)(nil).UpdateWorkoutComment), varargs...)
+}
+
+// UpdateWorkoutSets mocks base method.
+func (m *MockTx) UpdateWorkoutSets(ctx context.Context, p UpdateWorkoutSetsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkoutSets", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkoutSets indicates an expected call of UpdateWorkoutSets.
+func (mr *MockTxMockRecorder) UpdateWorkoutSets(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkoutSets", reflect.TypeOf((*MockTx)(nil).UpdateWorkoutSets), ctx, p)
+}
+
+// UpsertWorkoutReaction mocks base method.
+func (m *MockTx) UpsertWorkoutReaction(ctx context.Context, p UpsertWorkoutReactionParams) (*orm.WorkoutReaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkoutReaction", ctx, p)
+	ret0, _ := ret[0].(*orm.WorkoutReaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkoutReaction indicates an expected call of UpsertWorkoutReaction.
+func (mr *MockTxMockRecorder) UpsertWorkoutReaction(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkoutReaction", reflect.TypeOf((*MockTx)(nil).UpsertWorkoutReaction), ctx, p)
+}
+
+// exec mocks base method.
+func (m *MockTx) exec() *sql.Tx {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "exec")
+	ret0, _ := ret[0].(*sql.Tx)
+	return ret0
+}
+
+// exec indicates an expected call of exec.
+func (mr *MockTxMockRecorder) exec() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "exec", reflect.TypeOf((*MockTx)(nil).exec))
+}
+
+// Mockmethods is a mock of methods interface.
+type Mockmethods struct {
+	ctrl     *gomock.Controller
+	recorder *MockmethodsMockRecorder
+	isgomock struct{}
+}
+
+// MockmethodsMockRecorder is the mock recorder for Mockmethods.
+type MockmethodsMockRecorder struct {
+	mock *Mockmethods
+}
+
+// NewMockmethods creates a new mock instance.
+func NewMockmethods(ctrl *gomock.Controller) *Mockmethods {
+	mock := &Mockmethods{ctrl: ctrl}
+	mock.recorder = &MockmethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockmethods) EXPECT() *MockmethodsMockRecorder {
+	return m.recorder
+}
+
+// AddExerciseToRoutine mocks base method.
+func (m *Mockmethods) AddExerciseToRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddExerciseToRoutine", ctx, exercise, routine)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddExerciseToRoutine indicates an expected call of AddExerciseToRoutine.
+func (mr *MockmethodsMockRecorder) AddExerciseToRoutine(ctx, exercise, routine any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExerciseToRoutine", reflect.TypeOf((*Mockmethods)(nil).AddExerciseToRoutine), ctx, exercise, routine)
+}
+
+// ApproveFollowRequest mocks base method.
+func (m *Mockmethods) ApproveFollowRequest(ctx context.Context, p FollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveFollowRequest", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveFollowRequest indicates an expected call of ApproveFollowRequest.
+func (mr *MockmethodsMockRecorder) ApproveFollowRequest(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveFollowRequest", reflect.TypeOf((*Mockmethods)(nil).ApproveFollowRequest), ctx, p)
+}
+
+// ApproveFollowRequests mocks base method.
+func (m *Mockmethods) ApproveFollowRequests(ctx context.Context, followeeID string) (orm.FollowRequestSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveFollowRequests", ctx, followeeID)
+	ret0, _ := ret[0].(orm.FollowRequestSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveFollowRequests indicates an expected call of ApproveFollowRequests.
+func (mr *MockmethodsMockRecorder) ApproveFollowRequests(ctx, followeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveFollowRequests", reflect.TypeOf((*Mockmethods)(nil).ApproveFollowRequests), ctx, followeeID)
+}
+
+// BlockUser mocks base method.
+func (m *Mockmethods) BlockUser(ctx context.Context, p BlockParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUser", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockmethodsMockRecorder) BlockUser(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*Mockmethods)(nil).BlockUser), ctx, p)
+}
+
+// BuildYearSummary mocks base method.
+func (m *Mockmethods) BuildYearSummary(ctx context.Context, userID string, year int) (*YearSummaryPayload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildYearSummary", ctx, userID, year)
+	ret0, _ := ret[0].(*YearSummaryPayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildYearSummary indicates an expected call of BuildYearSummary.
+func (mr *MockmethodsMockRecorder) BuildYearSummary(ctx, userID, year any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildYearSummary", reflect.TypeOf((*Mockmethods)(nil).BuildYearSummary), ctx, userID, year)
+}
+
+// CanViewUser mocks base method.
+func (m *Mockmethods) CanViewUser(ctx context.Context, viewerID, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanViewUser", ctx, viewerID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanViewUser indicates an expected call of CanViewUser.
+func (mr *MockmethodsMockRecorder) CanViewUser(ctx, viewerID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewUser", reflect.TypeOf((*Mockmethods)(nil).CanViewUser), ctx, viewerID, userID)
+}
+
+// CanViewWorkout mocks base method.
+func (m *Mockmethods) CanViewWorkout(ctx context.Context, viewerID string, workout *orm.Workout) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanViewWorkout", ctx, viewerID, workout)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanViewWorkout indicates an expected call of CanViewWorkout.
+func (mr *MockmethodsMockRecorder) CanViewWorkout(ctx, viewerID, workout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewWorkout", reflect.TypeOf((*Mockmethods)(nil).CanViewWorkout), ctx, viewerID, workout)
+}
+
+// CloneRoutine mocks base method.
+func (m *Mockmethods) CloneRoutine(ctx context.Context, p CloneRoutineParams) (*orm.Routine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneRoutine", ctx, p)
+	ret0, _ := ret[0].(*orm.Routine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneRoutine indicates an expected call of CloneRoutine.
+func (mr *MockmethodsMockRecorder) CloneRoutine(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneRoutine", reflect.TypeOf((*Mockmethods)(nil).CloneRoutine), ctx, p)
+}
+
+// CompareEmailAndPassword mocks base method.
+func (m *Mockmethods) CompareEmailAndPassword(ctx context.Context, email, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareEmailAndPassword", ctx, email, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompareEmailAndPassword indicates an expected call of CompareEmailAndPassword.
+func (mr *MockmethodsMockRecorder) CompareEmailAndPassword(ctx, email, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareEmailAndPassword", reflect.TypeOf((*Mockmethods)(nil).CompareEmailAndPassword), ctx, email, password)
+}
+
+// CompleteDataExport mocks base method.
+func (m *Mockmethods) CompleteDataExport(ctx context.Context, exportID string, archive []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteDataExport", ctx, exportID, archive)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteDataExport indicates an expected call of CompleteDataExport.
+func (mr *MockmethodsMockRecorder) CompleteDataExport(ctx, exportID, archive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteDataExport", reflect.TypeOf((*Mockmethods)(nil).CompleteDataExport), ctx, exportID, archive)
+}
+
+// CountNotifications mocks base method.
+func (m *Mockmethods) CountNotifications(ctx context.Context, opts ...CountNotificationsOpt) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CountNotifications", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountNotifications indicates an expected call of CountNotifications.
+func (mr *MockmethodsMockRecorder) CountNotifications(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNotifications", reflect.TypeOf((*Mockmethods)(nil).CountNotifications), varargs...)
+}
+
+// CreateAchievements mocks base method.
+func (m *Mockmethods) CreateAchievements(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAchievements", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAchievements indicates an expected call of CreateAchievements.
+func (mr *MockmethodsMockRecorder) CreateAchievements(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAchievements", reflect.TypeOf((*Mockmethods)(nil).CreateAchievements), ctx, userID)
+}
+
+// CreateAuth mocks base method.
+func (m *Mockmethods) CreateAuth(ctx context.Context, email, password string) (*orm.Auth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuth", ctx, email, password)
+	ret0, _ := ret[0].(*orm.Auth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuth indicates an expected call of CreateAuth.
+func (mr *MockmethodsMockRecorder) CreateAuth(ctx, email, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuth", reflect.TypeOf((*Mockmethods)(nil).CreateAuth), ctx, email, password)
+}
+
+// CreateBodyweight mocks base method.
+func (m *Mockmethods) CreateBodyweight(ctx context.Context, userID string, bodyweight float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBodyweight", ctx, userID, bodyweight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBodyweight indicates an expected call of CreateBodyweight.
+func (mr *MockmethodsMockRecorder) CreateBodyweight(ctx, userID, bodyweight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBodyweight", reflect.TypeOf((*Mockmethods)(nil).CreateBodyweight), ctx, userID, bodyweight)
+}
+
+// CreateChallenge mocks base method.
+func (m *Mockmethods) CreateChallenge(ctx context.Context, p CreateChallengeParams) (*orm.Challenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChallenge", ctx, p)
+	ret0, _ := ret[0].(*orm.Challenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChallenge indicates an expected call of CreateChallenge.
+func (mr *MockmethodsMockRecorder) CreateChallenge(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChallenge", reflect.TypeOf((*Mockmethods)(nil).CreateChallenge), ctx, p)
+}
+
+// CreateClub mocks base method.
+func (m *Mockmethods) CreateClub(ctx context.Context, p CreateClubParams) (*orm.Club, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClub", ctx, p)
+	ret0, _ := ret[0].(*orm.Club)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClub indicates an expected call of CreateClub.
+func (mr *MockmethodsMockRecorder) CreateClub(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClub", reflect.TypeOf((*Mockmethods)(nil).CreateClub), ctx, p)
+}
+
+// CreateClubMember mocks base method.
+func (m *Mockmethods) CreateClubMember(ctx context.Context, p CreateClubMemberParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClubMember", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateClubMember indicates an expected call of CreateClubMember.
+func (mr *MockmethodsMockRecorder) CreateClubMember(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClubMember", reflect.TypeOf((*Mockmethods)(nil).CreateClubMember), ctx, p)
+}
+
+// CreateCoachAction mocks base method.
+func (m *Mockmethods) CreateCoachAction(ctx context.Context, p CreateCoachActionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCoachAction", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCoachAction indicates an expected call of CreateCoachAction.
+func (mr *MockmethodsMockRecorder) CreateCoachAction(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCoachAction", reflect.TypeOf((*Mockmethods)(nil).CreateCoachAction), ctx, p)
+}
+
+// CreateDataExport mocks base method.
+func (m *Mockmethods) CreateDataExport(ctx context.Context, userID string) (*orm.DataExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDataExport", ctx, userID)
+	ret0, _ := ret[0].(*orm.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDataExport indicates an expected call of CreateDataExport.
+func (mr *MockmethodsMockRecorder) CreateDataExport(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataExport", reflect.TypeOf((*Mockmethods)(nil).CreateDataExport), ctx, userID)
+}
+
+// CreateExercise mocks base method.
+func (m *Mockmethods) CreateExercise(ctx context.Context, p CreateExerciseParams) (*orm.Exercise, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExercise", ctx, p)
+	ret0, _ := ret[0].(*orm.Exercise)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExercise indicates an expected call of CreateExercise.
+func (mr *MockmethodsMockRecorder) CreateExercise(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExercise", reflect.TypeOf((*Mockmethods)(nil).CreateExercise), ctx, p)
+}
+
+// CreateFollowRequest mocks base method.
+func (m *Mockmethods) CreateFollowRequest(ctx context.Context, p FollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFollowRequest", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFollowRequest indicates an expected call of CreateFollowRequest.
+func (mr *MockmethodsMockRecorder) CreateFollowRequest(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFollowRequest", reflect.TypeOf((*Mockmethods)(nil).CreateFollowRequest), ctx, p)
+}
+
+// CreateGroupedNotification mocks base method.
+func (m *Mockmethods) CreateGroupedNotification(ctx context.Context, p CreateNotificationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupedNotification", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateGroupedNotification indicates an expected call of CreateGroupedNotification.
+func (mr *MockmethodsMockRecorder) CreateGroupedNotification(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupedNotification", reflect.TypeOf((*Mockmethods)(nil).CreateGroupedNotification), ctx, p)
+}
+
+// CreateNotification mocks base method.
+func (m *Mockmethods) CreateNotification(ctx context.Context, p CreateNotificationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MockmethodsMockRecorder) CreateNotification(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*Mockmethods)(nil).CreateNotification), ctx, p)
+}
+
+// CreatePersonalRecords mocks base method.
+func (m *Mockmethods) CreatePersonalRecords(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonalRecords", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePersonalRecords indicates an expected call of CreatePersonalRecords.
+func (mr *MockmethodsMockRecorder) CreatePersonalRecords(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalRecords", reflect.TypeOf((*Mockmethods)(nil).CreatePersonalRecords), ctx, userID)
+}
+
+// CreateReport mocks base method.
+func (m *Mockmethods) CreateReport(ctx context.Context, p CreateReportParams) (*orm.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", ctx, p)
+	ret0, _ := ret[0].(*orm.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockmethodsMockRecorder) CreateReport(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*Mockmethods)(nil).CreateReport), ctx, p)
+}
+
+// CreateRoutine mocks base method.
+func (m *Mockmethods) CreateRoutine(ctx context.Context, p CreateRoutineParams) (*orm.Routine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoutine", ctx, p)
+	ret0, _ := ret[0].(*orm.Routine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoutine indicates an expected call of CreateRoutine.
+func (mr *MockmethodsMockRecorder) CreateRoutine(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoutine", reflect.TypeOf((*Mockmethods)(nil).CreateRoutine), ctx, p)
+}
+
+// CreateUser mocks base method.
+func (m *Mockmethods) CreateUser(ctx context.Context, p CreateUserParams) (*orm.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, p)
+	ret0, _ := ret[0].(*orm.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockmethodsMockRecorder) CreateUser(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*Mockmethods)(nil).CreateUser), ctx, p)
+}
+
+// CreateWorkout mocks base method.
+func (m *Mockmethods) CreateWorkout(ctx context.Context, p CreateWorkoutParams) (*orm.Workout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkout", ctx, p)
+	ret0, _ := ret[0].(*orm.Workout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkout indicates an expected call of CreateWorkout.
+func (mr *MockmethodsMockRecorder) CreateWorkout(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkout", reflect.TypeOf((*Mockmethods)(nil).CreateWorkout), ctx, p)
+}
+
+// CreateWorkoutComment mocks base method.
+func (m *Mockmethods) CreateWorkoutComment(ctx context.Context, p CreateWorkoutCommentParams, opts ...CreateWorkoutCommentOpts) (*orm.WorkoutComment, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWorkoutComment", varargs...)
+	ret0, _ := ret[0].(*orm.WorkoutComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkoutComment indicates an expected call of CreateWorkoutComment.
+func (mr *MockmethodsMockRecorder) CreateWorkoutComment(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkoutComment", reflect.TypeOf((*Mockmethods)(nil).CreateWorkoutComment), varargs...)
+}
+
+// CreateYearSummary mocks base method.
+func (m *Mockmethods) CreateYearSummary(ctx context.Context, p CreateYearSummaryParams) (*orm.YearSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateYearSummary", ctx, p)
+	ret0, _ := ret[0].(*orm.YearSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateYearSummary indicates an expected call of CreateYearSummary.
+func (mr *MockmethodsMockRecorder) CreateYearSummary(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateYearSummary", reflect.TypeOf((*Mockmethods)(nil).CreateYearSummary), ctx, p)
+}
+
+// DeleteAccount mocks base method.
+func (m *Mockmethods) DeleteAccount(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockmethodsMockRecorder) DeleteAccount(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*Mockmethods)(nil).DeleteAccount), ctx, userID)
+}
+
+// DeleteClubMember mocks base method.
+func (m *Mockmethods) DeleteClubMember(ctx context.Context, clubID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClubMember", ctx, clubID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClubMember indicates an expected call of DeleteClubMember.
+func (mr *MockmethodsMockRecorder) DeleteClubMember(ctx, clubID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClubMember", reflect.TypeOf((*Mockmethods)(nil).DeleteClubMember), ctx, clubID, userID)
+}
+
+// DeleteFollowRequest mocks base method.
+func (m *Mockmethods) DeleteFollowRequest(ctx context.Context, p FollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFollowRequest", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFollowRequest indicates an expected call of DeleteFollowRequest.
+func (mr *MockmethodsMockRecorder) DeleteFollowRequest(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFollowRequest", reflect.TypeOf((*Mockmethods)(nil).DeleteFollowRequest), ctx, p)
+}
+
+// DeleteGroupedNotifications mocks base method.
+func (m *Mockmethods) DeleteGroupedNotifications(ctx context.Context, userID string, notificationType orm.NotificationType, workoutID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupedNotifications", ctx, userID, notificationType, workoutID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroupedNotifications indicates an expected call of DeleteGroupedNotifications.
+func (mr *MockmethodsMockRecorder) DeleteGroupedNotifications(ctx, userID, notificationType, workoutID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupedNotifications", reflect.TypeOf((*Mockmethods)(nil).DeleteGroupedNotifications), ctx, userID, notificationType, workoutID)
+}
+
+// DeleteRoutine mocks base method.
+func (m *Mockmethods) DeleteRoutine(ctx context.Context, routineID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoutine", ctx, routineID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoutine indicates an expected call of DeleteRoutine.
+func (mr *MockmethodsMockRecorder) DeleteRoutine(ctx, routineID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoutine", reflect.TypeOf((*Mockmethods)(nil).DeleteRoutine), ctx, routineID)
+}
+
+// DeleteWorkout mocks base method.
+func (m *Mockmethods) DeleteWorkout(ctx context.Context, opts ...DeleteWorkoutOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWorkout", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkout indicates an expected call of DeleteWorkout.
+func (mr *MockmethodsMockRecorder) DeleteWorkout(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkout", reflect.TypeOf((*Mockmethods)(nil).DeleteWorkout), varargs...)
+}
+
+// DeleteWorkoutComment mocks base method.
+func (m *Mockmethods) DeleteWorkoutComment(ctx context.Context, commentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkoutComment", ctx, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkoutComment indicates an expected call of DeleteWorkoutComment.
+func (mr *MockmethodsMockRecorder) DeleteWorkoutComment(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkoutComment", reflect.TypeOf((*Mockmethods)(nil).DeleteWorkoutComment), ctx, commentID)
+}
+
+// DeleteWorkoutReaction mocks base method.
+func (m *Mockmethods) DeleteWorkoutReaction(ctx context.Context, userID, workoutID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkoutReaction", ctx, userID, workoutID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkoutReaction indicates an expected call of DeleteWorkoutReaction.
+func (mr *MockmethodsMockRecorder) DeleteWorkoutReaction(ctx, userID, workoutID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkoutReaction", reflect.TypeOf((*Mockmethods)(nil).DeleteWorkoutReaction), ctx, userID, workoutID)
+}
+
+// Follow mocks base method.
+func (m *Mockmethods) Follow(ctx context.Context, p FollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockmethodsMockRecorder) Follow(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*Mockmethods)(nil).Follow), ctx, p)
+}
+
+// GetAuth mocks base method.
+func (m *Mockmethods) GetAuth(ctx context.Context, opts ...GetAuthOpt) (*orm.Auth, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAuth", varargs...)
+	ret0, _ := ret[0].(*orm.Auth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuth indicates an expected call of GetAuth.
+func (mr *MockmethodsMockRecorder) GetAuth(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuth", reflect.TypeOf((*Mockmethods)(nil).GetAuth), varargs...)
+}
+
+// GetBodyweightAt mocks base method.
+func (m *Mockmethods) GetBodyweightAt(ctx context.Context, userID string, at time.Time) (*orm.Bodyweight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBodyweightAt", ctx, userID, at)
+	ret0, _ := ret[0].(*orm.Bodyweight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBodyweightAt indicates an expected call of GetBodyweightAt.
+func (mr *MockmethodsMockRecorder) GetBodyweightAt(ctx, userID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBodyweightAt", reflect.TypeOf((*Mockmethods)(nil).GetBodyweightAt), ctx, userID, at)
+}
+
+// GetChallenge mocks base method.
+func (m *Mockmethods) GetChallenge(ctx context.Context, opts ...GetChallengeOpt) (*orm.Challenge, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChallenge", varargs...)
+	ret0, _ := ret[0].(*orm.Challenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChallenge indicates an expected call of GetChallenge.
+func (mr *MockmethodsMockRecorder) GetChallenge(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChallenge", reflect.TypeOf((*Mockmethods)(nil).GetChallenge), varargs...)
+}
+
+// GetChallengeStandings mocks base method.
+func (m *Mockmethods) GetChallengeStandings(ctx context.Context, challengeID string) ([]ChallengeStanding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChallengeStandings", ctx, challengeID)
+	ret0, _ := ret[0].([]ChallengeStanding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChallengeStandings indicates an expected call of GetChallengeStandings.
+func (mr *MockmethodsMockRecorder) GetChallengeStandings(ctx, challengeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChallengeStandings", reflect.TypeOf((*Mockmethods)(nil).GetChallengeStandings), ctx, challengeID)
+}
+
+// GetClub mocks base method.
+func (m *Mockmethods) GetClub(ctx context.Context, opts ...GetClubOpt) (*orm.Club, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClub", varargs...)
+	ret0, _ := ret[0].(*orm.Club)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClub indicates an expected call of GetClub.
+func (mr *MockmethodsMockRecorder) GetClub(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClub", reflect.TypeOf((*Mockmethods)(nil).GetClub), varargs...)
+}
+
+// GetClubMember mocks base method.
+func (m *Mockmethods) GetClubMember(ctx context.Context, clubID, userID string) (*orm.ClubMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClubMember", ctx, clubID, userID)
+	ret0, _ := ret[0].(*orm.ClubMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClubMember indicates an expected call of GetClubMember.
+func (mr *MockmethodsMockRecorder) GetClubMember(ctx, clubID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClubMember", reflect.TypeOf((*Mockmethods)(nil).GetClubMember), ctx, clubID, userID)
+}
+
+// GetDataExport mocks base method.
+func (m *Mockmethods) GetDataExport(ctx context.Context, opts ...GetDataExportOpt) (*orm.DataExport, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDataExport", varargs...)
+	ret0, _ := ret[0].(*orm.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDataExport indicates an expected call of GetDataExport.
+func (mr *MockmethodsMockRecorder) GetDataExport(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataExport", reflect.TypeOf((*Mockmethods)(nil).GetDataExport), varargs...)
+}
+
+// GetExercise mocks base method.
+func (m *Mockmethods) GetExercise(ctx context.Context, opts ...GetExerciseOpt) (*orm.Exercise, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetExercise", varargs...)
+	ret0, _ := ret[0].(*orm.Exercise)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExercise indicates an expected call of GetExercise.
+func (mr *MockmethodsMockRecorder) GetExercise(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExercise", reflect.TypeOf((*Mockmethods)(nil).GetExercise), varargs...)
+}
+
+// GetExerciseProgress mocks base method.
+func (m *Mockmethods) GetExerciseProgress(ctx context.Context, p GetExerciseProgressParams) ([]ExerciseProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExerciseProgress", ctx, p)
+	ret0, _ := ret[0].([]ExerciseProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExerciseProgress indicates an expected call of GetExerciseProgress.
+func (mr *MockmethodsMockRecorder) GetExerciseProgress(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExerciseProgress", reflect.TypeOf((*Mockmethods)(nil).GetExerciseProgress), ctx, p)
+}
+
+// GetLeaderboard mocks base method.
+func (m *Mockmethods) GetLeaderboard(ctx context.Context, p GetLeaderboardParams) ([]LeaderboardEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderboard", ctx, p)
+	ret0, _ := ret[0].([]LeaderboardEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderboard indicates an expected call of GetLeaderboard.
+func (mr *MockmethodsMockRecorder) GetLeaderboard(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboard", reflect.TypeOf((*Mockmethods)(nil).GetLeaderboard), ctx, p)
+}
+
+// GetPersonalBests mocks base method.
+func (m *Mockmethods) GetPersonalBests(ctx context.Context, userIDs ...string) (orm.SetSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range userIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPersonalBests", varargs...)
+	ret0, _ := ret[0].(orm.SetSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalBests indicates an expected call of GetPersonalBests.
+func (mr *MockmethodsMockRecorder) GetPersonalBests(ctx any, userIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, userIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalBests", reflect.TypeOf((*Mockmethods)(nil).GetPersonalBests), varargs...)
+}
+
+// GetPreviousWorkoutSets mocks base method.
+func (m *Mockmethods) GetPreviousWorkoutSets(ctx context.Context, exerciseIDs []string) (orm.SetSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreviousWorkoutSets", ctx, exerciseIDs)
+	ret0, _ := ret[0].(orm.SetSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreviousWorkoutSets indicates an expected call of GetPreviousWorkoutSets.
+func (mr *MockmethodsMockRecorder) GetPreviousWorkoutSets(ctx, exerciseIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviousWorkoutSets", reflect.TypeOf((*Mockmethods)(nil).GetPreviousWorkoutSets), ctx, exerciseIDs)
+}
+
+// GetReport mocks base method.
+func (m *Mockmethods) GetReport(ctx context.Context, opts ...GetReportOpt) (*orm.Report, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReport", varargs...)
+	ret0, _ := ret[0].(*orm.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockmethodsMockRecorder) GetReport(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*Mockmethods)(nil).GetReport), varargs...)
+}
+
+// GetRoutine mocks base method.
+func (m *Mockmethods) GetRoutine(ctx context.Context, opts ...GetRoutineOpt) (*orm.Routine, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRoutine", varargs...)
+	ret0, _ := ret[0].(*orm.Routine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoutine indicates an expected call of GetRoutine.
+func (mr *MockmethodsMockRecorder) GetRoutine(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutine", reflect.TypeOf((*Mockmethods)(nil).GetRoutine), varargs...)
+}
+
+// GetUser mocks base method.
+func (m *Mockmethods) GetUser(ctx context.Context, opts ...GetUserOpt) (*orm.User, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUser", varargs...)
+	ret0, _ := ret[0].(*orm.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockmethodsMockRecorder) GetUser(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*Mockmethods)(nil).GetUser), varargs...)
+}
+
+// GetVisiblePersonalBests mocks base method.
+func (m *Mockmethods) GetVisiblePersonalBests(ctx context.Context, viewerID string, userIDs ...string) (orm.SetSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, viewerID}
+	for _, a := range userIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVisiblePersonalBests", varargs...)
+	ret0, _ := ret[0].(orm.SetSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVisiblePersonalBests indicates an expected call of GetVisiblePersonalBests.
+func (mr *MockmethodsMockRecorder) GetVisiblePersonalBests(ctx, viewerID any, userIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, viewerID}, userIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisiblePersonalBests", reflect.TypeOf((*Mockmethods)(nil).GetVisiblePersonalBests), varargs...)
+}
+
+// GetWorkout mocks base method.
+func (m *Mockmethods) GetWorkout(ctx context.Context, opts ...GetWorkoutOpt) (*orm.Workout, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkout", varargs...)
+	ret0, _ := ret[0].(*orm.Workout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkout indicates an expected call of GetWorkout.
+func (mr *MockmethodsMockRecorder) GetWorkout(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkout", reflect.TypeOf((*Mockmethods)(nil).GetWorkout), varargs...)
+}
+
+// GetWorkoutComment mocks base method.
+func (m *Mockmethods) GetWorkoutComment(ctx context.Context, opts ...GetWorkoutCommentOpt) (*orm.WorkoutComment, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkoutComment", varargs...)
+	ret0, _ := ret[0].(*orm.WorkoutComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkoutComment indicates an expected call of GetWorkoutComment.
+func (mr *MockmethodsMockRecorder) GetWorkoutComment(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkoutComment", reflect.TypeOf((*Mockmethods)(nil).GetWorkoutComment), varargs...)
+}
+
+// GetWorkoutReaction mocks base method.
+func (m *Mockmethods) GetWorkoutReaction(ctx context.Context, opts ...GetWorkoutReactionOpt) (*orm.WorkoutReaction, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWorkoutReaction", varargs...)
+	ret0, _ := ret[0].(*orm.WorkoutReaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkoutReaction indicates an expected call of GetWorkoutReaction.
+func (mr *MockmethodsMockRecorder) GetWorkoutReaction(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkoutReaction", reflect.TypeOf((*Mockmethods)(nil).GetWorkoutReaction), varargs...)
+}
+
+// GetYearSummary mocks base method.
+func (m *Mockmethods) GetYearSummary(ctx context.Context, opts ...GetYearSummaryOpt) (*orm.YearSummary, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetYearSummary", varargs...)
+	ret0, _ := ret[0].(*orm.YearSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetYearSummary indicates an expected call of GetYearSummary.
+func (mr *MockmethodsMockRecorder) GetYearSummary(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearSummary", reflect.TypeOf((*Mockmethods)(nil).GetYearSummary), varargs...)
+}
+
+// GrantCoachAccess mocks base method.
+func (m *Mockmethods) GrantCoachAccess(ctx context.Context, p CoachingParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantCoachAccess", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantCoachAccess indicates an expected call of GrantCoachAccess.
+func (mr *MockmethodsMockRecorder) GrantCoachAccess(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantCoachAccess", reflect.TypeOf((*Mockmethods)(nil).GrantCoachAccess), ctx, p)
+}
+
+// HideWorkout mocks base method.
+func (m *Mockmethods) HideWorkout(ctx context.Context, workoutID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideWorkout", ctx, workoutID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HideWorkout indicates an expected call of HideWorkout.
+func (mr *MockmethodsMockRecorder) HideWorkout(ctx, workoutID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideWorkout", reflect.TypeOf((*Mockmethods)(nil).HideWorkout), ctx, workoutID)
+}
+
+// HideWorkoutComment mocks base method.
+func (m *Mockmethods) HideWorkoutComment(ctx context.Context, commentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideWorkoutComment", ctx, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HideWorkoutComment indicates an expected call of HideWorkoutComment.
+func (mr *MockmethodsMockRecorder) HideWorkoutComment(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideWorkoutComment", reflect.TypeOf((*Mockmethods)(nil).HideWorkoutComment), ctx, commentID)
+}
+
+// InviteToChallenge mocks base method.
+func (m *Mockmethods) InviteToChallenge(ctx context.Context, challengeID string, userIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteToChallenge", ctx, challengeID, userIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteToChallenge indicates an expected call of InviteToChallenge.
+func (mr *MockmethodsMockRecorder) InviteToChallenge(ctx, challengeID, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteToChallenge", reflect.TypeOf((*Mockmethods)(nil).InviteToChallenge), ctx, challengeID, userIDs)
+}
+
+// IsBlocked mocks base method.
+func (m *Mockmethods) IsBlocked(ctx context.Context, userID string, otherUserIDs ...string) (bool, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range otherUserIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IsBlocked", varargs...)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockmethodsMockRecorder) IsBlocked(ctx, userID any, otherUserIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, otherUserIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*Mockmethods)(nil).IsBlocked), varargs...)
+}
+
+// IsCoach mocks base method.
+func (m *Mockmethods) IsCoach(ctx context.Context, p CoachingParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCoach", ctx, p)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsCoach indicates an expected call of IsCoach.
+func (mr *MockmethodsMockRecorder) IsCoach(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCoach", reflect.TypeOf((*Mockmethods)(nil).IsCoach), ctx, p)
+}
+
+// IsUserFollowedByUserID mocks base method.
 func (m *Mockmethods) IsUserFollowedByUserID(ctx context.Context, user *orm.User, userID string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsUserFollowedByUserID", ctx, user, userID)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "IsUserFollowedByUserID", ctx, user, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserFollowedByUserID indicates an expected call of IsUserFollowedByUserID.
+func (mr *MockmethodsMockRecorder) IsUserFollowedByUserID(ctx, user, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserFollowedByUserID", reflect.TypeOf((*Mockmethods)(nil).IsUserFollowedByUserID), ctx, user, userID)
+}
+
+// JoinChallenge mocks base method.
+func (m *Mockmethods) JoinChallenge(ctx context.Context, challengeID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinChallenge", ctx, challengeID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JoinChallenge indicates an expected call of JoinChallenge.
+func (mr *MockmethodsMockRecorder) JoinChallenge(ctx, challengeID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChallenge", reflect.TypeOf((*Mockmethods)(nil).JoinChallenge), ctx, challengeID, userID)
+}
+
+// LeaveChallenge mocks base method.
+func (m *Mockmethods) LeaveChallenge(ctx context.Context, challengeID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveChallenge", ctx, challengeID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveChallenge indicates an expected call of LeaveChallenge.
+func (mr *MockmethodsMockRecorder) LeaveChallenge(ctx, challengeID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveChallenge", reflect.TypeOf((*Mockmethods)(nil).LeaveChallenge), ctx, challengeID, userID)
+}
+
+// ListAchievements mocks base method.
+func (m *Mockmethods) ListAchievements(ctx context.Context, opts ...ListAchievementsOpt) (orm.AchievementSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAchievements", varargs...)
+	ret0, _ := ret[0].(orm.AchievementSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAchievements indicates an expected call of ListAchievements.
+func (mr *MockmethodsMockRecorder) ListAchievements(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAchievements", reflect.TypeOf((*Mockmethods)(nil).ListAchievements), varargs...)
+}
+
+// ListAthleteSummaries mocks base method.
+func (m *Mockmethods) ListAthleteSummaries(ctx context.Context, coachID string, now time.Time) ([]AthleteSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAthleteSummaries", ctx, coachID, now)
+	ret0, _ := ret[0].([]AthleteSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAthleteSummaries indicates an expected call of ListAthleteSummaries.
+func (mr *MockmethodsMockRecorder) ListAthleteSummaries(ctx, coachID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAthleteSummaries", reflect.TypeOf((*Mockmethods)(nil).ListAthleteSummaries), ctx, coachID, now)
+}
+
+// ListBlockedUsers mocks base method.
+func (m *Mockmethods) ListBlockedUsers(ctx context.Context, userID string) (orm.UserSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlockedUsers", ctx, userID)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlockedUsers indicates an expected call of ListBlockedUsers.
+func (mr *MockmethodsMockRecorder) ListBlockedUsers(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlockedUsers", reflect.TypeOf((*Mockmethods)(nil).ListBlockedUsers), ctx, userID)
+}
+
+// ListChallenges mocks base method.
+func (m *Mockmethods) ListChallenges(ctx context.Context, opts ...ListChallengesOpt) (orm.ChallengeSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListChallenges", varargs...)
+	ret0, _ := ret[0].(orm.ChallengeSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChallenges indicates an expected call of ListChallenges.
+func (mr *MockmethodsMockRecorder) ListChallenges(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChallenges", reflect.TypeOf((*Mockmethods)(nil).ListChallenges), varargs...)
+}
+
+// ListClubMembers mocks base method.
+func (m *Mockmethods) ListClubMembers(ctx context.Context, opts ...ListClubMembersOpt) (orm.ClubMemberSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClubMembers", varargs...)
+	ret0, _ := ret[0].(orm.ClubMemberSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClubMembers indicates an expected call of ListClubMembers.
+func (mr *MockmethodsMockRecorder) ListClubMembers(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClubMembers", reflect.TypeOf((*Mockmethods)(nil).ListClubMembers), varargs...)
+}
+
+// ListClubs mocks base method.
+func (m *Mockmethods) ListClubs(ctx context.Context, opts ...ListClubsOpt) (orm.ClubSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClubs", varargs...)
+	ret0, _ := ret[0].(orm.ClubSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClubs indicates an expected call of ListClubs.
+func (mr *MockmethodsMockRecorder) ListClubs(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClubs", reflect.TypeOf((*Mockmethods)(nil).ListClubs), varargs...)
+}
+
+// ListCoachActions mocks base method.
+func (m *Mockmethods) ListCoachActions(ctx context.Context, opts ...ListCoachActionsOpt) (orm.CoachActionSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCoachActions", varargs...)
+	ret0, _ := ret[0].(orm.CoachActionSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCoachActions indicates an expected call of ListCoachActions.
+func (mr *MockmethodsMockRecorder) ListCoachActions(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCoachActions", reflect.TypeOf((*Mockmethods)(nil).ListCoachActions), varargs...)
+}
+
+// ListCoaches mocks base method.
+func (m *Mockmethods) ListCoaches(ctx context.Context, athleteID string) (orm.UserSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCoaches", ctx, athleteID)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCoaches indicates an expected call of ListCoaches.
+func (mr *MockmethodsMockRecorder) ListCoaches(ctx, athleteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCoaches", reflect.TypeOf((*Mockmethods)(nil).ListCoaches), ctx, athleteID)
+}
+
+// ListExercises mocks base method.
+func (m *Mockmethods) ListExercises(ctx context.Context, opts ...ListExercisesOpt) (orm.ExerciseSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListExercises", varargs...)
+	ret0, _ := ret[0].(orm.ExerciseSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExercises indicates an expected call of ListExercises.
+func (mr *MockmethodsMockRecorder) ListExercises(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExercises", reflect.TypeOf((*Mockmethods)(nil).ListExercises), varargs...)
+}
+
+// ListFollowRequests mocks base method.
+func (m *Mockmethods) ListFollowRequests(ctx context.Context, opts ...ListFollowRequestsOpt) (orm.FollowRequestSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollowRequests", varargs...)
+	ret0, _ := ret[0].(orm.FollowRequestSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollowRequests indicates an expected call of ListFollowRequests.
+func (mr *MockmethodsMockRecorder) ListFollowRequests(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowRequests", reflect.TypeOf((*Mockmethods)(nil).ListFollowRequests), varargs...)
+}
+
+// ListFollowees mocks base method.
+func (m *Mockmethods) ListFollowees(ctx context.Context, userID string, opts ...ListFolloweesOpt) (orm.UserSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollowees", varargs...)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollowees indicates an expected call of ListFollowees.
+func (mr *MockmethodsMockRecorder) ListFollowees(ctx, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowees", reflect.TypeOf((*Mockmethods)(nil).ListFollowees), varargs...)
+}
+
+// ListFollowers mocks base method.
+func (m *Mockmethods) ListFollowers(ctx context.Context, userID string, opts ...ListFollowersOpt) (orm.UserSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollowers", varargs...)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollowers indicates an expected call of ListFollowers.
+func (mr *MockmethodsMockRecorder) ListFollowers(ctx, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowers", reflect.TypeOf((*Mockmethods)(nil).ListFollowers), varargs...)
+}
+
+// ListFollows mocks base method.
+func (m *Mockmethods) ListFollows(ctx context.Context, opts ...ListFollowsOpt) (orm.FollowerSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollows", varargs...)
+	ret0, _ := ret[0].(orm.FollowerSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollows indicates an expected call of ListFollows.
+func (mr *MockmethodsMockRecorder) ListFollows(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollows", reflect.TypeOf((*Mockmethods)(nil).ListFollows), varargs...)
+}
+
+// ListNotifications mocks base method.
+func (m *Mockmethods) ListNotifications(ctx context.Context, opts ...ListNotificationsOpt) (orm.NotificationSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNotifications", varargs...)
+	ret0, _ := ret[0].(orm.NotificationSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotifications indicates an expected call of ListNotifications.
+func (mr *MockmethodsMockRecorder) ListNotifications(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*Mockmethods)(nil).ListNotifications), varargs...)
+}
+
+// ListPersonalRecords mocks base method.
+func (m *Mockmethods) ListPersonalRecords(ctx context.Context, opts ...ListPersonalRecordsOpt) (orm.PersonalRecordSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPersonalRecords", varargs...)
+	ret0, _ := ret[0].(orm.PersonalRecordSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPersonalRecords indicates an expected call of ListPersonalRecords.
+func (mr *MockmethodsMockRecorder) ListPersonalRecords(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPersonalRecords", reflect.TypeOf((*Mockmethods)(nil).ListPersonalRecords), varargs...)
+}
+
+// ListReports mocks base method.
+func (m *Mockmethods) ListReports(ctx context.Context, opts ...ListReportsOpt) (orm.ReportSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListReports", varargs...)
+	ret0, _ := ret[0].(orm.ReportSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReports indicates an expected call of ListReports.
+func (mr *MockmethodsMockRecorder) ListReports(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReports", reflect.TypeOf((*Mockmethods)(nil).ListReports), varargs...)
+}
+
+// ListRoutines mocks base method.
+func (m *Mockmethods) ListRoutines(ctx context.Context, opts ...ListRoutineOpt) (orm.RoutineSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoutines", varargs...)
+	ret0, _ := ret[0].(orm.RoutineSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoutines indicates an expected call of ListRoutines.
+func (mr *MockmethodsMockRecorder) ListRoutines(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutines", reflect.TypeOf((*Mockmethods)(nil).ListRoutines), varargs...)
+}
+
+// ListSets mocks base method.
+func (m *Mockmethods) ListSets(ctx context.Context, opts ...ListSetsOpt) (orm.SetSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSets", varargs...)
+	ret0, _ := ret[0].(orm.SetSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSets indicates an expected call of ListSets.
+func (mr *MockmethodsMockRecorder) ListSets(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSets", reflect.TypeOf((*Mockmethods)(nil).ListSets), varargs...)
+}
+
+// ListUsers mocks base method.
+func (m *Mockmethods) ListUsers(ctx context.Context, opts ...ListUsersOpt) (orm.UserSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsers", varargs...)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockmethodsMockRecorder) ListUsers(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*Mockmethods)(nil).ListUsers), varargs...)
+}
+
+// ListWorkoutComments mocks base method.
+func (m *Mockmethods) ListWorkoutComments(ctx context.Context, opts ...ListWorkoutCommentsOpt) (orm.WorkoutCommentSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkoutComments", varargs...)
+	ret0, _ := ret[0].(orm.WorkoutCommentSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkoutComments indicates an expected call of ListWorkoutComments.
+func (mr *MockmethodsMockRecorder) ListWorkoutComments(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkoutComments", reflect.TypeOf((*Mockmethods)(nil).ListWorkoutComments), varargs...)
+}
+
+// ListWorkoutReactions mocks base method.
+func (m *Mockmethods) ListWorkoutReactions(ctx context.Context, opts ...ListWorkoutReactionsOpt) (orm.WorkoutReactionSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkoutReactions", varargs...)
+	ret0, _ := ret[0].(orm.WorkoutReactionSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkoutReactions indicates an expected call of ListWorkoutReactions.
+func (mr *MockmethodsMockRecorder) ListWorkoutReactions(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkoutReactions", reflect.TypeOf((*Mockmethods)(nil).ListWorkoutReactions), varargs...)
+}
+
+// ListWorkouts mocks base method.
+func (m *Mockmethods) ListWorkouts(ctx context.Context, opts ...ListWorkoutsOpt) (orm.WorkoutSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkouts", varargs...)
+	ret0, _ := ret[0].(orm.WorkoutSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkouts indicates an expected call of ListWorkouts.
+func (mr *MockmethodsMockRecorder) ListWorkouts(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkouts", reflect.TypeOf((*Mockmethods)(nil).ListWorkouts), varargs...)
+}
+
+// ListYearSummaries mocks base method.
+func (m *Mockmethods) ListYearSummaries(ctx context.Context, opts ...ListYearSummariesOpt) (orm.YearSummarySlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListYearSummaries", varargs...)
+	ret0, _ := ret[0].(orm.YearSummarySlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListYearSummaries indicates an expected call of ListYearSummaries.
+func (mr *MockmethodsMockRecorder) ListYearSummaries(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListYearSummaries", reflect.TypeOf((*Mockmethods)(nil).ListYearSummaries), varargs...)
+}
+
+// ListYearSummaryCandidates mocks base method.
+func (m *Mockmethods) ListYearSummaryCandidates(ctx context.Context, year int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListYearSummaryCandidates", ctx, year)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListYearSummaryCandidates indicates an expected call of ListYearSummaryCandidates.
+func (mr *MockmethodsMockRecorder) ListYearSummaryCandidates(ctx, year any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListYearSummaryCandidates", reflect.TypeOf((*Mockmethods)(nil).ListYearSummaryCandidates), ctx, year)
+}
+
+// MarkNotificationsAsRead mocks base method.
+func (m *Mockmethods) MarkNotificationsAsRead(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsAsRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationsAsRead indicates an expected call of MarkNotificationsAsRead.
+func (mr *MockmethodsMockRecorder) MarkNotificationsAsRead(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsAsRead", reflect.TypeOf((*Mockmethods)(nil).MarkNotificationsAsRead), ctx, userID)
+}
+
+// MergeExercises mocks base method.
+func (m *Mockmethods) MergeExercises(ctx context.Context, p MergeExercisesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeExercises", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeExercises indicates an expected call of MergeExercises.
+func (mr *MockmethodsMockRecorder) MergeExercises(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeExercises", reflect.TypeOf((*Mockmethods)(nil).MergeExercises), ctx, p)
+}
+
+// PostCreateWorkoutCommentLoadUser mocks base method.
+func (m *Mockmethods) PostCreateWorkoutCommentLoadUser(ctx context.Context) CreateWorkoutCommentOpts {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostCreateWorkoutCommentLoadUser", ctx)
+	ret0, _ := ret[0].(CreateWorkoutCommentOpts)
+	return ret0
+}
+
+// PostCreateWorkoutCommentLoadUser indicates an expected call of PostCreateWorkoutCommentLoadUser.
+func (mr *MockmethodsMockRecorder) PostCreateWorkoutCommentLoadUser(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostCreateWorkoutCommentLoadUser", reflect.TypeOf((*Mockmethods)(nil).PostCreateWorkoutCommentLoadUser), ctx)
+}
+
+// PublishEvent mocks base method.
+func (m *Mockmethods) PublishEvent(ctx context.Context, topic orm.EventTopic, payload []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishEvent", ctx, topic, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishEvent indicates an expected call of PublishEvent.
+func (mr *MockmethodsMockRecorder) PublishEvent(ctx, topic, payload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvent", reflect.TypeOf((*Mockmethods)(nil).PublishEvent), ctx, topic, payload)
+}
+
+// PurgeDataExports mocks base method.
+func (m *Mockmethods) PurgeDataExports(ctx context.Context, createdBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDataExports", ctx, createdBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDataExports indicates an expected call of PurgeDataExports.
+func (mr *MockmethodsMockRecorder) PurgeDataExports(ctx, createdBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDataExports", reflect.TypeOf((*Mockmethods)(nil).PurgeDataExports), ctx, createdBefore)
+}
+
+// PurgeExercises mocks base method.
+func (m *Mockmethods) PurgeExercises(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExercises", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExercises indicates an expected call of PurgeExercises.
+func (mr *MockmethodsMockRecorder) PurgeExercises(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExercises", reflect.TypeOf((*Mockmethods)(nil).PurgeExercises), ctx, deletedBefore)
+}
+
+// PurgeRoutines mocks base method.
+func (m *Mockmethods) PurgeRoutines(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeRoutines", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeRoutines indicates an expected call of PurgeRoutines.
+func (mr *MockmethodsMockRecorder) PurgeRoutines(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeRoutines", reflect.TypeOf((*Mockmethods)(nil).PurgeRoutines), ctx, deletedBefore)
+}
+
+// PurgeWorkouts mocks base method.
+func (m *Mockmethods) PurgeWorkouts(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeWorkouts", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeWorkouts indicates an expected call of PurgeWorkouts.
+func (mr *MockmethodsMockRecorder) PurgeWorkouts(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeWorkouts", reflect.TypeOf((*Mockmethods)(nil).PurgeWorkouts), ctx, deletedBefore)
+}
+
+// RefreshTokenExists mocks base method.
+func (m *Mockmethods) RefreshTokenExists(ctx context.Context, refreshToken string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTokenExists", ctx, refreshToken)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTokenExists indicates an expected call of RefreshTokenExists.
+func (mr *MockmethodsMockRecorder) RefreshTokenExists(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokenExists", reflect.TypeOf((*Mockmethods)(nil).RefreshTokenExists), ctx, refreshToken)
+}
+
+// RemoveExerciseFromRoutine mocks base method.
+func (m *Mockmethods) RemoveExerciseFromRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveExerciseFromRoutine", ctx, exercise, routine)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveExerciseFromRoutine indicates an expected call of RemoveExerciseFromRoutine.
+func (mr *MockmethodsMockRecorder) RemoveExerciseFromRoutine(ctx, exercise, routine any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExerciseFromRoutine", reflect.TypeOf((*Mockmethods)(nil).RemoveExerciseFromRoutine), ctx, exercise, routine)
+}
+
+// ResolveReport mocks base method.
+func (m *Mockmethods) ResolveReport(ctx context.Context, p ResolveReportParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReport", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveReport indicates an expected call of ResolveReport.
+func (mr *MockmethodsMockRecorder) ResolveReport(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReport", reflect.TypeOf((*Mockmethods)(nil).ResolveReport), ctx, p)
+}
+
+// RestoreExercise mocks base method.
+func (m *Mockmethods) RestoreExercise(ctx context.Context, p RestoreExerciseParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreExercise", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreExercise indicates an expected call of RestoreExercise.
+func (mr *MockmethodsMockRecorder) RestoreExercise(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreExercise", reflect.TypeOf((*Mockmethods)(nil).RestoreExercise), ctx, p)
+}
+
+// RestoreRoutine mocks base method.
+func (m *Mockmethods) RestoreRoutine(ctx context.Context, routineID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRoutine", ctx, routineID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreRoutine indicates an expected call of RestoreRoutine.
+func (mr *MockmethodsMockRecorder) RestoreRoutine(ctx, routineID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRoutine", reflect.TypeOf((*Mockmethods)(nil).RestoreRoutine), ctx, routineID)
+}
+
+// RestoreWorkout mocks base method.
+func (m *Mockmethods) RestoreWorkout(ctx context.Context, workoutID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreWorkout", ctx, workoutID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreWorkout indicates an expected call of RestoreWorkout.
+func (mr *MockmethodsMockRecorder) RestoreWorkout(ctx, workoutID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkout", reflect.TypeOf((*Mockmethods)(nil).RestoreWorkout), ctx, workoutID)
+}
+
+// RevokeCoachAccess mocks base method.
+func (m *Mockmethods) RevokeCoachAccess(ctx context.Context, p CoachingParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCoachAccess", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeCoachAccess indicates an expected call of RevokeCoachAccess.
+func (mr *MockmethodsMockRecorder) RevokeCoachAccess(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCoachAccess", reflect.TypeOf((*Mockmethods)(nil).RevokeCoachAccess), ctx, p)
+}
+
+// SetRoutineExercises mocks base method.
+func (m *Mockmethods) SetRoutineExercises(ctx context.Context, routine *orm.Routine, exercises orm.ExerciseSlice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoutineExercises", ctx, routine, exercises)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRoutineExercises indicates an expected call of SetRoutineExercises.
+func (mr *MockmethodsMockRecorder) SetRoutineExercises(ctx, routine, exercises any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoutineExercises", reflect.TypeOf((*Mockmethods)(nil).SetRoutineExercises), ctx, routine, exercises)
+}
+
+// SoftDeleteExercise mocks base method.
+func (m *Mockmethods) SoftDeleteExercise(ctx context.Context, p SoftDeleteExerciseParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDeleteExercise", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDeleteExercise indicates an expected call of SoftDeleteExercise.
+func (mr *MockmethodsMockRecorder) SoftDeleteExercise(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteExercise", reflect.TypeOf((*Mockmethods)(nil).SoftDeleteExercise), ctx, p)
+}
+
+// StoreTrace mocks base method.
+func (m *Mockmethods) StoreTrace(ctx context.Context, p StoreTraceParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreTrace", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreTrace indicates an expected call of StoreTrace.
+func (mr *MockmethodsMockRecorder) StoreTrace(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreTrace", reflect.TypeOf((*Mockmethods)(nil).StoreTrace), ctx, p)
+}
+
+// SuspendUser mocks base method.
+func (m *Mockmethods) SuspendUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuspendUser indicates an expected call of SuspendUser.
+func (mr *MockmethodsMockRecorder) SuspendUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUser", reflect.TypeOf((*Mockmethods)(nil).SuspendUser), ctx, userID)
+}
+
+// TransferClubOwnership mocks base method.
+func (m *Mockmethods) TransferClubOwnership(ctx context.Context, p TransferClubOwnershipParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferClubOwnership", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferClubOwnership indicates an expected call of TransferClubOwnership.
+func (mr *MockmethodsMockRecorder) TransferClubOwnership(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferClubOwnership", reflect.TypeOf((*Mockmethods)(nil).TransferClubOwnership), ctx, p)
+}
+
+// UnblockUser mocks base method.
+func (m *Mockmethods) UnblockUser(ctx context.Context, p BlockParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockUser", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockmethodsMockRecorder) UnblockUser(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*Mockmethods)(nil).UnblockUser), ctx, p)
+}
+
+// Unfollow mocks base method.
+func (m *Mockmethods) Unfollow(ctx context.Context, p UnfollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfollow indicates an expected call of Unfollow.
+func (mr *MockmethodsMockRecorder) Unfollow(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*Mockmethods)(nil).Unfollow), ctx, p)
+}
+
+// UpdateAuth mocks base method.
+func (m *Mockmethods) UpdateAuth(ctx context.Context, authID string, opts ...UpdateAuthOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, authID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAuth", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAuth indicates an expected call of UpdateAuth.
+func (mr *MockmethodsMockRecorder) UpdateAuth(ctx, authID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, authID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuth", reflect.TypeOf((*Mockmethods)(nil).UpdateAuth), varargs...)
+}
+
+// UpdateChallenge mocks base method.
+func (m *Mockmethods) UpdateChallenge(ctx context.Context, challengeID string, opts ...UpdateChallengeOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, challengeID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateChallenge", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChallenge indicates an expected call of UpdateChallenge.
+func (mr *MockmethodsMockRecorder) UpdateChallenge(ctx, challengeID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, challengeID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChallenge", reflect.TypeOf((*Mockmethods)(nil).UpdateChallenge), varargs...)
+}
+
+// UpdateClubMember mocks base method.
+func (m *Mockmethods) UpdateClubMember(ctx context.Context, clubID, userID string, opts ...UpdateClubMemberOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, clubID, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateClubMember", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClubMember indicates an expected call of UpdateClubMember.
+func (mr *MockmethodsMockRecorder) UpdateClubMember(ctx, clubID, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, clubID, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClubMember", reflect.TypeOf((*Mockmethods)(nil).UpdateClubMember), varargs...)
+}
+
+// UpdateExercise mocks base method.
+func (m *Mockmethods) UpdateExercise(ctx context.Context, exerciseID string, opts ...UpdateExerciseOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, exerciseID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateExercise", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateExercise indicates an expected call of UpdateExercise.
+func (mr *MockmethodsMockRecorder) UpdateExercise(ctx, exerciseID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, exerciseID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExercise", reflect.TypeOf((*Mockmethods)(nil).UpdateExercise), varargs...)
+}
+
+// UpdateGroupedNotification mocks base method.
+func (m *Mockmethods) UpdateGroupedNotification(ctx context.Context, p CreateNotificationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroupedNotification", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGroupedNotification indicates an expected call of UpdateGroupedNotification.
+func (mr *MockmethodsMockRecorder) UpdateGroupedNotification(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupedNotification", reflect.TypeOf((*Mockmethods)(nil).UpdateGroupedNotification), ctx, p)
+}
+
+// UpdateRoutine mocks base method.
+func (m *Mockmethods) UpdateRoutine(ctx context.Context, routineID string, opts ...UpdateRoutineOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, routineID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRoutine", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRoutine indicates an expected call of UpdateRoutine.
+func (mr *MockmethodsMockRecorder) UpdateRoutine(ctx, routineID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, routineID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoutine", reflect.TypeOf((*Mockmethods)(nil).UpdateRoutine), varargs...)
+}
+
+// UpdateUser mocks base method.
+func (m *Mockmethods) UpdateUser(ctx context.Context, userID string, opts ...UpdateUserOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUser", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockmethodsMockRecorder) UpdateUser(ctx, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*Mockmethods)(nil).UpdateUser), varargs...)
+}
+
+// UpdateWorkout mocks base method.
+func (m *Mockmethods) UpdateWorkout(ctx context.Context, workoutID string, opts ...UpdateWorkoutOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, workoutID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkout", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkout indicates an expected call of UpdateWorkout.
+func (mr *MockmethodsMockRecorder) UpdateWorkout(ctx, workoutID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, workoutID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkout", reflect.TypeOf((*Mockmethods)(nil).UpdateWorkout), varargs...)
+}
+
+// UpdateWorkoutComment mocks base method.
+func (m *Mockmethods) UpdateWorkoutComment(ctx context.Context, commentID string, opts ...UpdateWorkoutCommentOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, commentID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkoutComment", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkoutComment indicates an expected call of UpdateWorkoutComment.
+func (mr *MockmethodsMockRecorder) UpdateWorkoutComment(ctx, commentID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, commentID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkoutComment", reflect.TypeOf((*Mockmethods)(nil).UpdateWorkoutComment), varargs...)
+}
+
+// UpdateWorkoutSets mocks base method.
+func (m *Mockmethods) UpdateWorkoutSets(ctx context.Context, p UpdateWorkoutSetsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkoutSets", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkoutSets indicates an expected call of UpdateWorkoutSets.
+func (mr *MockmethodsMockRecorder) UpdateWorkoutSets(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkoutSets", reflect.TypeOf((*Mockmethods)(nil).UpdateWorkoutSets), ctx, p)
+}
+
+// UpsertWorkoutReaction mocks base method.
+func (m *Mockmethods) UpsertWorkoutReaction(ctx context.Context, p UpsertWorkoutReactionParams) (*orm.WorkoutReaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertWorkoutReaction", ctx, p)
+	ret0, _ := ret[0].(*orm.WorkoutReaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertWorkoutReaction indicates an expected call of UpsertWorkoutReaction.
+func (mr *MockmethodsMockRecorder) UpsertWorkoutReaction(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkoutReaction", reflect.TypeOf((*Mockmethods)(nil).UpsertWorkoutReaction), ctx, p)
+}
+
+// MocksetMethods is a mock of setMethods interface.
+type MocksetMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MocksetMethodsMockRecorder
+	isgomock struct{}
+}
+
+// MocksetMethodsMockRecorder is the mock recorder for MocksetMethods.
+type MocksetMethodsMockRecorder struct {
+	mock *MocksetMethods
+}
+
+// NewMocksetMethods creates a new mock instance.
+func NewMocksetMethods(ctrl *gomock.Controller) *MocksetMethods {
+	mock := &MocksetMethods{ctrl: ctrl}
+	mock.recorder = &MocksetMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksetMethods) EXPECT() *MocksetMethodsMockRecorder {
+	return m.recorder
+}
+
+// GetExerciseProgress mocks base method.
+func (m *MocksetMethods) GetExerciseProgress(ctx context.Context, p GetExerciseProgressParams) ([]ExerciseProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExerciseProgress", ctx, p)
+	ret0, _ := ret[0].([]ExerciseProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExerciseProgress indicates an expected call of GetExerciseProgress.
+func (mr *MocksetMethodsMockRecorder) GetExerciseProgress(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExerciseProgress", reflect.TypeOf((*MocksetMethods)(nil).GetExerciseProgress), ctx, p)
+}
+
+// GetLeaderboard mocks base method.
+func (m *MocksetMethods) GetLeaderboard(ctx context.Context, p GetLeaderboardParams) ([]LeaderboardEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderboard", ctx, p)
+	ret0, _ := ret[0].([]LeaderboardEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderboard indicates an expected call of GetLeaderboard.
+func (mr *MocksetMethodsMockRecorder) GetLeaderboard(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboard", reflect.TypeOf((*MocksetMethods)(nil).GetLeaderboard), ctx, p)
+}
+
+// GetPersonalBests mocks base method.
+func (m *MocksetMethods) GetPersonalBests(ctx context.Context, userIDs ...string) (orm.SetSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range userIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPersonalBests", varargs...)
+	ret0, _ := ret[0].(orm.SetSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalBests indicates an expected call of GetPersonalBests.
+func (mr *MocksetMethodsMockRecorder) GetPersonalBests(ctx any, userIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, userIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalBests", reflect.TypeOf((*MocksetMethods)(nil).GetPersonalBests), varargs...)
+}
+
+// GetPreviousWorkoutSets mocks base method.
+func (m *MocksetMethods) GetPreviousWorkoutSets(ctx context.Context, exerciseIDs []string) (orm.SetSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreviousWorkoutSets", ctx, exerciseIDs)
+	ret0, _ := ret[0].(orm.SetSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreviousWorkoutSets indicates an expected call of GetPreviousWorkoutSets.
+func (mr *MocksetMethodsMockRecorder) GetPreviousWorkoutSets(ctx, exerciseIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviousWorkoutSets", reflect.TypeOf((*MocksetMethods)(nil).GetPreviousWorkoutSets), ctx, exerciseIDs)
+}
+
+// GetVisiblePersonalBests mocks base method.
+func (m *MocksetMethods) GetVisiblePersonalBests(ctx context.Context, viewerID string, userIDs ...string) (orm.SetSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, viewerID}
+	for _, a := range userIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVisiblePersonalBests", varargs...)
+	ret0, _ := ret[0].(orm.SetSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVisiblePersonalBests indicates an expected call of GetVisiblePersonalBests.
+func (mr *MocksetMethodsMockRecorder) GetVisiblePersonalBests(ctx, viewerID any, userIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, viewerID}, userIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisiblePersonalBests", reflect.TypeOf((*MocksetMethods)(nil).GetVisiblePersonalBests), varargs...)
+}
+
+// ListSets mocks base method.
+func (m *MocksetMethods) ListSets(ctx context.Context, opts ...ListSetsOpt) (orm.SetSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSets", varargs...)
+	ret0, _ := ret[0].(orm.SetSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSets indicates an expected call of ListSets.
+func (mr *MocksetMethodsMockRecorder) ListSets(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSets", reflect.TypeOf((*MocksetMethods)(nil).ListSets), varargs...)
+}
+
+// MockauthMethods is a mock of authMethods interface.
+type MockauthMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthMethodsMockRecorder
+	isgomock struct{}
+}
+
+// MockauthMethodsMockRecorder is the mock recorder for MockauthMethods.
+type MockauthMethodsMockRecorder struct {
+	mock *MockauthMethods
+}
+
+// NewMockauthMethods creates a new mock instance.
+func NewMockauthMethods(ctrl *gomock.Controller) *MockauthMethods {
+	mock := &MockauthMethods{ctrl: ctrl}
+	mock.recorder = &MockauthMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauthMethods) EXPECT() *MockauthMethodsMockRecorder {
+	return m.recorder
+}
+
+// CompareEmailAndPassword mocks base method.
+func (m *MockauthMethods) CompareEmailAndPassword(ctx context.Context, email, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareEmailAndPassword", ctx, email, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompareEmailAndPassword indicates an expected call of CompareEmailAndPassword.
+func (mr *MockauthMethodsMockRecorder) CompareEmailAndPassword(ctx, email, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareEmailAndPassword", reflect.TypeOf((*MockauthMethods)(nil).CompareEmailAndPassword), ctx, email, password)
+}
+
+// CreateAuth mocks base method.
+func (m *MockauthMethods) CreateAuth(ctx context.Context, email, password string) (*orm.Auth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuth", ctx, email, password)
+	ret0, _ := ret[0].(*orm.Auth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuth indicates an expected call of CreateAuth.
+func (mr *MockauthMethodsMockRecorder) CreateAuth(ctx, email, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuth", reflect.TypeOf((*MockauthMethods)(nil).CreateAuth), ctx, email, password)
+}
+
+// GetAuth mocks base method.
+func (m *MockauthMethods) GetAuth(ctx context.Context, opts ...GetAuthOpt) (*orm.Auth, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAuth", varargs...)
+	ret0, _ := ret[0].(*orm.Auth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuth indicates an expected call of GetAuth.
+func (mr *MockauthMethodsMockRecorder) GetAuth(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuth", reflect.TypeOf((*MockauthMethods)(nil).GetAuth), varargs...)
+}
+
+// RefreshTokenExists mocks base method.
+func (m *MockauthMethods) RefreshTokenExists(ctx context.Context, refreshToken string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTokenExists", ctx, refreshToken)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTokenExists indicates an expected call of RefreshTokenExists.
+func (mr *MockauthMethodsMockRecorder) RefreshTokenExists(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokenExists", reflect.TypeOf((*MockauthMethods)(nil).RefreshTokenExists), ctx, refreshToken)
+}
+
+// UpdateAuth mocks base method.
+func (m *MockauthMethods) UpdateAuth(ctx context.Context, authID string, opts ...UpdateAuthOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, authID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAuth", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAuth indicates an expected call of UpdateAuth.
+func (mr *MockauthMethodsMockRecorder) UpdateAuth(ctx, authID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, authID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuth", reflect.TypeOf((*MockauthMethods)(nil).UpdateAuth), varargs...)
+}
+
+// MockuserMethods is a mock of userMethods interface.
+type MockuserMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserMethodsMockRecorder
+	isgomock struct{}
+}
+
+// MockuserMethodsMockRecorder is the mock recorder for MockuserMethods.
+type MockuserMethodsMockRecorder struct {
+	mock *MockuserMethods
+}
+
+// NewMockuserMethods creates a new mock instance.
+func NewMockuserMethods(ctrl *gomock.Controller) *MockuserMethods {
+	mock := &MockuserMethods{ctrl: ctrl}
+	mock.recorder = &MockuserMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserMethods) EXPECT() *MockuserMethodsMockRecorder {
+	return m.recorder
+}
+
+// CanViewUser mocks base method.
+func (m *MockuserMethods) CanViewUser(ctx context.Context, viewerID, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanViewUser", ctx, viewerID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanViewUser indicates an expected call of CanViewUser.
+func (mr *MockuserMethodsMockRecorder) CanViewUser(ctx, viewerID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewUser", reflect.TypeOf((*MockuserMethods)(nil).CanViewUser), ctx, viewerID, userID)
+}
+
+// CreateBodyweight mocks base method.
+func (m *MockuserMethods) CreateBodyweight(ctx context.Context, userID string, bodyweight float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBodyweight", ctx, userID, bodyweight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBodyweight indicates an expected call of CreateBodyweight.
+func (mr *MockuserMethodsMockRecorder) CreateBodyweight(ctx, userID, bodyweight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBodyweight", reflect.TypeOf((*MockuserMethods)(nil).CreateBodyweight), ctx, userID, bodyweight)
+}
+
+// CreateUser mocks base method.
+func (m *MockuserMethods) CreateUser(ctx context.Context, p CreateUserParams) (*orm.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, p)
+	ret0, _ := ret[0].(*orm.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockuserMethodsMockRecorder) CreateUser(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockuserMethods)(nil).CreateUser), ctx, p)
+}
+
+// DeleteAccount mocks base method.
+func (m *MockuserMethods) DeleteAccount(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount.
+func (mr *MockuserMethodsMockRecorder) DeleteAccount(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockuserMethods)(nil).DeleteAccount), ctx, userID)
+}
+
+// Follow mocks base method.
+func (m *MockuserMethods) Follow(ctx context.Context, p FollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockuserMethodsMockRecorder) Follow(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockuserMethods)(nil).Follow), ctx, p)
+}
+
+// GetBodyweightAt mocks base method.
+func (m *MockuserMethods) GetBodyweightAt(ctx context.Context, userID string, at time.Time) (*orm.Bodyweight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBodyweightAt", ctx, userID, at)
+	ret0, _ := ret[0].(*orm.Bodyweight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBodyweightAt indicates an expected call of GetBodyweightAt.
+func (mr *MockuserMethodsMockRecorder) GetBodyweightAt(ctx, userID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBodyweightAt", reflect.TypeOf((*MockuserMethods)(nil).GetBodyweightAt), ctx, userID, at)
+}
+
+// GetUser mocks base method.
+func (m *MockuserMethods) GetUser(ctx context.Context, opts ...GetUserOpt) (*orm.User, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUser", varargs...)
+	ret0, _ := ret[0].(*orm.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockuserMethodsMockRecorder) GetUser(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockuserMethods)(nil).GetUser), varargs...)
+}
+
+// IsUserFollowedByUserID mocks base method.
+func (m *MockuserMethods) IsUserFollowedByUserID(ctx context.Context, user *orm.User, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserFollowedByUserID", ctx, user, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserFollowedByUserID indicates an expected call of IsUserFollowedByUserID.
+func (mr *MockuserMethodsMockRecorder) IsUserFollowedByUserID(ctx, user, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserFollowedByUserID", reflect.TypeOf((*MockuserMethods)(nil).IsUserFollowedByUserID), ctx, user, userID)
+}
+
+// ListFollowees mocks base method.
+func (m *MockuserMethods) ListFollowees(ctx context.Context, userID string, opts ...ListFolloweesOpt) (orm.UserSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollowees", varargs...)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollowees indicates an expected call of ListFollowees.
+func (mr *MockuserMethodsMockRecorder) ListFollowees(ctx, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowees", reflect.TypeOf((*MockuserMethods)(nil).ListFollowees), varargs...)
+}
+
+// ListFollowers mocks base method.
+func (m *MockuserMethods) ListFollowers(ctx context.Context, userID string, opts ...ListFollowersOpt) (orm.UserSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollowers", varargs...)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollowers indicates an expected call of ListFollowers.
+func (mr *MockuserMethodsMockRecorder) ListFollowers(ctx, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowers", reflect.TypeOf((*MockuserMethods)(nil).ListFollowers), varargs...)
+}
+
+// ListFollows mocks base method.
+func (m *MockuserMethods) ListFollows(ctx context.Context, opts ...ListFollowsOpt) (orm.FollowerSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollows", varargs...)
+	ret0, _ := ret[0].(orm.FollowerSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollows indicates an expected call of ListFollows.
+func (mr *MockuserMethodsMockRecorder) ListFollows(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollows", reflect.TypeOf((*MockuserMethods)(nil).ListFollows), varargs...)
+}
+
+// ListUsers mocks base method.
+func (m *MockuserMethods) ListUsers(ctx context.Context, opts ...ListUsersOpt) (orm.UserSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsers", varargs...)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockuserMethodsMockRecorder) ListUsers(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockuserMethods)(nil).ListUsers), varargs...)
+}
+
+// Unfollow mocks base method.
+func (m *MockuserMethods) Unfollow(ctx context.Context, p UnfollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfollow indicates an expected call of Unfollow.
+func (mr *MockuserMethodsMockRecorder) Unfollow(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockuserMethods)(nil).Unfollow), ctx, p)
+}
+
+// UpdateUser mocks base method.
+func (m *MockuserMethods) UpdateUser(ctx context.Context, userID string, opts ...UpdateUserOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateUser", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockuserMethodsMockRecorder) UpdateUser(ctx, userID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockuserMethods)(nil).UpdateUser), varargs...)
+}
+
+// MocktraceMethods is a mock of traceMethods interface.
+type MocktraceMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MocktraceMethodsMockRecorder
+	isgomock struct{}
+}
+
+// MocktraceMethodsMockRecorder is the mock recorder for MocktraceMethods.
+type MocktraceMethodsMockRecorder struct {
+	mock *MocktraceMethods
+}
+
+// NewMocktraceMethods creates a new mock instance.
+func NewMocktraceMethods(ctrl *gomock.Controller) *MocktraceMethods {
+	mock := &MocktraceMethods{ctrl: ctrl}
+	mock.recorder = &MocktraceMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktraceMethods) EXPECT() *MocktraceMethodsMockRecorder {
+	return m.recorder
+}
+
+// StoreTrace mocks base method.
+func (m *MocktraceMethods) StoreTrace(ctx context.Context, p StoreTraceParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreTrace", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreTrace indicates an expected call of StoreTrace.
+func (mr *MocktraceMethodsMockRecorder) StoreTrace(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreTrace", reflect.TypeOf((*MocktraceMethods)(nil).StoreTrace), ctx, p)
+}
+
+// MockroutineMethods is a mock of routineMethods interface.
+type MockroutineMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MockroutineMethodsMockRecorder
+	isgomock struct{}
+}
+
+// MockroutineMethodsMockRecorder is the mock recorder for MockroutineMethods.
+type MockroutineMethodsMockRecorder struct {
+	mock *MockroutineMethods
+}
+
+// NewMockroutineMethods creates a new mock instance.
+func NewMockroutineMethods(ctrl *gomock.Controller) *MockroutineMethods {
+	mock := &MockroutineMethods{ctrl: ctrl}
+	mock.recorder = &MockroutineMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockroutineMethods) EXPECT() *MockroutineMethodsMockRecorder {
+	return m.recorder
+}
+
+// AddExerciseToRoutine mocks base method.
+func (m *MockroutineMethods) AddExerciseToRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddExerciseToRoutine", ctx, exercise, routine)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddExerciseToRoutine indicates an expected call of AddExerciseToRoutine.
+func (mr *MockroutineMethodsMockRecorder) AddExerciseToRoutine(ctx, exercise, routine any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExerciseToRoutine", reflect.TypeOf((*MockroutineMethods)(nil).AddExerciseToRoutine), ctx, exercise, routine)
+}
+
+// CloneRoutine mocks base method.
+func (m *MockroutineMethods) CloneRoutine(ctx context.Context, p CloneRoutineParams) (*orm.Routine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneRoutine", ctx, p)
+	ret0, _ := ret[0].(*orm.Routine)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsUserFollowedByUserID indicates an expected call of IsUserFollowedByUserID.
-func (mr *MockmethodsMockRecorder) IsUserFollowedByUserID(ctx, user, userID any) *gomock.Call {
+// CloneRoutine indicates an expected call of CloneRoutine.
+func (mr *MockroutineMethodsMockRecorder) CloneRoutine(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserFollowedByUserID", reflect.TypeOf((*Mockmethods)(nil).IsUserFollowedByUserID), ctx, user, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneRoutine", reflect.TypeOf((*MockroutineMethods)(nil).CloneRoutine), ctx, p)
 }
 
-// ListExercises mocks base method.
-func (m *Mockmethods) ListExercises(ctx context.Context, opts ...ListExercisesOpt) (orm.ExerciseSlice, error) {
+// CreateRoutine mocks base method.
+func (m *MockroutineMethods) CreateRoutine(ctx context.Context, p CreateRoutineParams) (*orm.Routine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoutine", ctx, p)
+	ret0, _ := ret[0].(*orm.Routine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoutine indicates an expected call of CreateRoutine.
+func (mr *MockroutineMethodsMockRecorder) CreateRoutine(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoutine", reflect.TypeOf((*MockroutineMethods)(nil).CreateRoutine), ctx, p)
+}
+
+// DeleteRoutine mocks base method.
+func (m *MockroutineMethods) DeleteRoutine(ctx context.Context, routineID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoutine", ctx, routineID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRoutine indicates an expected call of DeleteRoutine.
+func (mr *MockroutineMethodsMockRecorder) DeleteRoutine(ctx, routineID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoutine", reflect.TypeOf((*MockroutineMethods)(nil).DeleteRoutine), ctx, routineID)
+}
+
+// GetRoutine mocks base method.
+func (m *MockroutineMethods) GetRoutine(ctx context.Context, opts ...GetRoutineOpt) (*orm.Routine, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListExercises", varargs...)
-	ret0, _ := ret[0].(orm.ExerciseSlice)
+	ret := m.ctrl.Call(m, "GetRoutine", varargs...)
+	ret0, _ := ret[0].(*orm.Routine)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListExercises indicates an expected call of ListExercises.
-func (mr *MockmethodsMockRecorder) ListExercises(ctx any, opts ...any) *gomock.Call {
+// GetRoutine indicates an expected call of GetRoutine.
+func (mr *MockroutineMethodsMockRecorder) GetRoutine(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExercises", reflect.TypeOf((*Mockmethods)(nil).ListExercises), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutine", reflect.TypeOf((*MockroutineMethods)(nil).GetRoutine), varargs...)
 }
 
-// ListFollowees mocks base method.
-func (m *Mockmethods) ListFollowees(ctx context.Context, userID string, opts ...ListFolloweesOpt) (orm.UserSlice, error) {
+// ListRoutines mocks base method.
+func (m *MockroutineMethods) ListRoutines(ctx context.Context, opts ...ListRoutineOpt) (orm.RoutineSlice, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, userID}
+	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListFollowees", varargs...)
-	ret0, _ := ret[0].(orm.UserSlice)
+	ret := m.ctrl.Call(m, "ListRoutines", varargs...)
+	ret0, _ := ret[0].(orm.RoutineSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoutines indicates an expected call of ListRoutines.
+func (mr *MockroutineMethodsMockRecorder) ListRoutines(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutines", reflect.TypeOf((*MockroutineMethods)(nil).ListRoutines), varargs...)
+}
+
+// PurgeRoutines mocks base method.
+func (m *MockroutineMethods) PurgeRoutines(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeRoutines", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeRoutines indicates an expected call of PurgeRoutines.
+func (mr *MockroutineMethodsMockRecorder) PurgeRoutines(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeRoutines", reflect.TypeOf((*MockroutineMethods)(nil).PurgeRoutines), ctx, deletedBefore)
+}
+
+// RemoveExerciseFromRoutine mocks base method.
+func (m *MockroutineMethods) RemoveExerciseFromRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveExerciseFromRoutine", ctx, exercise, routine)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveExerciseFromRoutine indicates an expected call of RemoveExerciseFromRoutine.
+func (mr *MockroutineMethodsMockRecorder) RemoveExerciseFromRoutine(ctx, exercise, routine any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExerciseFromRoutine", reflect.TypeOf((*MockroutineMethods)(nil).RemoveExerciseFromRoutine), ctx, exercise, routine)
+}
+
+// RestoreRoutine mocks base method.
+func (m *MockroutineMethods) RestoreRoutine(ctx context.Context, routineID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRoutine", ctx, routineID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreRoutine indicates an expected call of RestoreRoutine.
+func (mr *MockroutineMethodsMockRecorder) RestoreRoutine(ctx, routineID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRoutine", reflect.TypeOf((*MockroutineMethods)(nil).RestoreRoutine), ctx, routineID)
+}
+
+// SetRoutineExercises mocks base method.
+func (m *MockroutineMethods) SetRoutineExercises(ctx context.Context, routine *orm.Routine, exercises orm.ExerciseSlice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoutineExercises", ctx, routine, exercises)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRoutineExercises indicates an expected call of SetRoutineExercises.
+func (mr *MockroutineMethodsMockRecorder) SetRoutineExercises(ctx, routine, exercises any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoutineExercises", reflect.TypeOf((*MockroutineMethods)(nil).SetRoutineExercises), ctx, routine, exercises)
+}
+
+// UpdateRoutine mocks base method.
+func (m *MockroutineMethods) UpdateRoutine(ctx context.Context, routineID string, opts ...UpdateRoutineOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, routineID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRoutine", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRoutine indicates an expected call of UpdateRoutine.
+func (mr *MockroutineMethodsMockRecorder) UpdateRoutine(ctx, routineID any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, routineID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoutine", reflect.TypeOf((*MockroutineMethods)(nil).UpdateRoutine), varargs...)
+}
+
+// MockworkoutMethods is a mock of workoutMethods interface.
+type MockworkoutMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkoutMethodsMockRecorder
+	isgomock struct{}
+}
+
+// MockworkoutMethodsMockRecorder is the mock recorder for MockworkoutMethods.
+type MockworkoutMethodsMockRecorder struct {
+	mock *MockworkoutMethods
+}
+
+// NewMockworkoutMethods creates a new mock instance.
+func NewMockworkoutMethods(ctrl *gomock.Controller) *MockworkoutMethods {
+	mock := &MockworkoutMethods{ctrl: ctrl}
+	mock.recorder = &MockworkoutMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkoutMethods) EXPECT() *MockworkoutMethodsMockRecorder {
+	return m.recorder
+}
+
+// CanViewWorkout mocks base method.
+func (m *MockworkoutMethods) CanViewWorkout(ctx context.Context, viewerID string, workout *orm.Workout) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanViewWorkout", ctx, viewerID, workout)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanViewWorkout indicates an expected call of CanViewWorkout.
+func (mr *MockworkoutMethodsMockRecorder) CanViewWorkout(ctx, viewerID, workout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanViewWorkout", reflect.TypeOf((*MockworkoutMethods)(nil).CanViewWorkout), ctx, viewerID, workout)
+}
+
+// CreateAchievements mocks base method.
+func (m *MockworkoutMethods) CreateAchievements(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAchievements", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAchievements indicates an expected call of CreateAchievements.
+func (mr *MockworkoutMethodsMockRecorder) CreateAchievements(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAchievements", reflect.TypeOf((*MockworkoutMethods)(nil).CreateAchievements), ctx, userID)
+}
+
+// CreatePersonalRecords mocks base method.
+func (m *MockworkoutMethods) CreatePersonalRecords(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonalRecords", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePersonalRecords indicates an expected call of CreatePersonalRecords.
+func (mr *MockworkoutMethodsMockRecorder) CreatePersonalRecords(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonalRecords", reflect.TypeOf((*MockworkoutMethods)(nil).CreatePersonalRecords), ctx, userID)
+}
+
+// CreateWorkout mocks base method.
+func (m *MockworkoutMethods) CreateWorkout(ctx context.Context, p CreateWorkoutParams) (*orm.Workout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkout", ctx, p)
+	ret0, _ := ret[0].(*orm.Workout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkout indicates an expected call of CreateWorkout.
+func (mr *MockworkoutMethodsMockRecorder) CreateWorkout(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkout", reflect.TypeOf((*MockworkoutMethods)(nil).CreateWorkout), ctx, p)
+}
+
+// CreateWorkoutComment mocks base method.
+func (m *MockworkoutMethods) CreateWorkoutComment(ctx context.Context, p CreateWorkoutCommentParams, opts ...CreateWorkoutCommentOpts) (*orm.WorkoutComment, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWorkoutComment", varargs...)
+	ret0, _ := ret[0].(*orm.WorkoutComment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListFollowees indicates an expected call of ListFollowees.
-func (mr *MockmethodsMockRecorder) ListFollowees(ctx, userID any, opts ...any) *gomock.Call {
+// CreateWorkoutComment indicates an expected call of CreateWorkoutComment.
+func (mr *MockworkoutMethodsMockRecorder) CreateWorkoutComment(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkoutComment", reflect.TypeOf((*MockworkoutMethods)(nil).CreateWorkoutComment), varargs...)
+}
+
+// DeleteWorkout mocks base method.
+func (m *MockworkoutMethods) DeleteWorkout(ctx context.Context, opts ...DeleteWorkoutOpt) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteWorkout", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkout indicates an expected call of DeleteWorkout.
+func (mr *MockworkoutMethodsMockRecorder) DeleteWorkout(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkout", reflect.TypeOf((*MockworkoutMethods)(nil).DeleteWorkout), varargs...)
+}
+
+// DeleteWorkoutComment mocks base method.
+func (m *MockworkoutMethods) DeleteWorkoutComment(ctx context.Context, commentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkoutComment", ctx, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkoutComment indicates an expected call of DeleteWorkoutComment.
+func (mr *MockworkoutMethodsMockRecorder) DeleteWorkoutComment(ctx, commentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, userID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowees", reflect.TypeOf((*Mockmethods)(nil).ListFollowees), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkoutComment", reflect.TypeOf((*MockworkoutMethods)(nil).DeleteWorkoutComment), ctx, commentID)
 }
 
-// ListFollowers mocks base method.
-func (m *Mockmethods) ListFollowers(ctx context.Context, userID string, opts ...ListFollowersOpt) (orm.UserSlice, error) {
+// GetWorkout mocks base method.
+func (m *MockworkoutMethods) GetWorkout(ctx context.Context, opts ...GetWorkoutOpt) (*orm.Workout, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, userID}
+	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListFollowers", varargs...)
-	ret0, _ := ret[0].(orm.UserSlice)
+	ret := m.ctrl.Call(m, "GetWorkout", varargs...)
+	ret0, _ := ret[0].(*orm.Workout)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListFollowers indicates an expected call of ListFollowers.
-func (mr *MockmethodsMockRecorder) ListFollowers(ctx, userID any, opts ...any) *gomock.Call {
+// GetWorkout indicates an expected call of GetWorkout.
+func (mr *MockworkoutMethodsMockRecorder) GetWorkout(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, userID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowers", reflect.TypeOf((*Mockmethods)(nil).ListFollowers), varargs...)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkout", reflect.TypeOf((*MockworkoutMethods)(nil).GetWorkout), varargs...)
 }
 
-// ListNotifications mocks base method.
-func (m *Mockmethods) ListNotifications(ctx context.Context, opts ...ListNotificationsOpt) (orm.NotificationSlice, error) {
+// GetWorkoutComment mocks base method.
+func (m *MockworkoutMethods) GetWorkoutComment(ctx context.Context, opts ...GetWorkoutCommentOpt) (*orm.WorkoutComment, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListNotifications", varargs...)
-	ret0, _ := ret[0].(orm.NotificationSlice)
+	ret := m.ctrl.Call(m, "GetWorkoutComment", varargs...)
+	ret0, _ := ret[0].(*orm.WorkoutComment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListNotifications indicates an expected call of ListNotifications.
-func (mr *MockmethodsMockRecorder) ListNotifications(ctx any, opts ...any) *gomock.Call {
+// GetWorkoutComment indicates an expected call of GetWorkoutComment.
+func (mr *MockworkoutMethodsMockRecorder) GetWorkoutComment(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*Mockmethods)(nil).ListNotifications), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkoutComment", reflect.TypeOf((*MockworkoutMethods)(nil).GetWorkoutComment), varargs...)
 }
 
-// ListRoutines mocks base method.
-func (m *Mockmethods) ListRoutines(ctx context.Context, opts ...ListRoutineOpt) (orm.RoutineSlice, error) {
+// ListAchievements mocks base method.
+func (m *MockworkoutMethods) ListAchievements(ctx context.Context, opts ...ListAchievementsOpt) (orm.AchievementSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListRoutines", varargs...)
-	ret0, _ := ret[0].(orm.RoutineSlice)
+	ret := m.ctrl.Call(m, "ListAchievements", varargs...)
+	ret0, _ := ret[0].(orm.AchievementSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRoutines indicates an expected call of ListRoutines.
-func (mr *MockmethodsMockRecorder) ListRoutines(ctx any, opts ...any) *gomock.Call {
+// ListAchievements indicates an expected call of ListAchievements.
+func (mr *MockworkoutMethodsMockRecorder) ListAchievements(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutines", reflect.TypeOf((*Mockmethods)(nil).ListRoutines), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAchievements", reflect.TypeOf((*MockworkoutMethods)(nil).ListAchievements), varargs...)
 }
 
-// ListSets mocks base method.
-func (m *Mockmethods) ListSets(ctx context.Context, opts ...ListSetsOpt) (orm.SetSlice, error) {
+// ListPersonalRecords mocks base method.
+func (m *MockworkoutMethods) ListPersonalRecords(ctx context.Context, opts ...ListPersonalRecordsOpt) (orm.PersonalRecordSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListSets", varargs...)
-	ret0, _ := ret[0].(orm.SetSlice)
+	ret := m.ctrl.Call(m, "ListPersonalRecords", varargs...)
+	ret0, _ := ret[0].(orm.PersonalRecordSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListSets indicates an expected call of ListSets.
-func (mr *MockmethodsMockRecorder) ListSets(ctx any, opts ...any) *gomock.Call {
+// ListPersonalRecords indicates an expected call of ListPersonalRecords.
+func (mr *MockworkoutMethodsMockRecorder) ListPersonalRecords(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSets", reflect.TypeOf((*Mockmethods)(nil).ListSets), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPersonalRecords", reflect.TypeOf((*MockworkoutMethods)(nil).ListPersonalRecords), varargs...)
 }
 
-// ListUsers mocks base method.
-func (m *Mockmethods) ListUsers(ctx context.Context, opts ...ListUsersOpt) (orm.UserSlice, error) {
+// ListWorkoutComments mocks base method.
+func (m *MockworkoutMethods) ListWorkoutComments(ctx context.Context, opts ...ListWorkoutCommentsOpt) (orm.WorkoutCommentSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListUsers", varargs...)
-	ret0, _ := ret[0].(orm.UserSlice)
+	ret := m.ctrl.Call(m, "ListWorkoutComments", varargs...)
+	ret0, _ := ret[0].(orm.WorkoutCommentSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListUsers indicates an expected call of ListUsers.
-func (mr *MockmethodsMockRecorder) ListUsers(ctx any, opts ...any) *gomock.Call {
+// ListWorkoutComments indicates an expected call of ListWorkoutComments.
+func (mr *MockworkoutMethodsMockRecorder) ListWorkoutComments(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*Mockmethods)(nil).ListUsers), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkoutComments", reflect.TypeOf((*MockworkoutMethods)(nil).ListWorkoutComments), varargs...)
 }
 
 // ListWorkouts mocks base method.
-func (m *Mockmethods) ListWorkouts(ctx context.Context, opts ...ListWorkoutsOpt) (orm.WorkoutSlice, error) {
+func (m *MockworkoutMethods) ListWorkouts(ctx context.Context, opts ...ListWorkoutsOpt) (orm.WorkoutSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
@@ -2148,160 +7252,245 @@ func (m *Mockmethods) ListWorkouts(ctx context.Context, opts ...ListWorkoutsOpt)
 }
 
 // ListWorkouts indicates an expected call of ListWorkouts.
-func (mr *MockmethodsMockRecorder) ListWorkouts(ctx any, opts ...any) *gomock.Call {
+func (mr *MockworkoutMethodsMockRecorder) ListWorkouts(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkouts", reflect.TypeOf((*Mockmethods)(nil).ListWorkouts), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkouts", reflect.TypeOf((*MockworkoutMethods)(nil).ListWorkouts), varargs...)
 }
 
-// MarkNotificationsAsRead mocks base method.
-func (m *Mockmethods) MarkNotificationsAsRead(ctx context.Context, userID string) error {
+// PostCreateWorkoutCommentLoadUser mocks base method.
+func (m *MockworkoutMethods) PostCreateWorkoutCommentLoadUser(ctx context.Context) CreateWorkoutCommentOpts {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkNotificationsAsRead", ctx, userID)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "PostCreateWorkoutCommentLoadUser", ctx)
+	ret0, _ := ret[0].(CreateWorkoutCommentOpts)
 	return ret0
 }
 
-// MarkNotificationsAsRead indicates an expected call of MarkNotificationsAsRead.
-func (mr *MockmethodsMockRecorder) MarkNotificationsAsRead(ctx, userID any) *gomock.Call {
+// PostCreateWorkoutCommentLoadUser indicates an expected call of PostCreateWorkoutCommentLoadUser.
+func (mr *MockworkoutMethodsMockRecorder) PostCreateWorkoutCommentLoadUser(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsAsRead", reflect.TypeOf((*Mockmethods)(nil).MarkNotificationsAsRead), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostCreateWorkoutCommentLoadUser", reflect.TypeOf((*MockworkoutMethods)(nil).PostCreateWorkoutCommentLoadUser), ctx)
 }
 
-// PostCreateWorkoutCommentLoadUser mocks base method.
-func (m *Mockmethods) PostCreateWorkoutCommentLoadUser(ctx context.Context) CreateWorkoutCommentOpts {
+// PurgeWorkouts mocks base method.
+func (m *MockworkoutMethods) PurgeWorkouts(ctx context.Context, deletedBefore time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PostCreateWorkoutCommentLoadUser", ctx)
-	ret0, _ := ret[0].(CreateWorkoutCommentOpts)
-	return ret0
+	ret := m.ctrl.Call(m, "PurgeWorkouts", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// PostCreateWorkoutCommentLoadUser indicates an expected call of PostCreateWorkoutCommentLoadUser.
-func (mr *MockmethodsMockRecorder) PostCreateWorkoutCommentLoadUser(ctx any) *gomock.Call {
+// PurgeWorkouts indicates an expected call of PurgeWorkouts.
+func (mr *MockworkoutMethodsMockRecorder) PurgeWorkouts(ctx, deletedBefore any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostCreateWorkoutCommentLoadUser", reflect.TypeOf((*Mockmethods)(nil).PostCreateWorkoutCommentLoadUser), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeWorkouts", reflect.TypeOf((*MockworkoutMethods)(nil).PurgeWorkouts), ctx, deletedBefore)
 }
 
-// PublishEvent mocks base method.
-func (m *Mockmethods) PublishEvent(ctx context.Context, topic orm.EventTopic, payload []byte) error {
+// RestoreWorkout mocks base method.
+func (m *MockworkoutMethods) RestoreWorkout(ctx context.Context, workoutID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PublishEvent", ctx, topic, payload)
+	ret := m.ctrl.Call(m, "RestoreWorkout", ctx, workoutID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PublishEvent indicates an expected call of PublishEvent.
-func (mr *MockmethodsMockRecorder) PublishEvent(ctx, topic, payload any) *gomock.Call {
+// RestoreWorkout indicates an expected call of RestoreWorkout.
+func (mr *MockworkoutMethodsMockRecorder) RestoreWorkout(ctx, workoutID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvent", reflect.TypeOf((*Mockmethods)(nil).PublishEvent), ctx, topic, payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkout", reflect.TypeOf((*MockworkoutMethods)(nil).RestoreWorkout), ctx, workoutID)
 }
 
-// RefreshTokenExists mocks base method.
-func (m *Mockmethods) RefreshTokenExists(ctx context.Context, refreshToken string) (bool, error) {
+// UpdateWorkout mocks base method.
+func (m *MockworkoutMethods) UpdateWorkout(ctx context.Context, workoutID string, opts ...UpdateWorkoutOpt) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefreshTokenExists", ctx, refreshToken)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{ctx, workoutID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkout", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// RefreshTokenExists indicates an expected call of RefreshTokenExists.
-func (mr *MockmethodsMockRecorder) RefreshTokenExists(ctx, refreshToken any) *gomock.Call {
+// UpdateWorkout indicates an expected call of UpdateWorkout.
+func (mr *MockworkoutMethodsMockRecorder) UpdateWorkout(ctx, workoutID any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokenExists", reflect.TypeOf((*Mockmethods)(nil).RefreshTokenExists), ctx, refreshToken)
+	varargs := append([]any{ctx, workoutID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkout", reflect.TypeOf((*MockworkoutMethods)(nil).UpdateWorkout), varargs...)
 }
 
-// RemoveExerciseFromRoutine mocks base method.
-func (m *Mockmethods) RemoveExerciseFromRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
+// UpdateWorkoutComment mocks base method.
+func (m *MockworkoutMethods) UpdateWorkoutComment(ctx context.Context, commentID string, opts ...UpdateWorkoutCommentOpt) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveExerciseFromRoutine", ctx, exercise, routine)
+	varargs := []any{ctx, commentID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateWorkoutComment", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RemoveExerciseFromRoutine indicates an expected call of RemoveExerciseFromRoutine.
-func (mr *MockmethodsMockRecorder) RemoveExerciseFromRoutine(ctx, exercise, routine any) *gomock.Call {
+// UpdateWorkoutComment indicates an expected call of UpdateWorkoutComment.
+func (mr *MockworkoutMethodsMockRecorder) UpdateWorkoutComment(ctx, commentID any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExerciseFromRoutine", reflect.TypeOf((*Mockmethods)(nil).RemoveExerciseFromRoutine), ctx, exercise, routine)
+	varargs := append([]any{ctx, commentID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkoutComment", reflect.TypeOf((*MockworkoutMethods)(nil).UpdateWorkoutComment), varargs...)
 }
 
-// SetRoutineExercises mocks base method.
-func (m *Mockmethods) SetRoutineExercises(ctx context.Context, routine *orm.Routine, exercises orm.ExerciseSlice) error {
+// UpdateWorkoutSets mocks base method.
+func (m *MockworkoutMethods) UpdateWorkoutSets(ctx context.Context, p UpdateWorkoutSetsParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetRoutineExercises", ctx, routine, exercises)
+	ret := m.ctrl.Call(m, "UpdateWorkoutSets", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SetRoutineExercises indicates an expected call of SetRoutineExercises.
-func (mr *MockmethodsMockRecorder) SetRoutineExercises(ctx, routine, exercises any) *gomock.Call {
+// UpdateWorkoutSets indicates an expected call of UpdateWorkoutSets.
+func (mr *MockworkoutMethodsMockRecorder) UpdateWorkoutSets(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoutineExercises", reflect.TypeOf((*Mockmethods)(nil).SetRoutineExercises), ctx, routine, exercises)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkoutSets", reflect.TypeOf((*MockworkoutMethods)(nil).UpdateWorkoutSets), ctx, p)
 }
 
-// SoftDeleteExercise mocks base method.
-func (m *Mockmethods) SoftDeleteExercise(ctx context.Context, p SoftDeleteExerciseParams) error {
+// MockexerciseMethods is a mock of exerciseMethods interface.
+type MockexerciseMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MockexerciseMethodsMockRecorder
+	isgomock struct{}
+}
+
+// MockexerciseMethodsMockRecorder is the mock recorder for MockexerciseMethods.
+type MockexerciseMethodsMockRecorder struct {
+	mock *MockexerciseMethods
+}
+
+// NewMockexerciseMethods creates a new mock instance.
+func NewMockexerciseMethods(ctrl *gomock.Controller) *MockexerciseMethods {
+	mock := &MockexerciseMethods{ctrl: ctrl}
+	mock.recorder = &MockexerciseMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexerciseMethods) EXPECT() *MockexerciseMethodsMockRecorder {
+	return m.recorder
+}
+
+// CreateExercise mocks base method.
+func (m *MockexerciseMethods) CreateExercise(ctx context.Context, p CreateExerciseParams) (*orm.Exercise, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SoftDeleteExercise", ctx, p)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateExercise", ctx, p)
+	ret0, _ := ret[0].(*orm.Exercise)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SoftDeleteExercise indicates an expected call of SoftDeleteExercise.
-func (mr *MockmethodsMockRecorder) SoftDeleteExercise(ctx, p any) *gomock.Call {
+// CreateExercise indicates an expected call of CreateExercise.
+func (mr *MockexerciseMethodsMockRecorder) CreateExercise(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteExercise", reflect.TypeOf((*Mockmethods)(nil).SoftDeleteExercise), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExercise", reflect.TypeOf((*MockexerciseMethods)(nil).CreateExercise), ctx, p)
 }
 
-// StoreTrace mocks base method.
-func (m *Mockmethods) StoreTrace(ctx context.Context, p StoreTraceParams) error {
+// GetExercise mocks base method.
+func (m *MockexerciseMethods) GetExercise(ctx context.Context, opts ...GetExerciseOpt) (*orm.Exercise, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StoreTrace", ctx, p)
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetExercise", varargs...)
+	ret0, _ := ret[0].(*orm.Exercise)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExercise indicates an expected call of GetExercise.
+func (mr *MockexerciseMethodsMockRecorder) GetExercise(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExercise", reflect.TypeOf((*MockexerciseMethods)(nil).GetExercise), varargs...)
+}
+
+// ListExercises mocks base method.
+func (m *MockexerciseMethods) ListExercises(ctx context.Context, opts ...ListExercisesOpt) (orm.ExerciseSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListExercises", varargs...)
+	ret0, _ := ret[0].(orm.ExerciseSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExercises indicates an expected call of ListExercises.
+func (mr *MockexerciseMethodsMockRecorder) ListExercises(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExercises", reflect.TypeOf((*MockexerciseMethods)(nil).ListExercises), varargs...)
+}
+
+// MergeExercises mocks base method.
+func (m *MockexerciseMethods) MergeExercises(ctx context.Context, p MergeExercisesParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeExercises", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// StoreTrace indicates an expected call of StoreTrace.
-func (mr *MockmethodsMockRecorder) StoreTrace(ctx, p any) *gomock.Call {
+// MergeExercises indicates an expected call of MergeExercises.
+func (mr *MockexerciseMethodsMockRecorder) MergeExercises(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreTrace", reflect.TypeOf((*Mockmethods)(nil).StoreTrace), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeExercises", reflect.TypeOf((*MockexerciseMethods)(nil).MergeExercises), ctx, p)
 }
 
-// Unfollow mocks base method.
-func (m *Mockmethods) Unfollow(ctx context.Context, p UnfollowParams) error {
+// PurgeExercises mocks base method.
+func (m *MockexerciseMethods) PurgeExercises(ctx context.Context, deletedBefore time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Unfollow", ctx, p)
+	ret := m.ctrl.Call(m, "PurgeExercises", ctx, deletedBefore)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExercises indicates an expected call of PurgeExercises.
+func (mr *MockexerciseMethodsMockRecorder) PurgeExercises(ctx, deletedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExercises", reflect.TypeOf((*MockexerciseMethods)(nil).PurgeExercises), ctx, deletedBefore)
+}
+
+// RestoreExercise mocks base method.
+func (m *MockexerciseMethods) RestoreExercise(ctx context.Context, p RestoreExerciseParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreExercise", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Unfollow indicates an expected call of Unfollow.
-func (mr *MockmethodsMockRecorder) Unfollow(ctx, p any) *gomock.Call {
+// RestoreExercise indicates an expected call of RestoreExercise.
+func (mr *MockexerciseMethodsMockRecorder) RestoreExercise(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*Mockmethods)(nil).Unfollow), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreExercise", reflect.TypeOf((*MockexerciseMethods)(nil).RestoreExercise), ctx, p)
 }
 
-// UpdateAuth mocks base method.
-func (m *Mockmethods) UpdateAuth(ctx context.Context, authID string, opts ...UpdateAuthOpt) error {
+// SoftDeleteExercise mocks base method.
+func (m *MockexerciseMethods) SoftDeleteExercise(ctx context.Context, p SoftDeleteExerciseParams) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, authID}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "UpdateAuth", varargs...)
+	ret := m.ctrl.Call(m, "SoftDeleteExercise", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
-
-// UpdateAuth indicates an expected call of UpdateAuth.
-func (mr *MockmethodsMockRecorder) UpdateAuth(ctx, authID any, opts ...any) *gomock.Call {
+
+// SoftDeleteExercise indicates an expected call of SoftDeleteExercise.
+func (mr *MockexerciseMethodsMockRecorder) SoftDeleteExercise(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, authID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuth", reflect.TypeOf((*Mockmethods)(nil).UpdateAuth), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteExercise", reflect.TypeOf((*MockexerciseMethods)(nil).SoftDeleteExercise), ctx, p)
 }
 
 // UpdateExercise mocks base method.
-func (m *Mockmethods) UpdateExercise(ctx context.Context, exerciseID string, opts ...UpdateExerciseOpt) error {
+func (m *MockexerciseMethods) UpdateExercise(ctx context.Context, exerciseID string, opts ...UpdateExerciseOpt) error {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, exerciseID}
 	for _, a := range opts {
@@ -2313,991 +7502,1219 @@ func (m *Mockmethods) UpdateExercise(ctx context.Context, exerciseID string, opt
 }
 
 // UpdateExercise indicates an expected call of UpdateExercise.
-func (mr *MockmethodsMockRecorder) UpdateExercise(ctx, exerciseID any, opts ...any) *gomock.Call {
+func (mr *MockexerciseMethodsMockRecorder) UpdateExercise(ctx, exerciseID any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, exerciseID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExercise", reflect.TypeOf((*Mockmethods)(nil).UpdateExercise), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExercise", reflect.TypeOf((*MockexerciseMethods)(nil).UpdateExercise), varargs...)
 }
 
-// UpdateRoutine mocks base method.
-func (m *Mockmethods) UpdateRoutine(ctx context.Context, routineID string, opts ...UpdateRoutineOpt) error {
-	m.ctrl.T.Helper()
-	varargs := []any{ctx, routineID}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "UpdateRoutine", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+// MocknotificationMethods is a mock of notificationMethods interface.
+type MocknotificationMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MocknotificationMethodsMockRecorder
+	isgomock struct{}
 }
 
-// UpdateRoutine indicates an expected call of UpdateRoutine.
-func (mr *MockmethodsMockRecorder) UpdateRoutine(ctx, routineID any, opts ...any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, routineID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoutine", reflect.TypeOf((*Mockmethods)(nil).UpdateRoutine), varargs...)
+// MocknotificationMethodsMockRecorder is the mock recorder for MocknotificationMethods.
+type MocknotificationMethodsMockRecorder struct {
+	mock *MocknotificationMethods
 }
 
-// UpdateWorkout mocks base method.
-func (m *Mockmethods) UpdateWorkout(ctx context.Context, workoutID string, opts ...UpdateWorkoutOpt) error {
+// NewMocknotificationMethods creates a new mock instance.
+func NewMocknotificationMethods(ctrl *gomock.Controller) *MocknotificationMethods {
+	mock := &MocknotificationMethods{ctrl: ctrl}
+	mock.recorder = &MocknotificationMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocknotificationMethods) EXPECT() *MocknotificationMethodsMockRecorder {
+	return m.recorder
+}
+
+// CountNotifications mocks base method.
+func (m *MocknotificationMethods) CountNotifications(ctx context.Context, opts ...CountNotificationsOpt) (int64, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, workoutID}
+	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateWorkout", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CountNotifications", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateWorkout indicates an expected call of UpdateWorkout.
-func (mr *MockmethodsMockRecorder) UpdateWorkout(ctx, workoutID any, opts ...any) *gomock.Call {
+// CountNotifications indicates an expected call of CountNotifications.
+func (mr *MocknotificationMethodsMockRecorder) CountNotifications(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, workoutID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkout", reflect.TypeOf((*Mockmethods)(nil).UpdateWorkout), varargs...)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNotifications", reflect.TypeOf((*MocknotificationMethods)(nil).CountNotifications), varargs...)
 }
 
-// UpdateWorkoutSets mocks base method.
-func (m *Mockmethods) UpdateWorkoutSets(ctx context.Context, p UpdateWorkoutSetsParams) error {
+// CreateGroupedNotification mocks base method.
+func (m *MocknotificationMethods) CreateGroupedNotification(ctx context.Context, p CreateNotificationParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateWorkoutSets", ctx, p)
+	ret := m.ctrl.Call(m, "CreateGroupedNotification", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateWorkoutSets indicates an expected call of UpdateWorkoutSets.
-func (mr *MockmethodsMockRecorder) UpdateWorkoutSets(ctx, p any) *gomock.Call {
+// CreateGroupedNotification indicates an expected call of CreateGroupedNotification.
+func (mr *MocknotificationMethodsMockRecorder) CreateGroupedNotification(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkoutSets", reflect.TypeOf((*Mockmethods)(nil).UpdateWorkoutSets), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupedNotification", reflect.TypeOf((*MocknotificationMethods)(nil).CreateGroupedNotification), ctx, p)
 }
 
-// MocksetMethods is a mock of setMethods interface.
-type MocksetMethods struct {
-	ctrl     *gomock.Controller
-	recorder *MocksetMethodsMockRecorder
-	isgomock struct{}
+// CreateNotification mocks base method.
+func (m *MocknotificationMethods) CreateNotification(ctx context.Context, p CreateNotificationParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MocksetMethodsMockRecorder is the mock recorder for MocksetMethods.
-type MocksetMethodsMockRecorder struct {
-	mock *MocksetMethods
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MocknotificationMethodsMockRecorder) CreateNotification(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MocknotificationMethods)(nil).CreateNotification), ctx, p)
 }
 
-// NewMocksetMethods creates a new mock instance.
-func NewMocksetMethods(ctrl *gomock.Controller) *MocksetMethods {
-	mock := &MocksetMethods{ctrl: ctrl}
-	mock.recorder = &MocksetMethodsMockRecorder{mock}
-	return mock
+// DeleteGroupedNotifications mocks base method.
+func (m *MocknotificationMethods) DeleteGroupedNotifications(ctx context.Context, userID string, notificationType orm.NotificationType, workoutID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupedNotifications", ctx, userID, notificationType, workoutID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocksetMethods) EXPECT() *MocksetMethodsMockRecorder {
-	return m.recorder
+// DeleteGroupedNotifications indicates an expected call of DeleteGroupedNotifications.
+func (mr *MocknotificationMethodsMockRecorder) DeleteGroupedNotifications(ctx, userID, notificationType, workoutID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupedNotifications", reflect.TypeOf((*MocknotificationMethods)(nil).DeleteGroupedNotifications), ctx, userID, notificationType, workoutID)
 }
 
-// GetPersonalBests mocks base method.
-func (m *MocksetMethods) GetPersonalBests(ctx context.Context, userIDs ...string) (orm.SetSlice, error) {
+// ListNotifications mocks base method.
+func (m *MocknotificationMethods) ListNotifications(ctx context.Context, opts ...ListNotificationsOpt) (orm.NotificationSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
-	for _, a := range userIDs {
+	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetPersonalBests", varargs...)
-	ret0, _ := ret[0].(orm.SetSlice)
+	ret := m.ctrl.Call(m, "ListNotifications", varargs...)
+	ret0, _ := ret[0].(orm.NotificationSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPersonalBests indicates an expected call of GetPersonalBests.
-func (mr *MocksetMethodsMockRecorder) GetPersonalBests(ctx any, userIDs ...any) *gomock.Call {
+// ListNotifications indicates an expected call of ListNotifications.
+func (mr *MocknotificationMethodsMockRecorder) ListNotifications(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, userIDs...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalBests", reflect.TypeOf((*MocksetMethods)(nil).GetPersonalBests), varargs...)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MocknotificationMethods)(nil).ListNotifications), varargs...)
 }
 
-// GetPreviousWorkoutSets mocks base method.
-func (m *MocksetMethods) GetPreviousWorkoutSets(ctx context.Context, exerciseIDs []string) (orm.SetSlice, error) {
+// MarkNotificationsAsRead mocks base method.
+func (m *MocknotificationMethods) MarkNotificationsAsRead(ctx context.Context, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPreviousWorkoutSets", ctx, exerciseIDs)
-	ret0, _ := ret[0].(orm.SetSlice)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "MarkNotificationsAsRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetPreviousWorkoutSets indicates an expected call of GetPreviousWorkoutSets.
-func (mr *MocksetMethodsMockRecorder) GetPreviousWorkoutSets(ctx, exerciseIDs any) *gomock.Call {
+// MarkNotificationsAsRead indicates an expected call of MarkNotificationsAsRead.
+func (mr *MocknotificationMethodsMockRecorder) MarkNotificationsAsRead(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviousWorkoutSets", reflect.TypeOf((*MocksetMethods)(nil).GetPreviousWorkoutSets), ctx, exerciseIDs)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsAsRead", reflect.TypeOf((*MocknotificationMethods)(nil).MarkNotificationsAsRead), ctx, userID)
 }
 
-// ListSets mocks base method.
-func (m *MocksetMethods) ListSets(ctx context.Context, opts ...ListSetsOpt) (orm.SetSlice, error) {
+// UpdateGroupedNotification mocks base method.
+func (m *MocknotificationMethods) UpdateGroupedNotification(ctx context.Context, p CreateNotificationParams) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ListSets", varargs...)
-	ret0, _ := ret[0].(orm.SetSlice)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpdateGroupedNotification", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListSets indicates an expected call of ListSets.
-func (mr *MocksetMethodsMockRecorder) ListSets(ctx any, opts ...any) *gomock.Call {
+// UpdateGroupedNotification indicates an expected call of UpdateGroupedNotification.
+func (mr *MocknotificationMethodsMockRecorder) UpdateGroupedNotification(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSets", reflect.TypeOf((*MocksetMethods)(nil).ListSets), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupedNotification", reflect.TypeOf((*MocknotificationMethods)(nil).UpdateGroupedNotification), ctx, p)
 }
 
-// MockauthMethods is a mock of authMethods interface.
-type MockauthMethods struct {
+// MockyearSummaryMethods is a mock of yearSummaryMethods interface.
+type MockyearSummaryMethods struct {
 	ctrl     *gomock.Controller
-	recorder *MockauthMethodsMockRecorder
+	recorder *MockyearSummaryMethodsMockRecorder
 	isgomock struct{}
 }
 
-// MockauthMethodsMockRecorder is the mock recorder for MockauthMethods.
-type MockauthMethodsMockRecorder struct {
-	mock *MockauthMethods
+// MockyearSummaryMethodsMockRecorder is the mock recorder for MockyearSummaryMethods.
+type MockyearSummaryMethodsMockRecorder struct {
+	mock *MockyearSummaryMethods
 }
 
-// NewMockauthMethods creates a new mock instance.
-func NewMockauthMethods(ctrl *gomock.Controller) *MockauthMethods {
-	mock := &MockauthMethods{ctrl: ctrl}
-	mock.recorder = &MockauthMethodsMockRecorder{mock}
+// NewMockyearSummaryMethods creates a new mock instance.
+func NewMockyearSummaryMethods(ctrl *gomock.Controller) *MockyearSummaryMethods {
+	mock := &MockyearSummaryMethods{ctrl: ctrl}
+	mock.recorder = &MockyearSummaryMethodsMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockauthMethods) EXPECT() *MockauthMethodsMockRecorder {
+func (m *MockyearSummaryMethods) EXPECT() *MockyearSummaryMethodsMockRecorder {
 	return m.recorder
 }
 
-// CompareEmailAndPassword mocks base method.
-func (m *MockauthMethods) CompareEmailAndPassword(ctx context.Context, email, password string) error {
+// BuildYearSummary mocks base method.
+func (m *MockyearSummaryMethods) BuildYearSummary(ctx context.Context, userID string, year int) (*YearSummaryPayload, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CompareEmailAndPassword", ctx, email, password)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "BuildYearSummary", ctx, userID, year)
+	ret0, _ := ret[0].(*YearSummaryPayload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CompareEmailAndPassword indicates an expected call of CompareEmailAndPassword.
-func (mr *MockauthMethodsMockRecorder) CompareEmailAndPassword(ctx, email, password any) *gomock.Call {
+// BuildYearSummary indicates an expected call of BuildYearSummary.
+func (mr *MockyearSummaryMethodsMockRecorder) BuildYearSummary(ctx, userID, year any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareEmailAndPassword", reflect.TypeOf((*MockauthMethods)(nil).CompareEmailAndPassword), ctx, email, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildYearSummary", reflect.TypeOf((*MockyearSummaryMethods)(nil).BuildYearSummary), ctx, userID, year)
 }
 
-// CreateAuth mocks base method.
-func (m *MockauthMethods) CreateAuth(ctx context.Context, email, password string) (*orm.Auth, error) {
+// CreateYearSummary mocks base method.
+func (m *MockyearSummaryMethods) CreateYearSummary(ctx context.Context, p CreateYearSummaryParams) (*orm.YearSummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAuth", ctx, email, password)
-	ret0, _ := ret[0].(*orm.Auth)
+	ret := m.ctrl.Call(m, "CreateYearSummary", ctx, p)
+	ret0, _ := ret[0].(*orm.YearSummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateAuth indicates an expected call of CreateAuth.
-func (mr *MockauthMethodsMockRecorder) CreateAuth(ctx, email, password any) *gomock.Call {
+// CreateYearSummary indicates an expected call of CreateYearSummary.
+func (mr *MockyearSummaryMethodsMockRecorder) CreateYearSummary(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuth", reflect.TypeOf((*MockauthMethods)(nil).CreateAuth), ctx, email, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateYearSummary", reflect.TypeOf((*MockyearSummaryMethods)(nil).CreateYearSummary), ctx, p)
 }
 
-// GetAuth mocks base method.
-func (m *MockauthMethods) GetAuth(ctx context.Context, opts ...GetAuthOpt) (*orm.Auth, error) {
+// GetYearSummary mocks base method.
+func (m *MockyearSummaryMethods) GetYearSummary(ctx context.Context, opts ...GetYearSummaryOpt) (*orm.YearSummary, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetAuth", varargs...)
-	ret0, _ := ret[0].(*orm.Auth)
+	ret := m.ctrl.Call(m, "GetYearSummary", varargs...)
+	ret0, _ := ret[0].(*orm.YearSummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAuth indicates an expected call of GetAuth.
-func (mr *MockauthMethodsMockRecorder) GetAuth(ctx any, opts ...any) *gomock.Call {
+// GetYearSummary indicates an expected call of GetYearSummary.
+func (mr *MockyearSummaryMethodsMockRecorder) GetYearSummary(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuth", reflect.TypeOf((*MockauthMethods)(nil).GetAuth), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearSummary", reflect.TypeOf((*MockyearSummaryMethods)(nil).GetYearSummary), varargs...)
 }
 
-// RefreshTokenExists mocks base method.
-func (m *MockauthMethods) RefreshTokenExists(ctx context.Context, refreshToken string) (bool, error) {
+// ListYearSummaries mocks base method.
+func (m *MockyearSummaryMethods) ListYearSummaries(ctx context.Context, opts ...ListYearSummariesOpt) (orm.YearSummarySlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefreshTokenExists", ctx, refreshToken)
-	ret0, _ := ret[0].(bool)
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListYearSummaries", varargs...)
+	ret0, _ := ret[0].(orm.YearSummarySlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RefreshTokenExists indicates an expected call of RefreshTokenExists.
-func (mr *MockauthMethodsMockRecorder) RefreshTokenExists(ctx, refreshToken any) *gomock.Call {
+// ListYearSummaries indicates an expected call of ListYearSummaries.
+func (mr *MockyearSummaryMethodsMockRecorder) ListYearSummaries(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokenExists", reflect.TypeOf((*MockauthMethods)(nil).RefreshTokenExists), ctx, refreshToken)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListYearSummaries", reflect.TypeOf((*MockyearSummaryMethods)(nil).ListYearSummaries), varargs...)
 }
 
-// UpdateAuth mocks base method.
-func (m *MockauthMethods) UpdateAuth(ctx context.Context, authID string, opts ...UpdateAuthOpt) error {
+// ListYearSummaryCandidates mocks base method.
+func (m *MockyearSummaryMethods) ListYearSummaryCandidates(ctx context.Context, year int) ([]string, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, authID}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "UpdateAuth", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListYearSummaryCandidates", ctx, year)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateAuth indicates an expected call of UpdateAuth.
-func (mr *MockauthMethodsMockRecorder) UpdateAuth(ctx, authID any, opts ...any) *gomock.Call {
+// ListYearSummaryCandidates indicates an expected call of ListYearSummaryCandidates.
+func (mr *MockyearSummaryMethodsMockRecorder) ListYearSummaryCandidates(ctx, year any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, authID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAuth", reflect.TypeOf((*MockauthMethods)(nil).UpdateAuth), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListYearSummaryCandidates", reflect.TypeOf((*MockyearSummaryMethods)(nil).ListYearSummaryCandidates), ctx, year)
 }
 
-// MockuserMethods is a mock of userMethods interface.
-type MockuserMethods struct {
+// MockdataExportMethods is a mock of dataExportMethods interface.
+type MockdataExportMethods struct {
 	ctrl     *gomock.Controller
-	recorder *MockuserMethodsMockRecorder
+	recorder *MockdataExportMethodsMockRecorder
 	isgomock struct{}
 }
 
-// MockuserMethodsMockRecorder is the mock recorder for MockuserMethods.
-type MockuserMethodsMockRecorder struct {
-	mock *MockuserMethods
+// MockdataExportMethodsMockRecorder is the mock recorder for MockdataExportMethods.
+type MockdataExportMethodsMockRecorder struct {
+	mock *MockdataExportMethods
 }
 
-// NewMockuserMethods creates a new mock instance.
-func NewMockuserMethods(ctrl *gomock.Controller) *MockuserMethods {
-	mock := &MockuserMethods{ctrl: ctrl}
-	mock.recorder = &MockuserMethodsMockRecorder{mock}
+// NewMockdataExportMethods creates a new mock instance.
+func NewMockdataExportMethods(ctrl *gomock.Controller) *MockdataExportMethods {
+	mock := &MockdataExportMethods{ctrl: ctrl}
+	mock.recorder = &MockdataExportMethodsMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockuserMethods) EXPECT() *MockuserMethodsMockRecorder {
+func (m *MockdataExportMethods) EXPECT() *MockdataExportMethodsMockRecorder {
 	return m.recorder
 }
 
-// CreateUser mocks base method.
-func (m *MockuserMethods) CreateUser(ctx context.Context, p CreateUserParams) (*orm.User, error) {
+// CompleteDataExport mocks base method.
+func (m *MockdataExportMethods) CompleteDataExport(ctx context.Context, exportID string, archive []byte) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, p)
-	ret0, _ := ret[0].(*orm.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CompleteDataExport", ctx, exportID, archive)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockuserMethodsMockRecorder) CreateUser(ctx, p any) *gomock.Call {
+// CompleteDataExport indicates an expected call of CompleteDataExport.
+func (mr *MockdataExportMethodsMockRecorder) CompleteDataExport(ctx, exportID, archive any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockuserMethods)(nil).CreateUser), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteDataExport", reflect.TypeOf((*MockdataExportMethods)(nil).CompleteDataExport), ctx, exportID, archive)
 }
 
-// Follow mocks base method.
-func (m *MockuserMethods) Follow(ctx context.Context, p FollowParams) error {
+// CreateDataExport mocks base method.
+func (m *MockdataExportMethods) CreateDataExport(ctx context.Context, userID string) (*orm.DataExport, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Follow", ctx, p)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateDataExport", ctx, userID)
+	ret0, _ := ret[0].(*orm.DataExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Follow indicates an expected call of Follow.
-func (mr *MockuserMethodsMockRecorder) Follow(ctx, p any) *gomock.Call {
+// CreateDataExport indicates an expected call of CreateDataExport.
+func (mr *MockdataExportMethodsMockRecorder) CreateDataExport(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockuserMethods)(nil).Follow), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataExport", reflect.TypeOf((*MockdataExportMethods)(nil).CreateDataExport), ctx, userID)
 }
 
-// GetUser mocks base method.
-func (m *MockuserMethods) GetUser(ctx context.Context, opts ...GetUserOpt) (*orm.User, error) {
+// GetDataExport mocks base method.
+func (m *MockdataExportMethods) GetDataExport(ctx context.Context, opts ...GetDataExportOpt) (*orm.DataExport, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetUser", varargs...)
-	ret0, _ := ret[0].(*orm.User)
+	ret := m.ctrl.Call(m, "GetDataExport", varargs...)
+	ret0, _ := ret[0].(*orm.DataExport)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
-
-// GetUser indicates an expected call of GetUser.
-func (mr *MockuserMethodsMockRecorder) GetUser(ctx any, opts ...any) *gomock.Call {
+
+// GetDataExport indicates an expected call of GetDataExport.
+func (mr *MockdataExportMethodsMockRecorder) GetDataExport(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockuserMethods)(nil).GetUser), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataExport", reflect.TypeOf((*MockdataExportMethods)(nil).GetDataExport), varargs...)
 }
 
-// IsUserFollowedByUserID mocks base method.
-func (m *MockuserMethods) IsUserFollowedByUserID(ctx context.Context, user *orm.User, userID string) (bool, error) {
+// PurgeDataExports mocks base method.
+func (m *MockdataExportMethods) PurgeDataExports(ctx context.Context, createdBefore time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsUserFollowedByUserID", ctx, user, userID)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "PurgeDataExports", ctx, createdBefore)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsUserFollowedByUserID indicates an expected call of IsUserFollowedByUserID.
-func (mr *MockuserMethodsMockRecorder) IsUserFollowedByUserID(ctx, user, userID any) *gomock.Call {
+// PurgeDataExports indicates an expected call of PurgeDataExports.
+func (mr *MockdataExportMethodsMockRecorder) PurgeDataExports(ctx, createdBefore any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserFollowedByUserID", reflect.TypeOf((*MockuserMethods)(nil).IsUserFollowedByUserID), ctx, user, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDataExports", reflect.TypeOf((*MockdataExportMethods)(nil).PurgeDataExports), ctx, createdBefore)
 }
 
-// ListFollowees mocks base method.
-func (m *MockuserMethods) ListFollowees(ctx context.Context, userID string, opts ...ListFolloweesOpt) (orm.UserSlice, error) {
+// MockreactionMethods is a mock of reactionMethods interface.
+type MockreactionMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MockreactionMethodsMockRecorder
+	isgomock struct{}
+}
+
+// MockreactionMethodsMockRecorder is the mock recorder for MockreactionMethods.
+type MockreactionMethodsMockRecorder struct {
+	mock *MockreactionMethods
+}
+
+// NewMockreactionMethods creates a new mock instance.
+func NewMockreactionMethods(ctrl *gomock.Controller) *MockreactionMethods {
+	mock := &MockreactionMethods{ctrl: ctrl}
+	mock.recorder = &MockreactionMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreactionMethods) EXPECT() *MockreactionMethodsMockRecorder {
+	return m.recorder
+}
+
+// DeleteWorkoutReaction mocks base method.
+func (m *MockreactionMethods) DeleteWorkoutReaction(ctx context.Context, userID, workoutID string) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, userID}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ListFollowees", varargs...)
-	ret0, _ := ret[0].(orm.UserSlice)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteWorkoutReaction", ctx, userID, workoutID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListFollowees indicates an expected call of ListFollowees.
-func (mr *MockuserMethodsMockRecorder) ListFollowees(ctx, userID any, opts ...any) *gomock.Call {
+// DeleteWorkoutReaction indicates an expected call of DeleteWorkoutReaction.
+func (mr *MockreactionMethodsMockRecorder) DeleteWorkoutReaction(ctx, userID, workoutID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, userID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowees", reflect.TypeOf((*MockuserMethods)(nil).ListFollowees), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkoutReaction", reflect.TypeOf((*MockreactionMethods)(nil).DeleteWorkoutReaction), ctx, userID, workoutID)
 }
 
-// ListFollowers mocks base method.
-func (m *MockuserMethods) ListFollowers(ctx context.Context, userID string, opts ...ListFollowersOpt) (orm.UserSlice, error) {
+// GetWorkoutReaction mocks base method.
+func (m *MockreactionMethods) GetWorkoutReaction(ctx context.Context, opts ...GetWorkoutReactionOpt) (*orm.WorkoutReaction, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, userID}
+	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListFollowers", varargs...)
-	ret0, _ := ret[0].(orm.UserSlice)
+	ret := m.ctrl.Call(m, "GetWorkoutReaction", varargs...)
+	ret0, _ := ret[0].(*orm.WorkoutReaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListFollowers indicates an expected call of ListFollowers.
-func (mr *MockuserMethodsMockRecorder) ListFollowers(ctx, userID any, opts ...any) *gomock.Call {
+// GetWorkoutReaction indicates an expected call of GetWorkoutReaction.
+func (mr *MockreactionMethodsMockRecorder) GetWorkoutReaction(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, userID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowers", reflect.TypeOf((*MockuserMethods)(nil).ListFollowers), varargs...)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkoutReaction", reflect.TypeOf((*MockreactionMethods)(nil).GetWorkoutReaction), varargs...)
 }
 
-// ListUsers mocks base method.
-func (m *MockuserMethods) ListUsers(ctx context.Context, opts ...ListUsersOpt) (orm.UserSlice, error) {
+// ListWorkoutReactions mocks base method.
+func (m *MockreactionMethods) ListWorkoutReactions(ctx context.Context, opts ...ListWorkoutReactionsOpt) (orm.WorkoutReactionSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListUsers", varargs...)
-	ret0, _ := ret[0].(orm.UserSlice)
+	ret := m.ctrl.Call(m, "ListWorkoutReactions", varargs...)
+	ret0, _ := ret[0].(orm.WorkoutReactionSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListUsers indicates an expected call of ListUsers.
-func (mr *MockuserMethodsMockRecorder) ListUsers(ctx any, opts ...any) *gomock.Call {
+// ListWorkoutReactions indicates an expected call of ListWorkoutReactions.
+func (mr *MockreactionMethodsMockRecorder) ListWorkoutReactions(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockuserMethods)(nil).ListUsers), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkoutReactions", reflect.TypeOf((*MockreactionMethods)(nil).ListWorkoutReactions), varargs...)
 }
 
-// Unfollow mocks base method.
-func (m *MockuserMethods) Unfollow(ctx context.Context, p UnfollowParams) error {
+// UpsertWorkoutReaction mocks base method.
+func (m *MockreactionMethods) UpsertWorkoutReaction(ctx context.Context, p UpsertWorkoutReactionParams) (*orm.WorkoutReaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Unfollow", ctx, p)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpsertWorkoutReaction", ctx, p)
+	ret0, _ := ret[0].(*orm.WorkoutReaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Unfollow indicates an expected call of Unfollow.
-func (mr *MockuserMethodsMockRecorder) Unfollow(ctx, p any) *gomock.Call {
+// UpsertWorkoutReaction indicates an expected call of UpsertWorkoutReaction.
+func (mr *MockreactionMethodsMockRecorder) UpsertWorkoutReaction(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockuserMethods)(nil).Unfollow), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertWorkoutReaction", reflect.TypeOf((*MockreactionMethods)(nil).UpsertWorkoutReaction), ctx, p)
 }
 
-// MocktraceMethods is a mock of traceMethods interface.
-type MocktraceMethods struct {
+// MockfollowRequestMethods is a mock of followRequestMethods interface.
+type MockfollowRequestMethods struct {
 	ctrl     *gomock.Controller
-	recorder *MocktraceMethodsMockRecorder
+	recorder *MockfollowRequestMethodsMockRecorder
 	isgomock struct{}
 }
 
-// MocktraceMethodsMockRecorder is the mock recorder for MocktraceMethods.
-type MocktraceMethodsMockRecorder struct {
-	mock *MocktraceMethods
+// MockfollowRequestMethodsMockRecorder is the mock recorder for MockfollowRequestMethods.
+type MockfollowRequestMethodsMockRecorder struct {
+	mock *MockfollowRequestMethods
 }
 
-// NewMocktraceMethods creates a new mock instance.
-func NewMocktraceMethods(ctrl *gomock.Controller) *MocktraceMethods {
-	mock := &MocktraceMethods{ctrl: ctrl}
-	mock.recorder = &MocktraceMethodsMockRecorder{mock}
+// NewMockfollowRequestMethods creates a new mock instance.
+func NewMockfollowRequestMethods(ctrl *gomock.Controller) *MockfollowRequestMethods {
+	mock := &MockfollowRequestMethods{ctrl: ctrl}
+	mock.recorder = &MockfollowRequestMethodsMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocktraceMethods) EXPECT() *MocktraceMethodsMockRecorder {
+func (m *MockfollowRequestMethods) EXPECT() *MockfollowRequestMethodsMockRecorder {
 	return m.recorder
 }
 
-// StoreTrace mocks base method.
-func (m *MocktraceMethods) StoreTrace(ctx context.Context, p StoreTraceParams) error {
+// ApproveFollowRequest mocks base method.
+func (m *MockfollowRequestMethods) ApproveFollowRequest(ctx context.Context, p FollowParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StoreTrace", ctx, p)
+	ret := m.ctrl.Call(m, "ApproveFollowRequest", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// StoreTrace indicates an expected call of StoreTrace.
-func (mr *MocktraceMethodsMockRecorder) StoreTrace(ctx, p any) *gomock.Call {
+// ApproveFollowRequest indicates an expected call of ApproveFollowRequest.
+func (mr *MockfollowRequestMethodsMockRecorder) ApproveFollowRequest(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreTrace", reflect.TypeOf((*MocktraceMethods)(nil).StoreTrace), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveFollowRequest", reflect.TypeOf((*MockfollowRequestMethods)(nil).ApproveFollowRequest), ctx, p)
 }
 
-// MockroutineMethods is a mock of routineMethods interface.
-type MockroutineMethods struct {
+// ApproveFollowRequests mocks base method.
+func (m *MockfollowRequestMethods) ApproveFollowRequests(ctx context.Context, followeeID string) (orm.FollowRequestSlice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveFollowRequests", ctx, followeeID)
+	ret0, _ := ret[0].(orm.FollowRequestSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveFollowRequests indicates an expected call of ApproveFollowRequests.
+func (mr *MockfollowRequestMethodsMockRecorder) ApproveFollowRequests(ctx, followeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveFollowRequests", reflect.TypeOf((*MockfollowRequestMethods)(nil).ApproveFollowRequests), ctx, followeeID)
+}
+
+// CreateFollowRequest mocks base method.
+func (m *MockfollowRequestMethods) CreateFollowRequest(ctx context.Context, p FollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFollowRequest", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateFollowRequest indicates an expected call of CreateFollowRequest.
+func (mr *MockfollowRequestMethodsMockRecorder) CreateFollowRequest(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFollowRequest", reflect.TypeOf((*MockfollowRequestMethods)(nil).CreateFollowRequest), ctx, p)
+}
+
+// DeleteFollowRequest mocks base method.
+func (m *MockfollowRequestMethods) DeleteFollowRequest(ctx context.Context, p FollowParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFollowRequest", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFollowRequest indicates an expected call of DeleteFollowRequest.
+func (mr *MockfollowRequestMethodsMockRecorder) DeleteFollowRequest(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFollowRequest", reflect.TypeOf((*MockfollowRequestMethods)(nil).DeleteFollowRequest), ctx, p)
+}
+
+// ListFollowRequests mocks base method.
+func (m *MockfollowRequestMethods) ListFollowRequests(ctx context.Context, opts ...ListFollowRequestsOpt) (orm.FollowRequestSlice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFollowRequests", varargs...)
+	ret0, _ := ret[0].(orm.FollowRequestSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFollowRequests indicates an expected call of ListFollowRequests.
+func (mr *MockfollowRequestMethodsMockRecorder) ListFollowRequests(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFollowRequests", reflect.TypeOf((*MockfollowRequestMethods)(nil).ListFollowRequests), varargs...)
+}
+
+// MockblockMethods is a mock of blockMethods interface.
+type MockblockMethods struct {
 	ctrl     *gomock.Controller
-	recorder *MockroutineMethodsMockRecorder
+	recorder *MockblockMethodsMockRecorder
 	isgomock struct{}
 }
 
-// MockroutineMethodsMockRecorder is the mock recorder for MockroutineMethods.
-type MockroutineMethodsMockRecorder struct {
-	mock *MockroutineMethods
+// MockblockMethodsMockRecorder is the mock recorder for MockblockMethods.
+type MockblockMethodsMockRecorder struct {
+	mock *MockblockMethods
 }
 
-// NewMockroutineMethods creates a new mock instance.
-func NewMockroutineMethods(ctrl *gomock.Controller) *MockroutineMethods {
-	mock := &MockroutineMethods{ctrl: ctrl}
-	mock.recorder = &MockroutineMethodsMockRecorder{mock}
+// NewMockblockMethods creates a new mock instance.
+func NewMockblockMethods(ctrl *gomock.Controller) *MockblockMethods {
+	mock := &MockblockMethods{ctrl: ctrl}
+	mock.recorder = &MockblockMethodsMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockroutineMethods) EXPECT() *MockroutineMethodsMockRecorder {
+func (m *MockblockMethods) EXPECT() *MockblockMethodsMockRecorder {
 	return m.recorder
 }
 
-// AddExerciseToRoutine mocks base method.
-func (m *MockroutineMethods) AddExerciseToRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
+// BlockUser mocks base method.
+func (m *MockblockMethods) BlockUser(ctx context.Context, p BlockParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddExerciseToRoutine", ctx, exercise, routine)
+	ret := m.ctrl.Call(m, "BlockUser", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddExerciseToRoutine indicates an expected call of AddExerciseToRoutine.
-func (mr *MockroutineMethodsMockRecorder) AddExerciseToRoutine(ctx, exercise, routine any) *gomock.Call {
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockblockMethodsMockRecorder) BlockUser(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddExerciseToRoutine", reflect.TypeOf((*MockroutineMethods)(nil).AddExerciseToRoutine), ctx, exercise, routine)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockblockMethods)(nil).BlockUser), ctx, p)
 }
 
-// CreateRoutine mocks base method.
-func (m *MockroutineMethods) CreateRoutine(ctx context.Context, p CreateRoutineParams) (*orm.Routine, error) {
+// IsBlocked mocks base method.
+func (m *MockblockMethods) IsBlocked(ctx context.Context, userID string, otherUserIDs ...string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRoutine", ctx, p)
-	ret0, _ := ret[0].(*orm.Routine)
+	varargs := []any{ctx, userID}
+	for _, a := range otherUserIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IsBlocked", varargs...)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateRoutine indicates an expected call of CreateRoutine.
-func (mr *MockroutineMethodsMockRecorder) CreateRoutine(ctx, p any) *gomock.Call {
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockblockMethodsMockRecorder) IsBlocked(ctx, userID any, otherUserIDs ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoutine", reflect.TypeOf((*MockroutineMethods)(nil).CreateRoutine), ctx, p)
+	varargs := append([]any{ctx, userID}, otherUserIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockblockMethods)(nil).IsBlocked), varargs...)
 }
 
-// DeleteRoutine mocks base method.
-func (m *MockroutineMethods) DeleteRoutine(ctx context.Context, routineID string) error {
+// ListBlockedUsers mocks base method.
+func (m *MockblockMethods) ListBlockedUsers(ctx context.Context, userID string) (orm.UserSlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRoutine", ctx, routineID)
+	ret := m.ctrl.Call(m, "ListBlockedUsers", ctx, userID)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlockedUsers indicates an expected call of ListBlockedUsers.
+func (mr *MockblockMethodsMockRecorder) ListBlockedUsers(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlockedUsers", reflect.TypeOf((*MockblockMethods)(nil).ListBlockedUsers), ctx, userID)
+}
+
+// UnblockUser mocks base method.
+func (m *MockblockMethods) UnblockUser(ctx context.Context, p BlockParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockUser", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteRoutine indicates an expected call of DeleteRoutine.
-func (mr *MockroutineMethodsMockRecorder) DeleteRoutine(ctx, routineID any) *gomock.Call {
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockblockMethodsMockRecorder) UnblockUser(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoutine", reflect.TypeOf((*MockroutineMethods)(nil).DeleteRoutine), ctx, routineID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockblockMethods)(nil).UnblockUser), ctx, p)
 }
 
-// GetRoutine mocks base method.
-func (m *MockroutineMethods) GetRoutine(ctx context.Context, opts ...GetRoutineOpt) (*orm.Routine, error) {
+// MockreportMethods is a mock of reportMethods interface.
+type MockreportMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MockreportMethodsMockRecorder
+	isgomock struct{}
+}
+
+// MockreportMethodsMockRecorder is the mock recorder for MockreportMethods.
+type MockreportMethodsMockRecorder struct {
+	mock *MockreportMethods
+}
+
+// NewMockreportMethods creates a new mock instance.
+func NewMockreportMethods(ctrl *gomock.Controller) *MockreportMethods {
+	mock := &MockreportMethods{ctrl: ctrl}
+	mock.recorder = &MockreportMethodsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreportMethods) EXPECT() *MockreportMethodsMockRecorder {
+	return m.recorder
+}
+
+// CreateReport mocks base method.
+func (m *MockreportMethods) CreateReport(ctx context.Context, p CreateReportParams) (*orm.Report, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetRoutine", varargs...)
-	ret0, _ := ret[0].(*orm.Routine)
+	ret := m.ctrl.Call(m, "CreateReport", ctx, p)
+	ret0, _ := ret[0].(*orm.Report)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRoutine indicates an expected call of GetRoutine.
-func (mr *MockroutineMethodsMockRecorder) GetRoutine(ctx any, opts ...any) *gomock.Call {
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockreportMethodsMockRecorder) CreateReport(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutine", reflect.TypeOf((*MockroutineMethods)(nil).GetRoutine), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockreportMethods)(nil).CreateReport), ctx, p)
 }
 
-// ListRoutines mocks base method.
-func (m *MockroutineMethods) ListRoutines(ctx context.Context, opts ...ListRoutineOpt) (orm.RoutineSlice, error) {
+// GetReport mocks base method.
+func (m *MockreportMethods) GetReport(ctx context.Context, opts ...GetReportOpt) (*orm.Report, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListRoutines", varargs...)
-	ret0, _ := ret[0].(orm.RoutineSlice)
+	ret := m.ctrl.Call(m, "GetReport", varargs...)
+	ret0, _ := ret[0].(*orm.Report)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRoutines indicates an expected call of ListRoutines.
-func (mr *MockroutineMethodsMockRecorder) ListRoutines(ctx any, opts ...any) *gomock.Call {
+// GetReport indicates an expected call of GetReport.
+func (mr *MockreportMethodsMockRecorder) GetReport(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutines", reflect.TypeOf((*MockroutineMethods)(nil).ListRoutines), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockreportMethods)(nil).GetReport), varargs...)
 }
 
-// RemoveExerciseFromRoutine mocks base method.
-func (m *MockroutineMethods) RemoveExerciseFromRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
+// HideWorkout mocks base method.
+func (m *MockreportMethods) HideWorkout(ctx context.Context, workoutID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveExerciseFromRoutine", ctx, exercise, routine)
+	ret := m.ctrl.Call(m, "HideWorkout", ctx, workoutID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RemoveExerciseFromRoutine indicates an expected call of RemoveExerciseFromRoutine.
-func (mr *MockroutineMethodsMockRecorder) RemoveExerciseFromRoutine(ctx, exercise, routine any) *gomock.Call {
+// HideWorkout indicates an expected call of HideWorkout.
+func (mr *MockreportMethodsMockRecorder) HideWorkout(ctx, workoutID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveExerciseFromRoutine", reflect.TypeOf((*MockroutineMethods)(nil).RemoveExerciseFromRoutine), ctx, exercise, routine)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideWorkout", reflect.TypeOf((*MockreportMethods)(nil).HideWorkout), ctx, workoutID)
 }
 
-// SetRoutineExercises mocks base method.
-func (m *MockroutineMethods) SetRoutineExercises(ctx context.Context, routine *orm.Routine, exercises orm.ExerciseSlice) error {
+// HideWorkoutComment mocks base method.
+func (m *MockreportMethods) HideWorkoutComment(ctx context.Context, commentID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetRoutineExercises", ctx, routine, exercises)
+	ret := m.ctrl.Call(m, "HideWorkoutComment", ctx, commentID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SetRoutineExercises indicates an expected call of SetRoutineExercises.
-func (mr *MockroutineMethodsMockRecorder) SetRoutineExercises(ctx, routine, exercises any) *gomock.Call {
+// HideWorkoutComment indicates an expected call of HideWorkoutComment.
+func (mr *MockreportMethodsMockRecorder) HideWorkoutComment(ctx, commentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoutineExercises", reflect.TypeOf((*MockroutineMethods)(nil).SetRoutineExercises), ctx, routine, exercises)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideWorkoutComment", reflect.TypeOf((*MockreportMethods)(nil).HideWorkoutComment), ctx, commentID)
 }
 
-// UpdateRoutine mocks base method.
-func (m *MockroutineMethods) UpdateRoutine(ctx context.Context, routineID string, opts ...UpdateRoutineOpt) error {
+// ListReports mocks base method.
+func (m *MockreportMethods) ListReports(ctx context.Context, opts ...ListReportsOpt) (orm.ReportSlice, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, routineID}
+	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateRoutine", varargs...)
+	ret := m.ctrl.Call(m, "ListReports", varargs...)
+	ret0, _ := ret[0].(orm.ReportSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReports indicates an expected call of ListReports.
+func (mr *MockreportMethodsMockRecorder) ListReports(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReports", reflect.TypeOf((*MockreportMethods)(nil).ListReports), varargs...)
+}
+
+// ResolveReport mocks base method.
+func (m *MockreportMethods) ResolveReport(ctx context.Context, p ResolveReportParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReport", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateRoutine indicates an expected call of UpdateRoutine.
-func (mr *MockroutineMethodsMockRecorder) UpdateRoutine(ctx, routineID any, opts ...any) *gomock.Call {
+// ResolveReport indicates an expected call of ResolveReport.
+func (mr *MockreportMethodsMockRecorder) ResolveReport(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, routineID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoutine", reflect.TypeOf((*MockroutineMethods)(nil).UpdateRoutine), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReport", reflect.TypeOf((*MockreportMethods)(nil).ResolveReport), ctx, p)
 }
 
-// MockworkoutMethods is a mock of workoutMethods interface.
-type MockworkoutMethods struct {
-	ctrl     *gomock.Controller
-	recorder *MockworkoutMethodsMockRecorder
-	isgomock struct{}
+// SuspendUser mocks base method.
+func (m *MockreportMethods) SuspendUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockworkoutMethodsMockRecorder is the mock recorder for MockworkoutMethods.
-type MockworkoutMethodsMockRecorder struct {
-	mock *MockworkoutMethods
+// SuspendUser indicates an expected call of SuspendUser.
+func (mr *MockreportMethodsMockRecorder) SuspendUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendUser", reflect.TypeOf((*MockreportMethods)(nil).SuspendUser), ctx, userID)
 }
 
-// NewMockworkoutMethods creates a new mock instance.
-func NewMockworkoutMethods(ctrl *gomock.Controller) *MockworkoutMethods {
-	mock := &MockworkoutMethods{ctrl: ctrl}
-	mock.recorder = &MockworkoutMethodsMockRecorder{mock}
-	return mock
+// MockchallengeMethods is a mock of challengeMethods interface.
+type MockchallengeMethods struct {
+	ctrl     *gomock.Controller
+	recorder *MockchallengeMethodsMockRecorder
+	isgomock struct{}
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockworkoutMethods) EXPECT() *MockworkoutMethodsMockRecorder {
-	return m.recorder
+// MockchallengeMethodsMockRecorder is the mock recorder for MockchallengeMethods.
+type MockchallengeMethodsMockRecorder struct {
+	mock *MockchallengeMethods
 }
-
-// CreateWorkout mocks base method.
-func (m *MockworkoutMethods) CreateWorkout(ctx context.Context, p CreateWorkoutParams) (*orm.Workout, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateWorkout", ctx, p)
-	ret0, _ := ret[0].(*orm.Workout)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+
+// NewMockchallengeMethods creates a new mock instance.
+func NewMockchallengeMethods(ctrl *gomock.Controller) *MockchallengeMethods {
+	mock := &MockchallengeMethods{ctrl: ctrl}
+	mock.recorder = &MockchallengeMethodsMockRecorder{mock}
+	return mock
 }
 
-// CreateWorkout indicates an expected call of CreateWorkout.
-func (mr *MockworkoutMethodsMockRecorder) CreateWorkout(ctx, p any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkout", reflect.TypeOf((*MockworkoutMethods)(nil).CreateWorkout), ctx, p)
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockchallengeMethods) EXPECT() *MockchallengeMethodsMockRecorder {
+	return m.recorder
 }
 
-// CreateWorkoutComment mocks base method.
-func (m *MockworkoutMethods) CreateWorkoutComment(ctx context.Context, p CreateWorkoutCommentParams, opts ...CreateWorkoutCommentOpts) (*orm.WorkoutComment, error) {
+// CreateChallenge mocks base method.
+func (m *MockchallengeMethods) CreateChallenge(ctx context.Context, p CreateChallengeParams) (*orm.Challenge, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, p}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "CreateWorkoutComment", varargs...)
-	ret0, _ := ret[0].(*orm.WorkoutComment)
+	ret := m.ctrl.Call(m, "CreateChallenge", ctx, p)
+	ret0, _ := ret[0].(*orm.Challenge)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateWorkoutComment indicates an expected call of CreateWorkoutComment.
-func (mr *MockworkoutMethodsMockRecorder) CreateWorkoutComment(ctx, p any, opts ...any) *gomock.Call {
+// CreateChallenge indicates an expected call of CreateChallenge.
+func (mr *MockchallengeMethodsMockRecorder) CreateChallenge(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, p}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkoutComment", reflect.TypeOf((*MockworkoutMethods)(nil).CreateWorkoutComment), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChallenge", reflect.TypeOf((*MockchallengeMethods)(nil).CreateChallenge), ctx, p)
 }
 
-// DeleteWorkout mocks base method.
-func (m *MockworkoutMethods) DeleteWorkout(ctx context.Context, opts ...DeleteWorkoutOpt) error {
+// GetChallenge mocks base method.
+func (m *MockchallengeMethods) GetChallenge(ctx context.Context, opts ...GetChallengeOpt) (*orm.Challenge, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "DeleteWorkout", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetChallenge", varargs...)
+	ret0, _ := ret[0].(*orm.Challenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteWorkout indicates an expected call of DeleteWorkout.
-func (mr *MockworkoutMethodsMockRecorder) DeleteWorkout(ctx any, opts ...any) *gomock.Call {
+// GetChallenge indicates an expected call of GetChallenge.
+func (mr *MockchallengeMethodsMockRecorder) GetChallenge(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkout", reflect.TypeOf((*MockworkoutMethods)(nil).DeleteWorkout), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChallenge", reflect.TypeOf((*MockchallengeMethods)(nil).GetChallenge), varargs...)
 }
 
-// GetWorkout mocks base method.
-func (m *MockworkoutMethods) GetWorkout(ctx context.Context, opts ...GetWorkoutOpt) (*orm.Workout, error) {
+// GetChallengeStandings mocks base method.
+func (m *MockchallengeMethods) GetChallengeStandings(ctx context.Context, challengeID string) ([]ChallengeStanding, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetWorkout", varargs...)
-	ret0, _ := ret[0].(*orm.Workout)
+	ret := m.ctrl.Call(m, "GetChallengeStandings", ctx, challengeID)
+	ret0, _ := ret[0].([]ChallengeStanding)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWorkout indicates an expected call of GetWorkout.
-func (mr *MockworkoutMethodsMockRecorder) GetWorkout(ctx any, opts ...any) *gomock.Call {
+// GetChallengeStandings indicates an expected call of GetChallengeStandings.
+func (mr *MockchallengeMethodsMockRecorder) GetChallengeStandings(ctx, challengeID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkout", reflect.TypeOf((*MockworkoutMethods)(nil).GetWorkout), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChallengeStandings", reflect.TypeOf((*MockchallengeMethods)(nil).GetChallengeStandings), ctx, challengeID)
 }
 
-// GetWorkoutComment mocks base method.
-func (m *MockworkoutMethods) GetWorkoutComment(ctx context.Context, opts ...GetWorkoutCommentOpt) (*orm.WorkoutComment, error) {
+// InviteToChallenge mocks base method.
+func (m *MockchallengeMethods) InviteToChallenge(ctx context.Context, challengeID string, userIDs []string) ([]string, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetWorkoutComment", varargs...)
-	ret0, _ := ret[0].(*orm.WorkoutComment)
+	ret := m.ctrl.Call(m, "InviteToChallenge", ctx, challengeID, userIDs)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetWorkoutComment indicates an expected call of GetWorkoutComment.
-func (mr *MockworkoutMethodsMockRecorder) GetWorkoutComment(ctx any, opts ...any) *gomock.Call {
+// InviteToChallenge indicates an expected call of InviteToChallenge.
+func (mr *MockchallengeMethodsMockRecorder) InviteToChallenge(ctx, challengeID, userIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkoutComment", reflect.TypeOf((*MockworkoutMethods)(nil).GetWorkoutComment), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteToChallenge", reflect.TypeOf((*MockchallengeMethods)(nil).InviteToChallenge), ctx, challengeID, userIDs)
 }
 
-// ListWorkouts mocks base method.
-func (m *MockworkoutMethods) ListWorkouts(ctx context.Context, opts ...ListWorkoutsOpt) (orm.WorkoutSlice, error) {
+// JoinChallenge mocks base method.
+func (m *MockchallengeMethods) JoinChallenge(ctx context.Context, challengeID, userID string) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ListWorkouts", varargs...)
-	ret0, _ := ret[0].(orm.WorkoutSlice)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "JoinChallenge", ctx, challengeID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ListWorkouts indicates an expected call of ListWorkouts.
-func (mr *MockworkoutMethodsMockRecorder) ListWorkouts(ctx any, opts ...any) *gomock.Call {
+// JoinChallenge indicates an expected call of JoinChallenge.
+func (mr *MockchallengeMethodsMockRecorder) JoinChallenge(ctx, challengeID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkouts", reflect.TypeOf((*MockworkoutMethods)(nil).ListWorkouts), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChallenge", reflect.TypeOf((*MockchallengeMethods)(nil).JoinChallenge), ctx, challengeID, userID)
 }
 
-// PostCreateWorkoutCommentLoadUser mocks base method.
-func (m *MockworkoutMethods) PostCreateWorkoutCommentLoadUser(ctx context.Context) CreateWorkoutCommentOpts {
+// LeaveChallenge mocks base method.
+func (m *MockchallengeMethods) LeaveChallenge(ctx context.Context, challengeID, userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PostCreateWorkoutCommentLoadUser", ctx)
-	ret0, _ := ret[0].(CreateWorkoutCommentOpts)
+	ret := m.ctrl.Call(m, "LeaveChallenge", ctx, challengeID, userID)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PostCreateWorkoutCommentLoadUser indicates an expected call of PostCreateWorkoutCommentLoadUser.
-func (mr *MockworkoutMethodsMockRecorder) PostCreateWorkoutCommentLoadUser(ctx any) *gomock.Call {
+// LeaveChallenge indicates an expected call of LeaveChallenge.
+func (mr *MockchallengeMethodsMockRecorder) LeaveChallenge(ctx, challengeID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostCreateWorkoutCommentLoadUser", reflect.TypeOf((*MockworkoutMethods)(nil).PostCreateWorkoutCommentLoadUser), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveChallenge", reflect.TypeOf((*MockchallengeMethods)(nil).LeaveChallenge), ctx, challengeID, userID)
 }
 
-// UpdateWorkout mocks base method.
-func (m *MockworkoutMethods) UpdateWorkout(ctx context.Context, workoutID string, opts ...UpdateWorkoutOpt) error {
+// ListChallenges mocks base method.
+func (m *MockchallengeMethods) ListChallenges(ctx context.Context, opts ...ListChallengesOpt) (orm.ChallengeSlice, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, workoutID}
+	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateWorkout", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListChallenges", varargs...)
+	ret0, _ := ret[0].(orm.ChallengeSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateWorkout indicates an expected call of UpdateWorkout.
-func (mr *MockworkoutMethodsMockRecorder) UpdateWorkout(ctx, workoutID any, opts ...any) *gomock.Call {
+// ListChallenges indicates an expected call of ListChallenges.
+func (mr *MockchallengeMethodsMockRecorder) ListChallenges(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, workoutID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkout", reflect.TypeOf((*MockworkoutMethods)(nil).UpdateWorkout), varargs...)
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChallenges", reflect.TypeOf((*MockchallengeMethods)(nil).ListChallenges), varargs...)
 }
 
-// UpdateWorkoutSets mocks base method.
-func (m *MockworkoutMethods) UpdateWorkoutSets(ctx context.Context, p UpdateWorkoutSetsParams) error {
+// UpdateChallenge mocks base method.
+func (m *MockchallengeMethods) UpdateChallenge(ctx context.Context, challengeID string, opts ...UpdateChallengeOpt) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateWorkoutSets", ctx, p)
+	varargs := []any{ctx, challengeID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateChallenge", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateWorkoutSets indicates an expected call of UpdateWorkoutSets.
-func (mr *MockworkoutMethodsMockRecorder) UpdateWorkoutSets(ctx, p any) *gomock.Call {
+// UpdateChallenge indicates an expected call of UpdateChallenge.
+func (mr *MockchallengeMethodsMockRecorder) UpdateChallenge(ctx, challengeID any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkoutSets", reflect.TypeOf((*MockworkoutMethods)(nil).UpdateWorkoutSets), ctx, p)
+	varargs := append([]any{ctx, challengeID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChallenge", reflect.TypeOf((*MockchallengeMethods)(nil).UpdateChallenge), varargs...)
 }
 
-// MockexerciseMethods is a mock of exerciseMethods interface.
-type MockexerciseMethods struct {
+// MockclubMethods is a mock of clubMethods interface.
+type MockclubMethods struct {
 	ctrl     *gomock.Controller
-	recorder *MockexerciseMethodsMockRecorder
+	recorder *MockclubMethodsMockRecorder
 	isgomock struct{}
 }
 
-// MockexerciseMethodsMockRecorder is the mock recorder for MockexerciseMethods.
-type MockexerciseMethodsMockRecorder struct {
-	mock *MockexerciseMethods
+// MockclubMethodsMockRecorder is the mock recorder for MockclubMethods.
+type MockclubMethodsMockRecorder struct {
+	mock *MockclubMethods
 }
 
-// NewMockexerciseMethods creates a new mock instance.
-func NewMockexerciseMethods(ctrl *gomock.Controller) *MockexerciseMethods {
-	mock := &MockexerciseMethods{ctrl: ctrl}
-	mock.recorder = &MockexerciseMethodsMockRecorder{mock}
+// NewMockclubMethods creates a new mock instance.
+func NewMockclubMethods(ctrl *gomock.Controller) *MockclubMethods {
+	mock := &MockclubMethods{ctrl: ctrl}
+	mock.recorder = &MockclubMethodsMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockexerciseMethods) EXPECT() *MockexerciseMethodsMockRecorder {
+func (m *MockclubMethods) EXPECT() *MockclubMethodsMockRecorder {
 	return m.recorder
 }
 
-// CreateExercise mocks base method.
-func (m *MockexerciseMethods) CreateExercise(ctx context.Context, p CreateExerciseParams) (*orm.Exercise, error) {
+// CreateClub mocks base method.
+func (m *MockclubMethods) CreateClub(ctx context.Context, p CreateClubParams) (*orm.Club, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateExercise", ctx, p)
-	ret0, _ := ret[0].(*orm.Exercise)
+	ret := m.ctrl.Call(m, "CreateClub", ctx, p)
+	ret0, _ := ret[0].(*orm.Club)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateExercise indicates an expected call of CreateExercise.
-func (mr *MockexerciseMethodsMockRecorder) CreateExercise(ctx, p any) *gomock.Call {
+// CreateClub indicates an expected call of CreateClub.
+func (mr *MockclubMethodsMockRecorder) CreateClub(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExercise", reflect.TypeOf((*MockexerciseMethods)(nil).CreateExercise), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClub", reflect.TypeOf((*MockclubMethods)(nil).CreateClub), ctx, p)
 }
 
-// GetExercise mocks base method.
-func (m *MockexerciseMethods) GetExercise(ctx context.Context, opts ...GetExerciseOpt) (*orm.Exercise, error) {
+// CreateClubMember mocks base method.
+func (m *MockclubMethods) CreateClubMember(ctx context.Context, p CreateClubMemberParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClubMember", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateClubMember indicates an expected call of CreateClubMember.
+func (mr *MockclubMethodsMockRecorder) CreateClubMember(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClubMember", reflect.TypeOf((*MockclubMethods)(nil).CreateClubMember), ctx, p)
+}
+
+// DeleteClubMember mocks base method.
+func (m *MockclubMethods) DeleteClubMember(ctx context.Context, clubID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClubMember", ctx, clubID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClubMember indicates an expected call of DeleteClubMember.
+func (mr *MockclubMethodsMockRecorder) DeleteClubMember(ctx, clubID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClubMember", reflect.TypeOf((*MockclubMethods)(nil).DeleteClubMember), ctx, clubID, userID)
+}
+
+// GetClub mocks base method.
+func (m *MockclubMethods) GetClub(ctx context.Context, opts ...GetClubOpt) (*orm.Club, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetExercise", varargs...)
-	ret0, _ := ret[0].(*orm.Exercise)
+	ret := m.ctrl.Call(m, "GetClub", varargs...)
+	ret0, _ := ret[0].(*orm.Club)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetExercise indicates an expected call of GetExercise.
-func (mr *MockexerciseMethodsMockRecorder) GetExercise(ctx any, opts ...any) *gomock.Call {
+// GetClub indicates an expected call of GetClub.
+func (mr *MockclubMethodsMockRecorder) GetClub(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExercise", reflect.TypeOf((*MockexerciseMethods)(nil).GetExercise), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClub", reflect.TypeOf((*MockclubMethods)(nil).GetClub), varargs...)
 }
 
-// ListExercises mocks base method.
-func (m *MockexerciseMethods) ListExercises(ctx context.Context, opts ...ListExercisesOpt) (orm.ExerciseSlice, error) {
+// GetClubMember mocks base method.
+func (m *MockclubMethods) GetClubMember(ctx context.Context, clubID, userID string) (*orm.ClubMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClubMember", ctx, clubID, userID)
+	ret0, _ := ret[0].(*orm.ClubMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClubMember indicates an expected call of GetClubMember.
+func (mr *MockclubMethodsMockRecorder) GetClubMember(ctx, clubID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClubMember", reflect.TypeOf((*MockclubMethods)(nil).GetClubMember), ctx, clubID, userID)
+}
+
+// ListClubMembers mocks base method.
+func (m *MockclubMethods) ListClubMembers(ctx context.Context, opts ...ListClubMembersOpt) (orm.ClubMemberSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListExercises", varargs...)
-	ret0, _ := ret[0].(orm.ExerciseSlice)
+	ret := m.ctrl.Call(m, "ListClubMembers", varargs...)
+	ret0, _ := ret[0].(orm.ClubMemberSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListExercises indicates an expected call of ListExercises.
-func (mr *MockexerciseMethodsMockRecorder) ListExercises(ctx any, opts ...any) *gomock.Call {
+// ListClubMembers indicates an expected call of ListClubMembers.
+func (mr *MockclubMethodsMockRecorder) ListClubMembers(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExercises", reflect.TypeOf((*MockexerciseMethods)(nil).ListExercises), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClubMembers", reflect.TypeOf((*MockclubMethods)(nil).ListClubMembers), varargs...)
 }
 
-// SoftDeleteExercise mocks base method.
-func (m *MockexerciseMethods) SoftDeleteExercise(ctx context.Context, p SoftDeleteExerciseParams) error {
+// ListClubs mocks base method.
+func (m *MockclubMethods) ListClubs(ctx context.Context, opts ...ListClubsOpt) (orm.ClubSlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SoftDeleteExercise", ctx, p)
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClubs", varargs...)
+	ret0, _ := ret[0].(orm.ClubSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClubs indicates an expected call of ListClubs.
+func (mr *MockclubMethodsMockRecorder) ListClubs(ctx any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClubs", reflect.TypeOf((*MockclubMethods)(nil).ListClubs), varargs...)
+}
+
+// TransferClubOwnership mocks base method.
+func (m *MockclubMethods) TransferClubOwnership(ctx context.Context, p TransferClubOwnershipParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferClubOwnership", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SoftDeleteExercise indicates an expected call of SoftDeleteExercise.
-func (mr *MockexerciseMethodsMockRecorder) SoftDeleteExercise(ctx, p any) *gomock.Call {
+// TransferClubOwnership indicates an expected call of TransferClubOwnership.
+func (mr *MockclubMethodsMockRecorder) TransferClubOwnership(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDeleteExercise", reflect.TypeOf((*MockexerciseMethods)(nil).SoftDeleteExercise), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferClubOwnership", reflect.TypeOf((*MockclubMethods)(nil).TransferClubOwnership), ctx, p)
 }
 
-// UpdateExercise mocks base method.
-func (m *MockexerciseMethods) UpdateExercise(ctx context.Context, exerciseID string, opts ...UpdateExerciseOpt) error {
+// UpdateClubMember mocks base method.
+func (m *MockclubMethods) UpdateClubMember(ctx context.Context, clubID, userID string, opts ...UpdateClubMemberOpt) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, exerciseID}
+	varargs := []any{ctx, clubID, userID}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "UpdateExercise", varargs...)
+	ret := m.ctrl.Call(m, "UpdateClubMember", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateExercise indicates an expected call of UpdateExercise.
-func (mr *MockexerciseMethodsMockRecorder) UpdateExercise(ctx, exerciseID any, opts ...any) *gomock.Call {
+// UpdateClubMember indicates an expected call of UpdateClubMember.
+func (mr *MockclubMethodsMockRecorder) UpdateClubMember(ctx, clubID, userID any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, exerciseID}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExercise", reflect.TypeOf((*MockexerciseMethods)(nil).UpdateExercise), varargs...)
+	varargs := append([]any{ctx, clubID, userID}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClubMember", reflect.TypeOf((*MockclubMethods)(nil).UpdateClubMember), varargs...)
 }
 
-// MocknotificationMethods is a mock of notificationMethods interface.
-type MocknotificationMethods struct {
+// MockcoachMethods is a mock of coachMethods interface.
+type MockcoachMethods struct {
 	ctrl     *gomock.Controller
-	recorder *MocknotificationMethodsMockRecorder
+	recorder *MockcoachMethodsMockRecorder
 	isgomock struct{}
 }
 
-// MocknotificationMethodsMockRecorder is the mock recorder for MocknotificationMethods.
-type MocknotificationMethodsMockRecorder struct {
-	mock *MocknotificationMethods
+// MockcoachMethodsMockRecorder is the mock recorder for MockcoachMethods.
+type MockcoachMethodsMockRecorder struct {
+	mock *MockcoachMethods
 }
 
-// NewMocknotificationMethods creates a new mock instance.
-func NewMocknotificationMethods(ctrl *gomock.Controller) *MocknotificationMethods {
-	mock := &MocknotificationMethods{ctrl: ctrl}
-	mock.recorder = &MocknotificationMethodsMockRecorder{mock}
+// NewMockcoachMethods creates a new mock instance.
+func NewMockcoachMethods(ctrl *gomock.Controller) *MockcoachMethods {
+	mock := &MockcoachMethods{ctrl: ctrl}
+	mock.recorder = &MockcoachMethodsMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocknotificationMethods) EXPECT() *MocknotificationMethodsMockRecorder {
+func (m *MockcoachMethods) EXPECT() *MockcoachMethodsMockRecorder {
 	return m.recorder
 }
 
-// CountNotifications mocks base method.
-func (m *MocknotificationMethods) CountNotifications(ctx context.Context, opts ...CountNotificationsOpt) (int64, error) {
+// CreateCoachAction mocks base method.
+func (m *MockcoachMethods) CreateCoachAction(ctx context.Context, p CreateCoachActionParams) error {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "CountNotifications", varargs...)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CreateCoachAction", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CountNotifications indicates an expected call of CountNotifications.
-func (mr *MocknotificationMethodsMockRecorder) CountNotifications(ctx any, opts ...any) *gomock.Call {
+// CreateCoachAction indicates an expected call of CreateCoachAction.
+func (mr *MockcoachMethodsMockRecorder) CreateCoachAction(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNotifications", reflect.TypeOf((*MocknotificationMethods)(nil).CountNotifications), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCoachAction", reflect.TypeOf((*MockcoachMethods)(nil).CreateCoachAction), ctx, p)
 }
 
-// CreateNotification mocks base method.
-func (m *MocknotificationMethods) CreateNotification(ctx context.Context, p CreateNotificationParams) error {
+// GrantCoachAccess mocks base method.
+func (m *MockcoachMethods) GrantCoachAccess(ctx context.Context, p CoachingParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNotification", ctx, p)
+	ret := m.ctrl.Call(m, "GrantCoachAccess", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateNotification indicates an expected call of CreateNotification.
-func (mr *MocknotificationMethodsMockRecorder) CreateNotification(ctx, p any) *gomock.Call {
+// GrantCoachAccess indicates an expected call of GrantCoachAccess.
+func (mr *MockcoachMethodsMockRecorder) GrantCoachAccess(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MocknotificationMethods)(nil).CreateNotification), ctx, p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantCoachAccess", reflect.TypeOf((*MockcoachMethods)(nil).GrantCoachAccess), ctx, p)
 }
 
-// ListNotifications mocks base method.
-func (m *MocknotificationMethods) ListNotifications(ctx context.Context, opts ...ListNotificationsOpt) (orm.NotificationSlice, error) {
+// IsCoach mocks base method.
+func (m *MockcoachMethods) IsCoach(ctx context.Context, p CoachingParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCoach", ctx, p)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsCoach indicates an expected call of IsCoach.
+func (mr *MockcoachMethodsMockRecorder) IsCoach(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCoach", reflect.TypeOf((*MockcoachMethods)(nil).IsCoach), ctx, p)
+}
+
+// ListAthleteSummaries mocks base method.
+func (m *MockcoachMethods) ListAthleteSummaries(ctx context.Context, coachID string, now time.Time) ([]AthleteSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAthleteSummaries", ctx, coachID, now)
+	ret0, _ := ret[0].([]AthleteSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAthleteSummaries indicates an expected call of ListAthleteSummaries.
+func (mr *MockcoachMethodsMockRecorder) ListAthleteSummaries(ctx, coachID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAthleteSummaries", reflect.TypeOf((*MockcoachMethods)(nil).ListAthleteSummaries), ctx, coachID, now)
+}
+
+// ListCoachActions mocks base method.
+func (m *MockcoachMethods) ListCoachActions(ctx context.Context, opts ...ListCoachActionsOpt) (orm.CoachActionSlice, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "ListNotifications", varargs...)
-	ret0, _ := ret[0].(orm.NotificationSlice)
+	ret := m.ctrl.Call(m, "ListCoachActions", varargs...)
+	ret0, _ := ret[0].(orm.CoachActionSlice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListNotifications indicates an expected call of ListNotifications.
-func (mr *MocknotificationMethodsMockRecorder) ListNotifications(ctx any, opts ...any) *gomock.Call {
+// ListCoachActions indicates an expected call of ListCoachActions.
+func (mr *MockcoachMethodsMockRecorder) ListCoachActions(ctx any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotifications", reflect.TypeOf((*MocknotificationMethods)(nil).ListNotifications), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCoachActions", reflect.TypeOf((*MockcoachMethods)(nil).ListCoachActions), varargs...)
 }
 
-// MarkNotificationsAsRead mocks base method.
-func (m *MocknotificationMethods) MarkNotificationsAsRead(ctx context.Context, userID string) error {
+// ListCoaches mocks base method.
+func (m *MockcoachMethods) ListCoaches(ctx context.Context, athleteID string) (orm.UserSlice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkNotificationsAsRead", ctx, userID)
+	ret := m.ctrl.Call(m, "ListCoaches", ctx, athleteID)
+	ret0, _ := ret[0].(orm.UserSlice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCoaches indicates an expected call of ListCoaches.
+func (mr *MockcoachMethodsMockRecorder) ListCoaches(ctx, athleteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCoaches", reflect.TypeOf((*MockcoachMethods)(nil).ListCoaches), ctx, athleteID)
+}
+
+// RevokeCoachAccess mocks base method.
+func (m *MockcoachMethods) RevokeCoachAccess(ctx context.Context, p CoachingParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCoachAccess", ctx, p)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MarkNotificationsAsRead indicates an expected call of MarkNotificationsAsRead.
-func (mr *MocknotificationMethodsMockRecorder) MarkNotificationsAsRead(ctx, userID any) *gomock.Call {
+// RevokeCoachAccess indicates an expected call of RevokeCoachAccess.
+func (mr *MockcoachMethodsMockRecorder) RevokeCoachAccess(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsAsRead", reflect.TypeOf((*MocknotificationMethods)(nil).MarkNotificationsAsRead), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCoachAccess", reflect.TypeOf((*MockcoachMethods)(nil).RevokeCoachAccess), ctx, p)
 }
 
 // MockpubSubMethods is a mock of pubSubMethods interface.
@@ -1,10 +1,14 @@
 package repo
 
 import (
+	"cmp"
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"strings"
 	"time"
 
@@ -16,6 +20,8 @@ import (
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/crlssn/getstronger/server/gen/orm"
+	"github.com/crlssn/getstronger/server/mention"
+	"github.com/crlssn/getstronger/server/strength"
 )
 
 type order string
@@ -215,16 +221,18 @@ func (r *repo) CreateUser(ctx context.Context, p CreateUserParams) (*orm.User, e
 }
 
 type CreateExerciseParams struct {
-	UserID string
-	Name   string
-	Label  string
+	UserID          string
+	Name            string
+	Label           string
+	CatalogExercise orm.NullCatalogExercise
 }
 
 func (r *repo) CreateExercise(ctx context.Context, p CreateExerciseParams) (*orm.Exercise, error) {
 	exercise := &orm.Exercise{
-		UserID:   p.UserID,
-		Title:    p.Name,
-		SubTitle: null.NewString(p.Label, p.Label != ""),
+		UserID:          p.UserID,
+		Title:           p.Name,
+		SubTitle:        null.NewString(p.Label, p.Label != ""),
+		CatalogExercise: p.CatalogExercise,
 	}
 	if err := exercise.Insert(ctx, r.executor(), boil.Infer()); err != nil {
 		return nil, fmt.Errorf("exercise insert: %w", err)
@@ -249,15 +257,18 @@ func (r *repo) SoftDeleteExercise(ctx context.Context, p SoftDeleteExerciseParam
 			return fmt.Errorf("exercise fetch: %w", err)
 		}
 
+		memberships := make([]RoutineMembership, 0, len(exercise.R.Routines))
 		for _, routine := range exercise.R.Routines {
 			var exerciseIDs []string
 			if err = json.Unmarshal(routine.ExerciseOrder, &exerciseIDs); err != nil {
 				return fmt.Errorf("exercise order unmarshal: %w", err)
 			}
 
-			exerciseOrder := make([]string, 0, len(exerciseIDs)-1)
-			for _, exerciseID := range exerciseIDs {
+			position := len(exerciseIDs)
+			exerciseOrder := make([]string, 0, len(exerciseIDs))
+			for i, exerciseID := range exerciseIDs {
 				if exerciseID == exercise.ID {
+					position = i
 					continue
 				}
 				exerciseOrder = append(exerciseOrder, exerciseID)
@@ -266,12 +277,21 @@ func (r *repo) SoftDeleteExercise(ctx context.Context, p SoftDeleteExerciseParam
 			if err = tx.UpdateRoutine(ctx, routine.ID, UpdateRoutineExerciseOrder(exerciseOrder)); err != nil {
 				return fmt.Errorf("routine update: %w", err)
 			}
+
+			memberships = append(memberships, RoutineMembership{
+				RoutineID: routine.ID,
+				Position:  position,
+			})
 		}
 
 		if err = exercise.SetRoutines(ctx, tx.exec(), false); err != nil {
 			return fmt.Errorf("exercise routines set: %w", err)
 		}
 
+		if exercise.DeletedFromRoutines, err = json.Marshal(memberships); err != nil {
+			return fmt.Errorf("routine memberships marshal: %w", err)
+		}
+
 		exercise.DeletedAt = null.TimeFrom(time.Now().UTC())
 		if _, err = exercise.Update(ctx, tx.exec(), boil.Infer()); err != nil {
 			return fmt.Errorf("exercise soft delete: %w", err)
@@ -281,6 +301,250 @@ func (r *repo) SoftDeleteExercise(ctx context.Context, p SoftDeleteExerciseParam
 	})
 }
 
+// RoutineMembership records the routines a soft deleted exercise was removed
+// from, so that it can be re-added to them when restored.
+type RoutineMembership struct {
+	RoutineID string `json:"routineId"`
+	Position  int    `json:"position"`
+}
+
+type RestoreExerciseParams struct {
+	UserID     string
+	ExerciseID string
+}
+
+// RestoreExercise undoes a soft delete and re-adds the exercise to the
+// routines it was removed from, at its previous position when possible.
+// Routines that have been purged in the meantime are skipped.
+func (r *repo) RestoreExercise(ctx context.Context, p RestoreExerciseParams) error {
+	return r.NewTx(ctx, func(tx Tx) error {
+		exercise, err := orm.Exercises(
+			orm.ExerciseWhere.ID.EQ(p.ExerciseID),
+			orm.ExerciseWhere.UserID.EQ(p.UserID),
+			orm.ExerciseWhere.DeletedAt.IsNotNull(),
+		).One(ctx, tx.exec())
+		if err != nil {
+			return fmt.Errorf("exercise fetch: %w", err)
+		}
+
+		var memberships []RoutineMembership
+		if err = json.Unmarshal(exercise.DeletedFromRoutines, &memberships); err != nil {
+			return fmt.Errorf("routine memberships unmarshal: %w", err)
+		}
+
+		routineIDs := make([]string, 0, len(memberships))
+		for _, membership := range memberships {
+			routineIDs = append(routineIDs, membership.RoutineID)
+		}
+
+		routines, err := orm.Routines(orm.RoutineWhere.ID.IN(routineIDs)).All(ctx, tx.exec())
+		if err != nil {
+			return fmt.Errorf("routines fetch: %w", err)
+		}
+
+		mapRoutines := make(map[string]*orm.Routine, len(routines))
+		for _, routine := range routines {
+			mapRoutines[routine.ID] = routine
+		}
+
+		for _, membership := range memberships {
+			routine, ok := mapRoutines[membership.RoutineID]
+			if !ok {
+				continue
+			}
+
+			var exerciseIDs []string
+			if err = json.Unmarshal(routine.ExerciseOrder, &exerciseIDs); err != nil {
+				return fmt.Errorf("exercise order unmarshal: %w", err)
+			}
+
+			position := min(membership.Position, len(exerciseIDs))
+			exerciseIDs = slices.Insert(exerciseIDs, position, exercise.ID)
+
+			if err = tx.UpdateRoutine(ctx, routine.ID, UpdateRoutineExerciseOrder(exerciseIDs)); err != nil {
+				return fmt.Errorf("routine update: %w", err)
+			}
+
+			if err = routine.AddExercises(ctx, tx.exec(), false, exercise); err != nil {
+				return fmt.Errorf("routine exercises add: %w", err)
+			}
+		}
+
+		exercise.DeletedAt = null.Time{}
+		exercise.DeletedFromRoutines = []byte("[]")
+		if _, err = exercise.Update(ctx, tx.exec(), boil.Infer()); err != nil {
+			return fmt.Errorf("exercise restore: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// PurgeExercises permanently deletes exercises soft deleted before the given
+// time. Exercises that still have sets are kept to preserve workout history.
+func (r *repo) PurgeExercises(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	rows, err := orm.Exercises(
+		orm.ExerciseWhere.DeletedAt.LT(null.TimeFrom(deletedBefore)),
+		qm.Where("NOT EXISTS (SELECT 1 FROM getstronger.sets WHERE sets.exercise_id = exercises.id)"),
+	).DeleteAll(ctx, r.executor())
+	if err != nil {
+		return 0, fmt.Errorf("exercises delete: %w", err)
+	}
+
+	return rows, nil
+}
+
+type MergeExercisesParams struct {
+	UserID            string
+	TargetExerciseID  string
+	SourceExerciseIDs []string
+}
+
+var (
+	ErrMergeExerciseNotFound   = fmt.Errorf("merge exercise not found")
+	ErrMergeExerciseIntoItself = fmt.Errorf("exercise cannot be merged into itself")
+)
+
+// MergeExercises moves the sets and routine memberships of the source
+// exercises to the target exercise, and soft deletes the source exercises.
+func (r *repo) MergeExercises(ctx context.Context, p MergeExercisesParams) error {
+	mapSourceIDs := make(map[string]struct{}, len(p.SourceExerciseIDs))
+	for _, sourceID := range p.SourceExerciseIDs {
+		if sourceID == p.TargetExerciseID {
+			return ErrMergeExerciseIntoItself
+		}
+		mapSourceIDs[sourceID] = struct{}{}
+	}
+
+	sourceIDs := make([]string, 0, len(mapSourceIDs))
+	for sourceID := range mapSourceIDs {
+		sourceIDs = append(sourceIDs, sourceID)
+	}
+
+	return r.NewTx(ctx, func(tx Tx) error {
+		exercises, err := orm.Exercises(
+			orm.ExerciseWhere.ID.IN(append([]string{p.TargetExerciseID}, sourceIDs...)),
+			orm.ExerciseWhere.UserID.EQ(p.UserID),
+			orm.ExerciseWhere.DeletedAt.IsNull(),
+			qm.Load(orm.ExerciseRels.Routines),
+		).All(ctx, tx.exec())
+		if err != nil {
+			return fmt.Errorf("exercises fetch: %w", err)
+		}
+
+		if len(exercises) != len(sourceIDs)+1 {
+			return ErrMergeExerciseNotFound
+		}
+
+		var target *orm.Exercise
+		sources := make(orm.ExerciseSlice, 0, len(sourceIDs))
+		for _, exercise := range exercises {
+			if exercise.ID == p.TargetExerciseID {
+				target = exercise
+				continue
+			}
+			sources = append(sources, exercise)
+		}
+
+		if _, err = orm.Sets(orm.SetWhere.ExerciseID.IN(sourceIDs)).UpdateAll(ctx, tx.exec(), orm.M{
+			orm.SetColumns.ExerciseID: target.ID,
+		}); err != nil {
+			return fmt.Errorf("sets update: %w", err)
+		}
+
+		mapTargetRoutineIDs := make(map[string]struct{}, len(target.R.Routines))
+		for _, routine := range target.R.Routines {
+			mapTargetRoutineIDs[routine.ID] = struct{}{}
+		}
+
+		mapMergedRoutineIDs := make(map[string]struct{})
+		for _, source := range sources {
+			for _, routine := range source.R.Routines {
+				if _, ok := mapMergedRoutineIDs[routine.ID]; ok {
+					continue
+				}
+				mapMergedRoutineIDs[routine.ID] = struct{}{}
+
+				if err = mergeRoutineExercises(ctx, tx, routine, mapSourceIDs, target.ID); err != nil {
+					return fmt.Errorf("routine exercises merge: %w", err)
+				}
+
+				if _, ok := mapTargetRoutineIDs[routine.ID]; ok {
+					continue
+				}
+				mapTargetRoutineIDs[routine.ID] = struct{}{}
+
+				if err = routine.AddExercises(ctx, tx.exec(), false, target); err != nil {
+					return fmt.Errorf("routine exercises add: %w", err)
+				}
+			}
+		}
+
+		for _, source := range sources {
+			if err = source.SetRoutines(ctx, tx.exec(), false); err != nil {
+				return fmt.Errorf("exercise routines set: %w", err)
+			}
+
+			source.DeletedAt = null.TimeFrom(time.Now().UTC())
+			if _, err = source.Update(ctx, tx.exec(), boil.Infer()); err != nil {
+				return fmt.Errorf("exercise soft delete: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// mergeRoutineExercises replaces the source exercises with the target exercise
+// in the routine's exercise order and exercise targets. The target keeps the
+// position of its first occurrence.
+func mergeRoutineExercises(ctx context.Context, tx Tx, routine *orm.Routine, mapSourceIDs map[string]struct{}, targetID string) error {
+	var exerciseIDs []string
+	if err := json.Unmarshal(routine.ExerciseOrder, &exerciseIDs); err != nil {
+		return fmt.Errorf("exercise order unmarshal: %w", err)
+	}
+
+	var exerciseTargets []RoutineExerciseTarget
+	if err := json.Unmarshal(routine.ExerciseTargets, &exerciseTargets); err != nil {
+		return fmt.Errorf("exercise targets unmarshal: %w", err)
+	}
+
+	exerciseOrder := make([]string, 0, len(exerciseIDs))
+	mapOrderIDs := make(map[string]struct{}, len(exerciseIDs))
+	for _, exerciseID := range exerciseIDs {
+		if _, ok := mapSourceIDs[exerciseID]; ok {
+			exerciseID = targetID
+		}
+		if _, ok := mapOrderIDs[exerciseID]; ok {
+			continue
+		}
+		mapOrderIDs[exerciseID] = struct{}{}
+		exerciseOrder = append(exerciseOrder, exerciseID)
+	}
+
+	targets := make([]RoutineExerciseTarget, 0, len(exerciseTargets))
+	mapTargetIDs := make(map[string]struct{}, len(exerciseTargets))
+	for _, target := range exerciseTargets {
+		if _, ok := mapSourceIDs[target.ExerciseID]; ok {
+			target.ExerciseID = targetID
+		}
+		if _, ok := mapTargetIDs[target.ExerciseID]; ok {
+			continue
+		}
+		mapTargetIDs[target.ExerciseID] = struct{}{}
+		targets = append(targets, target)
+	}
+
+	if err := tx.UpdateRoutine(ctx, routine.ID,
+		UpdateRoutineExerciseOrder(exerciseOrder),
+		UpdateRoutineExerciseTargets(targets),
+	); err != nil {
+		return fmt.Errorf("routine update: %w", err)
+	}
+
+	return nil
+}
+
 type ListExercisesOpt func() ([]qm.QueryMod, error)
 
 func ListExercisesWithPageToken(pageToken []byte) ListExercisesOpt {
@@ -311,6 +575,14 @@ func ListExercisesWithoutDeleted() ListExercisesOpt {
 	}
 }
 
+func ListExercisesOnlyDeleted() ListExercisesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.ExerciseWhere.DeletedAt.IsNotNull(),
+		}, nil
+	}
+}
+
 func ListExercisesWithIDs(ids []string) ListExercisesOpt {
 	return func() ([]qm.QueryMod, error) {
 		if len(ids) == 0 {
@@ -331,6 +603,14 @@ func ListExercisesWithName(name string) ListExercisesOpt {
 	}
 }
 
+func ListExercisesWithCatalogExercise() ListExercisesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.ExerciseWhere.CatalogExercise.IsNotNull(),
+		}, nil
+	}
+}
+
 func ListExercisesWithUserID(userID string) ListExercisesOpt {
 	return func() ([]qm.QueryMod, error) {
 		return []qm.QueryMod{
@@ -406,6 +686,14 @@ func UpdateExerciseSubTitle(subTitle string) UpdateExerciseOpt {
 	}
 }
 
+// UpdateExerciseCatalogExercise links the exercise to a catalog lift, or
+// unlinks it if the catalog exercise is not valid.
+func UpdateExerciseCatalogExercise(catalogExercise orm.NullCatalogExercise) UpdateExerciseOpt {
+	return func() (orm.M, error) {
+		return orm.M{orm.ExerciseColumns.CatalogExercise: catalogExercise}, nil
+	}
+}
+
 func (r *repo) UpdateExercise(ctx context.Context, exerciseID string, opts ...UpdateExerciseOpt) error {
 	columns, err := updateColumnsFromOpts(opts)
 	if err != nil {
@@ -491,6 +779,12 @@ func GetRoutineWithUserID(userID string) GetRoutineOpt {
 	}
 }
 
+func GetRoutineWithoutDeleted() GetRoutineOpt {
+	return func() qm.QueryMod {
+		return orm.RoutineWhere.DeletedAt.IsNull()
+	}
+}
+
 func GetRoutineWithExercises() GetRoutineOpt {
 	return func() qm.QueryMod {
 		return qm.Load(orm.RoutineRels.Exercises)
@@ -511,23 +805,69 @@ func (r *repo) GetRoutine(ctx context.Context, opts ...GetRoutineOpt) (*orm.Rout
 	return routine, nil
 }
 
+// DeleteRoutine soft deletes the routine. Its exercises are kept so that the
+// routine can be restored as it was.
 func (r *repo) DeleteRoutine(ctx context.Context, id string) error {
-	return r.NewTx(ctx, func(tx Tx) error {
-		routine, err := tx.GetRoutine(ctx, GetRoutineWithID(id))
+	rows, err := orm.Routines(
+		orm.RoutineWhere.ID.EQ(id),
+		orm.RoutineWhere.DeletedAt.IsNull(),
+	).UpdateAll(ctx, r.executor(), orm.M{
+		orm.RoutineColumns.DeletedAt: null.TimeFrom(time.Now().UTC()),
+	})
+	if err != nil {
+		return fmt.Errorf("routine soft delete: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("routine soft delete: %w", sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+func (r *repo) RestoreRoutine(ctx context.Context, id string) error {
+	rows, err := orm.Routines(
+		orm.RoutineWhere.ID.EQ(id),
+		orm.RoutineWhere.DeletedAt.IsNotNull(),
+	).UpdateAll(ctx, r.executor(), orm.M{
+		orm.RoutineColumns.DeletedAt: null.Time{},
+	})
+	if err != nil {
+		return fmt.Errorf("routine restore: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("routine restore: %w", sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+// PurgeRoutines permanently deletes routines soft deleted before the given time.
+func (r *repo) PurgeRoutines(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var rows int64
+	if err := r.NewTx(ctx, func(tx Tx) error {
+		routines, err := orm.Routines(orm.RoutineWhere.DeletedAt.LT(null.TimeFrom(deletedBefore))).All(ctx, tx.exec())
 		if err != nil {
-			return fmt.Errorf("routine fetch: %w", err)
+			return fmt.Errorf("routines fetch: %w", err)
 		}
 
-		if err = routine.SetExercises(ctx, tx.exec(), false); err != nil {
-			return fmt.Errorf("routine exercises set: %w", err)
+		for _, routine := range routines {
+			if err = routine.SetExercises(ctx, tx.exec(), false); err != nil {
+				return fmt.Errorf("routine exercises set: %w", err)
+			}
 		}
 
-		if _, err = routine.Delete(ctx, tx.exec()); err != nil {
-			return fmt.Errorf("routine delete: %w", err)
+		if rows, err = routines.DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("routines delete: %w", err)
 		}
 
 		return nil
-	})
+	}); err != nil {
+		return 0, fmt.Errorf("routines purge tx: %w", err)
+	}
+
+	return rows, nil
 }
 
 type ListRoutineOpt func() ([]qm.QueryMod, error)
@@ -568,6 +908,22 @@ func ListRoutinesWithUserID(userID string) ListRoutineOpt {
 	}
 }
 
+func ListRoutinesWithoutDeleted() ListRoutineOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.RoutineWhere.DeletedAt.IsNull(),
+		}, nil
+	}
+}
+
+func ListRoutinesOnlyDeleted() ListRoutineOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.RoutineWhere.DeletedAt.IsNotNull(),
+		}, nil
+	}
+}
+
 func ListRoutinesWithLimit(limit int) ListRoutineOpt {
 	return func() ([]qm.QueryMod, error) {
 		return []qm.QueryMod{
@@ -613,6 +969,49 @@ func UpdateRoutineExerciseOrder(exerciseIDs []string) UpdateRoutineOpt {
 	}
 }
 
+type RoutineTargetSet struct {
+	Weight float64 `json:"weight"`
+	Reps   int     `json:"reps"`
+}
+
+type RoutineExerciseTarget struct {
+	ExerciseID string             `json:"exerciseId"`
+	Sets       []RoutineTargetSet `json:"sets"`
+}
+
+func UpdateRoutineExerciseTargets(targets []RoutineExerciseTarget) UpdateRoutineOpt {
+	return func() (orm.M, error) {
+		if targets == nil {
+			targets = []RoutineExerciseTarget{}
+		}
+
+		bytes, err := json.Marshal(targets)
+		if err != nil {
+			return nil, fmt.Errorf("exercise targets marshal: %w", err)
+		}
+
+		return orm.M{orm.RoutineColumns.ExerciseTargets: bytes}, nil
+	}
+}
+
+func UpdateRoutineVisibility(visibility orm.RoutineVisibility) UpdateRoutineOpt {
+	return func() (orm.M, error) {
+		return orm.M{orm.RoutineColumns.Visibility: visibility}, nil
+	}
+}
+
+func UpdateRoutineShareToken(token null.String) UpdateRoutineOpt {
+	return func() (orm.M, error) {
+		return orm.M{orm.RoutineColumns.ShareToken: token}, nil
+	}
+}
+
+func UpdateRoutineClonedFromRoutineID(routineID string) UpdateRoutineOpt {
+	return func() (orm.M, error) {
+		return orm.M{orm.RoutineColumns.ClonedFromRoutineID: null.StringFrom(routineID)}, nil
+	}
+}
+
 func (r *repo) UpdateRoutine(ctx context.Context, routineID string, opts ...UpdateRoutineOpt) error {
 	columns, err := updateColumnsFromOpts(opts)
 	if err != nil {
@@ -633,61 +1032,244 @@ func (r *repo) UpdateRoutine(ctx context.Context, routineID string, opts ...Upda
 	})
 }
 
-func (r *repo) AddExerciseToRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
-	if err := routine.AddExercises(ctx, r.executor(), false, exercise); err != nil {
-		return fmt.Errorf("routine exercises add: %w", err)
-	}
-	return nil
-}
-
-func (r *repo) RemoveExerciseFromRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
-	if err := routine.RemoveExercises(ctx, r.executor(), exercise); err != nil {
-		return fmt.Errorf("routine exercises remove: %w", err)
-	}
-	return nil
+type CloneRoutineParams struct {
+	RoutineID string
+	UserID    string
+	Name      string
 }
 
-type ListWorkoutsOpt func() ([]qm.QueryMod, error)
+var ErrRoutineCloneOwnRoutine = fmt.Errorf("routine belongs to user")
 
-func (r *repo) ListWorkouts(ctx context.Context, opts ...ListWorkoutsOpt) (orm.WorkoutSlice, error) {
-	var query []qm.QueryMod
-	for _, opt := range opts {
-		q, err := opt()
+// CloneRoutine copies a routine into the user's account. The routine's
+// exercises are mapped to the user's exercises by name, and created for the
+// user when no exercise with the same name exists.
+func (r *repo) CloneRoutine(ctx context.Context, p CloneRoutineParams) (*orm.Routine, error) {
+	var clone *orm.Routine
+	if err := r.NewTx(ctx, func(tx Tx) error {
+		source, err := tx.GetRoutine(ctx,
+			GetRoutineWithID(p.RoutineID),
+			GetRoutineWithoutDeleted(),
+			GetRoutineWithExercises(),
+		)
 		if err != nil {
-			return nil, fmt.Errorf("workout list opt: %w", err)
+			return fmt.Errorf("routine fetch: %w", err)
 		}
-		query = append(query, q...)
-	}
 
-	workouts, err := orm.Workouts(query...).All(ctx, r.executor())
-	if err != nil {
-		return nil, fmt.Errorf("workouts fetch: %w", err)
-	}
+		if source.UserID == p.UserID {
+			return ErrRoutineCloneOwnRoutine
+		}
 
-	return workouts, nil
-}
+		var exerciseOrder []string
+		if err = json.Unmarshal(source.ExerciseOrder, &exerciseOrder); err != nil {
+			return fmt.Errorf("exercise order unmarshal: %w", err)
+		}
 
-func ListWorkoutsWithIDs(ids []string) ListWorkoutsOpt {
-	return func() ([]qm.QueryMod, error) {
-		return []qm.QueryMod{
-			orm.WorkoutWhere.ID.IN(ids),
-		}, nil
-	}
-}
+		var exerciseTargets []RoutineExerciseTarget
+		if err = json.Unmarshal(source.ExerciseTargets, &exerciseTargets); err != nil {
+			return fmt.Errorf("exercise targets unmarshal: %w", err)
+		}
 
-func ListWorkoutsLoadUser() ListWorkoutsOpt {
-	return func() ([]qm.QueryMod, error) {
-		return []qm.QueryMod{
-			qm.Load(orm.WorkoutRels.User),
-		}, nil
-	}
-}
+		exercises, err := tx.ListExercises(ctx, ListExercisesWithUserID(p.UserID), ListExercisesWithoutDeleted())
+		if err != nil {
+			return fmt.Errorf("exercises fetch: %w", err)
+		}
 
-func ListWorkoutsLoadComments() ListWorkoutsOpt {
-	return func() ([]qm.QueryMod, error) {
-		return []qm.QueryMod{
-			qm.Load(orm.WorkoutRels.WorkoutComments),
-		}, nil
+		mapExercisesByName := make(map[string]*orm.Exercise, len(exercises))
+		for _, exercise := range exercises {
+			mapExercisesByName[exerciseNameKey(exercise.Title)] = exercise
+		}
+
+		mapSourceExercises := make(map[string]*orm.Exercise, len(source.R.Exercises))
+		for _, exercise := range source.R.Exercises {
+			mapSourceExercises[exercise.ID] = exercise
+		}
+
+		exerciseIDs := make([]string, 0, len(exerciseOrder))
+		mapExerciseIDs := make(map[string]string, len(exerciseOrder))
+		mapClonedExerciseIDs := make(map[string]struct{}, len(exerciseOrder))
+		for _, exerciseID := range exerciseOrder {
+			sourceExercise, ok := mapSourceExercises[exerciseID]
+			if !ok {
+				continue
+			}
+
+			exercise, ok := mapExercisesByName[exerciseNameKey(sourceExercise.Title)]
+			if !ok {
+				exercise, err = tx.CreateExercise(ctx, CreateExerciseParams{
+					UserID: p.UserID,
+					Name:   sourceExercise.Title,
+					Label:  sourceExercise.SubTitle.String,
+				})
+				if err != nil {
+					return fmt.Errorf("exercise create: %w", err)
+				}
+				mapExercisesByName[exerciseNameKey(exercise.Title)] = exercise
+			}
+
+			if _, ok = mapClonedExerciseIDs[exercise.ID]; ok {
+				continue
+			}
+
+			mapExerciseIDs[exerciseID] = exercise.ID
+			mapClonedExerciseIDs[exercise.ID] = struct{}{}
+			exerciseIDs = append(exerciseIDs, exercise.ID)
+		}
+
+		targets := make([]RoutineExerciseTarget, 0, len(exerciseTargets))
+		for _, target := range exerciseTargets {
+			exerciseID, ok := mapExerciseIDs[target.ExerciseID]
+			if !ok {
+				continue
+			}
+
+			targets = append(targets, RoutineExerciseTarget{
+				ExerciseID: exerciseID,
+				Sets:       target.Sets,
+			})
+		}
+
+		name := p.Name
+		if name == "" {
+			name = source.Title
+		}
+
+		clone, err = tx.CreateRoutine(ctx, CreateRoutineParams{
+			UserID:      p.UserID,
+			Name:        name,
+			ExerciseIDs: exerciseIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("routine create: %w", err)
+		}
+
+		if err = tx.UpdateRoutine(ctx, clone.ID,
+			UpdateRoutineExerciseTargets(targets),
+			UpdateRoutineClonedFromRoutineID(source.ID),
+		); err != nil {
+			return fmt.Errorf("routine update: %w", err)
+		}
+
+		if _, err = tx.exec().ExecContext(ctx,
+			"UPDATE getstronger.routines SET clone_count = clone_count + 1 WHERE id = $1", source.ID,
+		); err != nil {
+			return fmt.Errorf("routine clone count update: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("routine clone tx: %w", err)
+	}
+
+	return clone, nil
+}
+
+func exerciseNameKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func (r *repo) AddExerciseToRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
+	if err := routine.AddExercises(ctx, r.executor(), false, exercise); err != nil {
+		return fmt.Errorf("routine exercises add: %w", err)
+	}
+	return nil
+}
+
+func (r *repo) RemoveExerciseFromRoutine(ctx context.Context, exercise *orm.Exercise, routine *orm.Routine) error {
+	return r.NewTx(ctx, func(tx Tx) error {
+		if err := routine.RemoveExercises(ctx, tx.exec(), exercise); err != nil {
+			return fmt.Errorf("routine exercises remove: %w", err)
+		}
+
+		if err := pruneRoutineExerciseTargets(ctx, tx, routine, func(exerciseID string) bool {
+			return exerciseID != exercise.ID
+		}); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// pruneRoutineExerciseTargets drops the routine's exercise targets for which
+// keep returns false, so targets don't outlive the exercise in the routine.
+func pruneRoutineExerciseTargets(ctx context.Context, tx Tx, routine *orm.Routine, keep func(exerciseID string) bool) error {
+	var exerciseTargets []RoutineExerciseTarget
+	if err := json.Unmarshal(routine.ExerciseTargets, &exerciseTargets); err != nil {
+		return fmt.Errorf("exercise targets unmarshal: %w", err)
+	}
+
+	targets := make([]RoutineExerciseTarget, 0, len(exerciseTargets))
+	for _, target := range exerciseTargets {
+		if keep(target.ExerciseID) {
+			targets = append(targets, target)
+		}
+	}
+
+	if len(targets) == len(exerciseTargets) {
+		return nil
+	}
+
+	if err := tx.UpdateRoutine(ctx, routine.ID, UpdateRoutineExerciseTargets(targets)); err != nil {
+		return fmt.Errorf("routine update: %w", err)
+	}
+
+	return nil
+}
+
+type ListWorkoutsOpt func() ([]qm.QueryMod, error)
+
+func (r *repo) ListWorkouts(ctx context.Context, opts ...ListWorkoutsOpt) (orm.WorkoutSlice, error) {
+	var query []qm.QueryMod
+	for _, opt := range opts {
+		q, err := opt()
+		if err != nil {
+			return nil, fmt.Errorf("workout list opt: %w", err)
+		}
+		query = append(query, q...)
+	}
+
+	workouts, err := orm.Workouts(query...).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("workouts fetch: %w", err)
+	}
+
+	return workouts, nil
+}
+
+func ListWorkoutsWithIDs(ids []string) ListWorkoutsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.WorkoutWhere.ID.IN(ids),
+		}, nil
+	}
+}
+
+func ListWorkoutsLoadUser() ListWorkoutsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Load(orm.WorkoutRels.User),
+		}, nil
+	}
+}
+
+// ListWorkoutsLoadCommentsVisibleTo loads the comments, excluding those hidden
+// by moderation and those of users the viewer has blocked or is blocked by.
+func ListWorkoutsLoadCommentsVisibleTo(viewerID string) ListWorkoutsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Load(orm.WorkoutRels.WorkoutComments,
+				orm.WorkoutCommentWhere.HiddenAt.IsNull(),
+				qm.Where(notBlockedClause(orm.TableNames.WorkoutComments+".user_id"), viewerID, viewerID),
+			),
+		}, nil
+	}
+}
+
+func ListWorkoutsLoadReactions() ListWorkoutsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Load(orm.WorkoutRels.WorkoutReactions),
+		}, nil
 	}
 }
 
@@ -707,6 +1289,42 @@ func ListWorkoutsWithUserIDs(userIDs ...string) ListWorkoutsOpt {
 	}
 }
 
+// ListWorkoutsVisibleTo excludes workouts the viewer is not allowed to see,
+// either because of the workout's visibility, because the owner is private or
+// because either of them has blocked the other.
+func ListWorkoutsVisibleTo(viewerID string) ListWorkoutsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Where(workoutVisibleToClause(orm.TableNames.Workouts), viewerID, viewerID),
+			qm.Where(notBlockedClause(orm.TableNames.Workouts+".user_id"), viewerID, viewerID),
+		}, nil
+	}
+}
+
+func ListWorkoutsWithImportKeys(keys []string) ListWorkoutsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.WorkoutWhere.ImportKey.IN(keys),
+		}, nil
+	}
+}
+
+func ListWorkoutsWithoutDeleted() ListWorkoutsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.WorkoutWhere.DeletedAt.IsNull(),
+		}, nil
+	}
+}
+
+func ListWorkoutsWithoutHidden() ListWorkoutsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.WorkoutWhere.HiddenAt.IsNull(),
+		}, nil
+	}
+}
+
 func ListWorkoutsWithLimit(size int) ListWorkoutsOpt {
 	return func() ([]qm.QueryMod, error) {
 		return []qm.QueryMod{
@@ -717,10 +1335,9 @@ func ListWorkoutsWithLimit(size int) ListWorkoutsOpt {
 
 func ListWorkoutsWithPageToken(token []byte) ListWorkoutsOpt {
 	return func() ([]qm.QueryMod, error) {
+		orderBy := qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC", orm.WorkoutTableColumns.CreatedAt, orm.WorkoutTableColumns.ID))
 		if token == nil {
-			return []qm.QueryMod{
-				qm.OrderBy(fmt.Sprintf("%s DESC", orm.WorkoutColumns.CreatedAt)),
-			}, nil
+			return []qm.QueryMod{orderBy}, nil
 		}
 
 		var pt PageToken
@@ -729,8 +1346,8 @@ func ListWorkoutsWithPageToken(token []byte) ListWorkoutsOpt {
 		}
 
 		return []qm.QueryMod{
-			orm.WorkoutWhere.CreatedAt.LT(pt.CreatedAt),
-			qm.OrderBy(fmt.Sprintf("%s DESC", orm.WorkoutColumns.CreatedAt)),
+			pt.after(orm.WorkoutTableColumns.CreatedAt, orm.WorkoutTableColumns.ID),
+			orderBy,
 		}, nil
 	}
 }
@@ -742,6 +1359,15 @@ type CreateWorkoutParams struct {
 	ExerciseSets []ExerciseSet
 	StartedAt    time.Time
 	FinishedAt   time.Time
+	// ImportKey identifies a workout imported from another app, so it is
+	// never imported twice.
+	ImportKey    string
+	NoteMentions []mention.Mention
+	// Visibility defaults to the user's default workout visibility if empty.
+	Visibility orm.WorkoutVisibility
+	// CreatedAt defaults to now if zero. Imported workouts are backdated so
+	// they don't surface as new activity.
+	CreatedAt time.Time
 }
 
 type ExerciseSet struct {
@@ -762,9 +1388,28 @@ func (r *repo) CreateWorkout(ctx context.Context, p CreateWorkoutParams) (*orm.W
 		UserID:     p.UserID,
 		StartedAt:  p.StartedAt.Truncate(time.Minute).UTC(),
 		FinishedAt: p.FinishedAt.Truncate(time.Minute).UTC(),
+		ImportKey:  null.NewString(p.ImportKey, p.ImportKey != ""),
+		CreatedAt:  p.CreatedAt.UTC(),
+	}
+
+	if len(p.NoteMentions) > 0 {
+		mentions, err := marshalMentions(p.NoteMentions)
+		if err != nil {
+			return nil, err
+		}
+		workout.NoteMentions = mentions
 	}
 
 	if err := r.NewTx(ctx, func(tx Tx) error {
+		workout.Visibility = p.Visibility
+		if workout.Visibility == "" {
+			user, err := orm.FindUser(ctx, tx.exec(), p.UserID)
+			if err != nil {
+				return fmt.Errorf("user fetch: %w", err)
+			}
+			workout.Visibility = user.DefaultWorkoutVisibility
+		}
+
 		if err := workout.Insert(ctx, tx.exec(), boil.Infer()); err != nil {
 			return fmt.Errorf("workout insert: %w", err)
 		}
@@ -778,6 +1423,7 @@ func (r *repo) CreateWorkout(ctx context.Context, p CreateWorkoutParams) (*orm.W
 					UserID:     p.UserID,
 					WorkoutID:  workout.ID,
 					ExerciseID: exerciseSet.ExerciseID,
+					CreatedAt:  p.CreatedAt.UTC(),
 				})
 			}
 
@@ -802,6 +1448,18 @@ func GetWorkoutWithID(id string) GetWorkoutOpt {
 	}
 }
 
+func GetWorkoutWithoutDeleted() GetWorkoutOpt {
+	return func() qm.QueryMod {
+		return orm.WorkoutWhere.DeletedAt.IsNull()
+	}
+}
+
+func GetWorkoutWithoutHidden() GetWorkoutOpt {
+	return func() qm.QueryMod {
+		return orm.WorkoutWhere.HiddenAt.IsNull()
+	}
+}
+
 func GetWorkoutLoadSets() GetWorkoutOpt {
 	return func() qm.QueryMod {
 		return qm.Load(orm.WorkoutRels.Sets)
@@ -814,9 +1472,14 @@ func GetWorkoutLoadUser() GetWorkoutOpt {
 	}
 }
 
-func GetWorkoutLoadComments() GetWorkoutOpt {
+// GetWorkoutLoadCommentsVisibleTo loads the comments, excluding those hidden by
+// moderation and those of users the viewer has blocked or is blocked by.
+func GetWorkoutLoadCommentsVisibleTo(viewerID string) GetWorkoutOpt {
 	return func() qm.QueryMod {
-		return qm.Load(orm.WorkoutRels.WorkoutComments)
+		return qm.Load(orm.WorkoutRels.WorkoutComments,
+			orm.WorkoutCommentWhere.HiddenAt.IsNull(),
+			qm.Where(notBlockedClause(orm.TableNames.WorkoutComments+".user_id"), viewerID, viewerID),
+		)
 	}
 }
 
@@ -826,6 +1489,12 @@ func GetWorkoutLoadExercises() GetWorkoutOpt {
 	}
 }
 
+func GetWorkoutLoadReactions() GetWorkoutOpt {
+	return func() qm.QueryMod {
+		return qm.Load(orm.WorkoutRels.WorkoutReactions)
+	}
+}
+
 func GetWorkoutLoadCommentUsers() GetWorkoutOpt {
 	return func() qm.QueryMod {
 		return qm.Load(fmt.Sprintf("%s.%s", orm.WorkoutRels.WorkoutComments, orm.WorkoutCommentRels.User))
@@ -862,55 +1531,109 @@ func DeleteWorkoutWithUserID(userID string) DeleteWorkoutOpt {
 
 var errDeleteWorkoutMissingOptions = fmt.Errorf("delete workout: missing options")
 
+// DeleteWorkout soft deletes the workout. Its sets, comments and
+// notifications are kept until the workout is purged, so that it can be
+// restored.
 func (r *repo) DeleteWorkout(ctx context.Context, opts ...DeleteWorkoutOpt) error {
 	if len(opts) == 0 {
 		return errDeleteWorkoutMissingOptions
 	}
 
 	query := []qm.QueryMod{
-		qm.Load(orm.WorkoutRels.Sets),
-		qm.Load(orm.WorkoutRels.WorkoutComments),
+		orm.WorkoutWhere.DeletedAt.IsNull(),
 	}
 	for _, opt := range opts {
 		query = append(query, opt())
 	}
 
-	return r.NewTx(ctx, func(tx Tx) error {
-		workout, err := orm.Workouts(query...).One(ctx, tx.exec())
+	rows, err := orm.Workouts(query...).UpdateAll(ctx, r.executor(), orm.M{
+		orm.WorkoutColumns.DeletedAt: null.TimeFrom(time.Now().UTC()),
+	})
+	if err != nil {
+		return fmt.Errorf("workout soft delete: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("workout soft delete: %w", sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+func (r *repo) RestoreWorkout(ctx context.Context, workoutID string) error {
+	rows, err := orm.Workouts(
+		orm.WorkoutWhere.ID.EQ(workoutID),
+		orm.WorkoutWhere.DeletedAt.IsNotNull(),
+	).UpdateAll(ctx, r.executor(), orm.M{
+		orm.WorkoutColumns.DeletedAt: null.Time{},
+	})
+	if err != nil {
+		return fmt.Errorf("workout restore: %w", err)
+	}
+
+	if rows == 0 {
+		return fmt.Errorf("workout restore: %w", sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+// PurgeWorkouts permanently deletes workouts soft deleted before the given
+// time, along with their sets, comments and the notifications referencing them.
+func (r *repo) PurgeWorkouts(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var rows int64
+	if err := r.NewTx(ctx, func(tx Tx) error {
+		workouts, err := orm.Workouts(
+			orm.WorkoutWhere.DeletedAt.LT(null.TimeFrom(deletedBefore)),
+		).All(ctx, tx.exec())
 		if err != nil {
-			return fmt.Errorf("workout fetch: %w", err)
+			return fmt.Errorf("workouts fetch: %w", err)
 		}
 
-		if _, err = workout.R.Sets.DeleteAll(ctx, tx.exec()); err != nil {
+		if len(workouts) == 0 {
+			return nil
+		}
+
+		workoutIDs := make([]string, 0, len(workouts))
+		for _, workout := range workouts {
+			workoutIDs = append(workoutIDs, workout.ID)
+		}
+
+		if _, err = orm.Sets(orm.SetWhere.WorkoutID.IN(workoutIDs)).DeleteAll(ctx, tx.exec()); err != nil {
 			return fmt.Errorf("workout sets delete: %w", err)
 		}
 
-		if _, err = workout.R.WorkoutComments.DeleteAll(ctx, tx.exec()); err != nil {
+		if _, err = orm.WorkoutComments(orm.WorkoutCommentWhere.WorkoutID.IN(workoutIDs)).DeleteAll(ctx, tx.exec()); err != nil {
 			return fmt.Errorf("workout comments delete: %w", err)
 		}
 
 		if _, err = orm.Notifications(
-			qm.Where("payload ->> 'workoutId' = ?", workout.ID),
+			qm.Where("payload ->> 'workoutId' = ANY(?)", types.Array(workoutIDs)),
 		).DeleteAll(ctx, tx.exec()); err != nil {
 			return fmt.Errorf("notifications delete: %w", err)
 		}
 
-		if _, err = workout.Delete(ctx, tx.exec()); err != nil {
-			return fmt.Errorf("workout delete: %w", err)
+		if rows, err = workouts.DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("workouts delete: %w", err)
 		}
 
 		return nil
-	})
+	}); err != nil {
+		return 0, fmt.Errorf("workouts purge tx: %w", err)
+	}
+
+	return rows, nil
 }
 
 func (r *repo) GetPreviousWorkoutSets(ctx context.Context, exerciseIDs []string) (orm.SetSlice, error) {
 	rawQuery := `
 SELECT id FROM getstronger.sets 
 WHERE (exercise_id, workout_id) IN (
-	SELECT DISTINCT ON (exercise_id) exercise_id, workout_id	
-	FROM getstronger.sets
-	WHERE exercise_id = ANY($1)
-	ORDER BY exercise_id, created_at DESC
+	SELECT DISTINCT ON (s.exercise_id) s.exercise_id, s.workout_id
+	FROM getstronger.sets s
+	JOIN getstronger.workouts w ON w.id = s.workout_id
+	WHERE s.exercise_id = ANY($1) AND w.deleted_at IS NULL
+	ORDER BY s.exercise_id, s.created_at DESC
 )
 ORDER BY created_at;
 `
@@ -933,7 +1656,17 @@ ORDER BY created_at;
 }
 
 func (r *repo) GetPersonalBests(ctx context.Context, userIDs ...string) (orm.SetSlice, error) {
-	workouts, err := r.ListWorkouts(ctx, ListWorkoutsWithUserIDs(userIDs...))
+	return r.personalBests(ctx, ListWorkoutsWithUserIDs(userIDs...))
+}
+
+// GetVisiblePersonalBests returns the personal bests of the users set in
+// workouts the viewer can see.
+func (r *repo) GetVisiblePersonalBests(ctx context.Context, viewerID string, userIDs ...string) (orm.SetSlice, error) {
+	return r.personalBests(ctx, ListWorkoutsWithUserIDs(userIDs...), ListWorkoutsVisibleTo(viewerID))
+}
+
+func (r *repo) personalBests(ctx context.Context, opts ...ListWorkoutsOpt) (orm.SetSlice, error) {
+	workouts, err := r.ListWorkouts(ctx, append(opts, ListWorkoutsWithoutDeleted(), ListWorkoutsWithoutHidden())...)
 	if err != nil {
 		return nil, fmt.Errorf("workouts fetch: %w", err)
 	}
@@ -972,9 +1705,26 @@ type FollowParams struct {
 	FolloweeID string
 }
 
+var ErrUserBlocked = fmt.Errorf("user is blocked")
+
+// Follow follows the user. It returns ErrUserBlocked if either user has
+// blocked the other.
 func (r *repo) Follow(ctx context.Context, p FollowParams) error {
-	user := &orm.User{ID: p.FollowerID}
-	if err := user.AddFolloweeUsers(ctx, r.executor(), false, &orm.User{ID: p.FolloweeID}); err != nil {
+	blocked, err := r.IsBlocked(ctx, p.FollowerID, p.FolloweeID)
+	if err != nil {
+		return fmt.Errorf("block check: %w", err)
+	}
+
+	if blocked {
+		return ErrUserBlocked
+	}
+
+	follower := &orm.Follower{
+		FollowerID: p.FollowerID,
+		FolloweeID: p.FolloweeID,
+	}
+
+	if err = follower.Insert(ctx, r.executor(), boil.Infer()); err != nil {
 		return fmt.Errorf("follow add: %w", err)
 	}
 
@@ -986,25 +1736,47 @@ type UnfollowParams struct {
 	FolloweeID string
 }
 
+// Unfollow removes the follow, or cancels the follow request if the followee
+// is private and has not yet approved it.
 func (r *repo) Unfollow(ctx context.Context, p UnfollowParams) error {
-	user := &orm.User{ID: p.FollowerID}
-	if err := user.RemoveFolloweeUsers(ctx, r.executor(), &orm.User{ID: p.FolloweeID}); err != nil {
-		return fmt.Errorf("follow add: %w", err)
-	}
+	return r.NewTx(ctx, func(tx Tx) error {
+		if _, err := orm.Followers(
+			orm.FollowerWhere.FollowerID.EQ(p.FollowerID),
+			orm.FollowerWhere.FolloweeID.EQ(p.FolloweeID),
+		).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("follow delete: %w", err)
+		}
 
-	return nil
+		if err := tx.DeleteFollowRequest(ctx, FollowParams{
+			FollowerID: p.FollowerID,
+			FolloweeID: p.FolloweeID,
+		}); err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("follow request delete: %w", err)
+		}
+
+		return nil
+	})
 }
 
 type ListFollowersOpt func() qm.QueryMod
 
+// ListFollowersWithoutBlocked excludes followers who have blocked, or are
+// blocked by, the user.
+func ListFollowersWithoutBlocked(userID string) ListFollowersOpt {
+	return func() qm.QueryMod {
+		return qm.Where(notBlockedClause(orm.TableNames.Users+".id"), userID, userID)
+	}
+}
+
 func (r *repo) ListFollowers(ctx context.Context, userID string, opts ...ListFollowersOpt) (orm.UserSlice, error) {
-	query := make([]qm.QueryMod, 0, len(opts))
+	query := []qm.QueryMod{
+		qm.Where("id IN (SELECT follower_id FROM getstronger.followers WHERE followee_id = ?)", userID),
+	}
 	for _, opt := range opts {
 		query = append(query, opt())
 	}
 
-	user := &orm.User{ID: userID}
-	users, err := user.FollowerUsers(query...).All(ctx, r.executor())
+	users, err := orm.Users(query...).All(ctx, r.executor())
 	if err != nil {
 		return nil, fmt.Errorf("users fetch: %w", err)
 	}
@@ -1014,14 +1786,23 @@ func (r *repo) ListFollowers(ctx context.Context, userID string, opts ...ListFol
 
 type ListFolloweesOpt func() qm.QueryMod
 
+// ListFolloweesWithoutBlocked excludes followees who have blocked, or are
+// blocked by, the user.
+func ListFolloweesWithoutBlocked(userID string) ListFolloweesOpt {
+	return func() qm.QueryMod {
+		return qm.Where(notBlockedClause(orm.TableNames.Users+".id"), userID, userID)
+	}
+}
+
 func (r *repo) ListFollowees(ctx context.Context, userID string, opts ...ListFolloweesOpt) (orm.UserSlice, error) {
-	query := make([]qm.QueryMod, 0, len(opts))
+	query := []qm.QueryMod{
+		qm.Where("id IN (SELECT followee_id FROM getstronger.followers WHERE follower_id = ?)", userID),
+	}
 	for _, opt := range opts {
 		query = append(query, opt())
 	}
 
-	user := &orm.User{ID: userID}
-	users, err := user.FolloweeUsers(query...).All(ctx, r.executor())
+	users, err := orm.Users(query...).All(ctx, r.executor())
 	if err != nil {
 		return nil, fmt.Errorf("users fetch: %w", err)
 	}
@@ -1031,6 +1812,12 @@ func (r *repo) ListFollowees(ctx context.Context, userID string, opts ...ListFol
 
 type GetUserOpt func() qm.QueryMod
 
+func GetUserWithoutDeleted() GetUserOpt {
+	return func() qm.QueryMod {
+		return orm.UserWhere.DeletedAt.IsNull()
+	}
+}
+
 func GetUserWithID(id string) GetUserOpt {
 	return func() qm.QueryMod {
 		return orm.UserWhere.ID.EQ(id)
@@ -1057,34 +1844,162 @@ func (r *repo) GetUser(ctx context.Context, opts ...GetUserOpt) (*orm.User, erro
 	return user, nil
 }
 
-type ListUsersOpt func() []qm.QueryMod
+const (
+	DeletedUserFirstName = "Deleted"
+	DeletedUserLastName  = "user"
+)
 
-func ListUsersWithIDs(ids []string) ListUsersOpt {
-	return func() []qm.QueryMod {
-		return []qm.QueryMod{
-			orm.UserWhere.ID.IN(ids),
-		}
+type UpdateUserOpt func() (orm.M, error)
+
+func UpdateUserSex(sex orm.NullSex) UpdateUserOpt {
+	return func() (orm.M, error) {
+		return orm.M{orm.UserColumns.Sex: sex}, nil
 	}
 }
 
-func ListUsersWithNameMatching(query string) ListUsersOpt {
-	return func() []qm.QueryMod {
-		return []qm.QueryMod{
-			orm.UserWhere.FullNameSearch.LIKE(fmt.Sprintf("%%%s%%", strings.ToLower(query))),
-			qm.OrderBy(fmt.Sprintf("similarity(full_name_search, '%s') DESC", query)),
-		}
+func UpdateUserBirthDate(birthDate null.Time) UpdateUserOpt {
+	return func() (orm.M, error) {
+		return orm.M{orm.UserColumns.BirthDate: birthDate}, nil
 	}
 }
 
-func ListUsersWithLimit(limit int) ListUsersOpt {
-	return func() []qm.QueryMod {
-		return []qm.QueryMod{
-			qm.Limit(limit),
-		}
+func UpdateUserBodyweight(bodyweight null.Float64) UpdateUserOpt {
+	return func() (orm.M, error) {
+		return orm.M{orm.UserColumns.Bodyweight: bodyweight}, nil
 	}
 }
 
-func (r *repo) ListUsers(ctx context.Context, opts ...ListUsersOpt) (orm.UserSlice, error) {
+func UpdateUserPrivate(private bool) UpdateUserOpt {
+	return func() (orm.M, error) {
+		return orm.M{orm.UserColumns.Private: private}, nil
+	}
+}
+
+func UpdateUserDefaultWorkoutVisibility(visibility orm.WorkoutVisibility) UpdateUserOpt {
+	return func() (orm.M, error) {
+		return orm.M{orm.UserColumns.DefaultWorkoutVisibility: visibility}, nil
+	}
+}
+
+func UpdateUserDeletionRequestedAt(requestedAt null.Time) UpdateUserOpt {
+	return func() (orm.M, error) {
+		return orm.M{orm.UserColumns.DeletionRequestedAt: requestedAt}, nil
+	}
+}
+
+func (r *repo) UpdateUser(ctx context.Context, userID string, opts ...UpdateUserOpt) error {
+	columns, err := updateColumnsFromOpts(opts)
+	if err != nil {
+		return fmt.Errorf("user update columns: %w", err)
+	}
+
+	return r.NewTx(ctx, func(tx Tx) error {
+		rows, rowsErr := orm.Users(orm.UserWhere.ID.EQ(userID)).UpdateAll(ctx, tx.exec(), columns)
+		if rowsErr != nil {
+			return fmt.Errorf("user update: %w", rowsErr)
+		}
+
+		if rows != 1 {
+			return fmt.Errorf("%w: expected 1, got %d", ErrUpdateRowsAffected, rows)
+		}
+
+		return nil
+	})
+}
+
+func (r *repo) CreateBodyweight(ctx context.Context, userID string, bodyweight float64) error {
+	b := &orm.Bodyweight{
+		UserID:     userID,
+		Bodyweight: bodyweight,
+	}
+
+	if err := b.Insert(ctx, r.executor(), boil.Infer()); err != nil {
+		return fmt.Errorf("bodyweight insert: %w", err)
+	}
+
+	return nil
+}
+
+// GetBodyweightAt returns the user's latest bodyweight recorded at or before
+// the given time, falling back to the earliest bodyweight recorded after it.
+func (r *repo) GetBodyweightAt(ctx context.Context, userID string, at time.Time) (*orm.Bodyweight, error) {
+	bodyweight, err := orm.Bodyweights(
+		orm.BodyweightWhere.UserID.EQ(userID),
+		orm.BodyweightWhere.CreatedAt.LTE(at.UTC()),
+		qm.OrderBy(fmt.Sprintf("%s DESC", orm.BodyweightColumns.CreatedAt)),
+	).One(ctx, r.executor())
+	if err == nil {
+		return bodyweight, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("bodyweight fetch: %w", err)
+	}
+
+	bodyweight, err = orm.Bodyweights(
+		orm.BodyweightWhere.UserID.EQ(userID),
+		qm.OrderBy(fmt.Sprintf("%s ASC", orm.BodyweightColumns.CreatedAt)),
+	).One(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("bodyweight fetch: %w", err)
+	}
+
+	return bodyweight, nil
+}
+
+type ListUsersOpt func() []qm.QueryMod
+
+func ListUsersWithIDs(ids []string) ListUsersOpt {
+	return func() []qm.QueryMod {
+		return []qm.QueryMod{
+			orm.UserWhere.ID.IN(ids),
+		}
+	}
+}
+
+func ListUsersWithNameMatching(query string) ListUsersOpt {
+	return func() []qm.QueryMod {
+		return []qm.QueryMod{
+			orm.UserWhere.FullNameSearch.LIKE(fmt.Sprintf("%%%s%%", strings.ToLower(query))),
+			qm.OrderBy(fmt.Sprintf("similarity(full_name_search, '%s') DESC", query)),
+		}
+	}
+}
+
+// ListUsersWithoutBlocked excludes users who have blocked, or are blocked by,
+// the user.
+func ListUsersWithoutBlocked(userID string) ListUsersOpt {
+	return func() []qm.QueryMod {
+		return []qm.QueryMod{
+			qm.Where(notBlockedClause(orm.TableNames.Users+".id"), userID, userID),
+		}
+	}
+}
+
+func ListUsersWithoutDeleted() ListUsersOpt {
+	return func() []qm.QueryMod {
+		return []qm.QueryMod{
+			orm.UserWhere.DeletedAt.IsNull(),
+		}
+	}
+}
+
+func ListUsersWithDeletionRequestedBefore(requestedBefore time.Time) ListUsersOpt {
+	return func() []qm.QueryMod {
+		return []qm.QueryMod{
+			orm.UserWhere.DeletionRequestedAt.LT(null.TimeFrom(requestedBefore)),
+		}
+	}
+}
+
+func ListUsersWithLimit(limit int) ListUsersOpt {
+	return func() []qm.QueryMod {
+		return []qm.QueryMod{
+			qm.Limit(limit),
+		}
+	}
+}
+
+func (r *repo) ListUsers(ctx context.Context, opts ...ListUsersOpt) (orm.UserSlice, error) {
 	query := make([]qm.QueryMod, 0, len(opts))
 	for _, opt := range opts {
 		query = append(query, opt()...)
@@ -1099,9 +2014,11 @@ func (r *repo) ListUsers(ctx context.Context, opts ...ListUsersOpt) (orm.UserSli
 }
 
 type CreateWorkoutCommentParams struct {
-	UserID    string
-	WorkoutID string
-	Comment   string
+	UserID          string
+	WorkoutID       string
+	Comment         string
+	ParentCommentID string
+	Mentions        []mention.Mention
 }
 
 type CreateWorkoutCommentOpts func(comment *orm.WorkoutComment) error
@@ -1121,19 +2038,54 @@ func (r *repo) PostCreateWorkoutCommentLoadUser(ctx context.Context) CreateWorko
 	}
 }
 
+// CreateWorkoutComment creates the comment. It returns ErrUserBlocked if the
+// author has blocked, or is blocked by, the workout owner or the author of the
+// parent comment.
 func (r *repo) CreateWorkoutComment(ctx context.Context, p CreateWorkoutCommentParams, opts ...CreateWorkoutCommentOpts) (*orm.WorkoutComment, error) {
+	workout, err := orm.FindWorkout(ctx, r.executor(), p.WorkoutID, orm.WorkoutColumns.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("workout fetch: %w", err)
+	}
+
+	userIDs := []string{workout.UserID}
+	if p.ParentCommentID != "" {
+		parent, err := orm.FindWorkoutComment(ctx, r.executor(), p.ParentCommentID, orm.WorkoutCommentColumns.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("parent comment fetch: %w", err)
+		}
+		userIDs = append(userIDs, parent.UserID)
+	}
+
+	blocked, err := r.IsBlocked(ctx, p.UserID, userIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("block check: %w", err)
+	}
+
+	if blocked {
+		return nil, ErrUserBlocked
+	}
+
 	comment := &orm.WorkoutComment{
-		UserID:    p.UserID,
-		WorkoutID: p.WorkoutID,
-		Comment:   p.Comment,
+		UserID:          p.UserID,
+		WorkoutID:       p.WorkoutID,
+		Comment:         p.Comment,
+		ParentCommentID: null.NewString(p.ParentCommentID, p.ParentCommentID != ""),
+	}
+
+	if len(p.Mentions) > 0 {
+		mentions, err := marshalMentions(p.Mentions)
+		if err != nil {
+			return nil, err
+		}
+		comment.Mentions = mentions
 	}
 
-	if err := comment.Insert(ctx, r.executor(), boil.Infer()); err != nil {
+	if err = comment.Insert(ctx, r.executor(), boil.Infer()); err != nil {
 		return nil, fmt.Errorf("workout comment insert: %w", err)
 	}
 
 	for _, opt := range opts {
-		if err := opt(comment); err != nil {
+		if err = opt(comment); err != nil {
 			return nil, fmt.Errorf("workout comment opt: %w", err)
 		}
 	}
@@ -1167,8 +2119,12 @@ type CreateNotificationParams struct {
 }
 
 type NotificationPayload struct {
-	ActorID   string `json:"actorId,omitempty"`
-	WorkoutID string `json:"workoutId,omitempty"`
+	ActorID       string `json:"actorId,omitempty"`
+	WorkoutID     string `json:"workoutId,omitempty"`
+	CommentID     string `json:"commentId,omitempty"`
+	YearSummaryID string `json:"yearSummaryId,omitempty"`
+	Reactions     int    `json:"reactions,omitempty"`
+	ChallengeID   string `json:"challengeId,omitempty"`
 }
 
 func (r *repo) CreateNotification(ctx context.Context, p CreateNotificationParams) error {
@@ -1189,6 +2145,66 @@ func (r *repo) CreateNotification(ctx context.Context, p CreateNotificationParam
 	return nil
 }
 
+// CreateGroupedNotification replaces the user's unread notification of the
+// same type and workout, so that repeated events are grouped into a single
+// notification rather than one each.
+func (r *repo) CreateGroupedNotification(ctx context.Context, p CreateNotificationParams) error {
+	if err := r.NewTx(ctx, func(tx Tx) error {
+		if _, err := orm.Notifications(
+			orm.NotificationWhere.UserID.EQ(p.UserID),
+			orm.NotificationWhere.Type.EQ(p.Type),
+			orm.NotificationWhere.ReadAt.IsNull(),
+			qm.Where("payload ->> 'workoutId' = ?", p.Payload.WorkoutID),
+		).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("notifications delete: %w", err)
+		}
+
+		if err := tx.CreateNotification(ctx, p); err != nil {
+			return fmt.Errorf("notification create: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("grouped notification tx: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateGroupedNotification replaces the payload of the user's unread grouped
+// notification for the workout, if there is one.
+func (r *repo) UpdateGroupedNotification(ctx context.Context, p CreateNotificationParams) error {
+	payload, err := json.Marshal(p.Payload)
+	if err != nil {
+		return fmt.Errorf("payload marshal: %w", err)
+	}
+
+	if _, err = orm.Notifications(
+		orm.NotificationWhere.UserID.EQ(p.UserID),
+		orm.NotificationWhere.Type.EQ(p.Type),
+		orm.NotificationWhere.ReadAt.IsNull(),
+		qm.Where("payload ->> 'workoutId' = ?", p.Payload.WorkoutID),
+	).UpdateAll(ctx, r.executor(), orm.M{orm.NotificationColumns.Payload: payload}); err != nil {
+		return fmt.Errorf("notifications update: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteGroupedNotifications deletes the user's notifications of the given
+// type for the workout, read or not.
+func (r *repo) DeleteGroupedNotifications(ctx context.Context, userID string, notificationType orm.NotificationType, workoutID string) error {
+	if _, err := orm.Notifications(
+		orm.NotificationWhere.UserID.EQ(userID),
+		orm.NotificationWhere.Type.EQ(notificationType),
+		qm.Where("payload ->> 'workoutId' = ?", workoutID),
+	).DeleteAll(ctx, r.executor()); err != nil {
+		return fmt.Errorf("notifications delete: %w", err)
+	}
+
+	return nil
+}
+
 type GetWorkoutCommentOpt func() qm.QueryMod
 
 func GetWorkoutCommentWithID(id string) GetWorkoutCommentOpt {
@@ -1203,6 +2219,18 @@ func GetWorkoutCommentWithWorkout() GetWorkoutCommentOpt {
 	}
 }
 
+func GetWorkoutCommentWithoutHidden() GetWorkoutCommentOpt {
+	return func() qm.QueryMod {
+		return orm.WorkoutCommentWhere.HiddenAt.IsNull()
+	}
+}
+
+func GetWorkoutCommentLoadUser() GetWorkoutCommentOpt {
+	return func() qm.QueryMod {
+		return qm.Load(orm.WorkoutCommentRels.User)
+	}
+}
+
 func (r *repo) GetWorkoutComment(ctx context.Context, opts ...GetWorkoutCommentOpt) (*orm.WorkoutComment, error) {
 	query := make([]qm.QueryMod, 0, len(opts))
 	for _, opt := range opts {
@@ -1322,7 +2350,7 @@ func (r *repo) MarkNotificationsAsRead(ctx context.Context, userID string) error
 }
 
 func (r *repo) IsUserFollowedByUserID(ctx context.Context, user *orm.User, userID string) (bool, error) {
-	exists, err := user.FollowerUsers(orm.UserWhere.ID.EQ(userID)).Exists(ctx, r.executor())
+	exists, err := orm.FollowerExists(ctx, r.executor(), userID, user.ID)
 	if err != nil {
 		return false, fmt.Errorf("user exists check: %w", err)
 	}
@@ -1330,6 +2358,103 @@ func (r *repo) IsUserFollowedByUserID(ctx context.Context, user *orm.User, userI
 	return exists, nil
 }
 
+// visibleToQuery restricts the rows of the table to those owned by users whose
+// content the viewer can see: their own, public users' and the private users'
+// they are an approved follower of, unless either has blocked the other.
+func visibleToQuery(table, viewerID string) qm.QueryMod {
+	return qm.Where(fmt.Sprintf(`(
+		%[1]s.user_id = ?
+		OR %[1]s.user_id IN (SELECT id FROM getstronger.users WHERE NOT private)
+		OR %[1]s.user_id IN (SELECT followee_id FROM getstronger.followers WHERE follower_id = ?)
+	) AND %[2]s`, table, notBlockedClause(table+".user_id")), viewerID, viewerID, viewerID, viewerID)
+}
+
+// notBlockedClause excludes rows where the user in the column has blocked the
+// viewer or is blocked by them. The clause expects the viewer id twice.
+func notBlockedClause(column string) string {
+	return fmt.Sprintf(`NOT EXISTS (
+		SELECT 1 FROM getstronger.user_blocks b
+		WHERE (b.blocker_id = ? AND b.blocked_id = %[1]s) OR (b.blocker_id = %[1]s AND b.blocked_id = ?)
+	)`, column)
+}
+
+// workoutVisibleToClause restricts the workouts of the table, or alias, to
+// those the viewer can see. Public workouts are visible to everyone unless the
+// owner is private, follower workouts to approved followers, and private
+// workouts to the owner only. Workouts hidden by moderation are visible to no
+// one. The clause expects the viewer id twice.
+func workoutVisibleToClause(table string) string {
+	return fmt.Sprintf(`(
+		%[1]s.user_id = ?
+		OR (%[1]s.visibility = 'Public' AND %[1]s.user_id IN (SELECT id FROM getstronger.users WHERE NOT private))
+		OR (%[1]s.visibility IN ('Public', 'Followers') AND %[1]s.user_id IN (SELECT followee_id FROM getstronger.followers WHERE follower_id = ?))
+	) AND %[1]s.hidden_at IS NULL`, table)
+}
+
+// CanViewWorkout reports whether the viewer can see the workout.
+func (r *repo) CanViewWorkout(ctx context.Context, viewerID string, workout *orm.Workout) (bool, error) {
+	if workout.HiddenAt.Valid {
+		return false, nil
+	}
+
+	if viewerID == workout.UserID {
+		return true, nil
+	}
+
+	if workout.Visibility == orm.WorkoutVisibilityPrivate {
+		return false, nil
+	}
+
+	blocked, err := r.IsBlocked(ctx, viewerID, workout.UserID)
+	if err != nil {
+		return false, fmt.Errorf("block check: %w", err)
+	}
+
+	if blocked {
+		return false, nil
+	}
+
+	owner, err := orm.FindUser(ctx, r.executor(), workout.UserID)
+	if err != nil {
+		return false, fmt.Errorf("user fetch: %w", err)
+	}
+
+	if workout.Visibility == orm.WorkoutVisibilityPublic && !owner.Private {
+		return true, nil
+	}
+
+	return r.IsUserFollowedByUserID(ctx, owner, viewerID)
+}
+
+// CanViewUser reports whether the viewer can see the user's workouts, sets and
+// personal bests. Private users are only visible to their approved followers,
+// and users are never visible to those they have blocked or are blocked by.
+func (r *repo) CanViewUser(ctx context.Context, viewerID, userID string) (bool, error) {
+	if viewerID == userID {
+		return true, nil
+	}
+
+	blocked, err := r.IsBlocked(ctx, viewerID, userID)
+	if err != nil {
+		return false, fmt.Errorf("block check: %w", err)
+	}
+
+	if blocked {
+		return false, nil
+	}
+
+	user, err := orm.FindUser(ctx, r.executor(), userID)
+	if err != nil {
+		return false, fmt.Errorf("user fetch: %w", err)
+	}
+
+	if !user.Private {
+		return true, nil
+	}
+
+	return r.IsUserFollowedByUserID(ctx, user, viewerID)
+}
+
 type GetAuthOpt func() qm.QueryMod
 
 func GetAuthByID(id string) GetAuthOpt {
@@ -1396,6 +2521,15 @@ func ListSetsWithUserID(userID ...string) ListSetsOpt {
 	}
 }
 
+// ListSetsVisibleTo excludes sets of workouts the viewer is not allowed to see.
+func ListSetsVisibleTo(viewerID string) ListSetsOpt {
+	return func() (qm.QueryMod, error) {
+		return qm.Where(fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM getstronger.workouts w WHERE w.id = sets.workout_id AND %s AND %s
+		)`, workoutVisibleToClause("w"), notBlockedClause("w.user_id")), viewerID, viewerID, viewerID, viewerID), nil
+	}
+}
+
 func ListSetsWithExerciseID(exerciseID ...string) ListSetsOpt {
 	return func() (qm.QueryMod, error) {
 		return orm.SetWhere.ExerciseID.IN(exerciseID), nil
@@ -1413,7 +2547,7 @@ func ListSetsWithPageToken(token []byte) ListSetsOpt {
 			return nil, fmt.Errorf("page token unmarshal: %w", err)
 		}
 
-		return orm.SetWhere.CreatedAt.LT(pt.CreatedAt), nil
+		return pt.after(orm.SetTableColumns.CreatedAt, orm.SetTableColumns.ID), nil
 	}
 }
 
@@ -1423,6 +2557,14 @@ func ListSetsWithID(id ...string) ListSetsOpt {
 	}
 }
 
+func ListSetsWithoutDeletedWorkouts() ListSetsOpt {
+	return func() (qm.QueryMod, error) {
+		return qm.Where(`NOT EXISTS (
+			SELECT 1 FROM getstronger.workouts w WHERE w.id = sets.workout_id AND w.deleted_at IS NOT NULL
+		)`), nil
+	}
+}
+
 func ListSetsLoadExercise() ListSetsOpt {
 	return func() (qm.QueryMod, error) {
 		return qm.Load(orm.SetRels.Exercise), nil
@@ -1431,7 +2573,7 @@ func ListSetsLoadExercise() ListSetsOpt {
 
 func ListSetsOrderByCreatedAt(order order) ListSetsOpt {
 	return func() (qm.QueryMod, error) {
-		return qm.OrderBy(fmt.Sprintf("%s %s", orm.SetColumns.CreatedAt, order)), nil
+		return qm.OrderBy(fmt.Sprintf("%s %s, %s %s", orm.SetTableColumns.CreatedAt, order, orm.SetTableColumns.ID, order)), nil
 	}
 }
 
@@ -1455,73 +2597,344 @@ func (r *repo) ListSets(ctx context.Context, opts ...ListSetsOpt) (orm.SetSlice,
 	return sets, nil
 }
 
-func (r *repo) SetRoutineExercises(ctx context.Context, routine *orm.Routine, exercises orm.ExerciseSlice) error {
-	if err := routine.SetExercises(ctx, r.executor(), false, exercises...); err != nil {
-		return fmt.Errorf("routine exercises set: %w", err)
-	}
+type ProgressInterval string
 
-	return nil
-}
+const (
+	ProgressIntervalDay   ProgressInterval = "day"
+	ProgressIntervalWeek  ProgressInterval = "week"
+	ProgressIntervalMonth ProgressInterval = "month"
+)
 
-type UpdateWorkoutOpt func() (orm.M, error)
+type GetExerciseProgressParams struct {
+	// ViewerID is the user requesting the progress. Workouts the viewer is not
+	// allowed to see are excluded.
+	ViewerID   string
+	UserID     string
+	ExerciseID string
+	Interval   ProgressInterval
+	From       time.Time
+	To         time.Time
+}
 
-func UpdateWorkoutName(name string) UpdateWorkoutOpt {
-	return func() (orm.M, error) {
-		return orm.M{
-			orm.WorkoutColumns.Name: name,
-		}, nil
-	}
+type ExerciseProgress struct {
+	Bucket       time.Time `boil:"bucket"`
+	TopSetWeight float64   `boil:"top_set_weight"`
+	TopSetReps   int       `boil:"top_set_reps"`
+	EstimatedMax float64   `boil:"estimated_max"`
+	Volume       float64   `boil:"volume"`
+	TotalReps    int       `boil:"total_reps"`
 }
 
-func UpdateWorkoutNote(note string) UpdateWorkoutOpt {
-	return func() (orm.M, error) {
-		return orm.M{
-			orm.WorkoutColumns.Note: null.NewString(note, note != ""),
-		}, nil
+var ErrInvalidProgressInterval = fmt.Errorf("invalid progress interval")
+
+// GetExerciseProgress aggregates the sets of an exercise into buckets of the
+// given interval. The estimated max is calculated with the Epley formula.
+func (r *repo) GetExerciseProgress(ctx context.Context, p GetExerciseProgressParams) ([]ExerciseProgress, error) {
+	switch p.Interval {
+	case ProgressIntervalDay, ProgressIntervalWeek, ProgressIntervalMonth:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidProgressInterval, p.Interval)
 	}
-}
 
-func UpdateWorkoutStartedAt(startedAt time.Time) UpdateWorkoutOpt {
-	return func() (orm.M, error) {
-		return orm.M{
-			orm.WorkoutColumns.StartedAt: startedAt,
-		}, nil
+	query := []qm.QueryMod{
+		qm.Select(
+			// The interval is one of the known constants checked above.
+			fmt.Sprintf("date_trunc('%s', w.finished_at) AS bucket", p.Interval),
+			"(array_agg(s.weight ORDER BY s.weight DESC, s.reps DESC))[1] AS top_set_weight",
+			"(array_agg(s.reps ORDER BY s.weight DESC, s.reps DESC))[1] AS top_set_reps",
+			"MAX(CASE WHEN s.reps = 1 THEN s.weight ELSE s.weight * (1 + s.reps / 30.0) END) AS estimated_max",
+			"SUM(s.weight * s.reps) AS volume",
+			"SUM(s.reps) AS total_reps",
+		),
+		qm.From("getstronger.sets s"),
+		qm.InnerJoin("getstronger.workouts w ON w.id = s.workout_id"),
+		qm.Where("s.user_id = ? AND s.exercise_id = ?", p.UserID, p.ExerciseID),
+		qm.Where("w.finished_at >= ? AND w.finished_at < ?", p.From, p.To),
+		qm.Where("w.deleted_at IS NULL"),
+		qm.Where(workoutVisibleToClause("w"), p.ViewerID, p.ViewerID),
+		qm.Where(notBlockedClause("w.user_id"), p.ViewerID, p.ViewerID),
+		qm.GroupBy("bucket"),
+		qm.OrderBy("bucket"),
 	}
-}
 
-func UpdateWorkoutFinishedAt(finishedAt time.Time) UpdateWorkoutOpt {
-	return func() (orm.M, error) {
-		return orm.M{
-			orm.WorkoutColumns.FinishedAt: finishedAt,
-		}, nil
+	var progress []ExerciseProgress
+	if err := orm.NewQuery(query...).Bind(ctx, r.executor(), &progress); err != nil {
+		return nil, fmt.Errorf("exercise progress fetch: %w", err)
 	}
+
+	return progress, nil
 }
 
-func (r *repo) UpdateWorkout(ctx context.Context, workoutID string, opts ...UpdateWorkoutOpt) error {
-	if _, err := r.GetWorkout(ctx, GetWorkoutWithID(workoutID)); err != nil {
-		return fmt.Errorf("workout fetch: %w", err)
-	}
+type LeaderboardMetric string
 
-	columns, err := updateColumnsFromOpts(opts)
-	if err != nil {
-		return fmt.Errorf("workout update columns: %w", err)
-	}
+const (
+	LeaderboardMetricBestEstimatedMax LeaderboardMetric = "best_estimated_max"
+	LeaderboardMetricHeaviestSingle   LeaderboardMetric = "heaviest_single"
+	LeaderboardMetricWeeklyVolume     LeaderboardMetric = "weekly_volume"
+	LeaderboardMetricSessionCount     LeaderboardMetric = "session_count"
+)
 
-	return r.NewTx(ctx, func(tx Tx) error {
-		rows, rowsErr := orm.Workouts(orm.WorkoutWhere.ID.EQ(workoutID)).UpdateAll(ctx, tx.exec(), columns)
-		if rowsErr != nil {
-			return fmt.Errorf("workout update: %w", err)
+type GetLeaderboardParams struct {
+	// ViewerID is the user requesting the leaderboard. Workouts the viewer is
+	// not allowed to see are excluded.
+	ViewerID string
+	UserIDs  []string
+	// CatalogExercise identifies the exercise across users, since each user has
+	// their own exercises linked to the catalog.
+	CatalogExercise orm.CatalogExercise
+	Metric          LeaderboardMetric
+	From            time.Time
+	To              time.Time
+	// PerBodyweight divides each set's value by the bodyweight the user had
+	// recorded when the workout finished and excludes users without one.
+	PerBodyweight bool
+}
+
+type LeaderboardEntry struct {
+	UserID string
+	Value  float64
+	Rank   int
+}
+
+var ErrInvalidLeaderboardMetric = fmt.Errorf("invalid leaderboard metric")
+
+const daysPerWeek = 7
+
+type leaderboardSet struct {
+	UserID     string    `boil:"user_id"`
+	WorkoutID  string    `boil:"workout_id"`
+	FinishedAt time.Time `boil:"finished_at"`
+	Weight     float64   `boil:"weight"`
+	Reps       int       `boil:"reps"`
+}
+
+// GetLeaderboard ranks the users on the catalog exercise by the metric over the
+// time window. Users tied on value share a rank, and users without any sets of
+// the exercise in the window are left out.
+func (r *repo) GetLeaderboard(ctx context.Context, p GetLeaderboardParams) ([]LeaderboardEntry, error) {
+	switch p.Metric {
+	case LeaderboardMetricBestEstimatedMax, LeaderboardMetricHeaviestSingle, LeaderboardMetricWeeklyVolume, LeaderboardMetricSessionCount:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidLeaderboardMetric, p.Metric)
+	}
+
+	var sets []leaderboardSet
+	if err := orm.NewQuery(
+		qm.Select("w.user_id", "w.id AS workout_id", "w.finished_at", "s.weight", "s.reps"),
+		qm.From("getstronger.sets s"),
+		qm.InnerJoin("getstronger.workouts w ON w.id = s.workout_id"),
+		qm.InnerJoin("getstronger.exercises e ON e.id = s.exercise_id"),
+		qm.Where("w.user_id = ANY(?)", types.Array(p.UserIDs)),
+		qm.Where("e.catalog_exercise = ?", p.CatalogExercise),
+		qm.Where("w.finished_at >= ? AND w.finished_at < ?", p.From, p.To),
+		qm.Where("w.deleted_at IS NULL"),
+		qm.Where(workoutVisibleToClause("w"), p.ViewerID, p.ViewerID),
+		qm.Where(notBlockedClause("w.user_id"), p.ViewerID, p.ViewerID),
+	).Bind(ctx, r.executor(), &sets); err != nil {
+		return nil, fmt.Errorf("leaderboard sets fetch: %w", err)
+	}
+
+	bodyweights := make(map[string]orm.BodyweightSlice)
+	if p.PerBodyweight {
+		history, err := orm.Bodyweights(
+			orm.BodyweightWhere.UserID.IN(p.UserIDs),
+			qm.OrderBy(fmt.Sprintf("%s ASC", orm.BodyweightColumns.CreatedAt)),
+		).All(ctx, r.executor())
+		if err != nil {
+			return nil, fmt.Errorf("bodyweights fetch: %w", err)
 		}
 
-		if rows > 1 {
-			return fmt.Errorf("%w: expected 1, got %d", ErrUpdateRowsAffected, rows)
+		for _, bodyweight := range history {
+			bodyweights[bodyweight.UserID] = append(bodyweights[bodyweight.UserID], bodyweight)
 		}
+	}
 
-		return nil
-	})
-}
-
-type UpdateWorkoutSetsParams struct {
+	weeks := math.Max(1, p.To.Sub(p.From).Hours()/24/daysPerWeek)
+	values := make(map[string]float64)
+	sessions := make(map[string]map[string]struct{})
+	for _, set := range sets {
+		var value float64
+		switch p.Metric {
+		case LeaderboardMetricBestEstimatedMax:
+			value = strength.EstimatedOneRepMax(set.Weight, set.Reps)
+		case LeaderboardMetricHeaviestSingle:
+			if set.Reps != 1 {
+				continue
+			}
+			value = set.Weight
+		case LeaderboardMetricWeeklyVolume:
+			value = set.Weight * float64(set.Reps) / weeks
+		case LeaderboardMetricSessionCount:
+			if sessions[set.UserID] == nil {
+				sessions[set.UserID] = make(map[string]struct{})
+			}
+			sessions[set.UserID][set.WorkoutID] = struct{}{}
+			values[set.UserID] = float64(len(sessions[set.UserID]))
+			continue
+		}
+
+		if p.PerBodyweight {
+			bodyweight, ok := bodyweightAt(bodyweights[set.UserID], set.FinishedAt)
+			if !ok {
+				continue
+			}
+			value /= bodyweight
+		}
+
+		current, ok := values[set.UserID]
+		switch {
+		case p.Metric == LeaderboardMetricWeeklyVolume:
+			values[set.UserID] = current + value
+		case !ok || value > current:
+			values[set.UserID] = value
+		}
+	}
+
+	return rankLeaderboard(values), nil
+}
+
+// rankLeaderboard orders the users by value, giving users tied on value the
+// same rank.
+func rankLeaderboard(values map[string]float64) []LeaderboardEntry {
+	entries := make([]LeaderboardEntry, 0, len(values))
+	for userID, value := range values {
+		entries = append(entries, LeaderboardEntry{UserID: userID, Value: value})
+	}
+
+	slices.SortFunc(entries, func(a, b LeaderboardEntry) int {
+		if c := cmp.Compare(b.Value, a.Value); c != 0 {
+			return c
+		}
+		return strings.Compare(a.UserID, b.UserID)
+	})
+
+	for i := range entries {
+		entries[i].Rank = i + 1
+		if i > 0 && entries[i].Value == entries[i-1].Value {
+			entries[i].Rank = entries[i-1].Rank
+		}
+	}
+
+	return entries
+}
+
+// bodyweightAt returns the latest bodyweight recorded at or before the given
+// time, falling back to the earliest one. The history is expected to be sorted
+// by creation time.
+func bodyweightAt(history orm.BodyweightSlice, at time.Time) (float64, bool) {
+	if len(history) == 0 {
+		return 0, false
+	}
+
+	bodyweight := history[0].Bodyweight
+	for _, b := range history {
+		if b.CreatedAt.After(at) {
+			break
+		}
+		bodyweight = b.Bodyweight
+	}
+
+	return bodyweight, bodyweight > 0
+}
+
+func (r *repo) SetRoutineExercises(ctx context.Context, routine *orm.Routine, exercises orm.ExerciseSlice) error {
+	return r.NewTx(ctx, func(tx Tx) error {
+		if err := routine.SetExercises(ctx, tx.exec(), false, exercises...); err != nil {
+			return fmt.Errorf("routine exercises set: %w", err)
+		}
+
+		mapExerciseIDs := make(map[string]struct{}, len(exercises))
+		for _, exercise := range exercises {
+			mapExerciseIDs[exercise.ID] = struct{}{}
+		}
+
+		if err := pruneRoutineExerciseTargets(ctx, tx, routine, func(exerciseID string) bool {
+			_, ok := mapExerciseIDs[exerciseID]
+			return ok
+		}); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+type UpdateWorkoutOpt func() (orm.M, error)
+
+func UpdateWorkoutName(name string) UpdateWorkoutOpt {
+	return func() (orm.M, error) {
+		return orm.M{
+			orm.WorkoutColumns.Name: name,
+		}, nil
+	}
+}
+
+func UpdateWorkoutNote(note string) UpdateWorkoutOpt {
+	return func() (orm.M, error) {
+		return orm.M{
+			orm.WorkoutColumns.Note: null.NewString(note, note != ""),
+		}, nil
+	}
+}
+
+func UpdateWorkoutNoteMentions(mentions []mention.Mention) UpdateWorkoutOpt {
+	return func() (orm.M, error) {
+		bytes, err := marshalMentions(mentions)
+		if err != nil {
+			return nil, err
+		}
+
+		return orm.M{orm.WorkoutColumns.NoteMentions: bytes}, nil
+	}
+}
+
+func UpdateWorkoutVisibility(visibility orm.WorkoutVisibility) UpdateWorkoutOpt {
+	return func() (orm.M, error) {
+		return orm.M{orm.WorkoutColumns.Visibility: visibility}, nil
+	}
+}
+
+func UpdateWorkoutStartedAt(startedAt time.Time) UpdateWorkoutOpt {
+	return func() (orm.M, error) {
+		return orm.M{
+			orm.WorkoutColumns.StartedAt: startedAt,
+		}, nil
+	}
+}
+
+func UpdateWorkoutFinishedAt(finishedAt time.Time) UpdateWorkoutOpt {
+	return func() (orm.M, error) {
+		return orm.M{
+			orm.WorkoutColumns.FinishedAt: finishedAt,
+		}, nil
+	}
+}
+
+func (r *repo) UpdateWorkout(ctx context.Context, workoutID string, opts ...UpdateWorkoutOpt) error {
+	if _, err := r.GetWorkout(ctx, GetWorkoutWithID(workoutID)); err != nil {
+		return fmt.Errorf("workout fetch: %w", err)
+	}
+
+	columns, err := updateColumnsFromOpts(opts)
+	if err != nil {
+		return fmt.Errorf("workout update columns: %w", err)
+	}
+
+	return r.NewTx(ctx, func(tx Tx) error {
+		rows, rowsErr := orm.Workouts(orm.WorkoutWhere.ID.EQ(workoutID)).UpdateAll(ctx, tx.exec(), columns)
+		if rowsErr != nil {
+			return fmt.Errorf("workout update: %w", err)
+		}
+
+		if rows > 1 {
+			return fmt.Errorf("%w: expected 1, got %d", ErrUpdateRowsAffected, rows)
+		}
+
+		return nil
+	})
+}
+
+type UpdateWorkoutSetsParams struct {
 	WorkoutID    string
 	ExerciseSets []ExerciseSet
 }
@@ -1605,3 +3018,2557 @@ func ListWorkoutsLoadExercises() ListWorkoutsOpt {
 		}, nil
 	}
 }
+
+type YearSummaryExercise struct {
+	ExerciseID string `boil:"exercise_id" json:"exerciseId"`
+	Name       string `boil:"name"        json:"name"`
+	Sets       int    `boil:"sets"        json:"sets"`
+}
+
+type YearSummaryFollowee struct {
+	UserID    string `boil:"user_id"    json:"userId"`
+	FirstName string `boil:"first_name" json:"firstName"`
+	LastName  string `boil:"last_name"  json:"lastName"`
+	Workouts  int    `boil:"workouts"   json:"workouts"`
+}
+
+type YearSummaryPayload struct {
+	Sessions             int                   `json:"sessions"`
+	Tonnage              float64               `json:"tonnage"`
+	MostTrainedExercises []YearSummaryExercise `json:"mostTrainedExercises"`
+	PersonalBests        int                   `json:"personalBests"`
+	LongestStreakWeeks   int                   `json:"longestStreakWeeks"`
+	MostActiveFollowee   *YearSummaryFollowee  `json:"mostActiveFollowee,omitempty"`
+}
+
+const yearSummaryMostTrainedExercises = 3
+
+func yearBounds(year int) (time.Time, time.Time) {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(1, 0, 0)
+}
+
+// BuildYearSummary aggregates the training of a user over a calendar year.
+// A personal best is counted when the heaviest set of an exercise in a workout
+// is heavier than every set of the exercise in earlier workouts, and streaks
+// are counted in consecutive weeks. Workouts hidden by moderation are left out.
+func (r *repo) BuildYearSummary(ctx context.Context, userID string, year int) (*YearSummaryPayload, error) {
+	start, end := yearBounds(year)
+
+	var totals struct {
+		Sessions int     `boil:"sessions"`
+		Tonnage  float64 `boil:"tonnage"`
+	}
+	if err := queries.Raw(`
+	SELECT COUNT(DISTINCT w.id) AS sessions, COALESCE(SUM(s.weight * s.reps), 0) AS tonnage
+	FROM getstronger.workouts w
+	LEFT JOIN getstronger.sets s ON s.workout_id = w.id
+	WHERE w.user_id = $1 AND w.finished_at >= $2 AND w.finished_at < $3 AND w.deleted_at IS NULL AND w.hidden_at IS NULL;
+`, userID, start, end).Bind(ctx, r.executor(), &totals); err != nil {
+		return nil, fmt.Errorf("totals fetch: %w", err)
+	}
+
+	var exercises []YearSummaryExercise
+	if err := queries.Raw(`
+	SELECT e.id AS exercise_id, e.title AS name, COUNT(*) AS sets
+	FROM getstronger.sets s
+	JOIN getstronger.workouts w ON w.id = s.workout_id
+	JOIN getstronger.exercises e ON e.id = s.exercise_id
+	WHERE w.user_id = $1 AND w.finished_at >= $2 AND w.finished_at < $3 AND w.deleted_at IS NULL AND w.hidden_at IS NULL
+	GROUP BY e.id, e.title
+	ORDER BY sets DESC, e.title
+	LIMIT $4;
+`, userID, start, end, yearSummaryMostTrainedExercises).Bind(ctx, r.executor(), &exercises); err != nil {
+		return nil, fmt.Errorf("exercises fetch: %w", err)
+	}
+
+	var personalBests struct {
+		Count int `boil:"count"`
+	}
+	if err := queries.Raw(`
+	SELECT COUNT(*) AS count
+	FROM (
+		SELECT w.finished_at, MAX(s.weight) AS best,
+		       MAX(MAX(s.weight)) OVER (
+		           PARTITION BY s.exercise_id
+		           ORDER BY w.finished_at, w.id
+		           ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING
+		       ) AS previous_best
+		FROM getstronger.sets s
+		JOIN getstronger.workouts w ON w.id = s.workout_id
+		WHERE w.user_id = $1 AND w.finished_at < $3 AND w.deleted_at IS NULL AND w.hidden_at IS NULL
+		GROUP BY s.exercise_id, w.id, w.finished_at
+	) t
+	WHERE t.finished_at >= $2 AND t.best > t.previous_best;
+`, userID, start, end).Bind(ctx, r.executor(), &personalBests); err != nil {
+		return nil, fmt.Errorf("personal bests fetch: %w", err)
+	}
+
+	var streak struct {
+		Weeks int `boil:"weeks"`
+	}
+	if err := queries.Raw(`
+	WITH weeks AS (
+		SELECT DISTINCT date_trunc('week', finished_at) AS week
+		FROM getstronger.workouts
+		WHERE user_id = $1 AND finished_at >= $2 AND finished_at < $3 AND deleted_at IS NULL AND hidden_at IS NULL
+	), streaks AS (
+		SELECT week - (ROW_NUMBER() OVER (ORDER BY week) * INTERVAL '1 week') AS streak
+		FROM weeks
+	)
+	SELECT COALESCE(MAX(weeks), 0) AS weeks
+	FROM (SELECT COUNT(*) AS weeks FROM streaks GROUP BY streak) t;
+`, userID, start, end).Bind(ctx, r.executor(), &streak); err != nil {
+		return nil, fmt.Errorf("streak fetch: %w", err)
+	}
+
+	// Only workouts the user can see count, since the summary is shared.
+	var followees []*YearSummaryFollowee
+	if err := orm.NewQuery(
+		qm.Select("u.id AS user_id", "u.first_name", "u.last_name", "COUNT(w.id) AS workouts"),
+		qm.From("getstronger.followers f"),
+		qm.InnerJoin("getstronger.users u ON u.id = f.followee_id"),
+		qm.InnerJoin("getstronger.workouts w ON w.user_id = u.id"),
+		qm.Where("f.follower_id = ?", userID),
+		qm.Where("w.finished_at >= ? AND w.finished_at < ?", start, end),
+		qm.Where("w.deleted_at IS NULL"),
+		qm.Where(workoutVisibleToClause("w"), userID, userID),
+		qm.Where(notBlockedClause("f.followee_id"), userID, userID),
+		qm.GroupBy("u.id, u.first_name, u.last_name"),
+		qm.OrderBy("workouts DESC, u.id"),
+		qm.Limit(1),
+	).Bind(ctx, r.executor(), &followees); err != nil {
+		return nil, fmt.Errorf("followees fetch: %w", err)
+	}
+
+	summary := &YearSummaryPayload{
+		Sessions:             totals.Sessions,
+		Tonnage:              totals.Tonnage,
+		MostTrainedExercises: exercises,
+		PersonalBests:        personalBests.Count,
+		LongestStreakWeeks:   streak.Weeks,
+		MostActiveFollowee:   nil,
+	}
+
+	if len(followees) > 0 {
+		summary.MostActiveFollowee = followees[0]
+	}
+
+	return summary, nil
+}
+
+// ListYearSummaryCandidates returns the IDs of users who trained during the
+// year but do not have a summary for it yet.
+func (r *repo) ListYearSummaryCandidates(ctx context.Context, year int) ([]string, error) {
+	start, end := yearBounds(year)
+
+	var candidates []struct {
+		UserID string `boil:"user_id"`
+	}
+	if err := queries.Raw(`
+	SELECT DISTINCT w.user_id
+	FROM getstronger.workouts w
+	WHERE w.finished_at >= $1 AND w.finished_at < $2 AND w.deleted_at IS NULL AND w.hidden_at IS NULL
+	  AND NOT EXISTS (
+		SELECT 1 FROM getstronger.year_summaries ys WHERE ys.user_id = w.user_id AND ys.year = $3
+	  );
+`, start, end, year).Bind(ctx, r.executor(), &candidates); err != nil {
+		return nil, fmt.Errorf("candidates fetch: %w", err)
+	}
+
+	userIDs := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		userIDs = append(userIDs, candidate.UserID)
+	}
+
+	return userIDs, nil
+}
+
+type CreateYearSummaryParams struct {
+	UserID  string
+	Year    int
+	Payload YearSummaryPayload
+}
+
+func (r *repo) CreateYearSummary(ctx context.Context, p CreateYearSummaryParams) (*orm.YearSummary, error) {
+	payload, err := json.Marshal(p.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("payload marshal: %w", err)
+	}
+
+	summary := &orm.YearSummary{
+		UserID:  p.UserID,
+		Year:    p.Year,
+		Payload: payload,
+	}
+	if err = summary.Insert(ctx, r.executor(), boil.Infer()); err != nil {
+		return nil, fmt.Errorf("year summary insert: %w", err)
+	}
+
+	return summary, nil
+}
+
+type GetYearSummaryOpt func() qm.QueryMod
+
+func GetYearSummaryWithUserID(userID string) GetYearSummaryOpt {
+	return func() qm.QueryMod {
+		return orm.YearSummaryWhere.UserID.EQ(userID)
+	}
+}
+
+func GetYearSummaryWithYear(year int) GetYearSummaryOpt {
+	return func() qm.QueryMod {
+		return orm.YearSummaryWhere.Year.EQ(year)
+	}
+}
+
+func GetYearSummaryLoadUser() GetYearSummaryOpt {
+	return func() qm.QueryMod {
+		return qm.Load(orm.YearSummaryRels.User)
+	}
+}
+
+func (r *repo) GetYearSummary(ctx context.Context, opts ...GetYearSummaryOpt) (*orm.YearSummary, error) {
+	query := make([]qm.QueryMod, 0, len(opts))
+	for _, opt := range opts {
+		query = append(query, opt())
+	}
+
+	summary, err := orm.YearSummaries(query...).One(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("year summary fetch: %w", err)
+	}
+
+	return summary, nil
+}
+
+type ListYearSummariesOpt func() ([]qm.QueryMod, error)
+
+func ListYearSummariesWithIDs(ids []string) ListYearSummariesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.YearSummaryWhere.ID.IN(ids),
+		}, nil
+	}
+}
+
+func ListYearSummariesWithUserIDs(userIDs ...string) ListYearSummariesOpt {
+	return func() ([]qm.QueryMod, error) {
+		if len(userIDs) == 0 {
+			return nil, nil
+		}
+
+		return []qm.QueryMod{
+			orm.YearSummaryWhere.UserID.IN(userIDs),
+		}, nil
+	}
+}
+
+// ListYearSummariesVisibleTo excludes summaries of private users the viewer
+// does not follow.
+func ListYearSummariesVisibleTo(viewerID string) ListYearSummariesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			visibleToQuery(orm.TableNames.YearSummaries, viewerID),
+		}, nil
+	}
+}
+
+func ListYearSummariesWithPageToken(token []byte) ListYearSummariesOpt {
+	return func() ([]qm.QueryMod, error) {
+		if len(token) == 0 {
+			return nil, nil
+		}
+
+		var pageToken PageToken
+		if err := json.Unmarshal(token, &pageToken); err != nil {
+			return nil, fmt.Errorf("page token unmarshal: %w", err)
+		}
+
+		return []qm.QueryMod{
+			pageToken.after(orm.YearSummaryTableColumns.CreatedAt, orm.YearSummaryTableColumns.ID),
+		}, nil
+	}
+}
+
+func ListYearSummariesLoadUser() ListYearSummariesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Load(orm.YearSummaryRels.User),
+		}, nil
+	}
+}
+
+func (r *repo) ListYearSummaries(ctx context.Context, opts ...ListYearSummariesOpt) (orm.YearSummarySlice, error) {
+	query := []qm.QueryMod{
+		qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC", orm.YearSummaryTableColumns.CreatedAt, orm.YearSummaryTableColumns.ID)),
+	}
+	for _, opt := range opts {
+		q, err := opt()
+		if err != nil {
+			return nil, fmt.Errorf("year summaries list opt: %w", err)
+		}
+
+		query = append(query, q...)
+	}
+
+	summaries, err := orm.YearSummaries(query...).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("year summaries fetch: %w", err)
+	}
+
+	return summaries, nil
+}
+
+type UpdateWorkoutCommentOpt func() (orm.M, error)
+
+// UpdateWorkoutCommentText updates the comment text and marks it as edited.
+func UpdateWorkoutCommentText(comment string) UpdateWorkoutCommentOpt {
+	return func() (orm.M, error) {
+		return orm.M{
+			orm.WorkoutCommentColumns.Comment:  comment,
+			orm.WorkoutCommentColumns.EditedAt: null.TimeFrom(time.Now().UTC()),
+		}, nil
+	}
+}
+
+func UpdateWorkoutCommentMentions(mentions []mention.Mention) UpdateWorkoutCommentOpt {
+	return func() (orm.M, error) {
+		bytes, err := marshalMentions(mentions)
+		if err != nil {
+			return nil, err
+		}
+
+		return orm.M{orm.WorkoutCommentColumns.Mentions: bytes}, nil
+	}
+}
+
+func (r *repo) UpdateWorkoutComment(ctx context.Context, commentID string, opts ...UpdateWorkoutCommentOpt) error {
+	columns, err := updateColumnsFromOpts(opts)
+	if err != nil {
+		return fmt.Errorf("workout comment update columns: %w", err)
+	}
+
+	rows, err := orm.WorkoutComments(orm.WorkoutCommentWhere.ID.EQ(commentID)).UpdateAll(ctx, r.executor(), columns)
+	if err != nil {
+		return fmt.Errorf("workout comment update: %w", err)
+	}
+
+	if rows != 1 {
+		return fmt.Errorf("%w: expected 1, got %d", ErrUpdateRowsAffected, rows)
+	}
+
+	return nil
+}
+
+// DeleteWorkoutComment deletes the comment along with its replies, which are
+// removed by the foreign key cascade, and the notifications for all of them.
+func (r *repo) DeleteWorkoutComment(ctx context.Context, commentID string) error {
+	if err := r.NewTx(ctx, func(tx Tx) error {
+		if _, err := queries.Raw(`
+			WITH RECURSIVE thread AS (
+				SELECT id FROM getstronger.workout_comments WHERE id = $1
+				UNION ALL
+				SELECT c.id FROM getstronger.workout_comments c JOIN thread t ON c.parent_comment_id = t.id
+			)
+			DELETE FROM getstronger.notifications WHERE payload ->> 'commentId' IN (SELECT id::text FROM thread);
+		`, commentID).ExecContext(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("notifications delete: %w", err)
+		}
+
+		rows, err := orm.WorkoutComments(orm.WorkoutCommentWhere.ID.EQ(commentID)).DeleteAll(ctx, tx.exec())
+		if err != nil {
+			return fmt.Errorf("workout comment delete: %w", err)
+		}
+
+		if rows == 0 {
+			return sql.ErrNoRows
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("workout comment delete tx: %w", err)
+	}
+
+	return nil
+}
+
+type ListWorkoutCommentsOpt func() ([]qm.QueryMod, error)
+
+func ListWorkoutCommentsWithUserID(userID string) ListWorkoutCommentsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.WorkoutCommentWhere.UserID.EQ(userID),
+		}, nil
+	}
+}
+
+func (r *repo) ListWorkoutComments(ctx context.Context, opts ...ListWorkoutCommentsOpt) (orm.WorkoutCommentSlice, error) {
+	query := []qm.QueryMod{
+		qm.OrderBy(fmt.Sprintf("%s ASC", orm.WorkoutCommentColumns.CreatedAt)),
+	}
+	for _, opt := range opts {
+		q, err := opt()
+		if err != nil {
+			return nil, fmt.Errorf("workout comments list opt: %w", err)
+		}
+
+		query = append(query, q...)
+	}
+
+	comments, err := orm.WorkoutComments(query...).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("workout comments fetch: %w", err)
+	}
+
+	return comments, nil
+}
+
+func (r *repo) CreateDataExport(ctx context.Context, userID string) (*orm.DataExport, error) {
+	export := &orm.DataExport{
+		UserID: userID,
+	}
+	if err := export.Insert(ctx, r.executor(), boil.Infer()); err != nil {
+		return nil, fmt.Errorf("data export insert: %w", err)
+	}
+
+	return export, nil
+}
+
+type GetDataExportOpt func() qm.QueryMod
+
+func GetDataExportWithID(id string) GetDataExportOpt {
+	return func() qm.QueryMod {
+		return orm.DataExportWhere.ID.EQ(id)
+	}
+}
+
+func GetDataExportWithToken(token string) GetDataExportOpt {
+	return func() qm.QueryMod {
+		return orm.DataExportWhere.Token.EQ(token)
+	}
+}
+
+func GetDataExportWithUserID(userID string) GetDataExportOpt {
+	return func() qm.QueryMod {
+		return orm.DataExportWhere.UserID.EQ(userID)
+	}
+}
+
+func GetDataExportOnlyCompleted() GetDataExportOpt {
+	return func() qm.QueryMod {
+		return orm.DataExportWhere.CompletedAt.IsNotNull()
+	}
+}
+
+func (r *repo) GetDataExport(ctx context.Context, opts ...GetDataExportOpt) (*orm.DataExport, error) {
+	query := make([]qm.QueryMod, 0, len(opts))
+	for _, opt := range opts {
+		query = append(query, opt())
+	}
+
+	export, err := orm.DataExports(query...).One(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("data export fetch: %w", err)
+	}
+
+	return export, nil
+}
+
+// CompleteDataExport stores the archive of a data export and marks it as
+// ready for download.
+func (r *repo) CompleteDataExport(ctx context.Context, exportID string, archive []byte) error {
+	rows, err := orm.DataExports(
+		orm.DataExportWhere.ID.EQ(exportID),
+	).UpdateAll(ctx, r.executor(), orm.M{
+		orm.DataExportColumns.Archive:     archive,
+		orm.DataExportColumns.CompletedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("data export update: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// PurgeDataExports permanently deletes data exports created before the given
+// time, regardless of whether they were downloaded.
+func (r *repo) PurgeDataExports(ctx context.Context, createdBefore time.Time) (int64, error) {
+	rows, err := orm.DataExports(
+		orm.DataExportWhere.CreatedAt.LT(createdBefore),
+	).DeleteAll(ctx, r.executor())
+	if err != nil {
+		return 0, fmt.Errorf("data exports delete: %w", err)
+	}
+
+	return rows, nil
+}
+
+// DeleteAccount removes everything belonging to the user and anonymizes the
+// user and auth rows. The rows are kept so that comments left on other users'
+// workouts can still be shown, attributed to a deleted user.
+func (r *repo) DeleteAccount(ctx context.Context, userID string) error {
+	if err := r.NewTx(ctx, func(tx Tx) error {
+		user, err := orm.FindUser(ctx, tx.exec(), userID)
+		if err != nil {
+			return fmt.Errorf("user fetch: %w", err)
+		}
+
+		workouts, err := orm.Workouts(orm.WorkoutWhere.UserID.EQ(userID)).All(ctx, tx.exec())
+		if err != nil {
+			return fmt.Errorf("workouts fetch: %w", err)
+		}
+
+		workoutIDs := make([]string, 0, len(workouts))
+		for _, workout := range workouts {
+			workoutIDs = append(workoutIDs, workout.ID)
+		}
+
+		if _, err = orm.Sets(orm.SetWhere.WorkoutID.IN(workoutIDs)).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("sets delete: %w", err)
+		}
+
+		if _, err = orm.WorkoutComments(orm.WorkoutCommentWhere.WorkoutID.IN(workoutIDs)).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("workout comments delete: %w", err)
+		}
+
+		if _, err = orm.Notifications(
+			qm.Where("user_id = ? OR payload ->> 'actorId' = ? OR payload ->> 'workoutId' = ANY(?)", userID, userID, types.Array(workoutIDs)),
+		).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("notifications delete: %w", err)
+		}
+
+		if _, err = workouts.DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("workouts delete: %w", err)
+		}
+
+		if _, err = queries.Raw(`
+DELETE FROM getstronger.exercises_routines
+WHERE routine_id IN (SELECT id FROM getstronger.routines WHERE user_id = $1)
+   OR exercise_id IN (SELECT id FROM getstronger.exercises WHERE user_id = $1);
+`, userID).ExecContext(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("routine exercises delete: %w", err)
+		}
+
+		if _, err = orm.Routines(orm.RoutineWhere.UserID.EQ(userID)).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("routines delete: %w", err)
+		}
+
+		if _, err = orm.Exercises(orm.ExerciseWhere.UserID.EQ(userID)).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("exercises delete: %w", err)
+		}
+
+		if _, err = queries.Raw(`
+DELETE FROM getstronger.followers WHERE follower_id = $1 OR followee_id = $1;
+`, userID).ExecContext(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("followers delete: %w", err)
+		}
+
+		if _, err = orm.FollowRequests(
+			qm.Where("follower_id = ? OR followee_id = ?", userID, userID),
+		).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("follow requests delete: %w", err)
+		}
+
+		if _, err = orm.UserBlocks(
+			qm.Where("blocker_id = ? OR blocked_id = ?", userID, userID),
+		).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("user blocks delete: %w", err)
+		}
+
+		if _, err = orm.ChallengeParticipants(orm.ChallengeParticipantWhere.UserID.EQ(userID)).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("challenge participants delete: %w", err)
+		}
+
+		if err = deleteClubMemberships(ctx, tx.exec(), userID); err != nil {
+			return fmt.Errorf("club memberships delete: %w", err)
+		}
+
+		if _, err = orm.Coaches(
+			qm.Where("coach_id = ? OR athlete_id = ?", userID, userID),
+		).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("coaches delete: %w", err)
+		}
+
+		if _, err = orm.CoachActions(orm.CoachActionWhere.AthleteID.EQ(userID)).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("coach actions delete: %w", err)
+		}
+
+		if _, err = orm.YearSummaries(orm.YearSummaryWhere.UserID.EQ(userID)).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("year summaries delete: %w", err)
+		}
+
+		if _, err = orm.Achievements(orm.AchievementWhere.UserID.EQ(userID)).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("achievements delete: %w", err)
+		}
+
+		if _, err = orm.PersonalRecords(orm.PersonalRecordWhere.UserID.EQ(userID)).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("personal records delete: %w", err)
+		}
+
+		if _, err = orm.WorkoutReactions(orm.WorkoutReactionWhere.UserID.EQ(userID)).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("workout reactions delete: %w", err)
+		}
+
+		if _, err = orm.DataExports(orm.DataExportWhere.UserID.EQ(userID)).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("data exports delete: %w", err)
+		}
+
+		if _, err = orm.Bodyweights(orm.BodyweightWhere.UserID.EQ(userID)).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("bodyweights delete: %w", err)
+		}
+
+		if _, err = orm.Users(orm.UserWhere.ID.EQ(userID)).UpdateAll(ctx, tx.exec(), orm.M{
+			orm.UserColumns.FirstName:           DeletedUserFirstName,
+			orm.UserColumns.LastName:            DeletedUserLastName,
+			orm.UserColumns.Sex:                 orm.NullSex{},
+			orm.UserColumns.BirthDate:           null.Time{},
+			orm.UserColumns.Bodyweight:          null.Float64{},
+			orm.UserColumns.Private:             false,
+			orm.UserColumns.DeletionRequestedAt: null.Time{},
+			orm.UserColumns.DeletedAt:           null.TimeFrom(time.Now().UTC()),
+		}); err != nil {
+			return fmt.Errorf("user anonymize: %w", err)
+		}
+
+		// The auth row is anonymized rather than deleted since older users
+		// share their id with their auth row.
+		if _, err = orm.Auths(orm.AuthWhere.ID.EQ(user.AuthID)).UpdateAll(ctx, tx.exec(), orm.M{
+			orm.AuthColumns.Email:                        fmt.Sprintf("deleted+%s@getstronger.invalid", user.AuthID),
+			orm.AuthColumns.Password:                     []byte{},
+			orm.AuthColumns.RefreshToken:                 nil,
+			orm.AuthColumns.EmailVerified:                false,
+			orm.AuthColumns.PasswordResetToken:           nil,
+			orm.AuthColumns.PasswordResetTokenValidUntil: nil,
+		}); err != nil {
+			return fmt.Errorf("auth anonymize: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("account delete tx: %w", err)
+	}
+
+	return nil
+}
+
+type GetWorkoutReactionOpt func() qm.QueryMod
+
+func GetWorkoutReactionWithID(id string) GetWorkoutReactionOpt {
+	return func() qm.QueryMod {
+		return orm.WorkoutReactionWhere.ID.EQ(id)
+	}
+}
+
+func GetWorkoutReactionLoadWorkout() GetWorkoutReactionOpt {
+	return func() qm.QueryMod {
+		return qm.Load(orm.WorkoutReactionRels.Workout)
+	}
+}
+
+func (r *repo) GetWorkoutReaction(ctx context.Context, opts ...GetWorkoutReactionOpt) (*orm.WorkoutReaction, error) {
+	query := make([]qm.QueryMod, 0, len(opts))
+	for _, opt := range opts {
+		query = append(query, opt())
+	}
+
+	reaction, err := orm.WorkoutReactions(query...).One(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("workout reaction fetch: %w", err)
+	}
+
+	return reaction, nil
+}
+
+type ListWorkoutReactionsOpt func() ([]qm.QueryMod, error)
+
+func ListWorkoutReactionsWithWorkoutID(workoutID string) ListWorkoutReactionsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.WorkoutReactionWhere.WorkoutID.EQ(workoutID),
+		}, nil
+	}
+}
+
+func ListWorkoutReactionsLoadUser() ListWorkoutReactionsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Load(orm.WorkoutReactionRels.User),
+		}, nil
+	}
+}
+
+func (r *repo) ListWorkoutReactions(ctx context.Context, opts ...ListWorkoutReactionsOpt) (orm.WorkoutReactionSlice, error) {
+	query := []qm.QueryMod{
+		qm.OrderBy(fmt.Sprintf("%s DESC", orm.WorkoutReactionColumns.CreatedAt)),
+	}
+	for _, opt := range opts {
+		q, err := opt()
+		if err != nil {
+			return nil, fmt.Errorf("workout reaction list opt: %w", err)
+		}
+		query = append(query, q...)
+	}
+
+	reactions, err := orm.WorkoutReactions(query...).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("workout reactions fetch: %w", err)
+	}
+
+	return reactions, nil
+}
+
+type UpsertWorkoutReactionParams struct {
+	UserID    string
+	WorkoutID string
+	Type      orm.ReactionType
+}
+
+// UpsertWorkoutReaction reacts to the workout on behalf of the user. A user has
+// at most one reaction per workout, so reacting again replaces the type.
+func (r *repo) UpsertWorkoutReaction(ctx context.Context, p UpsertWorkoutReactionParams) (*orm.WorkoutReaction, error) {
+	reaction := &orm.WorkoutReaction{
+		UserID:    p.UserID,
+		WorkoutID: p.WorkoutID,
+		Type:      p.Type,
+	}
+
+	if err := reaction.Upsert(ctx, r.executor(), true,
+		[]string{orm.WorkoutReactionColumns.UserID, orm.WorkoutReactionColumns.WorkoutID},
+		boil.Whitelist(orm.WorkoutReactionColumns.Type),
+		boil.Infer(),
+	); err != nil {
+		return nil, fmt.Errorf("workout reaction upsert: %w", err)
+	}
+
+	return reaction, nil
+}
+
+func (r *repo) DeleteWorkoutReaction(ctx context.Context, userID, workoutID string) error {
+	rows, err := orm.WorkoutReactions(
+		orm.WorkoutReactionWhere.UserID.EQ(userID),
+		orm.WorkoutReactionWhere.WorkoutID.EQ(workoutID),
+	).DeleteAll(ctx, r.executor())
+	if err != nil {
+		return fmt.Errorf("workout reaction delete: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// CreateFollowRequest requests to follow a private user. Requesting again while
+// a request is pending is a no-op. It returns ErrUserBlocked if either user has
+// blocked the other.
+func (r *repo) CreateFollowRequest(ctx context.Context, p FollowParams) error {
+	blocked, err := r.IsBlocked(ctx, p.FollowerID, p.FolloweeID)
+	if err != nil {
+		return fmt.Errorf("block check: %w", err)
+	}
+
+	if blocked {
+		return ErrUserBlocked
+	}
+
+	request := &orm.FollowRequest{
+		FollowerID: p.FollowerID,
+		FolloweeID: p.FolloweeID,
+	}
+
+	if err = request.Upsert(ctx, r.executor(), false,
+		[]string{orm.FollowRequestColumns.FollowerID, orm.FollowRequestColumns.FolloweeID},
+		boil.None(),
+		boil.Infer(),
+	); err != nil {
+		return fmt.Errorf("follow request upsert: %w", err)
+	}
+
+	return nil
+}
+
+type ListFollowRequestsOpt func() ([]qm.QueryMod, error)
+
+func ListFollowRequestsWithFolloweeID(followeeID string) ListFollowRequestsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.FollowRequestWhere.FolloweeID.EQ(followeeID),
+		}, nil
+	}
+}
+
+func ListFollowRequestsWithFollowerID(followerID string) ListFollowRequestsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.FollowRequestWhere.FollowerID.EQ(followerID),
+		}, nil
+	}
+}
+
+func ListFollowRequestsLoadFollower() ListFollowRequestsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Load(orm.FollowRequestRels.Follower),
+		}, nil
+	}
+}
+
+func (r *repo) ListFollowRequests(ctx context.Context, opts ...ListFollowRequestsOpt) (orm.FollowRequestSlice, error) {
+	query := []qm.QueryMod{
+		qm.OrderBy(fmt.Sprintf("%s DESC", orm.FollowRequestColumns.CreatedAt)),
+	}
+	for _, opt := range opts {
+		q, err := opt()
+		if err != nil {
+			return nil, fmt.Errorf("follow request list opt: %w", err)
+		}
+		query = append(query, q...)
+	}
+
+	requests, err := orm.FollowRequests(query...).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("follow requests fetch: %w", err)
+	}
+
+	return requests, nil
+}
+
+// ApproveFollowRequest turns the pending request into a follow. It returns
+// sql.ErrNoRows if there is no pending request.
+func (r *repo) ApproveFollowRequest(ctx context.Context, p FollowParams) error {
+	return r.NewTx(ctx, func(tx Tx) error {
+		if err := tx.DeleteFollowRequest(ctx, p); err != nil {
+			return fmt.Errorf("follow request delete: %w", err)
+		}
+
+		if err := tx.Follow(ctx, p); err != nil {
+			return fmt.Errorf("follow: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ApproveFollowRequests approves all pending requests to follow the user and
+// returns the approved requests.
+func (r *repo) ApproveFollowRequests(ctx context.Context, followeeID string) (orm.FollowRequestSlice, error) {
+	var requests orm.FollowRequestSlice
+	if err := r.NewTx(ctx, func(tx Tx) error {
+		var err error
+		if requests, err = tx.ListFollowRequests(ctx, ListFollowRequestsWithFolloweeID(followeeID)); err != nil {
+			return fmt.Errorf("follow requests list: %w", err)
+		}
+
+		for _, request := range requests {
+			if err = tx.ApproveFollowRequest(ctx, FollowParams{
+				FollowerID: request.FollowerID,
+				FolloweeID: request.FolloweeID,
+			}); err != nil {
+				return fmt.Errorf("follow request approve: %w", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("follow requests approve tx: %w", err)
+	}
+
+	return requests, nil
+}
+
+// DeleteFollowRequest rejects or cancels a pending request. It returns
+// sql.ErrNoRows if there is no pending request.
+func (r *repo) DeleteFollowRequest(ctx context.Context, p FollowParams) error {
+	rows, err := orm.FollowRequests(
+		orm.FollowRequestWhere.FollowerID.EQ(p.FollowerID),
+		orm.FollowRequestWhere.FolloweeID.EQ(p.FolloweeID),
+	).DeleteAll(ctx, r.executor())
+	if err != nil {
+		return fmt.Errorf("follow request delete: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+type BlockParams struct {
+	BlockerID string
+	BlockedID string
+}
+
+// BlockUser blocks the user and removes any follows and pending follow requests
+// between the two users, in both directions. Blocking again is a no-op.
+func (r *repo) BlockUser(ctx context.Context, p BlockParams) error {
+	return r.NewTx(ctx, func(tx Tx) error {
+		block := &orm.UserBlock{
+			BlockerID: p.BlockerID,
+			BlockedID: p.BlockedID,
+		}
+
+		if err := block.Upsert(ctx, tx.exec(), false,
+			[]string{orm.UserBlockColumns.BlockerID, orm.UserBlockColumns.BlockedID},
+			boil.None(),
+			boil.Infer(),
+		); err != nil {
+			return fmt.Errorf("user block upsert: %w", err)
+		}
+
+		if _, err := queries.Raw(`
+DELETE FROM getstronger.followers
+WHERE (follower_id = $1 AND followee_id = $2) OR (follower_id = $2 AND followee_id = $1);
+`, p.BlockerID, p.BlockedID).ExecContext(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("followers delete: %w", err)
+		}
+
+		if _, err := orm.FollowRequests(
+			qm.Where("(follower_id = ? AND followee_id = ?) OR (follower_id = ? AND followee_id = ?)",
+				p.BlockerID, p.BlockedID, p.BlockedID, p.BlockerID),
+		).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("follow requests delete: %w", err)
+		}
+
+		if _, err := orm.Coaches(
+			qm.Where("(coach_id = ? AND athlete_id = ?) OR (coach_id = ? AND athlete_id = ?)",
+				p.BlockerID, p.BlockedID, p.BlockedID, p.BlockerID),
+		).DeleteAll(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("coaches delete: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// UnblockUser removes the block. It returns sql.ErrNoRows if the user is not
+// blocked. Follows removed by the block are not restored.
+func (r *repo) UnblockUser(ctx context.Context, p BlockParams) error {
+	rows, err := orm.UserBlocks(
+		orm.UserBlockWhere.BlockerID.EQ(p.BlockerID),
+		orm.UserBlockWhere.BlockedID.EQ(p.BlockedID),
+	).DeleteAll(ctx, r.executor())
+	if err != nil {
+		return fmt.Errorf("user block delete: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// ListBlockedUsers returns the users blocked by the user, most recently
+// blocked first.
+func (r *repo) ListBlockedUsers(ctx context.Context, userID string) (orm.UserSlice, error) {
+	blocks, err := orm.UserBlocks(
+		orm.UserBlockWhere.BlockerID.EQ(userID),
+		qm.Load(orm.UserBlockRels.Blocked),
+		qm.OrderBy(fmt.Sprintf("%s DESC", orm.UserBlockColumns.CreatedAt)),
+	).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("user blocks fetch: %w", err)
+	}
+
+	users := make(orm.UserSlice, 0, len(blocks))
+	for _, block := range blocks {
+		users = append(users, block.R.Blocked)
+	}
+
+	return users, nil
+}
+
+// IsBlocked reports whether the user has blocked, or is blocked by, any of the
+// other users.
+func (r *repo) IsBlocked(ctx context.Context, userID string, otherUserIDs ...string) (bool, error) {
+	exists, err := orm.UserBlocks(
+		qm.Expr(
+			orm.UserBlockWhere.BlockerID.EQ(userID),
+			orm.UserBlockWhere.BlockedID.IN(otherUserIDs),
+		),
+		qm.Or2(qm.Expr(
+			orm.UserBlockWhere.BlockedID.EQ(userID),
+			orm.UserBlockWhere.BlockerID.IN(otherUserIDs),
+		)),
+	).Exists(ctx, r.executor())
+	if err != nil {
+		return false, fmt.Errorf("user block exists check: %w", err)
+	}
+
+	return exists, nil
+}
+
+type CreateReportParams struct {
+	ReporterID string
+	Target     orm.ReportTarget
+	TargetID   string
+	Reason     string
+}
+
+func (r *repo) CreateReport(ctx context.Context, p CreateReportParams) (*orm.Report, error) {
+	report := &orm.Report{
+		ReporterID: p.ReporterID,
+		Target:     p.Target,
+		TargetID:   p.TargetID,
+		Reason:     p.Reason,
+	}
+
+	if err := report.Insert(ctx, r.executor(), boil.Infer()); err != nil {
+		return nil, fmt.Errorf("report insert: %w", err)
+	}
+
+	return report, nil
+}
+
+type GetReportOpt func() qm.QueryMod
+
+func GetReportWithID(id string) GetReportOpt {
+	return func() qm.QueryMod {
+		return orm.ReportWhere.ID.EQ(id)
+	}
+}
+
+func (r *repo) GetReport(ctx context.Context, opts ...GetReportOpt) (*orm.Report, error) {
+	query := make([]qm.QueryMod, 0, len(opts))
+	for _, opt := range opts {
+		query = append(query, opt())
+	}
+
+	report, err := orm.Reports(query...).One(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("report fetch: %w", err)
+	}
+
+	return report, nil
+}
+
+type ListReportsOpt func() ([]qm.QueryMod, error)
+
+func ListReportsWithStatus(status orm.ReportStatus) ListReportsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.ReportWhere.Status.EQ(status),
+		}, nil
+	}
+}
+
+func ListReportsWithLimit(limit int) ListReportsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Limit(limit),
+		}, nil
+	}
+}
+
+func ListReportsWithPageToken(token []byte) ListReportsOpt {
+	return func() ([]qm.QueryMod, error) {
+		if len(token) == 0 {
+			return []qm.QueryMod{
+				qm.OrderBy(fmt.Sprintf("%s DESC", orm.ReportColumns.CreatedAt)),
+			}, nil
+		}
+
+		var pageToken PageToken
+		if err := json.Unmarshal(token, &pageToken); err != nil {
+			return nil, fmt.Errorf("page token unmarshal: %w", err)
+		}
+
+		return []qm.QueryMod{
+			orm.ReportWhere.CreatedAt.LT(pageToken.CreatedAt),
+			qm.OrderBy(fmt.Sprintf("%s DESC", orm.ReportColumns.CreatedAt)),
+		}, nil
+	}
+}
+
+func ListReportsLoadReporter() ListReportsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Load(orm.ReportRels.Reporter),
+		}, nil
+	}
+}
+
+func (r *repo) ListReports(ctx context.Context, opts ...ListReportsOpt) (orm.ReportSlice, error) {
+	query := make([]qm.QueryMod, 0, len(opts))
+	for _, opt := range opts {
+		q, err := opt()
+		if err != nil {
+			return nil, fmt.Errorf("report list opt: %w", err)
+		}
+		query = append(query, q...)
+	}
+
+	reports, err := orm.Reports(query...).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("reports fetch: %w", err)
+	}
+
+	return reports, nil
+}
+
+type ResolveReportParams struct {
+	ReportID   string
+	ResolverID string
+	Status     orm.ReportStatus
+}
+
+// ResolveReport closes the open report with the status. It returns
+// sql.ErrNoRows if there is no open report with the id.
+func (r *repo) ResolveReport(ctx context.Context, p ResolveReportParams) error {
+	rows, err := orm.Reports(
+		orm.ReportWhere.ID.EQ(p.ReportID),
+		orm.ReportWhere.Status.EQ(orm.ReportStatusOpen),
+	).UpdateAll(ctx, r.executor(), orm.M{
+		orm.ReportColumns.Status:     p.Status,
+		orm.ReportColumns.ResolvedBy: p.ResolverID,
+		orm.ReportColumns.ResolvedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("report update: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// HideWorkout hides the workout from everyone, including the owner.
+func (r *repo) HideWorkout(ctx context.Context, workoutID string) error {
+	rows, err := orm.Workouts(orm.WorkoutWhere.ID.EQ(workoutID)).UpdateAll(ctx, r.executor(), orm.M{
+		orm.WorkoutColumns.HiddenAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("workout update: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// HideWorkoutComment hides the comment and deletes the notifications about it.
+func (r *repo) HideWorkoutComment(ctx context.Context, commentID string) error {
+	return r.NewTx(ctx, func(tx Tx) error {
+		rows, err := orm.WorkoutComments(orm.WorkoutCommentWhere.ID.EQ(commentID)).UpdateAll(ctx, tx.exec(), orm.M{
+			orm.WorkoutCommentColumns.HiddenAt: time.Now().UTC(),
+		})
+		if err != nil {
+			return fmt.Errorf("workout comment update: %w", err)
+		}
+
+		if rows == 0 {
+			return sql.ErrNoRows
+		}
+
+		if _, err = queries.Raw(`
+			DELETE FROM getstronger.notifications WHERE payload ->> 'commentId' = $1;
+		`, commentID).ExecContext(ctx, tx.exec()); err != nil {
+			return fmt.Errorf("notifications delete: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// SuspendUser suspends the user and revokes their refresh token so they are
+// signed out once their access token expires.
+func (r *repo) SuspendUser(ctx context.Context, userID string) error {
+	return r.NewTx(ctx, func(tx Tx) error {
+		user, err := orm.FindUser(ctx, tx.exec(), userID)
+		if err != nil {
+			return fmt.Errorf("user fetch: %w", err)
+		}
+
+		user.SuspendedAt = null.TimeFrom(time.Now().UTC())
+		if _, err = user.Update(ctx, tx.exec(), boil.Whitelist(orm.UserColumns.SuspendedAt)); err != nil {
+			return fmt.Errorf("user update: %w", err)
+		}
+
+		if err = tx.UpdateAuth(ctx, user.AuthID, UpdateAuthDeleteRefreshToken()); err != nil {
+			return fmt.Errorf("auth update: %w", err)
+		}
+
+		return nil
+	})
+}
+
+type CreateChallengeParams struct {
+	CreatorID string
+	Title     string
+	Metric    orm.ChallengeMetric
+	Goal      null.Float64
+	StartsAt  time.Time
+	EndsAt    time.Time
+}
+
+// CreateChallenge creates the challenge with the creator as its first
+// participant.
+func (r *repo) CreateChallenge(ctx context.Context, p CreateChallengeParams) (*orm.Challenge, error) {
+	challenge := &orm.Challenge{
+		CreatorID: p.CreatorID,
+		Title:     p.Title,
+		Metric:    p.Metric,
+		Goal:      p.Goal,
+		StartsAt:  p.StartsAt,
+		EndsAt:    p.EndsAt,
+	}
+
+	if err := r.NewTx(ctx, func(tx Tx) error {
+		if err := challenge.Insert(ctx, tx.exec(), boil.Infer()); err != nil {
+			return fmt.Errorf("challenge insert: %w", err)
+		}
+
+		participant := &orm.ChallengeParticipant{
+			ChallengeID: challenge.ID,
+			UserID:      p.CreatorID,
+			JoinedAt:    null.TimeFrom(time.Now().UTC()),
+		}
+		if err := participant.Insert(ctx, tx.exec(), boil.Infer()); err != nil {
+			return fmt.Errorf("challenge participant insert: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("challenge create tx: %w", err)
+	}
+
+	return challenge, nil
+}
+
+type GetChallengeOpt func() qm.QueryMod
+
+func GetChallengeWithID(id string) GetChallengeOpt {
+	return func() qm.QueryMod {
+		return orm.ChallengeWhere.ID.EQ(id)
+	}
+}
+
+// GetChallengeWithParticipant only matches challenges the user has joined or
+// been invited to.
+func GetChallengeWithParticipant(userID string) GetChallengeOpt {
+	return func() qm.QueryMod {
+		return qm.Where(`EXISTS (
+			SELECT 1 FROM getstronger.challenge_participants cp
+			WHERE cp.challenge_id = challenges.id AND cp.user_id = ?
+		)`, userID)
+	}
+}
+
+func GetChallengeLoadCreator() GetChallengeOpt {
+	return func() qm.QueryMod {
+		return qm.Load(orm.ChallengeRels.Creator)
+	}
+}
+
+// GetChallengeLoadParticipant loads the participant row of the user only, so
+// callers can tell whether the user has joined.
+func GetChallengeLoadParticipant(userID string) GetChallengeOpt {
+	return func() qm.QueryMod {
+		return qm.Load(orm.ChallengeRels.ChallengeParticipants, orm.ChallengeParticipantWhere.UserID.EQ(userID))
+	}
+}
+
+func (r *repo) GetChallenge(ctx context.Context, opts ...GetChallengeOpt) (*orm.Challenge, error) {
+	query := make([]qm.QueryMod, 0, len(opts))
+	for _, opt := range opts {
+		query = append(query, opt())
+	}
+
+	challenge, err := orm.Challenges(query...).One(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("challenge fetch: %w", err)
+	}
+
+	return challenge, nil
+}
+
+type ListChallengesOpt func() ([]qm.QueryMod, error)
+
+func ListChallengesWithIDs(ids []string) ListChallengesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.ChallengeWhere.ID.IN(ids),
+		}, nil
+	}
+}
+
+// ListChallengesWithParticipant only lists challenges the user has joined or
+// been invited to.
+func ListChallengesWithParticipant(userID string) ListChallengesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			GetChallengeWithParticipant(userID)(),
+		}, nil
+	}
+}
+
+// ListChallengesWithoutEnded only lists challenges that have started and are
+// yet to be marked as ended.
+func ListChallengesWithoutEnded(now time.Time) ListChallengesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.ChallengeWhere.EndedAt.IsNull(),
+			orm.ChallengeWhere.StartsAt.LTE(now),
+		}, nil
+	}
+}
+
+func ListChallengesWithLimit(limit int) ListChallengesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Limit(limit),
+		}, nil
+	}
+}
+
+func ListChallengesWithPageToken(token []byte) ListChallengesOpt {
+	return func() ([]qm.QueryMod, error) {
+		if len(token) == 0 {
+			return []qm.QueryMod{
+				qm.OrderBy(fmt.Sprintf("%s DESC", orm.ChallengeColumns.CreatedAt)),
+			}, nil
+		}
+
+		var pageToken PageToken
+		if err := json.Unmarshal(token, &pageToken); err != nil {
+			return nil, fmt.Errorf("page token unmarshal: %w", err)
+		}
+
+		return []qm.QueryMod{
+			orm.ChallengeWhere.CreatedAt.LT(pageToken.CreatedAt),
+			qm.OrderBy(fmt.Sprintf("%s DESC", orm.ChallengeColumns.CreatedAt)),
+		}, nil
+	}
+}
+
+func ListChallengesLoadCreator() ListChallengesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			GetChallengeLoadCreator()(),
+		}, nil
+	}
+}
+
+func ListChallengesLoadParticipant(userID string) ListChallengesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			GetChallengeLoadParticipant(userID)(),
+		}, nil
+	}
+}
+
+func (r *repo) ListChallenges(ctx context.Context, opts ...ListChallengesOpt) (orm.ChallengeSlice, error) {
+	query := make([]qm.QueryMod, 0, len(opts))
+	for _, opt := range opts {
+		q, err := opt()
+		if err != nil {
+			return nil, fmt.Errorf("challenge list opt: %w", err)
+		}
+		query = append(query, q...)
+	}
+
+	challenges, err := orm.Challenges(query...).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("challenges fetch: %w", err)
+	}
+
+	return challenges, nil
+}
+
+type UpdateChallengeOpt func() (orm.M, error)
+
+func UpdateChallengeLeaderID(leaderID string) UpdateChallengeOpt {
+	return func() (orm.M, error) {
+		return orm.M{
+			orm.ChallengeColumns.LeaderID: null.NewString(leaderID, leaderID != ""),
+		}, nil
+	}
+}
+
+func UpdateChallengeEndedAt(endedAt time.Time) UpdateChallengeOpt {
+	return func() (orm.M, error) {
+		return orm.M{
+			orm.ChallengeColumns.EndedAt: null.TimeFrom(endedAt),
+		}, nil
+	}
+}
+
+func (r *repo) UpdateChallenge(ctx context.Context, challengeID string, opts ...UpdateChallengeOpt) error {
+	columns, err := updateColumnsFromOpts(opts)
+	if err != nil {
+		return fmt.Errorf("challenge update columns: %w", err)
+	}
+
+	rows, err := orm.Challenges(orm.ChallengeWhere.ID.EQ(challengeID)).UpdateAll(ctx, r.executor(), columns)
+	if err != nil {
+		return fmt.Errorf("challenge update: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// InviteToChallenge invites the users to the challenge and returns the ids of
+// the users that had not already been invited.
+func (r *repo) InviteToChallenge(ctx context.Context, challengeID string, userIDs []string) ([]string, error) {
+	invited := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		res, err := queries.Raw(`
+INSERT INTO getstronger.challenge_participants (challenge_id, user_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+`, challengeID, userID).ExecContext(ctx, r.executor())
+		if err != nil {
+			return nil, fmt.Errorf("challenge participant insert: %w", err)
+		}
+
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return nil, fmt.Errorf("rows affected: %w", err)
+		}
+
+		if rows > 0 {
+			invited = append(invited, userID)
+		}
+	}
+
+	return invited, nil
+}
+
+// JoinChallenge accepts the user's invitation to the challenge. It returns
+// sql.ErrNoRows if the user has no pending invitation.
+func (r *repo) JoinChallenge(ctx context.Context, challengeID, userID string) error {
+	rows, err := orm.ChallengeParticipants(
+		orm.ChallengeParticipantWhere.ChallengeID.EQ(challengeID),
+		orm.ChallengeParticipantWhere.UserID.EQ(userID),
+		orm.ChallengeParticipantWhere.JoinedAt.IsNull(),
+	).UpdateAll(ctx, r.executor(), orm.M{
+		orm.ChallengeParticipantColumns.JoinedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("challenge participant update: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// LeaveChallenge removes the user from the challenge, or declines their
+// invitation. It returns sql.ErrNoRows if the user is not a participant.
+func (r *repo) LeaveChallenge(ctx context.Context, challengeID, userID string) error {
+	rows, err := orm.ChallengeParticipants(
+		orm.ChallengeParticipantWhere.ChallengeID.EQ(challengeID),
+		orm.ChallengeParticipantWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, r.executor())
+	if err != nil {
+		return fmt.Errorf("challenge participant delete: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+type ChallengeStanding struct {
+	UserID string  `boil:"user_id"`
+	Value  float64 `boil:"value"`
+	Rank   int     `boil:"rank"`
+}
+
+var ErrInvalidChallengeMetric = fmt.Errorf("invalid challenge metric")
+
+// GetChallengeStandings ranks the participants that have joined the challenge
+// by their progress from workouts finished during the challenge window.
+// Participants tied on progress share a rank, and participants without any
+// progress are ranked last with a value of zero.
+func (r *repo) GetChallengeStandings(ctx context.Context, challengeID string) ([]ChallengeStanding, error) {
+	challenge, err := orm.FindChallenge(ctx, r.executor(), challengeID)
+	if err != nil {
+		return nil, fmt.Errorf("challenge fetch: %w", err)
+	}
+
+	const window = `w.user_id = p.user_id
+		AND w.finished_at >= c.starts_at
+		AND w.finished_at < c.ends_at
+		AND w.deleted_at IS NULL
+		AND w.hidden_at IS NULL`
+
+	var value string
+	switch challenge.Metric {
+	case orm.ChallengeMetricTotalVolume:
+		value = fmt.Sprintf(`(
+			SELECT COALESCE(SUM(s.weight * s.reps), 0)
+			FROM getstronger.sets s
+			JOIN getstronger.workouts w ON w.id = s.workout_id
+			WHERE %s
+		)`, window)
+	case orm.ChallengeMetricSessionCount:
+		value = fmt.Sprintf(`(
+			SELECT COUNT(*)
+			FROM getstronger.workouts w
+			WHERE %s
+		)`, window)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidChallengeMetric, challenge.Metric)
+	}
+
+	var standings []ChallengeStanding
+	if err = queries.Raw(fmt.Sprintf(`
+SELECT user_id, value, RANK() OVER (ORDER BY value DESC) AS rank
+FROM (
+	SELECT p.user_id, %s::float8 AS value
+	FROM getstronger.challenge_participants p
+	JOIN getstronger.challenges c ON c.id = p.challenge_id
+	WHERE p.challenge_id = $1
+	  AND p.joined_at IS NOT NULL
+) standings
+ORDER BY rank, user_id;
+`, value), challengeID).Bind(ctx, r.executor(), &standings); err != nil {
+		return nil, fmt.Errorf("challenge standings fetch: %w", err)
+	}
+
+	return standings, nil
+}
+
+type CreateClubParams struct {
+	OwnerID     string
+	Name        string
+	Description string
+}
+
+// CreateClub creates the club with the owner as its first active member.
+func (r *repo) CreateClub(ctx context.Context, p CreateClubParams) (*orm.Club, error) {
+	club := &orm.Club{
+		Name:        p.Name,
+		Description: null.NewString(p.Description, p.Description != ""),
+	}
+
+	if err := r.NewTx(ctx, func(tx Tx) error {
+		if err := club.Insert(ctx, tx.exec(), boil.Infer()); err != nil {
+			return fmt.Errorf("club insert: %w", err)
+		}
+
+		member := &orm.ClubMember{
+			ClubID: club.ID,
+			UserID: p.OwnerID,
+			Role:   orm.ClubRoleOwner,
+			Status: orm.ClubMemberStatusActive,
+		}
+		if err := member.Insert(ctx, tx.exec(), boil.Infer()); err != nil {
+			return fmt.Errorf("club member insert: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("club create tx: %w", err)
+	}
+
+	return club, nil
+}
+
+type GetClubOpt func() qm.QueryMod
+
+func GetClubWithID(id string) GetClubOpt {
+	return func() qm.QueryMod {
+		return orm.ClubWhere.ID.EQ(id)
+	}
+}
+
+// GetClubLoadMember loads the membership of the user only, if any.
+func GetClubLoadMember(userID string) GetClubOpt {
+	return func() qm.QueryMod {
+		return qm.Load(orm.ClubRels.ClubMembers, orm.ClubMemberWhere.UserID.EQ(userID))
+	}
+}
+
+func (r *repo) GetClub(ctx context.Context, opts ...GetClubOpt) (*orm.Club, error) {
+	query := make([]qm.QueryMod, 0, len(opts))
+	for _, opt := range opts {
+		query = append(query, opt())
+	}
+
+	club, err := orm.Clubs(query...).One(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("club fetch: %w", err)
+	}
+
+	return club, nil
+}
+
+type ListClubsOpt func() ([]qm.QueryMod, error)
+
+// ListClubsWithMember only lists clubs the user is a member of, or has a
+// pending invitation or request to join.
+func ListClubsWithMember(userID string) ListClubsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Where(`EXISTS (
+				SELECT 1 FROM getstronger.club_members cm
+				WHERE cm.club_id = clubs.id AND cm.user_id = ?
+			)`, userID),
+		}, nil
+	}
+}
+
+func ListClubsWithLimit(limit int) ListClubsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Limit(limit),
+		}, nil
+	}
+}
+
+func ListClubsWithPageToken(token []byte) ListClubsOpt {
+	return func() ([]qm.QueryMod, error) {
+		if len(token) == 0 {
+			return []qm.QueryMod{
+				qm.OrderBy(fmt.Sprintf("%s DESC", orm.ClubColumns.CreatedAt)),
+			}, nil
+		}
+
+		var pageToken PageToken
+		if err := json.Unmarshal(token, &pageToken); err != nil {
+			return nil, fmt.Errorf("page token unmarshal: %w", err)
+		}
+
+		return []qm.QueryMod{
+			orm.ClubWhere.CreatedAt.LT(pageToken.CreatedAt),
+			qm.OrderBy(fmt.Sprintf("%s DESC", orm.ClubColumns.CreatedAt)),
+		}, nil
+	}
+}
+
+func ListClubsLoadMember(userID string) ListClubsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			GetClubLoadMember(userID)(),
+		}, nil
+	}
+}
+
+func (r *repo) ListClubs(ctx context.Context, opts ...ListClubsOpt) (orm.ClubSlice, error) {
+	query := make([]qm.QueryMod, 0, len(opts))
+	for _, opt := range opts {
+		q, err := opt()
+		if err != nil {
+			return nil, fmt.Errorf("club list opt: %w", err)
+		}
+		query = append(query, q...)
+	}
+
+	clubs, err := orm.Clubs(query...).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("clubs fetch: %w", err)
+	}
+
+	return clubs, nil
+}
+
+func (r *repo) GetClubMember(ctx context.Context, clubID, userID string) (*orm.ClubMember, error) {
+	member, err := orm.FindClubMember(ctx, r.executor(), clubID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("club member fetch: %w", err)
+	}
+
+	return member, nil
+}
+
+type ListClubMembersOpt func() ([]qm.QueryMod, error)
+
+func ListClubMembersWithClubID(clubID string) ListClubMembersOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.ClubMemberWhere.ClubID.EQ(clubID),
+		}, nil
+	}
+}
+
+func ListClubMembersWithStatus(status orm.ClubMemberStatus) ListClubMembersOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.ClubMemberWhere.Status.EQ(status),
+		}, nil
+	}
+}
+
+func ListClubMembersWithLimit(limit int) ListClubMembersOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Limit(limit),
+		}, nil
+	}
+}
+
+func ListClubMembersWithPageToken(token []byte) ListClubMembersOpt {
+	return func() ([]qm.QueryMod, error) {
+		if len(token) == 0 {
+			return []qm.QueryMod{
+				qm.OrderBy(fmt.Sprintf("%s DESC", orm.ClubMemberColumns.CreatedAt)),
+			}, nil
+		}
+
+		var pageToken PageToken
+		if err := json.Unmarshal(token, &pageToken); err != nil {
+			return nil, fmt.Errorf("page token unmarshal: %w", err)
+		}
+
+		return []qm.QueryMod{
+			orm.ClubMemberWhere.CreatedAt.LT(pageToken.CreatedAt),
+			qm.OrderBy(fmt.Sprintf("%s DESC", orm.ClubMemberColumns.CreatedAt)),
+		}, nil
+	}
+}
+
+func ListClubMembersLoadUser() ListClubMembersOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Load(orm.ClubMemberRels.User),
+		}, nil
+	}
+}
+
+func (r *repo) ListClubMembers(ctx context.Context, opts ...ListClubMembersOpt) (orm.ClubMemberSlice, error) {
+	query := make([]qm.QueryMod, 0, len(opts))
+	for _, opt := range opts {
+		q, err := opt()
+		if err != nil {
+			return nil, fmt.Errorf("club member list opt: %w", err)
+		}
+		query = append(query, q...)
+	}
+
+	members, err := orm.ClubMembers(query...).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("club members fetch: %w", err)
+	}
+
+	return members, nil
+}
+
+type CreateClubMemberParams struct {
+	ClubID string
+	UserID string
+	Status orm.ClubMemberStatus
+}
+
+func (r *repo) CreateClubMember(ctx context.Context, p CreateClubMemberParams) error {
+	member := &orm.ClubMember{
+		ClubID: p.ClubID,
+		UserID: p.UserID,
+		Role:   orm.ClubRoleMember,
+		Status: p.Status,
+	}
+
+	if err := member.Insert(ctx, r.executor(), boil.Infer()); err != nil {
+		return fmt.Errorf("club member insert: %w", err)
+	}
+
+	return nil
+}
+
+type UpdateClubMemberOpt func() (orm.M, error)
+
+func UpdateClubMemberRole(role orm.ClubRole) UpdateClubMemberOpt {
+	return func() (orm.M, error) {
+		return orm.M{
+			orm.ClubMemberColumns.Role: role,
+		}, nil
+	}
+}
+
+func UpdateClubMemberStatus(status orm.ClubMemberStatus) UpdateClubMemberOpt {
+	return func() (orm.M, error) {
+		return orm.M{
+			orm.ClubMemberColumns.Status: status,
+		}, nil
+	}
+}
+
+func (r *repo) UpdateClubMember(ctx context.Context, clubID, userID string, opts ...UpdateClubMemberOpt) error {
+	columns, err := updateColumnsFromOpts(opts)
+	if err != nil {
+		return fmt.Errorf("club member update columns: %w", err)
+	}
+
+	rows, err := orm.ClubMembers(
+		orm.ClubMemberWhere.ClubID.EQ(clubID),
+		orm.ClubMemberWhere.UserID.EQ(userID),
+	).UpdateAll(ctx, r.executor(), columns)
+	if err != nil {
+		return fmt.Errorf("club member update: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// DeleteClubMember removes the user from the club, along with any pending
+// invitation or request. It returns sql.ErrNoRows if there is no such member.
+func (r *repo) DeleteClubMember(ctx context.Context, clubID, userID string) error {
+	rows, err := orm.ClubMembers(
+		orm.ClubMemberWhere.ClubID.EQ(clubID),
+		orm.ClubMemberWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, r.executor())
+	if err != nil {
+		return fmt.Errorf("club member delete: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+type TransferClubOwnershipParams struct {
+	ClubID     string
+	OwnerID    string
+	NewOwnerID string
+}
+
+// TransferClubOwnership makes the new owner the owner of the club and demotes
+// the previous owner to admin.
+func (r *repo) TransferClubOwnership(ctx context.Context, p TransferClubOwnershipParams) error {
+	return r.NewTx(ctx, func(tx Tx) error {
+		if err := tx.UpdateClubMember(ctx, p.ClubID, p.OwnerID, UpdateClubMemberRole(orm.ClubRoleAdmin)); err != nil {
+			return fmt.Errorf("owner demote: %w", err)
+		}
+
+		if err := tx.UpdateClubMember(ctx, p.ClubID, p.NewOwnerID, UpdateClubMemberRole(orm.ClubRoleOwner)); err != nil {
+			return fmt.Errorf("owner promote: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// deleteClubMemberships removes the user from their clubs. Clubs owned by the
+// user are passed on to their longest standing admin, or member if there are no
+// admins, and deleted if there is no one left to pass them on to.
+func deleteClubMemberships(ctx context.Context, exec boil.ContextExecutor, userID string) error {
+	owned, err := orm.ClubMembers(
+		orm.ClubMemberWhere.UserID.EQ(userID),
+		orm.ClubMemberWhere.Role.EQ(orm.ClubRoleOwner),
+	).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("owned clubs fetch: %w", err)
+	}
+
+	if _, err = orm.ClubMembers(orm.ClubMemberWhere.UserID.EQ(userID)).DeleteAll(ctx, exec); err != nil {
+		return fmt.Errorf("club members delete: %w", err)
+	}
+
+	for _, member := range owned {
+		res, err := queries.Raw(`
+UPDATE getstronger.club_members SET role = 'Owner'
+WHERE (club_id, user_id) = (
+	SELECT club_id, user_id FROM getstronger.club_members
+	WHERE club_id = $1 AND status = 'Active'
+	ORDER BY role = 'Admin' DESC, created_at
+	LIMIT 1
+);
+`, member.ClubID).ExecContext(ctx, exec)
+		if err != nil {
+			return fmt.Errorf("club owner promote: %w", err)
+		}
+
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("rows affected: %w", err)
+		}
+
+		if rows > 0 {
+			continue
+		}
+
+		if _, err = orm.Clubs(orm.ClubWhere.ID.EQ(member.ClubID)).DeleteAll(ctx, exec); err != nil {
+			return fmt.Errorf("club delete: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// clubMembersClause restricts the column to the ids of the active members of
+// the club. The clause expects the club id.
+const clubMembersClause = `%s IN (
+	SELECT user_id FROM getstronger.club_members
+	WHERE club_id = ? AND status = 'Active'
+)`
+
+// ListWorkoutsWithClubMembers only lists workouts of the active members of the
+// club.
+func ListWorkoutsWithClubMembers(clubID string) ListWorkoutsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Where(fmt.Sprintf(clubMembersClause, orm.TableNames.Workouts+".user_id"), clubID),
+		}, nil
+	}
+}
+
+// ListYearSummariesWithClubMembers only lists year summaries of the active
+// members of the club.
+func ListYearSummariesWithClubMembers(clubID string) ListYearSummariesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Where(fmt.Sprintf(clubMembersClause, orm.TableNames.YearSummaries+".user_id"), clubID),
+		}, nil
+	}
+}
+
+type CoachingParams struct {
+	CoachID   string
+	AthleteID string
+}
+
+// GrantCoachAccess gives the coach access to the athlete's account. It returns
+// ErrUserBlocked if either of them has blocked the other.
+func (r *repo) GrantCoachAccess(ctx context.Context, p CoachingParams) error {
+	blocked, err := r.IsBlocked(ctx, p.AthleteID, p.CoachID)
+	if err != nil {
+		return fmt.Errorf("block check: %w", err)
+	}
+
+	if blocked {
+		return ErrUserBlocked
+	}
+
+	coach := &orm.Coach{
+		CoachID:   p.CoachID,
+		AthleteID: p.AthleteID,
+	}
+
+	if err = coach.Upsert(ctx, r.executor(), false,
+		[]string{orm.CoachColumns.CoachID, orm.CoachColumns.AthleteID},
+		boil.None(),
+		boil.Infer(),
+	); err != nil {
+		return fmt.Errorf("coach upsert: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeCoachAccess removes the coach's access to the athlete's account. It
+// returns sql.ErrNoRows if the coach has no access.
+func (r *repo) RevokeCoachAccess(ctx context.Context, p CoachingParams) error {
+	rows, err := orm.Coaches(
+		orm.CoachWhere.CoachID.EQ(p.CoachID),
+		orm.CoachWhere.AthleteID.EQ(p.AthleteID),
+	).DeleteAll(ctx, r.executor())
+	if err != nil {
+		return fmt.Errorf("coach delete: %w", err)
+	}
+
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *repo) IsCoach(ctx context.Context, p CoachingParams) (bool, error) {
+	exists, err := orm.CoachExists(ctx, r.executor(), p.CoachID, p.AthleteID)
+	if err != nil {
+		return false, fmt.Errorf("coach exists: %w", err)
+	}
+
+	return exists, nil
+}
+
+// ListCoaches lists the users with access to the athlete's account.
+func (r *repo) ListCoaches(ctx context.Context, athleteID string) (orm.UserSlice, error) {
+	users, err := orm.Users(
+		qm.Where("id IN (SELECT coach_id FROM getstronger.coaches WHERE athlete_id = ?)", athleteID),
+		qm.OrderBy(orm.UserColumns.FirstName),
+	).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("coaches fetch: %w", err)
+	}
+
+	return users, nil
+}
+
+type CreateCoachActionParams struct {
+	CoachID   string
+	AthleteID string
+	Action    orm.CoachActionType
+	TargetID  string
+}
+
+func (r *repo) CreateCoachAction(ctx context.Context, p CreateCoachActionParams) error {
+	action := &orm.CoachAction{
+		CoachID:   p.CoachID,
+		AthleteID: p.AthleteID,
+		Action:    p.Action,
+		TargetID:  null.NewString(p.TargetID, p.TargetID != ""),
+	}
+
+	if err := action.Insert(ctx, r.executor(), boil.Infer()); err != nil {
+		return fmt.Errorf("coach action insert: %w", err)
+	}
+
+	return nil
+}
+
+type ListCoachActionsOpt func() ([]qm.QueryMod, error)
+
+func ListCoachActionsWithAthleteID(athleteID string) ListCoachActionsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.CoachActionWhere.AthleteID.EQ(athleteID),
+		}, nil
+	}
+}
+
+func ListCoachActionsWithCoachID(coachID string) ListCoachActionsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.CoachActionWhere.CoachID.EQ(coachID),
+		}, nil
+	}
+}
+
+func ListCoachActionsWithLimit(limit int) ListCoachActionsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Limit(limit),
+		}, nil
+	}
+}
+
+func ListCoachActionsWithPageToken(token []byte) ListCoachActionsOpt {
+	return func() ([]qm.QueryMod, error) {
+		if len(token) == 0 {
+			return []qm.QueryMod{
+				qm.OrderBy(fmt.Sprintf("%s DESC", orm.CoachActionColumns.CreatedAt)),
+			}, nil
+		}
+
+		var pageToken PageToken
+		if err := json.Unmarshal(token, &pageToken); err != nil {
+			return nil, fmt.Errorf("page token unmarshal: %w", err)
+		}
+
+		return []qm.QueryMod{
+			orm.CoachActionWhere.CreatedAt.LT(pageToken.CreatedAt),
+			qm.OrderBy(fmt.Sprintf("%s DESC", orm.CoachActionColumns.CreatedAt)),
+		}, nil
+	}
+}
+
+func ListCoachActionsLoadCoach() ListCoachActionsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Load(orm.CoachActionRels.Coach),
+		}, nil
+	}
+}
+
+func (r *repo) ListCoachActions(ctx context.Context, opts ...ListCoachActionsOpt) (orm.CoachActionSlice, error) {
+	query := make([]qm.QueryMod, 0, len(opts))
+	for _, opt := range opts {
+		q, err := opt()
+		if err != nil {
+			return nil, fmt.Errorf("coach action list opt: %w", err)
+		}
+		query = append(query, q...)
+	}
+
+	actions, err := orm.CoachActions(query...).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("coach actions fetch: %w", err)
+	}
+
+	return actions, nil
+}
+
+type AthleteSummary struct {
+	AthleteID     string    `boil:"athlete_id"`
+	LastSessionAt null.Time `boil:"last_session_at"`
+	// AcuteLoad is the volume lifted over the last week.
+	AcuteLoad float64 `boil:"acute_load"`
+	// ChronicLoad is the average weekly volume lifted over the last four weeks.
+	ChronicLoad float64 `boil:"chronic_load"`
+}
+
+const (
+	acuteLoadWeeks   = 1
+	chronicLoadWeeks = 4
+)
+
+// ListAthleteSummaries summarises the recent training of every athlete the
+// coach has access to, regardless of the visibility of their workouts.
+func (r *repo) ListAthleteSummaries(ctx context.Context, coachID string, now time.Time) ([]AthleteSummary, error) {
+	week := daysPerWeek * 24 * time.Hour
+	acuteFrom := now.Add(-acuteLoadWeeks * week)
+	chronicFrom := now.Add(-chronicLoadWeeks * week)
+
+	var summaries []AthleteSummary
+	if err := queries.Raw(`
+SELECT
+	c.athlete_id,
+	(
+		SELECT MAX(w.finished_at)
+		FROM getstronger.workouts w
+		WHERE w.user_id = c.athlete_id AND w.deleted_at IS NULL AND w.hidden_at IS NULL
+	) AS last_session_at,
+	(
+		SELECT COALESCE(SUM(s.weight * s.reps), 0)
+		FROM getstronger.sets s
+		JOIN getstronger.workouts w ON w.id = s.workout_id
+		WHERE w.user_id = c.athlete_id AND w.deleted_at IS NULL AND w.hidden_at IS NULL AND w.finished_at >= $2
+	)::float8 / $4 AS acute_load,
+	(
+		SELECT COALESCE(SUM(s.weight * s.reps), 0)
+		FROM getstronger.sets s
+		JOIN getstronger.workouts w ON w.id = s.workout_id
+		WHERE w.user_id = c.athlete_id AND w.deleted_at IS NULL AND w.hidden_at IS NULL AND w.finished_at >= $3
+	)::float8 / $5 AS chronic_load
+FROM getstronger.coaches c
+WHERE c.coach_id = $1
+ORDER BY c.created_at;
+`, coachID, acuteFrom, chronicFrom, acuteLoadWeeks, chronicLoadWeeks).Bind(ctx, r.executor(), &summaries); err != nil {
+		return nil, fmt.Errorf("athlete summaries fetch: %w", err)
+	}
+
+	return summaries, nil
+}
+
+func ListYearSummariesWithLimit(limit int) ListYearSummariesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Limit(limit),
+		}, nil
+	}
+}
+
+// ListSetsWithClubMembers only lists sets of the active members of the club.
+func ListSetsWithClubMembers(clubID string) ListSetsOpt {
+	return func() (qm.QueryMod, error) {
+		return qm.Where(fmt.Sprintf(clubMembersClause, orm.TableNames.Sets+".user_id"), clubID), nil
+	}
+}
+
+type ListFollowsOpt func() ([]qm.QueryMod, error)
+
+func ListFollowsWithFollowerIDs(userIDs ...string) ListFollowsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.FollowerWhere.FollowerID.IN(userIDs),
+		}, nil
+	}
+}
+
+func ListFollowsWithFolloweeIDs(userIDs ...string) ListFollowsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.FollowerWhere.FolloweeID.IN(userIDs),
+		}, nil
+	}
+}
+
+// ListFollowsWithClubMembers only lists follows made by the active members of
+// the club.
+func ListFollowsWithClubMembers(clubID string) ListFollowsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Where(fmt.Sprintf(clubMembersClause, orm.TableNames.Followers+".follower_id"), clubID),
+		}, nil
+	}
+}
+
+// ListFollowsWithoutBlocked excludes follows where either user has blocked, or
+// is blocked by, the viewer.
+func ListFollowsWithoutBlocked(viewerID string) ListFollowsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Where(notBlockedClause(orm.TableNames.Followers+".follower_id"), viewerID, viewerID),
+			qm.Where(notBlockedClause(orm.TableNames.Followers+".followee_id"), viewerID, viewerID),
+		}, nil
+	}
+}
+
+func ListFollowsWithLimit(limit int) ListFollowsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Limit(limit),
+		}, nil
+	}
+}
+
+func ListFollowsWithPageToken(token []byte) ListFollowsOpt {
+	return func() ([]qm.QueryMod, error) {
+		if len(token) == 0 {
+			return nil, nil
+		}
+
+		var pageToken PageToken
+		if err := json.Unmarshal(token, &pageToken); err != nil {
+			return nil, fmt.Errorf("page token unmarshal: %w", err)
+		}
+
+		return []qm.QueryMod{
+			pageToken.after(orm.FollowerTableColumns.CreatedAt, orm.FollowerTableColumns.FollowerID, orm.FollowerTableColumns.FolloweeID),
+		}, nil
+	}
+}
+
+func ListFollowsLoadUsers() ListFollowsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Load(orm.FollowerRels.Follower),
+			qm.Load(orm.FollowerRels.Followee),
+		}, nil
+	}
+}
+
+// ListFollows lists follows newest first. Follows made before they were
+// timestamped are left out.
+func (r *repo) ListFollows(ctx context.Context, opts ...ListFollowsOpt) (orm.FollowerSlice, error) {
+	query := []qm.QueryMod{
+		orm.FollowerWhere.CreatedAt.IsNotNull(),
+		qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC, %s DESC",
+			orm.FollowerTableColumns.CreatedAt, orm.FollowerTableColumns.FollowerID, orm.FollowerTableColumns.FolloweeID,
+		)),
+	}
+	for _, opt := range opts {
+		q, err := opt()
+		if err != nil {
+			return nil, fmt.Errorf("follow list opt: %w", err)
+		}
+		query = append(query, q...)
+	}
+
+	follows, err := orm.Followers(query...).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("follows fetch: %w", err)
+	}
+
+	return follows, nil
+}
+
+var workoutMilestones = types.Int64Array{10, 25, 50, 100, 250, 500, 1000}
+
+// CreateAchievements records the milestone numbers of workouts the user has
+// logged, dated by the workout that reached the milestone. Achievements are
+// kept once recorded, even if workouts are later deleted or hidden.
+func (r *repo) CreateAchievements(ctx context.Context, userID string) error {
+	rawQuery := `
+	INSERT INTO getstronger.achievements (user_id, workout_count, created_at)
+	SELECT user_id, workout_count, created_at
+	FROM (
+		SELECT user_id, created_at, ROW_NUMBER() OVER (ORDER BY created_at, id) AS workout_count
+		FROM getstronger.workouts
+		WHERE user_id = $1 AND deleted_at IS NULL AND hidden_at IS NULL
+	) AS workouts
+	WHERE workout_count = ANY ($2)
+	ON CONFLICT (user_id, workout_count) DO NOTHING;
+`
+
+	if _, err := queries.Raw(rawQuery, userID, workoutMilestones).ExecContext(ctx, r.executor()); err != nil {
+		return fmt.Errorf("achievements insert: %w", err)
+	}
+
+	return nil
+}
+
+type ListAchievementsOpt func() ([]qm.QueryMod, error)
+
+func ListAchievementsWithUserIDs(userIDs ...string) ListAchievementsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.AchievementWhere.UserID.IN(userIDs),
+		}, nil
+	}
+}
+
+// ListAchievementsVisibleTo excludes achievements of private users the viewer
+// does not follow.
+func ListAchievementsVisibleTo(viewerID string) ListAchievementsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			visibleToQuery("achievements", viewerID),
+		}, nil
+	}
+}
+
+// ListAchievementsWithClubMembers only lists achievements of the active
+// members of the club.
+func ListAchievementsWithClubMembers(clubID string) ListAchievementsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Where(fmt.Sprintf(clubMembersClause, "achievements.user_id"), clubID),
+		}, nil
+	}
+}
+
+func ListAchievementsWithLimit(limit int) ListAchievementsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Limit(limit),
+		}, nil
+	}
+}
+
+func ListAchievementsWithPageToken(token []byte) ListAchievementsOpt {
+	return func() ([]qm.QueryMod, error) {
+		if len(token) == 0 {
+			return nil, nil
+		}
+
+		var pageToken PageToken
+		if err := json.Unmarshal(token, &pageToken); err != nil {
+			return nil, fmt.Errorf("page token unmarshal: %w", err)
+		}
+
+		return []qm.QueryMod{
+			pageToken.after(orm.AchievementTableColumns.CreatedAt, orm.AchievementTableColumns.UserID, orm.AchievementTableColumns.WorkoutCount),
+		}, nil
+	}
+}
+
+// ListAchievements lists achievements newest first.
+func (r *repo) ListAchievements(ctx context.Context, opts ...ListAchievementsOpt) (orm.AchievementSlice, error) {
+	query := []qm.QueryMod{
+		qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC, %s DESC",
+			orm.AchievementTableColumns.CreatedAt, orm.AchievementTableColumns.UserID, orm.AchievementTableColumns.WorkoutCount,
+		)),
+	}
+	for _, opt := range opts {
+		q, err := opt()
+		if err != nil {
+			return nil, fmt.Errorf("achievement list opt: %w", err)
+		}
+		query = append(query, q...)
+	}
+
+	achievements, err := orm.Achievements(query...).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("achievements fetch: %w", err)
+	}
+
+	return achievements, nil
+}
+
+// personalRecordClause restricts sets to those that set a personal record: the
+// best set of the exercise in its workout that beats every set of the exercise
+// in the user's earlier workouts. The first workout with an exercise sets no
+// record.
+const personalRecordClause = `EXISTS (
+	SELECT 1 FROM getstronger.sets p
+	JOIN getstronger.workouts pw ON pw.id = p.workout_id
+	WHERE p.user_id = sets.user_id AND p.exercise_id = sets.exercise_id AND pw.deleted_at IS NULL
+		AND pw.created_at < (SELECT created_at FROM getstronger.workouts WHERE id = sets.workout_id)
+) AND NOT EXISTS (
+	SELECT 1 FROM getstronger.sets p
+	JOIN getstronger.workouts pw ON pw.id = p.workout_id
+	WHERE p.user_id = sets.user_id AND p.exercise_id = sets.exercise_id AND pw.deleted_at IS NULL
+		AND pw.created_at < (SELECT created_at FROM getstronger.workouts WHERE id = sets.workout_id)
+		AND (p.weight > sets.weight OR (p.weight = sets.weight AND p.reps >= sets.reps))
+) AND NOT EXISTS (
+	SELECT 1 FROM getstronger.sets o
+	WHERE o.workout_id = sets.workout_id AND o.exercise_id = sets.exercise_id AND o.id <> sets.id
+		AND (o.weight > sets.weight OR (o.weight = sets.weight AND (o.reps > sets.reps OR (o.reps = sets.reps AND o.id < sets.id))))
+)`
+
+// CreatePersonalRecords records the sets of the user that set a personal
+// record at the time they were logged, dated by the set. Personal records are
+// kept once recorded, even if earlier workouts are later changed.
+func (r *repo) CreatePersonalRecords(ctx context.Context, userID string) error {
+	rawQuery := `
+	INSERT INTO getstronger.personal_records (user_id, workout_id, set_id, created_at)
+	SELECT sets.user_id, sets.workout_id, sets.id, sets.created_at
+	FROM getstronger.sets
+	JOIN getstronger.workouts w ON w.id = sets.workout_id
+	WHERE sets.user_id = $1 AND w.deleted_at IS NULL AND ` + personalRecordClause + `
+	ON CONFLICT (set_id) DO NOTHING;
+`
+
+	if _, err := queries.Raw(rawQuery, userID).ExecContext(ctx, r.executor()); err != nil {
+		return fmt.Errorf("personal records insert: %w", err)
+	}
+
+	return nil
+}
+
+type ListPersonalRecordsOpt func() ([]qm.QueryMod, error)
+
+func ListPersonalRecordsWithUserIDs(userIDs ...string) ListPersonalRecordsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.PersonalRecordWhere.UserID.IN(userIDs),
+		}, nil
+	}
+}
+
+// ListPersonalRecordsVisibleTo only lists personal records set in workouts the
+// viewer can see.
+func ListPersonalRecordsVisibleTo(viewerID string) ListPersonalRecordsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Where(fmt.Sprintf(`EXISTS (
+				SELECT 1 FROM getstronger.workouts w WHERE w.id = personal_records.workout_id AND %s AND %s
+			)`, workoutVisibleToClause("w"), notBlockedClause("w.user_id")), viewerID, viewerID, viewerID, viewerID),
+		}, nil
+	}
+}
+
+func ListPersonalRecordsWithoutDeletedWorkouts() ListPersonalRecordsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Where(`NOT EXISTS (
+				SELECT 1 FROM getstronger.workouts w WHERE w.id = personal_records.workout_id AND w.deleted_at IS NOT NULL
+			)`),
+		}, nil
+	}
+}
+
+// ListPersonalRecordsWithClubMembers only lists personal records of the active
+// members of the club.
+func ListPersonalRecordsWithClubMembers(clubID string) ListPersonalRecordsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Where(fmt.Sprintf(clubMembersClause, "personal_records.user_id"), clubID),
+		}, nil
+	}
+}
+
+func ListPersonalRecordsLoadSetExercise() ListPersonalRecordsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Load(fmt.Sprintf("%s.%s", orm.PersonalRecordRels.Set, orm.SetRels.Exercise)),
+		}, nil
+	}
+}
+
+func ListPersonalRecordsWithLimit(limit int) ListPersonalRecordsOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Limit(limit),
+		}, nil
+	}
+}
+
+func ListPersonalRecordsWithPageToken(token []byte) ListPersonalRecordsOpt {
+	return func() ([]qm.QueryMod, error) {
+		if len(token) == 0 {
+			return nil, nil
+		}
+
+		var pageToken PageToken
+		if err := json.Unmarshal(token, &pageToken); err != nil {
+			return nil, fmt.Errorf("page token unmarshal: %w", err)
+		}
+
+		return []qm.QueryMod{
+			pageToken.after(orm.PersonalRecordTableColumns.CreatedAt, orm.PersonalRecordTableColumns.SetID),
+		}, nil
+	}
+}
+
+// ListPersonalRecords lists personal records newest first.
+func (r *repo) ListPersonalRecords(ctx context.Context, opts ...ListPersonalRecordsOpt) (orm.PersonalRecordSlice, error) {
+	query := []qm.QueryMod{
+		qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC",
+			orm.PersonalRecordTableColumns.CreatedAt, orm.PersonalRecordTableColumns.SetID,
+		)),
+	}
+	for _, opt := range opts {
+		q, err := opt()
+		if err != nil {
+			return nil, fmt.Errorf("personal record list opt: %w", err)
+		}
+		query = append(query, q...)
+	}
+
+	records, err := orm.PersonalRecords(query...).All(ctx, r.executor())
+	if err != nil {
+		return nil, fmt.Errorf("personal records fetch: %w", err)
+	}
+
+	return records, nil
+}
+
+// ListChallengesWithJoinedParticipant only lists challenges the user has
+// joined.
+func ListChallengesWithJoinedParticipant(userID string) ListChallengesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Where(`EXISTS (
+				SELECT 1 FROM getstronger.challenge_participants cp
+				WHERE cp.challenge_id = challenges.id AND cp.user_id = ? AND cp.joined_at IS NOT NULL
+			)`, userID),
+		}, nil
+	}
+}
+
+func ListChallengesWithLeaderIDs(userIDs ...string) ListChallengesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			orm.ChallengeWhere.LeaderID.IN(userIDs),
+		}, nil
+	}
+}
+
+// ListChallengesWithClubMembers only lists challenges led by the active
+// members of the club.
+func ListChallengesWithClubMembers(clubID string) ListChallengesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Where(fmt.Sprintf(clubMembersClause, orm.TableNames.Challenges+".leader_id"), clubID),
+		}, nil
+	}
+}
+
+// ListChallengesWithEndedPageToken only lists challenges that have ended,
+// ordered by when they ended rather than when they were created.
+func ListChallengesWithEndedPageToken(token []byte) ListChallengesOpt {
+	return func() ([]qm.QueryMod, error) {
+		query := []qm.QueryMod{
+			orm.ChallengeWhere.EndedAt.IsNotNull(),
+			qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC", orm.ChallengeTableColumns.EndedAt, orm.ChallengeTableColumns.ID)),
+		}
+
+		if len(token) == 0 {
+			return query, nil
+		}
+
+		var pageToken PageToken
+		if err := json.Unmarshal(token, &pageToken); err != nil {
+			return nil, fmt.Errorf("page token unmarshal: %w", err)
+		}
+
+		return append(query, pageToken.after(orm.ChallengeTableColumns.EndedAt, orm.ChallengeTableColumns.ID)), nil
+	}
+}
+
+func ListChallengesLoadLeader() ListChallengesOpt {
+	return func() ([]qm.QueryMod, error) {
+		return []qm.QueryMod{
+			qm.Load(orm.ChallengeRels.Leader),
+		}, nil
+	}
+}
@@ -16,11 +16,13 @@ import (
 	"github.com/lib/pq"
 	"github.com/stretchr/testify/suite"
 	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/crlssn/getstronger/server/gen/orm"
 	"github.com/crlssn/getstronger/server/repo"
+	"github.com/crlssn/getstronger/server/strength"
 	"github.com/crlssn/getstronger/server/testing/container"
 	"github.com/crlssn/getstronger/server/testing/factory"
 )
@@ -735,6 +737,212 @@ func (s *repoSuite) TestSoftDeleteExercise() {
 	}
 }
 
+func (s *repoSuite) TestRestoreExercise() {
+	type expected struct {
+		err error
+	}
+
+	type test struct {
+		name     string
+		params   repo.RestoreExerciseParams
+		init     func(test) *orm.Routine
+		expected expected
+	}
+
+	tests := []test{
+		{
+			name: "ok_restore_exercise_into_routine",
+			params: repo.RestoreExerciseParams{
+				UserID:     s.factory.NewUser().ID,
+				ExerciseID: uuid.NewString(),
+			},
+			init: func(t test) *orm.Routine {
+				exercises := orm.ExerciseSlice{
+					s.factory.NewExercise(factory.ExerciseUserID(t.params.UserID)),
+					s.factory.NewExercise(factory.ExerciseID(t.params.ExerciseID), factory.ExerciseUserID(t.params.UserID)),
+					s.factory.NewExercise(factory.ExerciseUserID(t.params.UserID)),
+				}
+
+				routine := s.factory.NewRoutine(
+					factory.RoutineUserID(t.params.UserID),
+					factory.RoutineExerciseOrder([]string{exercises[0].ID, exercises[1].ID, exercises[2].ID}),
+				)
+				s.factory.AddRoutineExercise(routine, exercises...)
+
+				s.Require().NoError(s.repo.SoftDeleteExercise(context.Background(), repo.SoftDeleteExerciseParams{
+					UserID:     t.params.UserID,
+					ExerciseID: t.params.ExerciseID,
+				}))
+
+				return routine
+			},
+			expected: expected{
+				err: nil,
+			},
+		},
+		{
+			name: "err_exercise_not_deleted",
+			params: repo.RestoreExerciseParams{
+				UserID:     s.factory.NewUser().ID,
+				ExerciseID: uuid.NewString(),
+			},
+			init: func(t test) *orm.Routine {
+				s.factory.NewExercise(factory.ExerciseID(t.params.ExerciseID), factory.ExerciseUserID(t.params.UserID))
+				return nil
+			},
+			expected: expected{
+				err: sql.ErrNoRows,
+			},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			routine := t.init(t)
+			var exerciseOrder []string
+			if routine != nil {
+				s.Require().NoError(json.Unmarshal(routine.ExerciseOrder, &exerciseOrder))
+			}
+
+			err := s.repo.RestoreExercise(context.Background(), t.params)
+			if t.expected.err != nil {
+				s.Require().ErrorIs(err, t.expected.err)
+				return
+			}
+			s.Require().NoError(err)
+
+			exercise, err := orm.FindExercise(context.Background(), s.container.DB, t.params.ExerciseID)
+			s.Require().NoError(err)
+			s.Require().False(exercise.DeletedAt.Valid)
+
+			s.Require().NoError(routine.Reload(context.Background(), s.container.DB))
+			var exerciseIDs []string
+			s.Require().NoError(json.Unmarshal(routine.ExerciseOrder, &exerciseIDs))
+			s.Require().Equal(exerciseOrder, exerciseIDs)
+
+			exists, err := routine.Exercises(orm.ExerciseWhere.ID.EQ(t.params.ExerciseID)).Exists(context.Background(), s.container.DB)
+			s.Require().NoError(err)
+			s.Require().True(exists)
+		})
+	}
+}
+
+func (s *repoSuite) TestMergeExercises() {
+	type expected struct {
+		err           error
+		exerciseOrder []string
+	}
+
+	type test struct {
+		name     string
+		params   repo.MergeExercisesParams
+		init     func(test) *orm.Routine
+		expected expected
+	}
+
+	userID := s.factory.NewUser().ID
+	targetID := uuid.NewString()
+	sourceIDs := []string{uuid.NewString(), uuid.NewString()}
+	otherID := uuid.NewString()
+
+	tests := []test{
+		{
+			name: "ok_merge_exercises",
+			params: repo.MergeExercisesParams{
+				UserID:            userID,
+				TargetExerciseID:  targetID,
+				SourceExerciseIDs: sourceIDs,
+			},
+			init: func(t test) *orm.Routine {
+				exercises := orm.ExerciseSlice{
+					s.factory.NewExercise(factory.ExerciseID(sourceIDs[0]), factory.ExerciseUserID(userID)),
+					s.factory.NewExercise(factory.ExerciseID(otherID), factory.ExerciseUserID(userID)),
+					s.factory.NewExercise(factory.ExerciseID(targetID), factory.ExerciseUserID(userID)),
+					s.factory.NewExercise(factory.ExerciseID(sourceIDs[1]), factory.ExerciseUserID(userID)),
+				}
+
+				for _, exercise := range exercises {
+					s.factory.NewSet(factory.SetExerciseID(exercise.ID), factory.SetUserID(userID))
+				}
+
+				routine := s.factory.NewRoutine(
+					factory.RoutineUserID(userID),
+					factory.RoutineExerciseOrder([]string{sourceIDs[0], otherID, targetID, sourceIDs[1]}),
+				)
+				s.factory.AddRoutineExercise(routine, exercises...)
+
+				return routine
+			},
+			expected: expected{
+				err:           nil,
+				exerciseOrder: []string{targetID, otherID},
+			},
+		},
+		{
+			name: "err_merge_exercise_into_itself",
+			params: repo.MergeExercisesParams{
+				UserID:            userID,
+				TargetExerciseID:  targetID,
+				SourceExerciseIDs: []string{targetID},
+			},
+			expected: expected{
+				err: repo.ErrMergeExerciseIntoItself,
+			},
+		},
+		{
+			name: "err_merge_exercise_not_found",
+			params: repo.MergeExercisesParams{
+				UserID:            userID,
+				TargetExerciseID:  targetID,
+				SourceExerciseIDs: []string{uuid.NewString()},
+			},
+			expected: expected{
+				err: repo.ErrMergeExerciseNotFound,
+			},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			var routine *orm.Routine
+			if t.init != nil {
+				routine = t.init(t)
+			}
+
+			err := s.repo.MergeExercises(context.Background(), t.params)
+			if t.expected.err != nil {
+				s.Require().ErrorIs(err, t.expected.err)
+				return
+			}
+			s.Require().NoError(err)
+
+			count, err := orm.Sets(orm.SetWhere.ExerciseID.IN(t.params.SourceExerciseIDs)).Count(context.Background(), s.container.DB)
+			s.Require().NoError(err)
+			s.Require().Zero(count)
+
+			count, err = orm.Sets(orm.SetWhere.ExerciseID.EQ(t.params.TargetExerciseID)).Count(context.Background(), s.container.DB)
+			s.Require().NoError(err)
+			s.Require().Equal(int64(len(t.params.SourceExerciseIDs)+1), count)
+
+			exists, err := orm.Exercises(
+				orm.ExerciseWhere.ID.IN(t.params.SourceExerciseIDs),
+				orm.ExerciseWhere.DeletedAt.IsNull(),
+			).Exists(context.Background(), s.container.DB)
+			s.Require().NoError(err)
+			s.Require().False(exists)
+
+			s.Require().NoError(routine.Reload(context.Background(), s.container.DB))
+			var exerciseIDs []string
+			s.Require().NoError(json.Unmarshal(routine.ExerciseOrder, &exerciseIDs))
+			s.Require().Equal(t.expected.exerciseOrder, exerciseIDs)
+
+			exercises, err := routine.Exercises().All(context.Background(), s.container.DB)
+			s.Require().NoError(err)
+			s.Require().Len(exercises, len(t.expected.exerciseOrder))
+		})
+	}
+}
+
 func (s *repoSuite) TestListExercises() {
 	type expected struct {
 		err           error
@@ -852,6 +1060,21 @@ func (s *repoSuite) TestUpdateRoutine() {
 				err: nil,
 			},
 		},
+		{
+			name:      "ok_update_exercise_targets",
+			routineID: uuid.NewString(),
+			opts: []repo.UpdateRoutineOpt{
+				repo.UpdateRoutineExerciseTargets([]repo.RoutineExerciseTarget{
+					{ExerciseID: "1", Sets: []repo.RoutineTargetSet{{Weight: 100, Reps: 5}}},
+				}),
+			},
+			init: func(t test) {
+				s.factory.NewRoutine(factory.RoutineID(t.routineID))
+			},
+			expected: expected{
+				err: nil,
+			},
+		},
 		{
 			name:      "err_duplicate_column_update",
 			routineID: uuid.NewString(),
@@ -875,6 +1098,127 @@ func (s *repoSuite) TestUpdateRoutine() {
 	}
 }
 
+func (s *repoSuite) TestRemoveExerciseFromRoutine() {
+	user := s.factory.NewUser()
+	exercises := s.factory.NewExerciseSlice(2, factory.ExerciseUserID(user.ID))
+	routine := s.factory.NewRoutine(factory.RoutineUserID(user.ID))
+	s.factory.AddRoutineExercise(routine, exercises...)
+
+	err := s.repo.UpdateRoutine(context.Background(), routine.ID, repo.UpdateRoutineExerciseTargets([]repo.RoutineExerciseTarget{
+		{ExerciseID: exercises[0].ID, Sets: []repo.RoutineTargetSet{{Weight: 100, Reps: 5}}},
+		{ExerciseID: exercises[1].ID, Sets: []repo.RoutineTargetSet{{Weight: 60, Reps: 8}}},
+	}))
+	s.Require().NoError(err)
+	s.Require().NoError(routine.Reload(context.Background(), s.container.DB))
+
+	err = s.repo.RemoveExerciseFromRoutine(context.Background(), exercises[0], routine)
+	s.Require().NoError(err)
+
+	s.Require().NoError(routine.Reload(context.Background(), s.container.DB))
+	var targets []repo.RoutineExerciseTarget
+	s.Require().NoError(json.Unmarshal(routine.ExerciseTargets, &targets))
+	s.Require().Len(targets, 1)
+	s.Require().Equal(exercises[1].ID, targets[0].ExerciseID)
+}
+
+func (s *repoSuite) TestCloneRoutine() {
+	type expected struct {
+		err error
+	}
+
+	type test struct {
+		name     string
+		params   repo.CloneRoutineParams
+		init     func(t *test)
+		expected expected
+	}
+
+	tests := []test{
+		{
+			name: "ok_map_and_create_exercises",
+			init: func(t *test) {
+				author := s.factory.NewUser()
+				squat := s.factory.NewExercise(factory.ExerciseUserID(author.ID), factory.ExerciseTitle("Squat"))
+				press := s.factory.NewExercise(factory.ExerciseUserID(author.ID), factory.ExerciseTitle("Overhead Press"))
+				routine := s.factory.NewRoutine(
+					factory.RoutineUserID(author.ID),
+					factory.RoutineExerciseOrder([]string{squat.ID, press.ID}),
+				)
+				s.factory.AddRoutineExercise(routine, squat, press)
+
+				cloner := s.factory.NewUser()
+				s.factory.NewExercise(factory.ExerciseUserID(cloner.ID), factory.ExerciseTitle("squat"))
+
+				t.params = repo.CloneRoutineParams{
+					RoutineID: routine.ID,
+					UserID:    cloner.ID,
+				}
+			},
+			expected: expected{
+				err: nil,
+			},
+		},
+		{
+			name: "err_clone_own_routine",
+			init: func(t *test) {
+				routine := s.factory.NewRoutine()
+				t.params = repo.CloneRoutineParams{
+					RoutineID: routine.ID,
+					UserID:    routine.UserID,
+				}
+			},
+			expected: expected{
+				err: repo.ErrRoutineCloneOwnRoutine,
+			},
+		},
+		{
+			name: "err_routine_not_found",
+			init: func(t *test) {
+				t.params = repo.CloneRoutineParams{
+					RoutineID: uuid.NewString(),
+					UserID:    s.factory.NewUser().ID,
+				}
+			},
+			expected: expected{
+				err: sql.ErrNoRows,
+			},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			t.init(&t)
+			clone, err := s.repo.CloneRoutine(context.Background(), t.params)
+			s.Require().ErrorIs(err, t.expected.err)
+			if t.expected.err != nil {
+				s.Require().Nil(clone)
+				return
+			}
+
+			source, err := orm.FindRoutine(context.Background(), s.container.DB, t.params.RoutineID)
+			s.Require().NoError(err)
+			s.Require().Equal(1, source.CloneCount)
+
+			clone, err = orm.Routines(
+				orm.RoutineWhere.ID.EQ(clone.ID),
+				qm.Load(orm.RoutineRels.Exercises),
+			).One(context.Background(), s.container.DB)
+			s.Require().NoError(err)
+			s.Require().Equal(t.params.UserID, clone.UserID)
+			s.Require().Equal(source.Title, clone.Title)
+			s.Require().Equal(null.StringFrom(source.ID), clone.ClonedFromRoutineID)
+			s.Require().Len(clone.R.Exercises, 2)
+
+			exercises, err := orm.Exercises(orm.ExerciseWhere.UserID.EQ(t.params.UserID)).All(context.Background(), s.container.DB)
+			s.Require().NoError(err)
+			s.Require().Len(exercises, 2)
+			for _, exercise := range clone.R.Exercises {
+				s.Require().Equal(t.params.UserID, exercise.UserID)
+			}
+		})
+	}
+}
+
 func (s *repoSuite) TestGetPreviousWorkoutSets() {
 	type expected struct {
 		err  error
@@ -1050,29 +1394,142 @@ func (s *repoSuite) TestDeleteWorkout() {
 			err := s.repo.DeleteWorkout(context.Background(), t.opts...)
 			s.Require().ErrorIs(err, t.expected.err)
 
-			exists, err := orm.Workouts(orm.WorkoutWhere.ID.EQ(workout.ID)).
-				Exists(context.Background(), s.container.DB)
-			s.Require().NoError(err)
-			s.Require().False(exists)
+			s.Require().NoError(workout.Reload(context.Background(), s.container.DB))
+			s.Require().True(workout.DeletedAt.Valid)
 
-			exists, err = orm.Sets(orm.SetWhere.WorkoutID.EQ(workout.ID)).
+			exists, err := orm.Sets(orm.SetWhere.WorkoutID.EQ(workout.ID)).
 				Exists(context.Background(), s.container.DB)
 			s.Require().NoError(err)
-			s.Require().False(exists)
+			s.Require().True(exists)
 
 			exists, err = orm.WorkoutComments(orm.WorkoutCommentWhere.WorkoutID.EQ(workout.ID)).
 				Exists(context.Background(), s.container.DB)
 			s.Require().NoError(err)
-			s.Require().False(exists)
+			s.Require().True(exists)
 
-			exists, err = orm.Notifications(qm.Where("payload ->> 'workoutId' = ?", workout.ID)).
-				Exists(context.Background(), s.container.DB)
-			s.Require().NoError(err)
-			s.Require().False(exists)
+			s.Require().NoError(s.repo.RestoreWorkout(context.Background(), workout.ID))
+			s.Require().NoError(workout.Reload(context.Background(), s.container.DB))
+			s.Require().False(workout.DeletedAt.Valid)
 		})
 	}
 }
 
+func (s *repoSuite) TestPurgeWorkouts() {
+	workout := s.factory.NewWorkout()
+	s.factory.NewSet(factory.SetWorkoutID(workout.ID))
+	s.factory.NewWorkoutComment(factory.WorkoutCommentWorkoutID(workout.ID))
+	s.factory.NewNotification(factory.NotificationPayload(repo.NotificationPayload{
+		WorkoutID: workout.ID,
+	}))
+
+	recent := s.factory.NewWorkout()
+
+	s.Require().NoError(s.repo.DeleteWorkout(context.Background(), repo.DeleteWorkoutWithID(workout.ID)))
+	s.Require().NoError(s.repo.DeleteWorkout(context.Background(), repo.DeleteWorkoutWithID(recent.ID)))
+
+	workout.DeletedAt = null.TimeFrom(time.Now().UTC().Add(-48 * time.Hour))
+	_, err := workout.Update(context.Background(), s.container.DB, boil.Whitelist(orm.WorkoutColumns.DeletedAt))
+	s.Require().NoError(err)
+
+	_, err = s.repo.PurgeWorkouts(context.Background(), time.Now().UTC().Add(-24*time.Hour))
+	s.Require().NoError(err)
+
+	exists, err := orm.Workouts(orm.WorkoutWhere.ID.EQ(workout.ID)).
+		Exists(context.Background(), s.container.DB)
+	s.Require().NoError(err)
+	s.Require().False(exists)
+
+	exists, err = orm.Sets(orm.SetWhere.WorkoutID.EQ(workout.ID)).
+		Exists(context.Background(), s.container.DB)
+	s.Require().NoError(err)
+	s.Require().False(exists)
+
+	exists, err = orm.WorkoutComments(orm.WorkoutCommentWhere.WorkoutID.EQ(workout.ID)).
+		Exists(context.Background(), s.container.DB)
+	s.Require().NoError(err)
+	s.Require().False(exists)
+
+	exists, err = orm.Notifications(qm.Where("payload ->> 'workoutId' = ?", workout.ID)).
+		Exists(context.Background(), s.container.DB)
+	s.Require().NoError(err)
+	s.Require().False(exists)
+
+	exists, err = orm.Workouts(orm.WorkoutWhere.ID.EQ(recent.ID)).
+		Exists(context.Background(), s.container.DB)
+	s.Require().NoError(err)
+	s.Require().True(exists)
+}
+
+func (s *repoSuite) TestDeleteAccount() {
+	ctx := context.Background()
+	user := s.factory.NewUser()
+	other := s.factory.NewUser()
+
+	exercise := s.factory.NewExercise(factory.ExerciseUserID(user.ID))
+	routine := s.factory.NewRoutine(factory.RoutineUserID(user.ID))
+	s.factory.AddRoutineExercise(routine, exercise)
+
+	workout := s.factory.NewWorkout(factory.WorkoutUserID(user.ID))
+	s.factory.NewSet(factory.SetWorkoutID(workout.ID), factory.SetExerciseID(exercise.ID), factory.SetUserID(user.ID))
+	s.factory.NewWorkoutComment(factory.WorkoutCommentWorkoutID(workout.ID), factory.WorkoutCommentUserID(other.ID))
+
+	otherWorkout := s.factory.NewWorkout(factory.WorkoutUserID(other.ID))
+	comment := s.factory.NewWorkoutComment(factory.WorkoutCommentWorkoutID(otherWorkout.ID), factory.WorkoutCommentUserID(user.ID))
+
+	s.factory.NewNotification(factory.NotificationUserID(user.ID))
+	s.factory.NewNotification(
+		factory.NotificationUserID(other.ID),
+		factory.NotificationPayload(repo.NotificationPayload{ActorID: user.ID}),
+	)
+
+	s.Require().NoError(s.repo.Follow(ctx, repo.FollowParams{FollowerID: user.ID, FolloweeID: other.ID}))
+	s.Require().NoError(s.repo.Follow(ctx, repo.FollowParams{FollowerID: other.ID, FolloweeID: user.ID}))
+
+	authBefore, err := orm.FindAuth(ctx, s.container.DB, user.AuthID)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.repo.DeleteAccount(ctx, user.ID))
+
+	deleted, err := orm.FindUser(ctx, s.container.DB, user.ID)
+	s.Require().NoError(err)
+	s.Require().True(deleted.DeletedAt.Valid)
+	s.Require().Equal(repo.DeletedUserFirstName, deleted.FirstName)
+	s.Require().Equal(repo.DeletedUserLastName, deleted.LastName)
+
+	auth, err := orm.FindAuth(ctx, s.container.DB, deleted.AuthID)
+	s.Require().NoError(err)
+	s.Require().False(auth.RefreshToken.Valid)
+	s.Require().NotEqual(authBefore.Email, auth.Email)
+	s.Require().Error(s.repo.CompareEmailAndPassword(ctx, auth.Email, ""))
+
+	for name, query := range map[string]interface {
+		Count(ctx context.Context, exec boil.ContextExecutor) (int64, error)
+	}{
+		"workouts":      orm.Workouts(orm.WorkoutWhere.UserID.EQ(user.ID)),
+		"sets":          orm.Sets(orm.SetWhere.WorkoutID.EQ(workout.ID)),
+		"exercises":     orm.Exercises(orm.ExerciseWhere.UserID.EQ(user.ID)),
+		"routines":      orm.Routines(orm.RoutineWhere.UserID.EQ(user.ID)),
+		"comments":      orm.WorkoutComments(orm.WorkoutCommentWhere.WorkoutID.EQ(workout.ID)),
+		"notifications": orm.Notifications(qm.Where("user_id = ? OR payload ->> 'actorId' = ?", user.ID, user.ID)),
+	} {
+		count, err := query.Count(ctx, s.container.DB)
+		s.Require().NoError(err, name)
+		s.Require().Zero(count, name)
+	}
+
+	followers, err := s.repo.ListFollowers(ctx, other.ID)
+	s.Require().NoError(err)
+	s.Require().Empty(followers)
+
+	followees, err := s.repo.ListFollowees(ctx, other.ID)
+	s.Require().NoError(err)
+	s.Require().Empty(followees)
+
+	exists, err := orm.WorkoutCommentExists(ctx, s.container.DB, comment.ID)
+	s.Require().NoError(err)
+	s.Require().True(exists)
+}
+
 func (s *repoSuite) TestUpdateWorkoutSets() {
 	type expected struct {
 		err error
@@ -1362,3 +1819,1203 @@ func (s *repoSuite) TestUpdateWorkout() {
 		})
 	}
 }
+
+func (s *repoSuite) TestUpdateUser() {
+	type expected struct {
+		err     error
+		columns orm.M
+	}
+
+	type test struct {
+		name     string
+		user     *orm.User
+		opts     []repo.UpdateUserOpt
+		expected expected
+	}
+
+	tests := []test{
+		{
+			name: "ok_update_sex",
+			user: s.factory.NewUser(),
+			opts: []repo.UpdateUserOpt{
+				repo.UpdateUserSex(orm.NullSexFrom(orm.SexFemale)),
+			},
+			expected: expected{
+				err: nil,
+				columns: orm.M{
+					orm.UserColumns.Sex: orm.NullSexFrom(orm.SexFemale),
+				},
+			},
+		},
+		{
+			name: "ok_update_multiple_columns",
+			user: s.factory.NewUser(),
+			opts: []repo.UpdateUserOpt{
+				repo.UpdateUserBodyweight(null.Float64From(82.5)),
+				repo.UpdateUserBirthDate(null.TimeFrom(time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC))),
+			},
+			expected: expected{
+				err: nil,
+				columns: orm.M{
+					orm.UserColumns.Bodyweight: null.Float64From(82.5),
+					orm.UserColumns.BirthDate:  time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "err_not_found",
+			user: &orm.User{
+				ID: uuid.NewString(),
+			},
+			opts: []repo.UpdateUserOpt{
+				repo.UpdateUserBodyweight(null.Float64From(82.5)),
+			},
+			expected: expected{
+				err: repo.ErrUpdateRowsAffected,
+			},
+		},
+		{
+			name: "err_empty_opts",
+			user: s.factory.NewUser(),
+			opts: nil,
+			expected: expected{
+				err: repo.ErrUpdateNoColumns,
+			},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			err := s.repo.UpdateUser(context.Background(), t.user.ID, t.opts...)
+			if t.expected.err != nil {
+				s.Require().Error(err)
+				s.Require().ErrorIs(err, t.expected.err)
+				return
+			}
+			s.Require().NoError(err)
+
+			user, err := orm.FindUser(context.Background(), s.container.DB, t.user.ID)
+			s.Require().NoError(err)
+			for column, value := range t.expected.columns {
+				switch column {
+				case orm.UserColumns.Sex:
+					s.Require().Equal(value, user.Sex)
+				case orm.UserColumns.Bodyweight:
+					s.Require().Equal(value, user.Bodyweight)
+				case orm.UserColumns.BirthDate:
+					s.Require().True(value.(time.Time).Equal(user.BirthDate.Time))
+				}
+			}
+		})
+	}
+}
+
+func (s *repoSuite) TestGetExerciseProgress() {
+	user := s.factory.NewUser()
+	exercise := s.factory.NewExercise(factory.ExerciseUserID(user.ID))
+
+	day := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)
+	workout1 := s.factory.NewWorkout(factory.WorkoutUserID(user.ID), factory.WorkoutFinishedAt(day))
+	workout2 := s.factory.NewWorkout(factory.WorkoutUserID(user.ID), factory.WorkoutFinishedAt(day.AddDate(0, 0, 2)))
+	workout3 := s.factory.NewWorkout(factory.WorkoutUserID(user.ID), factory.WorkoutFinishedAt(day.AddDate(0, 0, 7)))
+
+	for _, set := range []struct {
+		workoutID string
+		weight    float64
+		reps      int
+	}{
+		{workout1.ID, 100, 5},
+		{workout1.ID, 110, 1},
+		{workout2.ID, 105, 3},
+		{workout3.ID, 120, 2},
+	} {
+		s.factory.NewSet(
+			factory.SetUserID(user.ID),
+			factory.SetWorkoutID(set.workoutID),
+			factory.SetExerciseID(exercise.ID),
+			factory.SetWeight(set.weight),
+			factory.SetReps(set.reps),
+		)
+	}
+
+	type expected struct {
+		err      error
+		progress []repo.ExerciseProgress
+	}
+
+	type test struct {
+		name     string
+		params   repo.GetExerciseProgressParams
+		expected expected
+	}
+
+	tests := []test{
+		{
+			name: "ok_day",
+			params: repo.GetExerciseProgressParams{
+				ViewerID:   user.ID,
+				UserID:     user.ID,
+				ExerciseID: exercise.ID,
+				Interval:   repo.ProgressIntervalDay,
+				From:       day.AddDate(0, 0, -1),
+				To:         day.AddDate(0, 0, 3),
+			},
+			expected: expected{
+				progress: []repo.ExerciseProgress{
+					{
+						Bucket:       time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+						TopSetWeight: 110,
+						TopSetReps:   1,
+						EstimatedMax: 100 * (1 + 5/30.0),
+						Volume:       610,
+						TotalReps:    6,
+					},
+					{
+						Bucket:       time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC),
+						TopSetWeight: 105,
+						TopSetReps:   3,
+						EstimatedMax: 105 * (1 + 3/30.0),
+						Volume:       315,
+						TotalReps:    3,
+					},
+				},
+			},
+		},
+		{
+			name: "ok_week",
+			params: repo.GetExerciseProgressParams{
+				ViewerID:   user.ID,
+				UserID:     user.ID,
+				ExerciseID: exercise.ID,
+				Interval:   repo.ProgressIntervalWeek,
+				From:       day.AddDate(0, 0, -1),
+				To:         day.AddDate(0, 0, 14),
+			},
+			expected: expected{
+				progress: []repo.ExerciseProgress{
+					{
+						Bucket:       time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC),
+						TopSetWeight: 110,
+						TopSetReps:   1,
+						EstimatedMax: 100 * (1 + 5/30.0),
+						Volume:       925,
+						TotalReps:    9,
+					},
+					{
+						Bucket:       time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC),
+						TopSetWeight: 120,
+						TopSetReps:   2,
+						EstimatedMax: 120 * (1 + 2/30.0),
+						Volume:       240,
+						TotalReps:    2,
+					},
+				},
+			},
+		},
+		{
+			name: "err_invalid_interval",
+			params: repo.GetExerciseProgressParams{
+				ViewerID:   user.ID,
+				UserID:     user.ID,
+				ExerciseID: exercise.ID,
+				Interval:   "year",
+			},
+			expected: expected{
+				err: repo.ErrInvalidProgressInterval,
+			},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			progress, err := s.repo.GetExerciseProgress(context.Background(), t.params)
+			if t.expected.err != nil {
+				s.Require().Error(err)
+				s.Require().ErrorIs(err, t.expected.err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(progress, len(t.expected.progress))
+			for i, p := range progress {
+				s.Require().True(t.expected.progress[i].Bucket.Equal(p.Bucket))
+				s.Require().InDelta(t.expected.progress[i].TopSetWeight, p.TopSetWeight, 0.01)
+				s.Require().Equal(t.expected.progress[i].TopSetReps, p.TopSetReps)
+				s.Require().InDelta(t.expected.progress[i].EstimatedMax, p.EstimatedMax, 0.01)
+				s.Require().InDelta(t.expected.progress[i].Volume, p.Volume, 0.01)
+				s.Require().Equal(t.expected.progress[i].TotalReps, p.TotalReps)
+			}
+		})
+	}
+}
+
+func (s *repoSuite) TestBuildYearSummary() {
+	const year = 2020
+	week := func(i int) time.Time {
+		return time.Date(year, time.March, 2, 12, 0, 0, 0, time.UTC).AddDate(0, 0, 7*i)
+	}
+
+	type expected struct {
+		personalBests      int
+		longestStreakWeeks int
+		followee           bool
+	}
+
+	type test struct {
+		name     string
+		init     func(userID string)
+		expected expected
+	}
+
+	// newWorkout logs a workout in the given week with a set of each weight
+	// for the exercise.
+	newWorkout := func(userID, exerciseID string, at time.Time, weights ...float64) *orm.Workout {
+		workout := s.factory.NewWorkout(factory.WorkoutUserID(userID), factory.WorkoutFinishedAt(at))
+		for _, weight := range weights {
+			s.factory.NewSet(
+				factory.SetUserID(userID),
+				factory.SetWorkoutID(workout.ID),
+				factory.SetExerciseID(exerciseID),
+				factory.SetWeight(weight),
+				factory.SetReps(5),
+			)
+		}
+		return workout
+	}
+
+	tests := []test{
+		{
+			name: "ok_one_personal_best_per_workout",
+			init: func(userID string) {
+				exerciseID := s.factory.NewExercise(factory.ExerciseUserID(userID)).ID
+				newWorkout(userID, exerciseID, week(0), 100)
+				newWorkout(userID, exerciseID, week(1), 102, 104, 106, 108, 110)
+			},
+			expected: expected{personalBests: 1, longestStreakWeeks: 2},
+		},
+		{
+			name: "ok_personal_best_compared_with_earlier_workouts",
+			init: func(userID string) {
+				exerciseID := s.factory.NewExercise(factory.ExerciseUserID(userID)).ID
+				newWorkout(userID, exerciseID, week(0), 100)
+				newWorkout(userID, exerciseID, week(1), 90)
+				newWorkout(userID, exerciseID, week(2), 105)
+				newWorkout(userID, exerciseID, week(3), 105)
+			},
+			expected: expected{personalBests: 1, longestStreakWeeks: 4},
+		},
+		{
+			name: "ok_hidden_workouts_excluded",
+			init: func(userID string) {
+				exerciseID := s.factory.NewExercise(factory.ExerciseUserID(userID)).ID
+				newWorkout(userID, exerciseID, week(0), 100)
+				hidden := newWorkout(userID, exerciseID, week(1), 110)
+				s.Require().NoError(s.repo.HideWorkout(context.Background(), hidden.ID))
+				newWorkout(userID, exerciseID, week(2), 105)
+			},
+			expected: expected{personalBests: 1, longestStreakWeeks: 1},
+		},
+		{
+			name: "ok_longest_streak",
+			init: func(userID string) {
+				exerciseID := s.factory.NewExercise(factory.ExerciseUserID(userID)).ID
+				for _, i := range []int{0, 1, 3, 4, 5, 7} {
+					newWorkout(userID, exerciseID, week(i), 100)
+				}
+			},
+			expected: expected{personalBests: 0, longestStreakWeeks: 3},
+		},
+		{
+			name: "ok_most_active_followee",
+			init: func(userID string) {
+				followee := s.factory.NewUser()
+				s.Require().NoError(s.repo.Follow(context.Background(), repo.FollowParams{FollowerID: userID, FolloweeID: followee.ID}))
+				s.factory.NewWorkout(factory.WorkoutUserID(followee.ID), factory.WorkoutFinishedAt(week(0)))
+			},
+			expected: expected{followee: true},
+		},
+		{
+			name: "ok_followee_private_workouts_excluded",
+			init: func(userID string) {
+				followee := s.factory.NewUser()
+				s.Require().NoError(s.repo.Follow(context.Background(), repo.FollowParams{FollowerID: userID, FolloweeID: followee.ID}))
+				s.factory.NewWorkout(
+					factory.WorkoutUserID(followee.ID),
+					factory.WorkoutFinishedAt(week(0)),
+					factory.WorkoutVisibility(orm.WorkoutVisibilityPrivate),
+				)
+			},
+			expected: expected{followee: false},
+		},
+		{
+			name: "ok_blocked_followee_excluded",
+			init: func(userID string) {
+				followee := s.factory.NewUser()
+				s.Require().NoError(s.repo.Follow(context.Background(), repo.FollowParams{FollowerID: userID, FolloweeID: followee.ID}))
+				s.factory.NewWorkout(factory.WorkoutUserID(followee.ID), factory.WorkoutFinishedAt(week(0)))
+				s.Require().NoError(s.repo.BlockUser(context.Background(), repo.BlockParams{BlockerID: followee.ID, BlockedID: userID}))
+			},
+			expected: expected{followee: false},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			user := s.factory.NewUser()
+			t.init(user.ID)
+
+			summary, err := s.repo.BuildYearSummary(context.Background(), user.ID, year)
+			s.Require().NoError(err)
+			s.Require().Equal(t.expected.personalBests, summary.PersonalBests)
+			s.Require().Equal(t.expected.longestStreakWeeks, summary.LongestStreakWeeks)
+			s.Require().Equal(t.expected.followee, summary.MostActiveFollowee != nil)
+		})
+	}
+}
+
+func (s *repoSuite) TestGetBodyweightAt() {
+	user := s.factory.NewUser()
+	now := time.Now().UTC()
+
+	for i, bodyweight := range []float64{80, 85, 90} {
+		b := &orm.Bodyweight{
+			UserID:     user.ID,
+			Bodyweight: bodyweight,
+			CreatedAt:  now.AddDate(0, i-2, 0),
+		}
+		s.Require().NoError(b.Insert(context.Background(), s.container.DB, boil.Infer()))
+	}
+
+	tests := []struct {
+		name     string
+		at       time.Time
+		expected float64
+	}{
+		{name: "ok_latest_before", at: now.AddDate(0, -1, 5), expected: 85},
+		{name: "ok_later_entry_closer", at: now.AddDate(0, 0, -1), expected: 85},
+		{name: "ok_recorded_at", at: now, expected: 90},
+		{name: "ok_earliest_when_none_before", at: now.AddDate(-1, 0, 0), expected: 80},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			bodyweight, err := s.repo.GetBodyweightAt(context.Background(), user.ID, t.at)
+			s.Require().NoError(err)
+			s.Require().InDelta(t.expected, bodyweight.Bodyweight, 0)
+		})
+	}
+
+	_, err := s.repo.GetBodyweightAt(context.Background(), s.factory.NewUser().ID, now)
+	s.Require().ErrorIs(err, sql.ErrNoRows)
+}
+
+func (s *repoSuite) TestCanViewUser() {
+	ctx := context.Background()
+	viewer := s.factory.NewUser()
+	public := s.factory.NewUser()
+	private := s.factory.NewUser(factory.UserPrivate(true))
+	followed := s.factory.NewUser(factory.UserPrivate(true))
+	s.Require().NoError(s.repo.Follow(ctx, repo.FollowParams{FollowerID: viewer.ID, FolloweeID: followed.ID}))
+
+	tests := []struct {
+		name     string
+		userID   string
+		expected bool
+	}{
+		{name: "ok_self", userID: viewer.ID, expected: true},
+		{name: "ok_public", userID: public.ID, expected: true},
+		{name: "ok_private", userID: private.ID, expected: false},
+		{name: "ok_private_followed", userID: followed.ID, expected: true},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			visible, err := s.repo.CanViewUser(ctx, viewer.ID, t.userID)
+			s.Require().NoError(err)
+			s.Require().Equal(t.expected, visible)
+		})
+	}
+
+	s.Run("ok_list_workouts_visible_to", func() {
+		publicWorkout := s.factory.NewWorkout(factory.WorkoutUserID(public.ID))
+		s.factory.NewWorkout(factory.WorkoutUserID(private.ID))
+		followedWorkout := s.factory.NewWorkout(factory.WorkoutUserID(followed.ID))
+
+		workouts, err := s.repo.ListWorkouts(ctx,
+			repo.ListWorkoutsWithUserIDs(public.ID, private.ID, followed.ID),
+			repo.ListWorkoutsVisibleTo(viewer.ID),
+		)
+		s.Require().NoError(err)
+		s.Require().Len(workouts, 2)
+		s.Require().ElementsMatch(
+			[]string{publicWorkout.ID, followedWorkout.ID},
+			[]string{workouts[0].ID, workouts[1].ID},
+		)
+	})
+}
+
+func (s *repoSuite) TestWorkoutVisibility() {
+	ctx := context.Background()
+	viewer := s.factory.NewUser()
+	owner := s.factory.NewUser()
+	followed := s.factory.NewUser()
+	s.Require().NoError(s.repo.Follow(ctx, repo.FollowParams{FollowerID: viewer.ID, FolloweeID: followed.ID}))
+
+	tests := []struct {
+		name       string
+		userID     string
+		visibility orm.WorkoutVisibility
+		expected   bool
+	}{
+		{name: "ok_public", userID: owner.ID, visibility: orm.WorkoutVisibilityPublic, expected: true},
+		{name: "ok_followers", userID: owner.ID, visibility: orm.WorkoutVisibilityFollowers, expected: false},
+		{name: "ok_followers_followed", userID: followed.ID, visibility: orm.WorkoutVisibilityFollowers, expected: true},
+		{name: "ok_private", userID: followed.ID, visibility: orm.WorkoutVisibilityPrivate, expected: false},
+		{name: "ok_private_self", userID: viewer.ID, visibility: orm.WorkoutVisibilityPrivate, expected: true},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			workout := s.factory.NewWorkout(
+				factory.WorkoutUserID(t.userID),
+				factory.WorkoutVisibility(t.visibility),
+			)
+
+			visible, err := s.repo.CanViewWorkout(ctx, viewer.ID, workout)
+			s.Require().NoError(err)
+			s.Require().Equal(t.expected, visible)
+
+			workouts, err := s.repo.ListWorkouts(ctx,
+				repo.ListWorkoutsWithIDs([]string{workout.ID}),
+				repo.ListWorkoutsVisibleTo(viewer.ID),
+			)
+			s.Require().NoError(err)
+			s.Require().Equal(t.expected, len(workouts) == 1)
+		})
+	}
+
+	s.Run("ok_default_visibility", func() {
+		user := s.factory.NewUser()
+		s.Require().NoError(s.repo.UpdateUser(ctx, user.ID, repo.UpdateUserDefaultWorkoutVisibility(orm.WorkoutVisibilityPrivate)))
+
+		workout, err := s.repo.CreateWorkout(ctx, repo.CreateWorkoutParams{
+			UserID:     user.ID,
+			StartedAt:  time.Now().Add(-time.Hour),
+			FinishedAt: time.Now(),
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(orm.WorkoutVisibilityPrivate, workout.Visibility)
+	})
+}
+
+func (s *repoSuite) TestBlockUser() {
+	ctx := context.Background()
+	blocker := s.factory.NewUser()
+	blocked := s.factory.NewUser()
+	s.Require().NoError(s.repo.Follow(ctx, repo.FollowParams{FollowerID: blocker.ID, FolloweeID: blocked.ID}))
+	s.Require().NoError(s.repo.Follow(ctx, repo.FollowParams{FollowerID: blocked.ID, FolloweeID: blocker.ID}))
+
+	p := repo.BlockParams{BlockerID: blocker.ID, BlockedID: blocked.ID}
+	s.Require().NoError(s.repo.BlockUser(ctx, p))
+	s.Require().NoError(s.repo.BlockUser(ctx, p))
+
+	s.Run("ok_follows_removed", func() {
+		followed, err := s.repo.IsUserFollowedByUserID(ctx, blocked, blocker.ID)
+		s.Require().NoError(err)
+		s.Require().False(followed)
+
+		followed, err = s.repo.IsUserFollowedByUserID(ctx, blocker, blocked.ID)
+		s.Require().NoError(err)
+		s.Require().False(followed)
+	})
+
+	s.Run("err_follow", func() {
+		err := s.repo.Follow(ctx, repo.FollowParams{FollowerID: blocked.ID, FolloweeID: blocker.ID})
+		s.Require().ErrorIs(err, repo.ErrUserBlocked)
+
+		err = s.repo.CreateFollowRequest(ctx, repo.FollowParams{FollowerID: blocker.ID, FolloweeID: blocked.ID})
+		s.Require().ErrorIs(err, repo.ErrUserBlocked)
+	})
+
+	s.Run("err_comment", func() {
+		workout := s.factory.NewWorkout(factory.WorkoutUserID(blocker.ID))
+		_, err := s.repo.CreateWorkoutComment(ctx, repo.CreateWorkoutCommentParams{
+			UserID:    blocked.ID,
+			WorkoutID: workout.ID,
+			Comment:   "comment",
+		})
+		s.Require().ErrorIs(err, repo.ErrUserBlocked)
+	})
+
+	s.Run("ok_hidden", func() {
+		workout := s.factory.NewWorkout(factory.WorkoutUserID(blocker.ID))
+		visible, err := s.repo.CanViewWorkout(ctx, blocked.ID, workout)
+		s.Require().NoError(err)
+		s.Require().False(visible)
+
+		visible, err = s.repo.CanViewUser(ctx, blocker.ID, blocked.ID)
+		s.Require().NoError(err)
+		s.Require().False(visible)
+
+		workouts, err := s.repo.ListWorkouts(ctx,
+			repo.ListWorkoutsWithIDs([]string{workout.ID}),
+			repo.ListWorkoutsVisibleTo(blocked.ID),
+		)
+		s.Require().NoError(err)
+		s.Require().Empty(workouts)
+
+		other := s.factory.NewWorkout()
+		s.factory.NewWorkoutComment(
+			factory.WorkoutCommentUserID(blocker.ID),
+			factory.WorkoutCommentWorkoutID(other.ID),
+		)
+		comment := s.factory.NewWorkoutComment(factory.WorkoutCommentWorkoutID(other.ID))
+
+		loaded, err := s.repo.GetWorkout(ctx,
+			repo.GetWorkoutWithID(other.ID),
+			repo.GetWorkoutLoadCommentsVisibleTo(blocked.ID),
+		)
+		s.Require().NoError(err)
+		s.Require().Len(loaded.R.WorkoutComments, 1)
+		s.Require().Equal(comment.ID, loaded.R.WorkoutComments[0].ID)
+
+		users, err := s.repo.ListUsers(ctx,
+			repo.ListUsersWithIDs([]string{blocker.ID}),
+			repo.ListUsersWithoutBlocked(blocked.ID),
+		)
+		s.Require().NoError(err)
+		s.Require().Empty(users)
+	})
+
+	s.Run("ok_list_blocked_users", func() {
+		users, err := s.repo.ListBlockedUsers(ctx, blocker.ID)
+		s.Require().NoError(err)
+		s.Require().Len(users, 1)
+		s.Require().Equal(blocked.ID, users[0].ID)
+
+		users, err = s.repo.ListBlockedUsers(ctx, blocked.ID)
+		s.Require().NoError(err)
+		s.Require().Empty(users)
+	})
+
+	s.Run("ok_unblock", func() {
+		s.Require().NoError(s.repo.UnblockUser(ctx, p))
+		s.Require().ErrorIs(s.repo.UnblockUser(ctx, p), sql.ErrNoRows)
+
+		isBlocked, err := s.repo.IsBlocked(ctx, blocked.ID, blocker.ID)
+		s.Require().NoError(err)
+		s.Require().False(isBlocked)
+		s.Require().NoError(s.repo.Follow(ctx, repo.FollowParams{FollowerID: blocked.ID, FolloweeID: blocker.ID}))
+	})
+}
+
+func (s *repoSuite) TestReports() {
+	ctx := context.Background()
+	admin := s.factory.NewUser()
+	reporter := s.factory.NewUser()
+	workout := s.factory.NewWorkout()
+	comment := s.factory.NewWorkoutComment(factory.WorkoutCommentWorkoutID(workout.ID))
+
+	report, err := s.repo.CreateReport(ctx, repo.CreateReportParams{
+		ReporterID: reporter.ID,
+		Target:     orm.ReportTargetComment,
+		TargetID:   comment.ID,
+		Reason:     "spam",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(orm.ReportStatusOpen, report.Status)
+
+	s.Run("ok_list_reports", func() {
+		reports, err := s.repo.ListReports(ctx,
+			repo.ListReportsWithStatus(orm.ReportStatusOpen),
+			repo.ListReportsLoadReporter(),
+		)
+		s.Require().NoError(err)
+		s.Require().NotEmpty(reports)
+		s.Require().Equal(report.ID, reports[0].ID)
+		s.Require().Equal(reporter.ID, reports[0].R.Reporter.ID)
+	})
+
+	s.Run("ok_hide_comment", func() {
+		notification := s.factory.NewNotification(factory.NotificationPayload(repo.NotificationPayload{
+			CommentID: comment.ID,
+		}))
+		s.Require().NoError(s.repo.HideWorkoutComment(ctx, comment.ID))
+
+		notifications, err := s.repo.ListNotifications(ctx, repo.ListNotificationsWithUserID(notification.UserID))
+		s.Require().NoError(err)
+		s.Require().Empty(notifications)
+
+		loaded, err := s.repo.GetWorkout(ctx,
+			repo.GetWorkoutWithID(workout.ID),
+			repo.GetWorkoutLoadCommentsVisibleTo(reporter.ID),
+		)
+		s.Require().NoError(err)
+		s.Require().Empty(loaded.R.WorkoutComments)
+	})
+
+	s.Run("ok_hide_workout", func() {
+		set := s.factory.NewSet(
+			factory.SetUserID(workout.UserID),
+			factory.SetWorkoutID(workout.ID),
+			factory.SetWeight(500),
+		)
+		s.Require().NoError(s.repo.HideWorkout(ctx, workout.ID))
+
+		_, err := s.repo.GetWorkout(ctx, repo.GetWorkoutWithID(workout.ID), repo.GetWorkoutWithoutHidden())
+		s.Require().ErrorIs(err, sql.ErrNoRows)
+
+		workouts, err := s.repo.ListWorkouts(ctx,
+			repo.ListWorkoutsWithIDs([]string{workout.ID}),
+			repo.ListWorkoutsVisibleTo(workout.UserID),
+		)
+		s.Require().NoError(err)
+		s.Require().Empty(workouts)
+
+		personalBests, err := s.repo.GetPersonalBests(ctx, workout.UserID)
+		s.Require().NoError(err)
+		for _, personalBest := range personalBests {
+			s.Require().NotEqual(set.ID, personalBest.ID)
+		}
+	})
+
+	s.Run("ok_resolve_report", func() {
+		p := repo.ResolveReportParams{
+			ReportID:   report.ID,
+			ResolverID: admin.ID,
+			Status:     orm.ReportStatusActioned,
+		}
+		s.Require().NoError(s.repo.ResolveReport(ctx, p))
+		s.Require().ErrorIs(s.repo.ResolveReport(ctx, p), sql.ErrNoRows)
+
+		resolved, err := s.repo.GetReport(ctx, repo.GetReportWithID(report.ID))
+		s.Require().NoError(err)
+		s.Require().Equal(orm.ReportStatusActioned, resolved.Status)
+		s.Require().Equal(admin.ID, resolved.ResolvedBy.String)
+	})
+
+	s.Run("ok_suspend_user", func() {
+		user := s.factory.NewUser()
+		s.Require().NoError(s.repo.SuspendUser(ctx, user.ID))
+
+		suspended, err := s.repo.GetUser(ctx, repo.GetUserWithID(user.ID))
+		s.Require().NoError(err)
+		s.Require().True(suspended.SuspendedAt.Valid)
+	})
+}
+
+func (s *repoSuite) TestGetLeaderboard() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	viewer := s.factory.NewUser()
+	followee := s.factory.NewUser()
+	private := s.factory.NewUser()
+	unlinked := s.factory.NewUser()
+
+	newSet := func(userID string, catalogExercise orm.CatalogExercise, weight float64, reps int, visibility orm.WorkoutVisibility) {
+		opts := []factory.ExerciseOpt{factory.ExerciseUserID(userID), factory.ExerciseTitle("Bench Press")}
+		if catalogExercise != "" {
+			opts = append(opts, factory.ExerciseCatalogExercise(catalogExercise))
+		}
+		exercise := s.factory.NewExercise(opts...)
+		workout := s.factory.NewWorkout(
+			factory.WorkoutUserID(userID),
+			factory.WorkoutFinishedAt(now.Add(-time.Hour)),
+			factory.WorkoutVisibility(visibility),
+		)
+		s.factory.NewSet(
+			factory.SetUserID(userID),
+			factory.SetExerciseID(exercise.ID),
+			factory.SetWorkoutID(workout.ID),
+			factory.SetWeight(weight),
+			factory.SetReps(reps),
+		)
+	}
+
+	newBodyweight := func(userID string, bodyweight float64, createdAt time.Time) {
+		b := &orm.Bodyweight{UserID: userID, Bodyweight: bodyweight, CreatedAt: createdAt}
+		s.Require().NoError(b.Insert(ctx, s.container.DB, boil.Infer()))
+	}
+
+	newSet(viewer.ID, orm.CatalogExerciseBenchPress, 100, 1, orm.WorkoutVisibilityPublic)
+	newSet(viewer.ID, orm.CatalogExerciseBenchPress, 90, 5, orm.WorkoutVisibilityPublic)
+	newSet(followee.ID, orm.CatalogExerciseBenchPress, 120, 1, orm.WorkoutVisibilityPublic)
+	newSet(private.ID, orm.CatalogExerciseBenchPress, 200, 1, orm.WorkoutVisibilityPrivate)
+	newSet(unlinked.ID, "", 300, 1, orm.WorkoutVisibilityPublic)
+
+	// The viewer weighed 100 when lifting and 50 later on, while the followee
+	// weighed 80 throughout.
+	newBodyweight(viewer.ID, 100, now.Add(-2*time.Hour))
+	newBodyweight(viewer.ID, 50, now.Add(-time.Minute))
+	newBodyweight(followee.ID, 80, now.Add(-2*time.Hour))
+
+	userIDs := []string{viewer.ID, followee.ID, private.ID, unlinked.ID}
+
+	tests := []struct {
+		name          string
+		metric        repo.LeaderboardMetric
+		perBodyweight bool
+		expected      []repo.LeaderboardEntry
+	}{
+		{
+			name:   "ok_heaviest_single",
+			metric: repo.LeaderboardMetricHeaviestSingle,
+			expected: []repo.LeaderboardEntry{
+				{UserID: followee.ID, Value: 120, Rank: 1},
+				{UserID: viewer.ID, Value: 100, Rank: 2},
+			},
+		},
+		{
+			name:   "ok_best_estimated_max",
+			metric: repo.LeaderboardMetricBestEstimatedMax,
+			expected: []repo.LeaderboardEntry{
+				{UserID: followee.ID, Value: 120, Rank: 1},
+				{UserID: viewer.ID, Value: strength.EstimatedOneRepMax(90, 5), Rank: 2},
+			},
+		},
+		{
+			name:          "ok_heaviest_single_per_bodyweight_at_workout",
+			metric:        repo.LeaderboardMetricHeaviestSingle,
+			perBodyweight: true,
+			expected: []repo.LeaderboardEntry{
+				{UserID: followee.ID, Value: 1.5, Rank: 1},
+				{UserID: viewer.ID, Value: 1, Rank: 2},
+			},
+		},
+		{
+			name:   "ok_session_count",
+			metric: repo.LeaderboardMetricSessionCount,
+			expected: []repo.LeaderboardEntry{
+				{UserID: viewer.ID, Value: 2, Rank: 1},
+				{UserID: followee.ID, Value: 1, Rank: 2},
+			},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			entries, err := s.repo.GetLeaderboard(ctx, repo.GetLeaderboardParams{
+				ViewerID:        viewer.ID,
+				UserIDs:         userIDs,
+				CatalogExercise: orm.CatalogExerciseBenchPress,
+				Metric:          t.metric,
+				From:            now.Add(-24 * time.Hour),
+				To:              now,
+				PerBodyweight:   t.perBodyweight,
+			})
+			s.Require().NoError(err)
+			s.Require().Len(entries, len(t.expected))
+			for i, entry := range entries {
+				s.Require().Equal(t.expected[i].UserID, entry.UserID)
+				s.Require().Equal(t.expected[i].Rank, entry.Rank)
+				s.Require().InDelta(t.expected[i].Value, entry.Value, 0.001)
+			}
+		})
+	}
+
+	_, err := s.repo.GetLeaderboard(ctx, repo.GetLeaderboardParams{
+		ViewerID: viewer.ID,
+		UserIDs:  []string{viewer.ID},
+		Metric:   "unknown",
+		From:     now.Add(-24 * time.Hour),
+		To:       now,
+	})
+	s.Require().ErrorIs(err, repo.ErrInvalidLeaderboardMetric)
+}
+
+func (s *repoSuite) TestChallenges() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	creator := s.factory.NewUser()
+	invitee := s.factory.NewUser()
+	stranger := s.factory.NewUser()
+
+	challenge, err := s.repo.CreateChallenge(ctx, repo.CreateChallengeParams{
+		CreatorID: creator.ID,
+		Title:     "Most volume",
+		Metric:    orm.ChallengeMetricTotalVolume,
+		StartsAt:  now.Add(-24 * time.Hour),
+		EndsAt:    now.Add(24 * time.Hour),
+	})
+	s.Require().NoError(err)
+
+	s.Run("ok_invite", func() {
+		invited, err := s.repo.InviteToChallenge(ctx, challenge.ID, []string{invitee.ID})
+		s.Require().NoError(err)
+		s.Require().Equal([]string{invitee.ID}, invited)
+
+		invited, err = s.repo.InviteToChallenge(ctx, challenge.ID, []string{invitee.ID})
+		s.Require().NoError(err)
+		s.Require().Empty(invited)
+	})
+
+	s.Run("err_not_participant", func() {
+		_, err := s.repo.GetChallenge(ctx,
+			repo.GetChallengeWithID(challenge.ID),
+			repo.GetChallengeWithParticipant(stranger.ID),
+		)
+		s.Require().ErrorIs(err, sql.ErrNoRows)
+	})
+
+	s.Run("ok_standings", func() {
+		// Invited users are left out of the standings until they join.
+		standings, err := s.repo.GetChallengeStandings(ctx, challenge.ID)
+		s.Require().NoError(err)
+		s.Require().Len(standings, 1)
+		s.Require().Equal(creator.ID, standings[0].UserID)
+
+		s.Require().NoError(s.repo.JoinChallenge(ctx, challenge.ID, invitee.ID))
+		s.Require().ErrorIs(s.repo.JoinChallenge(ctx, challenge.ID, invitee.ID), sql.ErrNoRows)
+
+		workout := s.factory.NewWorkout(
+			factory.WorkoutUserID(invitee.ID),
+			factory.WorkoutFinishedAt(now.Add(-time.Hour)),
+		)
+		s.factory.NewSet(
+			factory.SetUserID(invitee.ID),
+			factory.SetWorkoutID(workout.ID),
+			factory.SetWeight(100),
+			factory.SetReps(5),
+		)
+
+		standings, err = s.repo.GetChallengeStandings(ctx, challenge.ID)
+		s.Require().NoError(err)
+		s.Require().Len(standings, 2)
+		s.Require().Equal(invitee.ID, standings[0].UserID)
+		s.Require().InDelta(500, standings[0].Value, 0.001)
+		s.Require().Equal(creator.ID, standings[1].UserID)
+		s.Require().Zero(standings[1].Value)
+	})
+
+	s.Run("ok_leave", func() {
+		s.Require().NoError(s.repo.LeaveChallenge(ctx, challenge.ID, invitee.ID))
+		s.Require().ErrorIs(s.repo.LeaveChallenge(ctx, challenge.ID, invitee.ID), sql.ErrNoRows)
+	})
+}
+
+func (s *repoSuite) TestClubs() {
+	ctx := context.Background()
+	owner := s.factory.NewUser()
+	admin := s.factory.NewUser()
+	requester := s.factory.NewUser()
+
+	club, err := s.repo.CreateClub(ctx, repo.CreateClubParams{
+		OwnerID: owner.ID,
+		Name:    "Barbell Club",
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.repo.CreateClubMember(ctx, repo.CreateClubMemberParams{
+		ClubID: club.ID,
+		UserID: admin.ID,
+		Status: orm.ClubMemberStatusActive,
+	}))
+	s.Require().NoError(s.repo.UpdateClubMember(ctx, club.ID, admin.ID, repo.UpdateClubMemberRole(orm.ClubRoleAdmin)))
+	s.Require().NoError(s.repo.CreateClubMember(ctx, repo.CreateClubMemberParams{
+		ClubID: club.ID,
+		UserID: requester.ID,
+		Status: orm.ClubMemberStatusRequested,
+	}))
+
+	s.Run("ok_list_clubs", func() {
+		clubs, err := s.repo.ListClubs(ctx,
+			repo.ListClubsWithMember(requester.ID),
+			repo.ListClubsLoadMember(requester.ID),
+		)
+		s.Require().NoError(err)
+		s.Require().Len(clubs, 1)
+		s.Require().Equal(orm.ClubMemberStatusRequested, clubs[0].R.ClubMembers[0].Status)
+	})
+
+	s.Run("ok_list_workouts_with_club_members", func() {
+		ownerWorkout := s.factory.NewWorkout(factory.WorkoutUserID(owner.ID))
+		s.factory.NewWorkout(factory.WorkoutUserID(requester.ID))
+
+		workouts, err := s.repo.ListWorkouts(ctx, repo.ListWorkoutsWithClubMembers(club.ID))
+		s.Require().NoError(err)
+		s.Require().Len(workouts, 1)
+		s.Require().Equal(ownerWorkout.ID, workouts[0].ID)
+	})
+
+	s.Run("ok_transfer_ownership", func() {
+		s.Require().NoError(s.repo.TransferClubOwnership(ctx, repo.TransferClubOwnershipParams{
+			ClubID:     club.ID,
+			OwnerID:    owner.ID,
+			NewOwnerID: admin.ID,
+		}))
+
+		member, err := s.repo.GetClubMember(ctx, club.ID, owner.ID)
+		s.Require().NoError(err)
+		s.Require().Equal(orm.ClubRoleAdmin, member.Role)
+
+		member, err = s.repo.GetClubMember(ctx, club.ID, admin.ID)
+		s.Require().NoError(err)
+		s.Require().Equal(orm.ClubRoleOwner, member.Role)
+	})
+
+	s.Run("ok_delete_account_passes_on_ownership", func() {
+		s.Require().NoError(s.repo.DeleteAccount(ctx, admin.ID))
+
+		member, err := s.repo.GetClubMember(ctx, club.ID, owner.ID)
+		s.Require().NoError(err)
+		s.Require().Equal(orm.ClubRoleOwner, member.Role)
+
+		s.Require().NoError(s.repo.DeleteAccount(ctx, owner.ID))
+		_, err = s.repo.GetClub(ctx, repo.GetClubWithID(club.ID))
+		s.Require().ErrorIs(err, sql.ErrNoRows)
+	})
+}
+
+func (s *repoSuite) TestFeedSources() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	viewer := s.factory.NewUser()
+	lifter := s.factory.NewUser()
+	friend := s.factory.NewUser()
+
+	s.Require().NoError(s.repo.Follow(ctx, repo.FollowParams{FollowerID: viewer.ID, FolloweeID: lifter.ID}))
+	s.Require().NoError(s.repo.Follow(ctx, repo.FollowParams{FollowerID: viewer.ID, FolloweeID: friend.ID}))
+	s.Require().NoError(s.repo.Follow(ctx, repo.FollowParams{FollowerID: lifter.ID, FolloweeID: friend.ID}))
+
+	s.Run("ok_list_personal_records", func() {
+		exercise := s.factory.NewExercise(factory.ExerciseUserID(lifter.ID))
+		newSet := func(workoutID string, weight float64) *orm.Set {
+			return s.factory.NewSet(
+				factory.SetUserID(lifter.ID),
+				factory.SetWorkoutID(workoutID),
+				factory.SetExerciseID(exercise.ID),
+				factory.SetWeight(weight),
+				factory.SetReps(5),
+			)
+		}
+
+		first := s.factory.NewWorkout(factory.WorkoutUserID(lifter.ID), factory.WorkoutCreatedAt(now.Add(-2*time.Hour)))
+		newSet(first.ID, 100)
+		second := s.factory.NewWorkout(factory.WorkoutUserID(lifter.ID), factory.WorkoutCreatedAt(now.Add(-time.Hour)))
+		record := newSet(second.ID, 105)
+		newSet(second.ID, 100)
+		third := s.factory.NewWorkout(factory.WorkoutUserID(lifter.ID), factory.WorkoutCreatedAt(now))
+		newSet(third.ID, 105)
+
+		s.Require().NoError(s.repo.CreatePersonalRecords(ctx, lifter.ID))
+		s.Require().NoError(s.repo.CreatePersonalRecords(ctx, lifter.ID))
+
+		records, err := s.repo.ListPersonalRecords(ctx,
+			repo.ListPersonalRecordsWithUserIDs(lifter.ID),
+			repo.ListPersonalRecordsVisibleTo(viewer.ID),
+			repo.ListPersonalRecordsLoadSetExercise(),
+		)
+		s.Require().NoError(err)
+		s.Require().Len(records, 1)
+		s.Require().Equal(record.ID, records[0].SetID)
+		s.Require().Equal(second.ID, records[0].WorkoutID)
+		s.Require().Equal(exercise.ID, records[0].R.GetSet().R.GetExercise().ID)
+
+		s.Require().NoError(s.repo.DeleteWorkout(ctx, repo.DeleteWorkoutWithID(second.ID)))
+		records, err = s.repo.ListPersonalRecords(ctx,
+			repo.ListPersonalRecordsWithUserIDs(lifter.ID),
+			repo.ListPersonalRecordsWithoutDeletedWorkouts(),
+		)
+		s.Require().NoError(err)
+		s.Require().Empty(records)
+	})
+
+	s.Run("ok_list_follows", func() {
+		follows, err := s.repo.ListFollows(ctx,
+			repo.ListFollowsWithFollowerIDs(lifter.ID, friend.ID),
+			repo.ListFollowsWithFolloweeIDs(lifter.ID, friend.ID),
+			repo.ListFollowsLoadUsers(),
+		)
+		s.Require().NoError(err)
+		s.Require().Len(follows, 1)
+		s.Require().Equal(lifter.ID, follows[0].R.Follower.ID)
+		s.Require().Equal(friend.ID, follows[0].R.Followee.ID)
+	})
+
+	s.Run("ok_list_achievements", func() {
+		user := s.factory.NewUser()
+		workouts := make(orm.WorkoutSlice, 0, 10)
+		for i := range 10 {
+			workouts = append(workouts, s.factory.NewWorkout(
+				factory.WorkoutUserID(user.ID),
+				factory.WorkoutCreatedAt(now.Add(time.Duration(i-10)*time.Hour)),
+			))
+		}
+
+		s.Require().NoError(s.repo.CreateAchievements(ctx, user.ID))
+
+		// Achievements are kept once recorded.
+		s.Require().NoError(s.repo.DeleteWorkout(ctx, repo.DeleteWorkoutWithID(workouts[0].ID)))
+		s.Require().NoError(s.repo.CreateAchievements(ctx, user.ID))
+
+		achievements, err := s.repo.ListAchievements(ctx,
+			repo.ListAchievementsWithUserIDs(user.ID),
+			repo.ListAchievementsVisibleTo(viewer.ID),
+		)
+		s.Require().NoError(err)
+		s.Require().Len(achievements, 1)
+		s.Require().Equal(10, achievements[0].WorkoutCount)
+		s.Require().True(achievements[0].CreatedAt.Equal(now.Add(-time.Hour).Truncate(time.Microsecond)))
+	})
+
+	s.Run("ok_list_ended_challenges", func() {
+		challenge, err := s.repo.CreateChallenge(ctx, repo.CreateChallengeParams{
+			CreatorID: viewer.ID,
+			Title:     "Most sessions",
+			Metric:    orm.ChallengeMetricSessionCount,
+			StartsAt:  now.Add(-48 * time.Hour),
+			EndsAt:    now.Add(-time.Hour),
+		})
+		s.Require().NoError(err)
+
+		opts := []repo.ListChallengesOpt{
+			repo.ListChallengesWithJoinedParticipant(viewer.ID),
+			repo.ListChallengesWithEndedPageToken(nil),
+			repo.ListChallengesLoadLeader(),
+		}
+
+		challenges, err := s.repo.ListChallenges(ctx, opts...)
+		s.Require().NoError(err)
+		s.Require().Empty(challenges)
+
+		s.Require().NoError(s.repo.UpdateChallenge(ctx, challenge.ID,
+			repo.UpdateChallengeLeaderID(viewer.ID),
+			repo.UpdateChallengeEndedAt(now),
+		))
+
+		challenges, err = s.repo.ListChallenges(ctx, opts...)
+		s.Require().NoError(err)
+		s.Require().Len(challenges, 1)
+		s.Require().Equal(viewer.ID, challenges[0].R.Leader.ID)
+	})
+}
+
+func (s *repoSuite) TestCoaching() {
+	ctx := context.Background()
+	coach := s.factory.NewUser()
+	athlete := s.factory.NewUser()
+	now := time.Now().UTC()
+
+	p := repo.CoachingParams{CoachID: coach.ID, AthleteID: athlete.ID}
+	s.Require().NoError(s.repo.GrantCoachAccess(ctx, p))
+	s.Require().NoError(s.repo.GrantCoachAccess(ctx, p))
+
+	s.Run("ok_list_coaches", func() {
+		coaches, err := s.repo.ListCoaches(ctx, athlete.ID)
+		s.Require().NoError(err)
+		s.Require().Len(coaches, 1)
+		s.Require().Equal(coach.ID, coaches[0].ID)
+	})
+
+	s.Run("ok_list_athlete_summaries", func() {
+		recent := s.factory.NewWorkout(
+			factory.WorkoutUserID(athlete.ID),
+			factory.WorkoutFinishedAt(now.Add(-24*time.Hour)),
+		)
+		s.factory.NewSet(factory.SetWorkoutID(recent.ID), factory.SetWeight(100), factory.SetReps(5))
+		older := s.factory.NewWorkout(
+			factory.WorkoutUserID(athlete.ID),
+			factory.WorkoutFinishedAt(now.Add(-14*24*time.Hour)),
+		)
+		s.factory.NewSet(factory.SetWorkoutID(older.ID), factory.SetWeight(100), factory.SetReps(3))
+		hidden := s.factory.NewWorkout(
+			factory.WorkoutUserID(athlete.ID),
+			factory.WorkoutFinishedAt(now.Add(-time.Hour)),
+		)
+		s.factory.NewSet(factory.SetWorkoutID(hidden.ID), factory.SetWeight(1000), factory.SetReps(10))
+		s.Require().NoError(s.repo.HideWorkout(ctx, hidden.ID))
+
+		summaries, err := s.repo.ListAthleteSummaries(ctx, coach.ID, now)
+		s.Require().NoError(err)
+		s.Require().Len(summaries, 1)
+		s.Require().Equal(athlete.ID, summaries[0].AthleteID)
+		s.Require().True(recent.FinishedAt.Equal(summaries[0].LastSessionAt.Time))
+		s.Require().InDelta(500, summaries[0].AcuteLoad, 0.001)
+		s.Require().InDelta(200, summaries[0].ChronicLoad, 0.001)
+	})
+
+	s.Run("ok_list_coach_actions", func() {
+		s.Require().NoError(s.repo.CreateCoachAction(ctx, repo.CreateCoachActionParams{
+			CoachID:   coach.ID,
+			AthleteID: athlete.ID,
+			Action:    orm.CoachActionTypeViewWorkouts,
+		}))
+
+		actions, err := s.repo.ListCoachActions(ctx,
+			repo.ListCoachActionsWithAthleteID(athlete.ID),
+			repo.ListCoachActionsLoadCoach(),
+		)
+		s.Require().NoError(err)
+		s.Require().Len(actions, 1)
+		s.Require().Equal(orm.CoachActionTypeViewWorkouts, actions[0].Action)
+		s.Require().Equal(coach.ID, actions[0].R.Coach.ID)
+	})
+
+	s.Run("ok_block_revokes_access", func() {
+		s.Require().NoError(s.repo.BlockUser(ctx, repo.BlockParams{BlockerID: athlete.ID, BlockedID: coach.ID}))
+
+		isCoach, err := s.repo.IsCoach(ctx, p)
+		s.Require().NoError(err)
+		s.Require().False(isCoach)
+
+		s.Require().ErrorIs(s.repo.GrantCoachAccess(ctx, p), repo.ErrUserBlocked)
+		s.Require().ErrorIs(s.repo.RevokeCoachAccess(ctx, p), sql.ErrNoRows)
+	})
+}
+
+func (s *repoSuite) TestFollowRequests() {
+	ctx := context.Background()
+	followee := s.factory.NewUser(factory.UserPrivate(true))
+
+	s.Run("ok_approve", func() {
+		follower := s.factory.NewUser()
+		p := repo.FollowParams{FollowerID: follower.ID, FolloweeID: followee.ID}
+		s.Require().NoError(s.repo.CreateFollowRequest(ctx, p))
+		s.Require().NoError(s.repo.CreateFollowRequest(ctx, p))
+		s.Require().NoError(s.repo.ApproveFollowRequest(ctx, p))
+
+		followed, err := s.repo.IsUserFollowedByUserID(ctx, followee, follower.ID)
+		s.Require().NoError(err)
+		s.Require().True(followed)
+
+		err = s.repo.ApproveFollowRequest(ctx, p)
+		s.Require().ErrorIs(err, sql.ErrNoRows)
+	})
+
+	s.Run("ok_reject", func() {
+		follower := s.factory.NewUser()
+		p := repo.FollowParams{FollowerID: follower.ID, FolloweeID: followee.ID}
+		s.Require().NoError(s.repo.CreateFollowRequest(ctx, p))
+		s.Require().NoError(s.repo.DeleteFollowRequest(ctx, p))
+
+		followed, err := s.repo.IsUserFollowedByUserID(ctx, followee, follower.ID)
+		s.Require().NoError(err)
+		s.Require().False(followed)
+	})
+
+	s.Run("ok_unfollow_cancels_request", func() {
+		follower := s.factory.NewUser()
+		p := repo.FollowParams{FollowerID: follower.ID, FolloweeID: followee.ID}
+		s.Require().NoError(s.repo.CreateFollowRequest(ctx, p))
+		s.Require().NoError(s.repo.Unfollow(ctx, repo.UnfollowParams{FollowerID: follower.ID, FolloweeID: followee.ID}))
+
+		requests, err := s.repo.ListFollowRequests(ctx, repo.ListFollowRequestsWithFollowerID(follower.ID))
+		s.Require().NoError(err)
+		s.Require().Empty(requests)
+	})
+
+	s.Run("ok_approve_all", func() {
+		user := s.factory.NewUser(factory.UserPrivate(true))
+		followers := s.factory.NewUserSlice(2)
+		for _, follower := range followers {
+			s.Require().NoError(s.repo.CreateFollowRequest(ctx, repo.FollowParams{FollowerID: follower.ID, FolloweeID: user.ID}))
+		}
+
+		approved, err := s.repo.ApproveFollowRequests(ctx, user.ID)
+		s.Require().NoError(err)
+		s.Require().Len(approved, 2)
+
+		users, err := s.repo.ListFollowers(ctx, user.ID)
+		s.Require().NoError(err)
+		s.Require().Len(users, 2)
+	})
+}
@@ -21,6 +21,11 @@ func Module() fx.Option {
 			handlers.NewWorkoutHandler,
 			handlers.NewExerciseHandler,
 			handlers.NewNotificationHandler,
+			handlers.NewReportHandler,
+			handlers.NewAdminHandler,
+			handlers.NewChallengeHandler,
+			handlers.NewClubHandler,
+			handlers.NewCoachHandler,
 		),
 	)
 }
@@ -35,6 +40,11 @@ type BuildHandlersParams struct {
 	Workout      apiv1connect.WorkoutServiceHandler
 	Exercise     apiv1connect.ExerciseServiceHandler
 	Notification apiv1connect.NotificationServiceHandler
+	Report       apiv1connect.ReportServiceHandler
+	Admin        apiv1connect.AdminServiceHandler
+	Challenge    apiv1connect.ChallengeServiceHandler
+	Club         apiv1connect.ClubServiceHandler
+	Coach        apiv1connect.CoachServiceHandler
 }
 
 type HandlerFunc func(opts ...connect.HandlerOption) (string, http.Handler)
@@ -62,5 +72,20 @@ func BuildHandlers(p BuildHandlersParams) []HandlerFunc {
 		func(opts ...connect.HandlerOption) (string, http.Handler) {
 			return apiv1connect.NewNotificationServiceHandler(p.Notification, opts...)
 		},
+		func(opts ...connect.HandlerOption) (string, http.Handler) {
+			return apiv1connect.NewReportServiceHandler(p.Report, opts...)
+		},
+		func(opts ...connect.HandlerOption) (string, http.Handler) {
+			return apiv1connect.NewAdminServiceHandler(p.Admin, opts...)
+		},
+		func(opts ...connect.HandlerOption) (string, http.Handler) {
+			return apiv1connect.NewChallengeServiceHandler(p.Challenge, opts...)
+		},
+		func(opts ...connect.HandlerOption) (string, http.Handler) {
+			return apiv1connect.NewClubServiceHandler(p.Club, opts...)
+		},
+		func(opts ...connect.HandlerOption) (string, http.Handler) {
+			return apiv1connect.NewCoachServiceHandler(p.Coach, opts...)
+		},
 	}
 }
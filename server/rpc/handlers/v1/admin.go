@@ -0,0 +1,242 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/gen/orm"
+	apiv1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+	"github.com/crlssn/getstronger/server/gen/proto/api/v1/apiv1connect"
+	"github.com/crlssn/getstronger/server/repo"
+	"github.com/crlssn/getstronger/server/rpc/parser"
+	"github.com/crlssn/getstronger/server/xcontext"
+)
+
+var _ apiv1connect.AdminServiceHandler = (*adminHandler)(nil)
+
+type adminHandler struct {
+	repo repo.Repo
+}
+
+func NewAdminHandler(r repo.Repo) apiv1connect.AdminServiceHandler {
+	return &adminHandler{r}
+}
+
+func (h *adminHandler) ListReports(ctx context.Context, req *connect.Request[apiv1.ListReportsRequest]) (*connect.Response[apiv1.ListReportsResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+
+	limit := int(req.Msg.GetPagination().GetPageLimit())
+	opts := []repo.ListReportsOpt{
+		repo.ListReportsLoadReporter(),
+		repo.ListReportsWithLimit(limit + 1),
+		repo.ListReportsWithPageToken(req.Msg.GetPagination().GetPageToken()),
+	}
+
+	if req.Msg.GetStatus() != apiv1.ReportStatus_REPORT_STATUS_UNSPECIFIED {
+		opts = append(opts, repo.ListReportsWithStatus(parser.ReportStatusFromPB(req.Msg.GetStatus())))
+	}
+
+	reports, err := h.repo.ListReports(ctx, opts...)
+	if err != nil {
+		log.Error("failed to list reports", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	pagination, err := repo.PaginateSlice(reports, limit, func(report *orm.Report) time.Time {
+		return report.CreatedAt
+	})
+	if err != nil {
+		log.Error("failed to paginate reports", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("reports listed")
+	return &connect.Response[apiv1.ListReportsResponse]{
+		Msg: &apiv1.ListReportsResponse{
+			Reports: parser.ReportSlice(pagination.Items),
+			Pagination: &apiv1.PaginationResponse{
+				NextPageToken: pagination.NextPageToken,
+			},
+		},
+	}, nil
+}
+
+var ErrReportTargetNotHideable = errors.New("reported users cannot be hidden")
+
+func (h *adminHandler) HideContent(ctx context.Context, req *connect.Request[apiv1.HideContentRequest]) (*connect.Response[apiv1.HideContentResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	report, err := h.getOpenReport(ctx, req.Msg.GetReportId())
+	if err != nil {
+		return nil, err
+	}
+
+	if report.Target == orm.ReportTargetUser {
+		log.Warn("report target cannot be hidden")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrReportTargetNotHideable)
+	}
+
+	if err = h.repo.NewTx(ctx, func(tx repo.Tx) error {
+		switch report.Target {
+		case orm.ReportTargetWorkout:
+			if err = tx.HideWorkout(ctx, report.TargetID); err != nil {
+				return fmt.Errorf("hide workout: %w", err)
+			}
+		case orm.ReportTargetComment:
+			if err = tx.HideWorkoutComment(ctx, report.TargetID); err != nil {
+				return fmt.Errorf("hide workout comment: %w", err)
+			}
+		case orm.ReportTargetUser:
+		}
+
+		if err = tx.ResolveReport(ctx, repo.ResolveReportParams{
+			ReportID:   report.ID,
+			ResolverID: userID,
+			Status:     orm.ReportStatusActioned,
+		}); err != nil {
+			return fmt.Errorf("resolve report: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("reported content not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to hide content", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("content hidden")
+	return &connect.Response[apiv1.HideContentResponse]{
+		Msg: &apiv1.HideContentResponse{},
+	}, nil
+}
+
+func (h *adminHandler) DismissReport(ctx context.Context, req *connect.Request[apiv1.DismissReportRequest]) (*connect.Response[apiv1.DismissReportResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if err := h.repo.ResolveReport(ctx, repo.ResolveReportParams{
+		ReportID:   req.Msg.GetReportId(),
+		ResolverID: userID,
+		Status:     orm.ReportStatusDismissed,
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("open report not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to dismiss report", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("report dismissed")
+	return &connect.Response[apiv1.DismissReportResponse]{
+		Msg: &apiv1.DismissReportResponse{},
+	}, nil
+}
+
+func (h *adminHandler) SuspendUser(ctx context.Context, req *connect.Request[apiv1.SuspendUserRequest]) (*connect.Response[apiv1.SuspendUserResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	report, err := h.getOpenReport(ctx, req.Msg.GetReportId())
+	if err != nil {
+		return nil, err
+	}
+
+	suspendedID, err := h.reportedUserID(ctx, report)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("reported content not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get reported user", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if suspendedID == userID {
+		log.Warn("admin tried to suspend themselves")
+		return nil, connect.NewError(connect.CodeInvalidArgument, nil)
+	}
+
+	if err = h.repo.NewTx(ctx, func(tx repo.Tx) error {
+		if err = tx.SuspendUser(ctx, suspendedID); err != nil {
+			return fmt.Errorf("suspend user: %w", err)
+		}
+
+		if err = tx.ResolveReport(ctx, repo.ResolveReportParams{
+			ReportID:   report.ID,
+			ResolverID: userID,
+			Status:     orm.ReportStatusActioned,
+		}); err != nil {
+			return fmt.Errorf("resolve report: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		log.Error("failed to suspend user", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("user suspended")
+	return &connect.Response[apiv1.SuspendUserResponse]{
+		Msg: &apiv1.SuspendUserResponse{},
+	}, nil
+}
+
+// getOpenReport returns the report or a connect error if it does not exist or
+// has already been resolved.
+func (h *adminHandler) getOpenReport(ctx context.Context, reportID string) (*orm.Report, error) {
+	log := xcontext.MustExtractLogger(ctx)
+
+	report, err := h.repo.GetReport(ctx, repo.GetReportWithID(reportID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("report not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get report", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if report.Status != orm.ReportStatusOpen {
+		log.Warn("report already resolved")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, nil)
+	}
+
+	return report, nil
+}
+
+// reportedUserID returns the id of the reported user, or of the author of the
+// reported workout or comment.
+func (h *adminHandler) reportedUserID(ctx context.Context, report *orm.Report) (string, error) {
+	switch report.Target {
+	case orm.ReportTargetWorkout:
+		workout, err := h.repo.GetWorkout(ctx, repo.GetWorkoutWithID(report.TargetID))
+		if err != nil {
+			return "", fmt.Errorf("get workout: %w", err)
+		}
+		return workout.UserID, nil
+	case orm.ReportTargetComment:
+		comment, err := h.repo.GetWorkoutComment(ctx, repo.GetWorkoutCommentWithID(report.TargetID))
+		if err != nil {
+			return "", fmt.Errorf("get workout comment: %w", err)
+		}
+		return comment.UserID, nil
+	case orm.ReportTargetUser:
+	}
+
+	return report.TargetID, nil
+}
@@ -0,0 +1,353 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/volatiletech/null/v8"
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/gen/orm"
+	apiv1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+	"github.com/crlssn/getstronger/server/gen/proto/api/v1/apiv1connect"
+	"github.com/crlssn/getstronger/server/pubsub"
+	"github.com/crlssn/getstronger/server/pubsub/payloads"
+	"github.com/crlssn/getstronger/server/repo"
+	"github.com/crlssn/getstronger/server/rpc/parser"
+	"github.com/crlssn/getstronger/server/xcontext"
+	"github.com/crlssn/getstronger/server/xzap"
+)
+
+var _ apiv1connect.ChallengeServiceHandler = (*challengeHandler)(nil)
+
+type challengeHandler struct {
+	repo   repo.Repo
+	pubSub *pubsub.PubSub
+}
+
+func NewChallengeHandler(r repo.Repo, ps *pubsub.PubSub) apiv1connect.ChallengeServiceHandler {
+	return &challengeHandler{r, ps}
+}
+
+var (
+	ErrChallengeEndsInPast         = errors.New("challenge must end in the future")
+	ErrChallengeEnded              = errors.New("challenge has ended")
+	ErrChallengeInviteeNotFollower = errors.New("only followers can be invited")
+	ErrChallengeCreatorCannotLeave = errors.New("creator cannot leave their own challenge")
+)
+
+func (h *challengeHandler) CreateChallenge(ctx context.Context, req *connect.Request[apiv1.CreateChallengeRequest]) (*connect.Response[apiv1.CreateChallengeResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	startsAt := req.Msg.GetStartsAt().AsTime()
+	endsAt := req.Msg.GetEndsAt().AsTime()
+	if !startsAt.Before(endsAt) {
+		log.Warn("invalid date range")
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidDateRange)
+	}
+
+	if !endsAt.After(time.Now().UTC()) {
+		log.Warn("challenge ends in the past")
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrChallengeEndsInPast)
+	}
+
+	inviteeIDs, err := h.followerIDs(ctx, userID, req.Msg.GetUserIds())
+	if err != nil {
+		if errors.Is(err, ErrChallengeInviteeNotFollower) {
+			log.Warn("invitee is not a follower")
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrChallengeInviteeNotFollower)
+		}
+
+		log.Error("failed to list followers", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	var challengeID string
+	var invited []string
+	if err = h.repo.NewTx(ctx, func(tx repo.Tx) error {
+		challenge, err := tx.CreateChallenge(ctx, repo.CreateChallengeParams{
+			CreatorID: userID,
+			Title:     req.Msg.GetTitle(),
+			Metric:    parser.ChallengeMetricFromPB(req.Msg.GetMetric()),
+			Goal:      null.Float64FromPtr(req.Msg.Goal),
+			StartsAt:  startsAt,
+			EndsAt:    endsAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create challenge: %w", err)
+		}
+		challengeID = challenge.ID
+
+		if invited, err = tx.InviteToChallenge(ctx, challenge.ID, inviteeIDs); err != nil {
+			return fmt.Errorf("failed to invite to challenge: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		log.Error("failed to create challenge", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log = log.With(xzap.FieldChallengeID(challengeID))
+	h.publishInvites(ctx, userID, challengeID, invited)
+
+	challenge, err := h.repo.GetChallenge(ctx,
+		repo.GetChallengeWithID(challengeID),
+		repo.GetChallengeLoadCreator(),
+		repo.GetChallengeLoadParticipant(userID),
+	)
+	if err != nil {
+		log.Error("failed to get challenge", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("challenge created")
+	return connect.NewResponse(&apiv1.CreateChallengeResponse{
+		Challenge: parser.Challenge(challenge),
+	}), nil
+}
+
+func (h *challengeHandler) InviteToChallenge(ctx context.Context, req *connect.Request[apiv1.InviteToChallengeRequest]) (*connect.Response[apiv1.InviteToChallengeResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldChallengeID(req.Msg.GetChallengeId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	challenge, err := h.getChallenge(ctx, req.Msg.GetChallengeId(), userID)
+	if err != nil {
+		return nil, handleChallengeErr(log, err)
+	}
+
+	if challenge.CreatorID != userID {
+		log.Warn("user is not the creator of the challenge")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	if challengeEnded(challenge) {
+		log.Warn("challenge has ended")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrChallengeEnded)
+	}
+
+	inviteeIDs, err := h.followerIDs(ctx, userID, req.Msg.GetUserIds())
+	if err != nil {
+		if errors.Is(err, ErrChallengeInviteeNotFollower) {
+			log.Warn("invitee is not a follower")
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrChallengeInviteeNotFollower)
+		}
+
+		log.Error("failed to list followers", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	invited, err := h.repo.InviteToChallenge(ctx, challenge.ID, inviteeIDs)
+	if err != nil {
+		log.Error("failed to invite to challenge", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	h.publishInvites(ctx, userID, challenge.ID, invited)
+
+	log.Info("invited to challenge")
+	return connect.NewResponse(&apiv1.InviteToChallengeResponse{}), nil
+}
+
+func (h *challengeHandler) JoinChallenge(ctx context.Context, req *connect.Request[apiv1.JoinChallengeRequest]) (*connect.Response[apiv1.JoinChallengeResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldChallengeID(req.Msg.GetChallengeId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	challenge, err := h.getChallenge(ctx, req.Msg.GetChallengeId(), userID)
+	if err != nil {
+		return nil, handleChallengeErr(log, err)
+	}
+
+	if challengeEnded(challenge) {
+		log.Warn("challenge has ended")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrChallengeEnded)
+	}
+
+	if err = h.repo.JoinChallenge(ctx, challenge.ID, userID); err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			log.Error("failed to join challenge", zap.Error(err))
+			return nil, connect.NewError(connect.CodeInternal, nil)
+		}
+
+		// The user has already joined the challenge.
+	}
+
+	log.Info("challenge joined")
+	return connect.NewResponse(&apiv1.JoinChallengeResponse{}), nil
+}
+
+func (h *challengeHandler) LeaveChallenge(ctx context.Context, req *connect.Request[apiv1.LeaveChallengeRequest]) (*connect.Response[apiv1.LeaveChallengeResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldChallengeID(req.Msg.GetChallengeId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	challenge, err := h.getChallenge(ctx, req.Msg.GetChallengeId(), userID)
+	if err != nil {
+		return nil, handleChallengeErr(log, err)
+	}
+
+	if challenge.CreatorID == userID {
+		log.Warn("creator cannot leave their own challenge")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrChallengeCreatorCannotLeave)
+	}
+
+	if err = h.repo.LeaveChallenge(ctx, challenge.ID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("user is not a participant")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to leave challenge", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("challenge left")
+	return connect.NewResponse(&apiv1.LeaveChallengeResponse{}), nil
+}
+
+func (h *challengeHandler) ListChallenges(ctx context.Context, req *connect.Request[apiv1.ListChallengesRequest]) (*connect.Response[apiv1.ListChallengesResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	limit := int(req.Msg.GetPagination().GetPageLimit())
+	challenges, err := h.repo.ListChallenges(ctx,
+		repo.ListChallengesWithLimit(limit+1),
+		repo.ListChallengesWithParticipant(userID),
+		repo.ListChallengesWithPageToken(req.Msg.GetPagination().GetPageToken()),
+		repo.ListChallengesLoadCreator(),
+		repo.ListChallengesLoadParticipant(userID),
+	)
+	if err != nil {
+		log.Error("failed to list challenges", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	paginated, err := repo.PaginateSlice(challenges, limit, func(c *orm.Challenge) time.Time {
+		return c.CreatedAt
+	})
+	if err != nil {
+		log.Error("failed to paginate challenges", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("challenges listed")
+	return connect.NewResponse(&apiv1.ListChallengesResponse{
+		Challenges: parser.ChallengeSlice(paginated.Items),
+		Pagination: &apiv1.PaginationResponse{
+			NextPageToken: paginated.NextPageToken,
+		},
+	}), nil
+}
+
+func (h *challengeHandler) GetChallengeStandings(ctx context.Context, req *connect.Request[apiv1.GetChallengeStandingsRequest]) (*connect.Response[apiv1.GetChallengeStandingsResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldChallengeID(req.Msg.GetChallengeId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	challenge, err := h.getChallenge(ctx, req.Msg.GetChallengeId(), userID)
+	if err != nil {
+		return nil, handleChallengeErr(log, err)
+	}
+
+	standings, err := h.repo.GetChallengeStandings(ctx, challenge.ID)
+	if err != nil {
+		log.Error("failed to get challenge standings", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	userIDs := make([]string, 0, len(standings))
+	for _, standing := range standings {
+		userIDs = append(userIDs, standing.UserID)
+	}
+
+	users, err := h.repo.ListUsers(ctx, repo.ListUsersWithIDs(userIDs))
+	if err != nil {
+		log.Error("failed to list users", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("challenge standings fetched")
+	return connect.NewResponse(&apiv1.GetChallengeStandingsResponse{
+		Challenge: parser.Challenge(challenge),
+		Standings: parser.ChallengeStandingSlice(standings, users, challenge.Goal),
+	}), nil
+}
+
+// getChallenge returns the challenge if the user has joined or been invited
+// to it.
+func (h *challengeHandler) getChallenge(ctx context.Context, challengeID, userID string) (*orm.Challenge, error) {
+	challenge, err := h.repo.GetChallenge(ctx,
+		repo.GetChallengeWithID(challengeID),
+		repo.GetChallengeWithParticipant(userID),
+		repo.GetChallengeLoadCreator(),
+		repo.GetChallengeLoadParticipant(userID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("challenge fetch: %w", err)
+	}
+
+	return challenge, nil
+}
+
+func handleChallengeErr(log *zap.Logger, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Warn("challenge not found")
+		return connect.NewError(connect.CodeNotFound, nil)
+	}
+
+	log.Error("failed to get challenge", zap.Error(err))
+	return connect.NewError(connect.CodeInternal, nil)
+}
+
+// followerIDs returns the user ids without the user's own id, and
+// ErrChallengeInviteeNotFollower if any of them does not follow the user.
+func (h *challengeHandler) followerIDs(ctx context.Context, userID string, userIDs []string) ([]string, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	followers, err := h.repo.ListFollowers(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("followers fetch: %w", err)
+	}
+
+	followerIDs := make([]string, 0, len(followers))
+	for _, follower := range followers {
+		followerIDs = append(followerIDs, follower.ID)
+	}
+
+	ids := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id == userID || slices.Contains(ids, id) {
+			continue
+		}
+
+		if !slices.Contains(followerIDs, id) {
+			return nil, ErrChallengeInviteeNotFollower
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func (h *challengeHandler) publishInvites(ctx context.Context, actorID, challengeID string, userIDs []string) {
+	if len(userIDs) == 0 {
+		return
+	}
+
+	h.pubSub.Publish(ctx, orm.EventTopicChallengeInvited, payloads.ChallengeInvited{
+		ActorID:     actorID,
+		ChallengeID: challengeID,
+		UserIDs:     userIDs,
+	})
+}
+
+func challengeEnded(challenge *orm.Challenge) bool {
+	return challenge.EndedAt.Valid || !challenge.EndsAt.After(time.Now().UTC())
+}
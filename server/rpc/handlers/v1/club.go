@@ -0,0 +1,460 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/gen/orm"
+	apiv1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+	"github.com/crlssn/getstronger/server/gen/proto/api/v1/apiv1connect"
+	"github.com/crlssn/getstronger/server/repo"
+	"github.com/crlssn/getstronger/server/rpc/parser"
+	"github.com/crlssn/getstronger/server/xcontext"
+	"github.com/crlssn/getstronger/server/xzap"
+)
+
+var _ apiv1connect.ClubServiceHandler = (*clubHandler)(nil)
+
+type clubHandler struct {
+	repo repo.Repo
+}
+
+func NewClubHandler(r repo.Repo) apiv1connect.ClubServiceHandler {
+	return &clubHandler{r}
+}
+
+var (
+	ErrClubOwnerCannotLeave   = errors.New("owner must transfer ownership before leaving")
+	ErrClubMemberNotRequested = errors.New("user has not requested to join")
+	ErrClubMemberNotActive    = errors.New("user is not an active member")
+	ErrClubOwnRole            = errors.New("cannot change own role")
+)
+
+func (h *clubHandler) CreateClub(ctx context.Context, req *connect.Request[apiv1.CreateClubRequest]) (*connect.Response[apiv1.CreateClubResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	created, err := h.repo.CreateClub(ctx, repo.CreateClubParams{
+		OwnerID:     userID,
+		Name:        req.Msg.GetName(),
+		Description: req.Msg.GetDescription(),
+	})
+	if err != nil {
+		log.Error("failed to create club", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log = log.With(xzap.FieldClubID(created.ID))
+	club, err := h.repo.GetClub(ctx,
+		repo.GetClubWithID(created.ID),
+		repo.GetClubLoadMember(userID),
+	)
+	if err != nil {
+		log.Error("failed to get club", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("club created")
+	return connect.NewResponse(&apiv1.CreateClubResponse{
+		Club: parser.Club(club),
+	}), nil
+}
+
+func (h *clubHandler) GetClub(ctx context.Context, req *connect.Request[apiv1.GetClubRequest]) (*connect.Response[apiv1.GetClubResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldClubID(req.Msg.GetId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	club, err := h.repo.GetClub(ctx,
+		repo.GetClubWithID(req.Msg.GetId()),
+		repo.GetClubLoadMember(userID),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("club not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get club", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("club fetched")
+	return connect.NewResponse(&apiv1.GetClubResponse{
+		Club: parser.Club(club),
+	}), nil
+}
+
+func (h *clubHandler) ListClubs(ctx context.Context, req *connect.Request[apiv1.ListClubsRequest]) (*connect.Response[apiv1.ListClubsResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	limit := int(req.Msg.GetPagination().GetPageLimit())
+	clubs, err := h.repo.ListClubs(ctx,
+		repo.ListClubsWithLimit(limit+1),
+		repo.ListClubsWithMember(userID),
+		repo.ListClubsWithPageToken(req.Msg.GetPagination().GetPageToken()),
+		repo.ListClubsLoadMember(userID),
+	)
+	if err != nil {
+		log.Error("failed to list clubs", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	paginated, err := repo.PaginateSlice(clubs, limit, func(c *orm.Club) time.Time {
+		return c.CreatedAt
+	})
+	if err != nil {
+		log.Error("failed to paginate clubs", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("clubs listed")
+	return connect.NewResponse(&apiv1.ListClubsResponse{
+		Clubs: parser.ClubSlice(paginated.Items),
+		Pagination: &apiv1.PaginationResponse{
+			NextPageToken: paginated.NextPageToken,
+		},
+	}), nil
+}
+
+func (h *clubHandler) JoinClub(ctx context.Context, req *connect.Request[apiv1.JoinClubRequest]) (*connect.Response[apiv1.JoinClubResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldClubID(req.Msg.GetClubId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if _, err := h.repo.GetClub(ctx, repo.GetClubWithID(req.Msg.GetClubId())); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("club not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get club", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	status := orm.ClubMemberStatusRequested
+	member, err := h.repo.GetClubMember(ctx, req.Msg.GetClubId(), userID)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		if err = h.repo.CreateClubMember(ctx, repo.CreateClubMemberParams{
+			ClubID: req.Msg.GetClubId(),
+			UserID: userID,
+			Status: status,
+		}); err != nil {
+			log.Error("failed to create club member", zap.Error(err))
+			return nil, connect.NewError(connect.CodeInternal, nil)
+		}
+	case err != nil:
+		log.Error("failed to get club member", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	case member.Status == orm.ClubMemberStatusInvited:
+		status = orm.ClubMemberStatusActive
+		if err = h.repo.UpdateClubMember(ctx, member.ClubID, userID, repo.UpdateClubMemberStatus(status)); err != nil {
+			log.Error("failed to update club member", zap.Error(err))
+			return nil, connect.NewError(connect.CodeInternal, nil)
+		}
+	default:
+		// The user has already requested to join or is a member.
+		status = member.Status
+	}
+
+	log.Info("club joined", zap.String("status", string(status)))
+	return connect.NewResponse(&apiv1.JoinClubResponse{
+		Status: parser.ClubMemberStatus(status),
+	}), nil
+}
+
+func (h *clubHandler) LeaveClub(ctx context.Context, req *connect.Request[apiv1.LeaveClubRequest]) (*connect.Response[apiv1.LeaveClubResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldClubID(req.Msg.GetClubId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	member, err := h.repo.GetClubMember(ctx, req.Msg.GetClubId(), userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("club member not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get club member", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if member.Role == orm.ClubRoleOwner {
+		log.Warn("owner cannot leave the club")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrClubOwnerCannotLeave)
+	}
+
+	if err = h.repo.DeleteClubMember(ctx, member.ClubID, userID); err != nil {
+		log.Error("failed to delete club member", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("club left")
+	return connect.NewResponse(&apiv1.LeaveClubResponse{}), nil
+}
+
+func (h *clubHandler) InviteClubMember(ctx context.Context, req *connect.Request[apiv1.InviteClubMemberRequest]) (*connect.Response[apiv1.InviteClubMemberResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldClubID(req.Msg.GetClubId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if _, err := h.manager(ctx, req.Msg.GetClubId(), userID); err != nil {
+		return nil, handleClubManagerErr(log, err)
+	}
+
+	if _, err := h.repo.GetUser(ctx, repo.GetUserWithID(req.Msg.GetUserId())); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("user not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get user", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	blocked, err := h.repo.IsBlocked(ctx, userID, req.Msg.GetUserId())
+	if err != nil {
+		log.Error("failed to check block", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if blocked {
+		log.Warn("user is blocked")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	member, err := h.repo.GetClubMember(ctx, req.Msg.GetClubId(), req.Msg.GetUserId())
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		if err = h.repo.CreateClubMember(ctx, repo.CreateClubMemberParams{
+			ClubID: req.Msg.GetClubId(),
+			UserID: req.Msg.GetUserId(),
+			Status: orm.ClubMemberStatusInvited,
+		}); err != nil {
+			log.Error("failed to create club member", zap.Error(err))
+			return nil, connect.NewError(connect.CodeInternal, nil)
+		}
+	case err != nil:
+		log.Error("failed to get club member", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	case member.Status == orm.ClubMemberStatusRequested:
+		if err = h.repo.UpdateClubMember(ctx, member.ClubID, member.UserID, repo.UpdateClubMemberStatus(orm.ClubMemberStatusActive)); err != nil {
+			log.Error("failed to update club member", zap.Error(err))
+			return nil, connect.NewError(connect.CodeInternal, nil)
+		}
+	}
+
+	log.Info("club member invited")
+	return connect.NewResponse(&apiv1.InviteClubMemberResponse{}), nil
+}
+
+func (h *clubHandler) ApproveClubMember(ctx context.Context, req *connect.Request[apiv1.ApproveClubMemberRequest]) (*connect.Response[apiv1.ApproveClubMemberResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldClubID(req.Msg.GetClubId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if _, err := h.manager(ctx, req.Msg.GetClubId(), userID); err != nil {
+		return nil, handleClubManagerErr(log, err)
+	}
+
+	member, err := h.repo.GetClubMember(ctx, req.Msg.GetClubId(), req.Msg.GetUserId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("club member not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get club member", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if member.Status != orm.ClubMemberStatusRequested {
+		log.Warn("user has not requested to join")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrClubMemberNotRequested)
+	}
+
+	if err = h.repo.UpdateClubMember(ctx, member.ClubID, member.UserID, repo.UpdateClubMemberStatus(orm.ClubMemberStatusActive)); err != nil {
+		log.Error("failed to update club member", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("club member approved")
+	return connect.NewResponse(&apiv1.ApproveClubMemberResponse{}), nil
+}
+
+func (h *clubHandler) RemoveClubMember(ctx context.Context, req *connect.Request[apiv1.RemoveClubMemberRequest]) (*connect.Response[apiv1.RemoveClubMemberResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldClubID(req.Msg.GetClubId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	manager, err := h.manager(ctx, req.Msg.GetClubId(), userID)
+	if err != nil {
+		return nil, handleClubManagerErr(log, err)
+	}
+
+	member, err := h.repo.GetClubMember(ctx, req.Msg.GetClubId(), req.Msg.GetUserId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("club member not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get club member", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if member.Role == orm.ClubRoleOwner || (member.Role == orm.ClubRoleAdmin && manager.Role != orm.ClubRoleOwner) {
+		log.Warn("user is not allowed to remove the member")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	if err = h.repo.DeleteClubMember(ctx, member.ClubID, member.UserID); err != nil {
+		log.Error("failed to delete club member", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("club member removed")
+	return connect.NewResponse(&apiv1.RemoveClubMemberResponse{}), nil
+}
+
+func (h *clubHandler) SetClubMemberRole(ctx context.Context, req *connect.Request[apiv1.SetClubMemberRoleRequest]) (*connect.Response[apiv1.SetClubMemberRoleResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldClubID(req.Msg.GetClubId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	manager, err := h.manager(ctx, req.Msg.GetClubId(), userID)
+	if err != nil {
+		return nil, handleClubManagerErr(log, err)
+	}
+
+	if manager.Role != orm.ClubRoleOwner {
+		log.Warn("user is not the owner of the club")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	if req.Msg.GetUserId() == userID {
+		log.Warn("owner cannot change their own role")
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrClubOwnRole)
+	}
+
+	member, err := h.repo.GetClubMember(ctx, req.Msg.GetClubId(), req.Msg.GetUserId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("club member not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get club member", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if member.Status != orm.ClubMemberStatusActive {
+		log.Warn("user is not an active member")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrClubMemberNotActive)
+	}
+
+	role := parser.ClubRoleFromPB(req.Msg.GetRole())
+	if role == orm.ClubRoleOwner {
+		err = h.repo.TransferClubOwnership(ctx, repo.TransferClubOwnershipParams{
+			ClubID:     member.ClubID,
+			OwnerID:    userID,
+			NewOwnerID: member.UserID,
+		})
+	} else {
+		err = h.repo.UpdateClubMember(ctx, member.ClubID, member.UserID, repo.UpdateClubMemberRole(role))
+	}
+	if err != nil {
+		log.Error("failed to set club member role", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("club member role set", zap.String("role", string(role)))
+	return connect.NewResponse(&apiv1.SetClubMemberRoleResponse{}), nil
+}
+
+func (h *clubHandler) ListClubMembers(ctx context.Context, req *connect.Request[apiv1.ListClubMembersRequest]) (*connect.Response[apiv1.ListClubMembersResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldClubID(req.Msg.GetClubId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	viewer, err := h.repo.GetClubMember(ctx, req.Msg.GetClubId(), userID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Error("failed to get club member", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if viewer == nil || viewer.Status != orm.ClubMemberStatusActive {
+		log.Warn("user is not a member of the club")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	status := orm.ClubMemberStatusActive
+	if req.Msg.GetStatus() != apiv1.ClubMemberStatus_CLUB_MEMBER_STATUS_UNSPECIFIED {
+		status = parser.ClubMemberStatusFromPB(req.Msg.GetStatus())
+	}
+
+	if status != orm.ClubMemberStatusActive && viewer.Role == orm.ClubRoleMember {
+		log.Warn("user is not allowed to list pending members")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	limit := int(req.Msg.GetPagination().GetPageLimit())
+	members, err := h.repo.ListClubMembers(ctx,
+		repo.ListClubMembersWithClubID(req.Msg.GetClubId()),
+		repo.ListClubMembersWithStatus(status),
+		repo.ListClubMembersWithLimit(limit+1),
+		repo.ListClubMembersWithPageToken(req.Msg.GetPagination().GetPageToken()),
+		repo.ListClubMembersLoadUser(),
+	)
+	if err != nil {
+		log.Error("failed to list club members", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	paginated, err := repo.PaginateSlice(members, limit, func(m *orm.ClubMember) time.Time {
+		return m.CreatedAt
+	})
+	if err != nil {
+		log.Error("failed to paginate club members", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("club members listed")
+	return connect.NewResponse(&apiv1.ListClubMembersResponse{
+		Members: parser.ClubMemberSlice(paginated.Items),
+		Pagination: &apiv1.PaginationResponse{
+			NextPageToken: paginated.NextPageToken,
+		},
+	}), nil
+}
+
+var errNotClubManager = errors.New("user is not a club owner or admin")
+
+// manager returns the membership of the user if they are an active owner or
+// admin of the club, and errNotClubManager otherwise.
+func (h *clubHandler) manager(ctx context.Context, clubID, userID string) (*orm.ClubMember, error) {
+	member, err := h.repo.GetClubMember(ctx, clubID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errNotClubManager
+		}
+
+		return nil, fmt.Errorf("club member fetch: %w", err)
+	}
+
+	if member.Status != orm.ClubMemberStatusActive || member.Role == orm.ClubRoleMember {
+		return nil, errNotClubManager
+	}
+
+	return member, nil
+}
+
+func handleClubManagerErr(log *zap.Logger, err error) error {
+	if errors.Is(err, errNotClubManager) {
+		log.Warn("user is not a club owner or admin")
+		return connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	log.Error("failed to get club member", zap.Error(err))
+	return connect.NewError(connect.CodeInternal, nil)
+}
@@ -0,0 +1,456 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/gen/orm"
+	apiv1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+	"github.com/crlssn/getstronger/server/gen/proto/api/v1/apiv1connect"
+	"github.com/crlssn/getstronger/server/repo"
+	"github.com/crlssn/getstronger/server/rpc/parser"
+	"github.com/crlssn/getstronger/server/xcontext"
+	"github.com/crlssn/getstronger/server/xzap"
+)
+
+var _ apiv1connect.CoachServiceHandler = (*coachHandler)(nil)
+
+type coachHandler struct {
+	repo repo.Repo
+}
+
+func NewCoachHandler(r repo.Repo) apiv1connect.CoachServiceHandler {
+	return &coachHandler{r}
+}
+
+var (
+	ErrCoachSelf      = errors.New("cannot coach yourself")
+	errNotCoach       = errors.New("user is not a coach of the athlete")
+	errExercisesOwner = errors.New("exercises do not belong to the athlete")
+)
+
+func (h *coachHandler) GrantCoachAccess(ctx context.Context, req *connect.Request[apiv1.GrantCoachAccessRequest]) (*connect.Response[apiv1.GrantCoachAccessResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).
+		With(zap.String("coach_id", req.Msg.GetCoachId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if req.Msg.GetCoachId() == userID {
+		log.Warn("user cannot coach themselves")
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrCoachSelf)
+	}
+
+	if _, err := h.repo.GetUser(ctx, repo.GetUserWithID(req.Msg.GetCoachId())); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("coach not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get coach", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if err := h.repo.GrantCoachAccess(ctx, repo.CoachingParams{
+		CoachID:   req.Msg.GetCoachId(),
+		AthleteID: userID,
+	}); err != nil {
+		if errors.Is(err, repo.ErrUserBlocked) {
+			log.Warn("user is blocked")
+			return nil, connect.NewError(connect.CodePermissionDenied, nil)
+		}
+
+		log.Error("failed to grant coach access", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("coach access granted")
+	return connect.NewResponse(&apiv1.GrantCoachAccessResponse{}), nil
+}
+
+func (h *coachHandler) RevokeCoachAccess(ctx context.Context, req *connect.Request[apiv1.RevokeCoachAccessRequest]) (*connect.Response[apiv1.RevokeCoachAccessResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).
+		With(zap.String("coach_id", req.Msg.GetCoachId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if err := h.repo.RevokeCoachAccess(ctx, repo.CoachingParams{
+		CoachID:   req.Msg.GetCoachId(),
+		AthleteID: userID,
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("coach not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to revoke coach access", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("coach access revoked")
+	return connect.NewResponse(&apiv1.RevokeCoachAccessResponse{}), nil
+}
+
+func (h *coachHandler) ListCoaches(ctx context.Context, _ *connect.Request[apiv1.ListCoachesRequest]) (*connect.Response[apiv1.ListCoachesResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	coaches, err := h.repo.ListCoaches(ctx, userID)
+	if err != nil {
+		log.Error("failed to list coaches", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("coaches listed")
+	return connect.NewResponse(&apiv1.ListCoachesResponse{
+		Coaches: parser.UserSlice(coaches),
+	}), nil
+}
+
+func (h *coachHandler) ListCoachActions(ctx context.Context, req *connect.Request[apiv1.ListCoachActionsRequest]) (*connect.Response[apiv1.ListCoachActionsResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	limit := int(req.Msg.GetPagination().GetPageLimit())
+	actions, err := h.repo.ListCoachActions(ctx,
+		repo.ListCoachActionsWithAthleteID(userID),
+		repo.ListCoachActionsWithLimit(limit+1),
+		repo.ListCoachActionsWithPageToken(req.Msg.GetPagination().GetPageToken()),
+		repo.ListCoachActionsLoadCoach(),
+	)
+	if err != nil {
+		log.Error("failed to list coach actions", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	pagination, err := repo.PaginateSlice(actions, limit, func(action *orm.CoachAction) time.Time {
+		return action.CreatedAt
+	})
+	if err != nil {
+		log.Error("failed to paginate coach actions", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("coach actions listed")
+	return connect.NewResponse(&apiv1.ListCoachActionsResponse{
+		Actions: parser.CoachActionSlice(pagination.Items),
+		Pagination: &apiv1.PaginationResponse{
+			NextPageToken: pagination.NextPageToken,
+		},
+	}), nil
+}
+
+func (h *coachHandler) GetCoachDashboard(ctx context.Context, _ *connect.Request[apiv1.GetCoachDashboardRequest]) (*connect.Response[apiv1.GetCoachDashboardResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	summaries, err := h.repo.ListAthleteSummaries(ctx, userID, time.Now().UTC())
+	if err != nil {
+		log.Error("failed to list athlete summaries", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	athleteIDs := make([]string, 0, len(summaries))
+	for _, summary := range summaries {
+		athleteIDs = append(athleteIDs, summary.AthleteID)
+	}
+
+	athletes, err := h.repo.ListUsers(ctx, repo.ListUsersWithIDs(athleteIDs))
+	if err != nil {
+		log.Error("failed to list athletes", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if err = h.repo.NewTx(ctx, func(tx repo.Tx) error {
+		for _, athleteID := range athleteIDs {
+			if err = tx.CreateCoachAction(ctx, repo.CreateCoachActionParams{
+				CoachID:   userID,
+				AthleteID: athleteID,
+				Action:    orm.CoachActionTypeViewDashboard,
+			}); err != nil {
+				return fmt.Errorf("failed to create coach action: %w", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		log.Error("failed to record coach actions", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("coach dashboard returned")
+	return connect.NewResponse(&apiv1.GetCoachDashboardResponse{
+		Athletes: parser.AthleteSummarySlice(summaries, athletes),
+	}), nil
+}
+
+func (h *coachHandler) ListAthleteWorkouts(ctx context.Context, req *connect.Request[apiv1.ListAthleteWorkoutsRequest]) (*connect.Response[apiv1.ListAthleteWorkoutsResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).
+		With(xzap.FieldAthleteID(req.Msg.GetAthleteId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if err := h.checkCoach(ctx, userID, req.Msg.GetAthleteId()); err != nil {
+		return nil, handleCoachErr(log, err)
+	}
+
+	limit := int(req.Msg.GetPagination().GetPageLimit())
+	workouts, err := h.repo.ListWorkouts(ctx,
+		repo.ListWorkoutsLoadSets(),
+		repo.ListWorkoutsLoadUser(),
+		repo.ListWorkoutsLoadExercises(),
+		repo.ListWorkoutsLoadReactions(),
+		repo.ListWorkoutsWithLimit(limit+1),
+		repo.ListWorkoutsWithUserIDs(req.Msg.GetAthleteId()),
+		repo.ListWorkoutsWithPageToken(req.Msg.GetPagination().GetPageToken()),
+		repo.ListWorkoutsWithoutDeleted(),
+		repo.ListWorkoutsWithoutHidden(),
+	)
+	if err != nil {
+		log.Error("failed to list workouts", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	pagination, err := repo.PaginateSlice(workouts, limit, func(workout *orm.Workout) time.Time {
+		return workout.CreatedAt
+	})
+	if err != nil {
+		log.Error("failed to paginate workouts", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	personalBests, err := h.repo.GetPersonalBests(ctx, req.Msg.GetAthleteId())
+	if err != nil {
+		log.Error("failed to get personal bests", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	w, err := parser.WorkoutSlice(pagination.Items, personalBests, userID)
+	if err != nil {
+		log.Error("failed to parse workouts", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if err = h.repo.CreateCoachAction(ctx, repo.CreateCoachActionParams{
+		CoachID:   userID,
+		AthleteID: req.Msg.GetAthleteId(),
+		Action:    orm.CoachActionTypeViewWorkouts,
+	}); err != nil {
+		log.Error("failed to record coach action", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("athlete workouts listed")
+	return connect.NewResponse(&apiv1.ListAthleteWorkoutsResponse{
+		Workouts: w,
+		Pagination: &apiv1.PaginationResponse{
+			NextPageToken: pagination.NextPageToken,
+		},
+	}), nil
+}
+
+func (h *coachHandler) GetAthletePersonalBests(ctx context.Context, req *connect.Request[apiv1.GetAthletePersonalBestsRequest]) (*connect.Response[apiv1.GetAthletePersonalBestsResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).
+		With(xzap.FieldAthleteID(req.Msg.GetAthleteId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if err := h.checkCoach(ctx, userID, req.Msg.GetAthleteId()); err != nil {
+		return nil, handleCoachErr(log, err)
+	}
+
+	personalBests, err := h.repo.GetPersonalBests(ctx, req.Msg.GetAthleteId())
+	if err != nil {
+		log.Error("failed to get personal bests", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if err = h.repo.CreateCoachAction(ctx, repo.CreateCoachActionParams{
+		CoachID:   userID,
+		AthleteID: req.Msg.GetAthleteId(),
+		Action:    orm.CoachActionTypeViewPersonalBests,
+	}); err != nil {
+		log.Error("failed to record coach action", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("athlete personal bests returned")
+	return connect.NewResponse(&apiv1.GetAthletePersonalBestsResponse{
+		PersonalBests: parser.ExerciseSetSlice(personalBests),
+	}), nil
+}
+
+func (h *coachHandler) CreateAthleteRoutine(ctx context.Context, req *connect.Request[apiv1.CreateAthleteRoutineRequest]) (*connect.Response[apiv1.CreateAthleteRoutineResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).
+		With(xzap.FieldAthleteID(req.Msg.GetAthleteId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if err := h.checkCoach(ctx, userID, req.Msg.GetAthleteId()); err != nil {
+		return nil, handleCoachErr(log, err)
+	}
+
+	if _, err := h.athleteExercises(ctx, req.Msg.GetAthleteId(), req.Msg.GetExerciseIds()); err != nil {
+		return nil, handleCoachErr(log, err)
+	}
+
+	var routineID string
+	if err := h.repo.NewTx(ctx, func(tx repo.Tx) error {
+		routine, err := tx.CreateRoutine(ctx, repo.CreateRoutineParams{
+			UserID:      req.Msg.GetAthleteId(),
+			Name:        req.Msg.GetName(),
+			ExerciseIDs: req.Msg.GetExerciseIds(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create routine: %w", err)
+		}
+
+		if err = tx.CreateCoachAction(ctx, repo.CreateCoachActionParams{
+			CoachID:   userID,
+			AthleteID: req.Msg.GetAthleteId(),
+			Action:    orm.CoachActionTypeCreateRoutine,
+			TargetID:  routine.ID,
+		}); err != nil {
+			return fmt.Errorf("failed to create coach action: %w", err)
+		}
+
+		routineID = routine.ID
+		return nil
+	}); err != nil {
+		log.Error("failed to create athlete routine", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("athlete routine created")
+	return connect.NewResponse(&apiv1.CreateAthleteRoutineResponse{
+		Id: routineID,
+	}), nil
+}
+
+func (h *coachHandler) UpdateAthleteRoutine(ctx context.Context, req *connect.Request[apiv1.UpdateAthleteRoutineRequest]) (*connect.Response[apiv1.UpdateAthleteRoutineResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).
+		With(xzap.FieldAthleteID(req.Msg.GetAthleteId())).
+		With(xzap.FiledRoutineID(req.Msg.GetRoutine().GetId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if err := h.checkCoach(ctx, userID, req.Msg.GetAthleteId()); err != nil {
+		return nil, handleCoachErr(log, err)
+	}
+
+	routine, err := h.repo.GetRoutine(ctx,
+		repo.GetRoutineWithID(req.Msg.GetRoutine().GetId()),
+		repo.GetRoutineWithoutDeleted(),
+		repo.GetRoutineWithUserID(req.Msg.GetAthleteId()),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("routine not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get routine", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	exerciseIDs := make([]string, 0, len(req.Msg.GetRoutine().GetExercises()))
+	for _, exercise := range req.Msg.GetRoutine().GetExercises() {
+		exerciseIDs = append(exerciseIDs, exercise.GetId())
+	}
+
+	exercises, err := h.athleteExercises(ctx, req.Msg.GetAthleteId(), exerciseIDs)
+	if err != nil {
+		return nil, handleCoachErr(log, err)
+	}
+
+	if err = h.repo.NewTx(ctx, func(tx repo.Tx) error {
+		if err = tx.UpdateRoutine(ctx, routine.ID,
+			repo.UpdateRoutineName(req.Msg.GetRoutine().GetName()),
+			repo.UpdateRoutineExerciseOrder(exerciseIDs),
+		); err != nil {
+			return fmt.Errorf("failed to update routine: %w", err)
+		}
+
+		if err = tx.SetRoutineExercises(ctx, routine, exercises); err != nil {
+			return fmt.Errorf("failed to set routine exercises: %w", err)
+		}
+
+		if err = tx.CreateCoachAction(ctx, repo.CreateCoachActionParams{
+			CoachID:   userID,
+			AthleteID: req.Msg.GetAthleteId(),
+			Action:    orm.CoachActionTypeUpdateRoutine,
+			TargetID:  routine.ID,
+		}); err != nil {
+			return fmt.Errorf("failed to create coach action: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		log.Error("failed to update athlete routine", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	routine, err = h.repo.GetRoutine(ctx,
+		repo.GetRoutineWithID(routine.ID),
+		repo.GetRoutineWithoutDeleted(),
+		repo.GetRoutineWithExercises(),
+	)
+	if err != nil {
+		log.Error("failed to get routine", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("athlete routine updated")
+	return connect.NewResponse(&apiv1.UpdateAthleteRoutineResponse{
+		Routine: parser.Routine(routine),
+	}), nil
+}
+
+// checkCoach returns errNotCoach if the user has not been granted access to the
+// athlete's account.
+func (h *coachHandler) checkCoach(ctx context.Context, userID, athleteID string) error {
+	isCoach, err := h.repo.IsCoach(ctx, repo.CoachingParams{
+		CoachID:   userID,
+		AthleteID: athleteID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check coach: %w", err)
+	}
+
+	if !isCoach {
+		return errNotCoach
+	}
+
+	return nil
+}
+
+// athleteExercises returns errExercisesOwner unless every exercise belongs to
+// the athlete.
+func (h *coachHandler) athleteExercises(ctx context.Context, athleteID string, exerciseIDs []string) (orm.ExerciseSlice, error) {
+	exercises, err := h.repo.ListExercises(ctx,
+		repo.ListExercisesWithIDs(exerciseIDs),
+		repo.ListExercisesWithUserID(athleteID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exercises: %w", err)
+	}
+
+	if len(exercises) != len(exerciseIDs) {
+		return nil, errExercisesOwner
+	}
+
+	return exercises, nil
+}
+
+func handleCoachErr(log *zap.Logger, err error) error {
+	switch {
+	case errors.Is(err, errNotCoach):
+		log.Warn("user is not a coach of the athlete")
+		return connect.NewError(connect.CodePermissionDenied, nil)
+	case errors.Is(err, errExercisesOwner):
+		log.Warn("exercises do not belong to the athlete")
+		return connect.NewError(connect.CodeInvalidArgument, nil)
+	default:
+		log.Error("coach check failed", zap.Error(err))
+		return connect.NewError(connect.CodeInternal, nil)
+	}
+}
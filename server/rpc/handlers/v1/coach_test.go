@@ -0,0 +1,154 @@
+package v1_test
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/gen/orm"
+	v1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+	"github.com/crlssn/getstronger/server/gen/proto/api/v1/apiv1connect"
+	"github.com/crlssn/getstronger/server/repo"
+	handlers "github.com/crlssn/getstronger/server/rpc/handlers/v1"
+	"github.com/crlssn/getstronger/server/testing/container"
+	"github.com/crlssn/getstronger/server/testing/factory"
+	"github.com/crlssn/getstronger/server/xcontext"
+)
+
+type coachSuite struct {
+	suite.Suite
+
+	handler apiv1connect.CoachServiceHandler
+
+	repo      repo.Repo
+	factory   *factory.Factory
+	container *container.Container
+}
+
+func TestCoachSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(coachSuite))
+}
+
+func (s *coachSuite) SetupSuite() {
+	ctx := context.Background()
+	s.container = container.NewContainer(ctx)
+	s.factory = factory.NewFactory(s.container.DB)
+	s.repo = repo.New(s.container.DB)
+	s.handler = handlers.NewCoachHandler(s.repo)
+
+	s.T().Cleanup(func() {
+		if err := s.container.Terminate(ctx); err != nil {
+			log.Fatalf("failed to clean container: %s", err)
+		}
+	})
+}
+
+// coachAction performs an action as the coach on the athlete's account. The
+// routine and exercise belong to the athlete.
+type coachAction func(ctx context.Context, athleteID string, routine *orm.Routine, exercise *orm.Exercise) error
+
+func (s *coachSuite) coachActions() map[orm.CoachActionType]coachAction {
+	return map[orm.CoachActionType]coachAction{
+		orm.CoachActionTypeViewDashboard: func(ctx context.Context, _ string, _ *orm.Routine, _ *orm.Exercise) error {
+			_, err := s.handler.GetCoachDashboard(ctx, connect.NewRequest(&v1.GetCoachDashboardRequest{}))
+			return err
+		},
+		orm.CoachActionTypeViewWorkouts: func(ctx context.Context, athleteID string, _ *orm.Routine, _ *orm.Exercise) error {
+			_, err := s.handler.ListAthleteWorkouts(ctx, connect.NewRequest(&v1.ListAthleteWorkoutsRequest{
+				AthleteId:  athleteID,
+				Pagination: &v1.PaginationRequest{PageLimit: 10},
+			}))
+			return err
+		},
+		orm.CoachActionTypeViewPersonalBests: func(ctx context.Context, athleteID string, _ *orm.Routine, _ *orm.Exercise) error {
+			_, err := s.handler.GetAthletePersonalBests(ctx, connect.NewRequest(&v1.GetAthletePersonalBestsRequest{
+				AthleteId: athleteID,
+			}))
+			return err
+		},
+		orm.CoachActionTypeCreateRoutine: func(ctx context.Context, athleteID string, _ *orm.Routine, exercise *orm.Exercise) error {
+			_, err := s.handler.CreateAthleteRoutine(ctx, connect.NewRequest(&v1.CreateAthleteRoutineRequest{
+				AthleteId:   athleteID,
+				Name:        "Routine",
+				ExerciseIds: []string{exercise.ID},
+			}))
+			return err
+		},
+		orm.CoachActionTypeUpdateRoutine: func(ctx context.Context, athleteID string, routine *orm.Routine, exercise *orm.Exercise) error {
+			_, err := s.handler.UpdateAthleteRoutine(ctx, connect.NewRequest(&v1.UpdateAthleteRoutineRequest{
+				AthleteId: athleteID,
+				Routine: &v1.Routine{
+					Id:        routine.ID,
+					Name:      "Updated",
+					Exercises: []*v1.Exercise{{Id: exercise.ID}},
+				},
+			}))
+			return err
+		},
+	}
+}
+
+func (s *coachSuite) TestCoachActions() {
+	for action, perform := range s.coachActions() {
+		s.Run("ok_"+action.String()+"_recorded", func() {
+			coach := s.factory.NewUser()
+			athlete := s.factory.NewUser()
+			routine := s.factory.NewRoutine(factory.RoutineUserID(athlete.ID))
+			exercise := s.factory.NewExercise(factory.ExerciseUserID(athlete.ID))
+			s.Require().NoError(s.repo.GrantCoachAccess(context.Background(), repo.CoachingParams{
+				CoachID:   coach.ID,
+				AthleteID: athlete.ID,
+			}))
+
+			ctx := xcontext.WithLogger(context.Background(), zap.NewExample())
+			ctx = xcontext.WithUserID(ctx, coach.ID)
+			s.Require().NoError(perform(ctx, athlete.ID, routine, exercise))
+
+			actions, err := orm.CoachActions(
+				orm.CoachActionWhere.CoachID.EQ(coach.ID),
+				orm.CoachActionWhere.AthleteID.EQ(athlete.ID),
+			).All(context.Background(), s.container.DB)
+			s.Require().NoError(err)
+			s.Require().Len(actions, 1)
+			s.Require().Equal(action, actions[0].Action)
+
+			switch action { //nolint:exhaustive
+			case orm.CoachActionTypeCreateRoutine:
+				s.Require().True(actions[0].TargetID.Valid)
+			case orm.CoachActionTypeUpdateRoutine:
+				s.Require().Equal(routine.ID, actions[0].TargetID.String)
+			}
+		})
+	}
+}
+
+func (s *coachSuite) TestCoachActions_NotCoach() {
+	for action, perform := range s.coachActions() {
+		if action == orm.CoachActionTypeViewDashboard {
+			// The dashboard only lists the athletes the user coaches.
+			continue
+		}
+
+		s.Run("err_"+action.String()+"_not_coach", func() {
+			user := s.factory.NewUser()
+			athlete := s.factory.NewUser()
+			routine := s.factory.NewRoutine(factory.RoutineUserID(athlete.ID))
+			exercise := s.factory.NewExercise(factory.ExerciseUserID(athlete.ID))
+
+			ctx := xcontext.WithLogger(context.Background(), zap.NewExample())
+			ctx = xcontext.WithUserID(ctx, user.ID)
+			err := perform(ctx, athlete.ID, routine, exercise)
+			s.Require().Error(err)
+			s.Require().Equal(connect.CodePermissionDenied, connect.CodeOf(err))
+
+			count, err := orm.CoachActions(orm.CoachActionWhere.AthleteID.EQ(athlete.ID)).Count(context.Background(), s.container.DB)
+			s.Require().NoError(err)
+			s.Require().Zero(count)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"time"
 
 	"connectrpc.com/connect"
@@ -33,9 +34,10 @@ func (h *exerciseHandler) CreateExercise(ctx context.Context, req *connect.Reque
 	userID := xcontext.MustExtractUserID(ctx)
 
 	exercise, err := h.repo.CreateExercise(ctx, repo.CreateExerciseParams{
-		UserID: userID,
-		Name:   req.Msg.GetName(),
-		Label:  req.Msg.GetLabel(),
+		UserID:          userID,
+		Name:            req.Msg.GetName(),
+		Label:           req.Msg.GetLabel(),
+		CatalogExercise: parser.CatalogExerciseFromPB(req.Msg.GetCatalogExercise()),
 	})
 	if err != nil {
 		log.Error("create exercise failed", zap.Error(err))
@@ -94,6 +96,8 @@ func (h *exerciseHandler) UpdateExercise(ctx context.Context, req *connect.Reque
 			opts = append(opts, repo.UpdateExerciseTitle(req.Msg.GetExercise().GetName()))
 		case "label":
 			opts = append(opts, repo.UpdateExerciseSubTitle(req.Msg.GetExercise().GetLabel()))
+		case "catalog_exercise":
+			opts = append(opts, repo.UpdateExerciseCatalogExercise(parser.CatalogExerciseFromPB(req.Msg.GetExercise().GetCatalogExercise())))
 		default:
 			log.Error("invalid update mask path", zap.String("path", path))
 			return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidUpdateMaskPath)
@@ -217,8 +221,25 @@ func (h *exerciseHandler) GetPreviousWorkoutSets(ctx context.Context, req *conne
 
 func (h *exerciseHandler) GetPersonalBests(ctx context.Context, req *connect.Request[apiv1.GetPersonalBestsRequest]) (*connect.Response[apiv1.GetPersonalBestsResponse], error) {
 	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
 
-	personalBests, err := h.repo.GetPersonalBests(ctx, req.Msg.GetUserId())
+	visible, err := h.repo.CanViewUser(ctx, userID, req.Msg.GetUserId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("user not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to check user visibility", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if !visible {
+		log.Warn("user is not visible to viewer")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	personalBests, err := h.repo.GetVisiblePersonalBests(ctx, userID, req.Msg.GetUserId())
 	if err != nil {
 		log.Error("list personal bests failed", zap.Error(err))
 		return nil, connect.NewError(connect.CodeInternal, nil)
@@ -231,12 +252,15 @@ func (h *exerciseHandler) GetPersonalBests(ctx context.Context, req *connect.Req
 
 func (h *exerciseHandler) ListSets(ctx context.Context, req *connect.Request[apiv1.ListSetsRequest]) (*connect.Response[apiv1.ListSetsResponse], error) {
 	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
 
 	limit := int(req.Msg.GetPagination().GetPageLimit())
 	opts := []repo.ListSetsOpt{
 		repo.ListSetsWithLimit(limit + 1),
 		repo.ListSetsWithPageToken(req.Msg.GetPagination().GetPageToken()),
 		repo.ListSetsOrderByCreatedAt(repo.DESC),
+		repo.ListSetsWithoutDeletedWorkouts(),
+		repo.ListSetsVisibleTo(userID),
 	}
 
 	if req.Msg.GetExerciseIds() != nil {
@@ -280,3 +304,221 @@ func (h *exerciseHandler) ListSets(ctx context.Context, req *connect.Request[api
 		},
 	}), nil
 }
+
+var ErrInvalidDateRange = errors.New("from must be before to")
+
+func (h *exerciseHandler) GetExerciseProgress(ctx context.Context, req *connect.Request[apiv1.GetExerciseProgressRequest]) (*connect.Response[apiv1.GetExerciseProgressResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldExerciseID(req.Msg.GetExerciseId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	from := req.Msg.GetFrom().AsTime()
+	to := req.Msg.GetTo().AsTime()
+	if !from.Before(to) {
+		log.Warn("invalid date range")
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidDateRange)
+	}
+
+	visible, err := h.repo.CanViewUser(ctx, userID, req.Msg.GetUserId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("user not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to check user visibility", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if !visible {
+		log.Warn("user is not visible to viewer")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	progress, err := h.repo.GetExerciseProgress(ctx, repo.GetExerciseProgressParams{
+		ViewerID:   userID,
+		UserID:     req.Msg.GetUserId(),
+		ExerciseID: req.Msg.GetExerciseId(),
+		Interval:   parser.ProgressIntervalFromPB(req.Msg.GetInterval()),
+		From:       from,
+		To:         to,
+	})
+	if err != nil {
+		log.Error("get exercise progress failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	return connect.NewResponse(&apiv1.GetExerciseProgressResponse{
+		Points: parser.ProgressPointSlice(progress),
+	}), nil
+}
+
+func (h *exerciseHandler) MergeExercises(ctx context.Context, req *connect.Request[apiv1.MergeExercisesRequest]) (*connect.Response[apiv1.MergeExercisesResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).
+		With(xzap.FieldExerciseID(req.Msg.GetTargetExerciseId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	var personalBests orm.SetSlice
+	if err := h.repo.NewTx(ctx, func(tx repo.Tx) error {
+		if err := tx.MergeExercises(ctx, repo.MergeExercisesParams{
+			UserID:            userID,
+			TargetExerciseID:  req.Msg.GetTargetExerciseId(),
+			SourceExerciseIDs: req.Msg.GetSourceExerciseIds(),
+		}); err != nil {
+			return fmt.Errorf("merge exercises: %w", err)
+		}
+
+		var err error
+		if personalBests, err = tx.GetPersonalBests(ctx, userID); err != nil {
+			return fmt.Errorf("get personal bests: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, repo.ErrMergeExerciseIntoItself) {
+			log.Warn("exercise merged into itself")
+			return nil, connect.NewError(connect.CodeInvalidArgument, repo.ErrMergeExerciseIntoItself)
+		}
+
+		if errors.Is(err, repo.ErrMergeExerciseNotFound) {
+			log.Warn("merge exercise not found", zap.Strings("source_exercise_ids", req.Msg.GetSourceExerciseIds()))
+			return nil, connect.NewError(connect.CodeFailedPrecondition, repo.ErrMergeExerciseNotFound)
+		}
+
+		log.Error("merge exercises failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	exercise, err := h.repo.GetExercise(ctx,
+		repo.GetExerciseWithID(req.Msg.GetTargetExerciseId()),
+		repo.GetExerciseWithUserID(userID),
+	)
+	if err != nil {
+		log.Error("get exercise failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	var personalBest *apiv1.ExerciseSet
+	for _, set := range personalBests {
+		if set.ExerciseID == exercise.ID {
+			personalBest = parser.ExerciseSetSlice(orm.SetSlice{set})[0]
+			break
+		}
+	}
+
+	log.Info("exercises merged", zap.Strings("source_exercise_ids", req.Msg.GetSourceExerciseIds()))
+	return connect.NewResponse(&apiv1.MergeExercisesResponse{
+		Exercise:     parser.Exercise(exercise),
+		PersonalBest: personalBest,
+	}), nil
+}
+
+func (h *exerciseHandler) ListDeletedExercises(ctx context.Context, req *connect.Request[apiv1.ListDeletedExercisesRequest]) (*connect.Response[apiv1.ListDeletedExercisesResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	limit := int(req.Msg.GetPagination().GetPageLimit())
+	exercises, err := h.repo.ListExercises(ctx,
+		repo.ListExercisesWithLimit(limit+1),
+		repo.ListExercisesWithUserID(userID),
+		repo.ListExercisesWithPageToken(req.Msg.GetPagination().GetPageToken()),
+		repo.ListExercisesOnlyDeleted(),
+	)
+	if err != nil {
+		log.Error("list deleted exercises failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	pagination, err := repo.PaginateSlice(exercises, limit, func(exercise *orm.Exercise) time.Time {
+		return exercise.CreatedAt
+	})
+	if err != nil {
+		log.Error("paginate exercises failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("deleted exercises listed")
+	return connect.NewResponse(&apiv1.ListDeletedExercisesResponse{
+		Exercises: parser.ExerciseSlice(pagination.Items),
+		Pagination: &apiv1.PaginationResponse{
+			NextPageToken: pagination.NextPageToken,
+		},
+	}), nil
+}
+
+func (h *exerciseHandler) RestoreExercise(ctx context.Context, req *connect.Request[apiv1.RestoreExerciseRequest]) (*connect.Response[apiv1.RestoreExerciseResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).
+		With(xzap.FieldExerciseID(req.Msg.GetId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if err := h.repo.RestoreExercise(ctx, repo.RestoreExerciseParams{
+		UserID:     userID,
+		ExerciseID: req.Msg.GetId(),
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("deleted exercise not found", zap.Error(err))
+			return nil, connect.NewError(connect.CodeFailedPrecondition, nil)
+		}
+
+		log.Error("restore exercise failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("exercise restored")
+	return connect.NewResponse(&apiv1.RestoreExerciseResponse{}), nil
+}
+
+var ErrLeaderboardMetricPerBodyweight = errors.New("session count cannot be normalized by bodyweight")
+
+func (h *exerciseHandler) GetLeaderboard(ctx context.Context, req *connect.Request[apiv1.GetLeaderboardRequest]) (*connect.Response[apiv1.GetLeaderboardResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(zap.Stringer("catalog_exercise", req.Msg.GetCatalogExercise()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	from := req.Msg.GetFrom().AsTime()
+	to := req.Msg.GetTo().AsTime()
+	if !from.Before(to) {
+		log.Warn("invalid date range")
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidDateRange)
+	}
+
+	if req.Msg.GetPerBodyweight() && req.Msg.GetMetric() == apiv1.LeaderboardMetric_LEADERBOARD_METRIC_SESSION_COUNT {
+		log.Warn("session count normalized by bodyweight")
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrLeaderboardMetricPerBodyweight)
+	}
+
+	followees, err := h.repo.ListFollowees(ctx, userID)
+	if err != nil {
+		log.Error("failed to list followees", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	userIDs := make([]string, 0, len(followees)+1)
+	userIDs = append(userIDs, userID)
+	for _, followee := range followees {
+		userIDs = append(userIDs, followee.ID)
+	}
+
+	entries, err := h.repo.GetLeaderboard(ctx, repo.GetLeaderboardParams{
+		ViewerID:        userID,
+		UserIDs:         userIDs,
+		CatalogExercise: parser.CatalogExerciseFromPB(req.Msg.GetCatalogExercise()).Val,
+		Metric:          parser.LeaderboardMetricFromPB(req.Msg.GetMetric()),
+		From:            from,
+		To:              to,
+		PerBodyweight:   req.Msg.GetPerBodyweight(),
+	})
+	if err != nil {
+		log.Error("failed to get leaderboard", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	users, err := h.repo.ListUsers(ctx, repo.ListUsersWithIDs(userIDs))
+	if err != nil {
+		log.Error("failed to list users", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("leaderboard fetched")
+	return connect.NewResponse(&apiv1.GetLeaderboardResponse{
+		Entries: parser.LeaderboardEntrySlice(entries, users),
+	}), nil
+}
@@ -1204,3 +1204,134 @@ func (s *exerciseSuite) TestListSets() {
 		})
 	}
 }
+
+func (s *exerciseSuite) TestGetLeaderboard() {
+	type expected struct {
+		err     error
+		userIDs []string
+	}
+
+	type test struct {
+		name     string
+		req      *connect.Request[v1.GetLeaderboardRequest]
+		init     func(t test) context.Context
+		expected expected
+	}
+
+	now := time.Now().UTC()
+	viewer := s.factory.NewUser()
+	followee := s.factory.NewUser()
+	stranger := s.factory.NewUser()
+	s.Require().NoError(repo.New(s.container.DB).Follow(context.Background(), repo.FollowParams{
+		FollowerID: viewer.ID,
+		FolloweeID: followee.ID,
+	}))
+
+	for userID, weight := range map[string]float64{viewer.ID: 100, followee.ID: 120, stranger.ID: 140} {
+		exercise := s.factory.NewExercise(
+			factory.ExerciseUserID(userID),
+			factory.ExerciseCatalogExercise(orm.CatalogExerciseSquat),
+		)
+		workout := s.factory.NewWorkout(
+			factory.WorkoutUserID(userID),
+			factory.WorkoutFinishedAt(now.Add(-time.Hour)),
+		)
+		s.factory.NewSet(
+			factory.SetUserID(userID),
+			factory.SetWorkoutID(workout.ID),
+			factory.SetExerciseID(exercise.ID),
+			factory.SetWeight(weight),
+			factory.SetReps(1),
+		)
+	}
+
+	viewerContext := func(_ test) context.Context {
+		ctx := xcontext.WithLogger(context.Background(), zap.NewExample())
+		return xcontext.WithUserID(ctx, viewer.ID)
+	}
+
+	tests := []test{
+		{
+			name: "ok_followees_ranked",
+			req: &connect.Request[v1.GetLeaderboardRequest]{
+				Msg: &v1.GetLeaderboardRequest{
+					CatalogExercise: v1.CatalogExercise_CATALOG_EXERCISE_SQUAT,
+					Metric:          v1.LeaderboardMetric_LEADERBOARD_METRIC_HEAVIEST_SINGLE,
+					From:            timestamppb.New(now.Add(-24 * time.Hour)),
+					To:              timestamppb.New(now),
+				},
+			},
+			init: viewerContext,
+			expected: expected{
+				userIDs: []string{followee.ID, viewer.ID},
+			},
+		},
+		{
+			name: "ok_other_catalog_exercise_empty",
+			req: &connect.Request[v1.GetLeaderboardRequest]{
+				Msg: &v1.GetLeaderboardRequest{
+					CatalogExercise: v1.CatalogExercise_CATALOG_EXERCISE_DEADLIFT,
+					Metric:          v1.LeaderboardMetric_LEADERBOARD_METRIC_HEAVIEST_SINGLE,
+					From:            timestamppb.New(now.Add(-24 * time.Hour)),
+					To:              timestamppb.New(now),
+				},
+			},
+			init: viewerContext,
+			expected: expected{
+				userIDs: []string{},
+			},
+		},
+		{
+			name: "err_invalid_date_range",
+			req: &connect.Request[v1.GetLeaderboardRequest]{
+				Msg: &v1.GetLeaderboardRequest{
+					CatalogExercise: v1.CatalogExercise_CATALOG_EXERCISE_SQUAT,
+					Metric:          v1.LeaderboardMetric_LEADERBOARD_METRIC_HEAVIEST_SINGLE,
+					From:            timestamppb.New(now),
+					To:              timestamppb.New(now.Add(-24 * time.Hour)),
+				},
+			},
+			init: viewerContext,
+			expected: expected{
+				err: connect.NewError(connect.CodeInvalidArgument, handlers.ErrInvalidDateRange),
+			},
+		},
+		{
+			name: "err_session_count_per_bodyweight",
+			req: &connect.Request[v1.GetLeaderboardRequest]{
+				Msg: &v1.GetLeaderboardRequest{
+					CatalogExercise: v1.CatalogExercise_CATALOG_EXERCISE_SQUAT,
+					Metric:          v1.LeaderboardMetric_LEADERBOARD_METRIC_SESSION_COUNT,
+					From:            timestamppb.New(now.Add(-24 * time.Hour)),
+					To:              timestamppb.New(now),
+					PerBodyweight:   true,
+				},
+			},
+			init: viewerContext,
+			expected: expected{
+				err: connect.NewError(connect.CodeInvalidArgument, handlers.ErrLeaderboardMetricPerBodyweight),
+			},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			ctx := t.init(t)
+
+			res, err := s.handler.GetLeaderboard(ctx, t.req)
+			if t.expected.err != nil {
+				s.Require().Nil(res)
+				s.Require().Error(err)
+				s.Require().Equal(t.expected.err.Error(), err.Error())
+				return
+			}
+
+			s.Require().NoError(err)
+			userIDs := make([]string, 0, len(res.Msg.GetEntries()))
+			for _, entry := range res.Msg.GetEntries() {
+				userIDs = append(userIDs, entry.GetUser().GetId())
+			}
+			s.Require().Equal(t.expected.userIDs, userIDs)
+		})
+	}
+}
@@ -2,7 +2,11 @@ package v1
 
 import (
 	"context"
-	"time"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
 
 	"connectrpc.com/connect"
 	"go.uber.org/zap"
@@ -29,63 +33,225 @@ func (h *feedHandler) ListFeedItems(ctx context.Context, req *connect.Request[ap
 	log := xcontext.MustExtractLogger(ctx)
 	userID := xcontext.MustExtractUserID(ctx)
 
+	var pageToken repo.FeedPageToken
+	if token := req.Msg.GetPagination().GetPageToken(); len(token) > 0 {
+		if err := json.Unmarshal(token, &pageToken); err != nil {
+			log.Warn("invalid page token", zap.Error(err))
+			return nil, connect.NewError(connect.CodeInvalidArgument, nil)
+		}
+	}
+
 	limit := int(req.Msg.GetPagination().GetPageLimit())
 	opts := []repo.ListWorkoutsOpt{
 		repo.ListWorkoutsLoadSets(),
 		repo.ListWorkoutsLoadUser(),
-		repo.ListWorkoutsLoadComments(),
+		repo.ListWorkoutsLoadCommentsVisibleTo(userID),
 		repo.ListWorkoutsLoadExercises(),
+		repo.ListWorkoutsLoadReactions(),
 		repo.ListWorkoutsWithLimit(limit + 1),
-		repo.ListWorkoutsWithPageToken(req.Msg.GetPagination().GetPageToken()),
+		repo.ListWorkoutsWithPageToken(pageToken.Workouts),
+		repo.ListWorkoutsWithoutDeleted(),
+		repo.ListWorkoutsVisibleTo(userID),
+	}
+
+	summaryOpts := []repo.ListYearSummariesOpt{
+		repo.ListYearSummariesLoadUser(),
+		repo.ListYearSummariesWithLimit(limit + 1),
+		repo.ListYearSummariesWithPageToken(pageToken.YearSummaries),
+		repo.ListYearSummariesVisibleTo(userID),
+	}
+
+	recordOpts := []repo.ListPersonalRecordsOpt{
+		repo.ListPersonalRecordsLoadSetExercise(),
+		repo.ListPersonalRecordsWithLimit(limit + 1),
+		repo.ListPersonalRecordsWithPageToken(pageToken.PersonalRecords),
+		repo.ListPersonalRecordsWithoutDeletedWorkouts(),
+		repo.ListPersonalRecordsVisibleTo(userID),
+	}
+
+	achievementOpts := []repo.ListAchievementsOpt{
+		repo.ListAchievementsWithLimit(limit + 1),
+		repo.ListAchievementsWithPageToken(pageToken.Achievements),
+		repo.ListAchievementsVisibleTo(userID),
+	}
+
+	challengeOpts := []repo.ListChallengesOpt{
+		repo.ListChallengesLoadCreator(),
+		repo.ListChallengesLoadLeader(),
+		repo.ListChallengesLoadParticipant(userID),
+		repo.ListChallengesWithLimit(limit + 1),
+		repo.ListChallengesWithEndedPageToken(pageToken.Challenges),
+		repo.ListChallengesWithJoinedParticipant(userID),
+	}
+
+	followees, err := h.repo.ListFollowees(ctx, userID)
+	if err != nil {
+		log.Error("failed to list followees", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	followeeIDs := make([]string, 0, len(followees))
+	for _, followee := range followees {
+		followeeIDs = append(followeeIDs, followee.ID)
+	}
+
+	// Follows are only of interest when the viewer follows both users.
+	followOpts := []repo.ListFollowsOpt{
+		repo.ListFollowsLoadUsers(),
+		repo.ListFollowsWithLimit(limit + 1),
+		repo.ListFollowsWithPageToken(pageToken.Follows),
+		repo.ListFollowsWithFollowerIDs(followeeIDs...),
+		repo.ListFollowsWithFolloweeIDs(followeeIDs...),
+		repo.ListFollowsWithoutBlocked(userID),
 	}
 
 	if req.Msg.GetFollowedOnly() {
-		followees, err := h.repo.ListFollowees(ctx, userID)
-		if err != nil {
-			log.Error("failed to list followees", zap.Error(err))
+		userIDs := append(followeeIDs, userID)
+		opts = append(opts, repo.ListWorkoutsWithUserIDs(userIDs...))
+		summaryOpts = append(summaryOpts, repo.ListYearSummariesWithUserIDs(userIDs...))
+		recordOpts = append(recordOpts, repo.ListPersonalRecordsWithUserIDs(userIDs...))
+		achievementOpts = append(achievementOpts, repo.ListAchievementsWithUserIDs(userIDs...))
+		challengeOpts = append(challengeOpts, repo.ListChallengesWithLeaderIDs(userIDs...))
+	}
+
+	if req.Msg.ClubId != nil {
+		member, err := h.repo.GetClubMember(ctx, req.Msg.GetClubId(), userID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Error("failed to get club member", zap.Error(err))
 			return nil, connect.NewError(connect.CodeInternal, nil)
 		}
 
-		followeeIDs := make([]string, 0, len(followees))
-		for _, follower := range followees {
-			followeeIDs = append(followeeIDs, follower.ID)
+		if member == nil || member.Status != orm.ClubMemberStatusActive {
+			log.Warn("user is not a member of the club")
+			return nil, connect.NewError(connect.CodePermissionDenied, nil)
 		}
 
-		opts = append(opts, repo.ListWorkoutsWithUserIDs(append(followeeIDs, userID)...))
+		opts = append(opts, repo.ListWorkoutsWithClubMembers(req.Msg.GetClubId()))
+		summaryOpts = append(summaryOpts, repo.ListYearSummariesWithClubMembers(req.Msg.GetClubId()))
+		recordOpts = append(recordOpts, repo.ListPersonalRecordsWithClubMembers(req.Msg.GetClubId()))
+		followOpts = append(followOpts, repo.ListFollowsWithClubMembers(req.Msg.GetClubId()))
+		achievementOpts = append(achievementOpts, repo.ListAchievementsWithClubMembers(req.Msg.GetClubId()))
+		challengeOpts = append(challengeOpts, repo.ListChallengesWithClubMembers(req.Msg.GetClubId()))
 	}
 
-	workouts, err := h.repo.ListWorkouts(ctx, opts...)
-	if err != nil {
+	var sources parser.FeedSources
+	if sources.Workouts, err = h.repo.ListWorkouts(ctx, opts...); err != nil {
 		log.Error("failed to list workouts", zap.Error(err))
 		return nil, connect.NewError(connect.CodeInternal, nil)
 	}
 
-	paginated, err := repo.PaginateSlice(workouts, limit, func(workout *orm.Workout) time.Time {
-		return workout.CreatedAt
-	})
-	if err != nil {
-		log.Error("failed to paginate workouts", zap.Error(err))
+	if sources.YearSummaries, err = h.repo.ListYearSummaries(ctx, summaryOpts...); err != nil {
+		log.Error("failed to list year summaries", zap.Error(err))
 		return nil, connect.NewError(connect.CodeInternal, nil)
 	}
 
-	personalBests, err := h.repo.GetPersonalBests(ctx, userID)
-	if err != nil {
+	if sources.PersonalRecords, err = h.repo.ListPersonalRecords(ctx, recordOpts...); err != nil {
+		log.Error("failed to list personal records", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if sources.Follows, err = h.repo.ListFollows(ctx, followOpts...); err != nil {
+		log.Error("failed to list follows", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if sources.Achievements, err = h.repo.ListAchievements(ctx, achievementOpts...); err != nil {
+		log.Error("failed to list achievements", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if sources.Challenges, err = h.repo.ListChallenges(ctx, challengeOpts...); err != nil {
+		log.Error("failed to list challenges", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	ownerIDs := make([]string, 0, len(sources.PersonalRecords)+len(sources.Achievements))
+	for _, record := range sources.PersonalRecords {
+		ownerIDs = append(ownerIDs, record.UserID)
+	}
+	for _, achievement := range sources.Achievements {
+		ownerIDs = append(ownerIDs, achievement.UserID)
+	}
+
+	if sources.Users, err = h.repo.ListUsers(ctx, repo.ListUsersWithIDs(ownerIDs)); err != nil {
+		log.Error("failed to list users", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if sources.PersonalBests, err = h.repo.GetPersonalBests(ctx, userID); err != nil {
 		log.Error("failed to get personal bests", zap.Error(err))
 		return nil, connect.NewError(connect.CodeInternal, nil)
 	}
 
-	feedItems, err := parser.FeedItemSlice(paginated.Items, personalBests)
+	feedItems, err := parser.FeedItemSlice(sources, userID)
 	if err != nil {
 		log.Error("failed to parse feed items", zap.Error(err))
 		return nil, connect.NewError(connect.CodeInternal, nil)
 	}
 
+	feedItems, nextPageToken, err := paginateFeedItems(feedItems, limit, pageToken)
+	if err != nil {
+		log.Error("failed to paginate feed items", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
 	return &connect.Response[apiv1.ListFeedItemsResponse]{
 		Msg: &apiv1.ListFeedItemsResponse{
 			Items: feedItems,
 			Pagination: &apiv1.PaginationResponse{
-				NextPageToken: paginated.NextPageToken,
+				NextPageToken: nextPageToken,
 			},
 		},
 	}, nil
 }
+
+// paginateFeedItems cuts the newest first items to the limit. Every source is
+// fetched with one item beyond the limit, so there is a next page if any items
+// are left over. The next page token moves on the token of each source with
+// items on the page to the last of them, keyed so that items of the source
+// created at the same time are not skipped.
+func paginateFeedItems(items []*apiv1.FeedItem, limit int, token repo.FeedPageToken) ([]*apiv1.FeedItem, []byte, error) {
+	if len(items) <= limit {
+		return items, nil, nil
+	}
+
+	items = items[:limit]
+	for _, item := range items {
+		var cursor *json.RawMessage
+		var keys []string
+		switch t := item.GetType().(type) {
+		case *apiv1.FeedItem_Workout:
+			cursor = &token.Workouts
+			keys = []string{t.Workout.GetId()}
+		case *apiv1.FeedItem_YearSummary:
+			cursor = &token.YearSummaries
+			keys = []string{t.YearSummary.GetId()}
+		case *apiv1.FeedItem_PersonalRecord:
+			cursor = &token.PersonalRecords
+			keys = []string{t.PersonalRecord.GetExerciseSet().GetSet().GetId()}
+		case *apiv1.FeedItem_Follow:
+			cursor = &token.Follows
+			keys = []string{t.Follow.GetFollower().GetId(), t.Follow.GetFollowee().GetId()}
+		case *apiv1.FeedItem_Achievement:
+			cursor = &token.Achievements
+			keys = []string{t.Achievement.GetUser().GetId(), strconv.Itoa(int(t.Achievement.GetWorkoutCount()))}
+		case *apiv1.FeedItem_ChallengeResult:
+			cursor = &token.Challenges
+			keys = []string{t.ChallengeResult.GetChallenge().GetId()}
+		default:
+			continue
+		}
+
+		var err error
+		if *cursor, err = json.Marshal(repo.PageTokenCreatedAt(item.GetCreatedAt().AsTime(), keys...)); err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal page token: %w", err)
+		}
+	}
+
+	nextPageToken, err := json.Marshal(token)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal feed page token: %w", err)
+	}
+
+	return items, nextPageToken, nil
+}
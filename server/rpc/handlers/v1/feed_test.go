@@ -0,0 +1,122 @@
+package v1_test
+
+import (
+	"context"
+	"log"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	apiv1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+	"github.com/crlssn/getstronger/server/gen/proto/api/v1/apiv1connect"
+	"github.com/crlssn/getstronger/server/repo"
+	handlers "github.com/crlssn/getstronger/server/rpc/handlers/v1"
+	"github.com/crlssn/getstronger/server/testing/container"
+	"github.com/crlssn/getstronger/server/testing/factory"
+	"github.com/crlssn/getstronger/server/xcontext"
+)
+
+type feedSuite struct {
+	suite.Suite
+
+	handler apiv1connect.FeedServiceHandler
+
+	factory   *factory.Factory
+	container *container.Container
+}
+
+func TestFeedSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(feedSuite))
+}
+
+func (s *feedSuite) SetupSuite() {
+	ctx := context.Background()
+	s.container = container.NewContainer(ctx)
+	s.factory = factory.NewFactory(s.container.DB)
+	s.handler = handlers.NewFeedHandler(repo.New(s.container.DB))
+
+	s.T().Cleanup(func() {
+		if err := s.container.Terminate(ctx); err != nil {
+			log.Fatalf("failed to clean container: %s", err)
+		}
+	})
+}
+
+func (s *feedSuite) TestListFeedItems_PersonalRecordsSameTime() {
+	viewer := s.factory.NewUser()
+	lifter := s.factory.NewUser()
+	s.Require().NoError(repo.New(s.container.DB).Follow(context.Background(), repo.FollowParams{
+		FollowerID: viewer.ID,
+		FolloweeID: lifter.ID,
+	}))
+
+	now := time.Now().UTC()
+	first := s.factory.NewWorkout(factory.WorkoutUserID(lifter.ID), factory.WorkoutCreatedAt(now.Add(-2*time.Hour)))
+	second := s.factory.NewWorkout(factory.WorkoutUserID(lifter.ID), factory.WorkoutCreatedAt(now.Add(-time.Hour)))
+
+	// Every exercise gets a personal record in the second workout, all logged
+	// at the same time.
+	const records = 5
+	expected := make(map[string]struct{}, records)
+	for range records {
+		exercise := s.factory.NewExercise(factory.ExerciseUserID(lifter.ID))
+		s.factory.NewSet(
+			factory.SetUserID(lifter.ID),
+			factory.SetWorkoutID(first.ID),
+			factory.SetExerciseID(exercise.ID),
+			factory.SetWeight(100),
+			factory.SetReps(5),
+			factory.SetCreatedAt(now.Add(-2*time.Hour)),
+		)
+		record := s.factory.NewSet(
+			factory.SetUserID(lifter.ID),
+			factory.SetWorkoutID(second.ID),
+			factory.SetExerciseID(exercise.ID),
+			factory.SetWeight(110),
+			factory.SetReps(5),
+			factory.SetCreatedAt(now.Add(-time.Hour)),
+		)
+		expected[record.ID] = struct{}{}
+	}
+
+	s.Require().NoError(repo.New(s.container.DB).CreatePersonalRecords(context.Background(), lifter.ID))
+
+	ctx := xcontext.WithUserID(context.Background(), viewer.ID)
+	ctx = xcontext.WithLogger(ctx, zap.NewExample())
+
+	listed := make(map[string]struct{}, records)
+	var pageToken []byte
+	for range 2 * records {
+		res, err := s.handler.ListFeedItems(ctx, connect.NewRequest(&apiv1.ListFeedItemsRequest{
+			FollowedOnly: true,
+			Pagination: &apiv1.PaginationRequest{
+				PageLimit: 2,
+				PageToken: pageToken,
+			},
+		}))
+		s.Require().NoError(err)
+
+		for _, item := range res.Msg.GetItems() {
+			record := item.GetPersonalRecord()
+			if record == nil {
+				continue
+			}
+
+			setID := record.GetExerciseSet().GetSet().GetId()
+			s.Require().NotContains(listed, setID)
+			listed[setID] = struct{}{}
+		}
+
+		pageToken = res.Msg.GetPagination().GetNextPageToken()
+		if pageToken == nil {
+			break
+		}
+	}
+
+	s.Require().Nil(pageToken)
+	s.Require().Equal(expected, listed)
+}
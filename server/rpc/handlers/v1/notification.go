@@ -52,6 +52,8 @@ func (h *notificationHandler) ListNotifications(ctx context.Context, req *connec
 
 	var actorIDs []string
 	var workoutIDs []string
+	var summaryIDs []string
+	var challengeIDs []string
 
 	for _, n := range paginated.Items {
 		var payload repo.NotificationPayload
@@ -66,6 +68,12 @@ func (h *notificationHandler) ListNotifications(ctx context.Context, req *connec
 		if payload.WorkoutID != "" {
 			workoutIDs = append(workoutIDs, payload.WorkoutID)
 		}
+		if payload.YearSummaryID != "" {
+			summaryIDs = append(summaryIDs, payload.YearSummaryID)
+		}
+		if payload.ChallengeID != "" {
+			challengeIDs = append(challengeIDs, payload.ChallengeID)
+		}
 	}
 
 	actors, err := h.repo.ListUsers(ctx, repo.ListUsersWithIDs(actorIDs))
@@ -77,13 +85,34 @@ func (h *notificationHandler) ListNotifications(ctx context.Context, req *connec
 	workouts, err := h.repo.ListWorkouts(ctx,
 		repo.ListWorkoutsWithIDs(workoutIDs),
 		repo.ListWorkoutsLoadUser(),
+		repo.ListWorkoutsWithoutDeleted(),
+		repo.ListWorkoutsVisibleTo(userID),
 	)
 	if err != nil {
 		log.Error("failed to list workouts", zap.Error(err))
 		return nil, connect.NewError(connect.CodeInternal, nil)
 	}
 
-	notificationSlice, err := parser.NotificationSlice(paginated.Items, actors, workouts)
+	summaries, err := h.repo.ListYearSummaries(ctx,
+		repo.ListYearSummariesWithIDs(summaryIDs),
+		repo.ListYearSummariesLoadUser(),
+	)
+	if err != nil {
+		log.Error("failed to list year summaries", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	challenges, err := h.repo.ListChallenges(ctx,
+		repo.ListChallengesWithIDs(challengeIDs),
+		repo.ListChallengesLoadCreator(),
+		repo.ListChallengesLoadParticipant(userID),
+	)
+	if err != nil {
+		log.Error("failed to list challenges", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	notificationSlice, err := parser.NotificationSlice(paginated.Items, actors, workouts, summaries, challenges)
 	if err != nil {
 		log.Error("failed to parse notifications", zap.Error(err))
 		return nil, connect.NewError(connect.CodeInternal, nil)
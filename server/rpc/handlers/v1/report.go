@@ -0,0 +1,125 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"connectrpc.com/connect"
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/gen/orm"
+	apiv1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+	"github.com/crlssn/getstronger/server/gen/proto/api/v1/apiv1connect"
+	"github.com/crlssn/getstronger/server/repo"
+	"github.com/crlssn/getstronger/server/xcontext"
+)
+
+var _ apiv1connect.ReportServiceHandler = (*reportHandler)(nil)
+
+type reportHandler struct {
+	repo repo.Repo
+}
+
+func NewReportHandler(r repo.Repo) apiv1connect.ReportServiceHandler {
+	return &reportHandler{r}
+}
+
+func (h *reportHandler) ReportContent(ctx context.Context, req *connect.Request[apiv1.ReportContentRequest]) (*connect.Response[apiv1.ReportContentResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	p := repo.CreateReportParams{
+		ReporterID: userID,
+		Reason:     req.Msg.GetReason(),
+	}
+
+	var workout *orm.Workout
+	switch target := req.Msg.GetTarget().(type) {
+	case *apiv1.ReportContentRequest_WorkoutId:
+		var err error
+		workout, err = h.repo.GetWorkout(ctx,
+			repo.GetWorkoutWithID(target.WorkoutId),
+			repo.GetWorkoutWithoutDeleted(),
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Warn("workout not found")
+				return nil, connect.NewError(connect.CodeNotFound, nil)
+			}
+
+			log.Error("failed to get workout", zap.Error(err))
+			return nil, connect.NewError(connect.CodeInternal, nil)
+		}
+
+		p.Target = orm.ReportTargetWorkout
+		p.TargetID = workout.ID
+	case *apiv1.ReportContentRequest_CommentId:
+		comment, err := h.repo.GetWorkoutComment(ctx,
+			repo.GetWorkoutCommentWithID(target.CommentId),
+			repo.GetWorkoutCommentWithoutHidden(),
+			repo.GetWorkoutCommentWithWorkout(),
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Warn("comment not found")
+				return nil, connect.NewError(connect.CodeNotFound, nil)
+			}
+
+			log.Error("failed to get comment", zap.Error(err))
+			return nil, connect.NewError(connect.CodeInternal, nil)
+		}
+
+		workout = comment.R.Workout
+		p.Target = orm.ReportTargetComment
+		p.TargetID = comment.ID
+	case *apiv1.ReportContentRequest_UserId:
+		if target.UserId == userID {
+			log.Warn("user tried to report themselves")
+			return nil, connect.NewError(connect.CodeInvalidArgument, nil)
+		}
+
+		user, err := h.repo.GetUser(ctx,
+			repo.GetUserWithID(target.UserId),
+			repo.GetUserWithoutDeleted(),
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Warn("user not found")
+				return nil, connect.NewError(connect.CodeNotFound, nil)
+			}
+
+			log.Error("failed to get user", zap.Error(err))
+			return nil, connect.NewError(connect.CodeInternal, nil)
+		}
+
+		p.Target = orm.ReportTargetUser
+		p.TargetID = user.ID
+	default:
+		log.Warn("report target not set")
+		return nil, connect.NewError(connect.CodeInvalidArgument, nil)
+	}
+
+	if workout != nil {
+		visible, err := h.repo.CanViewWorkout(ctx, userID, workout)
+		if err != nil {
+			log.Error("failed to check workout visibility", zap.Error(err))
+			return nil, connect.NewError(connect.CodeInternal, nil)
+		}
+
+		if !visible {
+			log.Warn("workout is not visible to viewer")
+			return nil, connect.NewError(connect.CodePermissionDenied, nil)
+		}
+	}
+
+	if _, err := h.repo.CreateReport(ctx, p); err != nil {
+		log.Error("failed to create report", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("content reported")
+	return &connect.Response[apiv1.ReportContentResponse]{
+		Msg: &apiv1.ReportContentResponse{},
+	}, nil
+}
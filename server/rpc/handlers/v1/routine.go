@@ -6,9 +6,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
 	"go.uber.org/zap"
 
 	"github.com/crlssn/getstronger/server/gen/orm"
@@ -22,6 +25,8 @@ import (
 
 var _ apiv1connect.RoutineServiceHandler = (*routineHandler)(nil)
 
+var ErrWorkoutWithoutSets = errors.New("workout has no sets")
+
 type routineHandler struct {
 	repo repo.Repo
 }
@@ -57,7 +62,7 @@ func (h *routineHandler) GetRoutine(ctx context.Context, req *connect.Request[ap
 
 	routine, err := h.repo.GetRoutine(ctx,
 		repo.GetRoutineWithID(req.Msg.GetId()),
-		repo.GetRoutineWithUserID(userID),
+		repo.GetRoutineWithoutDeleted(),
 		repo.GetRoutineWithExercises(),
 	)
 	if err != nil {
@@ -70,6 +75,17 @@ func (h *routineHandler) GetRoutine(ctx context.Context, req *connect.Request[ap
 		return nil, connect.NewError(connect.CodeInternal, nil)
 	}
 
+	readable, err := h.canReadRoutine(ctx, routine, userID, req.Msg.GetShareToken())
+	if err != nil {
+		log.Error("routine access check failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if !readable {
+		log.Warn("routine not readable by user")
+		return nil, connect.NewError(connect.CodeNotFound, nil)
+	}
+
 	mapExercises := make(map[string]*orm.Exercise, len(routine.R.Exercises))
 	for _, exercise := range routine.R.Exercises {
 		mapExercises[exercise.ID] = exercise
@@ -91,9 +107,21 @@ func (h *routineHandler) GetRoutine(ctx context.Context, req *connect.Request[ap
 		routine.R.Exercises = append(routine.R.Exercises, exercise)
 	}
 
+	var exerciseTargets []repo.RoutineExerciseTarget
+	if err = json.Unmarshal(routine.ExerciseTargets, &exerciseTargets); err != nil {
+		log.Error("unmarshal exercise targets failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	r := parser.Routine(routine)
+	r.ExerciseTargets = parser.ExerciseTargetSlice(exerciseTargets)
+	if routine.UserID != userID {
+		r.ShareToken = ""
+	}
+
 	log.Info("routine returned")
 	return connect.NewResponse(&apiv1.GetRoutineResponse{
-		Routine: parser.Routine(routine),
+		Routine: r,
 	}), nil
 }
 
@@ -103,6 +131,7 @@ func (h *routineHandler) UpdateRoutine(ctx context.Context, req *connect.Request
 
 	routine, err := h.repo.GetRoutine(ctx,
 		repo.GetRoutineWithID(req.Msg.GetRoutine().GetId()),
+		repo.GetRoutineWithoutDeleted(),
 		repo.GetRoutineWithUserID(userID),
 	)
 	if err != nil {
@@ -154,6 +183,7 @@ func (h *routineHandler) UpdateRoutine(ctx context.Context, req *connect.Request
 
 	routine, err = h.repo.GetRoutine(ctx,
 		repo.GetRoutineWithID(req.Msg.GetRoutine().GetId()),
+		repo.GetRoutineWithoutDeleted(),
 		repo.GetRoutineWithExercises(),
 	)
 	if err != nil {
@@ -171,7 +201,10 @@ func (h *routineHandler) DeleteRoutine(ctx context.Context, req *connect.Request
 	log := xcontext.MustExtractLogger(ctx)
 	userID := xcontext.MustExtractUserID(ctx)
 
-	routine, err := h.repo.GetRoutine(ctx, repo.GetRoutineWithID(req.Msg.GetId()))
+	routine, err := h.repo.GetRoutine(ctx,
+		repo.GetRoutineWithID(req.Msg.GetId()),
+		repo.GetRoutineWithoutDeleted(),
+	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			log.Warn("exercise not found", zap.Error(err))
@@ -206,6 +239,7 @@ func (h *routineHandler) ListRoutines(ctx context.Context, req *connect.Request[
 		repo.ListRoutinesWithLimit(limit+1),
 		repo.ListRoutinesWithUserID(userID),
 		repo.ListRoutinesWithPageToken(req.Msg.GetPagination().GetPageToken()),
+		repo.ListRoutinesWithoutDeleted(),
 	)
 	if err != nil {
 		log.Error("list routines failed", zap.Error(err))
@@ -235,6 +269,7 @@ func (h *routineHandler) AddExercise(ctx context.Context, req *connect.Request[a
 
 	routine, err := h.repo.GetRoutine(ctx,
 		repo.GetRoutineWithID(req.Msg.GetRoutineId()),
+		repo.GetRoutineWithoutDeleted(),
 		repo.GetRoutineWithUserID(userID),
 	)
 	if err != nil {
@@ -276,6 +311,7 @@ func (h *routineHandler) RemoveExercise(ctx context.Context, req *connect.Reques
 
 	routine, err := h.repo.GetRoutine(ctx,
 		repo.GetRoutineWithID(req.Msg.GetRoutineId()),
+		repo.GetRoutineWithoutDeleted(),
 		repo.GetRoutineWithUserID(userID),
 	)
 	if err != nil {
@@ -317,6 +353,7 @@ func (h *routineHandler) UpdateExerciseOrder(ctx context.Context, req *connect.R
 
 	routine, err := h.repo.GetRoutine(ctx,
 		repo.GetRoutineWithID(req.Msg.GetRoutineId()),
+		repo.GetRoutineWithoutDeleted(),
 		repo.GetRoutineWithExercises(),
 	)
 	if err != nil {
@@ -359,3 +396,278 @@ func (h *routineHandler) UpdateExerciseOrder(ctx context.Context, req *connect.R
 	log.Info("exercise order updated")
 	return connect.NewResponse(&apiv1.UpdateExerciseOrderResponse{}), nil
 }
+
+func (h *routineHandler) CreateRoutineFromWorkout(ctx context.Context, req *connect.Request[apiv1.CreateRoutineFromWorkoutRequest]) (*connect.Response[apiv1.CreateRoutineFromWorkoutResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).
+		With(zap.String("workout_id", req.Msg.GetWorkoutId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	workout, err := h.repo.GetWorkout(ctx,
+		repo.GetWorkoutWithID(req.Msg.GetWorkoutId()),
+		repo.GetWorkoutWithoutDeleted(),
+		repo.GetWorkoutLoadSets(),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("workout not found", zap.Error(err))
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("get workout failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if workout.UserID != userID {
+		log.Error("workout does not belong to user")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	sets := workout.R.Sets
+	if len(sets) == 0 {
+		log.Warn("workout has no sets")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrWorkoutWithoutSets)
+	}
+
+	sort.SliceStable(sets, func(i, j int) bool {
+		return sets[i].CreatedAt.Before(sets[j].CreatedAt)
+	})
+
+	exerciseTargets := parser.RoutineExerciseTargets(sets)
+	exerciseIDs := make([]string, 0, len(exerciseTargets))
+	for _, target := range exerciseTargets {
+		exerciseIDs = append(exerciseIDs, target.ExerciseID)
+	}
+
+	var routine *orm.Routine
+	if err = h.repo.NewTx(ctx, func(tx repo.Tx) error {
+		routine, err = tx.CreateRoutine(ctx, repo.CreateRoutineParams{
+			UserID:      userID,
+			Name:        req.Msg.GetName(),
+			ExerciseIDs: exerciseIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("create routine: %w", err)
+		}
+
+		if !req.Msg.GetIncludeTargets() {
+			return nil
+		}
+
+		if err = tx.UpdateRoutine(ctx, routine.ID, repo.UpdateRoutineExerciseTargets(exerciseTargets)); err != nil {
+			return fmt.Errorf("update routine: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, repo.ErrRoutineExerciseDeleted) {
+			log.Warn("workout contains deleted exercise", zap.Error(err))
+			return nil, connect.NewError(connect.CodeFailedPrecondition, repo.ErrRoutineExerciseDeleted)
+		}
+
+		log.Error("create routine failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("routine created from workout", xzap.FiledRoutineID(routine.ID))
+	return connect.NewResponse(&apiv1.CreateRoutineFromWorkoutResponse{
+		Id: routine.ID,
+	}), nil
+}
+
+func (h *routineHandler) ShareRoutine(ctx context.Context, req *connect.Request[apiv1.ShareRoutineRequest]) (*connect.Response[apiv1.ShareRoutineResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).
+		With(xzap.FiledRoutineID(req.Msg.GetRoutineId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	routine, err := h.repo.GetRoutine(ctx,
+		repo.GetRoutineWithID(req.Msg.GetRoutineId()),
+		repo.GetRoutineWithoutDeleted(),
+		repo.GetRoutineWithUserID(userID),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("routine not found", zap.Error(err))
+			return nil, connect.NewError(connect.CodeFailedPrecondition, nil)
+		}
+
+		log.Error("get routine failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	visibility := parser.RoutineVisibilityFromPB(req.Msg.GetVisibility())
+	shareToken := null.String{}
+	if visibility == orm.RoutineVisibilityLink {
+		shareToken = routine.ShareToken
+		if !shareToken.Valid {
+			shareToken = null.StringFrom(uuid.NewString())
+		}
+	}
+
+	if err = h.repo.UpdateRoutine(ctx, routine.ID,
+		repo.UpdateRoutineVisibility(visibility),
+		repo.UpdateRoutineShareToken(shareToken),
+	); err != nil {
+		log.Error("update routine failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	routine, err = h.repo.GetRoutine(ctx,
+		repo.GetRoutineWithID(routine.ID),
+		repo.GetRoutineWithoutDeleted(),
+		repo.GetRoutineWithExercises(),
+	)
+	if err != nil {
+		log.Error("get routine failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("routine shared", zap.String("visibility", visibility.String()))
+	return connect.NewResponse(&apiv1.ShareRoutineResponse{
+		Routine: parser.Routine(routine),
+	}), nil
+}
+
+func (h *routineHandler) CloneRoutine(ctx context.Context, req *connect.Request[apiv1.CloneRoutineRequest]) (*connect.Response[apiv1.CloneRoutineResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).
+		With(xzap.FiledRoutineID(req.Msg.GetRoutineId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	routine, err := h.repo.GetRoutine(ctx,
+		repo.GetRoutineWithID(req.Msg.GetRoutineId()),
+		repo.GetRoutineWithoutDeleted(),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("routine not found", zap.Error(err))
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("get routine failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	readable, err := h.canReadRoutine(ctx, routine, userID, req.Msg.GetShareToken())
+	if err != nil {
+		log.Error("routine access check failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if !readable {
+		log.Warn("routine not readable by user")
+		return nil, connect.NewError(connect.CodeNotFound, nil)
+	}
+
+	clone, err := h.repo.CloneRoutine(ctx, repo.CloneRoutineParams{
+		RoutineID: routine.ID,
+		UserID:    userID,
+		Name:      req.Msg.GetName(),
+	})
+	if err != nil {
+		if errors.Is(err, repo.ErrRoutineCloneOwnRoutine) {
+			log.Warn("user cloned own routine")
+			return nil, connect.NewError(connect.CodeFailedPrecondition, repo.ErrRoutineCloneOwnRoutine)
+		}
+
+		log.Error("clone routine failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("routine cloned", zap.String("clone_id", clone.ID))
+	return connect.NewResponse(&apiv1.CloneRoutineResponse{
+		Id: clone.ID,
+	}), nil
+}
+
+// canReadRoutine reports whether the user can read the routine. Routines are
+// readable by their owner, by followers of the owner when shared with
+// followers or by link, and by anyone holding the share token of a routine
+// shared by link.
+func (h *routineHandler) canReadRoutine(ctx context.Context, routine *orm.Routine, userID, shareToken string) (bool, error) {
+	if routine.UserID == userID {
+		return true, nil
+	}
+
+	switch routine.Visibility {
+	case orm.RoutineVisibilityLink:
+		if shareToken != "" && routine.ShareToken.Valid && routine.ShareToken.String == shareToken {
+			return true, nil
+		}
+		fallthrough
+	case orm.RoutineVisibilityFollowers:
+		followed, err := h.repo.IsUserFollowedByUserID(ctx, &orm.User{ID: routine.UserID}, userID)
+		if err != nil {
+			return false, fmt.Errorf("failed to check if user is followed: %w", err)
+		}
+
+		return followed, nil
+	case orm.RoutineVisibilityPrivate:
+	}
+
+	return false, nil
+}
+
+func (h *routineHandler) ListDeletedRoutines(ctx context.Context, req *connect.Request[apiv1.ListDeletedRoutinesRequest]) (*connect.Response[apiv1.ListDeletedRoutinesResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	limit := int(req.Msg.GetPagination().GetPageLimit())
+	routines, err := h.repo.ListRoutines(ctx,
+		repo.ListRoutinesWithLimit(limit+1),
+		repo.ListRoutinesWithUserID(userID),
+		repo.ListRoutinesWithPageToken(req.Msg.GetPagination().GetPageToken()),
+		repo.ListRoutinesOnlyDeleted(),
+	)
+	if err != nil {
+		log.Error("list deleted routines failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	pagination, err := repo.PaginateSlice(routines, limit, func(routine *orm.Routine) time.Time {
+		return routine.CreatedAt
+	})
+	if err != nil {
+		log.Error("paginate routines failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("deleted routines listed")
+	return connect.NewResponse(&apiv1.ListDeletedRoutinesResponse{
+		Routines: parser.RoutineSlice(pagination.Items),
+		Pagination: &apiv1.PaginationResponse{
+			NextPageToken: pagination.NextPageToken,
+		},
+	}), nil
+}
+
+func (h *routineHandler) RestoreRoutine(ctx context.Context, req *connect.Request[apiv1.RestoreRoutineRequest]) (*connect.Response[apiv1.RestoreRoutineResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).
+		With(xzap.FiledRoutineID(req.Msg.GetId()))
+	userID := xcontext.MustExtractUserID(ctx)
+
+	routine, err := h.repo.GetRoutine(ctx,
+		repo.GetRoutineWithID(req.Msg.GetId()),
+		repo.GetRoutineWithUserID(userID),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("routine not found", zap.Error(err))
+			return nil, connect.NewError(connect.CodeFailedPrecondition, nil)
+		}
+
+		log.Error("get routine failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if err = h.repo.RestoreRoutine(ctx, routine.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("routine not deleted", zap.Error(err))
+			return nil, connect.NewError(connect.CodeFailedPrecondition, nil)
+		}
+
+		log.Error("restore routine failed", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("routine restored")
+	return connect.NewResponse(&apiv1.RestoreRoutineResponse{}), nil
+}
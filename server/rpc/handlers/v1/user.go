@@ -1,14 +1,21 @@
 package v1
 
 import (
+	"bufio"
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/volatiletech/null/v8"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/crlssn/getstronger/server/config"
+	"github.com/crlssn/getstronger/server/export"
 	"github.com/crlssn/getstronger/server/gen/orm"
 	apiv1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
 	"github.com/crlssn/getstronger/server/gen/proto/api/v1/apiv1connect"
@@ -16,18 +23,22 @@ import (
 	"github.com/crlssn/getstronger/server/pubsub/payloads"
 	"github.com/crlssn/getstronger/server/repo"
 	"github.com/crlssn/getstronger/server/rpc/parser"
+	"github.com/crlssn/getstronger/server/strength"
 	"github.com/crlssn/getstronger/server/xcontext"
+	"github.com/crlssn/getstronger/server/xzap"
 )
 
 var _ apiv1connect.UserServiceHandler = (*userHandler)(nil)
 
 type userHandler struct {
-	repo   repo.Repo
-	pubSub *pubsub.PubSub
+	repo     repo.Repo
+	config   *config.Config
+	pubSub   *pubsub.PubSub
+	exporter *export.Exporter
 }
 
-func NewUserHandler(r repo.Repo, ps *pubsub.PubSub) apiv1connect.UserServiceHandler {
-	return &userHandler{r, ps}
+func NewUserHandler(r repo.Repo, c *config.Config, ps *pubsub.PubSub, e *export.Exporter) apiv1connect.UserServiceHandler {
+	return &userHandler{r, c, ps, e}
 }
 
 func (h *userHandler) GetUser(ctx context.Context, req *connect.Request[apiv1.GetUserRequest]) (*connect.Response[apiv1.GetUserResponse], error) {
@@ -36,6 +47,7 @@ func (h *userHandler) GetUser(ctx context.Context, req *connect.Request[apiv1.Ge
 
 	user, err := h.repo.GetUser(ctx,
 		repo.GetUserWithID(req.Msg.GetId()),
+		repo.GetUserWithoutDeleted(),
 		repo.GetUserLoadAuth(),
 	)
 	if err != nil {
@@ -54,19 +66,45 @@ func (h *userHandler) GetUser(ctx context.Context, req *connect.Request[apiv1.Ge
 		return nil, connect.NewError(connect.CodeInternal, nil)
 	}
 
+	requests, err := h.repo.ListFollowRequests(ctx,
+		repo.ListFollowRequestsWithFollowerID(userID),
+		repo.ListFollowRequestsWithFolloweeID(user.ID),
+	)
+	if err != nil {
+		log.Error("failed to list follow requests", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	blockedUsers, err := h.repo.ListBlockedUsers(ctx, userID)
+	if err != nil {
+		log.Error("failed to list blocked users", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	blocked := slices.ContainsFunc(blockedUsers, func(u *orm.User) bool {
+		return u.ID == user.ID
+	})
+
 	return &connect.Response[apiv1.GetUserResponse]{
 		Msg: &apiv1.GetUserResponse{
-			User: parser.User(user, parser.UserFollowed(followed)),
+			User: parser.User(user,
+				parser.UserFollowed(followed),
+				parser.UserFollowRequested(len(requests) > 0),
+				parser.UserBlocked(blocked),
+			),
 		},
 	}, nil
 }
 
 func (h *userHandler) SearchUsers(ctx context.Context, req *connect.Request[apiv1.SearchUsersRequest]) (*connect.Response[apiv1.SearchUsersResponse], error) {
 	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
 
 	limit := int(req.Msg.GetPagination().GetPageLimit())
 	users, err := h.repo.ListUsers(ctx,
 		repo.ListUsersWithLimit(limit+1),
+		repo.ListUsersWithoutDeleted(),
+		repo.ListUsersWithoutBlocked(userID),
 		repo.ListUsersWithNameMatching(req.Msg.GetQuery()),
 	)
 	if err != nil {
@@ -97,10 +135,64 @@ func (h *userHandler) FollowUser(ctx context.Context, req *connect.Request[apiv1
 	log := xcontext.MustExtractLogger(ctx)
 	userID := xcontext.MustExtractUserID(ctx)
 
-	if err := h.repo.Follow(ctx, repo.FollowParams{
+	followee, err := h.repo.GetUser(ctx,
+		repo.GetUserWithID(req.Msg.GetFollowId()),
+		repo.GetUserWithoutDeleted(),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("user not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get user", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if followee.Private {
+		followed, err := h.repo.IsUserFollowedByUserID(ctx, followee, userID)
+		if err != nil {
+			log.Error("failed to check if user is followed", zap.Error(err))
+			return nil, connect.NewError(connect.CodeInternal, nil)
+		}
+
+		if !followed {
+			if err = h.repo.CreateFollowRequest(ctx, repo.FollowParams{
+				FollowerID: userID,
+				FolloweeID: followee.ID,
+			}); err != nil {
+				if errors.Is(err, repo.ErrUserBlocked) {
+					log.Warn("user is blocked")
+					return nil, connect.NewError(connect.CodePermissionDenied, nil)
+				}
+
+				log.Error("failed to create follow request", zap.Error(err))
+				return nil, connect.NewError(connect.CodeInternal, nil)
+			}
+
+			h.pubSub.Publish(ctx, orm.EventTopicFollowRequested, payloads.FollowRequested{
+				FollowerID: userID,
+				FolloweeID: followee.ID,
+			})
+
+			log.Info("follow requested")
+			return &connect.Response[apiv1.FollowUserResponse]{
+				Msg: &apiv1.FollowUserResponse{
+					Requested: true,
+				},
+			}, nil
+		}
+	}
+
+	if err = h.repo.Follow(ctx, repo.FollowParams{
 		FollowerID: userID,
 		FolloweeID: req.Msg.GetFollowId(),
 	}); err != nil {
+		if errors.Is(err, repo.ErrUserBlocked) {
+			log.Warn("user is blocked")
+			return nil, connect.NewError(connect.CodePermissionDenied, nil)
+		}
+
 		log.Error("failed to follow user", zap.Error(err))
 		return nil, connect.NewError(connect.CodeInternal, nil)
 	}
@@ -111,7 +203,9 @@ func (h *userHandler) FollowUser(ctx context.Context, req *connect.Request[apiv1
 	})
 
 	return &connect.Response[apiv1.FollowUserResponse]{
-		Msg: &apiv1.FollowUserResponse{},
+		Msg: &apiv1.FollowUserResponse{
+			Requested: false,
+		},
 	}, nil
 }
 
@@ -132,6 +226,159 @@ func (h *userHandler) UnfollowUser(ctx context.Context, req *connect.Request[api
 	}, nil
 }
 
+func (h *userHandler) BlockUser(ctx context.Context, req *connect.Request[apiv1.BlockUserRequest]) (*connect.Response[apiv1.BlockUserResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if req.Msg.GetUserId() == userID {
+		log.Warn("user tried to block themselves")
+		return nil, connect.NewError(connect.CodeInvalidArgument, nil)
+	}
+
+	user, err := h.repo.GetUser(ctx,
+		repo.GetUserWithID(req.Msg.GetUserId()),
+		repo.GetUserWithoutDeleted(),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("user not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get user", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if err = h.repo.BlockUser(ctx, repo.BlockParams{
+		BlockerID: userID,
+		BlockedID: user.ID,
+	}); err != nil {
+		log.Error("failed to block user", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("user blocked")
+	return &connect.Response[apiv1.BlockUserResponse]{
+		Msg: &apiv1.BlockUserResponse{},
+	}, nil
+}
+
+func (h *userHandler) UnblockUser(ctx context.Context, req *connect.Request[apiv1.UnblockUserRequest]) (*connect.Response[apiv1.UnblockUserResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if err := h.repo.UnblockUser(ctx, repo.BlockParams{
+		BlockerID: userID,
+		BlockedID: req.Msg.GetUserId(),
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("user not blocked")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to unblock user", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("user unblocked")
+	return &connect.Response[apiv1.UnblockUserResponse]{
+		Msg: &apiv1.UnblockUserResponse{},
+	}, nil
+}
+
+func (h *userHandler) ListBlockedUsers(ctx context.Context, _ *connect.Request[apiv1.ListBlockedUsersRequest]) (*connect.Response[apiv1.ListBlockedUsersResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	users, err := h.repo.ListBlockedUsers(ctx, userID)
+	if err != nil {
+		log.Error("failed to list blocked users", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	blocked := make([]*apiv1.User, 0, len(users))
+	for _, user := range users {
+		blocked = append(blocked, parser.User(user, parser.UserBlocked(true)))
+	}
+
+	return &connect.Response[apiv1.ListBlockedUsersResponse]{
+		Msg: &apiv1.ListBlockedUsersResponse{
+			Users: blocked,
+		},
+	}, nil
+}
+
+func (h *userHandler) ListFollowRequests(ctx context.Context, _ *connect.Request[apiv1.ListFollowRequestsRequest]) (*connect.Response[apiv1.ListFollowRequestsResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	requests, err := h.repo.ListFollowRequests(ctx,
+		repo.ListFollowRequestsWithFolloweeID(userID),
+		repo.ListFollowRequestsLoadFollower(),
+	)
+	if err != nil {
+		log.Error("failed to list follow requests", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	return &connect.Response[apiv1.ListFollowRequestsResponse]{
+		Msg: &apiv1.ListFollowRequestsResponse{
+			Requests: parser.FollowRequestSlice(requests),
+		},
+	}, nil
+}
+
+func (h *userHandler) ApproveFollowRequest(ctx context.Context, req *connect.Request[apiv1.ApproveFollowRequestRequest]) (*connect.Response[apiv1.ApproveFollowRequestResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if err := h.repo.ApproveFollowRequest(ctx, repo.FollowParams{
+		FollowerID: req.Msg.GetFollowerId(),
+		FolloweeID: userID,
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("follow request not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to approve follow request", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	h.pubSub.Publish(ctx, orm.EventTopicFollowRequestApproved, payloads.FollowRequestApproved{
+		FollowerID: req.Msg.GetFollowerId(),
+		FolloweeID: userID,
+	})
+
+	log.Info("follow request approved")
+	return &connect.Response[apiv1.ApproveFollowRequestResponse]{
+		Msg: &apiv1.ApproveFollowRequestResponse{},
+	}, nil
+}
+
+func (h *userHandler) RejectFollowRequest(ctx context.Context, req *connect.Request[apiv1.RejectFollowRequestRequest]) (*connect.Response[apiv1.RejectFollowRequestResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if err := h.repo.DeleteFollowRequest(ctx, repo.FollowParams{
+		FollowerID: req.Msg.GetFollowerId(),
+		FolloweeID: userID,
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("follow request not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to reject follow request", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("follow request rejected")
+	return &connect.Response[apiv1.RejectFollowRequestResponse]{
+		Msg: &apiv1.RejectFollowRequestResponse{},
+	}, nil
+}
+
 func (h *userHandler) ListFollowers(ctx context.Context, req *connect.Request[apiv1.ListFollowersRequest]) (*connect.Response[apiv1.ListFollowersResponse], error) {
 	log := xcontext.MustExtractLogger(ctx)
 
@@ -163,3 +410,464 @@ func (h *userHandler) ListFollowees(ctx context.Context, req *connect.Request[ap
 		},
 	}, nil
 }
+
+func (h *userHandler) GetProfile(ctx context.Context, _ *connect.Request[apiv1.GetProfileRequest]) (*connect.Response[apiv1.GetProfileResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	user, err := h.repo.GetUser(ctx, repo.GetUserWithID(userID))
+	if err != nil {
+		log.Error("failed to get user", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	return &connect.Response[apiv1.GetProfileResponse]{
+		Msg: &apiv1.GetProfileResponse{
+			Profile: parser.Profile(user),
+		},
+	}, nil
+}
+
+var ErrEmptyUpdateMask = errors.New("update mask must have at least one path")
+
+func (h *userHandler) UpdateProfile(ctx context.Context, req *connect.Request[apiv1.UpdateProfileRequest]) (*connect.Response[apiv1.UpdateProfileResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	if len(req.Msg.GetUpdateMask().GetPaths()) == 0 {
+		log.Warn("empty update mask")
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrEmptyUpdateMask)
+	}
+
+	profile := req.Msg.GetProfile()
+	var opts []repo.UpdateUserOpt
+	var bodyweight null.Float64
+	for _, path := range req.Msg.GetUpdateMask().GetPaths() {
+		switch path {
+		case "sex":
+			opts = append(opts, repo.UpdateUserSex(parser.SexFromPB(profile.GetSex())))
+		case "birth_date":
+			birthDate := null.NewTime(profile.GetBirthDate().AsTime(), profile.GetBirthDate() != nil)
+			opts = append(opts, repo.UpdateUserBirthDate(birthDate))
+		case "bodyweight":
+			bodyweight = null.NewFloat64(profile.GetBodyweight(), profile.GetBodyweight() > 0)
+			opts = append(opts, repo.UpdateUserBodyweight(bodyweight))
+		case "private":
+			opts = append(opts, repo.UpdateUserPrivate(profile.GetPrivate()))
+		case "default_workout_visibility":
+			opts = append(opts, repo.UpdateUserDefaultWorkoutVisibility(parser.WorkoutVisibilityFromPB(profile.GetDefaultWorkoutVisibility())))
+		default:
+			log.Error("invalid update mask path", zap.String("path", path))
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidUpdateMaskPath)
+		}
+	}
+
+	var approved orm.FollowRequestSlice
+	if err := h.repo.NewTx(ctx, func(tx repo.Tx) error {
+		if err := tx.UpdateUser(ctx, userID, opts...); err != nil {
+			return fmt.Errorf("update user: %w", err)
+		}
+
+		if bodyweight.Valid {
+			if err := tx.CreateBodyweight(ctx, userID, bodyweight.Float64); err != nil {
+				return fmt.Errorf("create bodyweight: %w", err)
+			}
+		}
+
+		user, err := tx.GetUser(ctx, repo.GetUserWithID(userID))
+		if err != nil {
+			return fmt.Errorf("get user: %w", err)
+		}
+
+		if user.Private {
+			return nil
+		}
+
+		// Pending requests are approved once the account is public since
+		// anyone can follow it.
+		if approved, err = tx.ApproveFollowRequests(ctx, userID); err != nil {
+			return fmt.Errorf("approve follow requests: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		log.Error("failed to update user", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	for _, request := range approved {
+		h.pubSub.Publish(ctx, orm.EventTopicFollowRequestApproved, payloads.FollowRequestApproved{
+			FollowerID: request.FollowerID,
+			FolloweeID: request.FolloweeID,
+		})
+	}
+
+	user, err := h.repo.GetUser(ctx, repo.GetUserWithID(userID))
+	if err != nil {
+		log.Error("failed to get user", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("profile updated")
+	return &connect.Response[apiv1.UpdateProfileResponse]{
+		Msg: &apiv1.UpdateProfileResponse{
+			Profile: parser.Profile(user),
+		},
+	}, nil
+}
+
+var (
+	ErrProfileIncomplete = errors.New("profile must have sex and bodyweight")
+	ErrLiftNotPerformed  = errors.New("lift has not been performed")
+)
+
+func (h *userHandler) GetStrengthScores(ctx context.Context, req *connect.Request[apiv1.GetStrengthScoresRequest]) (*connect.Response[apiv1.GetStrengthScoresResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldUserID(req.Msg.GetUserId()))
+	viewerID := xcontext.MustExtractUserID(ctx)
+
+	user, err := h.repo.GetUser(ctx, repo.GetUserWithID(req.Msg.GetUserId()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("user not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get user", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	visible, err := h.repo.CanViewUser(ctx, viewerID, user.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("user not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to check user visibility", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if !visible {
+		log.Warn("user is not visible to viewer")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	if !user.Sex.Valid || !user.Bodyweight.Valid {
+		log.Warn("profile incomplete")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrProfileIncomplete)
+	}
+
+	exercises, err := h.repo.ListExercises(ctx,
+		repo.ListExercisesWithUserID(user.ID),
+		repo.ListExercisesWithCatalogExercise(),
+		repo.ListExercisesWithoutDeleted(),
+	)
+	if err != nil {
+		log.Error("failed to list exercises", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if len(exercises) == 0 {
+		log.Warn("no exercises linked to the catalog")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrLiftNotPerformed)
+	}
+
+	exerciseIDs := make([]string, 0, len(exercises))
+	for _, exercise := range exercises {
+		exerciseIDs = append(exerciseIDs, exercise.ID)
+	}
+
+	sets, err := h.repo.ListSets(ctx,
+		repo.ListSetsWithUserID(user.ID),
+		repo.ListSetsWithExerciseID(exerciseIDs...),
+		repo.ListSetsVisibleTo(viewerID),
+		repo.ListSetsWithoutDeletedWorkouts(),
+		repo.ListSetsLoadExercise(),
+	)
+	if err != nil {
+		log.Error("failed to list sets", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	// Each lift is the set with the best estimated one rep max among the
+	// exercises the user linked to it in the catalog.
+	catalogBests := make(map[orm.CatalogExercise]*orm.Set, 3) //nolint:mnd
+	for _, set := range sets {
+		catalogExercise := set.R.Exercise.CatalogExercise.Val
+		best, ok := catalogBests[catalogExercise]
+		if !ok || strength.EstimatedOneRepMax(set.Weight, set.Reps) > strength.EstimatedOneRepMax(best.Weight, best.Reps) {
+			catalogBests[catalogExercise] = set
+		}
+	}
+
+	lifts := make([]*orm.Set, 0, 3) //nolint:mnd
+	for _, catalogExercise := range []orm.CatalogExercise{
+		orm.CatalogExerciseSquat,
+		orm.CatalogExerciseBenchPress,
+		orm.CatalogExerciseDeadlift,
+	} {
+		set, ok := catalogBests[catalogExercise]
+		if !ok {
+			log.Warn("lift not performed", zap.String("catalog_exercise", catalogExercise.String()))
+			return nil, connect.NewError(connect.CodeFailedPrecondition, ErrLiftNotPerformed)
+		}
+
+		lifts = append(lifts, set)
+	}
+
+	// The total is scored at the bodyweight recorded at the time of the latest
+	// lift, falling back to the current bodyweight if none was recorded.
+	liftedAt := lifts[0].CreatedAt
+	for _, set := range lifts[1:] {
+		if set.CreatedAt.After(liftedAt) {
+			liftedAt = set.CreatedAt
+		}
+	}
+
+	bodyweight := user.Bodyweight.Float64
+	recorded, err := h.repo.GetBodyweightAt(ctx, user.ID, liftedAt)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Error("failed to get bodyweight", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+	if recorded != nil {
+		bodyweight = recorded.Bodyweight
+	}
+
+	log.Info("strength scores computed")
+	return &connect.Response[apiv1.GetStrengthScoresResponse]{
+		Msg: &apiv1.GetStrengthScoresResponse{
+			Scores: parser.StrengthScores(user, bodyweight, lifts[0], lifts[1], lifts[2]),
+		},
+	}, nil
+}
+
+func (h *userHandler) GetYearSummary(ctx context.Context, req *connect.Request[apiv1.GetYearSummaryRequest]) (*connect.Response[apiv1.GetYearSummaryResponse], error) {
+	log := xcontext.MustExtractLogger(ctx).With(xzap.FieldUserID(req.Msg.GetUserId()))
+	viewerID := xcontext.MustExtractUserID(ctx)
+
+	visible, err := h.repo.CanViewUser(ctx, viewerID, req.Msg.GetUserId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("user not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to check user visibility", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if !visible {
+		log.Warn("user is not visible to viewer")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	summary, err := h.repo.GetYearSummary(ctx,
+		repo.GetYearSummaryWithUserID(req.Msg.GetUserId()),
+		repo.GetYearSummaryWithYear(int(req.Msg.GetYear())),
+		repo.GetYearSummaryLoadUser(),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("year summary not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get year summary", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	parsed, err := parser.YearSummary(summary)
+	if err != nil {
+		log.Error("failed to parse year summary", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	return &connect.Response[apiv1.GetYearSummaryResponse]{
+		Msg: &apiv1.GetYearSummaryResponse{
+			Summary: parsed,
+		},
+	}, nil
+}
+
+func (h *userHandler) ExportData(ctx context.Context, _ *connect.Request[apiv1.ExportDataRequest], res *connect.ServerStream[apiv1.ExportDataResponse]) error {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	workouts, err := h.repo.ListWorkouts(ctx,
+		repo.ListWorkoutsWithUserIDs(userID),
+		repo.ListWorkoutsWithLimit(export.MaxSyncWorkouts+1),
+	)
+	if err != nil {
+		log.Error("failed to list workouts", zap.Error(err))
+		return connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if len(workouts) > export.MaxSyncWorkouts {
+		dataExport, err := h.repo.CreateDataExport(ctx, userID)
+		if err != nil {
+			log.Error("failed to create data export", zap.Error(err))
+			return connect.NewError(connect.CodeInternal, nil)
+		}
+
+		h.pubSub.Publish(ctx, orm.EventTopicDataExportRequested, payloads.DataExportRequested{
+			ExportID: dataExport.ID,
+		})
+
+		if err = res.Send(&apiv1.ExportDataResponse{Queued: true}); err != nil {
+			log.Error("failed to send data export queued", zap.Error(err))
+			return connect.NewError(connect.CodeInternal, nil)
+		}
+
+		return nil
+	}
+
+	w := bufio.NewWriterSize(chunkWriter(func(chunk []byte) error {
+		return res.Send(&apiv1.ExportDataResponse{Chunk: chunk})
+	}), export.ChunkSize)
+
+	if err = h.exporter.Archive(ctx, userID, w); err != nil {
+		log.Error("failed to export data", zap.Error(err))
+		return connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if err = w.Flush(); err != nil {
+		log.Error("failed to flush data export", zap.Error(err))
+		return connect.NewError(connect.CodeInternal, nil)
+	}
+
+	return nil
+}
+
+func (h *userHandler) DownloadDataExport(ctx context.Context, req *connect.Request[apiv1.DownloadDataExportRequest], res *connect.ServerStream[apiv1.DownloadDataExportResponse]) error {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	dataExport, err := h.repo.GetDataExport(ctx,
+		repo.GetDataExportWithToken(req.Msg.GetToken()),
+		repo.GetDataExportWithUserID(userID),
+		repo.GetDataExportOnlyCompleted(),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("data export not found")
+			return connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get data export", zap.Error(err))
+		return connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if time.Since(dataExport.CompletedAt.Time) > export.Expiry {
+		log.Warn("data export expired")
+		return connect.NewError(connect.CodeNotFound, nil)
+	}
+
+	archive := dataExport.Archive.Bytes
+	for len(archive) > 0 {
+		n := min(len(archive), export.ChunkSize)
+		if err = res.Send(&apiv1.DownloadDataExportResponse{Chunk: archive[:n]}); err != nil {
+			log.Error("failed to send data export chunk", zap.Error(err))
+			return connect.NewError(connect.CodeInternal, nil)
+		}
+		archive = archive[n:]
+	}
+
+	return nil
+}
+
+func (h *userHandler) DeleteAccount(ctx context.Context, req *connect.Request[apiv1.DeleteAccountRequest]) (*connect.Response[apiv1.DeleteAccountResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	user, err := h.repo.GetUser(ctx,
+		repo.GetUserWithID(userID),
+		repo.GetUserWithoutDeleted(),
+		repo.GetUserLoadAuth(),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("user not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get user", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if err = h.repo.CompareEmailAndPassword(ctx, user.R.Auth.Email, req.Msg.GetPassword()); err != nil {
+		log.Warn("password invalid", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidCredentials)
+	}
+
+	requestedAt := time.Now().UTC()
+	if err = h.repo.NewTx(ctx, func(tx repo.Tx) error {
+		if err = tx.UpdateUser(ctx, userID, repo.UpdateUserDeletionRequestedAt(null.TimeFrom(requestedAt))); err != nil {
+			return fmt.Errorf("update user: %w", err)
+		}
+
+		if err = tx.UpdateAuth(ctx, user.R.Auth.ID, repo.UpdateAuthDeleteRefreshToken()); err != nil {
+			return fmt.Errorf("update auth: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		log.Error("failed to request account deletion", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("account deletion requested")
+	return &connect.Response[apiv1.DeleteAccountResponse]{
+		Msg: &apiv1.DeleteAccountResponse{
+			DeletesAt: timestamppb.New(requestedAt.Add(h.config.Jobs.AccountDeletionGracePeriod)),
+		},
+	}, nil
+}
+
+var ErrAccountDeletionNotRequested = errors.New("account deletion has not been requested")
+
+func (h *userHandler) CancelAccountDeletion(ctx context.Context, _ *connect.Request[apiv1.CancelAccountDeletionRequest]) (*connect.Response[apiv1.CancelAccountDeletionResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	user, err := h.repo.GetUser(ctx,
+		repo.GetUserWithID(userID),
+		repo.GetUserWithoutDeleted(),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("user not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get user", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if !user.DeletionRequestedAt.Valid {
+		log.Warn("account deletion not requested")
+		return nil, connect.NewError(connect.CodeFailedPrecondition, ErrAccountDeletionNotRequested)
+	}
+
+	if err = h.repo.UpdateUser(ctx, userID, repo.UpdateUserDeletionRequestedAt(null.Time{})); err != nil {
+		log.Error("failed to cancel account deletion", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("account deletion cancelled")
+	return &connect.Response[apiv1.CancelAccountDeletionResponse]{
+		Msg: &apiv1.CancelAccountDeletionResponse{},
+	}, nil
+}
+
+// chunkWriter is an io.Writer that hands each write to a send function. It is
+// meant to be wrapped in a bufio.Writer to control the chunk size.
+type chunkWriter func(chunk []byte) error
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	if err := w(p); err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,158 @@
+package v1_test
+
+import (
+	"context"
+	"log"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/crlssn/getstronger/server/config"
+	"github.com/crlssn/getstronger/server/gen/orm"
+	v1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+	"github.com/crlssn/getstronger/server/gen/proto/api/v1/apiv1connect"
+	"github.com/crlssn/getstronger/server/repo"
+	handlers "github.com/crlssn/getstronger/server/rpc/handlers/v1"
+	"github.com/crlssn/getstronger/server/testing/container"
+	"github.com/crlssn/getstronger/server/testing/factory"
+	"github.com/crlssn/getstronger/server/xcontext"
+)
+
+type userSuite struct {
+	suite.Suite
+
+	handler apiv1connect.UserServiceHandler
+
+	factory   *factory.Factory
+	container *container.Container
+}
+
+func TestUserSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(userSuite))
+}
+
+func (s *userSuite) SetupSuite() {
+	ctx := context.Background()
+	s.container = container.NewContainer(ctx)
+	s.factory = factory.NewFactory(s.container.DB)
+	s.handler = handlers.NewUserHandler(repo.New(s.container.DB), new(config.Config), nil, nil)
+
+	s.T().Cleanup(func() {
+		if err := s.container.Terminate(ctx); err != nil {
+			log.Fatalf("failed to clean container: %s", err)
+		}
+	})
+}
+
+func (s *userSuite) TestGetStrengthScores() {
+	type expected struct {
+		err        error
+		squat      float64
+		benchPress float64
+		deadlift   float64
+	}
+
+	type test struct {
+		name     string
+		init     func() (context.Context, *connect.Request[v1.GetStrengthScoresRequest])
+		expected expected
+	}
+
+	newLift := func(userID string, catalogExercise orm.CatalogExercise, weight float64, reps int) {
+		exercise := s.factory.NewExercise(
+			factory.ExerciseUserID(userID),
+			factory.ExerciseCatalogExercise(catalogExercise),
+		)
+		workout := s.factory.NewWorkout(factory.WorkoutUserID(userID))
+		s.factory.NewSet(
+			factory.SetUserID(userID),
+			factory.SetWorkoutID(workout.ID),
+			factory.SetExerciseID(exercise.ID),
+			factory.SetWeight(weight),
+			factory.SetReps(reps),
+		)
+	}
+
+	newRequest := func(userID string) (context.Context, *connect.Request[v1.GetStrengthScoresRequest]) {
+		ctx := xcontext.WithLogger(context.Background(), zap.NewExample())
+		ctx = xcontext.WithUserID(ctx, userID)
+		return ctx, connect.NewRequest(&v1.GetStrengthScoresRequest{UserId: userID})
+	}
+
+	tests := []test{
+		{
+			name: "ok_lifts_scored_by_estimated_one_rep_max",
+			init: func() (context.Context, *connect.Request[v1.GetStrengthScoresRequest]) {
+				user := s.factory.NewUser(factory.UserSex(orm.SexMale), factory.UserBodyweight(80))
+				newLift(user.ID, orm.CatalogExerciseSquat, 100, 1)
+				newLift(user.ID, orm.CatalogExerciseSquat, 95, 8)
+				newLift(user.ID, orm.CatalogExerciseBenchPress, 80, 1)
+				newLift(user.ID, orm.CatalogExerciseDeadlift, 140, 1)
+				return newRequest(user.ID)
+			},
+			expected: expected{
+				squat:      95,
+				benchPress: 80,
+				deadlift:   140,
+			},
+		},
+		{
+			name: "ok_unlinked_exercises_ignored",
+			init: func() (context.Context, *connect.Request[v1.GetStrengthScoresRequest]) {
+				user := s.factory.NewUser(factory.UserSex(orm.SexFemale), factory.UserBodyweight(60))
+				newLift(user.ID, orm.CatalogExerciseSquat, 80, 1)
+				newLift(user.ID, orm.CatalogExerciseBenchPress, 50, 1)
+				newLift(user.ID, orm.CatalogExerciseDeadlift, 100, 1)
+
+				exercise := s.factory.NewExercise(factory.ExerciseUserID(user.ID))
+				workout := s.factory.NewWorkout(factory.WorkoutUserID(user.ID))
+				s.factory.NewSet(
+					factory.SetUserID(user.ID),
+					factory.SetWorkoutID(workout.ID),
+					factory.SetExerciseID(exercise.ID),
+					factory.SetWeight(500),
+					factory.SetReps(1),
+				)
+				return newRequest(user.ID)
+			},
+			expected: expected{
+				squat:      80,
+				benchPress: 50,
+				deadlift:   100,
+			},
+		},
+		{
+			name: "err_lift_not_linked",
+			init: func() (context.Context, *connect.Request[v1.GetStrengthScoresRequest]) {
+				user := s.factory.NewUser(factory.UserSex(orm.SexMale), factory.UserBodyweight(80))
+				newLift(user.ID, orm.CatalogExerciseSquat, 100, 1)
+				newLift(user.ID, orm.CatalogExerciseBenchPress, 80, 1)
+				return newRequest(user.ID)
+			},
+			expected: expected{
+				err: connect.NewError(connect.CodeFailedPrecondition, handlers.ErrLiftNotPerformed),
+			},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			ctx, req := t.init()
+			res, err := s.handler.GetStrengthScores(ctx, req)
+			if t.expected.err != nil {
+				s.Require().Nil(res)
+				s.Require().Error(err)
+				s.Require().Equal(t.expected.err.Error(), err.Error())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().InEpsilon(t.expected.squat, res.Msg.GetScores().GetSquat().GetSet().GetWeight(), 0)
+			s.Require().InEpsilon(t.expected.benchPress, res.Msg.GetScores().GetBenchPress().GetSet().GetWeight(), 0)
+			s.Require().InEpsilon(t.expected.deadlift, res.Msg.GetScores().GetDeadlift().GetSet().GetWeight(), 0)
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package v1
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
@@ -13,6 +15,8 @@ import (
 	"github.com/crlssn/getstronger/server/gen/orm"
 	apiv1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
 	"github.com/crlssn/getstronger/server/gen/proto/api/v1/apiv1connect"
+	"github.com/crlssn/getstronger/server/importer"
+	"github.com/crlssn/getstronger/server/mention"
 	"github.com/crlssn/getstronger/server/pubsub"
 	"github.com/crlssn/getstronger/server/pubsub/payloads"
 	"github.com/crlssn/getstronger/server/repo"
@@ -23,12 +27,13 @@ import (
 var _ apiv1connect.WorkoutServiceHandler = (*workoutHandler)(nil)
 
 type workoutHandler struct {
-	repo   repo.Repo
-	pubSub *pubsub.PubSub
+	repo     repo.Repo
+	pubSub   *pubsub.PubSub
+	importer *importer.Importer
 }
 
-func NewWorkoutHandler(r repo.Repo, ps *pubsub.PubSub) apiv1connect.WorkoutServiceHandler {
-	return &workoutHandler{r, ps}
+func NewWorkoutHandler(r repo.Repo, ps *pubsub.PubSub, i *importer.Importer) apiv1connect.WorkoutServiceHandler {
+	return &workoutHandler{r, ps, i}
 }
 
 func (h *workoutHandler) CreateWorkout(ctx context.Context, req *connect.Request[apiv1.CreateWorkoutRequest]) (*connect.Response[apiv1.CreateWorkoutResponse], error) {
@@ -43,6 +48,7 @@ func (h *workoutHandler) CreateWorkout(ctx context.Context, req *connect.Request
 	routine, err := h.repo.GetRoutine(ctx,
 		repo.GetRoutineWithID(req.Msg.GetRoutineId()),
 		repo.GetRoutineWithUserID(userID),
+		repo.GetRoutineWithoutDeleted(),
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -54,6 +60,17 @@ func (h *workoutHandler) CreateWorkout(ctx context.Context, req *connect.Request
 		return nil, connect.NewError(connect.CodeInternal, nil)
 	}
 
+	var visibility orm.WorkoutVisibility
+	if req.Msg.GetVisibility() != apiv1.WorkoutVisibility_WORKOUT_VISIBILITY_UNSPECIFIED {
+		visibility = parser.WorkoutVisibilityFromPB(req.Msg.GetVisibility())
+	}
+
+	mentions, err := h.resolveMentions(ctx, userID, userID, visibility, req.Msg.GetNote())
+	if err != nil {
+		log.Error("failed to resolve mentions", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
 	workout, err := h.repo.CreateWorkout(ctx, repo.CreateWorkoutParams{
 		Name:         routine.Title,
 		Note:         req.Msg.GetNote(),
@@ -61,12 +78,24 @@ func (h *workoutHandler) CreateWorkout(ctx context.Context, req *connect.Request
 		StartedAt:    req.Msg.GetStartedAt().AsTime(),
 		FinishedAt:   req.Msg.GetFinishedAt().AsTime(),
 		ExerciseSets: parser.ExerciseSetsFromPB(req.Msg.GetExerciseSets()),
+		NoteMentions: mentions,
+		Visibility:   visibility,
 	})
 	if err != nil {
 		log.Error("failed to create workout", zap.Error(err))
 		return nil, connect.NewError(connect.CodeInternal, nil)
 	}
 
+	h.publishMentions(ctx, payloads.UsersMentioned{
+		ActorID:   userID,
+		WorkoutID: workout.ID,
+		UserIDs:   mention.UserIDs(mentions),
+	})
+
+	h.pubSub.Publish(ctx, orm.EventTopicWorkoutsCreated, payloads.WorkoutsCreated{
+		UserID: userID,
+	})
+
 	log.Info("workout finished")
 	return &connect.Response[apiv1.CreateWorkoutResponse]{
 		Msg: &apiv1.CreateWorkoutResponse{
@@ -77,15 +106,19 @@ func (h *workoutHandler) CreateWorkout(ctx context.Context, req *connect.Request
 
 func (h *workoutHandler) GetWorkout(ctx context.Context, req *connect.Request[apiv1.GetWorkoutRequest]) (*connect.Response[apiv1.GetWorkoutResponse], error) {
 	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
 
 	// TODO: Analyse query performance.
 	workout, err := h.repo.GetWorkout(ctx,
 		repo.GetWorkoutWithID(req.Msg.GetId()),
+		repo.GetWorkoutWithoutDeleted(),
+		repo.GetWorkoutWithoutHidden(),
 		repo.GetWorkoutLoadSets(),
 		repo.GetWorkoutLoadUser(),
-		repo.GetWorkoutLoadComments(),
+		repo.GetWorkoutLoadCommentsVisibleTo(userID),
 		repo.GetWorkoutLoadExercises(),
 		repo.GetWorkoutLoadCommentUsers(),
+		repo.GetWorkoutLoadReactions(),
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -97,6 +130,17 @@ func (h *workoutHandler) GetWorkout(ctx context.Context, req *connect.Request[ap
 		return nil, connect.NewError(connect.CodeInternal, nil)
 	}
 
+	visible, err := h.repo.CanViewWorkout(ctx, userID, workout)
+	if err != nil {
+		log.Error("failed to check workout visibility", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if !visible {
+		log.Warn("workout is not visible to viewer")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
 	personalBests, err := h.repo.GetPersonalBests(ctx, workout.UserID)
 	if err != nil {
 		log.Error("failed to get personal bests", zap.Error(err))
@@ -109,6 +153,7 @@ func (h *workoutHandler) GetWorkout(ctx context.Context, req *connect.Request[ap
 			Workout: parser.Workout(workout,
 				parser.WorkoutIntensity(workout.R.GetSets()),
 				parser.WorkoutExerciseSets(workout.R.GetSets(), personalBests),
+				parser.WorkoutReactions(workout.R.GetWorkoutReactions(), userID),
 			),
 		},
 	}, nil
@@ -116,15 +161,19 @@ func (h *workoutHandler) GetWorkout(ctx context.Context, req *connect.Request[ap
 
 func (h *workoutHandler) ListWorkouts(ctx context.Context, req *connect.Request[apiv1.ListWorkoutsRequest]) (*connect.Response[apiv1.ListWorkoutsResponse], error) {
 	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
 
 	limit := int(req.Msg.GetPagination().GetPageLimit())
 	workouts, err := h.repo.ListWorkouts(ctx,
 		repo.ListWorkoutsLoadSets(),
 		repo.ListWorkoutsLoadUser(),
 		repo.ListWorkoutsLoadExercises(),
+		repo.ListWorkoutsLoadReactions(),
 		repo.ListWorkoutsWithLimit(limit+1),
 		repo.ListWorkoutsWithUserIDs(req.Msg.GetUserIds()...),
+		repo.ListWorkoutsVisibleTo(userID),
 		repo.ListWorkoutsWithPageToken(req.Msg.GetPagination().GetPageToken()),
+		repo.ListWorkoutsWithoutDeleted(),
 	)
 	if err != nil {
 		log.Error("failed to list workouts", zap.Error(err))
@@ -145,7 +194,7 @@ func (h *workoutHandler) ListWorkouts(ctx context.Context, req *connect.Request[
 		return nil, connect.NewError(connect.CodeInternal, nil)
 	}
 
-	w, err := parser.WorkoutSlice(pagination.Items, personalBests)
+	w, err := parser.WorkoutSlice(pagination.Items, personalBests, userID)
 	if err != nil {
 		log.Error("failed to parse workouts", zap.Error(err))
 		return nil, connect.NewError(connect.CodeInternal, nil)
@@ -183,16 +232,111 @@ func (h *workoutHandler) DeleteWorkout(ctx context.Context, req *connect.Request
 	return &connect.Response[apiv1.DeleteWorkoutResponse]{}, nil
 }
 
+func (h *workoutHandler) RestoreWorkout(ctx context.Context, req *connect.Request[apiv1.RestoreWorkoutRequest]) (*connect.Response[apiv1.RestoreWorkoutResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	workout, err := h.repo.GetWorkout(ctx, repo.GetWorkoutWithID(req.Msg.GetId()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("workout not found")
+			return nil, connect.NewError(connect.CodeFailedPrecondition, nil)
+		}
+
+		log.Error("failed to get workout", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if workout.UserID != userID {
+		log.Error("workout does not belong to user")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	if err = h.repo.RestoreWorkout(ctx, workout.ID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("workout not deleted")
+			return nil, connect.NewError(connect.CodeFailedPrecondition, nil)
+		}
+
+		log.Error("failed to restore workout", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("workout restored")
+	return &connect.Response[apiv1.RestoreWorkoutResponse]{}, nil
+}
+
+var ErrParentCommentWorkoutMismatch = errors.New("parent comment belongs to another workout")
+
 func (h *workoutHandler) PostComment(ctx context.Context, req *connect.Request[apiv1.PostCommentRequest]) (*connect.Response[apiv1.PostCommentResponse], error) {
 	log := xcontext.MustExtractLogger(ctx)
 	userID := xcontext.MustExtractUserID(ctx)
 
+	workout, err := h.repo.GetWorkout(ctx,
+		repo.GetWorkoutWithID(req.Msg.GetWorkoutId()),
+		repo.GetWorkoutWithoutDeleted(),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("workout not found")
+			return nil, connect.NewError(connect.CodeFailedPrecondition, nil)
+		}
+
+		log.Error("failed to get workout", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	visible, err := h.repo.CanViewWorkout(ctx, userID, workout)
+	if err != nil {
+		log.Error("failed to check workout visibility", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if !visible {
+		log.Warn("workout is not visible to viewer")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	if req.Msg.ParentCommentId != nil {
+		parent, err := h.repo.GetWorkoutComment(ctx,
+			repo.GetWorkoutCommentWithID(req.Msg.GetParentCommentId()),
+			repo.GetWorkoutCommentWithoutHidden(),
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Warn("parent comment not found")
+				return nil, connect.NewError(connect.CodeFailedPrecondition, nil)
+			}
+
+			log.Error("failed to get parent comment", zap.Error(err))
+			return nil, connect.NewError(connect.CodeInternal, nil)
+		}
+
+		if parent.WorkoutID != req.Msg.GetWorkoutId() {
+			log.Warn("parent comment belongs to another workout")
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrParentCommentWorkoutMismatch)
+		}
+	}
+
+	mentions, err := h.resolveMentions(ctx, userID, workout.UserID, workout.Visibility, req.Msg.GetComment())
+	if err != nil {
+		log.Error("failed to resolve mentions", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
 	comment, err := h.repo.CreateWorkoutComment(ctx, repo.CreateWorkoutCommentParams{
-		UserID:    userID,
-		WorkoutID: req.Msg.GetWorkoutId(),
-		Comment:   req.Msg.GetComment(),
+		UserID:          userID,
+		WorkoutID:       req.Msg.GetWorkoutId(),
+		Comment:         req.Msg.GetComment(),
+		ParentCommentID: req.Msg.GetParentCommentId(),
+		Mentions:        mentions,
 	}, h.repo.PostCreateWorkoutCommentLoadUser(ctx))
 	if err != nil {
+		if errors.Is(err, repo.ErrUserBlocked) {
+			log.Warn("user is blocked")
+			return nil, connect.NewError(connect.CodePermissionDenied, nil)
+		}
+
 		log.Error("failed to create workout comment", zap.Error(err))
 		return nil, connect.NewError(connect.CodeInternal, nil)
 	}
@@ -201,6 +345,13 @@ func (h *workoutHandler) PostComment(ctx context.Context, req *connect.Request[a
 		CommentID: comment.ID,
 	})
 
+	h.publishMentions(ctx, payloads.UsersMentioned{
+		ActorID:   userID,
+		WorkoutID: comment.WorkoutID,
+		CommentID: comment.ID,
+		UserIDs:   mention.UserIDs(mentions),
+	})
+
 	log.Info("workout comment posted")
 	return &connect.Response[apiv1.PostCommentResponse]{
 		Msg: &apiv1.PostCommentResponse{
@@ -209,6 +360,103 @@ func (h *workoutHandler) PostComment(ctx context.Context, req *connect.Request[a
 	}, nil
 }
 
+func (h *workoutHandler) UpdateComment(ctx context.Context, req *connect.Request[apiv1.UpdateCommentRequest]) (*connect.Response[apiv1.UpdateCommentResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	comment, err := h.repo.GetWorkoutComment(ctx,
+		repo.GetWorkoutCommentWithID(req.Msg.GetId()),
+		repo.GetWorkoutCommentWithoutHidden(),
+		repo.GetWorkoutCommentWithWorkout(),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("workout comment not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get workout comment", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if comment.UserID != userID {
+		log.Warn("workout comment does not belong to user")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	mentions, err := h.resolveMentions(ctx, userID, comment.R.GetWorkout().UserID, comment.R.GetWorkout().Visibility, req.Msg.GetComment())
+	if err != nil {
+		log.Error("failed to resolve mentions", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if err = h.repo.UpdateWorkoutComment(ctx, comment.ID,
+		repo.UpdateWorkoutCommentText(req.Msg.GetComment()),
+		repo.UpdateWorkoutCommentMentions(mentions),
+	); err != nil {
+		log.Error("failed to update workout comment", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	h.publishMentions(ctx, payloads.UsersMentioned{
+		ActorID:   userID,
+		WorkoutID: comment.WorkoutID,
+		CommentID: comment.ID,
+		UserIDs:   newlyMentioned(comment.Mentions, mentions),
+	})
+
+	comment, err = h.repo.GetWorkoutComment(ctx,
+		repo.GetWorkoutCommentWithID(comment.ID),
+		repo.GetWorkoutCommentLoadUser(),
+	)
+	if err != nil {
+		log.Error("failed to get workout comment", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("workout comment updated")
+	return &connect.Response[apiv1.UpdateCommentResponse]{
+		Msg: &apiv1.UpdateCommentResponse{
+			Comment: parser.WorkoutComment(comment),
+		},
+	}, nil
+}
+
+func (h *workoutHandler) DeleteComment(ctx context.Context, req *connect.Request[apiv1.DeleteCommentRequest]) (*connect.Response[apiv1.DeleteCommentResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	comment, err := h.repo.GetWorkoutComment(ctx,
+		repo.GetWorkoutCommentWithID(req.Msg.GetId()),
+		repo.GetWorkoutCommentWithWorkout(),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("workout comment not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get workout comment", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	// The author and the owner of the workout can delete the comment.
+	if comment.UserID != userID && comment.R.GetWorkout().UserID != userID {
+		log.Warn("workout comment cannot be deleted by user")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	if err = h.repo.DeleteWorkoutComment(ctx, comment.ID); err != nil {
+		log.Error("failed to delete workout comment", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("workout comment deleted")
+	return &connect.Response[apiv1.DeleteCommentResponse]{
+		Msg: &apiv1.DeleteCommentResponse{},
+	}, nil
+}
+
 var ErrWorkoutMustStartBeforeFinish = errors.New("workout must start before it finishes")
 
 func (h *workoutHandler) UpdateWorkout(ctx context.Context, req *connect.Request[apiv1.UpdateWorkoutRequest]) (*connect.Response[apiv1.UpdateWorkoutResponse], error) {
@@ -220,7 +468,10 @@ func (h *workoutHandler) UpdateWorkout(ctx context.Context, req *connect.Request
 		return nil, connect.NewError(connect.CodeInvalidArgument, ErrWorkoutMustStartBeforeFinish)
 	}
 
-	workout, err := h.repo.GetWorkout(ctx, repo.GetWorkoutWithID(req.Msg.GetWorkout().GetId()))
+	workout, err := h.repo.GetWorkout(ctx,
+		repo.GetWorkoutWithID(req.Msg.GetWorkout().GetId()),
+		repo.GetWorkoutWithoutDeleted(),
+	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			log.Warn("workout not found", zap.Error(err))
@@ -236,12 +487,25 @@ func (h *workoutHandler) UpdateWorkout(ctx context.Context, req *connect.Request
 		return nil, connect.NewError(connect.CodePermissionDenied, nil)
 	}
 
+	visibility := workout.Visibility
+	if req.Msg.GetWorkout().GetVisibility() != apiv1.WorkoutVisibility_WORKOUT_VISIBILITY_UNSPECIFIED {
+		visibility = parser.WorkoutVisibilityFromPB(req.Msg.GetWorkout().GetVisibility())
+	}
+
+	mentions, err := h.resolveMentions(ctx, userID, userID, visibility, req.Msg.GetWorkout().GetNote())
+	if err != nil {
+		log.Error("failed to resolve mentions", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
 	if err = h.repo.NewTx(ctx, func(tx repo.Tx) error {
 		if err = tx.UpdateWorkout(ctx, workout.ID,
 			repo.UpdateWorkoutName(req.Msg.GetWorkout().GetName()),
 			repo.UpdateWorkoutNote(req.Msg.GetWorkout().GetNote()),
+			repo.UpdateWorkoutNoteMentions(mentions),
 			repo.UpdateWorkoutStartedAt(req.Msg.GetWorkout().GetStartedAt().AsTime()),
 			repo.UpdateWorkoutFinishedAt(req.Msg.GetWorkout().GetFinishedAt().AsTime()),
+			repo.UpdateWorkoutVisibility(visibility),
 		); err != nil {
 			return fmt.Errorf("failed to update workout: %w", err)
 		}
@@ -254,12 +518,427 @@ func (h *workoutHandler) UpdateWorkout(ctx context.Context, req *connect.Request
 			return fmt.Errorf("failed to update workout sets: %w", err)
 		}
 
+		// The sets were replaced, together with the personal records they set.
+		if err = tx.CreatePersonalRecords(ctx, userID); err != nil {
+			return fmt.Errorf("failed to create personal records: %w", err)
+		}
+
 		return nil
 	}); err != nil {
 		log.Error("failed to update workout", zap.Error(err))
 		return nil, connect.NewError(connect.CodeInternal, nil)
 	}
 
+	h.publishMentions(ctx, payloads.UsersMentioned{
+		ActorID:   userID,
+		WorkoutID: workout.ID,
+		UserIDs:   newlyMentioned(workout.NoteMentions, mentions),
+	})
+
 	log.Info("workout updated")
 	return &connect.Response[apiv1.UpdateWorkoutResponse]{}, nil
 }
+
+func (h *workoutHandler) CompareWorkouts(ctx context.Context, req *connect.Request[apiv1.CompareWorkoutsRequest]) (*connect.Response[apiv1.CompareWorkoutsResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	workouts := make([]*orm.Workout, 0, 2) //nolint:mnd
+	for _, workoutID := range []string{req.Msg.GetWorkoutId(), req.Msg.GetOtherWorkoutId()} {
+		workout, err := h.repo.GetWorkout(ctx,
+			repo.GetWorkoutWithID(workoutID),
+			repo.GetWorkoutWithoutDeleted(),
+			repo.GetWorkoutWithoutHidden(),
+			repo.GetWorkoutLoadSets(),
+			repo.GetWorkoutLoadUser(),
+			repo.GetWorkoutLoadExercises(),
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				log.Warn("workout not found", zap.String("workout_id", workoutID))
+				return nil, connect.NewError(connect.CodeNotFound, nil)
+			}
+
+			log.Error("failed to get workout", zap.Error(err))
+			return nil, connect.NewError(connect.CodeInternal, nil)
+		}
+
+		visible, err := h.repo.CanViewWorkout(ctx, userID, workout)
+		if err != nil {
+			log.Error("failed to check workout visibility", zap.Error(err))
+			return nil, connect.NewError(connect.CodeInternal, nil)
+		}
+
+		if !visible {
+			log.Warn("workout is not visible to viewer", zap.String("workout_id", workoutID))
+			return nil, connect.NewError(connect.CodePermissionDenied, nil)
+		}
+
+		// Comparing with another user's workout also requires a mutual follow.
+		if workout.UserID != userID {
+			mutual, err := h.followEachOther(ctx, workout.R.GetUser(), userID)
+			if err != nil {
+				log.Error("failed to check followers", zap.Error(err))
+				return nil, connect.NewError(connect.CodeInternal, nil)
+			}
+
+			if !mutual {
+				log.Warn("workout belongs to user who does not follow each other", zap.String("workout_id", workoutID))
+				return nil, connect.NewError(connect.CodePermissionDenied, nil)
+			}
+		}
+
+		workouts = append(workouts, workout)
+	}
+
+	personalBests, err := h.repo.GetPersonalBests(ctx, workouts[0].UserID, workouts[1].UserID)
+	if err != nil {
+		log.Error("failed to get personal bests", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	workout := parser.Workout(workouts[0], parser.WorkoutExerciseSets(workouts[0].R.GetSets(), personalBests))
+	other := parser.Workout(workouts[1], parser.WorkoutExerciseSets(workouts[1].R.GetSets(), personalBests))
+
+	log.Info("workouts compared")
+	return &connect.Response[apiv1.CompareWorkoutsResponse]{
+		Msg: &apiv1.CompareWorkoutsResponse{
+			Workout:      workout,
+			OtherWorkout: other,
+			Exercises:    parser.ExerciseComparisonSlice(workout, other),
+		},
+	}, nil
+}
+
+func (h *workoutHandler) followEachOther(ctx context.Context, user *orm.User, userID string) (bool, error) {
+	followed, err := h.repo.IsUserFollowedByUserID(ctx, user, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if user is followed: %w", err)
+	}
+
+	if !followed {
+		return false, nil
+	}
+
+	following, err := h.repo.IsUserFollowedByUserID(ctx, &orm.User{ID: userID}, user.ID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if user is following: %w", err)
+	}
+
+	return following, nil
+}
+
+var ErrInvalidTimezone = errors.New("invalid timezone")
+
+func (h *workoutHandler) ImportWorkouts(ctx context.Context, req *connect.Request[apiv1.ImportWorkoutsRequest]) (*connect.Response[apiv1.ImportWorkoutsResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	loc, err := time.LoadLocation(req.Msg.GetTimezone())
+	if err != nil {
+		log.Warn("invalid timezone", zap.String("timezone", req.Msg.GetTimezone()), zap.Error(err))
+		return nil, connect.NewError(connect.CodeInvalidArgument, ErrInvalidTimezone)
+	}
+
+	workouts, err := importer.Parse(parser.ImportFormatFromPB(req.Msg.GetFormat()), bytes.NewReader(req.Msg.GetFile()), loc)
+	if err != nil {
+		log.Warn("failed to parse import file", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := h.importer.Import(ctx, userID, workouts, req.Msg.GetDryRun())
+	if err != nil {
+		log.Error("failed to import workouts", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if !req.Msg.GetDryRun() && len(result.Workouts) > 0 {
+		h.pubSub.Publish(ctx, orm.EventTopicWorkoutsCreated, payloads.WorkoutsCreated{
+			UserID: userID,
+		})
+	}
+
+	log.Info("workouts imported",
+		zap.Bool("dry_run", req.Msg.GetDryRun()),
+		zap.Int("workouts", len(result.Workouts)),
+		zap.Int("conflicts", len(result.Conflicts)),
+	)
+	return &connect.Response[apiv1.ImportWorkoutsResponse]{
+		Msg: &apiv1.ImportWorkoutsResponse{
+			Workouts:     int32(len(result.Workouts)), //nolint:gosec
+			Sets:         int32(result.Sets()),        //nolint:gosec
+			NewExercises: result.NewExercises,
+			Conflicts:    parser.ImportConflicts(result.Conflicts),
+		},
+	}, nil
+}
+
+func (h *workoutHandler) ReactToWorkout(ctx context.Context, req *connect.Request[apiv1.ReactToWorkoutRequest]) (*connect.Response[apiv1.ReactToWorkoutResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	workout, err := h.repo.GetWorkout(ctx,
+		repo.GetWorkoutWithID(req.Msg.GetWorkoutId()),
+		repo.GetWorkoutWithoutDeleted(),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("workout not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get workout", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	visible, err := h.repo.CanViewWorkout(ctx, userID, workout)
+	if err != nil {
+		log.Error("failed to check workout visibility", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if !visible {
+		log.Warn("workout is not visible to viewer")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	reaction, err := h.repo.UpsertWorkoutReaction(ctx, repo.UpsertWorkoutReactionParams{
+		UserID:    userID,
+		WorkoutID: req.Msg.GetWorkoutId(),
+		Type:      parser.ReactionTypeFromPB(req.Msg.GetType()),
+	})
+	if err != nil {
+		log.Error("failed to upsert workout reaction", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	h.pubSub.Publish(ctx, orm.EventTopicWorkoutReacted, payloads.WorkoutReacted{
+		ReactionID: reaction.ID,
+	})
+
+	log.Info("workout reacted to")
+	return &connect.Response[apiv1.ReactToWorkoutResponse]{
+		Msg: &apiv1.ReactToWorkoutResponse{},
+	}, nil
+}
+
+func (h *workoutHandler) RemoveReaction(ctx context.Context, req *connect.Request[apiv1.RemoveReactionRequest]) (*connect.Response[apiv1.RemoveReactionResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	workout, err := h.repo.GetWorkout(ctx, repo.GetWorkoutWithID(req.Msg.GetWorkoutId()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("workout not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get workout", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if err = h.repo.NewTx(ctx, func(tx repo.Tx) error {
+		if err = tx.DeleteWorkoutReaction(ctx, userID, workout.ID); err != nil {
+			return fmt.Errorf("delete workout reaction: %w", err)
+		}
+
+		if workout.UserID == userID {
+			// Own reactions aren't notified.
+			return nil
+		}
+
+		reactions, listErr := tx.ListWorkoutReactions(ctx, repo.ListWorkoutReactionsWithWorkoutID(workout.ID))
+		if listErr != nil {
+			return fmt.Errorf("list workout reactions: %w", listErr)
+		}
+
+		// Reactions are listed newest first, so the actor is the latest
+		// remaining reactor.
+		var actorID string
+		var count int
+		for _, reaction := range reactions {
+			if reaction.UserID == workout.UserID {
+				continue
+			}
+			if actorID == "" {
+				actorID = reaction.UserID
+			}
+			count++
+		}
+
+		if count == 0 {
+			if err = tx.DeleteGroupedNotifications(ctx, workout.UserID, orm.NotificationTypeWorkoutReaction, workout.ID); err != nil {
+				return fmt.Errorf("delete notifications: %w", err)
+			}
+			return nil
+		}
+
+		if err = tx.UpdateGroupedNotification(ctx, repo.CreateNotificationParams{
+			Type:   orm.NotificationTypeWorkoutReaction,
+			UserID: workout.UserID,
+			Payload: repo.NotificationPayload{
+				ActorID:   actorID,
+				WorkoutID: workout.ID,
+				Reactions: count,
+			},
+		}); err != nil {
+			return fmt.Errorf("update notification: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("workout reaction not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to delete workout reaction", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("workout reaction removed")
+	return &connect.Response[apiv1.RemoveReactionResponse]{
+		Msg: &apiv1.RemoveReactionResponse{},
+	}, nil
+}
+
+func (h *workoutHandler) ListReactions(ctx context.Context, req *connect.Request[apiv1.ListReactionsRequest]) (*connect.Response[apiv1.ListReactionsResponse], error) {
+	log := xcontext.MustExtractLogger(ctx)
+	userID := xcontext.MustExtractUserID(ctx)
+
+	workout, err := h.repo.GetWorkout(ctx,
+		repo.GetWorkoutWithID(req.Msg.GetWorkoutId()),
+		repo.GetWorkoutWithoutDeleted(),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("workout not found")
+			return nil, connect.NewError(connect.CodeNotFound, nil)
+		}
+
+		log.Error("failed to get workout", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	visible, err := h.repo.CanViewWorkout(ctx, userID, workout)
+	if err != nil {
+		log.Error("failed to check workout visibility", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if !visible {
+		log.Warn("workout is not visible to viewer")
+		return nil, connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	reactions, err := h.repo.ListWorkoutReactions(ctx,
+		repo.ListWorkoutReactionsWithWorkoutID(req.Msg.GetWorkoutId()),
+		repo.ListWorkoutReactionsLoadUser(),
+	)
+	if err != nil {
+		log.Error("failed to list workout reactions", zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, nil)
+	}
+
+	log.Info("workout reactions listed")
+	return &connect.Response[apiv1.ListReactionsResponse]{
+		Msg: &apiv1.ListReactionsResponse{
+			Reactions: parser.ReactionSlice(reactions),
+		},
+	}, nil
+}
+
+// resolveMentions returns the mentions in the text of users who follow, or are
+// followed by, the author. Only users who can see the workout can be mentioned,
+// which depends on its visibility, whether the owner is private and whether the
+// owner has blocked, or is blocked by, the user. An empty visibility means the
+// owner's default workout visibility.
+func (h *workoutHandler) resolveMentions(ctx context.Context, authorID, ownerID string, visibility orm.WorkoutVisibility, text string) ([]mention.Mention, error) {
+	if !strings.Contains(text, "@") {
+		return nil, nil
+	}
+
+	followers, err := h.repo.ListFollowers(ctx, authorID, repo.ListFollowersWithoutBlocked(ownerID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list followers: %w", err)
+	}
+
+	followees, err := h.repo.ListFollowees(ctx, authorID, repo.ListFolloweesWithoutBlocked(ownerID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list followees: %w", err)
+	}
+
+	owner, err := h.repo.GetUser(ctx, repo.GetUserWithID(ownerID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get owner: %w", err)
+	}
+
+	if visibility == "" {
+		visibility = owner.DefaultWorkoutVisibility
+	}
+
+	var visible map[string]struct{}
+	switch {
+	case visibility == orm.WorkoutVisibilityPrivate:
+		visible = map[string]struct{}{ownerID: {}}
+	case owner.Private || visibility == orm.WorkoutVisibilityFollowers:
+		ownerFollowers, err := h.repo.ListFollowers(ctx, ownerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list owner followers: %w", err)
+		}
+
+		visible = map[string]struct{}{ownerID: {}}
+		for _, follower := range ownerFollowers {
+			visible[follower.ID] = struct{}{}
+		}
+	}
+
+	// A mutual follow is both a follower and a followee, but must only be a
+	// candidate once for their first name to resolve.
+	candidates := make(map[string]struct{}, len(followers)+len(followees))
+	users := make([]mention.User, 0, len(followers)+len(followees))
+	for _, user := range append(followers, followees...) {
+		if _, ok := candidates[user.ID]; ok {
+			continue
+		}
+		candidates[user.ID] = struct{}{}
+
+		if _, ok := visible[user.ID]; visible != nil && !ok {
+			continue
+		}
+
+		users = append(users, mention.User{
+			ID:        user.ID,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+		})
+	}
+
+	return mention.Parse(text, users), nil
+}
+
+func (h *workoutHandler) publishMentions(ctx context.Context, payload payloads.UsersMentioned) {
+	if len(payload.UserIDs) == 0 {
+		return
+	}
+
+	h.pubSub.Publish(ctx, orm.EventTopicUsersMentioned, payload)
+}
+
+// newlyMentioned returns the ids of the users in mentions that were not already
+// mentioned in the stored mentions, so that edits don't notify users twice.
+func newlyMentioned(stored []byte, mentions []mention.Mention) []string {
+	previous := make(map[string]struct{})
+	for _, m := range parser.Mentions(stored) {
+		previous[m.GetUserId()] = struct{}{}
+	}
+
+	var userIDs []string
+	for _, userID := range mention.UserIDs(mentions) {
+		if _, ok := previous[userID]; !ok {
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	return userIDs
+}
@@ -2,6 +2,7 @@ package v1_test
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 	"testing"
 	"time"
@@ -9,12 +10,16 @@ import (
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/crlssn/getstronger/server/gen/orm"
 	apiv1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
 	"github.com/crlssn/getstronger/server/gen/proto/api/v1/apiv1connect"
+	"github.com/crlssn/getstronger/server/importer"
+	"github.com/crlssn/getstronger/server/pubsub"
 	"github.com/crlssn/getstronger/server/repo"
 	handlers "github.com/crlssn/getstronger/server/rpc/handlers/v1"
 	"github.com/crlssn/getstronger/server/testing/container"
@@ -40,7 +45,10 @@ func (s *workoutSuite) SetupSuite() {
 	ctx := context.Background()
 	s.container = container.NewContainer(ctx)
 	s.factory = factory.NewFactory(s.container.DB)
-	s.handler = handlers.NewWorkoutHandler(repo.New(s.container.DB), nil)
+	s.handler = handlers.NewWorkoutHandler(repo.New(s.container.DB), pubsub.New(pubsub.Params{
+		Log:  zap.NewNop(),
+		Repo: repo.New(s.container.DB),
+	}), importer.New(repo.New(s.container.DB)))
 
 	s.T().Cleanup(func() {
 		if err := s.container.Terminate(ctx); err != nil {
@@ -174,3 +182,727 @@ func (s *workoutSuite) TestCreateWorkout() {
 		})
 	}
 }
+
+func (s *workoutSuite) TestCompareWorkouts() {
+	type expected struct {
+		err       error
+		exercises int
+	}
+
+	type test struct {
+		name     string
+		init     func(userID string) *connect.Request[apiv1.CompareWorkoutsRequest]
+		expected expected
+	}
+
+	r := repo.New(s.container.DB)
+	newWorkout := func(userID, exerciseName string, weights ...float64) *orm.Workout {
+		workout := s.factory.NewWorkout(factory.WorkoutUserID(userID))
+		exercise := s.factory.NewExercise(factory.ExerciseUserID(userID), factory.ExerciseTitle(exerciseName))
+		for _, weight := range weights {
+			s.factory.NewSet(
+				factory.SetUserID(userID),
+				factory.SetWorkoutID(workout.ID),
+				factory.SetExerciseID(exercise.ID),
+				factory.SetWeight(weight),
+			)
+		}
+		return workout
+	}
+
+	tests := []test{
+		{
+			name: "ok_own_workouts",
+			init: func(userID string) *connect.Request[apiv1.CompareWorkoutsRequest] {
+				return connect.NewRequest(&apiv1.CompareWorkoutsRequest{
+					WorkoutId:      newWorkout(userID, "Squat", 100, 100).ID,
+					OtherWorkoutId: newWorkout(userID, "Bench Press", 80).ID,
+				})
+			},
+			expected: expected{
+				exercises: 2,
+			},
+		},
+		{
+			name: "ok_mutual_followers",
+			init: func(userID string) *connect.Request[apiv1.CompareWorkoutsRequest] {
+				partner := s.factory.NewUser()
+				s.Require().NoError(r.Follow(context.Background(), repo.FollowParams{FollowerID: userID, FolloweeID: partner.ID}))
+				s.Require().NoError(r.Follow(context.Background(), repo.FollowParams{FollowerID: partner.ID, FolloweeID: userID}))
+
+				return connect.NewRequest(&apiv1.CompareWorkoutsRequest{
+					WorkoutId:      newWorkout(userID, "Squat", 100).ID,
+					OtherWorkoutId: newWorkout(partner.ID, "squat", 110, 110).ID,
+				})
+			},
+			expected: expected{
+				exercises: 1,
+			},
+		},
+		{
+			name: "err_not_mutual_followers",
+			init: func(userID string) *connect.Request[apiv1.CompareWorkoutsRequest] {
+				other := s.factory.NewUser()
+				s.Require().NoError(r.Follow(context.Background(), repo.FollowParams{FollowerID: userID, FolloweeID: other.ID}))
+
+				return connect.NewRequest(&apiv1.CompareWorkoutsRequest{
+					WorkoutId:      newWorkout(userID, "Squat", 100).ID,
+					OtherWorkoutId: newWorkout(other.ID, "Squat", 100).ID,
+				})
+			},
+			expected: expected{
+				err: connect.NewError(connect.CodePermissionDenied, nil),
+			},
+		},
+		{
+			name: "err_workout_hidden",
+			init: func(userID string) *connect.Request[apiv1.CompareWorkoutsRequest] {
+				hidden := newWorkout(userID, "Squat", 100)
+				s.Require().NoError(r.HideWorkout(context.Background(), hidden.ID))
+
+				return connect.NewRequest(&apiv1.CompareWorkoutsRequest{
+					WorkoutId:      newWorkout(userID, "Squat", 100).ID,
+					OtherWorkoutId: hidden.ID,
+				})
+			},
+			expected: expected{
+				err: connect.NewError(connect.CodeNotFound, nil),
+			},
+		},
+		{
+			name: "err_workout_not_found",
+			init: func(userID string) *connect.Request[apiv1.CompareWorkoutsRequest] {
+				return connect.NewRequest(&apiv1.CompareWorkoutsRequest{
+					WorkoutId:      newWorkout(userID, "Squat", 100).ID,
+					OtherWorkoutId: uuid.NewString(),
+				})
+			},
+			expected: expected{
+				err: connect.NewError(connect.CodeNotFound, nil),
+			},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			user := s.factory.NewUser()
+			ctx := xcontext.WithUserID(context.Background(), user.ID)
+			ctx = xcontext.WithLogger(ctx, zap.NewExample())
+
+			res, err := s.handler.CompareWorkouts(ctx, t.init(user.ID))
+			if t.expected.err != nil {
+				s.Require().Nil(res)
+				s.Require().Error(err)
+				s.Require().Equal(t.expected.err.Error(), err.Error())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().NotNil(res.Msg.GetWorkout())
+			s.Require().NotNil(res.Msg.GetOtherWorkout())
+			s.Require().Len(res.Msg.GetExercises(), t.expected.exercises)
+		})
+	}
+}
+
+func (s *workoutSuite) TestRestoreWorkout() {
+	type expected struct {
+		err error
+	}
+
+	type test struct {
+		name     string
+		init     func(userID string) *connect.Request[apiv1.RestoreWorkoutRequest]
+		expected expected
+	}
+
+	r := repo.New(s.container.DB)
+	newDeletedWorkout := func(userID string) *orm.Workout {
+		workout := s.factory.NewWorkout(factory.WorkoutUserID(userID))
+		s.Require().NoError(r.DeleteWorkout(context.Background(), repo.DeleteWorkoutWithID(workout.ID)))
+		return workout
+	}
+
+	tests := []test{
+		{
+			name: "ok",
+			init: func(userID string) *connect.Request[apiv1.RestoreWorkoutRequest] {
+				return connect.NewRequest(&apiv1.RestoreWorkoutRequest{
+					Id: newDeletedWorkout(userID).ID,
+				})
+			},
+			expected: expected{
+				err: nil,
+			},
+		},
+		{
+			name: "err_workout_not_deleted",
+			init: func(userID string) *connect.Request[apiv1.RestoreWorkoutRequest] {
+				return connect.NewRequest(&apiv1.RestoreWorkoutRequest{
+					Id: s.factory.NewWorkout(factory.WorkoutUserID(userID)).ID,
+				})
+			},
+			expected: expected{
+				err: connect.NewError(connect.CodeFailedPrecondition, nil),
+			},
+		},
+		{
+			name: "err_workout_belongs_to_another_user",
+			init: func(_ string) *connect.Request[apiv1.RestoreWorkoutRequest] {
+				return connect.NewRequest(&apiv1.RestoreWorkoutRequest{
+					Id: newDeletedWorkout(s.factory.NewUser().ID).ID,
+				})
+			},
+			expected: expected{
+				err: connect.NewError(connect.CodePermissionDenied, nil),
+			},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			user := s.factory.NewUser()
+			ctx := xcontext.WithUserID(context.Background(), user.ID)
+			ctx = xcontext.WithLogger(ctx, zap.NewExample())
+
+			req := t.init(user.ID)
+			res, err := s.handler.RestoreWorkout(ctx, req)
+			if t.expected.err != nil {
+				s.Require().Nil(res)
+				s.Require().Error(err)
+				s.Require().Equal(t.expected.err.Error(), err.Error())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			workout, err := orm.FindWorkout(context.Background(), s.container.DB, req.Msg.GetId())
+			s.Require().NoError(err)
+			s.Require().False(workout.DeletedAt.Valid)
+		})
+	}
+}
+
+func (s *workoutSuite) TestImportWorkouts() {
+	file := []byte(`Date,Workout Name,Duration,Exercise Name,Set Order,Weight,Reps,Distance,Seconds,Notes,Workout Notes,RPE
+2024-01-02 08:00:00,Push,1h 5m,Bench Press,1,100,5,0,0,,,
+2024-01-02 08:00:00,Push,1h 5m,Bench Press,2,100,5,0,0,,,
+2024-01-02 08:00:00,Push,1h 5m,Overhead Press,1,60,8,0,0,,,
+2024-01-04 08:00:00,Cardio,30m,Running,1,,,5,1800,,,
+`)
+
+	user := s.factory.NewUser()
+	exercise := s.factory.NewExercise(
+		factory.ExerciseUserID(user.ID),
+		factory.ExerciseTitle("bench press"),
+	)
+
+	ctx := xcontext.WithUserID(context.Background(), user.ID)
+	ctx = xcontext.WithLogger(ctx, zap.NewExample())
+
+	importWorkouts := func(dryRun bool) *apiv1.ImportWorkoutsResponse {
+		res, err := s.handler.ImportWorkouts(ctx, connect.NewRequest(&apiv1.ImportWorkoutsRequest{
+			Format:   apiv1.ImportFormat_IMPORT_FORMAT_STRONG,
+			File:     file,
+			DryRun:   dryRun,
+			Timezone: "Europe/Stockholm",
+		}))
+		s.Require().NoError(err)
+		return res.Msg
+	}
+
+	countWorkouts := func() int64 {
+		count, err := orm.Workouts(orm.WorkoutWhere.UserID.EQ(user.ID)).Count(context.Background(), s.container.DB)
+		s.Require().NoError(err)
+		return count
+	}
+
+	s.Run("ok_dry_run", func() {
+		res := importWorkouts(true)
+		s.Require().Equal(int32(1), res.GetWorkouts())
+		s.Require().Equal(int32(3), res.GetSets())
+		s.Require().Equal([]string{"Overhead Press"}, res.GetNewExercises())
+		s.Require().Len(res.GetConflicts(), 1)
+		s.Require().Equal(apiv1.ImportConflictReason_IMPORT_CONFLICT_REASON_WITHOUT_SETS, res.GetConflicts()[0].GetReason())
+		s.Require().Equal(int64(0), countWorkouts())
+	})
+
+	s.Run("ok_import", func() {
+		res := importWorkouts(false)
+		s.Require().Equal(int32(1), res.GetWorkouts())
+		s.Require().Equal(int64(1), countWorkouts())
+
+		sets, err := orm.Sets(orm.SetWhere.ExerciseID.EQ(exercise.ID)).Count(context.Background(), s.container.DB)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(2), sets)
+
+		// The export's local times are read in the user's time zone, and the
+		// workout is backdated to when it finished.
+		workout, err := orm.Workouts(orm.WorkoutWhere.UserID.EQ(user.ID)).One(context.Background(), s.container.DB)
+		s.Require().NoError(err)
+		s.Require().True(time.Date(2024, time.January, 2, 7, 0, 0, 0, time.UTC).Equal(workout.StartedAt))
+		s.Require().True(workout.FinishedAt.Equal(workout.CreatedAt))
+	})
+
+	s.Run("ok_import_is_idempotent", func() {
+		res := importWorkouts(false)
+		s.Require().Equal(int32(0), res.GetWorkouts())
+		s.Require().Empty(res.GetNewExercises())
+		s.Require().Len(res.GetConflicts(), 2)
+		s.Require().Equal(apiv1.ImportConflictReason_IMPORT_CONFLICT_REASON_ALREADY_IMPORTED, res.GetConflicts()[0].GetReason())
+		s.Require().Equal(int64(1), countWorkouts())
+	})
+
+	s.Run("err_invalid_timezone", func() {
+		res, err := s.handler.ImportWorkouts(ctx, connect.NewRequest(&apiv1.ImportWorkoutsRequest{
+			Format:   apiv1.ImportFormat_IMPORT_FORMAT_STRONG,
+			File:     file,
+			Timezone: "Mars/Olympus_Mons",
+		}))
+		s.Require().Nil(res)
+		s.Require().Equal(connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+
+	s.Run("err_invalid_file", func() {
+		res, err := s.handler.ImportWorkouts(ctx, connect.NewRequest(&apiv1.ImportWorkoutsRequest{
+			Format: apiv1.ImportFormat_IMPORT_FORMAT_HEVY,
+			File:   file,
+		}))
+		s.Require().Nil(res)
+		s.Require().Equal(connect.CodeInvalidArgument, connect.CodeOf(err))
+	})
+}
+
+func (s *workoutSuite) TestPostComment() {
+	type expected struct {
+		err error
+	}
+
+	type test struct {
+		name     string
+		init     func() *connect.Request[apiv1.PostCommentRequest]
+		expected expected
+	}
+
+	tests := []test{
+		{
+			name: "err_parent_comment_not_found",
+			init: func() *connect.Request[apiv1.PostCommentRequest] {
+				return connect.NewRequest(&apiv1.PostCommentRequest{
+					WorkoutId:       s.factory.NewWorkout().ID,
+					Comment:         "reply",
+					ParentCommentId: proto.String(uuid.NewString()),
+				})
+			},
+			expected: expected{
+				err: connect.NewError(connect.CodeFailedPrecondition, nil),
+			},
+		},
+		{
+			name: "err_parent_comment_belongs_to_another_workout",
+			init: func() *connect.Request[apiv1.PostCommentRequest] {
+				return connect.NewRequest(&apiv1.PostCommentRequest{
+					WorkoutId:       s.factory.NewWorkout().ID,
+					Comment:         "reply",
+					ParentCommentId: proto.String(s.factory.NewWorkoutComment().ID),
+				})
+			},
+			expected: expected{
+				err: connect.NewError(connect.CodeInvalidArgument, handlers.ErrParentCommentWorkoutMismatch),
+			},
+		},
+		{
+			name: "err_workout_owner_private",
+			init: func() *connect.Request[apiv1.PostCommentRequest] {
+				owner := s.factory.NewUser(factory.UserPrivate(true))
+				return connect.NewRequest(&apiv1.PostCommentRequest{
+					WorkoutId: s.factory.NewWorkout(factory.WorkoutUserID(owner.ID)).ID,
+					Comment:   "comment",
+				})
+			},
+			expected: expected{
+				err: connect.NewError(connect.CodePermissionDenied, nil),
+			},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			user := s.factory.NewUser()
+			ctx := xcontext.WithUserID(context.Background(), user.ID)
+			ctx = xcontext.WithLogger(ctx, zap.NewExample())
+
+			res, err := s.handler.PostComment(ctx, t.init())
+			s.Require().Nil(res)
+			s.Require().Error(err)
+			s.Require().Equal(t.expected.err.Error(), err.Error())
+		})
+	}
+}
+
+func (s *workoutSuite) TestPostComment_MentionsMutualFollow() {
+	r := repo.New(s.container.DB)
+	user := s.factory.NewUser()
+	friend := s.factory.NewUser(factory.UserFirstName("Jordan"))
+	s.Require().NoError(r.Follow(context.Background(), repo.FollowParams{FollowerID: user.ID, FolloweeID: friend.ID}))
+	s.Require().NoError(r.Follow(context.Background(), repo.FollowParams{FollowerID: friend.ID, FolloweeID: user.ID}))
+
+	ctx := xcontext.WithUserID(context.Background(), user.ID)
+	ctx = xcontext.WithLogger(ctx, zap.NewExample())
+
+	res, err := s.handler.PostComment(ctx, connect.NewRequest(&apiv1.PostCommentRequest{
+		WorkoutId: s.factory.NewWorkout(factory.WorkoutUserID(user.ID)).ID,
+		Comment:   "nice one @Jordan",
+	}))
+	s.Require().NoError(err)
+	s.Require().Len(res.Msg.GetComment().GetMentions(), 1)
+	s.Require().Equal(friend.ID, res.Msg.GetComment().GetMentions()[0].GetUserId())
+}
+
+func (s *workoutSuite) TestUpdateComment() {
+	type expected struct {
+		err error
+	}
+
+	type test struct {
+		name     string
+		init     func(userID string) *connect.Request[apiv1.UpdateCommentRequest]
+		expected expected
+	}
+
+	tests := []test{
+		{
+			name: "ok",
+			init: func(userID string) *connect.Request[apiv1.UpdateCommentRequest] {
+				return connect.NewRequest(&apiv1.UpdateCommentRequest{
+					Id:      s.factory.NewWorkoutComment(factory.WorkoutCommentUserID(userID)).ID,
+					Comment: "edited",
+				})
+			},
+			expected: expected{
+				err: nil,
+			},
+		},
+		{
+			name: "err_comment_belongs_to_another_user",
+			init: func(_ string) *connect.Request[apiv1.UpdateCommentRequest] {
+				return connect.NewRequest(&apiv1.UpdateCommentRequest{
+					Id:      s.factory.NewWorkoutComment().ID,
+					Comment: "edited",
+				})
+			},
+			expected: expected{
+				err: connect.NewError(connect.CodePermissionDenied, nil),
+			},
+		},
+		{
+			name: "err_comment_not_found",
+			init: func(_ string) *connect.Request[apiv1.UpdateCommentRequest] {
+				return connect.NewRequest(&apiv1.UpdateCommentRequest{
+					Id:      uuid.NewString(),
+					Comment: "edited",
+				})
+			},
+			expected: expected{
+				err: connect.NewError(connect.CodeNotFound, nil),
+			},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			user := s.factory.NewUser()
+			ctx := xcontext.WithUserID(context.Background(), user.ID)
+			ctx = xcontext.WithLogger(ctx, zap.NewExample())
+
+			res, err := s.handler.UpdateComment(ctx, t.init(user.ID))
+			if t.expected.err != nil {
+				s.Require().Nil(res)
+				s.Require().Error(err)
+				s.Require().Equal(t.expected.err.Error(), err.Error())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal("edited", res.Msg.GetComment().GetComment())
+			s.Require().NotNil(res.Msg.GetComment().GetEditedAt())
+			s.Require().Equal(user.ID, res.Msg.GetComment().GetUser().GetId())
+		})
+	}
+}
+
+func (s *workoutSuite) TestDeleteComment() {
+	type expected struct {
+		err error
+	}
+
+	type test struct {
+		name     string
+		init     func(userID string) *connect.Request[apiv1.DeleteCommentRequest]
+		expected expected
+	}
+
+	tests := []test{
+		{
+			name: "ok_author",
+			init: func(userID string) *connect.Request[apiv1.DeleteCommentRequest] {
+				return connect.NewRequest(&apiv1.DeleteCommentRequest{
+					Id: s.factory.NewWorkoutComment(factory.WorkoutCommentUserID(userID)).ID,
+				})
+			},
+			expected: expected{
+				err: nil,
+			},
+		},
+		{
+			name: "ok_workout_owner",
+			init: func(userID string) *connect.Request[apiv1.DeleteCommentRequest] {
+				workout := s.factory.NewWorkout(factory.WorkoutUserID(userID))
+				return connect.NewRequest(&apiv1.DeleteCommentRequest{
+					Id: s.factory.NewWorkoutComment(factory.WorkoutCommentWorkoutID(workout.ID)).ID,
+				})
+			},
+			expected: expected{
+				err: nil,
+			},
+		},
+		{
+			name: "err_comment_belongs_to_another_user",
+			init: func(_ string) *connect.Request[apiv1.DeleteCommentRequest] {
+				return connect.NewRequest(&apiv1.DeleteCommentRequest{
+					Id: s.factory.NewWorkoutComment().ID,
+				})
+			},
+			expected: expected{
+				err: connect.NewError(connect.CodePermissionDenied, nil),
+			},
+		},
+		{
+			name: "err_comment_not_found",
+			init: func(_ string) *connect.Request[apiv1.DeleteCommentRequest] {
+				return connect.NewRequest(&apiv1.DeleteCommentRequest{
+					Id: uuid.NewString(),
+				})
+			},
+			expected: expected{
+				err: connect.NewError(connect.CodeNotFound, nil),
+			},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			user := s.factory.NewUser()
+			ctx := xcontext.WithUserID(context.Background(), user.ID)
+			ctx = xcontext.WithLogger(ctx, zap.NewExample())
+
+			req := t.init(user.ID)
+			s.factory.NewNotification(factory.NotificationPayload(repo.NotificationPayload{
+				CommentID: req.Msg.GetId(),
+			}))
+
+			res, err := s.handler.DeleteComment(ctx, req)
+			if t.expected.err != nil {
+				s.Require().Nil(res)
+				s.Require().Error(err)
+				s.Require().Equal(t.expected.err.Error(), err.Error())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			exists, err := orm.WorkoutCommentExists(context.Background(), s.container.DB, req.Msg.GetId())
+			s.Require().NoError(err)
+			s.Require().False(exists)
+
+			exists, err = orm.Notifications(qm.Where("payload ->> 'commentId' = ?", req.Msg.GetId())).
+				Exists(context.Background(), s.container.DB)
+			s.Require().NoError(err)
+			s.Require().False(exists)
+		})
+	}
+}
+
+func (s *workoutSuite) TestReactToWorkout() {
+	user := s.factory.NewUser()
+	ctx := xcontext.WithUserID(context.Background(), user.ID)
+	ctx = xcontext.WithLogger(ctx, zap.NewExample())
+
+	workout := s.factory.NewWorkout()
+	s.Require().NoError(repo.New(s.container.DB).DeleteWorkout(context.Background(), repo.DeleteWorkoutWithID(workout.ID)))
+
+	res, err := s.handler.ReactToWorkout(ctx, connect.NewRequest(&apiv1.ReactToWorkoutRequest{
+		WorkoutId: workout.ID,
+		Type:      apiv1.ReactionType_REACTION_TYPE_FIRE,
+	}))
+	s.Require().Nil(res)
+	s.Require().Equal(connect.NewError(connect.CodeNotFound, nil).Error(), err.Error())
+}
+
+func (s *workoutSuite) TestRemoveReaction() {
+	type expected struct {
+		err error
+	}
+
+	type test struct {
+		name     string
+		init     func(userID string) *connect.Request[apiv1.RemoveReactionRequest]
+		expected expected
+	}
+
+	tests := []test{
+		{
+			name: "ok",
+			init: func(userID string) *connect.Request[apiv1.RemoveReactionRequest] {
+				return connect.NewRequest(&apiv1.RemoveReactionRequest{
+					WorkoutId: s.factory.NewWorkoutReaction(factory.WorkoutReactionUserID(userID)).WorkoutID,
+				})
+			},
+			expected: expected{
+				err: nil,
+			},
+		},
+		{
+			name: "err_reaction_belongs_to_another_user",
+			init: func(_ string) *connect.Request[apiv1.RemoveReactionRequest] {
+				return connect.NewRequest(&apiv1.RemoveReactionRequest{
+					WorkoutId: s.factory.NewWorkoutReaction().WorkoutID,
+				})
+			},
+			expected: expected{
+				err: connect.NewError(connect.CodeNotFound, nil),
+			},
+		},
+	}
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			user := s.factory.NewUser()
+			ctx := xcontext.WithUserID(context.Background(), user.ID)
+			ctx = xcontext.WithLogger(ctx, zap.NewExample())
+
+			req := t.init(user.ID)
+			res, err := s.handler.RemoveReaction(ctx, req)
+			if t.expected.err != nil {
+				s.Require().Nil(res)
+				s.Require().Error(err)
+				s.Require().Equal(t.expected.err.Error(), err.Error())
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().NotNil(res)
+
+			exists, err := orm.WorkoutReactions(
+				orm.WorkoutReactionWhere.UserID.EQ(user.ID),
+				orm.WorkoutReactionWhere.WorkoutID.EQ(req.Msg.GetWorkoutId()),
+			).Exists(context.Background(), s.container.DB)
+			s.Require().NoError(err)
+			s.Require().False(exists)
+		})
+	}
+}
+
+func (s *workoutSuite) TestRemoveReaction_GroupedNotification() {
+	owner := s.factory.NewUser()
+	workout := s.factory.NewWorkout(factory.WorkoutUserID(owner.ID))
+	reactors := s.factory.NewUserSlice(2)
+	for _, reactor := range reactors {
+		s.factory.NewWorkoutReaction(
+			factory.WorkoutReactionWorkoutID(workout.ID),
+			factory.WorkoutReactionUserID(reactor.ID),
+		)
+	}
+
+	notification := s.factory.NewNotification(
+		factory.NotificationUserID(owner.ID),
+		factory.NotificationType(orm.NotificationTypeWorkoutReaction),
+		factory.NotificationPayload(repo.NotificationPayload{
+			ActorID:   reactors[1].ID,
+			WorkoutID: workout.ID,
+			Reactions: 2,
+		}),
+	)
+
+	removeReaction := func(userID string) {
+		ctx := xcontext.WithUserID(context.Background(), userID)
+		ctx = xcontext.WithLogger(ctx, zap.NewExample())
+
+		_, err := s.handler.RemoveReaction(ctx, connect.NewRequest(&apiv1.RemoveReactionRequest{
+			WorkoutId: workout.ID,
+		}))
+		s.Require().NoError(err)
+	}
+
+	removeReaction(reactors[1].ID)
+
+	s.Require().NoError(notification.Reload(context.Background(), s.container.DB))
+	var payload repo.NotificationPayload
+	s.Require().NoError(json.Unmarshal(notification.Payload, &payload))
+	s.Require().Equal(reactors[0].ID, payload.ActorID)
+	s.Require().Equal(1, payload.Reactions)
+
+	removeReaction(reactors[0].ID)
+
+	exists, err := orm.NotificationExists(context.Background(), s.container.DB, notification.ID)
+	s.Require().NoError(err)
+	s.Require().False(exists)
+}
+
+func (s *workoutSuite) TestListReactions() {
+	user := s.factory.NewUser()
+	ctx := xcontext.WithUserID(context.Background(), user.ID)
+	ctx = xcontext.WithLogger(ctx, zap.NewExample())
+
+	workout := s.factory.NewWorkout()
+	reaction := s.factory.NewWorkoutReaction(factory.WorkoutReactionWorkoutID(workout.ID))
+	s.factory.NewWorkoutReaction(factory.WorkoutReactionWorkoutID(workout.ID))
+
+	res, err := s.handler.ListReactions(ctx, connect.NewRequest(&apiv1.ListReactionsRequest{
+		WorkoutId: workout.ID,
+	}))
+	s.Require().NoError(err)
+	s.Require().Len(res.Msg.GetReactions(), 2)
+
+	var found bool
+	for _, r := range res.Msg.GetReactions() {
+		if r.GetUser().GetId() == reaction.UserID {
+			found = true
+		}
+	}
+	s.Require().True(found)
+}
+
+func (s *workoutSuite) TestListReactions_NotVisible() {
+	user := s.factory.NewUser()
+	ctx := xcontext.WithUserID(context.Background(), user.ID)
+	ctx = xcontext.WithLogger(ctx, zap.NewExample())
+
+	s.Run("err_private_workout", func() {
+		workout := s.factory.NewWorkout(factory.WorkoutVisibility(orm.WorkoutVisibilityPrivate))
+		s.factory.NewWorkoutReaction(factory.WorkoutReactionWorkoutID(workout.ID))
+
+		res, err := s.handler.ListReactions(ctx, connect.NewRequest(&apiv1.ListReactionsRequest{
+			WorkoutId: workout.ID,
+		}))
+		s.Require().Nil(res)
+		s.Require().Equal(connect.NewError(connect.CodePermissionDenied, nil).Error(), err.Error())
+	})
+
+	s.Run("err_blocked_viewer", func() {
+		workout := s.factory.NewWorkout()
+		s.factory.NewWorkoutReaction(factory.WorkoutReactionWorkoutID(workout.ID))
+		s.Require().NoError(repo.New(s.container.DB).BlockUser(context.Background(), repo.BlockParams{
+			BlockerID: workout.UserID,
+			BlockedID: user.ID,
+		}))
+
+		res, err := s.handler.ListReactions(ctx, connect.NewRequest(&apiv1.ListReactionsRequest{
+			WorkoutId: workout.ID,
+		}))
+		s.Require().Nil(res)
+		s.Require().Equal(connect.NewError(connect.CodePermissionDenied, nil).Error(), err.Error())
+	})
+}
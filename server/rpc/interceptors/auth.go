@@ -2,10 +2,13 @@ package interceptors
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"connectrpc.com/connect"
 	"go.uber.org/zap"
@@ -15,26 +18,47 @@ import (
 
 	apiv1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
 	"github.com/crlssn/getstronger/server/jwt"
+	"github.com/crlssn/getstronger/server/repo"
 	"github.com/crlssn/getstronger/server/xcontext"
 	"github.com/crlssn/getstronger/server/xzap"
 )
 
 var _ connect.Interceptor = (*Auth)(nil)
 
-func NewAuth(log *zap.Logger, m *jwt.Manager) connect.Interceptor {
+func NewAuth(log *zap.Logger, m *jwt.Manager, r repo.Repo) connect.Interceptor {
 	a := &Auth{
-		log:     log,
-		jwt:     m,
-		methods: make(map[string]bool),
+		log:          log,
+		jwt:          m,
+		repo:         r,
+		methods:      make(map[string]bool),
+		adminMethods: make(map[string]bool),
+		statuses:     make(map[string]userStatus),
 	}
 	a.initMethods()
 	return a
 }
 
 type Auth struct {
-	log     *zap.Logger
-	jwt     *jwt.Manager
-	methods map[string]bool
+	log          *zap.Logger
+	jwt          *jwt.Manager
+	repo         repo.Repo
+	methods      map[string]bool
+	adminMethods map[string]bool
+
+	mu       sync.Mutex
+	statuses map[string]userStatus
+	prunedAt time.Time
+}
+
+// userStatusTTL is how long a user's status is cached, and so how long it can
+// take for a suspension, deletion or admin change to take effect.
+const userStatusTTL = 30 * time.Second
+
+type userStatus struct {
+	deleted   bool
+	suspended bool
+	admin     bool
+	expiresAt time.Time
 }
 
 func (a *Auth) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
@@ -59,6 +83,10 @@ func (a *Auth) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 		}
 
 		log = log.With(xzap.FieldUserID(claims.UserID))
+		if err = a.authorize(ctx, log, req.Spec().Procedure, claims.UserID); err != nil {
+			return nil, err
+		}
+
 		log.Info("request authenticated")
 
 		ctx = xcontext.WithLogger(ctx, log)
@@ -98,6 +126,10 @@ func (a *Auth) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.S
 		}
 
 		log = log.With(xzap.FieldUserID(claims.UserID))
+		if err = a.authorize(ctx, log, conn.Spec().Procedure, claims.UserID); err != nil {
+			return err
+		}
+
 		log.Info("request authenticated")
 
 		ctx = xcontext.WithLogger(ctx, log)
@@ -106,15 +138,92 @@ func (a *Auth) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.S
 	}
 }
 
+// authorize rejects deleted and suspended users, and users who are not admins
+// if the procedure is restricted to admins.
+func (a *Auth) authorize(ctx context.Context, log *zap.Logger, procedure, userID string) error {
+	status, err := a.userStatus(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("user not found")
+			return connect.NewError(connect.CodeUnauthenticated, nil)
+		}
+
+		log.Error("failed to get user", zap.Error(err))
+		return connect.NewError(connect.CodeInternal, nil)
+	}
+
+	if status.deleted {
+		log.Warn("user is deleted")
+		return connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if status.suspended {
+		log.Warn("user is suspended")
+		return connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	if a.adminMethods[procedure] && !status.admin {
+		log.Warn("user is not an admin")
+		return connect.NewError(connect.CodePermissionDenied, nil)
+	}
+
+	return nil
+}
+
+// userStatus returns the user's status, which is cached briefly to spare every
+// authenticated request a user lookup.
+func (a *Auth) userStatus(ctx context.Context, userID string) (userStatus, error) {
+	now := time.Now()
+
+	a.mu.Lock()
+	status, ok := a.statuses[userID]
+	a.mu.Unlock()
+	if ok && now.Before(status.expiresAt) {
+		return status, nil
+	}
+
+	user, err := a.repo.GetUser(ctx, repo.GetUserWithID(userID))
+	if err != nil {
+		return userStatus{}, fmt.Errorf("get user: %w", err)
+	}
+
+	status = userStatus{
+		deleted:   user.DeletedAt.Valid,
+		suspended: user.SuspendedAt.Valid,
+		admin:     user.Admin,
+		expiresAt: now.Add(userStatusTTL),
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if now.Sub(a.prunedAt) > userStatusTTL {
+		for id, s := range a.statuses {
+			if !now.Before(s.expiresAt) {
+				delete(a.statuses, id)
+			}
+		}
+		a.prunedAt = now
+	}
+	a.statuses[userID] = status
+
+	return status, nil
+}
+
 func (a *Auth) initMethods() {
 	fileDescriptors := []protoreflect.FileDescriptor{
 		apiv1.File_api_v1_auth_service_proto,
+		apiv1.File_api_v1_challenge_service_proto,
+		apiv1.File_api_v1_club_service_proto,
+		apiv1.File_api_v1_coach_service_proto,
+		apiv1.File_api_v1_admin_service_proto,
 		apiv1.File_api_v1_feed_service_proto,
 		apiv1.File_api_v1_user_service_proto,
 		apiv1.File_api_v1_routine_service_proto,
 		apiv1.File_api_v1_workout_service_proto,
 		apiv1.File_api_v1_exercise_service_proto,
 		apiv1.File_api_v1_notification_service_proto,
+		apiv1.File_api_v1_report_service_proto,
 	}
 
 	for _, fileDescriptor := range fileDescriptors {
@@ -140,9 +249,19 @@ func (a *Auth) initMethods() {
 					}
 				}
 
+				requiresAdmin := false
+				if proto.HasExtension(options, apiv1.E_Admin) {
+					if ext := proto.GetExtension(options, apiv1.E_Admin); ext != nil {
+						if v, ok := ext.(bool); ok {
+							requiresAdmin = v
+						}
+					}
+				}
+
 				// Build the full method name.
 				fullMethodName := fmt.Sprintf("/%s/%s", service.FullName(), method.Name())
-				a.methods[fullMethodName] = requiresAuth
+				a.methods[fullMethodName] = requiresAuth || requiresAdmin
+				a.adminMethods[fullMethodName] = requiresAdmin
 			}
 		}
 	}
@@ -1,15 +1,25 @@
 package interceptors_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
+	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 
+	"github.com/crlssn/getstronger/server/gen/orm"
+	apiv1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+	"github.com/crlssn/getstronger/server/gen/proto/api/v1/apiv1connect"
 	"github.com/crlssn/getstronger/server/jwt"
+	"github.com/crlssn/getstronger/server/repo"
 	"github.com/crlssn/getstronger/server/rpc/interceptors"
 )
 
@@ -17,6 +27,7 @@ type authSuite struct {
 	suite.Suite
 
 	jwt         *jwt.Manager
+	repo        *repo.MockRepo
 	interceptor *interceptors.Auth
 }
 
@@ -27,8 +38,9 @@ func TestAuthSuite(t *testing.T) {
 
 func (s *authSuite) SetupSuite() {
 	s.jwt = jwt.NewManager([]byte("access-token"), []byte("refresh-token"))
+	s.repo = repo.NewMockRepo(gomock.NewController(s.T()))
 
-	interceptor, ok := interceptors.NewAuth(zap.NewExample(), s.jwt).(*interceptors.Auth)
+	interceptor, ok := interceptors.NewAuth(zap.NewExample(), s.jwt, s.repo).(*interceptors.Auth)
 	s.Require().True(ok)
 
 	s.interceptor = interceptor
@@ -98,3 +110,64 @@ func (s *authSuite) TestClaimsFromHeader() {
 		})
 	}
 }
+
+func (s *authSuite) TestAuthorize() {
+	type test struct {
+		name     string
+		user     *orm.User
+		expected connect.Code
+	}
+
+	tests := []test{
+		{
+			name:     "ok_admin",
+			user:     &orm.User{ID: uuid.NewString(), Admin: true},
+			expected: connect.CodeUnimplemented,
+		},
+		{
+			name:     "err_not_admin",
+			user:     &orm.User{ID: uuid.NewString()},
+			expected: connect.CodePermissionDenied,
+		},
+		{
+			name:     "err_suspended",
+			user:     &orm.User{ID: uuid.NewString(), Admin: true, SuspendedAt: null.TimeFrom(time.Now())},
+			expected: connect.CodePermissionDenied,
+		},
+		{
+			name:     "err_deleted",
+			user:     &orm.User{ID: uuid.NewString(), Admin: true, DeletedAt: null.TimeFrom(time.Now())},
+			expected: connect.CodeUnauthenticated,
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(apiv1connect.NewAdminServiceHandler(
+		apiv1connect.UnimplementedAdminServiceHandler{},
+		connect.WithInterceptors(s.interceptor),
+	))
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := apiv1connect.NewAdminServiceClient(server.Client(), server.URL)
+
+	for _, t := range tests {
+		s.Run(t.name, func() {
+			accessToken, err := s.jwt.CreateToken(t.user.ID, jwt.TokenTypeAccess)
+			s.Require().NoError(err)
+
+			// The user is looked up once and then cached.
+			s.repo.EXPECT().GetUser(gomock.Any(), gomock.Any()).Return(t.user, nil).Times(1)
+
+			for range 2 {
+				req := connect.NewRequest(&apiv1.ListReportsRequest{})
+				req.Header().Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+
+				_, err = client.ListReports(context.Background(), req)
+				s.Require().Error(err)
+				s.Require().Equal(t.expected, connect.CodeOf(err))
+			}
+		})
+	}
+}
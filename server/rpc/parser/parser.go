@@ -1,25 +1,64 @@
 package parser
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/volatiletech/null/v8"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/crlssn/getstronger/server/gen/orm"
 	apiv1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
+	"github.com/crlssn/getstronger/server/importer"
+	"github.com/crlssn/getstronger/server/mention"
 	"github.com/crlssn/getstronger/server/repo"
 	"github.com/crlssn/getstronger/server/safe"
+	"github.com/crlssn/getstronger/server/strength"
 )
 
 func Exercise(exercise *orm.Exercise) *apiv1.Exercise {
 	return &apiv1.Exercise{
-		Id:     exercise.ID,
-		UserId: exercise.UserID,
-		Name:   exercise.Title,
-		Label:  exercise.SubTitle.String,
+		Id:              exercise.ID,
+		UserId:          exercise.UserID,
+		Name:            exercise.Title,
+		Label:           exercise.SubTitle.String,
+		CatalogExercise: CatalogExercise(exercise.CatalogExercise),
 	}
 }
 
+func CatalogExercise(catalogExercise orm.NullCatalogExercise) apiv1.CatalogExercise {
+	if !catalogExercise.Valid {
+		return apiv1.CatalogExercise_CATALOG_EXERCISE_UNSPECIFIED
+	}
+
+	switch catalogExercise.Val {
+	case orm.CatalogExerciseSquat:
+		return apiv1.CatalogExercise_CATALOG_EXERCISE_SQUAT
+	case orm.CatalogExerciseBenchPress:
+		return apiv1.CatalogExercise_CATALOG_EXERCISE_BENCH_PRESS
+	case orm.CatalogExerciseDeadlift:
+		return apiv1.CatalogExercise_CATALOG_EXERCISE_DEADLIFT
+	}
+
+	return apiv1.CatalogExercise_CATALOG_EXERCISE_UNSPECIFIED
+}
+
+func CatalogExerciseFromPB(catalogExercise apiv1.CatalogExercise) orm.NullCatalogExercise {
+	switch catalogExercise {
+	case apiv1.CatalogExercise_CATALOG_EXERCISE_SQUAT:
+		return orm.NullCatalogExerciseFrom(orm.CatalogExerciseSquat)
+	case apiv1.CatalogExercise_CATALOG_EXERCISE_BENCH_PRESS:
+		return orm.NullCatalogExerciseFrom(orm.CatalogExerciseBenchPress)
+	case apiv1.CatalogExercise_CATALOG_EXERCISE_DEADLIFT:
+		return orm.NullCatalogExerciseFrom(orm.CatalogExerciseDeadlift)
+	case apiv1.CatalogExercise_CATALOG_EXERCISE_UNSPECIFIED:
+	}
+
+	return orm.NullCatalogExercise{Val: "", Valid: false}
+}
+
 func ExerciseSlice(exercises orm.ExerciseSlice) []*apiv1.Exercise {
 	return parseWithoutOpts(exercises, Exercise)
 }
@@ -32,13 +71,28 @@ func UserFollowed(followed bool) UserOpt {
 	}
 }
 
+func UserFollowRequested(requested bool) UserOpt {
+	return func(user *apiv1.User) {
+		user.FollowRequested = requested
+	}
+}
+
+func UserBlocked(blocked bool) UserOpt {
+	return func(user *apiv1.User) {
+		user.Blocked = blocked
+	}
+}
+
 func User(user *orm.User, opts ...UserOpt) *apiv1.User {
 	u := &apiv1.User{
-		Id:        user.ID,
-		FirstName: user.FirstName,
-		LastName:  user.LastName,
-		Followed:  false,
-		Email:     "",
+		Id:              user.ID,
+		FirstName:       user.FirstName,
+		LastName:        user.LastName,
+		Email:           "",
+		Followed:        false,
+		Private:         user.Private,
+		FollowRequested: false,
+		Blocked:         false,
 	}
 
 	if user.R != nil {
@@ -58,11 +112,78 @@ func UserSlice(users orm.UserSlice) []*apiv1.User {
 	return parseWithEmptyOpts(users, User)
 }
 
+func Profile(user *orm.User) *apiv1.Profile {
+	p := &apiv1.Profile{
+		Sex:                      apiv1.Sex_SEX_UNSPECIFIED,
+		BirthDate:                nil,
+		Bodyweight:               user.Bodyweight.Float64,
+		Private:                  user.Private,
+		DefaultWorkoutVisibility: WorkoutVisibility(user.DefaultWorkoutVisibility),
+	}
+
+	if user.Sex.Valid {
+		switch user.Sex.Val {
+		case orm.SexMale:
+			p.Sex = apiv1.Sex_SEX_MALE
+		case orm.SexFemale:
+			p.Sex = apiv1.Sex_SEX_FEMALE
+		}
+	}
+
+	if user.BirthDate.Valid {
+		p.BirthDate = timestamppb.New(user.BirthDate.Time)
+	}
+
+	return p
+}
+
+// StrengthScores scores the total of the lifts' estimated one rep maxes at the
+// given bodyweight.
+func StrengthScores(user *orm.User, bodyweight float64, squat, benchPress, deadlift *orm.Set) *apiv1.StrengthScores {
+	sex := strength.Male
+	if user.Sex.Val == orm.SexFemale {
+		sex = strength.Female
+	}
+
+	var total float64
+	for _, set := range []*orm.Set{squat, benchPress, deadlift} {
+		total += strength.EstimatedOneRepMax(set.Weight, set.Reps)
+	}
+
+	return &apiv1.StrengthScores{
+		Squat:      ExerciseSetSlice(orm.SetSlice{squat})[0],
+		BenchPress: ExerciseSetSlice(orm.SetSlice{benchPress})[0],
+		Deadlift:   ExerciseSetSlice(orm.SetSlice{deadlift})[0],
+		Total:      total,
+		Bodyweight: bodyweight,
+		Wilks:      strength.Wilks(sex, bodyweight, total),
+		Dots:       strength.DOTS(sex, bodyweight, total),
+		IpfGl:      strength.IPFGL(sex, bodyweight, total),
+	}
+}
+
+func SexFromPB(sex apiv1.Sex) orm.NullSex {
+	switch sex {
+	case apiv1.Sex_SEX_MALE:
+		return orm.NullSexFrom(orm.SexMale)
+	case apiv1.Sex_SEX_FEMALE:
+		return orm.NullSexFrom(orm.SexFemale)
+	case apiv1.Sex_SEX_UNSPECIFIED:
+	}
+
+	return orm.NullSex{Val: "", Valid: false}
+}
+
 func Routine(routine *orm.Routine) *apiv1.Routine {
 	r := &apiv1.Routine{
-		Id:        routine.ID,
-		Name:      routine.Title,
-		Exercises: nil,
+		Id:                  routine.ID,
+		Name:                routine.Title,
+		Exercises:           nil,
+		UserId:              routine.UserID,
+		Visibility:          RoutineVisibility(routine.Visibility),
+		ShareToken:          routine.ShareToken.String,
+		ClonedFromRoutineId: routine.ClonedFromRoutineID.String,
+		CloneCount:          int32(routine.CloneCount), //nolint:gosec
 	}
 
 	if routine.R != nil {
@@ -78,6 +199,75 @@ func RoutineSlice(routines orm.RoutineSlice) []*apiv1.Routine {
 	return parseWithoutOpts(routines, Routine)
 }
 
+func RoutineVisibility(visibility orm.RoutineVisibility) apiv1.RoutineVisibility {
+	switch visibility {
+	case orm.RoutineVisibilityPrivate:
+		return apiv1.RoutineVisibility_ROUTINE_VISIBILITY_PRIVATE
+	case orm.RoutineVisibilityFollowers:
+		return apiv1.RoutineVisibility_ROUTINE_VISIBILITY_FOLLOWERS
+	case orm.RoutineVisibilityLink:
+		return apiv1.RoutineVisibility_ROUTINE_VISIBILITY_LINK
+	}
+
+	return apiv1.RoutineVisibility_ROUTINE_VISIBILITY_UNSPECIFIED
+}
+
+func RoutineVisibilityFromPB(visibility apiv1.RoutineVisibility) orm.RoutineVisibility {
+	switch visibility {
+	case apiv1.RoutineVisibility_ROUTINE_VISIBILITY_FOLLOWERS:
+		return orm.RoutineVisibilityFollowers
+	case apiv1.RoutineVisibility_ROUTINE_VISIBILITY_LINK:
+		return orm.RoutineVisibilityLink
+	case apiv1.RoutineVisibility_ROUTINE_VISIBILITY_PRIVATE,
+		apiv1.RoutineVisibility_ROUTINE_VISIBILITY_UNSPECIFIED:
+	}
+
+	return orm.RoutineVisibilityPrivate
+}
+
+func ExerciseTarget(target repo.RoutineExerciseTarget) *apiv1.ExerciseTarget {
+	sets := make([]*apiv1.TargetSet, 0, len(target.Sets))
+	for _, set := range target.Sets {
+		sets = append(sets, &apiv1.TargetSet{
+			Weight: set.Weight,
+			Reps:   int32(set.Reps), //nolint:gosec
+		})
+	}
+
+	return &apiv1.ExerciseTarget{
+		ExerciseId: target.ExerciseID,
+		Sets:       sets,
+	}
+}
+
+func ExerciseTargetSlice(targets []repo.RoutineExerciseTarget) []*apiv1.ExerciseTarget {
+	return parseWithoutOpts(targets, ExerciseTarget)
+}
+
+// RoutineExerciseTargets groups the sets by exercise in the order the
+// exercises first appear. The sets are expected to be sorted by creation time.
+func RoutineExerciseTargets(sets orm.SetSlice) []repo.RoutineExerciseTarget {
+	var targets []repo.RoutineExerciseTarget
+	mapTargetIndex := make(map[string]int)
+	for _, set := range sets {
+		index, ok := mapTargetIndex[set.ExerciseID]
+		if !ok {
+			index = len(targets)
+			mapTargetIndex[set.ExerciseID] = index
+			targets = append(targets, repo.RoutineExerciseTarget{
+				ExerciseID: set.ExerciseID,
+			})
+		}
+
+		targets[index].Sets = append(targets[index].Sets, repo.RoutineTargetSet{
+			Weight: set.Weight,
+			Reps:   set.Reps,
+		})
+	}
+
+	return targets
+}
+
 type WorkoutOpt func(*apiv1.Workout)
 
 func WorkoutExerciseSets(sets orm.SetSlice, personalBests orm.SetSlice) WorkoutOpt {
@@ -97,6 +287,58 @@ func WorkoutIntensity(sets orm.SetSlice) WorkoutOpt {
 	}
 }
 
+// WorkoutReactions sets the number of reactions per type and the reaction of
+// the given user, if any.
+func WorkoutReactions(reactions orm.WorkoutReactionSlice, userID string) WorkoutOpt {
+	return func(w *apiv1.Workout) {
+		counts := make(map[orm.ReactionType]int32)
+		for _, reaction := range reactions {
+			counts[reaction.Type]++
+			if reaction.UserID == userID {
+				w.Reaction = ReactionType(reaction.Type)
+			}
+		}
+
+		w.ReactionCounts = make([]*apiv1.ReactionCount, 0, len(counts))
+		for _, reactionType := range orm.AllReactionType() {
+			if counts[reactionType] == 0 {
+				continue
+			}
+
+			w.ReactionCounts = append(w.ReactionCounts, &apiv1.ReactionCount{
+				Type:  ReactionType(reactionType),
+				Count: counts[reactionType],
+			})
+		}
+	}
+}
+
+func WorkoutVisibility(visibility orm.WorkoutVisibility) apiv1.WorkoutVisibility {
+	switch visibility {
+	case orm.WorkoutVisibilityPublic:
+		return apiv1.WorkoutVisibility_WORKOUT_VISIBILITY_PUBLIC
+	case orm.WorkoutVisibilityFollowers:
+		return apiv1.WorkoutVisibility_WORKOUT_VISIBILITY_FOLLOWERS
+	case orm.WorkoutVisibilityPrivate:
+		return apiv1.WorkoutVisibility_WORKOUT_VISIBILITY_PRIVATE
+	}
+
+	return apiv1.WorkoutVisibility_WORKOUT_VISIBILITY_UNSPECIFIED
+}
+
+func WorkoutVisibilityFromPB(visibility apiv1.WorkoutVisibility) orm.WorkoutVisibility {
+	switch visibility {
+	case apiv1.WorkoutVisibility_WORKOUT_VISIBILITY_FOLLOWERS:
+		return orm.WorkoutVisibilityFollowers
+	case apiv1.WorkoutVisibility_WORKOUT_VISIBILITY_PRIVATE:
+		return orm.WorkoutVisibilityPrivate
+	case apiv1.WorkoutVisibility_WORKOUT_VISIBILITY_PUBLIC,
+		apiv1.WorkoutVisibility_WORKOUT_VISIBILITY_UNSPECIFIED:
+	}
+
+	return orm.WorkoutVisibilityPublic
+}
+
 func Workout(workout *orm.Workout, opts ...WorkoutOpt) *apiv1.Workout {
 	w := &apiv1.Workout{
 		Id:           workout.ID,
@@ -108,6 +350,8 @@ func Workout(workout *orm.Workout, opts ...WorkoutOpt) *apiv1.Workout {
 		ExerciseSets: nil,
 		Intensity:    0,
 		Note:         workout.Note.String,
+		NoteMentions: Mentions(workout.NoteMentions),
+		Visibility:   WorkoutVisibility(workout.Visibility),
 	}
 
 	if workout.R != nil {
@@ -115,9 +359,7 @@ func Workout(workout *orm.Workout, opts ...WorkoutOpt) *apiv1.Workout {
 			w.User = User(workout.R.GetUser())
 		}
 
-		for _, comment := range workout.R.GetWorkoutComments() {
-			w.Comments = append(w.Comments, WorkoutComment(comment))
-		}
+		w.Comments = WorkoutCommentThreads(workout.R.GetWorkoutComments())
 	}
 
 	for _, opt := range opts {
@@ -127,7 +369,8 @@ func Workout(workout *orm.Workout, opts ...WorkoutOpt) *apiv1.Workout {
 	return w
 }
 
-func WorkoutSlice(workouts orm.WorkoutSlice, personalBests orm.SetSlice) ([]*apiv1.Workout, error) {
+// WorkoutSlice parses the workouts as seen by the given user.
+func WorkoutSlice(workouts orm.WorkoutSlice, personalBests orm.SetSlice, userID string) ([]*apiv1.Workout, error) {
 	workoutSlice := make([]*apiv1.Workout, 0, len(workouts))
 	for _, workout := range workouts {
 		if workout.R == nil {
@@ -135,7 +378,9 @@ func WorkoutSlice(workouts orm.WorkoutSlice, personalBests orm.SetSlice) ([]*api
 			continue
 		}
 
-		var workoutOpts []WorkoutOpt
+		workoutOpts := []WorkoutOpt{
+			WorkoutReactions(workout.R.GetWorkoutReactions(), userID),
+		}
 		if workout.R.GetSets() != nil {
 			workoutOpts = append(workoutOpts,
 				WorkoutIntensity(workout.R.GetSets()),
@@ -157,6 +402,16 @@ func WorkoutComment(comment *orm.WorkoutComment) *apiv1.WorkoutComment {
 		User:      nil,
 	}
 
+	if comment.EditedAt.Valid {
+		c.EditedAt = timestamppb.New(comment.EditedAt.Time)
+	}
+
+	if comment.ParentCommentID.Valid {
+		c.ParentCommentId = comment.ParentCommentID.String
+	}
+
+	c.Mentions = Mentions(comment.Mentions)
+
 	if comment.R == nil {
 		return c
 	}
@@ -168,6 +423,86 @@ func WorkoutComment(comment *orm.WorkoutComment) *apiv1.WorkoutComment {
 	return c
 }
 
+// Mentions parses the mentions stored in a JSONB column. Mentions that can't
+// be unmarshalled are left out rather than failing the whole response.
+func Mentions(raw []byte) []*apiv1.Mention {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var mentions []mention.Mention
+	if err := json.Unmarshal(raw, &mentions); err != nil {
+		return nil
+	}
+
+	parsed := make([]*apiv1.Mention, 0, len(mentions))
+	for _, m := range mentions {
+		parsed = append(parsed, &apiv1.Mention{
+			UserId: m.UserID,
+			Start:  int32(m.Start), //nolint:gosec
+			End:    int32(m.End),   //nolint:gosec
+		})
+	}
+
+	return parsed
+}
+
+// MaxCommentDepth is the number of reply levels below a top-level comment.
+const MaxCommentDepth = 3
+
+// WorkoutCommentThreads nests the comments under the comments they reply to
+// and returns the top-level comments, oldest first. Replies that would be
+// nested deeper than MaxCommentDepth are flattened into the deepest level.
+func WorkoutCommentThreads(comments orm.WorkoutCommentSlice) []*apiv1.WorkoutComment {
+	sorted := make(orm.WorkoutCommentSlice, len(comments))
+	copy(sorted, comments)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	mapComments := make(map[string]*orm.WorkoutComment, len(sorted))
+	for _, comment := range sorted {
+		mapComments[comment.ID] = comment
+	}
+
+	parsed := make(map[string]*apiv1.WorkoutComment, len(sorted))
+	for _, comment := range sorted {
+		parsed[comment.ID] = WorkoutComment(comment)
+	}
+
+	// depth returns the number of ancestors of the comment, stopping at
+	// parents that are missing from the slice.
+	depth := func(comment *orm.WorkoutComment) int {
+		var d int
+		for comment.ParentCommentID.Valid {
+			parent, ok := mapComments[comment.ParentCommentID.String]
+			if !ok {
+				break
+			}
+			comment = parent
+			d++
+		}
+		return d
+	}
+
+	threads := make([]*apiv1.WorkoutComment, 0, len(sorted))
+	for _, comment := range sorted {
+		parent, ok := mapComments[comment.ParentCommentID.String]
+		if !comment.ParentCommentID.Valid || !ok {
+			threads = append(threads, parsed[comment.ID])
+			continue
+		}
+
+		for depth(parent) >= MaxCommentDepth {
+			parent = mapComments[parent.ParentCommentID.String]
+		}
+
+		parsed[parent.ID].Replies = append(parsed[parent.ID].Replies, parsed[comment.ID])
+	}
+
+	return threads
+}
+
 type ExerciseSetsSliceOpt func(*apiv1.ExerciseSets)
 
 func ExerciseSetsPersonalBests(personalBests orm.SetSlice) ExerciseSetsSliceOpt {
@@ -282,26 +617,109 @@ func NotificationActor(nType orm.NotificationType, actor *orm.User) Notification
 			}
 
 			n.GetType().(*apiv1.Notification_WorkoutComment_).WorkoutComment.Actor = User(actor) //nolint:forcetypeassert
+		case orm.NotificationTypeWorkoutCommentReply:
+			if _, ok := n.GetType().(*apiv1.Notification_WorkoutCommentReply_); !ok {
+				n.Type = &apiv1.Notification_WorkoutCommentReply_{
+					WorkoutCommentReply: &apiv1.Notification_WorkoutCommentReply{
+						Actor:   nil,
+						Workout: nil,
+					},
+				}
+			}
+
+			n.GetType().(*apiv1.Notification_WorkoutCommentReply_).WorkoutCommentReply.Actor = User(actor) //nolint:forcetypeassert
+		case orm.NotificationTypeWorkoutReaction:
+			if _, ok := n.GetType().(*apiv1.Notification_WorkoutReactions_); !ok {
+				n.Type = &apiv1.Notification_WorkoutReactions_{
+					WorkoutReactions: &apiv1.Notification_WorkoutReactions{
+						Actor:     nil,
+						Workout:   nil,
+						Reactions: 0,
+					},
+				}
+			}
+
+			n.GetType().(*apiv1.Notification_WorkoutReactions_).WorkoutReactions.Actor = User(actor) //nolint:forcetypeassert
+		case orm.NotificationTypeMention:
+			if _, ok := n.GetType().(*apiv1.Notification_Mention_); !ok {
+				n.Type = &apiv1.Notification_Mention_{
+					Mention: &apiv1.Notification_Mention{
+						Actor:   nil,
+						Workout: nil,
+					},
+				}
+			}
+
+			n.GetType().(*apiv1.Notification_Mention_).Mention.Actor = User(actor) //nolint:forcetypeassert
+		case orm.NotificationTypeFollowRequest:
+			n.Type = &apiv1.Notification_FollowRequest_{
+				FollowRequest: &apiv1.Notification_FollowRequest{
+					Actor: User(actor),
+				},
+			}
+		case orm.NotificationTypeFollowRequestApproved:
+			n.Type = &apiv1.Notification_FollowRequestApproved_{
+				FollowRequestApproved: &apiv1.Notification_FollowRequestApproved{
+					Actor: User(actor),
+				},
+			}
 		}
 	}
 }
 
 func NotificationWorkout(nType orm.NotificationType, workout *orm.Workout) NotificationOpt {
 	return func(n *apiv1.Notification) {
-		if nType != orm.NotificationTypeWorkoutComment || workout == nil {
+		if workout == nil {
 			return
 		}
 
-		if _, ok := n.GetType().(*apiv1.Notification_WorkoutComment_); !ok {
-			n.Type = &apiv1.Notification_WorkoutComment_{
-				WorkoutComment: &apiv1.Notification_WorkoutComment{
-					Actor:   nil,
-					Workout: nil,
-				},
+		switch nType {
+		case orm.NotificationTypeWorkoutComment:
+			if _, ok := n.GetType().(*apiv1.Notification_WorkoutComment_); !ok {
+				n.Type = &apiv1.Notification_WorkoutComment_{
+					WorkoutComment: &apiv1.Notification_WorkoutComment{
+						Actor:   nil,
+						Workout: nil,
+					},
+				}
+			}
+
+			n.Type.(*apiv1.Notification_WorkoutComment_).WorkoutComment.Workout = Workout(workout) //nolint:forcetypeassert
+		case orm.NotificationTypeWorkoutCommentReply:
+			if _, ok := n.GetType().(*apiv1.Notification_WorkoutCommentReply_); !ok {
+				n.Type = &apiv1.Notification_WorkoutCommentReply_{
+					WorkoutCommentReply: &apiv1.Notification_WorkoutCommentReply{
+						Actor:   nil,
+						Workout: nil,
+					},
+				}
+			}
+
+			n.Type.(*apiv1.Notification_WorkoutCommentReply_).WorkoutCommentReply.Workout = Workout(workout) //nolint:forcetypeassert
+		case orm.NotificationTypeWorkoutReaction:
+			if _, ok := n.GetType().(*apiv1.Notification_WorkoutReactions_); !ok {
+				n.Type = &apiv1.Notification_WorkoutReactions_{
+					WorkoutReactions: &apiv1.Notification_WorkoutReactions{
+						Actor:     nil,
+						Workout:   nil,
+						Reactions: 0,
+					},
+				}
 			}
-		}
 
-		n.Type.(*apiv1.Notification_WorkoutComment_).WorkoutComment.Workout = Workout(workout) //nolint:forcetypeassert
+			n.Type.(*apiv1.Notification_WorkoutReactions_).WorkoutReactions.Workout = Workout(workout) //nolint:forcetypeassert
+		case orm.NotificationTypeMention:
+			if _, ok := n.GetType().(*apiv1.Notification_Mention_); !ok {
+				n.Type = &apiv1.Notification_Mention_{
+					Mention: &apiv1.Notification_Mention{
+						Actor:   nil,
+						Workout: nil,
+					},
+				}
+			}
+
+			n.Type.(*apiv1.Notification_Mention_).Mention.Workout = Workout(workout) //nolint:forcetypeassert
+		}
 	}
 }
 
@@ -319,7 +737,41 @@ func Notification(notification *orm.Notification, opts ...NotificationOpt) *apiv
 	return n
 }
 
-func NotificationSlice(notifications orm.NotificationSlice, actors orm.UserSlice, workouts orm.WorkoutSlice) ([]*apiv1.Notification, error) {
+func NotificationReactions(nType orm.NotificationType, reactions int) NotificationOpt {
+	return func(n *apiv1.Notification) {
+		if nType != orm.NotificationTypeWorkoutReaction {
+			return
+		}
+
+		if _, ok := n.GetType().(*apiv1.Notification_WorkoutReactions_); !ok {
+			n.Type = &apiv1.Notification_WorkoutReactions_{
+				WorkoutReactions: &apiv1.Notification_WorkoutReactions{
+					Actor:     nil,
+					Workout:   nil,
+					Reactions: 0,
+				},
+			}
+		}
+
+		n.Type.(*apiv1.Notification_WorkoutReactions_).WorkoutReactions.Reactions = int32(reactions) //nolint:forcetypeassert,gosec
+	}
+}
+
+func NotificationYearSummary(nType orm.NotificationType, summary *apiv1.YearSummary) NotificationOpt {
+	return func(n *apiv1.Notification) {
+		if nType != orm.NotificationTypeYearSummary || summary == nil {
+			return
+		}
+
+		n.Type = &apiv1.Notification_YearSummary_{
+			YearSummary: &apiv1.Notification_YearSummary{
+				Summary: summary,
+			},
+		}
+	}
+}
+
+func NotificationSlice(notifications orm.NotificationSlice, actors orm.UserSlice, workouts orm.WorkoutSlice, summaries orm.YearSummarySlice, challenges orm.ChallengeSlice) ([]*apiv1.Notification, error) {
 	mapActors := make(map[string]*orm.User)
 	for _, a := range actors {
 		mapActors[a.ID] = a
@@ -330,6 +782,21 @@ func NotificationSlice(notifications orm.NotificationSlice, actors orm.UserSlice
 		mapWorkouts[w.ID] = w
 	}
 
+	mapSummaries := make(map[string]*apiv1.YearSummary)
+	for _, summary := range summaries {
+		parsed, err := YearSummary(summary)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse year summary: %w", err)
+		}
+
+		mapSummaries[summary.ID] = parsed
+	}
+
+	mapChallenges := make(map[string]*orm.Challenge)
+	for _, c := range challenges {
+		mapChallenges[c.ID] = c
+	}
+
 	nSlice := make([]*apiv1.Notification, 0, len(notifications))
 	for _, n := range notifications {
 		var p repo.NotificationPayload
@@ -339,19 +806,47 @@ func NotificationSlice(notifications orm.NotificationSlice, actors orm.UserSlice
 
 		actor, actorExists := mapActors[p.ActorID]
 		workout, workoutExists := mapWorkouts[p.WorkoutID]
+		summary, summaryExists := mapSummaries[p.YearSummaryID]
+		challenge, challengeExists := mapChallenges[p.ChallengeID]
 
 		switch n.Type {
-		case orm.NotificationTypeFollow:
+		case orm.NotificationTypeFollow, orm.NotificationTypeFollowRequest, orm.NotificationTypeFollowRequestApproved:
 			if actorExists {
 				nSlice = append(nSlice, Notification(n,
 					NotificationActor(n.Type, actor),
 				))
 			}
-		case orm.NotificationTypeWorkoutComment:
+		case orm.NotificationTypeWorkoutComment, orm.NotificationTypeWorkoutCommentReply, orm.NotificationTypeMention:
+			if actorExists && workoutExists {
+				nSlice = append(nSlice, Notification(n,
+					NotificationActor(n.Type, actor),
+					NotificationWorkout(n.Type, workout),
+				))
+			}
+		case orm.NotificationTypeWorkoutReaction:
 			if actorExists && workoutExists {
 				nSlice = append(nSlice, Notification(n,
 					NotificationActor(n.Type, actor),
 					NotificationWorkout(n.Type, workout),
+					NotificationReactions(n.Type, p.Reactions),
+				))
+			}
+		case orm.NotificationTypeYearSummary:
+			if summaryExists {
+				nSlice = append(nSlice, Notification(n,
+					NotificationYearSummary(n.Type, summary),
+				))
+			}
+		case orm.NotificationTypeChallengeInvite, orm.NotificationTypeChallengeLeadTaken:
+			if actorExists && challengeExists {
+				nSlice = append(nSlice, Notification(n,
+					NotificationChallenge(n.Type, actor, challenge),
+				))
+			}
+		case orm.NotificationTypeChallengeEnded:
+			if challengeExists {
+				nSlice = append(nSlice, Notification(n,
+					NotificationChallenge(n.Type, actor, challenge),
 				))
 			}
 		}
@@ -360,25 +855,169 @@ func NotificationSlice(notifications orm.NotificationSlice, actors orm.UserSlice
 	return nSlice, nil
 }
 
-func FeedItemSlice(workouts orm.WorkoutSlice, personalBests orm.SetSlice) ([]*apiv1.FeedItem, error) {
-	items := make([]*apiv1.FeedItem, 0, len(workouts))
+// FeedSources holds the rows of every kind of feed item.
+type FeedSources struct {
+	Workouts        orm.WorkoutSlice
+	YearSummaries   orm.YearSummarySlice
+	PersonalRecords orm.PersonalRecordSlice
+	Follows         orm.FollowerSlice
+	Achievements    orm.AchievementSlice
+	Challenges      orm.ChallengeSlice
+	// Users are the owners of the personal records and achievements.
+	Users orm.UserSlice
+	// PersonalBests are flagged in the sets of the workouts.
+	PersonalBests orm.SetSlice
+}
 
-	workoutSlice, err := WorkoutSlice(workouts, personalBests)
+// FeedItemSlice merges the sources into a single slice of feed items, newest
+// first. Items that happened at the same time keep the order of the sources.
+func FeedItemSlice(sources FeedSources, userID string) ([]*apiv1.FeedItem, error) {
+	workouts, err := WorkoutSlice(sources.Workouts, sources.PersonalBests, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse workouts: %w", err)
 	}
 
-	for _, workout := range workoutSlice {
+	items := make([]*apiv1.FeedItem, 0, len(workouts)+len(sources.YearSummaries)+len(sources.PersonalRecords)+
+		len(sources.Follows)+len(sources.Achievements)+len(sources.Challenges))
+	for i, workout := range workouts {
 		items = append(items, &apiv1.FeedItem{
-			Type: &apiv1.FeedItem_Workout{
-				Workout: workout,
-			},
+			Type:      &apiv1.FeedItem_Workout{Workout: workout},
+			CreatedAt: timestamppb.New(sources.Workouts[i].CreatedAt),
+		})
+	}
+
+	for _, summary := range sources.YearSummaries {
+		s, err := YearSummary(summary)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse year summary: %w", err)
+		}
+
+		items = append(items, &apiv1.FeedItem{
+			Type:      &apiv1.FeedItem_YearSummary{YearSummary: s},
+			CreatedAt: timestamppb.New(summary.CreatedAt),
+		})
+	}
+
+	mapUsers := make(map[string]*orm.User, len(sources.Users))
+	for _, user := range sources.Users {
+		mapUsers[user.ID] = user
+	}
+
+	for _, record := range sources.PersonalRecords {
+		user, ok := mapUsers[record.UserID]
+		if !ok {
+			continue
+		}
+
+		set := record.R.GetSet()
+
+		items = append(items, &apiv1.FeedItem{
+			Type: &apiv1.FeedItem_PersonalRecord{PersonalRecord: &apiv1.PersonalRecord{
+				User: User(user),
+				ExerciseSet: &apiv1.ExerciseSet{
+					Exercise: Exercise(set.R.GetExercise()),
+					Set:      Set(set, nil),
+				},
+			}},
+			CreatedAt: timestamppb.New(record.CreatedAt),
+		})
+	}
+
+	for _, follow := range sources.Follows {
+		items = append(items, &apiv1.FeedItem{
+			Type: &apiv1.FeedItem_Follow{Follow: &apiv1.Follow{
+				Follower: User(follow.R.GetFollower()),
+				Followee: User(follow.R.GetFollowee()),
+			}},
+			CreatedAt: timestamppb.New(follow.CreatedAt.Time),
 		})
 	}
 
+	for _, achievement := range sources.Achievements {
+		user, ok := mapUsers[achievement.UserID]
+		if !ok {
+			continue
+		}
+
+		items = append(items, &apiv1.FeedItem{
+			Type: &apiv1.FeedItem_Achievement{Achievement: &apiv1.Achievement{
+				User:         User(user),
+				WorkoutCount: int32(achievement.WorkoutCount), //nolint:gosec
+			}},
+			CreatedAt: timestamppb.New(achievement.CreatedAt),
+		})
+	}
+
+	for _, challenge := range sources.Challenges {
+		result := &apiv1.ChallengeResult{
+			Challenge: Challenge(challenge),
+			Winner:    nil,
+		}
+
+		if challenge.R != nil && challenge.R.Leader != nil {
+			result.Winner = User(challenge.R.GetLeader())
+		}
+
+		items = append(items, &apiv1.FeedItem{
+			Type:      &apiv1.FeedItem_ChallengeResult{ChallengeResult: result},
+			CreatedAt: timestamppb.New(challenge.EndedAt.Time),
+		})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].GetCreatedAt().AsTime().After(items[j].GetCreatedAt().AsTime())
+	})
+
 	return items, nil
 }
 
+func YearSummary(summary *orm.YearSummary) (*apiv1.YearSummary, error) {
+	var p repo.YearSummaryPayload
+	if err := summary.Payload.Unmarshal(&p); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal year summary payload: %w", err)
+	}
+
+	s := &apiv1.YearSummary{
+		Id:                   summary.ID,
+		User:                 nil,
+		Year:                 int32(summary.Year), //nolint:gosec
+		Sessions:             int32(p.Sessions),   //nolint:gosec
+		Tonnage:              p.Tonnage,
+		MostTrainedExercises: make([]*apiv1.YearSummary_Exercise, 0, len(p.MostTrainedExercises)),
+		PersonalBests:        int32(p.PersonalBests),      //nolint:gosec
+		LongestStreakWeeks:   int32(p.LongestStreakWeeks), //nolint:gosec
+		MostActiveFollowee:   nil,
+		CreatedAt:            timestamppb.New(summary.CreatedAt),
+	}
+
+	if summary.R != nil && summary.R.User != nil {
+		s.User = User(summary.R.GetUser())
+	}
+
+	for _, exercise := range p.MostTrainedExercises {
+		s.MostTrainedExercises = append(s.MostTrainedExercises, &apiv1.YearSummary_Exercise{
+			ExerciseId: exercise.ExerciseID,
+			Name:       exercise.Name,
+			Sets:       int32(exercise.Sets), //nolint:gosec
+		})
+	}
+
+	if p.MostActiveFollowee != nil {
+		s.MostActiveFollowee = &apiv1.YearSummary_Followee{
+			User: &apiv1.User{
+				Id:        p.MostActiveFollowee.UserID,
+				FirstName: p.MostActiveFollowee.FirstName,
+				LastName:  p.MostActiveFollowee.LastName,
+				Email:     "",
+				Followed:  false,
+			},
+			Workouts: int32(p.MostActiveFollowee.Workouts), //nolint:gosec
+		}
+	}
+
+	return s, nil
+}
+
 func SetSlice(sets orm.SetSlice, personalBests orm.SetSlice) []*apiv1.Set {
 	mapPersonalBests := make(map[string]struct{}, len(personalBests))
 	for _, set := range personalBests {
@@ -424,3 +1063,623 @@ func parseWithEmptyOpts[Input any, Output any, Opts any](input []Input, f func(I
 	}
 	return output
 }
+
+func ProgressIntervalFromPB(interval apiv1.ProgressInterval) repo.ProgressInterval {
+	switch interval {
+	case apiv1.ProgressInterval_PROGRESS_INTERVAL_DAY:
+		return repo.ProgressIntervalDay
+	case apiv1.ProgressInterval_PROGRESS_INTERVAL_WEEK:
+		return repo.ProgressIntervalWeek
+	case apiv1.ProgressInterval_PROGRESS_INTERVAL_MONTH:
+		return repo.ProgressIntervalMonth
+	case apiv1.ProgressInterval_PROGRESS_INTERVAL_UNSPECIFIED:
+	}
+
+	return ""
+}
+
+func ProgressPoint(progress repo.ExerciseProgress) *apiv1.ProgressPoint {
+	return &apiv1.ProgressPoint{
+		Bucket:       timestamppb.New(progress.Bucket),
+		TopSetWeight: progress.TopSetWeight,
+		TopSetReps:   int32(progress.TopSetReps), //nolint:gosec
+		EstimatedMax: progress.EstimatedMax,
+		Volume:       progress.Volume,
+		TotalReps:    int32(progress.TotalReps), //nolint:gosec
+	}
+}
+
+func ProgressPointSlice(progress []repo.ExerciseProgress) []*apiv1.ProgressPoint {
+	return parseWithoutOpts(progress, ProgressPoint)
+}
+
+// ExerciseComparisonSlice compares the exercise sets of two workouts. Exercises
+// are matched by ID, or by name when the workouts belong to different users.
+// Sets are matched by their position within the exercise.
+func ExerciseComparisonSlice(workout, other *apiv1.Workout) []*apiv1.ExerciseComparison {
+	matched := make(map[*apiv1.ExerciseSets]struct{}, len(other.GetExerciseSets()))
+	findOther := func(exerciseSets *apiv1.ExerciseSets) *apiv1.ExerciseSets {
+		for _, match := range []func(*apiv1.ExerciseSets) bool{
+			func(o *apiv1.ExerciseSets) bool {
+				return o.GetExercise().GetId() == exerciseSets.GetExercise().GetId()
+			},
+			func(o *apiv1.ExerciseSets) bool {
+				return strings.EqualFold(strings.TrimSpace(o.GetExercise().GetName()), strings.TrimSpace(exerciseSets.GetExercise().GetName()))
+			},
+		} {
+			for _, o := range other.GetExerciseSets() {
+				if _, ok := matched[o]; ok {
+					continue
+				}
+
+				if match(o) {
+					matched[o] = struct{}{}
+					return o
+				}
+			}
+		}
+
+		return nil
+	}
+
+	comparisons := make([]*apiv1.ExerciseComparison, 0, len(workout.GetExerciseSets()))
+	for _, exerciseSets := range workout.GetExerciseSets() {
+		comparisons = append(comparisons, ExerciseComparison(exerciseSets, findOther(exerciseSets)))
+	}
+
+	for _, o := range other.GetExerciseSets() {
+		if _, ok := matched[o]; !ok {
+			comparisons = append(comparisons, ExerciseComparison(nil, o))
+		}
+	}
+
+	return comparisons
+}
+
+func ExerciseComparison(exerciseSets, other *apiv1.ExerciseSets) *apiv1.ExerciseComparison {
+	c := &apiv1.ExerciseComparison{
+		Exercise:          exerciseSets.GetExercise(),
+		OtherExercise:     other.GetExercise(),
+		Sets:              nil,
+		SetsAdded:         0,
+		SetsRemoved:       0,
+		VolumeDelta:       volume(other.GetSets()) - volume(exerciseSets.GetSets()),
+		PersonalBest:      personalBest(exerciseSets.GetSets()),
+		OtherPersonalBest: personalBest(other.GetSets()),
+	}
+
+	sets := exerciseSets.GetSets()
+	otherSets := other.GetSets()
+	for i := range max(len(sets), len(otherSets)) {
+		var set, otherSet *apiv1.Set
+		if i < len(sets) {
+			set = sets[i]
+		}
+		if i < len(otherSets) {
+			otherSet = otherSets[i]
+		}
+
+		switch {
+		case set == nil:
+			c.SetsAdded++
+		case otherSet == nil:
+			c.SetsRemoved++
+		}
+
+		c.Sets = append(c.Sets, &apiv1.SetComparison{
+			Set:         set,
+			OtherSet:    otherSet,
+			WeightDelta: otherSet.GetWeight() - set.GetWeight(),
+			RepsDelta:   otherSet.GetReps() - set.GetReps(),
+		})
+	}
+
+	return c
+}
+
+func volume(sets []*apiv1.Set) float64 {
+	var v float64
+	for _, set := range sets {
+		v += set.GetWeight() * float64(set.GetReps())
+	}
+
+	return v
+}
+
+func personalBest(sets []*apiv1.Set) bool {
+	for _, set := range sets {
+		if set.GetMetadata().GetPersonalBest() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ImportFormatFromPB(format apiv1.ImportFormat) importer.Format {
+	switch format {
+	case apiv1.ImportFormat_IMPORT_FORMAT_STRONG:
+		return importer.FormatStrong
+	case apiv1.ImportFormat_IMPORT_FORMAT_HEVY:
+		return importer.FormatHevy
+	case apiv1.ImportFormat_IMPORT_FORMAT_UNSPECIFIED:
+	}
+
+	return 0
+}
+
+func ImportConflictReason(reason importer.ConflictReason) apiv1.ImportConflictReason {
+	switch reason {
+	case importer.ConflictAlreadyImported:
+		return apiv1.ImportConflictReason_IMPORT_CONFLICT_REASON_ALREADY_IMPORTED
+	case importer.ConflictOverlapsWorkout:
+		return apiv1.ImportConflictReason_IMPORT_CONFLICT_REASON_OVERLAPS_WORKOUT
+	case importer.ConflictWithoutSets:
+		return apiv1.ImportConflictReason_IMPORT_CONFLICT_REASON_WITHOUT_SETS
+	}
+
+	return apiv1.ImportConflictReason_IMPORT_CONFLICT_REASON_UNSPECIFIED
+}
+
+func ImportConflicts(conflicts []importer.Conflict) []*apiv1.ImportConflict {
+	c := make([]*apiv1.ImportConflict, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		c = append(c, &apiv1.ImportConflict{
+			WorkoutName: conflict.Workout.Name,
+			StartedAt:   timestamppb.New(conflict.Workout.StartedAt),
+			Reason:      ImportConflictReason(conflict.Reason),
+		})
+	}
+
+	return c
+}
+
+func ReactionType(reactionType orm.ReactionType) apiv1.ReactionType {
+	switch reactionType {
+	case orm.ReactionTypeThumbsUp:
+		return apiv1.ReactionType_REACTION_TYPE_THUMBS_UP
+	case orm.ReactionTypeFire:
+		return apiv1.ReactionType_REACTION_TYPE_FIRE
+	case orm.ReactionTypeFlexedBiceps:
+		return apiv1.ReactionType_REACTION_TYPE_FLEXED_BICEPS
+	case orm.ReactionTypeClappingHands:
+		return apiv1.ReactionType_REACTION_TYPE_CLAPPING_HANDS
+	}
+
+	return apiv1.ReactionType_REACTION_TYPE_UNSPECIFIED
+}
+
+func ReactionTypeFromPB(reactionType apiv1.ReactionType) orm.ReactionType {
+	switch reactionType {
+	case apiv1.ReactionType_REACTION_TYPE_FIRE:
+		return orm.ReactionTypeFire
+	case apiv1.ReactionType_REACTION_TYPE_FLEXED_BICEPS:
+		return orm.ReactionTypeFlexedBiceps
+	case apiv1.ReactionType_REACTION_TYPE_CLAPPING_HANDS:
+		return orm.ReactionTypeClappingHands
+	case apiv1.ReactionType_REACTION_TYPE_THUMBS_UP,
+		apiv1.ReactionType_REACTION_TYPE_UNSPECIFIED:
+	}
+
+	return orm.ReactionTypeThumbsUp
+}
+
+func Reaction(reaction *orm.WorkoutReaction) *apiv1.Reaction {
+	r := &apiv1.Reaction{
+		User:      nil,
+		Type:      ReactionType(reaction.Type),
+		CreatedAt: timestamppb.New(reaction.CreatedAt),
+	}
+
+	if reaction.R != nil && reaction.R.User != nil {
+		r.User = User(reaction.R.GetUser())
+	}
+
+	return r
+}
+
+func ReactionSlice(reactions orm.WorkoutReactionSlice) []*apiv1.Reaction {
+	return parseWithoutOpts(reactions, Reaction)
+}
+
+func FollowRequest(request *orm.FollowRequest) *apiv1.FollowRequest {
+	r := &apiv1.FollowRequest{
+		User:      nil,
+		CreatedAt: timestamppb.New(request.CreatedAt),
+	}
+
+	if request.R != nil && request.R.Follower != nil {
+		r.User = User(request.R.GetFollower())
+	}
+
+	return r
+}
+
+func FollowRequestSlice(requests orm.FollowRequestSlice) []*apiv1.FollowRequest {
+	return parseWithoutOpts(requests, FollowRequest)
+}
+
+func Report(report *orm.Report) *apiv1.Report {
+	r := &apiv1.Report{
+		Id:        report.ID,
+		Reporter:  nil,
+		Target:    ReportTarget(report.Target),
+		TargetId:  report.TargetID,
+		Reason:    report.Reason,
+		Status:    ReportStatus(report.Status),
+		CreatedAt: timestamppb.New(report.CreatedAt),
+	}
+
+	if report.R != nil && report.R.Reporter != nil {
+		r.Reporter = User(report.R.GetReporter())
+	}
+
+	return r
+}
+
+func ReportSlice(reports orm.ReportSlice) []*apiv1.Report {
+	return parseWithoutOpts(reports, Report)
+}
+
+func ReportTarget(target orm.ReportTarget) apiv1.ReportTarget {
+	switch target {
+	case orm.ReportTargetWorkout:
+		return apiv1.ReportTarget_REPORT_TARGET_WORKOUT
+	case orm.ReportTargetComment:
+		return apiv1.ReportTarget_REPORT_TARGET_COMMENT
+	case orm.ReportTargetUser:
+		return apiv1.ReportTarget_REPORT_TARGET_USER
+	}
+
+	return apiv1.ReportTarget_REPORT_TARGET_UNSPECIFIED
+}
+
+func ReportStatus(status orm.ReportStatus) apiv1.ReportStatus {
+	switch status {
+	case orm.ReportStatusOpen:
+		return apiv1.ReportStatus_REPORT_STATUS_OPEN
+	case orm.ReportStatusDismissed:
+		return apiv1.ReportStatus_REPORT_STATUS_DISMISSED
+	case orm.ReportStatusActioned:
+		return apiv1.ReportStatus_REPORT_STATUS_ACTIONED
+	}
+
+	return apiv1.ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func ReportStatusFromPB(status apiv1.ReportStatus) orm.ReportStatus {
+	switch status {
+	case apiv1.ReportStatus_REPORT_STATUS_OPEN:
+		return orm.ReportStatusOpen
+	case apiv1.ReportStatus_REPORT_STATUS_DISMISSED:
+		return orm.ReportStatusDismissed
+	case apiv1.ReportStatus_REPORT_STATUS_ACTIONED:
+		return orm.ReportStatusActioned
+	case apiv1.ReportStatus_REPORT_STATUS_UNSPECIFIED:
+	}
+
+	return ""
+}
+
+func LeaderboardMetricFromPB(metric apiv1.LeaderboardMetric) repo.LeaderboardMetric {
+	switch metric {
+	case apiv1.LeaderboardMetric_LEADERBOARD_METRIC_BEST_ESTIMATED_MAX:
+		return repo.LeaderboardMetricBestEstimatedMax
+	case apiv1.LeaderboardMetric_LEADERBOARD_METRIC_HEAVIEST_SINGLE:
+		return repo.LeaderboardMetricHeaviestSingle
+	case apiv1.LeaderboardMetric_LEADERBOARD_METRIC_WEEKLY_VOLUME:
+		return repo.LeaderboardMetricWeeklyVolume
+	case apiv1.LeaderboardMetric_LEADERBOARD_METRIC_SESSION_COUNT:
+		return repo.LeaderboardMetricSessionCount
+	case apiv1.LeaderboardMetric_LEADERBOARD_METRIC_UNSPECIFIED:
+	}
+
+	return ""
+}
+
+func LeaderboardEntrySlice(entries []repo.LeaderboardEntry, users orm.UserSlice) []*apiv1.LeaderboardEntry {
+	mapUsers := make(map[string]*orm.User, len(users))
+	for _, user := range users {
+		mapUsers[user.ID] = user
+	}
+
+	slice := make([]*apiv1.LeaderboardEntry, 0, len(entries))
+	for _, entry := range entries {
+		user, ok := mapUsers[entry.UserID]
+		if !ok {
+			continue
+		}
+
+		slice = append(slice, &apiv1.LeaderboardEntry{
+			Rank:  int32(entry.Rank), //nolint:gosec
+			User:  User(user),
+			Value: entry.Value,
+		})
+	}
+
+	return slice
+}
+
+// Challenge parses the challenge. Joined is derived from the loaded
+// participants, which are expected to be limited to the requesting user.
+func Challenge(challenge *orm.Challenge) *apiv1.Challenge {
+	c := &apiv1.Challenge{
+		Id:       challenge.ID,
+		Title:    challenge.Title,
+		Metric:   ChallengeMetric(challenge.Metric),
+		Goal:     challenge.Goal.Ptr(),
+		StartsAt: timestamppb.New(challenge.StartsAt),
+		EndsAt:   timestamppb.New(challenge.EndsAt),
+		Creator:  nil,
+		Joined:   false,
+		Ended:    challenge.EndedAt.Valid,
+	}
+
+	if challenge.R == nil {
+		return c
+	}
+
+	if challenge.R.Creator != nil {
+		c.Creator = User(challenge.R.GetCreator())
+	}
+
+	for _, participant := range challenge.R.GetChallengeParticipants() {
+		if participant.JoinedAt.Valid {
+			c.Joined = true
+		}
+	}
+
+	return c
+}
+
+func ChallengeSlice(challenges orm.ChallengeSlice) []*apiv1.Challenge {
+	return parseWithoutOpts(challenges, Challenge)
+}
+
+func ChallengeMetric(metric orm.ChallengeMetric) apiv1.ChallengeMetric {
+	switch metric {
+	case orm.ChallengeMetricTotalVolume:
+		return apiv1.ChallengeMetric_CHALLENGE_METRIC_TOTAL_VOLUME
+	case orm.ChallengeMetricSessionCount:
+		return apiv1.ChallengeMetric_CHALLENGE_METRIC_SESSION_COUNT
+	}
+
+	return apiv1.ChallengeMetric_CHALLENGE_METRIC_UNSPECIFIED
+}
+
+func ChallengeMetricFromPB(metric apiv1.ChallengeMetric) orm.ChallengeMetric {
+	switch metric {
+	case apiv1.ChallengeMetric_CHALLENGE_METRIC_TOTAL_VOLUME:
+		return orm.ChallengeMetricTotalVolume
+	case apiv1.ChallengeMetric_CHALLENGE_METRIC_SESSION_COUNT:
+		return orm.ChallengeMetricSessionCount
+	case apiv1.ChallengeMetric_CHALLENGE_METRIC_UNSPECIFIED:
+	}
+
+	return ""
+}
+
+func ChallengeStandingSlice(standings []repo.ChallengeStanding, users orm.UserSlice, goal null.Float64) []*apiv1.ChallengeStanding {
+	mapUsers := make(map[string]*orm.User, len(users))
+	for _, user := range users {
+		mapUsers[user.ID] = user
+	}
+
+	slice := make([]*apiv1.ChallengeStanding, 0, len(standings))
+	for _, standing := range standings {
+		user, ok := mapUsers[standing.UserID]
+		if !ok {
+			continue
+		}
+
+		slice = append(slice, &apiv1.ChallengeStanding{
+			Rank:        int32(standing.Rank), //nolint:gosec
+			User:        User(user),
+			Value:       standing.Value,
+			GoalReached: goal.Valid && standing.Value >= goal.Float64,
+		})
+	}
+
+	return slice
+}
+
+func NotificationChallenge(nType orm.NotificationType, actor *orm.User, challenge *orm.Challenge) NotificationOpt {
+	return func(n *apiv1.Notification) {
+		if challenge == nil {
+			return
+		}
+
+		var user *apiv1.User
+		if actor != nil {
+			user = User(actor)
+		}
+
+		switch nType {
+		case orm.NotificationTypeChallengeInvite:
+			n.Type = &apiv1.Notification_ChallengeInvite_{
+				ChallengeInvite: &apiv1.Notification_ChallengeInvite{
+					Actor:     user,
+					Challenge: Challenge(challenge),
+				},
+			}
+		case orm.NotificationTypeChallengeLeadTaken:
+			n.Type = &apiv1.Notification_ChallengeLeadTaken_{
+				ChallengeLeadTaken: &apiv1.Notification_ChallengeLeadTaken{
+					Actor:     user,
+					Challenge: Challenge(challenge),
+				},
+			}
+		case orm.NotificationTypeChallengeEnded:
+			n.Type = &apiv1.Notification_ChallengeEnded_{
+				ChallengeEnded: &apiv1.Notification_ChallengeEnded{
+					Winner:    user,
+					Challenge: Challenge(challenge),
+				},
+			}
+		}
+	}
+}
+
+// Club parses the club. The membership is taken from the loaded members,
+// which are expected to be limited to the requesting user.
+func Club(club *orm.Club) *apiv1.Club {
+	c := &apiv1.Club{
+		Id:          club.ID,
+		Name:        club.Name,
+		Description: club.Description.String,
+		CreatedAt:   timestamppb.New(club.CreatedAt),
+		Membership:  nil,
+	}
+
+	if club.R != nil && len(club.R.GetClubMembers()) > 0 {
+		member := club.R.GetClubMembers()[0]
+		c.Membership = &apiv1.ClubMembership{
+			Role:   ClubRole(member.Role),
+			Status: ClubMemberStatus(member.Status),
+		}
+	}
+
+	return c
+}
+
+func ClubSlice(clubs orm.ClubSlice) []*apiv1.Club {
+	return parseWithoutOpts(clubs, Club)
+}
+
+func ClubMember(member *orm.ClubMember) *apiv1.ClubMember {
+	m := &apiv1.ClubMember{
+		User:   nil,
+		Role:   ClubRole(member.Role),
+		Status: ClubMemberStatus(member.Status),
+	}
+
+	if member.R != nil && member.R.User != nil {
+		m.User = User(member.R.GetUser())
+	}
+
+	return m
+}
+
+func ClubMemberSlice(members orm.ClubMemberSlice) []*apiv1.ClubMember {
+	return parseWithoutOpts(members, ClubMember)
+}
+
+func ClubRole(role orm.ClubRole) apiv1.ClubRole {
+	switch role {
+	case orm.ClubRoleOwner:
+		return apiv1.ClubRole_CLUB_ROLE_OWNER
+	case orm.ClubRoleAdmin:
+		return apiv1.ClubRole_CLUB_ROLE_ADMIN
+	case orm.ClubRoleMember:
+		return apiv1.ClubRole_CLUB_ROLE_MEMBER
+	}
+
+	return apiv1.ClubRole_CLUB_ROLE_UNSPECIFIED
+}
+
+func ClubRoleFromPB(role apiv1.ClubRole) orm.ClubRole {
+	switch role {
+	case apiv1.ClubRole_CLUB_ROLE_OWNER:
+		return orm.ClubRoleOwner
+	case apiv1.ClubRole_CLUB_ROLE_ADMIN:
+		return orm.ClubRoleAdmin
+	case apiv1.ClubRole_CLUB_ROLE_MEMBER:
+		return orm.ClubRoleMember
+	case apiv1.ClubRole_CLUB_ROLE_UNSPECIFIED:
+	}
+
+	return ""
+}
+
+func ClubMemberStatus(status orm.ClubMemberStatus) apiv1.ClubMemberStatus {
+	switch status {
+	case orm.ClubMemberStatusInvited:
+		return apiv1.ClubMemberStatus_CLUB_MEMBER_STATUS_INVITED
+	case orm.ClubMemberStatusRequested:
+		return apiv1.ClubMemberStatus_CLUB_MEMBER_STATUS_REQUESTED
+	case orm.ClubMemberStatusActive:
+		return apiv1.ClubMemberStatus_CLUB_MEMBER_STATUS_ACTIVE
+	}
+
+	return apiv1.ClubMemberStatus_CLUB_MEMBER_STATUS_UNSPECIFIED
+}
+
+func ClubMemberStatusFromPB(status apiv1.ClubMemberStatus) orm.ClubMemberStatus {
+	switch status {
+	case apiv1.ClubMemberStatus_CLUB_MEMBER_STATUS_INVITED:
+		return orm.ClubMemberStatusInvited
+	case apiv1.ClubMemberStatus_CLUB_MEMBER_STATUS_REQUESTED:
+		return orm.ClubMemberStatusRequested
+	case apiv1.ClubMemberStatus_CLUB_MEMBER_STATUS_ACTIVE:
+		return orm.ClubMemberStatusActive
+	case apiv1.ClubMemberStatus_CLUB_MEMBER_STATUS_UNSPECIFIED:
+	}
+
+	return ""
+}
+
+func AthleteSummarySlice(summaries []repo.AthleteSummary, athletes orm.UserSlice) []*apiv1.AthleteSummary {
+	mapAthletes := make(map[string]*orm.User, len(athletes))
+	for _, athlete := range athletes {
+		mapAthletes[athlete.ID] = athlete
+	}
+
+	slice := make([]*apiv1.AthleteSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		athlete, ok := mapAthletes[summary.AthleteID]
+		if !ok {
+			continue
+		}
+
+		var lastSessionAt *timestamppb.Timestamp
+		if summary.LastSessionAt.Valid {
+			lastSessionAt = timestamppb.New(summary.LastSessionAt.Time)
+		}
+
+		slice = append(slice, &apiv1.AthleteSummary{
+			Athlete:       User(athlete),
+			LastSessionAt: lastSessionAt,
+			AcuteLoad:     summary.AcuteLoad,
+			ChronicLoad:   summary.ChronicLoad,
+		})
+	}
+
+	return slice
+}
+
+func CoachAction(action *orm.CoachAction) *apiv1.CoachAction {
+	a := &apiv1.CoachAction{
+		Id:        action.ID,
+		Coach:     nil,
+		Type:      CoachActionType(action.Action),
+		TargetId:  action.TargetID.Ptr(),
+		CreatedAt: timestamppb.New(action.CreatedAt),
+	}
+
+	if action.R != nil && action.R.Coach != nil {
+		a.Coach = User(action.R.GetCoach())
+	}
+
+	return a
+}
+
+func CoachActionSlice(actions orm.CoachActionSlice) []*apiv1.CoachAction {
+	return parseWithoutOpts(actions, CoachAction)
+}
+
+func CoachActionType(action orm.CoachActionType) apiv1.CoachActionType {
+	switch action {
+	case orm.CoachActionTypeViewDashboard:
+		return apiv1.CoachActionType_COACH_ACTION_TYPE_VIEW_DASHBOARD
+	case orm.CoachActionTypeViewWorkouts:
+		return apiv1.CoachActionType_COACH_ACTION_TYPE_VIEW_WORKOUTS
+	case orm.CoachActionTypeViewPersonalBests:
+		return apiv1.CoachActionType_COACH_ACTION_TYPE_VIEW_PERSONAL_BESTS
+	case orm.CoachActionTypeCreateRoutine:
+		return apiv1.CoachActionType_COACH_ACTION_TYPE_CREATE_ROUTINE
+	case orm.CoachActionTypeUpdateRoutine:
+		return apiv1.CoachActionType_COACH_ACTION_TYPE_UPDATE_ROUTINE
+	}
+
+	return apiv1.CoachActionType_COACH_ACTION_TYPE_UNSPECIFIED
+}
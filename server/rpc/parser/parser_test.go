@@ -5,10 +5,14 @@ import (
 	"database/sql"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
+	"github.com/volatiletech/null/v8"
 
 	"github.com/crlssn/getstronger/server/gen/orm"
+	apiv1 "github.com/crlssn/getstronger/server/gen/proto/api/v1"
 	"github.com/crlssn/getstronger/server/repo"
 	"github.com/crlssn/getstronger/server/rpc/parser"
 	"github.com/crlssn/getstronger/server/testing/container"
@@ -100,6 +104,11 @@ func (s *parserSuite) TestRoutine() {
 
 	s.Require().Equal(routine.ID, parsed.GetId())
 	s.Require().Equal(routine.Title, parsed.GetName())
+	s.Require().Equal(routine.UserID, parsed.GetUserId())
+	s.Require().Equal(apiv1.RoutineVisibility_ROUTINE_VISIBILITY_PRIVATE, parsed.GetVisibility())
+	s.Require().Empty(parsed.GetShareToken())
+	s.Require().Empty(parsed.GetClonedFromRoutineId())
+	s.Require().Zero(parsed.GetCloneCount())
 	s.Require().Nil(parsed.GetExercises())
 
 	routine = s.factory.NewRoutine()
@@ -205,7 +214,7 @@ func (s *parserSuite) TestWorkoutSlice() {
 			workouts[0].R.Sets[0],
 		}
 
-		parsed, err := parser.WorkoutSlice(workouts, personalBests)
+		parsed, err := parser.WorkoutSlice(workouts, personalBests, "")
 		s.Require().NoError(err)
 		s.Require().Len(parsed, len(workouts))
 
@@ -241,7 +250,7 @@ func (s *parserSuite) TestWorkoutSlice() {
 		workout := s.factory.NewWorkout()
 		workout.R = nil
 
-		parsed, err := parser.WorkoutSlice(orm.WorkoutSlice{workout}, nil)
+		parsed, err := parser.WorkoutSlice(orm.WorkoutSlice{workout}, nil, "")
 		s.Require().NoError(err)
 		s.Require().Len(parsed, 1)
 		s.Require().Equal(workout.ID, parsed[0].GetId())
@@ -265,6 +274,86 @@ func (s *parserSuite) TestWorkoutComment() {
 	s.Require().Empty(parsed.GetUser().GetId())
 	s.Require().Equal(comment.Comment, parsed.GetComment())
 	s.Require().True(comment.CreatedAt.Equal(parsed.GetCreatedAt().AsTime()))
+	s.Require().Nil(parsed.GetEditedAt())
+
+	comment.EditedAt = null.TimeFrom(time.Now().UTC())
+	parsed = parser.WorkoutComment(comment)
+	s.Require().True(comment.EditedAt.Time.Equal(parsed.GetEditedAt().AsTime()))
+}
+
+func (s *parserSuite) TestWorkoutCommentThreads() {
+	now := time.Now().UTC()
+	comments := make(orm.WorkoutCommentSlice, 0, parser.MaxCommentDepth+3)
+	comments = append(comments, &orm.WorkoutComment{ID: uuid.NewString(), CreatedAt: now})
+	for i := range parser.MaxCommentDepth + 1 {
+		comments = append(comments, &orm.WorkoutComment{
+			ID:              uuid.NewString(),
+			ParentCommentID: null.StringFrom(comments[i].ID),
+			CreatedAt:       now.Add(time.Duration(i+1) * time.Minute),
+		})
+	}
+	comments = append(comments, &orm.WorkoutComment{ID: uuid.NewString(), CreatedAt: now.Add(-time.Minute)})
+
+	threads := parser.WorkoutCommentThreads(comments)
+	s.Require().Len(threads, 2)
+	s.Require().Equal(comments[len(comments)-1].ID, threads[0].GetId())
+	s.Require().Empty(threads[0].GetReplies())
+
+	thread := threads[1]
+	s.Require().Equal(comments[0].ID, thread.GetId())
+	for i := 1; i < parser.MaxCommentDepth; i++ {
+		s.Require().Len(thread.GetReplies(), 1)
+		thread = thread.GetReplies()[0]
+		s.Require().Equal(comments[i].ID, thread.GetId())
+	}
+
+	// The reply beyond the maximum depth is flattened into the deepest level.
+	s.Require().Len(thread.GetReplies(), 2)
+	s.Require().Equal(comments[parser.MaxCommentDepth].ID, thread.GetReplies()[0].GetId())
+	s.Require().Equal(comments[parser.MaxCommentDepth+1].ID, thread.GetReplies()[1].GetId())
+	s.Require().Equal(comments[parser.MaxCommentDepth].ID, thread.GetReplies()[1].GetParentCommentId())
+	s.Require().Empty(thread.GetReplies()[0].GetReplies())
+}
+
+func (s *parserSuite) TestWorkoutReactions() {
+	userID := uuid.NewString()
+	reactions := orm.WorkoutReactionSlice{
+		{UserID: uuid.NewString(), Type: orm.ReactionTypeFire},
+		{UserID: userID, Type: orm.ReactionTypeClappingHands},
+		{UserID: uuid.NewString(), Type: orm.ReactionTypeFire},
+	}
+
+	workout := new(apiv1.Workout)
+	parser.WorkoutReactions(reactions, userID)(workout)
+	s.Require().Equal(apiv1.ReactionType_REACTION_TYPE_CLAPPING_HANDS, workout.GetReaction())
+	s.Require().Len(workout.GetReactionCounts(), 2)
+	s.Require().Equal(apiv1.ReactionType_REACTION_TYPE_FIRE, workout.GetReactionCounts()[0].GetType())
+	s.Require().Equal(int32(2), workout.GetReactionCounts()[0].GetCount())
+	s.Require().Equal(apiv1.ReactionType_REACTION_TYPE_CLAPPING_HANDS, workout.GetReactionCounts()[1].GetType())
+	s.Require().Equal(int32(1), workout.GetReactionCounts()[1].GetCount())
+
+	workout = new(apiv1.Workout)
+	parser.WorkoutReactions(nil, userID)(workout)
+	s.Require().Equal(apiv1.ReactionType_REACTION_TYPE_UNSPECIFIED, workout.GetReaction())
+	s.Require().Empty(workout.GetReactionCounts())
+}
+
+func (s *parserSuite) TestReactionType() {
+	for _, reactionType := range orm.AllReactionType() {
+		s.Require().Equal(reactionType, parser.ReactionTypeFromPB(parser.ReactionType(reactionType)))
+	}
+}
+
+func (s *parserSuite) TestMentions() {
+	s.Require().Nil(parser.Mentions(nil))
+	s.Require().Empty(parser.Mentions([]byte(`[]`)))
+
+	userID := uuid.NewString()
+	mentions := parser.Mentions([]byte(`[{"userId":"` + userID + `","start":4,"end":9}]`))
+	s.Require().Len(mentions, 1)
+	s.Require().Equal(userID, mentions[0].GetUserId())
+	s.Require().Equal(int32(4), mentions[0].GetStart())
+	s.Require().Equal(int32(9), mentions[0].GetEnd())
 }
 
 func (s *parserSuite) TestExerciseSetsSlice() {
@@ -339,6 +428,31 @@ func (s *parserSuite) TestExerciseSetsFromPB() {
 	}
 }
 
+func (s *parserSuite) TestRoutineExerciseTargets() {
+	sets := orm.SetSlice{
+		{ExerciseID: "1", Weight: 100, Reps: 5},
+		{ExerciseID: "2", Weight: 60, Reps: 8},
+		{ExerciseID: "1", Weight: 105, Reps: 3},
+	}
+
+	targets := parser.RoutineExerciseTargets(sets)
+	s.Require().Equal([]repo.RoutineExerciseTarget{
+		{ExerciseID: "1", Sets: []repo.RoutineTargetSet{{Weight: 100, Reps: 5}, {Weight: 105, Reps: 3}}},
+		{ExerciseID: "2", Sets: []repo.RoutineTargetSet{{Weight: 60, Reps: 8}}},
+	}, targets)
+
+	parsed := parser.ExerciseTargetSlice(targets)
+	s.Require().Len(parsed, len(targets))
+	for i, target := range parsed {
+		s.Require().Equal(targets[i].ExerciseID, target.GetExerciseId())
+		s.Require().Len(target.GetSets(), len(targets[i].Sets))
+		for j, set := range target.GetSets() {
+			s.Require().InEpsilon(targets[i].Sets[j].Weight, set.GetWeight(), 0)
+			s.Require().Equal(targets[i].Sets[j].Reps, int(set.GetReps()))
+		}
+	}
+}
+
 func (s *parserSuite) TestNotification() {
 	notification := s.factory.NewNotification(
 		factory.NotificationType(orm.NotificationTypeWorkoutComment),
@@ -455,7 +569,7 @@ func (s *parserSuite) TestNotificationSlice() {
 		),
 	}
 
-	parsed, err := parser.NotificationSlice(notifications, actors, workouts)
+	parsed, err := parser.NotificationSlice(notifications, actors, workouts, nil, nil)
 	s.Require().NoError(err)
 	s.Require().Len(parsed, len(notifications))
 	for i, notification := range parsed {
@@ -521,7 +635,7 @@ func (s *parserSuite) TestFeedItemSlice() {
 		workout.R.Sets = s.factory.NewSetSlice(1, factory.SetWorkoutID(workout.ID))
 	}
 
-	parsed, err := parser.FeedItemSlice(workouts, nil)
+	parsed, err := parser.FeedItemSlice(parser.FeedSources{Workouts: workouts}, "")
 	s.Require().NoError(err)
 	s.Require().Len(parsed, len(workouts))
 	for i, feedItem := range parsed {
@@ -602,3 +716,63 @@ func (s *parserSuite) TestSetSlice() {
 		s.Require().Equal(i == 0, set.GetMetadata().GetPersonalBest())
 	}
 }
+
+func (s *parserSuite) TestExerciseComparisonSlice() {
+	workout := &apiv1.Workout{
+		ExerciseSets: []*apiv1.ExerciseSets{
+			{
+				Exercise: &apiv1.Exercise{Id: "1", Name: "Squat"},
+				Sets: []*apiv1.Set{
+					{Weight: 100, Reps: 5},
+					{Weight: 100, Reps: 5},
+				},
+			},
+			{
+				Exercise: &apiv1.Exercise{Id: "2", Name: "Bench Press"},
+				Sets: []*apiv1.Set{
+					{Weight: 80, Reps: 5},
+				},
+			},
+		},
+	}
+
+	other := &apiv1.Workout{
+		ExerciseSets: []*apiv1.ExerciseSets{
+			{
+				Exercise: &apiv1.Exercise{Id: "3", Name: "squat "},
+				Sets: []*apiv1.Set{
+					{Weight: 105, Reps: 3, Metadata: &apiv1.MetadataSet{PersonalBest: true}},
+				},
+			},
+			{
+				Exercise: &apiv1.Exercise{Id: "4", Name: "Deadlift"},
+				Sets: []*apiv1.Set{
+					{Weight: 140, Reps: 5},
+				},
+			},
+		},
+	}
+
+	parsed := parser.ExerciseComparisonSlice(workout, other)
+	s.Require().Len(parsed, 3)
+
+	s.Require().Equal("1", parsed[0].GetExercise().GetId())
+	s.Require().Equal("3", parsed[0].GetOtherExercise().GetId())
+	s.Require().Len(parsed[0].GetSets(), 2)
+	s.Require().InDelta(5, parsed[0].GetSets()[0].GetWeightDelta(), 0)
+	s.Require().Equal(int32(-2), parsed[0].GetSets()[0].GetRepsDelta())
+	s.Require().Equal(int32(0), parsed[0].GetSetsAdded())
+	s.Require().Equal(int32(1), parsed[0].GetSetsRemoved())
+	s.Require().InDelta(315-1000, parsed[0].GetVolumeDelta(), 0)
+	s.Require().False(parsed[0].GetPersonalBest())
+	s.Require().True(parsed[0].GetOtherPersonalBest())
+
+	s.Require().Equal("2", parsed[1].GetExercise().GetId())
+	s.Require().Nil(parsed[1].GetOtherExercise())
+	s.Require().Equal(int32(1), parsed[1].GetSetsRemoved())
+
+	s.Require().Nil(parsed[2].GetExercise())
+	s.Require().Equal("4", parsed[2].GetOtherExercise().GetId())
+	s.Require().Equal(int32(1), parsed[2].GetSetsAdded())
+	s.Require().InDelta(700, parsed[2].GetVolumeDelta(), 0)
+}
@@ -0,0 +1,88 @@
+package strength
+
+import "math"
+
+type Sex int
+
+const (
+	Male Sex = iota
+	Female
+)
+
+// EstimatedOneRepMax returns the one rep max estimated with the Epley formula
+// from a set of the given weight and reps.
+func EstimatedOneRepMax(weight float64, reps int) float64 {
+	if reps <= 1 {
+		return weight
+	}
+
+	return weight * (1 + float64(reps)/30) //nolint:mnd
+}
+
+type bodyweightRange struct {
+	min float64
+	max float64
+}
+
+func (r bodyweightRange) clamp(bodyweight float64) float64 {
+	return math.Min(math.Max(bodyweight, r.min), r.max)
+}
+
+var (
+	wilksCoefficients = map[Sex][6]float64{
+		Male:   {-216.0475144, 16.2606339, -0.002388645, -0.00113732, 7.01863e-06, -1.291e-08},
+		Female: {594.31747775582, -27.23842536447, 0.82112226871, -0.00930733913, 4.731582e-05, -9.054e-08},
+	}
+	wilksBodyweightRanges = map[Sex]bodyweightRange{
+		Male:   {min: 40, max: 201.9},
+		Female: {min: 26.51, max: 154.53},
+	}
+)
+
+// Wilks returns the Wilks points for a total lifted at the given bodyweight,
+// both in kilograms.
+func Wilks(sex Sex, bodyweight, total float64) float64 {
+	c := wilksCoefficients[sex]
+	x := wilksBodyweightRanges[sex].clamp(bodyweight)
+
+	denominator := c[0] + c[1]*x + c[2]*math.Pow(x, 2) + c[3]*math.Pow(x, 3) + c[4]*math.Pow(x, 4) + c[5]*math.Pow(x, 5)
+	return total * 500 / denominator
+}
+
+var (
+	dotsCoefficients = map[Sex][5]float64{
+		Male:   {-0.000001093, 0.0007391293, -0.1918759221, 24.0900756, -307.75076},
+		Female: {-0.0000010706, 0.0005158568, -0.1126655495, 13.6175032, -57.96288},
+	}
+	dotsBodyweightRanges = map[Sex]bodyweightRange{
+		Male:   {min: 40, max: 210},
+		Female: {min: 40, max: 150},
+	}
+)
+
+// DOTS returns the DOTS points for a total lifted at the given bodyweight,
+// both in kilograms.
+func DOTS(sex Sex, bodyweight, total float64) float64 {
+	c := dotsCoefficients[sex]
+	x := dotsBodyweightRanges[sex].clamp(bodyweight)
+
+	denominator := c[0]*math.Pow(x, 4) + c[1]*math.Pow(x, 3) + c[2]*math.Pow(x, 2) + c[3]*x + c[4]
+	return total * 500 / denominator
+}
+
+// ipfGLCoefficients are the 2020 IPF coefficients for classic (raw) powerlifting.
+var ipfGLCoefficients = map[Sex][3]float64{
+	Male:   {1199.72839, 1025.18162, 0.00921},
+	Female: {610.32796, 1045.59282, 0.03048},
+}
+
+// IPFGL returns the IPF GL points for a total lifted at the given bodyweight,
+// both in kilograms.
+func IPFGL(sex Sex, bodyweight, total float64) float64 {
+	if bodyweight < 35 { //nolint:mnd
+		return 0
+	}
+
+	c := ipfGLCoefficients[sex]
+	return total * 100 / (c[0] - c[1]*math.Exp(-c[2]*bodyweight))
+}
@@ -0,0 +1,38 @@
+package strength_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/crlssn/getstronger/server/strength"
+)
+
+const delta = 0.01
+
+func TestEstimatedOneRepMax(t *testing.T) {
+	t.Parallel()
+	require.InDelta(t, 116.67, strength.EstimatedOneRepMax(100, 5), delta)
+	require.InDelta(t, 100.00, strength.EstimatedOneRepMax(100, 1), delta)
+}
+
+func TestWilks(t *testing.T) {
+	t.Parallel()
+	require.InDelta(t, 439.73, strength.Wilks(strength.Male, 93, 700), delta)
+	require.InDelta(t, 429.58, strength.Wilks(strength.Female, 63, 400), delta)
+	require.InDelta(t, strength.Wilks(strength.Male, 40, 300), strength.Wilks(strength.Male, 30, 300), delta)
+}
+
+func TestDOTS(t *testing.T) {
+	t.Parallel()
+	require.InDelta(t, 445.38, strength.DOTS(strength.Male, 93, 700), delta)
+	require.InDelta(t, 430.21, strength.DOTS(strength.Female, 63, 400), delta)
+	require.InDelta(t, strength.DOTS(strength.Female, 150, 500), strength.DOTS(strength.Female, 180, 500), delta)
+}
+
+func TestIPFGL(t *testing.T) {
+	t.Parallel()
+	require.InDelta(t, 91.57, strength.IPFGL(strength.Male, 93, 700), delta)
+	require.InDelta(t, 87.51, strength.IPFGL(strength.Female, 63, 400), delta)
+	require.Zero(t, strength.IPFGL(strength.Male, 30, 300))
+}
@@ -95,3 +95,9 @@ func ExerciseDeleted() ExerciseOpt {
 		m.DeletedAt = null.TimeFrom(time.Now())
 	}
 }
+
+func ExerciseCatalogExercise(catalogExercise orm.CatalogExercise) ExerciseOpt {
+	return func(m *orm.Exercise) {
+		m.CatalogExercise = orm.NullCatalogExerciseFrom(catalogExercise)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 
 	"github.com/crlssn/getstronger/server/gen/orm"
@@ -79,3 +80,21 @@ func UserFirstName(firstName string) UserOpt {
 		m.FirstName = firstName
 	}
 }
+
+func UserPrivate(private bool) UserOpt {
+	return func(m *orm.User) {
+		m.Private = private
+	}
+}
+
+func UserSex(sex orm.Sex) UserOpt {
+	return func(m *orm.User) {
+		m.Sex = orm.NullSexFrom(sex)
+	}
+}
+
+func UserBodyweight(bodyweight float64) UserOpt {
+	return func(m *orm.User) {
+		m.Bodyweight = null.Float64From(bodyweight)
+	}
+}
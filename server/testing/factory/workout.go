@@ -92,6 +92,19 @@ func WorkoutCreatedAt(createdAt time.Time) WorkoutOpt {
 	}
 }
 
+func WorkoutFinishedAt(finishedAt time.Time) WorkoutOpt {
+	return func(workout *orm.Workout) {
+		workout.StartedAt = finishedAt.Add(-time.Hour)
+		workout.FinishedAt = finishedAt
+	}
+}
+
+func WorkoutVisibility(visibility orm.WorkoutVisibility) WorkoutOpt {
+	return func(workout *orm.Workout) {
+		workout.Visibility = visibility
+	}
+}
+
 func (f *Factory) NewWorkoutCommentSlice(count int, opts ...WorkoutCommentOpt) orm.WorkoutCommentSlice {
 	var slice orm.WorkoutCommentSlice
 	for range count {
@@ -169,3 +182,51 @@ func WorkoutCommentWorkoutID(workoutID string) WorkoutCommentOpt {
 		comment.WorkoutID = workoutID
 	}
 }
+
+func WorkoutCommentParentCommentID(parentCommentID string) WorkoutCommentOpt {
+	return func(comment *orm.WorkoutComment) {
+		comment.ParentCommentID = null.StringFrom(parentCommentID)
+	}
+}
+
+type WorkoutReactionOpt func(reaction *orm.WorkoutReaction)
+
+func (f *Factory) NewWorkoutReaction(opts ...WorkoutReactionOpt) *orm.WorkoutReaction {
+	m := &orm.WorkoutReaction{
+		ID:        uuid.NewString(),
+		UserID:    "",
+		WorkoutID: "",
+		Type:      orm.ReactionType(f.Faker.RandomString([]string{orm.ReactionTypeThumbsUp.String(), orm.ReactionTypeFire.String()})),
+		CreatedAt: time.Time{},
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if m.UserID == "" {
+		m.UserID = f.NewUser().ID
+	}
+
+	if m.WorkoutID == "" {
+		m.WorkoutID = f.NewWorkout().ID
+	}
+
+	if err := m.Insert(context.Background(), f.db, boil.Infer()); err != nil {
+		panic(fmt.Errorf("failed to insert workout reaction: %w", err))
+	}
+
+	return m
+}
+
+func WorkoutReactionUserID(userID string) WorkoutReactionOpt {
+	return func(reaction *orm.WorkoutReaction) {
+		reaction.UserID = userID
+	}
+}
+
+func WorkoutReactionWorkoutID(workoutID string) WorkoutReactionOpt {
+	return func(reaction *orm.WorkoutReaction) {
+		reaction.WorkoutID = workoutID
+	}
+}
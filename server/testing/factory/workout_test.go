@@ -87,6 +87,16 @@ func TestFactory_Workout(t *testing.T) {
 		require.WithinDuration(t, createdAt, created.CreatedAt, time.Second)
 	})
 
+	t.Run("WorkoutFinishedAt", func(t *testing.T) {
+		t.Parallel()
+		finishedAt := time.Now().Add(-24 * time.Hour)
+		expected := f.NewWorkout(factory.WorkoutFinishedAt(finishedAt))
+		created, err := orm.FindWorkout(ctx, c.DB, expected.ID)
+		require.NoError(t, err)
+		require.WithinDuration(t, finishedAt, created.FinishedAt, time.Second)
+		require.True(t, created.StartedAt.Before(created.FinishedAt))
+	})
+
 	t.Cleanup(func() {
 		require.NoError(t, c.Terminate(ctx))
 	})
@@ -17,3 +17,15 @@ func FiledRoutineID(id string) zap.Field {
 func FieldExerciseID(exerciseID string) zap.Field {
 	return zap.String("exercise_id", exerciseID)
 }
+
+func FieldChallengeID(challengeID string) zap.Field {
+	return zap.String("challenge_id", challengeID)
+}
+
+func FieldClubID(clubID string) zap.Field {
+	return zap.String("club_id", clubID)
+}
+
+func FieldAthleteID(athleteID string) zap.Field {
+	return zap.String("athlete_id", athleteID)
+}
@@ -0,0 +1,309 @@
+// @generated by protoc-gen-es v2.2.3 with parameter "target=ts"
+// @generated from file api/v1/admin_service.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import { file_api_v1_options } from "./options_pb";
+import type { PaginationRequest, PaginationResponse, User } from "./shared_pb";
+import { file_api_v1_shared } from "./shared_pb";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/admin_service.proto.
+ */
+export const file_api_v1_admin_service: GenFile = /*@__PURE__*/
+  fileDesc("ChphcGkvdjEvYWRtaW5fc2VydmljZS5wcm90bxIGYXBpLnYxInsKEkxpc3RSZXBvcnRzUmVxdWVzdBIuCgZzdGF0dXMYASABKA4yFC5hcGkudjEuUmVwb3J0U3RhdHVzQgi6SAWCAQIQARI1CgpwYWdpbmF0aW9uGAIgASgLMhkuYXBpLnYxLlBhZ2luYXRpb25SZXF1ZXN0Qga6SAPIAQEiZgoTTGlzdFJlcG9ydHNSZXNwb25zZRIfCgdyZXBvcnRzGAEgAygLMg4uYXBpLnYxLlJlcG9ydBIuCgpwYWdpbmF0aW9uGAIgASgLMhouYXBpLnYxLlBhZ2luYXRpb25SZXNwb25zZSIxChJIaWRlQ29udGVudFJlcXVlc3QSGwoJcmVwb3J0X2lkGAEgASgJQgi6SAVyA7ABASIVChNIaWRlQ29udGVudFJlc3BvbnNlIjMKFERpc21pc3NSZXBvcnRSZXF1ZXN0EhsKCXJlcG9ydF9pZBgBIAEoCUIIukgFcgOwAQEiFwoVRGlzbWlzc1JlcG9ydFJlc3BvbnNlIjEKElN1c3BlbmRVc2VyUmVxdWVzdBIbCglyZXBvcnRfaWQYASABKAlCCLpIBXIDsAEBIhUKE1N1c3BlbmRVc2VyUmVzcG9uc2Ui0wEKBlJlcG9ydBIKCgJpZBgBIAEoCRIeCghyZXBvcnRlchgCIAEoCzIMLmFwaS52MS5Vc2VyEiQKBnRhcmdldBgDIAEoDjIULmFwaS52MS5SZXBvcnRUYXJnZXQSEQoJdGFyZ2V0X2lkGAQgASgJEg4KBnJlYXNvbhgFIAEoCRIkCgZzdGF0dXMYBiABKA4yFC5hcGkudjEuUmVwb3J0U3RhdHVzEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wKnsKDFJlcG9ydFRhcmdldBIdChlSRVBPUlRfVEFSR0VUX1VOU1BFQ0lGSUVEEAASGQoVUkVQT1JUX1RBUkdFVF9XT1JLT1VUEAESGQoVUkVQT1JUX1RBUkdFVF9DT01NRU5UEAISFgoSUkVQT1JUX1RBUkdFVF9VU0VSEAMqfgoMUmVwb3J0U3RhdHVzEh0KGVJFUE9SVF9TVEFUVVNfVU5TUEVDSUZJRUQQABIWChJSRVBPUlRfU1RBVFVTX09QRU4QARIbChdSRVBPUlRfU1RBVFVTX0RJU01JU1NFRBACEhoKFlJFUE9SVF9TVEFUVVNfQUNUSU9ORUQQAzLcAgoMQWRtaW5TZXJ2aWNlElAKC0xpc3RSZXBvcnRzEhouYXBpLnYxLkxpc3RSZXBvcnRzUmVxdWVzdBobLmFwaS52MS5MaXN0UmVwb3J0c1Jlc3BvbnNlIgiItRgBkLUYARJQCgtIaWRlQ29udGVudBIaLmFwaS52MS5IaWRlQ29udGVudFJlcXVlc3QaGy5hcGkudjEuSGlkZUNvbnRlbnRSZXNwb25zZSIIiLUYAZC1GAESVgoNRGlzbWlzc1JlcG9ydBIcLmFwaS52MS5EaXNtaXNzUmVwb3J0UmVxdWVzdBodLmFwaS52MS5EaXNtaXNzUmVwb3J0UmVzcG9uc2UiCIi1GAGQtRgBElAKC1N1c3BlbmRVc2VyEhouYXBpLnYxLlN1c3BlbmRVc2VyUmVxdWVzdBobLmFwaS52MS5TdXNwZW5kVXNlclJlc3BvbnNlIgiItRgBkLUYAUKVAQoKY29tLmFwaS52MUIRQWRtaW5TZXJ2aWNlUHJvdG9QAVo7Z2l0aHViLmNvbS9jcmxzc24vZ2V0c3Ryb25nZXIvc2VydmVyL2dlbi9wcm90by9hcGkvdjE7YXBpdjGiAgNBWFiqAgZBcGkuVjHKAgZBcGlcVjHiAhJBcGlcVjFcR1BCTWV0YWRhdGHqAgdBcGk6OlYxYgZwcm90bzM", [file_api_v1_options, file_api_v1_shared, file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message api.v1.ListReportsRequest
+ */
+export type ListReportsRequest = Message<"api.v1.ListReportsRequest"> & {
+  /**
+   * Lists reports of all statuses if unspecified.
+   *
+   * @generated from field: api.v1.ReportStatus status = 1;
+   */
+  status: ReportStatus;
+
+  /**
+   * @generated from field: api.v1.PaginationRequest pagination = 2;
+   */
+  pagination?: PaginationRequest;
+};
+
+/**
+ * Describes the message api.v1.ListReportsRequest.
+ * Use `create(ListReportsRequestSchema)` to create a new message.
+ */
+export const ListReportsRequestSchema: GenMessage<ListReportsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_admin_service, 0);
+
+/**
+ * @generated from message api.v1.ListReportsResponse
+ */
+export type ListReportsResponse = Message<"api.v1.ListReportsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Report reports = 1;
+   */
+  reports: Report[];
+
+  /**
+   * @generated from field: api.v1.PaginationResponse pagination = 2;
+   */
+  pagination?: PaginationResponse;
+};
+
+/**
+ * Describes the message api.v1.ListReportsResponse.
+ * Use `create(ListReportsResponseSchema)` to create a new message.
+ */
+export const ListReportsResponseSchema: GenMessage<ListReportsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_admin_service, 1);
+
+/**
+ * HideContent hides the reported workout or comment and resolves the report.
+ *
+ * @generated from message api.v1.HideContentRequest
+ */
+export type HideContentRequest = Message<"api.v1.HideContentRequest"> & {
+  /**
+   * @generated from field: string report_id = 1;
+   */
+  reportId: string;
+};
+
+/**
+ * Describes the message api.v1.HideContentRequest.
+ * Use `create(HideContentRequestSchema)` to create a new message.
+ */
+export const HideContentRequestSchema: GenMessage<HideContentRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_admin_service, 2);
+
+/**
+ * @generated from message api.v1.HideContentResponse
+ */
+export type HideContentResponse = Message<"api.v1.HideContentResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.HideContentResponse.
+ * Use `create(HideContentResponseSchema)` to create a new message.
+ */
+export const HideContentResponseSchema: GenMessage<HideContentResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_admin_service, 3);
+
+/**
+ * @generated from message api.v1.DismissReportRequest
+ */
+export type DismissReportRequest = Message<"api.v1.DismissReportRequest"> & {
+  /**
+   * @generated from field: string report_id = 1;
+   */
+  reportId: string;
+};
+
+/**
+ * Describes the message api.v1.DismissReportRequest.
+ * Use `create(DismissReportRequestSchema)` to create a new message.
+ */
+export const DismissReportRequestSchema: GenMessage<DismissReportRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_admin_service, 4);
+
+/**
+ * @generated from message api.v1.DismissReportResponse
+ */
+export type DismissReportResponse = Message<"api.v1.DismissReportResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.DismissReportResponse.
+ * Use `create(DismissReportResponseSchema)` to create a new message.
+ */
+export const DismissReportResponseSchema: GenMessage<DismissReportResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_admin_service, 5);
+
+/**
+ * SuspendUser suspends the author of the reported content, or the reported
+ * user, and resolves the report.
+ *
+ * @generated from message api.v1.SuspendUserRequest
+ */
+export type SuspendUserRequest = Message<"api.v1.SuspendUserRequest"> & {
+  /**
+   * @generated from field: string report_id = 1;
+   */
+  reportId: string;
+};
+
+/**
+ * Describes the message api.v1.SuspendUserRequest.
+ * Use `create(SuspendUserRequestSchema)` to create a new message.
+ */
+export const SuspendUserRequestSchema: GenMessage<SuspendUserRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_admin_service, 6);
+
+/**
+ * @generated from message api.v1.SuspendUserResponse
+ */
+export type SuspendUserResponse = Message<"api.v1.SuspendUserResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.SuspendUserResponse.
+ * Use `create(SuspendUserResponseSchema)` to create a new message.
+ */
+export const SuspendUserResponseSchema: GenMessage<SuspendUserResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_admin_service, 7);
+
+/**
+ * @generated from message api.v1.Report
+ */
+export type Report = Message<"api.v1.Report"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: api.v1.User reporter = 2;
+   */
+  reporter?: User;
+
+  /**
+   * @generated from field: api.v1.ReportTarget target = 3;
+   */
+  target: ReportTarget;
+
+  /**
+   * @generated from field: string target_id = 4;
+   */
+  targetId: string;
+
+  /**
+   * @generated from field: string reason = 5;
+   */
+  reason: string;
+
+  /**
+   * @generated from field: api.v1.ReportStatus status = 6;
+   */
+  status: ReportStatus;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.Report.
+ * Use `create(ReportSchema)` to create a new message.
+ */
+export const ReportSchema: GenMessage<Report> = /*@__PURE__*/
+  messageDesc(file_api_v1_admin_service, 8);
+
+/**
+ * @generated from enum api.v1.ReportTarget
+ */
+export enum ReportTarget {
+  /**
+   * @generated from enum value: REPORT_TARGET_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: REPORT_TARGET_WORKOUT = 1;
+   */
+  WORKOUT = 1,
+
+  /**
+   * @generated from enum value: REPORT_TARGET_COMMENT = 2;
+   */
+  COMMENT = 2,
+
+  /**
+   * @generated from enum value: REPORT_TARGET_USER = 3;
+   */
+  USER = 3,
+}
+
+/**
+ * Describes the enum api.v1.ReportTarget.
+ */
+export const ReportTargetSchema: GenEnum<ReportTarget> = /*@__PURE__*/
+  enumDesc(file_api_v1_admin_service, 0);
+
+/**
+ * @generated from enum api.v1.ReportStatus
+ */
+export enum ReportStatus {
+  /**
+   * @generated from enum value: REPORT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: REPORT_STATUS_OPEN = 1;
+   */
+  OPEN = 1,
+
+  /**
+   * @generated from enum value: REPORT_STATUS_DISMISSED = 2;
+   */
+  DISMISSED = 2,
+
+  /**
+   * @generated from enum value: REPORT_STATUS_ACTIONED = 3;
+   */
+  ACTIONED = 3,
+}
+
+/**
+ * Describes the enum api.v1.ReportStatus.
+ */
+export const ReportStatusSchema: GenEnum<ReportStatus> = /*@__PURE__*/
+  enumDesc(file_api_v1_admin_service, 1);
+
+/**
+ * @generated from service api.v1.AdminService
+ */
+export const AdminService: GenService<{
+  /**
+   * @generated from rpc api.v1.AdminService.ListReports
+   */
+  listReports: {
+    methodKind: "unary";
+    input: typeof ListReportsRequestSchema;
+    output: typeof ListReportsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.AdminService.HideContent
+   */
+  hideContent: {
+    methodKind: "unary";
+    input: typeof HideContentRequestSchema;
+    output: typeof HideContentResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.AdminService.DismissReport
+   */
+  dismissReport: {
+    methodKind: "unary";
+    input: typeof DismissReportRequestSchema;
+    output: typeof DismissReportResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.AdminService.SuspendUser
+   */
+  suspendUser: {
+    methodKind: "unary";
+    input: typeof SuspendUserRequestSchema;
+    output: typeof SuspendUserResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_admin_service, 0);
+
@@ -0,0 +1,441 @@
+// @generated by protoc-gen-es v2.2.3 with parameter "target=ts"
+// @generated from file api/v1/challenge_service.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import { file_api_v1_options } from "./options_pb";
+import type { PaginationRequest, PaginationResponse, User } from "./shared_pb";
+import { file_api_v1_shared } from "./shared_pb";
+import { file_api_v1_user_service } from "./user_service_pb";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/challenge_service.proto.
+ */
+export const file_api_v1_challenge_service: GenFile = /*@__PURE__*/
+  fileDesc("Ch5hcGkvdjEvY2hhbGxlbmdlX3NlcnZpY2UucHJvdG8SBmFwaS52MSKhAgoWQ3JlYXRlQ2hhbGxlbmdlUmVxdWVzdBIZCgV0aXRsZRgBIAEoCUIKukgHcgUQARj/ARIzCgZtZXRyaWMYAiABKA4yFy5hcGkudjEuQ2hhbGxlbmdlTWV0cmljQgq6SAeCAQQQASAAEiEKBGdvYWwYAyABKAFCDrpICxIJIQAAAAAAAAAASACIAQESNQoJc3RhcnRzX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBEjMKB2VuZHNfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESHwoIdXNlcl9pZHMYBiADKAlCDbpICpIBByIFcgOwAQFCBwoFX2dvYWwiPwoXQ3JlYXRlQ2hhbGxlbmdlUmVzcG9uc2USJAoJY2hhbGxlbmdlGAEgASgLMhEuYXBpLnYxLkNoYWxsZW5nZSJdChhJbnZpdGVUb0NoYWxsZW5nZVJlcXVlc3QSHgoMY2hhbGxlbmdlX2lkGAEgASgJQgi6SAVyA7ABARIhCgh1c2VyX2lkcxgCIAMoCUIPukgMkgEJCAEiBXIDsAEBIhsKGUludml0ZVRvQ2hhbGxlbmdlUmVzcG9uc2UiNgoUSm9pbkNoYWxsZW5nZVJlcXVlc3QSHgoMY2hhbGxlbmdlX2lkGAEgASgJQgi6SAVyA7ABASIXChVKb2luQ2hhbGxlbmdlUmVzcG9uc2UiNwoVTGVhdmVDaGFsbGVuZ2VSZXF1ZXN0Eh4KDGNoYWxsZW5nZV9pZBgBIAEoCUIIukgFcgOwAQEiGAoWTGVhdmVDaGFsbGVuZ2VSZXNwb25zZSJOChVMaXN0Q2hhbGxlbmdlc1JlcXVlc3QSNQoKcGFnaW5hdGlvbhgBIAEoCzIZLmFwaS52MS5QYWdpbmF0aW9uUmVxdWVzdEIGukgDyAEBIm8KFkxpc3RDaGFsbGVuZ2VzUmVzcG9uc2USJQoKY2hhbGxlbmdlcxgBIAMoCzIRLmFwaS52MS5DaGFsbGVuZ2USLgoKcGFnaW5hdGlvbhgCIAEoCzIaLmFwaS52MS5QYWdpbmF0aW9uUmVzcG9uc2UiPgocR2V0Q2hhbGxlbmdlU3RhbmRpbmdzUmVxdWVzdBIeCgxjaGFsbGVuZ2VfaWQYASABKAlCCLpIBXIDsAEBInMKHUdldENoYWxsZW5nZVN0YW5kaW5nc1Jlc3BvbnNlEiQKCWNoYWxsZW5nZRgBIAEoCzIRLmFwaS52MS5DaGFsbGVuZ2USLAoJc3RhbmRpbmdzGAIgAygLMhkuYXBpLnYxLkNoYWxsZW5nZVN0YW5kaW5nIoUCCglDaGFsbGVuZ2USCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSJwoGbWV0cmljGAMgASgOMhcuYXBpLnYxLkNoYWxsZW5nZU1ldHJpYxIRCgRnb2FsGAQgASgBSACIAQESLQoJc3RhcnRzX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIrCgdlbmRzX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIdCgdjcmVhdG9yGAcgASgLMgwuYXBpLnYxLlVzZXISDgoGam9pbmVkGAggASgIEg0KBWVuZGVkGAkgASgIQgcKBV9nb2FsImIKEUNoYWxsZW5nZVN0YW5kaW5nEgwKBHJhbmsYASABKAUSGgoEdXNlchgCIAEoCzIMLmFwaS52MS5Vc2VyEg0KBXZhbHVlGAMgASgBEhQKDGdvYWxfcmVhY2hlZBgEIAEoCCp6Cg9DaGFsbGVuZ2VNZXRyaWMSIAocQ0hBTExFTkdFX01FVFJJQ19VTlNQRUNJRklFRBAAEiEKHUNIQUxMRU5HRV9NRVRSSUNfVE9UQUxfVk9MVU1FEAESIgoeQ0hBTExFTkdFX01FVFJJQ19TRVNTSU9OX0NPVU5UEAIyugQKEENoYWxsZW5nZVNlcnZpY2USWAoPQ3JlYXRlQ2hhbGxlbmdlEh4uYXBpLnYxLkNyZWF0ZUNoYWxsZW5nZVJlcXVlc3QaHy5hcGkudjEuQ3JlYXRlQ2hhbGxlbmdlUmVzcG9uc2UiBIi1GAESXgoRSW52aXRlVG9DaGFsbGVuZ2USIC5hcGkudjEuSW52aXRlVG9DaGFsbGVuZ2VSZXF1ZXN0GiEuYXBpLnYxLkludml0ZVRvQ2hhbGxlbmdlUmVzcG9uc2UiBIi1GAESUgoNSm9pbkNoYWxsZW5nZRIcLmFwaS52MS5Kb2luQ2hhbGxlbmdlUmVxdWVzdBodLmFwaS52MS5Kb2luQ2hhbGxlbmdlUmVzcG9uc2UiBIi1GAESVQoOTGVhdmVDaGFsbGVuZ2USHS5hcGkudjEuTGVhdmVDaGFsbGVuZ2VSZXF1ZXN0Gh4uYXBpLnYxLkxlYXZlQ2hhbGxlbmdlUmVzcG9uc2UiBIi1GAESVQoOTGlzdENoYWxsZW5nZXMSHS5hcGkudjEuTGlzdENoYWxsZW5nZXNSZXF1ZXN0Gh4uYXBpLnYxLkxpc3RDaGFsbGVuZ2VzUmVzcG9uc2UiBIi1GAESagoVR2V0Q2hhbGxlbmdlU3RhbmRpbmdzEiQuYXBpLnYxLkdldENoYWxsZW5nZVN0YW5kaW5nc1JlcXVlc3QaJS5hcGkudjEuR2V0Q2hhbGxlbmdlU3RhbmRpbmdzUmVzcG9uc2UiBIi1GAFCmQEKCmNvbS5hcGkudjFCFUNoYWxsZW5nZVNlcnZpY2VQcm90b1ABWjtnaXRodWIuY29tL2NybHNzbi9nZXRzdHJvbmdlci9zZXJ2ZXIvZ2VuL3Byb3RvL2FwaS92MTthcGl2MaICA0FYWKoCBkFwaS5WMcoCBkFwaVxWMeICEkFwaVxWMVxHUEJNZXRhZGF0YeoCB0FwaTo6VjFiBnByb3RvMw", [file_api_v1_options, file_api_v1_shared, file_api_v1_user_service, file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message api.v1.CreateChallengeRequest
+ */
+export type CreateChallengeRequest = Message<"api.v1.CreateChallengeRequest"> & {
+  /**
+   * @generated from field: string title = 1;
+   */
+  title: string;
+
+  /**
+   * @generated from field: api.v1.ChallengeMetric metric = 2;
+   */
+  metric: ChallengeMetric;
+
+  /**
+   * The progress each participant aims for, e.g. 20 sessions. Standings are
+   * ranked by progress regardless of the goal.
+   *
+   * @generated from field: optional double goal = 3;
+   */
+  goal?: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp starts_at = 4;
+   */
+  startsAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp ends_at = 5;
+   */
+  endsAt?: Timestamp;
+
+  /**
+   * Followers of the creator to invite.
+   *
+   * @generated from field: repeated string user_ids = 6;
+   */
+  userIds: string[];
+};
+
+/**
+ * Describes the message api.v1.CreateChallengeRequest.
+ * Use `create(CreateChallengeRequestSchema)` to create a new message.
+ */
+export const CreateChallengeRequestSchema: GenMessage<CreateChallengeRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_challenge_service, 0);
+
+/**
+ * @generated from message api.v1.CreateChallengeResponse
+ */
+export type CreateChallengeResponse = Message<"api.v1.CreateChallengeResponse"> & {
+  /**
+   * @generated from field: api.v1.Challenge challenge = 1;
+   */
+  challenge?: Challenge;
+};
+
+/**
+ * Describes the message api.v1.CreateChallengeResponse.
+ * Use `create(CreateChallengeResponseSchema)` to create a new message.
+ */
+export const CreateChallengeResponseSchema: GenMessage<CreateChallengeResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_challenge_service, 1);
+
+/**
+ * InviteToChallenge invites followers of the creator. Only the creator can
+ * invite, and only until the challenge ends.
+ *
+ * @generated from message api.v1.InviteToChallengeRequest
+ */
+export type InviteToChallengeRequest = Message<"api.v1.InviteToChallengeRequest"> & {
+  /**
+   * @generated from field: string challenge_id = 1;
+   */
+  challengeId: string;
+
+  /**
+   * @generated from field: repeated string user_ids = 2;
+   */
+  userIds: string[];
+};
+
+/**
+ * Describes the message api.v1.InviteToChallengeRequest.
+ * Use `create(InviteToChallengeRequestSchema)` to create a new message.
+ */
+export const InviteToChallengeRequestSchema: GenMessage<InviteToChallengeRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_challenge_service, 2);
+
+/**
+ * @generated from message api.v1.InviteToChallengeResponse
+ */
+export type InviteToChallengeResponse = Message<"api.v1.InviteToChallengeResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.InviteToChallengeResponse.
+ * Use `create(InviteToChallengeResponseSchema)` to create a new message.
+ */
+export const InviteToChallengeResponseSchema: GenMessage<InviteToChallengeResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_challenge_service, 3);
+
+/**
+ * @generated from message api.v1.JoinChallengeRequest
+ */
+export type JoinChallengeRequest = Message<"api.v1.JoinChallengeRequest"> & {
+  /**
+   * @generated from field: string challenge_id = 1;
+   */
+  challengeId: string;
+};
+
+/**
+ * Describes the message api.v1.JoinChallengeRequest.
+ * Use `create(JoinChallengeRequestSchema)` to create a new message.
+ */
+export const JoinChallengeRequestSchema: GenMessage<JoinChallengeRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_challenge_service, 4);
+
+/**
+ * @generated from message api.v1.JoinChallengeResponse
+ */
+export type JoinChallengeResponse = Message<"api.v1.JoinChallengeResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.JoinChallengeResponse.
+ * Use `create(JoinChallengeResponseSchema)` to create a new message.
+ */
+export const JoinChallengeResponseSchema: GenMessage<JoinChallengeResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_challenge_service, 5);
+
+/**
+ * LeaveChallenge leaves a joined challenge or declines an invitation. The
+ * creator cannot leave their own challenge.
+ *
+ * @generated from message api.v1.LeaveChallengeRequest
+ */
+export type LeaveChallengeRequest = Message<"api.v1.LeaveChallengeRequest"> & {
+  /**
+   * @generated from field: string challenge_id = 1;
+   */
+  challengeId: string;
+};
+
+/**
+ * Describes the message api.v1.LeaveChallengeRequest.
+ * Use `create(LeaveChallengeRequestSchema)` to create a new message.
+ */
+export const LeaveChallengeRequestSchema: GenMessage<LeaveChallengeRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_challenge_service, 6);
+
+/**
+ * @generated from message api.v1.LeaveChallengeResponse
+ */
+export type LeaveChallengeResponse = Message<"api.v1.LeaveChallengeResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.LeaveChallengeResponse.
+ * Use `create(LeaveChallengeResponseSchema)` to create a new message.
+ */
+export const LeaveChallengeResponseSchema: GenMessage<LeaveChallengeResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_challenge_service, 7);
+
+/**
+ * ListChallenges lists the challenges the user has joined or been invited to.
+ *
+ * @generated from message api.v1.ListChallengesRequest
+ */
+export type ListChallengesRequest = Message<"api.v1.ListChallengesRequest"> & {
+  /**
+   * @generated from field: api.v1.PaginationRequest pagination = 1;
+   */
+  pagination?: PaginationRequest;
+};
+
+/**
+ * Describes the message api.v1.ListChallengesRequest.
+ * Use `create(ListChallengesRequestSchema)` to create a new message.
+ */
+export const ListChallengesRequestSchema: GenMessage<ListChallengesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_challenge_service, 8);
+
+/**
+ * @generated from message api.v1.ListChallengesResponse
+ */
+export type ListChallengesResponse = Message<"api.v1.ListChallengesResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Challenge challenges = 1;
+   */
+  challenges: Challenge[];
+
+  /**
+   * @generated from field: api.v1.PaginationResponse pagination = 2;
+   */
+  pagination?: PaginationResponse;
+};
+
+/**
+ * Describes the message api.v1.ListChallengesResponse.
+ * Use `create(ListChallengesResponseSchema)` to create a new message.
+ */
+export const ListChallengesResponseSchema: GenMessage<ListChallengesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_challenge_service, 9);
+
+/**
+ * @generated from message api.v1.GetChallengeStandingsRequest
+ */
+export type GetChallengeStandingsRequest = Message<"api.v1.GetChallengeStandingsRequest"> & {
+  /**
+   * @generated from field: string challenge_id = 1;
+   */
+  challengeId: string;
+};
+
+/**
+ * Describes the message api.v1.GetChallengeStandingsRequest.
+ * Use `create(GetChallengeStandingsRequestSchema)` to create a new message.
+ */
+export const GetChallengeStandingsRequestSchema: GenMessage<GetChallengeStandingsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_challenge_service, 10);
+
+/**
+ * @generated from message api.v1.GetChallengeStandingsResponse
+ */
+export type GetChallengeStandingsResponse = Message<"api.v1.GetChallengeStandingsResponse"> & {
+  /**
+   * @generated from field: api.v1.Challenge challenge = 1;
+   */
+  challenge?: Challenge;
+
+  /**
+   * Standings of the participants that have joined. Progress is tracked from
+   * every workout a participant finishes during the challenge window.
+   *
+   * @generated from field: repeated api.v1.ChallengeStanding standings = 2;
+   */
+  standings: ChallengeStanding[];
+};
+
+/**
+ * Describes the message api.v1.GetChallengeStandingsResponse.
+ * Use `create(GetChallengeStandingsResponseSchema)` to create a new message.
+ */
+export const GetChallengeStandingsResponseSchema: GenMessage<GetChallengeStandingsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_challenge_service, 11);
+
+/**
+ * @generated from message api.v1.Challenge
+ */
+export type Challenge = Message<"api.v1.Challenge"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: api.v1.ChallengeMetric metric = 3;
+   */
+  metric: ChallengeMetric;
+
+  /**
+   * @generated from field: optional double goal = 4;
+   */
+  goal?: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp starts_at = 5;
+   */
+  startsAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp ends_at = 6;
+   */
+  endsAt?: Timestamp;
+
+  /**
+   * @generated from field: api.v1.User creator = 7;
+   */
+  creator?: User;
+
+  /**
+   * Whether the user has joined the challenge, as opposed to only being invited.
+   *
+   * @generated from field: bool joined = 8;
+   */
+  joined: boolean;
+
+  /**
+   * @generated from field: bool ended = 9;
+   */
+  ended: boolean;
+};
+
+/**
+ * Describes the message api.v1.Challenge.
+ * Use `create(ChallengeSchema)` to create a new message.
+ */
+export const ChallengeSchema: GenMessage<Challenge> = /*@__PURE__*/
+  messageDesc(file_api_v1_challenge_service, 12);
+
+/**
+ * @generated from message api.v1.ChallengeStanding
+ */
+export type ChallengeStanding = Message<"api.v1.ChallengeStanding"> & {
+  /**
+   * @generated from field: int32 rank = 1;
+   */
+  rank: number;
+
+  /**
+   * @generated from field: api.v1.User user = 2;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: double value = 3;
+   */
+  value: number;
+
+  /**
+   * @generated from field: bool goal_reached = 4;
+   */
+  goalReached: boolean;
+};
+
+/**
+ * Describes the message api.v1.ChallengeStanding.
+ * Use `create(ChallengeStandingSchema)` to create a new message.
+ */
+export const ChallengeStandingSchema: GenMessage<ChallengeStanding> = /*@__PURE__*/
+  messageDesc(file_api_v1_challenge_service, 13);
+
+/**
+ * @generated from enum api.v1.ChallengeMetric
+ */
+export enum ChallengeMetric {
+  /**
+   * @generated from enum value: CHALLENGE_METRIC_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CHALLENGE_METRIC_TOTAL_VOLUME = 1;
+   */
+  TOTAL_VOLUME = 1,
+
+  /**
+   * @generated from enum value: CHALLENGE_METRIC_SESSION_COUNT = 2;
+   */
+  SESSION_COUNT = 2,
+}
+
+/**
+ * Describes the enum api.v1.ChallengeMetric.
+ */
+export const ChallengeMetricSchema: GenEnum<ChallengeMetric> = /*@__PURE__*/
+  enumDesc(file_api_v1_challenge_service, 0);
+
+/**
+ * @generated from service api.v1.ChallengeService
+ */
+export const ChallengeService: GenService<{
+  /**
+   * @generated from rpc api.v1.ChallengeService.CreateChallenge
+   */
+  createChallenge: {
+    methodKind: "unary";
+    input: typeof CreateChallengeRequestSchema;
+    output: typeof CreateChallengeResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ChallengeService.InviteToChallenge
+   */
+  inviteToChallenge: {
+    methodKind: "unary";
+    input: typeof InviteToChallengeRequestSchema;
+    output: typeof InviteToChallengeResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ChallengeService.JoinChallenge
+   */
+  joinChallenge: {
+    methodKind: "unary";
+    input: typeof JoinChallengeRequestSchema;
+    output: typeof JoinChallengeResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ChallengeService.LeaveChallenge
+   */
+  leaveChallenge: {
+    methodKind: "unary";
+    input: typeof LeaveChallengeRequestSchema;
+    output: typeof LeaveChallengeResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ChallengeService.ListChallenges
+   */
+  listChallenges: {
+    methodKind: "unary";
+    input: typeof ListChallengesRequestSchema;
+    output: typeof ListChallengesResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ChallengeService.GetChallengeStandings
+   */
+  getChallengeStandings: {
+    methodKind: "unary";
+    input: typeof GetChallengeStandingsRequestSchema;
+    output: typeof GetChallengeStandingsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_challenge_service, 0);
+
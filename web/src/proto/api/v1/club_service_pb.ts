@@ -0,0 +1,656 @@
+// @generated by protoc-gen-es v2.2.3 with parameter "target=ts"
+// @generated from file api/v1/club_service.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import { file_api_v1_options } from "./options_pb";
+import type { PaginationRequest, PaginationResponse, User } from "./shared_pb";
+import { file_api_v1_shared } from "./shared_pb";
+import { file_api_v1_user_service } from "./user_service_pb";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/club_service.proto.
+ */
+export const file_api_v1_club_service: GenFile = /*@__PURE__*/
+  fileDesc("ChlhcGkvdjEvY2x1Yl9zZXJ2aWNlLnByb3RvEgZhcGkudjEiTAoRQ3JlYXRlQ2x1YlJlcXVlc3QSGAoEbmFtZRgBIAEoCUIKukgHcgUQARj/ARIdCgtkZXNjcmlwdGlvbhgCIAEoCUIIukgFcgMY6AciMAoSQ3JlYXRlQ2x1YlJlc3BvbnNlEhoKBGNsdWIYASABKAsyDC5hcGkudjEuQ2x1YiImCg5HZXRDbHViUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQEiLQoPR2V0Q2x1YlJlc3BvbnNlEhoKBGNsdWIYASABKAsyDC5hcGkudjEuQ2x1YiJJChBMaXN0Q2x1YnNSZXF1ZXN0EjUKCnBhZ2luYXRpb24YASABKAsyGS5hcGkudjEuUGFnaW5hdGlvblJlcXVlc3RCBrpIA8gBASJgChFMaXN0Q2x1YnNSZXNwb25zZRIbCgVjbHVicxgBIAMoCzIMLmFwaS52MS5DbHViEi4KCnBhZ2luYXRpb24YAiABKAsyGi5hcGkudjEuUGFnaW5hdGlvblJlc3BvbnNlIiwKD0pvaW5DbHViUmVxdWVzdBIZCgdjbHViX2lkGAEgASgJQgi6SAVyA7ABASI8ChBKb2luQ2x1YlJlc3BvbnNlEigKBnN0YXR1cxgBIAEoDjIYLmFwaS52MS5DbHViTWVtYmVyU3RhdHVzIi0KEExlYXZlQ2x1YlJlcXVlc3QSGQoHY2x1Yl9pZBgBIAEoCUIIukgFcgOwAQEiEwoRTGVhdmVDbHViUmVzcG9uc2UiTwoXSW52aXRlQ2x1Yk1lbWJlclJlcXVlc3QSGQoHY2x1Yl9pZBgBIAEoCUIIukgFcgOwAQESGQoHdXNlcl9pZBgCIAEoCUIIukgFcgOwAQEiGgoYSW52aXRlQ2x1Yk1lbWJlclJlc3BvbnNlIlAKGEFwcHJvdmVDbHViTWVtYmVyUmVxdWVzdBIZCgdjbHViX2lkGAEgASgJQgi6SAVyA7ABARIZCgd1c2VyX2lkGAIgASgJQgi6SAVyA7ABASIbChlBcHByb3ZlQ2x1Yk1lbWJlclJlc3BvbnNlIk8KF1JlbW92ZUNsdWJNZW1iZXJSZXF1ZXN0EhkKB2NsdWJfaWQYASABKAlCCLpIBXIDsAEBEhkKB3VzZXJfaWQYAiABKAlCCLpIBXIDsAEBIhoKGFJlbW92ZUNsdWJNZW1iZXJSZXNwb25zZSJ8ChhTZXRDbHViTWVtYmVyUm9sZVJlcXVlc3QSGQoHY2x1Yl9pZBgBIAEoCUIIukgFcgOwAQESGQoHdXNlcl9pZBgCIAEoCUIIukgFcgOwAQESKgoEcm9sZRgDIAEoDjIQLmFwaS52MS5DbHViUm9sZUIKukgHggEEEAEgACIbChlTZXRDbHViTWVtYmVyUm9sZVJlc3BvbnNlIp4BChZMaXN0Q2x1Yk1lbWJlcnNSZXF1ZXN0EhkKB2NsdWJfaWQYASABKAlCCLpIBXIDsAEBEjIKBnN0YXR1cxgCIAEoDjIYLmFwaS52MS5DbHViTWVtYmVyU3RhdHVzQgi6SAWCAQIQARI1CgpwYWdpbmF0aW9uGAMgASgLMhkuYXBpLnYxLlBhZ2luYXRpb25SZXF1ZXN0Qga6SAPIAQEibgoXTGlzdENsdWJNZW1iZXJzUmVzcG9uc2USIwoHbWVtYmVycxgBIAMoCzISLmFwaS52MS5DbHViTWVtYmVyEi4KCnBhZ2luYXRpb24YAiABKAsyGi5hcGkudjEuUGFnaW5hdGlvblJlc3BvbnNlIpEBCgRDbHViEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKgoKbWVtYmVyc2hpcBgFIAEoCzIWLmFwaS52MS5DbHViTWVtYmVyc2hpcCJaCg5DbHViTWVtYmVyc2hpcBIeCgRyb2xlGAEgASgOMhAuYXBpLnYxLkNsdWJSb2xlEigKBnN0YXR1cxgCIAEoDjIYLmFwaS52MS5DbHViTWVtYmVyU3RhdHVzInIKCkNsdWJNZW1iZXISGgoEdXNlchgBIAEoCzIMLmFwaS52MS5Vc2VyEh4KBHJvbGUYAiABKA4yEC5hcGkudjEuQ2x1YlJvbGUSKAoGc3RhdHVzGAMgASgOMhguYXBpLnYxLkNsdWJNZW1iZXJTdGF0dXMqZQoIQ2x1YlJvbGUSGQoVQ0xVQl9ST0xFX1VOU1BFQ0lGSUVEEAASEwoPQ0xVQl9ST0xFX09XTkVSEAESEwoPQ0xVQl9ST0xFX0FETUlOEAISFAoQQ0xVQl9ST0xFX01FTUJFUhADKpcBChBDbHViTWVtYmVyU3RhdHVzEiIKHkNMVUJfTUVNQkVSX1NUQVRVU19VTlNQRUNJRklFRBAAEh4KGkNMVUJfTUVNQkVSX1NUQVRVU19JTlZJVEVEEAESIAocQ0xVQl9NRU1CRVJfU1RBVFVTX1JFUVVFU1RFRBACEh0KGUNMVUJfTUVNQkVSX1NUQVRVU19BQ1RJVkUQAzLDBgoLQ2x1YlNlcnZpY2USSQoKQ3JlYXRlQ2x1YhIZLmFwaS52MS5DcmVhdGVDbHViUmVxdWVzdBoaLmFwaS52MS5DcmVhdGVDbHViUmVzcG9uc2UiBIi1GAESQAoHR2V0Q2x1YhIWLmFwaS52MS5HZXRDbHViUmVxdWVzdBoXLmFwaS52MS5HZXRDbHViUmVzcG9uc2UiBIi1GAESRgoJTGlzdENsdWJzEhguYXBpLnYxLkxpc3RDbHVic1JlcXVlc3QaGS5hcGkudjEuTGlzdENsdWJzUmVzcG9uc2UiBIi1GAESQwoISm9pbkNsdWISFy5hcGkudjEuSm9pbkNsdWJSZXF1ZXN0GhguYXBpLnYxLkpvaW5DbHViUmVzcG9uc2UiBIi1GAESRgoJTGVhdmVDbHViEhguYXBpLnYxLkxlYXZlQ2x1YlJlcXVlc3QaGS5hcGkudjEuTGVhdmVDbHViUmVzcG9uc2UiBIi1GAESWwoQSW52aXRlQ2x1Yk1lbWJlchIfLmFwaS52MS5JbnZpdGVDbHViTWVtYmVyUmVxdWVzdBogLmFwaS52MS5JbnZpdGVDbHViTWVtYmVyUmVzcG9uc2UiBIi1GAESXgoRQXBwcm92ZUNsdWJNZW1iZXISIC5hcGkudjEuQXBwcm92ZUNsdWJNZW1iZXJSZXF1ZXN0GiEuYXBpLnYxLkFwcHJvdmVDbHViTWVtYmVyUmVzcG9uc2UiBIi1GAESWwoQUmVtb3ZlQ2x1Yk1lbWJlchIfLmFwaS52MS5SZW1vdmVDbHViTWVtYmVyUmVxdWVzdBogLmFwaS52MS5SZW1vdmVDbHViTWVtYmVyUmVzcG9uc2UiBIi1GAESXgoRU2V0Q2x1Yk1lbWJlclJvbGUSIC5hcGkudjEuU2V0Q2x1Yk1lbWJlclJvbGVSZXF1ZXN0GiEuYXBpLnYxLlNldENsdWJNZW1iZXJSb2xlUmVzcG9uc2UiBIi1GAESWAoPTGlzdENsdWJNZW1iZXJzEh4uYXBpLnYxLkxpc3RDbHViTWVtYmVyc1JlcXVlc3QaHy5hcGkudjEuTGlzdENsdWJNZW1iZXJzUmVzcG9uc2UiBIi1GAFClAEKCmNvbS5hcGkudjFCEENsdWJTZXJ2aWNlUHJvdG9QAVo7Z2l0aHViLmNvbS9jcmxzc24vZ2V0c3Ryb25nZXIvc2VydmVyL2dlbi9wcm90by9hcGkvdjE7YXBpdjGiAgNBWFiqAgZBcGkuVjHKAgZBcGlcVjHiAhJBcGlcVjFcR1BCTWV0YWRhdGHqAgdBcGk6OlYxYgZwcm90bzM", [file_api_v1_options, file_api_v1_shared, file_api_v1_user_service, file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * @generated from message api.v1.CreateClubRequest
+ */
+export type CreateClubRequest = Message<"api.v1.CreateClubRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+};
+
+/**
+ * Describes the message api.v1.CreateClubRequest.
+ * Use `create(CreateClubRequestSchema)` to create a new message.
+ */
+export const CreateClubRequestSchema: GenMessage<CreateClubRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 0);
+
+/**
+ * @generated from message api.v1.CreateClubResponse
+ */
+export type CreateClubResponse = Message<"api.v1.CreateClubResponse"> & {
+  /**
+   * @generated from field: api.v1.Club club = 1;
+   */
+  club?: Club;
+};
+
+/**
+ * Describes the message api.v1.CreateClubResponse.
+ * Use `create(CreateClubResponseSchema)` to create a new message.
+ */
+export const CreateClubResponseSchema: GenMessage<CreateClubResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 1);
+
+/**
+ * @generated from message api.v1.GetClubRequest
+ */
+export type GetClubRequest = Message<"api.v1.GetClubRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message api.v1.GetClubRequest.
+ * Use `create(GetClubRequestSchema)` to create a new message.
+ */
+export const GetClubRequestSchema: GenMessage<GetClubRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 2);
+
+/**
+ * @generated from message api.v1.GetClubResponse
+ */
+export type GetClubResponse = Message<"api.v1.GetClubResponse"> & {
+  /**
+   * @generated from field: api.v1.Club club = 1;
+   */
+  club?: Club;
+};
+
+/**
+ * Describes the message api.v1.GetClubResponse.
+ * Use `create(GetClubResponseSchema)` to create a new message.
+ */
+export const GetClubResponseSchema: GenMessage<GetClubResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 3);
+
+/**
+ * ListClubs lists the clubs the user is a member of, or has a pending
+ * invitation or request to join.
+ *
+ * @generated from message api.v1.ListClubsRequest
+ */
+export type ListClubsRequest = Message<"api.v1.ListClubsRequest"> & {
+  /**
+   * @generated from field: api.v1.PaginationRequest pagination = 1;
+   */
+  pagination?: PaginationRequest;
+};
+
+/**
+ * Describes the message api.v1.ListClubsRequest.
+ * Use `create(ListClubsRequestSchema)` to create a new message.
+ */
+export const ListClubsRequestSchema: GenMessage<ListClubsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 4);
+
+/**
+ * @generated from message api.v1.ListClubsResponse
+ */
+export type ListClubsResponse = Message<"api.v1.ListClubsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Club clubs = 1;
+   */
+  clubs: Club[];
+
+  /**
+   * @generated from field: api.v1.PaginationResponse pagination = 2;
+   */
+  pagination?: PaginationResponse;
+};
+
+/**
+ * Describes the message api.v1.ListClubsResponse.
+ * Use `create(ListClubsResponseSchema)` to create a new message.
+ */
+export const ListClubsResponseSchema: GenMessage<ListClubsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 5);
+
+/**
+ * JoinClub accepts an invitation to the club, or requests to join it if the
+ * user has not been invited.
+ *
+ * @generated from message api.v1.JoinClubRequest
+ */
+export type JoinClubRequest = Message<"api.v1.JoinClubRequest"> & {
+  /**
+   * @generated from field: string club_id = 1;
+   */
+  clubId: string;
+};
+
+/**
+ * Describes the message api.v1.JoinClubRequest.
+ * Use `create(JoinClubRequestSchema)` to create a new message.
+ */
+export const JoinClubRequestSchema: GenMessage<JoinClubRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 6);
+
+/**
+ * @generated from message api.v1.JoinClubResponse
+ */
+export type JoinClubResponse = Message<"api.v1.JoinClubResponse"> & {
+  /**
+   * @generated from field: api.v1.ClubMemberStatus status = 1;
+   */
+  status: ClubMemberStatus;
+};
+
+/**
+ * Describes the message api.v1.JoinClubResponse.
+ * Use `create(JoinClubResponseSchema)` to create a new message.
+ */
+export const JoinClubResponseSchema: GenMessage<JoinClubResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 7);
+
+/**
+ * LeaveClub leaves the club, or declines an invitation or withdraws a request
+ * to join it. The owner must transfer ownership before leaving.
+ *
+ * @generated from message api.v1.LeaveClubRequest
+ */
+export type LeaveClubRequest = Message<"api.v1.LeaveClubRequest"> & {
+  /**
+   * @generated from field: string club_id = 1;
+   */
+  clubId: string;
+};
+
+/**
+ * Describes the message api.v1.LeaveClubRequest.
+ * Use `create(LeaveClubRequestSchema)` to create a new message.
+ */
+export const LeaveClubRequestSchema: GenMessage<LeaveClubRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 8);
+
+/**
+ * @generated from message api.v1.LeaveClubResponse
+ */
+export type LeaveClubResponse = Message<"api.v1.LeaveClubResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.LeaveClubResponse.
+ * Use `create(LeaveClubResponseSchema)` to create a new message.
+ */
+export const LeaveClubResponseSchema: GenMessage<LeaveClubResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 9);
+
+/**
+ * InviteClubMember invites the user to the club. Only owners and admins can
+ * invite, and inviting a user who has requested to join approves them.
+ *
+ * @generated from message api.v1.InviteClubMemberRequest
+ */
+export type InviteClubMemberRequest = Message<"api.v1.InviteClubMemberRequest"> & {
+  /**
+   * @generated from field: string club_id = 1;
+   */
+  clubId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message api.v1.InviteClubMemberRequest.
+ * Use `create(InviteClubMemberRequestSchema)` to create a new message.
+ */
+export const InviteClubMemberRequestSchema: GenMessage<InviteClubMemberRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 10);
+
+/**
+ * @generated from message api.v1.InviteClubMemberResponse
+ */
+export type InviteClubMemberResponse = Message<"api.v1.InviteClubMemberResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.InviteClubMemberResponse.
+ * Use `create(InviteClubMemberResponseSchema)` to create a new message.
+ */
+export const InviteClubMemberResponseSchema: GenMessage<InviteClubMemberResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 11);
+
+/**
+ * ApproveClubMember approves a request to join the club. Only owners and
+ * admins can approve.
+ *
+ * @generated from message api.v1.ApproveClubMemberRequest
+ */
+export type ApproveClubMemberRequest = Message<"api.v1.ApproveClubMemberRequest"> & {
+  /**
+   * @generated from field: string club_id = 1;
+   */
+  clubId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message api.v1.ApproveClubMemberRequest.
+ * Use `create(ApproveClubMemberRequestSchema)` to create a new message.
+ */
+export const ApproveClubMemberRequestSchema: GenMessage<ApproveClubMemberRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 12);
+
+/**
+ * @generated from message api.v1.ApproveClubMemberResponse
+ */
+export type ApproveClubMemberResponse = Message<"api.v1.ApproveClubMemberResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.ApproveClubMemberResponse.
+ * Use `create(ApproveClubMemberResponseSchema)` to create a new message.
+ */
+export const ApproveClubMemberResponseSchema: GenMessage<ApproveClubMemberResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 13);
+
+/**
+ * RemoveClubMember removes a member, or cancels an invitation or rejects a
+ * request to join. Admins can only remove members, and the owner cannot be
+ * removed.
+ *
+ * @generated from message api.v1.RemoveClubMemberRequest
+ */
+export type RemoveClubMemberRequest = Message<"api.v1.RemoveClubMemberRequest"> & {
+  /**
+   * @generated from field: string club_id = 1;
+   */
+  clubId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message api.v1.RemoveClubMemberRequest.
+ * Use `create(RemoveClubMemberRequestSchema)` to create a new message.
+ */
+export const RemoveClubMemberRequestSchema: GenMessage<RemoveClubMemberRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 14);
+
+/**
+ * @generated from message api.v1.RemoveClubMemberResponse
+ */
+export type RemoveClubMemberResponse = Message<"api.v1.RemoveClubMemberResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RemoveClubMemberResponse.
+ * Use `create(RemoveClubMemberResponseSchema)` to create a new message.
+ */
+export const RemoveClubMemberResponseSchema: GenMessage<RemoveClubMemberResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 15);
+
+/**
+ * SetClubMemberRole changes the role of an active member. Only the owner can
+ * change roles, and making another member the owner demotes the current owner
+ * to admin.
+ *
+ * @generated from message api.v1.SetClubMemberRoleRequest
+ */
+export type SetClubMemberRoleRequest = Message<"api.v1.SetClubMemberRoleRequest"> & {
+  /**
+   * @generated from field: string club_id = 1;
+   */
+  clubId: string;
+
+  /**
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: api.v1.ClubRole role = 3;
+   */
+  role: ClubRole;
+};
+
+/**
+ * Describes the message api.v1.SetClubMemberRoleRequest.
+ * Use `create(SetClubMemberRoleRequestSchema)` to create a new message.
+ */
+export const SetClubMemberRoleRequestSchema: GenMessage<SetClubMemberRoleRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 16);
+
+/**
+ * @generated from message api.v1.SetClubMemberRoleResponse
+ */
+export type SetClubMemberRoleResponse = Message<"api.v1.SetClubMemberRoleResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.SetClubMemberRoleResponse.
+ * Use `create(SetClubMemberRoleResponseSchema)` to create a new message.
+ */
+export const SetClubMemberRoleResponseSchema: GenMessage<SetClubMemberRoleResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 17);
+
+/**
+ * ListClubMembers lists the members of the club with the status. Only members
+ * can list active members, and only owners and admins can list pending ones.
+ *
+ * @generated from message api.v1.ListClubMembersRequest
+ */
+export type ListClubMembersRequest = Message<"api.v1.ListClubMembersRequest"> & {
+  /**
+   * @generated from field: string club_id = 1;
+   */
+  clubId: string;
+
+  /**
+   * Lists active members if unspecified.
+   *
+   * @generated from field: api.v1.ClubMemberStatus status = 2;
+   */
+  status: ClubMemberStatus;
+
+  /**
+   * @generated from field: api.v1.PaginationRequest pagination = 3;
+   */
+  pagination?: PaginationRequest;
+};
+
+/**
+ * Describes the message api.v1.ListClubMembersRequest.
+ * Use `create(ListClubMembersRequestSchema)` to create a new message.
+ */
+export const ListClubMembersRequestSchema: GenMessage<ListClubMembersRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 18);
+
+/**
+ * @generated from message api.v1.ListClubMembersResponse
+ */
+export type ListClubMembersResponse = Message<"api.v1.ListClubMembersResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.ClubMember members = 1;
+   */
+  members: ClubMember[];
+
+  /**
+   * @generated from field: api.v1.PaginationResponse pagination = 2;
+   */
+  pagination?: PaginationResponse;
+};
+
+/**
+ * Describes the message api.v1.ListClubMembersResponse.
+ * Use `create(ListClubMembersResponseSchema)` to create a new message.
+ */
+export const ListClubMembersResponseSchema: GenMessage<ListClubMembersResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 19);
+
+/**
+ * @generated from message api.v1.Club
+ */
+export type Club = Message<"api.v1.Club"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 4;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * The membership of the user, unset if the user is not a member.
+   *
+   * @generated from field: api.v1.ClubMembership membership = 5;
+   */
+  membership?: ClubMembership;
+};
+
+/**
+ * Describes the message api.v1.Club.
+ * Use `create(ClubSchema)` to create a new message.
+ */
+export const ClubSchema: GenMessage<Club> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 20);
+
+/**
+ * @generated from message api.v1.ClubMembership
+ */
+export type ClubMembership = Message<"api.v1.ClubMembership"> & {
+  /**
+   * @generated from field: api.v1.ClubRole role = 1;
+   */
+  role: ClubRole;
+
+  /**
+   * @generated from field: api.v1.ClubMemberStatus status = 2;
+   */
+  status: ClubMemberStatus;
+};
+
+/**
+ * Describes the message api.v1.ClubMembership.
+ * Use `create(ClubMembershipSchema)` to create a new message.
+ */
+export const ClubMembershipSchema: GenMessage<ClubMembership> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 21);
+
+/**
+ * @generated from message api.v1.ClubMember
+ */
+export type ClubMember = Message<"api.v1.ClubMember"> & {
+  /**
+   * @generated from field: api.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: api.v1.ClubRole role = 2;
+   */
+  role: ClubRole;
+
+  /**
+   * @generated from field: api.v1.ClubMemberStatus status = 3;
+   */
+  status: ClubMemberStatus;
+};
+
+/**
+ * Describes the message api.v1.ClubMember.
+ * Use `create(ClubMemberSchema)` to create a new message.
+ */
+export const ClubMemberSchema: GenMessage<ClubMember> = /*@__PURE__*/
+  messageDesc(file_api_v1_club_service, 22);
+
+/**
+ * @generated from enum api.v1.ClubRole
+ */
+export enum ClubRole {
+  /**
+   * @generated from enum value: CLUB_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CLUB_ROLE_OWNER = 1;
+   */
+  OWNER = 1,
+
+  /**
+   * @generated from enum value: CLUB_ROLE_ADMIN = 2;
+   */
+  ADMIN = 2,
+
+  /**
+   * @generated from enum value: CLUB_ROLE_MEMBER = 3;
+   */
+  MEMBER = 3,
+}
+
+/**
+ * Describes the enum api.v1.ClubRole.
+ */
+export const ClubRoleSchema: GenEnum<ClubRole> = /*@__PURE__*/
+  enumDesc(file_api_v1_club_service, 0);
+
+/**
+ * @generated from enum api.v1.ClubMemberStatus
+ */
+export enum ClubMemberStatus {
+  /**
+   * @generated from enum value: CLUB_MEMBER_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CLUB_MEMBER_STATUS_INVITED = 1;
+   */
+  INVITED = 1,
+
+  /**
+   * @generated from enum value: CLUB_MEMBER_STATUS_REQUESTED = 2;
+   */
+  REQUESTED = 2,
+
+  /**
+   * @generated from enum value: CLUB_MEMBER_STATUS_ACTIVE = 3;
+   */
+  ACTIVE = 3,
+}
+
+/**
+ * Describes the enum api.v1.ClubMemberStatus.
+ */
+export const ClubMemberStatusSchema: GenEnum<ClubMemberStatus> = /*@__PURE__*/
+  enumDesc(file_api_v1_club_service, 1);
+
+/**
+ * @generated from service api.v1.ClubService
+ */
+export const ClubService: GenService<{
+  /**
+   * @generated from rpc api.v1.ClubService.CreateClub
+   */
+  createClub: {
+    methodKind: "unary";
+    input: typeof CreateClubRequestSchema;
+    output: typeof CreateClubResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ClubService.GetClub
+   */
+  getClub: {
+    methodKind: "unary";
+    input: typeof GetClubRequestSchema;
+    output: typeof GetClubResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ClubService.ListClubs
+   */
+  listClubs: {
+    methodKind: "unary";
+    input: typeof ListClubsRequestSchema;
+    output: typeof ListClubsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ClubService.JoinClub
+   */
+  joinClub: {
+    methodKind: "unary";
+    input: typeof JoinClubRequestSchema;
+    output: typeof JoinClubResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ClubService.LeaveClub
+   */
+  leaveClub: {
+    methodKind: "unary";
+    input: typeof LeaveClubRequestSchema;
+    output: typeof LeaveClubResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ClubService.InviteClubMember
+   */
+  inviteClubMember: {
+    methodKind: "unary";
+    input: typeof InviteClubMemberRequestSchema;
+    output: typeof InviteClubMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ClubService.ApproveClubMember
+   */
+  approveClubMember: {
+    methodKind: "unary";
+    input: typeof ApproveClubMemberRequestSchema;
+    output: typeof ApproveClubMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ClubService.RemoveClubMember
+   */
+  removeClubMember: {
+    methodKind: "unary";
+    input: typeof RemoveClubMemberRequestSchema;
+    output: typeof RemoveClubMemberResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ClubService.SetClubMemberRole
+   */
+  setClubMemberRole: {
+    methodKind: "unary";
+    input: typeof SetClubMemberRoleRequestSchema;
+    output: typeof SetClubMemberRoleResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ClubService.ListClubMembers
+   */
+  listClubMembers: {
+    methodKind: "unary";
+    input: typeof ListClubMembersRequestSchema;
+    output: typeof ListClubMembersResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_club_service, 0);
+
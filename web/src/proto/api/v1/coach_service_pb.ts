@@ -0,0 +1,558 @@
+// @generated by protoc-gen-es v2.2.3 with parameter "target=ts"
+// @generated from file api/v1/coach_service.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import { file_api_v1_exercise_service } from "./exercise_service_pb";
+import { file_api_v1_options } from "./options_pb";
+import type { Routine } from "./routine_service_pb";
+import { file_api_v1_routine_service } from "./routine_service_pb";
+import type { ExerciseSet, PaginationRequest, PaginationResponse, User } from "./shared_pb";
+import { file_api_v1_shared } from "./shared_pb";
+import type { Workout } from "./workout_service_pb";
+import { file_api_v1_workout_service } from "./workout_service_pb";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/coach_service.proto.
+ */
+export const file_api_v1_coach_service: GenFile = /*@__PURE__*/
+  fileDesc("ChphcGkvdjEvY29hY2hfc2VydmljZS5wcm90bxIGYXBpLnYxIjUKF0dyYW50Q29hY2hBY2Nlc3NSZXF1ZXN0EhoKCGNvYWNoX2lkGAEgASgJQgi6SAVyA7ABASIaChhHcmFudENvYWNoQWNjZXNzUmVzcG9uc2UiNgoYUmV2b2tlQ29hY2hBY2Nlc3NSZXF1ZXN0EhoKCGNvYWNoX2lkGAEgASgJQgi6SAVyA7ABASIbChlSZXZva2VDb2FjaEFjY2Vzc1Jlc3BvbnNlIhQKEkxpc3RDb2FjaGVzUmVxdWVzdCI0ChNMaXN0Q29hY2hlc1Jlc3BvbnNlEh0KB2NvYWNoZXMYASADKAsyDC5hcGkudjEuVXNlciJQChdMaXN0Q29hY2hBY3Rpb25zUmVxdWVzdBI1CgpwYWdpbmF0aW9uGAEgASgLMhkuYXBpLnYxLlBhZ2luYXRpb25SZXF1ZXN0Qga6SAPIAQEicAoYTGlzdENvYWNoQWN0aW9uc1Jlc3BvbnNlEiQKB2FjdGlvbnMYASADKAsyEy5hcGkudjEuQ29hY2hBY3Rpb24SLgoKcGFnaW5hdGlvbhgCIAEoCzIaLmFwaS52MS5QYWdpbmF0aW9uUmVzcG9uc2UiGgoYR2V0Q29hY2hEYXNoYm9hcmRSZXF1ZXN0IkUKGUdldENvYWNoRGFzaGJvYXJkUmVzcG9uc2USKAoIYXRobGV0ZXMYASADKAsyFi5hcGkudjEuQXRobGV0ZVN1bW1hcnkicQoaTGlzdEF0aGxldGVXb3Jrb3V0c1JlcXVlc3QSHAoKYXRobGV0ZV9pZBgBIAEoCUIIukgFcgOwAQESNQoKcGFnaW5hdGlvbhgCIAEoCzIZLmFwaS52MS5QYWdpbmF0aW9uUmVxdWVzdEIGukgDyAEBInAKG0xpc3RBdGhsZXRlV29ya291dHNSZXNwb25zZRIhCgh3b3Jrb3V0cxgBIAMoCzIPLmFwaS52MS5Xb3Jrb3V0Ei4KCnBhZ2luYXRpb24YAiABKAsyGi5hcGkudjEuUGFnaW5hdGlvblJlc3BvbnNlIj4KHkdldEF0aGxldGVQZXJzb25hbEJlc3RzUmVxdWVzdBIcCgphdGhsZXRlX2lkGAEgASgJQgi6SAVyA7ABASJOCh9HZXRBdGhsZXRlUGVyc29uYWxCZXN0c1Jlc3BvbnNlEisKDnBlcnNvbmFsX2Jlc3RzGAEgAygLMhMuYXBpLnYxLkV4ZXJjaXNlU2V0InkKG0NyZWF0ZUF0aGxldGVSb3V0aW5lUmVxdWVzdBIcCgphdGhsZXRlX2lkGAEgASgJQgi6SAVyA7ABARIVCgRuYW1lGAIgASgJQge6SARyAhABEiUKDGV4ZXJjaXNlX2lkcxgDIAMoCUIPukgMkgEJCAEiBXIDsAEBIioKHENyZWF0ZUF0aGxldGVSb3V0aW5lUmVzcG9uc2USCgoCaWQYASABKAkiZQobVXBkYXRlQXRobGV0ZVJvdXRpbmVSZXF1ZXN0EhwKCmF0aGxldGVfaWQYASABKAlCCLpIBXIDsAEBEigKB3JvdXRpbmUYAiABKAsyDy5hcGkudjEuUm91dGluZUIGukgDyAEBIkAKHFVwZGF0ZUF0aGxldGVSb3V0aW5lUmVzcG9uc2USIAoHcm91dGluZRgBIAEoCzIPLmFwaS52MS5Sb3V0aW5lIqcBCg5BdGhsZXRlU3VtbWFyeRIdCgdhdGhsZXRlGAEgASgLMgwuYXBpLnYxLlVzZXISOAoPbGFzdF9zZXNzaW9uX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEhIKCmFjdXRlX2xvYWQYAyABKAESFAoMY2hyb25pY19sb2FkGAQgASgBQhIKEF9sYXN0X3Nlc3Npb25fYXQiswEKC0NvYWNoQWN0aW9uEgoKAmlkGAEgASgJEhsKBWNvYWNoGAIgASgLMgwuYXBpLnYxLlVzZXISJQoEdHlwZRgDIAEoDjIXLmFwaS52MS5Db2FjaEFjdGlvblR5cGUSFgoJdGFyZ2V0X2lkGAQgASgJSACIAQESLgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCDAoKX3RhcmdldF9pZCr2AQoPQ29hY2hBY3Rpb25UeXBlEiEKHUNPQUNIX0FDVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASJAogQ09BQ0hfQUNUSU9OX1RZUEVfVklFV19EQVNIQk9BUkQQARIjCh9DT0FDSF9BQ1RJT05fVFlQRV9WSUVXX1dPUktPVVRTEAISKQolQ09BQ0hfQUNUSU9OX1RZUEVfVklFV19QRVJTT05BTF9CRVNUUxADEiQKIENPQUNIX0FDVElPTl9UWVBFX0NSRUFURV9ST1VUSU5FEAQSJAogQ09BQ0hfQUNUSU9OX1RZUEVfVVBEQVRFX1JPVVRJTkUQBTKABwoMQ29hY2hTZXJ2aWNlElsKEEdyYW50Q29hY2hBY2Nlc3MSHy5hcGkudjEuR3JhbnRDb2FjaEFjY2Vzc1JlcXVlc3QaIC5hcGkudjEuR3JhbnRDb2FjaEFjY2Vzc1Jlc3BvbnNlIgSItRgBEl4KEVJldm9rZUNvYWNoQWNjZXNzEiAuYXBpLnYxLlJldm9rZUNvYWNoQWNjZXNzUmVxdWVzdBohLmFwaS52MS5SZXZva2VDb2FjaEFjY2Vzc1Jlc3BvbnNlIgSItRgBEkwKC0xpc3RDb2FjaGVzEhouYXBpLnYxLkxpc3RDb2FjaGVzUmVxdWVzdBobLmFwaS52MS5MaXN0Q29hY2hlc1Jlc3BvbnNlIgSItRgBElsKEExpc3RDb2FjaEFjdGlvbnMSHy5hcGkudjEuTGlzdENvYWNoQWN0aW9uc1JlcXVlc3QaIC5hcGkudjEuTGlzdENvYWNoQWN0aW9uc1Jlc3BvbnNlIgSItRgBEl4KEUdldENvYWNoRGFzaGJvYXJkEiAuYXBpLnYxLkdldENvYWNoRGFzaGJvYXJkUmVxdWVzdBohLmFwaS52MS5HZXRDb2FjaERhc2hib2FyZFJlc3BvbnNlIgSItRgBEmQKE0xpc3RBdGhsZXRlV29ya291dHMSIi5hcGkudjEuTGlzdEF0aGxldGVXb3Jrb3V0c1JlcXVlc3QaIy5hcGkudjEuTGlzdEF0aGxldGVXb3Jrb3V0c1Jlc3BvbnNlIgSItRgBEnAKF0dldEF0aGxldGVQZXJzb25hbEJlc3RzEiYuYXBpLnYxLkdldEF0aGxldGVQZXJzb25hbEJlc3RzUmVxdWVzdBonLmFwaS52MS5HZXRBdGhsZXRlUGVyc29uYWxCZXN0c1Jlc3BvbnNlIgSItRgBEmcKFENyZWF0ZUF0aGxldGVSb3V0aW5lEiMuYXBpLnYxLkNyZWF0ZUF0aGxldGVSb3V0aW5lUmVxdWVzdBokLmFwaS52MS5DcmVhdGVBdGhsZXRlUm91dGluZVJlc3BvbnNlIgSItRgBEmcKFFVwZGF0ZUF0aGxldGVSb3V0aW5lEiMuYXBpLnYxLlVwZGF0ZUF0aGxldGVSb3V0aW5lUmVxdWVzdBokLmFwaS52MS5VcGRhdGVBdGhsZXRlUm91dGluZVJlc3BvbnNlIgSItRgBQpUBCgpjb20uYXBpLnYxQhFDb2FjaFNlcnZpY2VQcm90b1ABWjtnaXRodWIuY29tL2NybHNzbi9nZXRzdHJvbmdlci9zZXJ2ZXIvZ2VuL3Byb3RvL2FwaS92MTthcGl2MaICA0FYWKoCBkFwaS5WMcoCBkFwaVxWMeICEkFwaVxWMVxHUEJNZXRhZGF0YeoCB0FwaTo6VjFiBnByb3RvMw", [file_api_v1_exercise_service, file_api_v1_options, file_api_v1_routine_service, file_api_v1_shared, file_api_v1_workout_service, file_buf_validate_validate, file_google_protobuf_timestamp]);
+
+/**
+ * GrantCoachAccess lets the coach view the user's workouts and personal bests
+ * regardless of their visibility, and create or edit routines on their behalf.
+ *
+ * @generated from message api.v1.GrantCoachAccessRequest
+ */
+export type GrantCoachAccessRequest = Message<"api.v1.GrantCoachAccessRequest"> & {
+  /**
+   * @generated from field: string coach_id = 1;
+   */
+  coachId: string;
+};
+
+/**
+ * Describes the message api.v1.GrantCoachAccessRequest.
+ * Use `create(GrantCoachAccessRequestSchema)` to create a new message.
+ */
+export const GrantCoachAccessRequestSchema: GenMessage<GrantCoachAccessRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 0);
+
+/**
+ * @generated from message api.v1.GrantCoachAccessResponse
+ */
+export type GrantCoachAccessResponse = Message<"api.v1.GrantCoachAccessResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.GrantCoachAccessResponse.
+ * Use `create(GrantCoachAccessResponseSchema)` to create a new message.
+ */
+export const GrantCoachAccessResponseSchema: GenMessage<GrantCoachAccessResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 1);
+
+/**
+ * @generated from message api.v1.RevokeCoachAccessRequest
+ */
+export type RevokeCoachAccessRequest = Message<"api.v1.RevokeCoachAccessRequest"> & {
+  /**
+   * @generated from field: string coach_id = 1;
+   */
+  coachId: string;
+};
+
+/**
+ * Describes the message api.v1.RevokeCoachAccessRequest.
+ * Use `create(RevokeCoachAccessRequestSchema)` to create a new message.
+ */
+export const RevokeCoachAccessRequestSchema: GenMessage<RevokeCoachAccessRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 2);
+
+/**
+ * @generated from message api.v1.RevokeCoachAccessResponse
+ */
+export type RevokeCoachAccessResponse = Message<"api.v1.RevokeCoachAccessResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RevokeCoachAccessResponse.
+ * Use `create(RevokeCoachAccessResponseSchema)` to create a new message.
+ */
+export const RevokeCoachAccessResponseSchema: GenMessage<RevokeCoachAccessResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 3);
+
+/**
+ * ListCoaches lists the coaches with access to the user's account.
+ *
+ * @generated from message api.v1.ListCoachesRequest
+ */
+export type ListCoachesRequest = Message<"api.v1.ListCoachesRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.ListCoachesRequest.
+ * Use `create(ListCoachesRequestSchema)` to create a new message.
+ */
+export const ListCoachesRequestSchema: GenMessage<ListCoachesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 4);
+
+/**
+ * @generated from message api.v1.ListCoachesResponse
+ */
+export type ListCoachesResponse = Message<"api.v1.ListCoachesResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.User coaches = 1;
+   */
+  coaches: User[];
+};
+
+/**
+ * Describes the message api.v1.ListCoachesResponse.
+ * Use `create(ListCoachesResponseSchema)` to create a new message.
+ */
+export const ListCoachesResponseSchema: GenMessage<ListCoachesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 5);
+
+/**
+ * ListCoachActions lists the audit trail of everything the user's coaches have
+ * done in their account, newest first.
+ *
+ * @generated from message api.v1.ListCoachActionsRequest
+ */
+export type ListCoachActionsRequest = Message<"api.v1.ListCoachActionsRequest"> & {
+  /**
+   * @generated from field: api.v1.PaginationRequest pagination = 1;
+   */
+  pagination?: PaginationRequest;
+};
+
+/**
+ * Describes the message api.v1.ListCoachActionsRequest.
+ * Use `create(ListCoachActionsRequestSchema)` to create a new message.
+ */
+export const ListCoachActionsRequestSchema: GenMessage<ListCoachActionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 6);
+
+/**
+ * @generated from message api.v1.ListCoachActionsResponse
+ */
+export type ListCoachActionsResponse = Message<"api.v1.ListCoachActionsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.CoachAction actions = 1;
+   */
+  actions: CoachAction[];
+
+  /**
+   * @generated from field: api.v1.PaginationResponse pagination = 2;
+   */
+  pagination?: PaginationResponse;
+};
+
+/**
+ * Describes the message api.v1.ListCoachActionsResponse.
+ * Use `create(ListCoachActionsResponseSchema)` to create a new message.
+ */
+export const ListCoachActionsResponseSchema: GenMessage<ListCoachActionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 7);
+
+/**
+ * GetCoachDashboard lists the athletes that have granted the user access.
+ *
+ * @generated from message api.v1.GetCoachDashboardRequest
+ */
+export type GetCoachDashboardRequest = Message<"api.v1.GetCoachDashboardRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.GetCoachDashboardRequest.
+ * Use `create(GetCoachDashboardRequestSchema)` to create a new message.
+ */
+export const GetCoachDashboardRequestSchema: GenMessage<GetCoachDashboardRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 8);
+
+/**
+ * @generated from message api.v1.GetCoachDashboardResponse
+ */
+export type GetCoachDashboardResponse = Message<"api.v1.GetCoachDashboardResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.AthleteSummary athletes = 1;
+   */
+  athletes: AthleteSummary[];
+};
+
+/**
+ * Describes the message api.v1.GetCoachDashboardResponse.
+ * Use `create(GetCoachDashboardResponseSchema)` to create a new message.
+ */
+export const GetCoachDashboardResponseSchema: GenMessage<GetCoachDashboardResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 9);
+
+/**
+ * ListAthleteWorkouts lists the athlete's workouts regardless of their
+ * visibility. Workouts hidden by moderation are excluded.
+ *
+ * @generated from message api.v1.ListAthleteWorkoutsRequest
+ */
+export type ListAthleteWorkoutsRequest = Message<"api.v1.ListAthleteWorkoutsRequest"> & {
+  /**
+   * @generated from field: string athlete_id = 1;
+   */
+  athleteId: string;
+
+  /**
+   * @generated from field: api.v1.PaginationRequest pagination = 2;
+   */
+  pagination?: PaginationRequest;
+};
+
+/**
+ * Describes the message api.v1.ListAthleteWorkoutsRequest.
+ * Use `create(ListAthleteWorkoutsRequestSchema)` to create a new message.
+ */
+export const ListAthleteWorkoutsRequestSchema: GenMessage<ListAthleteWorkoutsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 10);
+
+/**
+ * @generated from message api.v1.ListAthleteWorkoutsResponse
+ */
+export type ListAthleteWorkoutsResponse = Message<"api.v1.ListAthleteWorkoutsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Workout workouts = 1;
+   */
+  workouts: Workout[];
+
+  /**
+   * @generated from field: api.v1.PaginationResponse pagination = 2;
+   */
+  pagination?: PaginationResponse;
+};
+
+/**
+ * Describes the message api.v1.ListAthleteWorkoutsResponse.
+ * Use `create(ListAthleteWorkoutsResponseSchema)` to create a new message.
+ */
+export const ListAthleteWorkoutsResponseSchema: GenMessage<ListAthleteWorkoutsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 11);
+
+/**
+ * @generated from message api.v1.GetAthletePersonalBestsRequest
+ */
+export type GetAthletePersonalBestsRequest = Message<"api.v1.GetAthletePersonalBestsRequest"> & {
+  /**
+   * @generated from field: string athlete_id = 1;
+   */
+  athleteId: string;
+};
+
+/**
+ * Describes the message api.v1.GetAthletePersonalBestsRequest.
+ * Use `create(GetAthletePersonalBestsRequestSchema)` to create a new message.
+ */
+export const GetAthletePersonalBestsRequestSchema: GenMessage<GetAthletePersonalBestsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 12);
+
+/**
+ * @generated from message api.v1.GetAthletePersonalBestsResponse
+ */
+export type GetAthletePersonalBestsResponse = Message<"api.v1.GetAthletePersonalBestsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.ExerciseSet personal_bests = 1;
+   */
+  personalBests: ExerciseSet[];
+};
+
+/**
+ * Describes the message api.v1.GetAthletePersonalBestsResponse.
+ * Use `create(GetAthletePersonalBestsResponseSchema)` to create a new message.
+ */
+export const GetAthletePersonalBestsResponseSchema: GenMessage<GetAthletePersonalBestsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 13);
+
+/**
+ * CreateAthleteRoutine creates a routine in the athlete's account. The
+ * exercises must belong to the athlete.
+ *
+ * @generated from message api.v1.CreateAthleteRoutineRequest
+ */
+export type CreateAthleteRoutineRequest = Message<"api.v1.CreateAthleteRoutineRequest"> & {
+  /**
+   * @generated from field: string athlete_id = 1;
+   */
+  athleteId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated string exercise_ids = 3;
+   */
+  exerciseIds: string[];
+};
+
+/**
+ * Describes the message api.v1.CreateAthleteRoutineRequest.
+ * Use `create(CreateAthleteRoutineRequestSchema)` to create a new message.
+ */
+export const CreateAthleteRoutineRequestSchema: GenMessage<CreateAthleteRoutineRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 14);
+
+/**
+ * @generated from message api.v1.CreateAthleteRoutineResponse
+ */
+export type CreateAthleteRoutineResponse = Message<"api.v1.CreateAthleteRoutineResponse"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message api.v1.CreateAthleteRoutineResponse.
+ * Use `create(CreateAthleteRoutineResponseSchema)` to create a new message.
+ */
+export const CreateAthleteRoutineResponseSchema: GenMessage<CreateAthleteRoutineResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 15);
+
+/**
+ * @generated from message api.v1.UpdateAthleteRoutineRequest
+ */
+export type UpdateAthleteRoutineRequest = Message<"api.v1.UpdateAthleteRoutineRequest"> & {
+  /**
+   * @generated from field: string athlete_id = 1;
+   */
+  athleteId: string;
+
+  /**
+   * @generated from field: api.v1.Routine routine = 2;
+   */
+  routine?: Routine;
+};
+
+/**
+ * Describes the message api.v1.UpdateAthleteRoutineRequest.
+ * Use `create(UpdateAthleteRoutineRequestSchema)` to create a new message.
+ */
+export const UpdateAthleteRoutineRequestSchema: GenMessage<UpdateAthleteRoutineRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 16);
+
+/**
+ * @generated from message api.v1.UpdateAthleteRoutineResponse
+ */
+export type UpdateAthleteRoutineResponse = Message<"api.v1.UpdateAthleteRoutineResponse"> & {
+  /**
+   * @generated from field: api.v1.Routine routine = 1;
+   */
+  routine?: Routine;
+};
+
+/**
+ * Describes the message api.v1.UpdateAthleteRoutineResponse.
+ * Use `create(UpdateAthleteRoutineResponseSchema)` to create a new message.
+ */
+export const UpdateAthleteRoutineResponseSchema: GenMessage<UpdateAthleteRoutineResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 17);
+
+/**
+ * @generated from message api.v1.AthleteSummary
+ */
+export type AthleteSummary = Message<"api.v1.AthleteSummary"> & {
+  /**
+   * @generated from field: api.v1.User athlete = 1;
+   */
+  athlete?: User;
+
+  /**
+   * When the athlete last finished a workout, if ever.
+   *
+   * @generated from field: optional google.protobuf.Timestamp last_session_at = 2;
+   */
+  lastSessionAt?: Timestamp;
+
+  /**
+   * Volume lifted over the last seven days.
+   *
+   * @generated from field: double acute_load = 3;
+   */
+  acuteLoad: number;
+
+  /**
+   * Average weekly volume lifted over the last 28 days.
+   *
+   * @generated from field: double chronic_load = 4;
+   */
+  chronicLoad: number;
+};
+
+/**
+ * Describes the message api.v1.AthleteSummary.
+ * Use `create(AthleteSummarySchema)` to create a new message.
+ */
+export const AthleteSummarySchema: GenMessage<AthleteSummary> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 18);
+
+/**
+ * @generated from message api.v1.CoachAction
+ */
+export type CoachAction = Message<"api.v1.CoachAction"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: api.v1.User coach = 2;
+   */
+  coach?: User;
+
+  /**
+   * @generated from field: api.v1.CoachActionType type = 3;
+   */
+  type: CoachActionType;
+
+  /**
+   * The routine created or updated, if any.
+   *
+   * @generated from field: optional string target_id = 4;
+   */
+  targetId?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 5;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.CoachAction.
+ * Use `create(CoachActionSchema)` to create a new message.
+ */
+export const CoachActionSchema: GenMessage<CoachAction> = /*@__PURE__*/
+  messageDesc(file_api_v1_coach_service, 19);
+
+/**
+ * @generated from enum api.v1.CoachActionType
+ */
+export enum CoachActionType {
+  /**
+   * @generated from enum value: COACH_ACTION_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: COACH_ACTION_TYPE_VIEW_DASHBOARD = 1;
+   */
+  VIEW_DASHBOARD = 1,
+
+  /**
+   * @generated from enum value: COACH_ACTION_TYPE_VIEW_WORKOUTS = 2;
+   */
+  VIEW_WORKOUTS = 2,
+
+  /**
+   * @generated from enum value: COACH_ACTION_TYPE_VIEW_PERSONAL_BESTS = 3;
+   */
+  VIEW_PERSONAL_BESTS = 3,
+
+  /**
+   * @generated from enum value: COACH_ACTION_TYPE_CREATE_ROUTINE = 4;
+   */
+  CREATE_ROUTINE = 4,
+
+  /**
+   * @generated from enum value: COACH_ACTION_TYPE_UPDATE_ROUTINE = 5;
+   */
+  UPDATE_ROUTINE = 5,
+}
+
+/**
+ * Describes the enum api.v1.CoachActionType.
+ */
+export const CoachActionTypeSchema: GenEnum<CoachActionType> = /*@__PURE__*/
+  enumDesc(file_api_v1_coach_service, 0);
+
+/**
+ * @generated from service api.v1.CoachService
+ */
+export const CoachService: GenService<{
+  /**
+   * @generated from rpc api.v1.CoachService.GrantCoachAccess
+   */
+  grantCoachAccess: {
+    methodKind: "unary";
+    input: typeof GrantCoachAccessRequestSchema;
+    output: typeof GrantCoachAccessResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.CoachService.RevokeCoachAccess
+   */
+  revokeCoachAccess: {
+    methodKind: "unary";
+    input: typeof RevokeCoachAccessRequestSchema;
+    output: typeof RevokeCoachAccessResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.CoachService.ListCoaches
+   */
+  listCoaches: {
+    methodKind: "unary";
+    input: typeof ListCoachesRequestSchema;
+    output: typeof ListCoachesResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.CoachService.ListCoachActions
+   */
+  listCoachActions: {
+    methodKind: "unary";
+    input: typeof ListCoachActionsRequestSchema;
+    output: typeof ListCoachActionsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.CoachService.GetCoachDashboard
+   */
+  getCoachDashboard: {
+    methodKind: "unary";
+    input: typeof GetCoachDashboardRequestSchema;
+    output: typeof GetCoachDashboardResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.CoachService.ListAthleteWorkouts
+   */
+  listAthleteWorkouts: {
+    methodKind: "unary";
+    input: typeof ListAthleteWorkoutsRequestSchema;
+    output: typeof ListAthleteWorkoutsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.CoachService.GetAthletePersonalBests
+   */
+  getAthletePersonalBests: {
+    methodKind: "unary";
+    input: typeof GetAthletePersonalBestsRequestSchema;
+    output: typeof GetAthletePersonalBestsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.CoachService.CreateAthleteRoutine
+   */
+  createAthleteRoutine: {
+    methodKind: "unary";
+    input: typeof CreateAthleteRoutineRequestSchema;
+    output: typeof CreateAthleteRoutineResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.CoachService.UpdateAthleteRoutine
+   */
+  updateAthleteRoutine: {
+    methodKind: "unary";
+    input: typeof UpdateAthleteRoutineRequestSchema;
+    output: typeof UpdateAthleteRoutineResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_coach_service, 0);
+
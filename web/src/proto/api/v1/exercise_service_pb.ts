@@ -2,13 +2,13 @@
 // @generated from file api/v1/exercise_service.proto (package api.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
 import { file_api_v1_options } from "./options_pb";
-import type { Exercise, ExerciseSet, ExerciseSets, PaginationRequest, PaginationResponse, Set } from "./shared_pb";
+import type { CatalogExercise, Exercise, ExerciseSet, ExerciseSets, PaginationRequest, PaginationResponse, Set, User } from "./shared_pb";
 import { file_api_v1_shared } from "./shared_pb";
-import type { FieldMask } from "@bufbuild/protobuf/wkt";
-import { file_google_protobuf_field_mask } from "@bufbuild/protobuf/wkt";
+import type { FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/exercise_service.proto.
  */
 export const file_api_v1_exercise_service: GenFile = /*@__PURE__*/
-  fileDesc("Ch1hcGkvdjEvZXhlcmNpc2Vfc2VydmljZS5wcm90bxIGYXBpLnYxIj0KFUNyZWF0ZUV4ZXJjaXNlUmVxdWVzdBIVCgRuYW1lGAEgASgJQge6SARyAhABEg0KBWxhYmVsGAIgASgJIiQKFkNyZWF0ZUV4ZXJjaXNlUmVzcG9uc2USCgoCaWQYASABKAkiKgoSR2V0RXhlcmNpc2VSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASI5ChNHZXRFeGVyY2lzZVJlc3BvbnNlEiIKCGV4ZXJjaXNlGAEgASgLMhAuYXBpLnYxLkV4ZXJjaXNlInQKFVVwZGF0ZUV4ZXJjaXNlUmVxdWVzdBIqCghleGVyY2lzZRgBIAEoCzIQLmFwaS52MS5FeGVyY2lzZUIGukgDyAEBEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzayI8ChZVcGRhdGVFeGVyY2lzZVJlc3BvbnNlEiIKCGV4ZXJjaXNlGAEgASgLMhAuYXBpLnYxLkV4ZXJjaXNlIi0KFURlbGV0ZUV4ZXJjaXNlUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQEiGAoWRGVsZXRlRXhlcmNpc2VSZXNwb25zZSKAAQoUTGlzdEV4ZXJjaXNlc1JlcXVlc3QSDAoEbmFtZRgBIAEoCRIjCgxleGVyY2lzZV9pZHMYAiADKAlCDbpICpIBByIFcgOwAQESNQoKcGFnaW5hdGlvbhgDIAEoCzIZLmFwaS52MS5QYWdpbmF0aW9uUmVxdWVzdEIGukgDyAEBImwKFUxpc3RFeGVyY2lzZXNSZXNwb25zZRIjCglleGVyY2lzZXMYASADKAsyEC5hcGkudjEuRXhlcmNpc2USLgoKcGFnaW5hdGlvbhgCIAEoCzIaLmFwaS52MS5QYWdpbmF0aW9uUmVzcG9uc2UiRAodR2V0UHJldmlvdXNXb3Jrb3V0U2V0c1JlcXVlc3QSIwoMZXhlcmNpc2VfaWRzGAEgAygJQg26SAqSAQciBXIDsAEBIk0KHkdldFByZXZpb3VzV29ya291dFNldHNSZXNwb25zZRIrCg1leGVyY2lzZV9zZXRzGAEgAygLMhQuYXBpLnYxLkV4ZXJjaXNlU2V0cyI0ChdHZXRQZXJzb25hbEJlc3RzUmVxdWVzdBIZCgd1c2VyX2lkGAEgASgJQgi6SAVyA7ABASJHChhHZXRQZXJzb25hbEJlc3RzUmVzcG9uc2USKwoOcGVyc29uYWxfYmVzdHMYASADKAsyEy5hcGkudjEuRXhlcmNpc2VTZXQicAoPTGlzdFNldHNSZXF1ZXN0EhAKCHVzZXJfaWRzGAEgAygJEhQKDGV4ZXJjaXNlX2lkcxgCIAMoCRI1CgpwYWdpbmF0aW9uGAMgASgLMhkuYXBpLnYxLlBhZ2luYXRpb25SZXF1ZXN0Qga6SAPIAQEiXQoQTGlzdFNldHNSZXNwb25zZRIZCgRzZXRzGAEgAygLMgsuYXBpLnYxLlNldBIuCgpwYWdpbmF0aW9uGAIgASgLMhouYXBpLnYxLlBhZ2luYXRpb25SZXNwb25zZTLJBQoPRXhlcmNpc2VTZXJ2aWNlElUKDkNyZWF0ZUV4ZXJjaXNlEh0uYXBpLnYxLkNyZWF0ZUV4ZXJjaXNlUmVxdWVzdBoeLmFwaS52MS5DcmVhdGVFeGVyY2lzZVJlc3BvbnNlIgSItRgBEkwKC0dldEV4ZXJjaXNlEhouYXBpLnYxLkdldEV4ZXJjaXNlUmVxdWVzdBobLmFwaS52MS5HZXRFeGVyY2lzZVJlc3BvbnNlIgSItRgBElUKDlVwZGF0ZUV4ZXJjaXNlEh0uYXBpLnYxLlVwZGF0ZUV4ZXJjaXNlUmVxdWVzdBoeLmFwaS52MS5VcGRhdGVFeGVyY2lzZVJlc3BvbnNlIgSItRgBElUKDkRlbGV0ZUV4ZXJjaXNlEh0uYXBpLnYxLkRlbGV0ZUV4ZXJjaXNlUmVxdWVzdBoeLmFwaS52MS5EZWxldGVFeGVyY2lzZVJlc3BvbnNlIgSItRgBElIKDUxpc3RFeGVyY2lzZXMSHC5hcGkudjEuTGlzdEV4ZXJjaXNlc1JlcXVlc3QaHS5hcGkudjEuTGlzdEV4ZXJjaXNlc1Jlc3BvbnNlIgSItRgBEm0KFkdldFByZXZpb3VzV29ya291dFNldHMSJS5hcGkudjEuR2V0UHJldmlvdXNXb3Jrb3V0U2V0c1JlcXVlc3QaJi5hcGkudjEuR2V0UHJldmlvdXNXb3Jrb3V0U2V0c1Jlc3BvbnNlIgSItRgBElsKEEdldFBlcnNvbmFsQmVzdHMSHy5hcGkudjEuR2V0UGVyc29uYWxCZXN0c1JlcXVlc3QaIC5hcGkudjEuR2V0UGVyc29uYWxCZXN0c1Jlc3BvbnNlIgSItRgBEkMKCExpc3RTZXRzEhcuYXBpLnYxLkxpc3RTZXRzUmVxdWVzdBoYLmFwaS52MS5MaXN0U2V0c1Jlc3BvbnNlIgSItRgBQpgBCgpjb20uYXBpLnYxQhRFeGVyY2lzZVNlcnZpY2VQcm90b1ABWjtnaXRodWIuY29tL2NybHNzbi9nZXRzdHJvbmdlci9zZXJ2ZXIvZ2VuL3Byb3RvL2FwaS92MTthcGl2MaICA0FYWKoCBkFwaS5WMcoCBkFwaVxWMeICEkFwaVxWMVxHUEJNZXRhZGF0YeoCB0FwaTo6VjFiBnByb3RvMw", [file_api_v1_options, file_api_v1_shared, file_google_protobuf_field_mask, file_buf_validate_validate]);
+  fileDesc("Ch1hcGkvdjEvZXhlcmNpc2Vfc2VydmljZS5wcm90bxIGYXBpLnYxInAKFUNyZWF0ZUV4ZXJjaXNlUmVxdWVzdBIVCgRuYW1lGAEgASgJQge6SARyAhABEg0KBWxhYmVsGAIgASgJEjEKEGNhdGFsb2dfZXhlcmNpc2UYAyABKA4yFy5hcGkudjEuQ2F0YWxvZ0V4ZXJjaXNlIiQKFkNyZWF0ZUV4ZXJjaXNlUmVzcG9uc2USCgoCaWQYASABKAkiKgoSR2V0RXhlcmNpc2VSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASI5ChNHZXRFeGVyY2lzZVJlc3BvbnNlEiIKCGV4ZXJjaXNlGAEgASgLMhAuYXBpLnYxLkV4ZXJjaXNlInQKFVVwZGF0ZUV4ZXJjaXNlUmVxdWVzdBIqCghleGVyY2lzZRgBIAEoCzIQLmFwaS52MS5FeGVyY2lzZUIGukgDyAEBEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzayI8ChZVcGRhdGVFeGVyY2lzZVJlc3BvbnNlEiIKCGV4ZXJjaXNlGAEgASgLMhAuYXBpLnYxLkV4ZXJjaXNlIi0KFURlbGV0ZUV4ZXJjaXNlUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQEiGAoWRGVsZXRlRXhlcmNpc2VSZXNwb25zZSKAAQoUTGlzdEV4ZXJjaXNlc1JlcXVlc3QSDAoEbmFtZRgBIAEoCRIjCgxleGVyY2lzZV9pZHMYAiADKAlCDbpICpIBByIFcgOwAQESNQoKcGFnaW5hdGlvbhgDIAEoCzIZLmFwaS52MS5QYWdpbmF0aW9uUmVxdWVzdEIGukgDyAEBImwKFUxpc3RFeGVyY2lzZXNSZXNwb25zZRIjCglleGVyY2lzZXMYASADKAsyEC5hcGkudjEuRXhlcmNpc2USLgoKcGFnaW5hdGlvbhgCIAEoCzIaLmFwaS52MS5QYWdpbmF0aW9uUmVzcG9uc2UiRAodR2V0UHJldmlvdXNXb3Jrb3V0U2V0c1JlcXVlc3QSIwoMZXhlcmNpc2VfaWRzGAEgAygJQg26SAqSAQciBXIDsAEBIk0KHkdldFByZXZpb3VzV29ya291dFNldHNSZXNwb25zZRIrCg1leGVyY2lzZV9zZXRzGAEgAygLMhQuYXBpLnYxLkV4ZXJjaXNlU2V0cyI0ChdHZXRQZXJzb25hbEJlc3RzUmVxdWVzdBIZCgd1c2VyX2lkGAEgASgJQgi6SAVyA7ABASJHChhHZXRQZXJzb25hbEJlc3RzUmVzcG9uc2USKwoOcGVyc29uYWxfYmVzdHMYASADKAsyEy5hcGkudjEuRXhlcmNpc2VTZXQicAoPTGlzdFNldHNSZXF1ZXN0EhAKCHVzZXJfaWRzGAEgAygJEhQKDGV4ZXJjaXNlX2lkcxgCIAMoCRI1CgpwYWdpbmF0aW9uGAMgASgLMhkuYXBpLnYxLlBhZ2luYXRpb25SZXF1ZXN0Qga6SAPIAQEiXQoQTGlzdFNldHNSZXNwb25zZRIZCgRzZXRzGAEgAygLMgsuYXBpLnYxLlNldBIuCgpwYWdpbmF0aW9uGAIgASgLMhouYXBpLnYxLlBhZ2luYXRpb25SZXNwb25zZSLwAQoaR2V0RXhlcmNpc2VQcm9ncmVzc1JlcXVlc3QSGQoHdXNlcl9pZBgBIAEoCUIIukgFcgOwAQESHQoLZXhlcmNpc2VfaWQYAiABKAlCCLpIBXIDsAEBEjYKCGludGVydmFsGAMgASgOMhguYXBpLnYxLlByb2dyZXNzSW50ZXJ2YWxCCrpIB4IBBBABIAASMAoEZnJvbRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARIuCgJ0bxgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBASJEChtHZXRFeGVyY2lzZVByb2dyZXNzUmVzcG9uc2USJQoGcG9pbnRzGAEgAygLMhUuYXBpLnYxLlByb2dyZXNzUG9pbnQiawoVTWVyZ2VFeGVyY2lzZXNSZXF1ZXN0EiQKEnRhcmdldF9leGVyY2lzZV9pZBgBIAEoCUIIukgFcgOwAQESLAoTc291cmNlX2V4ZXJjaXNlX2lkcxgCIAMoCUIPukgMkgEJCAEiBXIDsAEBImgKFk1lcmdlRXhlcmNpc2VzUmVzcG9uc2USIgoIZXhlcmNpc2UYASABKAsyEC5hcGkudjEuRXhlcmNpc2USKgoNcGVyc29uYWxfYmVzdBgCIAEoCzITLmFwaS52MS5FeGVyY2lzZVNldCJUChtMaXN0RGVsZXRlZEV4ZXJjaXNlc1JlcXVlc3QSNQoKcGFnaW5hdGlvbhgBIAEoCzIZLmFwaS52MS5QYWdpbmF0aW9uUmVxdWVzdEIGukgDyAEBInMKHExpc3REZWxldGVkRXhlcmNpc2VzUmVzcG9uc2USIwoJZXhlcmNpc2VzGAEgAygLMhAuYXBpLnYxLkV4ZXJjaXNlEi4KCnBhZ2luYXRpb24YAiABKAsyGi5hcGkudjEuUGFnaW5hdGlvblJlc3BvbnNlIi4KFlJlc3RvcmVFeGVyY2lzZVJlcXVlc3QSFAoCaWQYASABKAlCCLpIBXIDsAEBIhkKF1Jlc3RvcmVFeGVyY2lzZVJlc3BvbnNlIo0CChVHZXRMZWFkZXJib2FyZFJlcXVlc3QSPQoQY2F0YWxvZ19leGVyY2lzZRgGIAEoDjIXLmFwaS52MS5DYXRhbG9nRXhlcmNpc2VCCrpIB4IBBBABIAASNQoGbWV0cmljGAIgASgOMhkuYXBpLnYxLkxlYWRlcmJvYXJkTWV0cmljQgq6SAeCAQQQASAAEjAKBGZyb20YAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESLgoCdG8YBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESFgoOcGVyX2JvZHl3ZWlnaHQYBSABKAhKBAgBEAIiQwoWR2V0TGVhZGVyYm9hcmRSZXNwb25zZRIpCgdlbnRyaWVzGAEgAygLMhguYXBpLnYxLkxlYWRlcmJvYXJkRW50cnkipAEKDVByb2dyZXNzUG9pbnQSKgoGYnVja2V0GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIWCg50b3Bfc2V0X3dlaWdodBgCIAEoARIUCgx0b3Bfc2V0X3JlcHMYAyABKAUSFQoNZXN0aW1hdGVkX21heBgEIAEoARIOCgZ2b2x1bWUYBSABKAESEgoKdG90YWxfcmVwcxgGIAEoBSJLChBMZWFkZXJib2FyZEVudHJ5EgwKBHJhbmsYASABKAUSGgoEdXNlchgCIAEoCzIMLmFwaS52MS5Vc2VyEg0KBXZhbHVlGAMgASgBKtYBChFMZWFkZXJib2FyZE1ldHJpYxIiCh5MRUFERVJCT0FSRF9NRVRSSUNfVU5TUEVDSUZJRUQQABIpCiVMRUFERVJCT0FSRF9NRVRSSUNfQkVTVF9FU1RJTUFURURfTUFYEAESJgoiTEVBREVSQk9BUkRfTUVUUklDX0hFQVZJRVNUX1NJTkdMRRACEiQKIExFQURFUkJPQVJEX01FVFJJQ19XRUVLTFlfVk9MVU1FEAMSJAogTEVBREVSQk9BUkRfTUVUUklDX1NFU1NJT05fQ09VTlQQBCqJAQoQUHJvZ3Jlc3NJbnRlcnZhbBIhCh1QUk9HUkVTU19JTlRFUlZBTF9VTlNQRUNJRklFRBAAEhkKFVBST0dSRVNTX0lOVEVSVkFMX0RBWRABEhoKFlBST0dSRVNTX0lOVEVSVkFMX1dFRUsQAhIbChdQUk9HUkVTU19JTlRFUlZBTF9NT05USBADMqAJCg9FeGVyY2lzZVNlcnZpY2USVQoOQ3JlYXRlRXhlcmNpc2USHS5hcGkudjEuQ3JlYXRlRXhlcmNpc2VSZXF1ZXN0Gh4uYXBpLnYxLkNyZWF0ZUV4ZXJjaXNlUmVzcG9uc2UiBIi1GAESTAoLR2V0RXhlcmNpc2USGi5hcGkudjEuR2V0RXhlcmNpc2VSZXF1ZXN0GhsuYXBpLnYxLkdldEV4ZXJjaXNlUmVzcG9uc2UiBIi1GAESVQoOVXBkYXRlRXhlcmNpc2USHS5hcGkudjEuVXBkYXRlRXhlcmNpc2VSZXF1ZXN0Gh4uYXBpLnYxLlVwZGF0ZUV4ZXJjaXNlUmVzcG9uc2UiBIi1GAESVQoORGVsZXRlRXhlcmNpc2USHS5hcGkudjEuRGVsZXRlRXhlcmNpc2VSZXF1ZXN0Gh4uYXBpLnYxLkRlbGV0ZUV4ZXJjaXNlUmVzcG9uc2UiBIi1GAESUgoNTGlzdEV4ZXJjaXNlcxIcLmFwaS52MS5MaXN0RXhlcmNpc2VzUmVxdWVzdBodLmFwaS52MS5MaXN0RXhlcmNpc2VzUmVzcG9uc2UiBIi1GAESbQoWR2V0UHJldmlvdXNXb3Jrb3V0U2V0cxIlLmFwaS52MS5HZXRQcmV2aW91c1dvcmtvdXRTZXRzUmVxdWVzdBomLmFwaS52MS5HZXRQcmV2aW91c1dvcmtvdXRTZXRzUmVzcG9uc2UiBIi1GAESWwoQR2V0UGVyc29uYWxCZXN0cxIfLmFwaS52MS5HZXRQZXJzb25hbEJlc3RzUmVxdWVzdBogLmFwaS52MS5HZXRQZXJzb25hbEJlc3RzUmVzcG9uc2UiBIi1GAESQwoITGlzdFNldHMSFy5hcGkudjEuTGlzdFNldHNSZXF1ZXN0GhguYXBpLnYxLkxpc3RTZXRzUmVzcG9uc2UiBIi1GAESZAoTR2V0RXhlcmNpc2VQcm9ncmVzcxIiLmFwaS52MS5HZXRFeGVyY2lzZVByb2dyZXNzUmVxdWVzdBojLmFwaS52MS5HZXRFeGVyY2lzZVByb2dyZXNzUmVzcG9uc2UiBIi1GAESVQoOTWVyZ2VFeGVyY2lzZXMSHS5hcGkudjEuTWVyZ2VFeGVyY2lzZXNSZXF1ZXN0Gh4uYXBpLnYxLk1lcmdlRXhlcmNpc2VzUmVzcG9uc2UiBIi1GAESZwoUTGlzdERlbGV0ZWRFeGVyY2lzZXMSIy5hcGkudjEuTGlzdERlbGV0ZWRFeGVyY2lzZXNSZXF1ZXN0GiQuYXBpLnYxLkxpc3REZWxldGVkRXhlcmNpc2VzUmVzcG9uc2UiBIi1GAESWAoPUmVzdG9yZUV4ZXJjaXNlEh4uYXBpLnYxLlJlc3RvcmVFeGVyY2lzZVJlcXVlc3QaHy5hcGkudjEuUmVzdG9yZUV4ZXJjaXNlUmVzcG9uc2UiBIi1GAESVQoOR2V0TGVhZGVyYm9hcmQSHS5hcGkudjEuR2V0TGVhZGVyYm9hcmRSZXF1ZXN0Gh4uYXBpLnYxLkdldExlYWRlcmJvYXJkUmVzcG9uc2UiBIi1GAFCmAEKCmNvbS5hcGkudjFCFEV4ZXJjaXNlU2VydmljZVByb3RvUAFaO2dpdGh1Yi5jb20vY3Jsc3NuL2dldHN0cm9uZ2VyL3NlcnZlci9nZW4vcHJvdG8vYXBpL3YxO2FwaXYxogIDQVhYqgIGQXBpLlYxygIGQXBpXFYx4gISQXBpXFYxXEdQQk1ldGFkYXRh6gIHQXBpOjpWMWIGcHJvdG8z", [file_api_v1_options, file_api_v1_shared, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_buf_validate_validate]);
 
 /**
  * @generated from message api.v1.CreateExerciseRequest
@@ -31,6 +31,11 @@ export type CreateExerciseRequest = Message<"api.v1.CreateExerciseRequest"> & {
    * @generated from field: string label = 2;
    */
   label: string;
+
+  /**
+   * @generated from field: api.v1.CatalogExercise catalog_exercise = 3;
+   */
+  catalogExercise: CatalogExercise;
 };
 
 /**
@@ -326,6 +331,377 @@ export type ListSetsResponse = Message<"api.v1.ListSetsResponse"> & {
 export const ListSetsResponseSchema: GenMessage<ListSetsResponse> = /*@__PURE__*/
   messageDesc(file_api_v1_exercise_service, 15);
 
+/**
+ * @generated from message api.v1.GetExerciseProgressRequest
+ */
+export type GetExerciseProgressRequest = Message<"api.v1.GetExerciseProgressRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string exercise_id = 2;
+   */
+  exerciseId: string;
+
+  /**
+   * @generated from field: api.v1.ProgressInterval interval = 3;
+   */
+  interval: ProgressInterval;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp from = 4;
+   */
+  from?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp to = 5;
+   */
+  to?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.GetExerciseProgressRequest.
+ * Use `create(GetExerciseProgressRequestSchema)` to create a new message.
+ */
+export const GetExerciseProgressRequestSchema: GenMessage<GetExerciseProgressRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_exercise_service, 16);
+
+/**
+ * @generated from message api.v1.GetExerciseProgressResponse
+ */
+export type GetExerciseProgressResponse = Message<"api.v1.GetExerciseProgressResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.ProgressPoint points = 1;
+   */
+  points: ProgressPoint[];
+};
+
+/**
+ * Describes the message api.v1.GetExerciseProgressResponse.
+ * Use `create(GetExerciseProgressResponseSchema)` to create a new message.
+ */
+export const GetExerciseProgressResponseSchema: GenMessage<GetExerciseProgressResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_exercise_service, 17);
+
+/**
+ * @generated from message api.v1.MergeExercisesRequest
+ */
+export type MergeExercisesRequest = Message<"api.v1.MergeExercisesRequest"> & {
+  /**
+   * @generated from field: string target_exercise_id = 1;
+   */
+  targetExerciseId: string;
+
+  /**
+   * @generated from field: repeated string source_exercise_ids = 2;
+   */
+  sourceExerciseIds: string[];
+};
+
+/**
+ * Describes the message api.v1.MergeExercisesRequest.
+ * Use `create(MergeExercisesRequestSchema)` to create a new message.
+ */
+export const MergeExercisesRequestSchema: GenMessage<MergeExercisesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_exercise_service, 18);
+
+/**
+ * @generated from message api.v1.MergeExercisesResponse
+ */
+export type MergeExercisesResponse = Message<"api.v1.MergeExercisesResponse"> & {
+  /**
+   * @generated from field: api.v1.Exercise exercise = 1;
+   */
+  exercise?: Exercise;
+
+  /**
+   * The personal best of the target exercise after the merge, if any.
+   *
+   * @generated from field: api.v1.ExerciseSet personal_best = 2;
+   */
+  personalBest?: ExerciseSet;
+};
+
+/**
+ * Describes the message api.v1.MergeExercisesResponse.
+ * Use `create(MergeExercisesResponseSchema)` to create a new message.
+ */
+export const MergeExercisesResponseSchema: GenMessage<MergeExercisesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_exercise_service, 19);
+
+/**
+ * @generated from message api.v1.ListDeletedExercisesRequest
+ */
+export type ListDeletedExercisesRequest = Message<"api.v1.ListDeletedExercisesRequest"> & {
+  /**
+   * @generated from field: api.v1.PaginationRequest pagination = 1;
+   */
+  pagination?: PaginationRequest;
+};
+
+/**
+ * Describes the message api.v1.ListDeletedExercisesRequest.
+ * Use `create(ListDeletedExercisesRequestSchema)` to create a new message.
+ */
+export const ListDeletedExercisesRequestSchema: GenMessage<ListDeletedExercisesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_exercise_service, 20);
+
+/**
+ * @generated from message api.v1.ListDeletedExercisesResponse
+ */
+export type ListDeletedExercisesResponse = Message<"api.v1.ListDeletedExercisesResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Exercise exercises = 1;
+   */
+  exercises: Exercise[];
+
+  /**
+   * @generated from field: api.v1.PaginationResponse pagination = 2;
+   */
+  pagination?: PaginationResponse;
+};
+
+/**
+ * Describes the message api.v1.ListDeletedExercisesResponse.
+ * Use `create(ListDeletedExercisesResponseSchema)` to create a new message.
+ */
+export const ListDeletedExercisesResponseSchema: GenMessage<ListDeletedExercisesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_exercise_service, 21);
+
+/**
+ * @generated from message api.v1.RestoreExerciseRequest
+ */
+export type RestoreExerciseRequest = Message<"api.v1.RestoreExerciseRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message api.v1.RestoreExerciseRequest.
+ * Use `create(RestoreExerciseRequestSchema)` to create a new message.
+ */
+export const RestoreExerciseRequestSchema: GenMessage<RestoreExerciseRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_exercise_service, 22);
+
+/**
+ * @generated from message api.v1.RestoreExerciseResponse
+ */
+export type RestoreExerciseResponse = Message<"api.v1.RestoreExerciseResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RestoreExerciseResponse.
+ * Use `create(RestoreExerciseResponseSchema)` to create a new message.
+ */
+export const RestoreExerciseResponseSchema: GenMessage<RestoreExerciseResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_exercise_service, 23);
+
+/**
+ * GetLeaderboardRequest ranks the caller and the users they follow on the
+ * catalog exercise. Since exercises belong to their users, they are matched by
+ * the catalog exercise they are linked to.
+ *
+ * @generated from message api.v1.GetLeaderboardRequest
+ */
+export type GetLeaderboardRequest = Message<"api.v1.GetLeaderboardRequest"> & {
+  /**
+   * @generated from field: api.v1.CatalogExercise catalog_exercise = 6;
+   */
+  catalogExercise: CatalogExercise;
+
+  /**
+   * @generated from field: api.v1.LeaderboardMetric metric = 2;
+   */
+  metric: LeaderboardMetric;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp from = 3;
+   */
+  from?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp to = 4;
+   */
+  to?: Timestamp;
+
+  /**
+   * Divides the values by bodyweight. Users without a bodyweight are left out.
+   *
+   * @generated from field: bool per_bodyweight = 5;
+   */
+  perBodyweight: boolean;
+};
+
+/**
+ * Describes the message api.v1.GetLeaderboardRequest.
+ * Use `create(GetLeaderboardRequestSchema)` to create a new message.
+ */
+export const GetLeaderboardRequestSchema: GenMessage<GetLeaderboardRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_exercise_service, 24);
+
+/**
+ * @generated from message api.v1.GetLeaderboardResponse
+ */
+export type GetLeaderboardResponse = Message<"api.v1.GetLeaderboardResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.LeaderboardEntry entries = 1;
+   */
+  entries: LeaderboardEntry[];
+};
+
+/**
+ * Describes the message api.v1.GetLeaderboardResponse.
+ * Use `create(GetLeaderboardResponseSchema)` to create a new message.
+ */
+export const GetLeaderboardResponseSchema: GenMessage<GetLeaderboardResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_exercise_service, 25);
+
+/**
+ * @generated from message api.v1.ProgressPoint
+ */
+export type ProgressPoint = Message<"api.v1.ProgressPoint"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp bucket = 1;
+   */
+  bucket?: Timestamp;
+
+  /**
+   * @generated from field: double top_set_weight = 2;
+   */
+  topSetWeight: number;
+
+  /**
+   * @generated from field: int32 top_set_reps = 3;
+   */
+  topSetReps: number;
+
+  /**
+   * Epley formula.
+   *
+   * @generated from field: double estimated_max = 4;
+   */
+  estimatedMax: number;
+
+  /**
+   * @generated from field: double volume = 5;
+   */
+  volume: number;
+
+  /**
+   * @generated from field: int32 total_reps = 6;
+   */
+  totalReps: number;
+};
+
+/**
+ * Describes the message api.v1.ProgressPoint.
+ * Use `create(ProgressPointSchema)` to create a new message.
+ */
+export const ProgressPointSchema: GenMessage<ProgressPoint> = /*@__PURE__*/
+  messageDesc(file_api_v1_exercise_service, 26);
+
+/**
+ * @generated from message api.v1.LeaderboardEntry
+ */
+export type LeaderboardEntry = Message<"api.v1.LeaderboardEntry"> & {
+  /**
+   * Users tied on value share a rank.
+   *
+   * @generated from field: int32 rank = 1;
+   */
+  rank: number;
+
+  /**
+   * @generated from field: api.v1.User user = 2;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: double value = 3;
+   */
+  value: number;
+};
+
+/**
+ * Describes the message api.v1.LeaderboardEntry.
+ * Use `create(LeaderboardEntrySchema)` to create a new message.
+ */
+export const LeaderboardEntrySchema: GenMessage<LeaderboardEntry> = /*@__PURE__*/
+  messageDesc(file_api_v1_exercise_service, 27);
+
+/**
+ * @generated from enum api.v1.LeaderboardMetric
+ */
+export enum LeaderboardMetric {
+  /**
+   * @generated from enum value: LEADERBOARD_METRIC_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Epley formula.
+   *
+   * @generated from enum value: LEADERBOARD_METRIC_BEST_ESTIMATED_MAX = 1;
+   */
+  BEST_ESTIMATED_MAX = 1,
+
+  /**
+   * @generated from enum value: LEADERBOARD_METRIC_HEAVIEST_SINGLE = 2;
+   */
+  HEAVIEST_SINGLE = 2,
+
+  /**
+   * @generated from enum value: LEADERBOARD_METRIC_WEEKLY_VOLUME = 3;
+   */
+  WEEKLY_VOLUME = 3,
+
+  /**
+   * @generated from enum value: LEADERBOARD_METRIC_SESSION_COUNT = 4;
+   */
+  SESSION_COUNT = 4,
+}
+
+/**
+ * Describes the enum api.v1.LeaderboardMetric.
+ */
+export const LeaderboardMetricSchema: GenEnum<LeaderboardMetric> = /*@__PURE__*/
+  enumDesc(file_api_v1_exercise_service, 0);
+
+/**
+ * @generated from enum api.v1.ProgressInterval
+ */
+export enum ProgressInterval {
+  /**
+   * @generated from enum value: PROGRESS_INTERVAL_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PROGRESS_INTERVAL_DAY = 1;
+   */
+  DAY = 1,
+
+  /**
+   * @generated from enum value: PROGRESS_INTERVAL_WEEK = 2;
+   */
+  WEEK = 2,
+
+  /**
+   * @generated from enum value: PROGRESS_INTERVAL_MONTH = 3;
+   */
+  MONTH = 3,
+}
+
+/**
+ * Describes the enum api.v1.ProgressInterval.
+ */
+export const ProgressIntervalSchema: GenEnum<ProgressInterval> = /*@__PURE__*/
+  enumDesc(file_api_v1_exercise_service, 1);
+
 /**
  * @generated from service api.v1.ExerciseService
  */
@@ -394,6 +770,46 @@ export const ExerciseService: GenService<{
     input: typeof ListSetsRequestSchema;
     output: typeof ListSetsResponseSchema;
   },
+  /**
+   * @generated from rpc api.v1.ExerciseService.GetExerciseProgress
+   */
+  getExerciseProgress: {
+    methodKind: "unary";
+    input: typeof GetExerciseProgressRequestSchema;
+    output: typeof GetExerciseProgressResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ExerciseService.MergeExercises
+   */
+  mergeExercises: {
+    methodKind: "unary";
+    input: typeof MergeExercisesRequestSchema;
+    output: typeof MergeExercisesResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ExerciseService.ListDeletedExercises
+   */
+  listDeletedExercises: {
+    methodKind: "unary";
+    input: typeof ListDeletedExercisesRequestSchema;
+    output: typeof ListDeletedExercisesResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ExerciseService.RestoreExercise
+   */
+  restoreExercise: {
+    methodKind: "unary";
+    input: typeof RestoreExerciseRequestSchema;
+    output: typeof RestoreExerciseResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.ExerciseService.GetLeaderboard
+   */
+  getLeaderboard: {
+    methodKind: "unary";
+    input: typeof GetLeaderboardRequestSchema;
+    output: typeof GetLeaderboardResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_exercise_service, 0);
 
@@ -4,21 +4,29 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { Challenge } from "./challenge_service_pb";
+import { file_api_v1_challenge_service } from "./challenge_service_pb";
 import { file_api_v1_options } from "./options_pb";
-import type { PaginationRequest, PaginationResponse } from "./shared_pb";
+import type { ExerciseSet, PaginationRequest, PaginationResponse, User } from "./shared_pb";
 import { file_api_v1_shared } from "./shared_pb";
+import type { YearSummary } from "./user_service_pb";
+import { file_api_v1_user_service } from "./user_service_pb";
 import type { Workout } from "./workout_service_pb";
 import { file_api_v1_workout_service } from "./workout_service_pb";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file api/v1/feed_service.proto.
  */
 export const file_api_v1_feed_service: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvdjEvZmVlZF9zZXJ2aWNlLnByb3RvEgZhcGkudjEiZAoUTGlzdEZlZWRJdGVtc1JlcXVlc3QSFQoNZm9sbG93ZWRfb25seRgBIAEoCBI1CgpwYWdpbmF0aW9uGAIgASgLMhkuYXBpLnYxLlBhZ2luYXRpb25SZXF1ZXN0Qga6SAPIAQEiaAoVTGlzdEZlZWRJdGVtc1Jlc3BvbnNlEh8KBWl0ZW1zGAEgAygLMhAuYXBpLnYxLkZlZWRJdGVtEi4KCnBhZ2luYXRpb24YAiABKAsyGi5hcGkudjEuUGFnaW5hdGlvblJlc3BvbnNlIjYKCEZlZWRJdGVtEiIKB3dvcmtvdXQYASABKAsyDy5hcGkudjEuV29ya291dEgAQgYKBHR5cGUyYQoLRmVlZFNlcnZpY2USUgoNTGlzdEZlZWRJdGVtcxIcLmFwaS52MS5MaXN0RmVlZEl0ZW1zUmVxdWVzdBodLmFwaS52MS5MaXN0RmVlZEl0ZW1zUmVzcG9uc2UiBIi1GAFClAEKCmNvbS5hcGkudjFCEEZlZWRTZXJ2aWNlUHJvdG9QAVo7Z2l0aHViLmNvbS9jcmxzc24vZ2V0c3Ryb25nZXIvc2VydmVyL2dlbi9wcm90by9hcGkvdjE7YXBpdjGiAgNBWFiqAgZBcGkuVjHKAgZBcGlcVjHiAhJBcGlcVjFcR1BCTWV0YWRhdGHqAgdBcGk6OlYxYgZwcm90bzM", [file_api_v1_options, file_api_v1_shared, file_api_v1_workout_service, file_buf_validate_validate]);
+  fileDesc("ChlhcGkvdjEvZmVlZF9zZXJ2aWNlLnByb3RvEgZhcGkudjEikAEKFExpc3RGZWVkSXRlbXNSZXF1ZXN0EhUKDWZvbGxvd2VkX29ubHkYASABKAgSNQoKcGFnaW5hdGlvbhgCIAEoCzIZLmFwaS52MS5QYWdpbmF0aW9uUmVxdWVzdEIGukgDyAEBEh4KB2NsdWJfaWQYAyABKAlCCLpIBXIDsAEBSACIAQFCCgoIX2NsdWJfaWQiaAoVTGlzdEZlZWRJdGVtc1Jlc3BvbnNlEh8KBWl0ZW1zGAEgAygLMhAuYXBpLnYxLkZlZWRJdGVtEi4KCnBhZ2luYXRpb24YAiABKAsyGi5hcGkudjEuUGFnaW5hdGlvblJlc3BvbnNlIskCCghGZWVkSXRlbRIiCgd3b3Jrb3V0GAEgASgLMg8uYXBpLnYxLldvcmtvdXRIABIrCgx5ZWFyX3N1bW1hcnkYAiABKAsyEy5hcGkudjEuWWVhclN1bW1hcnlIABIxCg9wZXJzb25hbF9yZWNvcmQYAyABKAsyFi5hcGkudjEuUGVyc29uYWxSZWNvcmRIABIgCgZmb2xsb3cYBCABKAsyDi5hcGkudjEuRm9sbG93SAASKgoLYWNoaWV2ZW1lbnQYBSABKAsyEy5hcGkudjEuQWNoaWV2ZW1lbnRIABIzChBjaGFsbGVuZ2VfcmVzdWx0GAYgASgLMhcuYXBpLnYxLkNoYWxsZW5nZVJlc3VsdEgAEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQgYKBHR5cGUiVwoOUGVyc29uYWxSZWNvcmQSGgoEdXNlchgBIAEoCzIMLmFwaS52MS5Vc2VyEikKDGV4ZXJjaXNlX3NldBgCIAEoCzITLmFwaS52MS5FeGVyY2lzZVNldCJICgZGb2xsb3cSHgoIZm9sbG93ZXIYASABKAsyDC5hcGkudjEuVXNlchIeCghmb2xsb3dlZRgCIAEoCzIMLmFwaS52MS5Vc2VyIkAKC0FjaGlldmVtZW50EhoKBHVzZXIYASABKAsyDC5hcGkudjEuVXNlchIVCg13b3Jrb3V0X2NvdW50GAIgASgFIlUKD0NoYWxsZW5nZVJlc3VsdBIkCgljaGFsbGVuZ2UYASABKAsyES5hcGkudjEuQ2hhbGxlbmdlEhwKBndpbm5lchgCIAEoCzIMLmFwaS52MS5Vc2VyMmEKC0ZlZWRTZXJ2aWNlElIKDUxpc3RGZWVkSXRlbXMSHC5hcGkudjEuTGlzdEZlZWRJdGVtc1JlcXVlc3QaHS5hcGkudjEuTGlzdEZlZWRJdGVtc1Jlc3BvbnNlIgSItRgBQpQBCgpjb20uYXBpLnYxQhBGZWVkU2VydmljZVByb3RvUAFaO2dpdGh1Yi5jb20vY3Jsc3NuL2dldHN0cm9uZ2VyL3NlcnZlci9nZW4vcHJvdG8vYXBpL3YxO2FwaXYxogIDQVhYqgIGQXBpLlYxygIGQXBpXFYx4gISQXBpXFYxXEdQQk1ldGFkYXRh6gIHQXBpOjpWMWIGcHJvdG8z", [file_api_v1_challenge_service, file_api_v1_options, file_api_v1_shared, file_api_v1_user_service, file_api_v1_workout_service, file_buf_validate_validate, file_google_protobuf_timestamp]);
 
 /**
+ * ListFeedItems lists a single timeline of every item kind, newest first.
+ *
  * @generated from message api.v1.ListFeedItemsRequest
  */
 export type ListFeedItemsRequest = Message<"api.v1.ListFeedItemsRequest"> & {
@@ -28,9 +36,20 @@ export type ListFeedItemsRequest = Message<"api.v1.ListFeedItemsRequest"> & {
   followedOnly: boolean;
 
   /**
+   * The page token keeps a position per item kind, so it cannot be exchanged
+   * with the page tokens of other lists.
+   *
    * @generated from field: api.v1.PaginationRequest pagination = 2;
    */
   pagination?: PaginationRequest;
+
+  /**
+   * Only includes the active members of the club, which the user must be an
+   * active member of. Workouts are subject to their usual visibility.
+   *
+   * @generated from field: optional string club_id = 3;
+   */
+  clubId?: string;
 };
 
 /**
@@ -75,7 +94,44 @@ export type FeedItem = Message<"api.v1.FeedItem"> & {
      */
     value: Workout;
     case: "workout";
+  } | {
+    /**
+     * @generated from field: api.v1.YearSummary year_summary = 2;
+     */
+    value: YearSummary;
+    case: "yearSummary";
+  } | {
+    /**
+     * @generated from field: api.v1.PersonalRecord personal_record = 3;
+     */
+    value: PersonalRecord;
+    case: "personalRecord";
+  } | {
+    /**
+     * @generated from field: api.v1.Follow follow = 4;
+     */
+    value: Follow;
+    case: "follow";
+  } | {
+    /**
+     * @generated from field: api.v1.Achievement achievement = 5;
+     */
+    value: Achievement;
+    case: "achievement";
+  } | {
+    /**
+     * @generated from field: api.v1.ChallengeResult challenge_result = 6;
+     */
+    value: ChallengeResult;
+    case: "challengeResult";
   } | { case: undefined; value?: undefined };
+
+  /**
+   * When the item happened, which is what orders the feed.
+   *
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
 };
 
 /**
@@ -85,6 +141,106 @@ export type FeedItem = Message<"api.v1.FeedItem"> & {
 export const FeedItemSchema: GenMessage<FeedItem> = /*@__PURE__*/
   messageDesc(file_api_v1_feed_service, 2);
 
+/**
+ * PersonalRecord is a set that beat every set of the exercise the user logged
+ * in earlier workouts.
+ *
+ * @generated from message api.v1.PersonalRecord
+ */
+export type PersonalRecord = Message<"api.v1.PersonalRecord"> & {
+  /**
+   * @generated from field: api.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: api.v1.ExerciseSet exercise_set = 2;
+   */
+  exerciseSet?: ExerciseSet;
+};
+
+/**
+ * Describes the message api.v1.PersonalRecord.
+ * Use `create(PersonalRecordSchema)` to create a new message.
+ */
+export const PersonalRecordSchema: GenMessage<PersonalRecord> = /*@__PURE__*/
+  messageDesc(file_api_v1_feed_service, 3);
+
+/**
+ * Follow is a follow between two users the viewer follows.
+ *
+ * @generated from message api.v1.Follow
+ */
+export type Follow = Message<"api.v1.Follow"> & {
+  /**
+   * @generated from field: api.v1.User follower = 1;
+   */
+  follower?: User;
+
+  /**
+   * @generated from field: api.v1.User followee = 2;
+   */
+  followee?: User;
+};
+
+/**
+ * Describes the message api.v1.Follow.
+ * Use `create(FollowSchema)` to create a new message.
+ */
+export const FollowSchema: GenMessage<Follow> = /*@__PURE__*/
+  messageDesc(file_api_v1_feed_service, 4);
+
+/**
+ * Achievement is earned by logging a milestone number of workouts, e.g. the
+ * 100th.
+ *
+ * @generated from message api.v1.Achievement
+ */
+export type Achievement = Message<"api.v1.Achievement"> & {
+  /**
+   * @generated from field: api.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: int32 workout_count = 2;
+   */
+  workoutCount: number;
+};
+
+/**
+ * Describes the message api.v1.Achievement.
+ * Use `create(AchievementSchema)` to create a new message.
+ */
+export const AchievementSchema: GenMessage<Achievement> = /*@__PURE__*/
+  messageDesc(file_api_v1_feed_service, 5);
+
+/**
+ * ChallengeResult is a finished challenge the viewer took part in.
+ *
+ * @generated from message api.v1.ChallengeResult
+ */
+export type ChallengeResult = Message<"api.v1.ChallengeResult"> & {
+  /**
+   * @generated from field: api.v1.Challenge challenge = 1;
+   */
+  challenge?: Challenge;
+
+  /**
+   * The participant with the most progress, unset if the lead was shared.
+   *
+   * @generated from field: api.v1.User winner = 2;
+   */
+  winner?: User;
+};
+
+/**
+ * Describes the message api.v1.ChallengeResult.
+ * Use `create(ChallengeResultSchema)` to create a new message.
+ */
+export const ChallengeResultSchema: GenMessage<ChallengeResult> = /*@__PURE__*/
+  messageDesc(file_api_v1_feed_service, 6);
+
 /**
  * @generated from service api.v1.FeedService
  */
@@ -4,9 +4,13 @@
 
 import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { Challenge } from "./challenge_service_pb";
+import { file_api_v1_challenge_service } from "./challenge_service_pb";
 import { file_api_v1_options } from "./options_pb";
 import type { PaginationRequest, PaginationResponse, User } from "./shared_pb";
 import { file_api_v1_shared } from "./shared_pb";
+import type { YearSummary } from "./user_service_pb";
+import { file_api_v1_user_service } from "./user_service_pb";
 import type { Workout } from "./workout_service_pb";
 import { file_api_v1_workout_service } from "./workout_service_pb";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
@@ -16,7 +20,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/notification_service.proto.
  */
 export const file_api_v1_notification_service: GenFile = /*@__PURE__*/
-  fileDesc("CiFhcGkvdjEvbm90aWZpY2F0aW9uX3NlcnZpY2UucHJvdG8SBmFwaS52MSJRChhMaXN0Tm90aWZpY2F0aW9uc1JlcXVlc3QSNQoKcGFnaW5hdGlvbhgBIAEoCzIZLmFwaS52MS5QYWdpbmF0aW9uUmVxdWVzdEIGukgDyAEBIngKGUxpc3ROb3RpZmljYXRpb25zUmVzcG9uc2USKwoNbm90aWZpY2F0aW9ucxgBIAMoCzIULmFwaS52MS5Ob3RpZmljYXRpb24SLgoKcGFnaW5hdGlvbhgCIAEoCzIaLmFwaS52MS5QYWdpbmF0aW9uUmVzcG9uc2UiIAoeTWFya05vdGlmaWNhdGlvbnNBc1JlYWRSZXF1ZXN0IiEKH01hcmtOb3RpZmljYXRpb25zQXNSZWFkUmVzcG9uc2UiHAoaVW5yZWFkTm90aWZpY2F0aW9uc1JlcXVlc3QiLAobVW5yZWFkTm90aWZpY2F0aW9uc1Jlc3BvbnNlEg0KBWNvdW50GAEgASgDIrYCCgxOb3RpZmljYXRpb24SCgoCaWQYASABKAkSGAoQbm90aWZpZWRfYXRfdW5peBgCIAEoAxI6Cg11c2VyX2ZvbGxvd2VkGAMgASgLMiEuYXBpLnYxLk5vdGlmaWNhdGlvbi5Vc2VyRm9sbG93ZWRIABI+Cg93b3Jrb3V0X2NvbW1lbnQYBCABKAsyIy5hcGkudjEuTm90aWZpY2F0aW9uLldvcmtvdXRDb21tZW50SAAaKwoMVXNlckZvbGxvd2VkEhsKBWFjdG9yGAEgASgLMgwuYXBpLnYxLlVzZXIaTwoOV29ya291dENvbW1lbnQSGwoFYWN0b3IYASABKAsyDC5hcGkudjEuVXNlchIgCgd3b3Jrb3V0GAIgASgLMg8uYXBpLnYxLldvcmtvdXRCBgoEdHlwZTLPAgoTTm90aWZpY2F0aW9uU2VydmljZRJeChFMaXN0Tm90aWZpY2F0aW9ucxIgLmFwaS52MS5MaXN0Tm90aWZpY2F0aW9uc1JlcXVlc3QaIS5hcGkudjEuTGlzdE5vdGlmaWNhdGlvbnNSZXNwb25zZSIEiLUYARJwChdNYXJrTm90aWZpY2F0aW9uc0FzUmVhZBImLmFwaS52MS5NYXJrTm90aWZpY2F0aW9uc0FzUmVhZFJlcXVlc3QaJy5hcGkudjEuTWFya05vdGlmaWNhdGlvbnNBc1JlYWRSZXNwb25zZSIEiLUYARJmChNVbnJlYWROb3RpZmljYXRpb25zEiIuYXBpLnYxLlVucmVhZE5vdGlmaWNhdGlvbnNSZXF1ZXN0GiMuYXBpLnYxLlVucmVhZE5vdGlmaWNhdGlvbnNSZXNwb25zZSIEiLUYATABQpwBCgpjb20uYXBpLnYxQhhOb3RpZmljYXRpb25TZXJ2aWNlUHJvdG9QAVo7Z2l0aHViLmNvbS9jcmxzc24vZ2V0c3Ryb25nZXIvc2VydmVyL2dlbi9wcm90by9hcGkvdjE7YXBpdjGiAgNBWFiqAgZBcGkuVjHKAgZBcGlcVjHiAhJBcGlcVjFcR1BCTWV0YWRhdGHqAgdBcGk6OlYxYgZwcm90bzM", [file_api_v1_options, file_api_v1_shared, file_api_v1_workout_service, file_buf_validate_validate]);
+  fileDesc("CiFhcGkvdjEvbm90aWZpY2F0aW9uX3NlcnZpY2UucHJvdG8SBmFwaS52MSJRChhMaXN0Tm90aWZpY2F0aW9uc1JlcXVlc3QSNQoKcGFnaW5hdGlvbhgBIAEoCzIZLmFwaS52MS5QYWdpbmF0aW9uUmVxdWVzdEIGukgDyAEBIngKGUxpc3ROb3RpZmljYXRpb25zUmVzcG9uc2USKwoNbm90aWZpY2F0aW9ucxgBIAMoCzIULmFwaS52MS5Ob3RpZmljYXRpb24SLgoKcGFnaW5hdGlvbhgCIAEoCzIaLmFwaS52MS5QYWdpbmF0aW9uUmVzcG9uc2UiIAoeTWFya05vdGlmaWNhdGlvbnNBc1JlYWRSZXF1ZXN0IiEKH01hcmtOb3RpZmljYXRpb25zQXNSZWFkUmVzcG9uc2UiHAoaVW5yZWFkTm90aWZpY2F0aW9uc1JlcXVlc3QiLAobVW5yZWFkTm90aWZpY2F0aW9uc1Jlc3BvbnNlEg0KBWNvdW50GAEgASgDIqwMCgxOb3RpZmljYXRpb24SCgoCaWQYASABKAkSGAoQbm90aWZpZWRfYXRfdW5peBgCIAEoAxI6Cg11c2VyX2ZvbGxvd2VkGAMgASgLMiEuYXBpLnYxLk5vdGlmaWNhdGlvbi5Vc2VyRm9sbG93ZWRIABI+Cg93b3Jrb3V0X2NvbW1lbnQYBCABKAsyIy5hcGkudjEuTm90aWZpY2F0aW9uLldvcmtvdXRDb21tZW50SAASOAoMeWVhcl9zdW1tYXJ5GAUgASgLMiAuYXBpLnYxLk5vdGlmaWNhdGlvbi5ZZWFyU3VtbWFyeUgAEkkKFXdvcmtvdXRfY29tbWVudF9yZXBseRgGIAEoCzIoLmFwaS52MS5Ob3RpZmljYXRpb24uV29ya291dENvbW1lbnRSZXBseUgAEkIKEXdvcmtvdXRfcmVhY3Rpb25zGAcgASgLMiUuYXBpLnYxLk5vdGlmaWNhdGlvbi5Xb3Jrb3V0UmVhY3Rpb25zSAASLwoHbWVudGlvbhgIIAEoCzIcLmFwaS52MS5Ob3RpZmljYXRpb24uTWVudGlvbkgAEjwKDmZvbGxvd19yZXF1ZXN0GAkgASgLMiIuYXBpLnYxLk5vdGlmaWNhdGlvbi5Gb2xsb3dSZXF1ZXN0SAASTQoXZm9sbG93X3JlcXVlc3RfYXBwcm92ZWQYCiABKAsyKi5hcGkudjEuTm90aWZpY2F0aW9uLkZvbGxvd1JlcXVlc3RBcHByb3ZlZEgAEkAKEGNoYWxsZW5nZV9pbnZpdGUYCyABKAsyJC5hcGkudjEuTm90aWZpY2F0aW9uLkNoYWxsZW5nZUludml0ZUgAEkcKFGNoYWxsZW5nZV9sZWFkX3Rha2VuGAwgASgLMicuYXBpLnYxLk5vdGlmaWNhdGlvbi5DaGFsbGVuZ2VMZWFkVGFrZW5IABI+Cg9jaGFsbGVuZ2VfZW5kZWQYDSABKAsyIy5hcGkudjEuTm90aWZpY2F0aW9uLkNoYWxsZW5nZUVuZGVkSAAaKwoMVXNlckZvbGxvd2VkEhsKBWFjdG9yGAEgASgLMgwuYXBpLnYxLlVzZXIaTwoOV29ya291dENvbW1lbnQSGwoFYWN0b3IYASABKAsyDC5hcGkudjEuVXNlchIgCgd3b3Jrb3V0GAIgASgLMg8uYXBpLnYxLldvcmtvdXQaMwoLWWVhclN1bW1hcnkSJAoHc3VtbWFyeRgBIAEoCzITLmFwaS52MS5ZZWFyU3VtbWFyeRpUChNXb3Jrb3V0Q29tbWVudFJlcGx5EhsKBWFjdG9yGAEgASgLMgwuYXBpLnYxLlVzZXISIAoHd29ya291dBgCIAEoCzIPLmFwaS52MS5Xb3Jrb3V0GmQKEFdvcmtvdXRSZWFjdGlvbnMSGwoFYWN0b3IYASABKAsyDC5hcGkudjEuVXNlchIgCgd3b3Jrb3V0GAIgASgLMg8uYXBpLnYxLldvcmtvdXQSEQoJcmVhY3Rpb25zGAMgASgFGkgKB01lbnRpb24SGwoFYWN0b3IYASABKAsyDC5hcGkudjEuVXNlchIgCgd3b3Jrb3V0GAIgASgLMg8uYXBpLnYxLldvcmtvdXQaLAoNRm9sbG93UmVxdWVzdBIbCgVhY3RvchgBIAEoCzIMLmFwaS52MS5Vc2VyGjQKFUZvbGxvd1JlcXVlc3RBcHByb3ZlZBIbCgVhY3RvchgBIAEoCzIMLmFwaS52MS5Vc2VyGlQKD0NoYWxsZW5nZUludml0ZRIbCgVhY3RvchgBIAEoCzIMLmFwaS52MS5Vc2VyEiQKCWNoYWxsZW5nZRgCIAEoCzIRLmFwaS52MS5DaGFsbGVuZ2UaVwoSQ2hhbGxlbmdlTGVhZFRha2VuEhsKBWFjdG9yGAEgASgLMgwuYXBpLnYxLlVzZXISJAoJY2hhbGxlbmdlGAIgASgLMhEuYXBpLnYxLkNoYWxsZW5nZRpUCg5DaGFsbGVuZ2VFbmRlZBIcCgZ3aW5uZXIYASABKAsyDC5hcGkudjEuVXNlchIkCgljaGFsbGVuZ2UYAiABKAsyES5hcGkudjEuQ2hhbGxlbmdlQgYKBHR5cGUyzwIKE05vdGlmaWNhdGlvblNlcnZpY2USXgoRTGlzdE5vdGlmaWNhdGlvbnMSIC5hcGkudjEuTGlzdE5vdGlmaWNhdGlvbnNSZXF1ZXN0GiEuYXBpLnYxLkxpc3ROb3RpZmljYXRpb25zUmVzcG9uc2UiBIi1GAEScAoXTWFya05vdGlmaWNhdGlvbnNBc1JlYWQSJi5hcGkudjEuTWFya05vdGlmaWNhdGlvbnNBc1JlYWRSZXF1ZXN0GicuYXBpLnYxLk1hcmtOb3RpZmljYXRpb25zQXNSZWFkUmVzcG9uc2UiBIi1GAESZgoTVW5yZWFkTm90aWZpY2F0aW9ucxIiLmFwaS52MS5VbnJlYWROb3RpZmljYXRpb25zUmVxdWVzdBojLmFwaS52MS5VbnJlYWROb3RpZmljYXRpb25zUmVzcG9uc2UiBIi1GAEwAUKcAQoKY29tLmFwaS52MUIYTm90aWZpY2F0aW9uU2VydmljZVByb3RvUAFaO2dpdGh1Yi5jb20vY3Jsc3NuL2dldHN0cm9uZ2VyL3NlcnZlci9nZW4vcHJvdG8vYXBpL3YxO2FwaXYxogIDQVhYqgIGQXBpLlYxygIGQXBpXFYx4gISQXBpXFYxXEdQQk1ldGFkYXRh6gIHQXBpOjpWMWIGcHJvdG8z", [file_api_v1_challenge_service, file_api_v1_options, file_api_v1_shared, file_api_v1_user_service, file_api_v1_workout_service, file_buf_validate_validate]);
 
 /**
  * @generated from message api.v1.ListNotificationsRequest
@@ -144,6 +148,60 @@ export type Notification = Message<"api.v1.Notification"> & {
      */
     value: Notification_WorkoutComment;
     case: "workoutComment";
+  } | {
+    /**
+     * @generated from field: api.v1.Notification.YearSummary year_summary = 5;
+     */
+    value: Notification_YearSummary;
+    case: "yearSummary";
+  } | {
+    /**
+     * @generated from field: api.v1.Notification.WorkoutCommentReply workout_comment_reply = 6;
+     */
+    value: Notification_WorkoutCommentReply;
+    case: "workoutCommentReply";
+  } | {
+    /**
+     * @generated from field: api.v1.Notification.WorkoutReactions workout_reactions = 7;
+     */
+    value: Notification_WorkoutReactions;
+    case: "workoutReactions";
+  } | {
+    /**
+     * @generated from field: api.v1.Notification.Mention mention = 8;
+     */
+    value: Notification_Mention;
+    case: "mention";
+  } | {
+    /**
+     * @generated from field: api.v1.Notification.FollowRequest follow_request = 9;
+     */
+    value: Notification_FollowRequest;
+    case: "followRequest";
+  } | {
+    /**
+     * @generated from field: api.v1.Notification.FollowRequestApproved follow_request_approved = 10;
+     */
+    value: Notification_FollowRequestApproved;
+    case: "followRequestApproved";
+  } | {
+    /**
+     * @generated from field: api.v1.Notification.ChallengeInvite challenge_invite = 11;
+     */
+    value: Notification_ChallengeInvite;
+    case: "challengeInvite";
+  } | {
+    /**
+     * @generated from field: api.v1.Notification.ChallengeLeadTaken challenge_lead_taken = 12;
+     */
+    value: Notification_ChallengeLeadTaken;
+    case: "challengeLeadTaken";
+  } | {
+    /**
+     * @generated from field: api.v1.Notification.ChallengeEnded challenge_ended = 13;
+     */
+    value: Notification_ChallengeEnded;
+    case: "challengeEnded";
   } | { case: undefined; value?: undefined };
 };
 
@@ -193,6 +251,200 @@ export type Notification_WorkoutComment = Message<"api.v1.Notification.WorkoutCo
 export const Notification_WorkoutCommentSchema: GenMessage<Notification_WorkoutComment> = /*@__PURE__*/
   messageDesc(file_api_v1_notification_service, 6, 1);
 
+/**
+ * @generated from message api.v1.Notification.YearSummary
+ */
+export type Notification_YearSummary = Message<"api.v1.Notification.YearSummary"> & {
+  /**
+   * @generated from field: api.v1.YearSummary summary = 1;
+   */
+  summary?: YearSummary;
+};
+
+/**
+ * Describes the message api.v1.Notification.YearSummary.
+ * Use `create(Notification_YearSummarySchema)` to create a new message.
+ */
+export const Notification_YearSummarySchema: GenMessage<Notification_YearSummary> = /*@__PURE__*/
+  messageDesc(file_api_v1_notification_service, 6, 2);
+
+/**
+ * @generated from message api.v1.Notification.WorkoutCommentReply
+ */
+export type Notification_WorkoutCommentReply = Message<"api.v1.Notification.WorkoutCommentReply"> & {
+  /**
+   * @generated from field: api.v1.User actor = 1;
+   */
+  actor?: User;
+
+  /**
+   * @generated from field: api.v1.Workout workout = 2;
+   */
+  workout?: Workout;
+};
+
+/**
+ * Describes the message api.v1.Notification.WorkoutCommentReply.
+ * Use `create(Notification_WorkoutCommentReplySchema)` to create a new message.
+ */
+export const Notification_WorkoutCommentReplySchema: GenMessage<Notification_WorkoutCommentReply> = /*@__PURE__*/
+  messageDesc(file_api_v1_notification_service, 6, 3);
+
+/**
+ * Reactions to the same workout are grouped into a single notification
+ * where the actor is the latest user to react.
+ *
+ * @generated from message api.v1.Notification.WorkoutReactions
+ */
+export type Notification_WorkoutReactions = Message<"api.v1.Notification.WorkoutReactions"> & {
+  /**
+   * @generated from field: api.v1.User actor = 1;
+   */
+  actor?: User;
+
+  /**
+   * @generated from field: api.v1.Workout workout = 2;
+   */
+  workout?: Workout;
+
+  /**
+   * @generated from field: int32 reactions = 3;
+   */
+  reactions: number;
+};
+
+/**
+ * Describes the message api.v1.Notification.WorkoutReactions.
+ * Use `create(Notification_WorkoutReactionsSchema)` to create a new message.
+ */
+export const Notification_WorkoutReactionsSchema: GenMessage<Notification_WorkoutReactions> = /*@__PURE__*/
+  messageDesc(file_api_v1_notification_service, 6, 4);
+
+/**
+ * @generated from message api.v1.Notification.Mention
+ */
+export type Notification_Mention = Message<"api.v1.Notification.Mention"> & {
+  /**
+   * @generated from field: api.v1.User actor = 1;
+   */
+  actor?: User;
+
+  /**
+   * @generated from field: api.v1.Workout workout = 2;
+   */
+  workout?: Workout;
+};
+
+/**
+ * Describes the message api.v1.Notification.Mention.
+ * Use `create(Notification_MentionSchema)` to create a new message.
+ */
+export const Notification_MentionSchema: GenMessage<Notification_Mention> = /*@__PURE__*/
+  messageDesc(file_api_v1_notification_service, 6, 5);
+
+/**
+ * @generated from message api.v1.Notification.FollowRequest
+ */
+export type Notification_FollowRequest = Message<"api.v1.Notification.FollowRequest"> & {
+  /**
+   * @generated from field: api.v1.User actor = 1;
+   */
+  actor?: User;
+};
+
+/**
+ * Describes the message api.v1.Notification.FollowRequest.
+ * Use `create(Notification_FollowRequestSchema)` to create a new message.
+ */
+export const Notification_FollowRequestSchema: GenMessage<Notification_FollowRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_notification_service, 6, 6);
+
+/**
+ * @generated from message api.v1.Notification.FollowRequestApproved
+ */
+export type Notification_FollowRequestApproved = Message<"api.v1.Notification.FollowRequestApproved"> & {
+  /**
+   * @generated from field: api.v1.User actor = 1;
+   */
+  actor?: User;
+};
+
+/**
+ * Describes the message api.v1.Notification.FollowRequestApproved.
+ * Use `create(Notification_FollowRequestApprovedSchema)` to create a new message.
+ */
+export const Notification_FollowRequestApprovedSchema: GenMessage<Notification_FollowRequestApproved> = /*@__PURE__*/
+  messageDesc(file_api_v1_notification_service, 6, 7);
+
+/**
+ * @generated from message api.v1.Notification.ChallengeInvite
+ */
+export type Notification_ChallengeInvite = Message<"api.v1.Notification.ChallengeInvite"> & {
+  /**
+   * @generated from field: api.v1.User actor = 1;
+   */
+  actor?: User;
+
+  /**
+   * @generated from field: api.v1.Challenge challenge = 2;
+   */
+  challenge?: Challenge;
+};
+
+/**
+ * Describes the message api.v1.Notification.ChallengeInvite.
+ * Use `create(Notification_ChallengeInviteSchema)` to create a new message.
+ */
+export const Notification_ChallengeInviteSchema: GenMessage<Notification_ChallengeInvite> = /*@__PURE__*/
+  messageDesc(file_api_v1_notification_service, 6, 8);
+
+/**
+ * @generated from message api.v1.Notification.ChallengeLeadTaken
+ */
+export type Notification_ChallengeLeadTaken = Message<"api.v1.Notification.ChallengeLeadTaken"> & {
+  /**
+   * @generated from field: api.v1.User actor = 1;
+   */
+  actor?: User;
+
+  /**
+   * @generated from field: api.v1.Challenge challenge = 2;
+   */
+  challenge?: Challenge;
+};
+
+/**
+ * Describes the message api.v1.Notification.ChallengeLeadTaken.
+ * Use `create(Notification_ChallengeLeadTakenSchema)` to create a new message.
+ */
+export const Notification_ChallengeLeadTakenSchema: GenMessage<Notification_ChallengeLeadTaken> = /*@__PURE__*/
+  messageDesc(file_api_v1_notification_service, 6, 9);
+
+/**
+ * The winner is unset if no participant made any progress or the lead was
+ * shared when the challenge ended.
+ *
+ * @generated from message api.v1.Notification.ChallengeEnded
+ */
+export type Notification_ChallengeEnded = Message<"api.v1.Notification.ChallengeEnded"> & {
+  /**
+   * @generated from field: api.v1.User winner = 1;
+   */
+  winner?: User;
+
+  /**
+   * @generated from field: api.v1.Challenge challenge = 2;
+   */
+  challenge?: Challenge;
+};
+
+/**
+ * Describes the message api.v1.Notification.ChallengeEnded.
+ * Use `create(Notification_ChallengeEndedSchema)` to create a new message.
+ */
+export const Notification_ChallengeEndedSchema: GenMessage<Notification_ChallengeEnded> = /*@__PURE__*/
+  messageDesc(file_api_v1_notification_service, 6, 10);
+
 /**
  * @generated from service api.v1.NotificationService
  */
@@ -11,7 +11,7 @@ import { file_google_protobuf_descriptor } from "@bufbuild/protobuf/wkt";
  * Describes the file api/v1/options.proto.
  */
 export const file_api_v1_options: GenFile = /*@__PURE__*/
-  fileDesc("ChRhcGkvdjEvb3B0aW9ucy5wcm90bxIGYXBpLnYxOjQKBGF1dGgSHi5nb29nbGUucHJvdG9idWYuTWV0aG9kT3B0aW9ucxjRhgMgASgIUgRhdXRoQpABCgpjb20uYXBpLnYxQgxPcHRpb25zUHJvdG9QAVo7Z2l0aHViLmNvbS9jcmxzc24vZ2V0c3Ryb25nZXIvc2VydmVyL2dlbi9wcm90by9hcGkvdjE7YXBpdjGiAgNBWFiqAgZBcGkuVjHKAgZBcGlcVjHiAhJBcGlcVjFcR1BCTWV0YWRhdGHqAgdBcGk6OlYxYgZwcm90bzM", [file_google_protobuf_descriptor]);
+  fileDesc("ChRhcGkvdjEvb3B0aW9ucy5wcm90bxIGYXBpLnYxOjQKBGF1dGgSHi5nb29nbGUucHJvdG9idWYuTWV0aG9kT3B0aW9ucxjRhgMgASgIUgRhdXRoOjYKBWFkbWluEh4uZ29vZ2xlLnByb3RvYnVmLk1ldGhvZE9wdGlvbnMY0oYDIAEoCFIFYWRtaW5CkAEKCmNvbS5hcGkudjFCDE9wdGlvbnNQcm90b1ABWjtnaXRodWIuY29tL2NybHNzbi9nZXRzdHJvbmdlci9zZXJ2ZXIvZ2VuL3Byb3RvL2FwaS92MTthcGl2MaICA0FYWKoCBkFwaS5WMcoCBkFwaVxWMeICEkFwaVxWMVxHUEJNZXRhZGF0YeoCB0FwaTo6VjFiBnByb3RvMw", [file_google_protobuf_descriptor]);
 
 /**
  * @generated from extension: bool auth = 50001;
@@ -19,3 +19,11 @@ export const file_api_v1_options: GenFile = /*@__PURE__*/
 export const auth: GenExtension<MethodOptions, boolean> = /*@__PURE__*/
   extDesc(file_api_v1_options, 0);
 
+/**
+ * Restricts the method to admins. Implies auth.
+ *
+ * @generated from extension: bool admin = 50002;
+ */
+export const admin: GenExtension<MethodOptions, boolean> = /*@__PURE__*/
+  extDesc(file_api_v1_options, 1);
+
@@ -0,0 +1,84 @@
+// @generated by protoc-gen-es v2.2.3 with parameter "target=ts"
+// @generated from file api/v1/report_service.proto (package api.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import { file_api_v1_options } from "./options_pb";
+import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file api/v1/report_service.proto.
+ */
+export const file_api_v1_report_service: GenFile = /*@__PURE__*/
+  fileDesc("ChthcGkvdjEvcmVwb3J0X3NlcnZpY2UucHJvdG8SBmFwaS52MSKgAQoUUmVwb3J0Q29udGVudFJlcXVlc3QSHgoKd29ya291dF9pZBgBIAEoCUIIukgFcgOwAQFIABIeCgpjb21tZW50X2lkGAIgASgJQgi6SAVyA7ABAUgAEhsKB3VzZXJfaWQYAyABKAlCCLpIBXIDsAEBSAASGgoGcmVhc29uGAQgASgJQgq6SAdyBRABGOgHQg8KBnRhcmdldBIFukgCCAEiFwoVUmVwb3J0Q29udGVudFJlc3BvbnNlMmMKDVJlcG9ydFNlcnZpY2USUgoNUmVwb3J0Q29udGVudBIcLmFwaS52MS5SZXBvcnRDb250ZW50UmVxdWVzdBodLmFwaS52MS5SZXBvcnRDb250ZW50UmVzcG9uc2UiBIi1GAFClgEKCmNvbS5hcGkudjFCElJlcG9ydFNlcnZpY2VQcm90b1ABWjtnaXRodWIuY29tL2NybHNzbi9nZXRzdHJvbmdlci9zZXJ2ZXIvZ2VuL3Byb3RvL2FwaS92MTthcGl2MaICA0FYWKoCBkFwaS5WMcoCBkFwaVxWMeICEkFwaVxWMVxHUEJNZXRhZGF0YeoCB0FwaTo6VjFiBnByb3RvMw", [file_api_v1_options, file_buf_validate_validate]);
+
+/**
+ * @generated from message api.v1.ReportContentRequest
+ */
+export type ReportContentRequest = Message<"api.v1.ReportContentRequest"> & {
+  /**
+   * @generated from oneof api.v1.ReportContentRequest.target
+   */
+  target: {
+    /**
+     * @generated from field: string workout_id = 1;
+     */
+    value: string;
+    case: "workoutId";
+  } | {
+    /**
+     * @generated from field: string comment_id = 2;
+     */
+    value: string;
+    case: "commentId";
+  } | {
+    /**
+     * @generated from field: string user_id = 3;
+     */
+    value: string;
+    case: "userId";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message api.v1.ReportContentRequest.
+ * Use `create(ReportContentRequestSchema)` to create a new message.
+ */
+export const ReportContentRequestSchema: GenMessage<ReportContentRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_report_service, 0);
+
+/**
+ * @generated from message api.v1.ReportContentResponse
+ */
+export type ReportContentResponse = Message<"api.v1.ReportContentResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.ReportContentResponse.
+ * Use `create(ReportContentResponseSchema)` to create a new message.
+ */
+export const ReportContentResponseSchema: GenMessage<ReportContentResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_report_service, 1);
+
+/**
+ * @generated from service api.v1.ReportService
+ */
+export const ReportService: GenService<{
+  /**
+   * @generated from rpc api.v1.ReportService.ReportContent
+   */
+  reportContent: {
+    methodKind: "unary";
+    input: typeof ReportContentRequestSchema;
+    output: typeof ReportContentResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_api_v1_report_service, 0);
+
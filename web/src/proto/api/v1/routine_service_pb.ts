@@ -2,8 +2,8 @@
 // @generated from file api/v1/routine_service.proto (package api.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
 import { file_api_v1_options } from "./options_pb";
 import type { Exercise, PaginationRequest, PaginationResponse } from "./shared_pb";
 import { file_api_v1_shared } from "./shared_pb";
@@ -14,7 +14,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/routine_service.proto.
  */
 export const file_api_v1_routine_service: GenFile = /*@__PURE__*/
-  fileDesc("ChxhcGkvdjEvcm91dGluZV9zZXJ2aWNlLnByb3RvEgZhcGkudjEiTQoUQ3JlYXRlUm91dGluZVJlcXVlc3QSFQoEbmFtZRgBIAEoCUIHukgEcgIQARIeCgxleGVyY2lzZV9pZHMYAiADKAlCCLpIBZIBAggBIiMKFUNyZWF0ZVJvdXRpbmVSZXNwb25zZRIKCgJpZBgBIAEoCSIpChFHZXRSb3V0aW5lUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQEiNgoSR2V0Um91dGluZVJlc3BvbnNlEiAKB3JvdXRpbmUYASABKAsyDy5hcGkudjEuUm91dGluZSJAChRVcGRhdGVSb3V0aW5lUmVxdWVzdBIoCgdyb3V0aW5lGAEgASgLMg8uYXBpLnYxLlJvdXRpbmVCBrpIA8gBASI5ChVVcGRhdGVSb3V0aW5lUmVzcG9uc2USIAoHcm91dGluZRgBIAEoCzIPLmFwaS52MS5Sb3V0aW5lIiwKFERlbGV0ZVJvdXRpbmVSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASIXChVEZWxldGVSb3V0aW5lUmVzcG9uc2UiWgoTTGlzdFJvdXRpbmVzUmVxdWVzdBIMCgRuYW1lGAEgASgJEjUKCnBhZ2luYXRpb24YAiABKAsyGS5hcGkudjEuUGFnaW5hdGlvblJlcXVlc3RCBrpIA8gBASJpChRMaXN0Um91dGluZXNSZXNwb25zZRIhCghyb3V0aW5lcxgBIAMoCzIPLmFwaS52MS5Sb3V0aW5lEi4KCnBhZ2luYXRpb24YAiABKAsyGi5hcGkudjEuUGFnaW5hdGlvblJlc3BvbnNlIlEKEkFkZEV4ZXJjaXNlUmVxdWVzdBIcCgpyb3V0aW5lX2lkGAEgASgJQgi6SAVyA7ABARIdCgtleGVyY2lzZV9pZBgCIAEoCUIIukgFcgOwAQEiFQoTQWRkRXhlcmNpc2VSZXNwb25zZSJUChVSZW1vdmVFeGVyY2lzZVJlcXVlc3QSHAoKcm91dGluZV9pZBgBIAEoCUIIukgFcgOwAQESHQoLZXhlcmNpc2VfaWQYAiABKAlCCLpIBXIDsAEBIhgKFlJlbW92ZUV4ZXJjaXNlUmVzcG9uc2UiWgoaVXBkYXRlRXhlcmNpc2VPcmRlclJlcXVlc3QSHAoKcm91dGluZV9pZBgBIAEoCUIIukgFcgOwAQESHgoMZXhlcmNpc2VfaWRzGAIgAygJQgi6SAWSAQIIASIdChtVcGRhdGVFeGVyY2lzZU9yZGVyUmVzcG9uc2UiZQoHUm91dGluZRIUCgJpZBgBIAEoCUIIukgFcgOwAQESFQoEbmFtZRgCIAEoCUIHukgEcgIQARItCglleGVyY2lzZXMYAyADKAsyEC5hcGkudjEuRXhlcmNpc2VCCLpIBZIBAggBMrMFCg5Sb3V0aW5lU2VydmljZRJSCg1DcmVhdGVSb3V0aW5lEhwuYXBpLnYxLkNyZWF0ZVJvdXRpbmVSZXF1ZXN0Gh0uYXBpLnYxLkNyZWF0ZVJvdXRpbmVSZXNwb25zZSIEiLUYARJJCgpHZXRSb3V0aW5lEhkuYXBpLnYxLkdldFJvdXRpbmVSZXF1ZXN0GhouYXBpLnYxLkdldFJvdXRpbmVSZXNwb25zZSIEiLUYARJSCg1VcGRhdGVSb3V0aW5lEhwuYXBpLnYxLlVwZGF0ZVJvdXRpbmVSZXF1ZXN0Gh0uYXBpLnYxLlVwZGF0ZVJvdXRpbmVSZXNwb25zZSIEiLUYARJSCg1EZWxldGVSb3V0aW5lEhwuYXBpLnYxLkRlbGV0ZVJvdXRpbmVSZXF1ZXN0Gh0uYXBpLnYxLkRlbGV0ZVJvdXRpbmVSZXNwb25zZSIEiLUYARJPCgxMaXN0Um91dGluZXMSGy5hcGkudjEuTGlzdFJvdXRpbmVzUmVxdWVzdBocLmFwaS52MS5MaXN0Um91dGluZXNSZXNwb25zZSIEiLUYARJMCgtBZGRFeGVyY2lzZRIaLmFwaS52MS5BZGRFeGVyY2lzZVJlcXVlc3QaGy5hcGkudjEuQWRkRXhlcmNpc2VSZXNwb25zZSIEiLUYARJVCg5SZW1vdmVFeGVyY2lzZRIdLmFwaS52MS5SZW1vdmVFeGVyY2lzZVJlcXVlc3QaHi5hcGkudjEuUmVtb3ZlRXhlcmNpc2VSZXNwb25zZSIEiLUYARJkChNVcGRhdGVFeGVyY2lzZU9yZGVyEiIuYXBpLnYxLlVwZGF0ZUV4ZXJjaXNlT3JkZXJSZXF1ZXN0GiMuYXBpLnYxLlVwZGF0ZUV4ZXJjaXNlT3JkZXJSZXNwb25zZSIEiLUYAUKXAQoKY29tLmFwaS52MUITUm91dGluZVNlcnZpY2VQcm90b1ABWjtnaXRodWIuY29tL2NybHNzbi9nZXRzdHJvbmdlci9zZXJ2ZXIvZ2VuL3Byb3RvL2FwaS92MTthcGl2MaICA0FYWKoCBkFwaS5WMcoCBkFwaVxWMeICEkFwaVxWMVxHUEJNZXRhZGF0YeoCB0FwaTo6VjFiBnByb3RvMw", [file_api_v1_options, file_api_v1_shared, file_buf_validate_validate]);
+  fileDesc("ChxhcGkvdjEvcm91dGluZV9zZXJ2aWNlLnByb3RvEgZhcGkudjEiTQoUQ3JlYXRlUm91dGluZVJlcXVlc3QSFQoEbmFtZRgBIAEoCUIHukgEcgIQARIeCgxleGVyY2lzZV9pZHMYAiADKAlCCLpIBZIBAggBIiMKFUNyZWF0ZVJvdXRpbmVSZXNwb25zZRIKCgJpZBgBIAEoCSI+ChFHZXRSb3V0aW5lUmVxdWVzdBIUCgJpZBgBIAEoCUIIukgFcgOwAQESEwoLc2hhcmVfdG9rZW4YAiABKAkiNgoSR2V0Um91dGluZVJlc3BvbnNlEiAKB3JvdXRpbmUYASABKAsyDy5hcGkudjEuUm91dGluZSJAChRVcGRhdGVSb3V0aW5lUmVxdWVzdBIoCgdyb3V0aW5lGAEgASgLMg8uYXBpLnYxLlJvdXRpbmVCBrpIA8gBASI5ChVVcGRhdGVSb3V0aW5lUmVzcG9uc2USIAoHcm91dGluZRgBIAEoCzIPLmFwaS52MS5Sb3V0aW5lIiwKFERlbGV0ZVJvdXRpbmVSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASIXChVEZWxldGVSb3V0aW5lUmVzcG9uc2UiWgoTTGlzdFJvdXRpbmVzUmVxdWVzdBIMCgRuYW1lGAEgASgJEjUKCnBhZ2luYXRpb24YAiABKAsyGS5hcGkudjEuUGFnaW5hdGlvblJlcXVlc3RCBrpIA8gBASJpChRMaXN0Um91dGluZXNSZXNwb25zZRIhCghyb3V0aW5lcxgBIAMoCzIPLmFwaS52MS5Sb3V0aW5lEi4KCnBhZ2luYXRpb24YAiABKAsyGi5hcGkudjEuUGFnaW5hdGlvblJlc3BvbnNlIlEKEkFkZEV4ZXJjaXNlUmVxdWVzdBIcCgpyb3V0aW5lX2lkGAEgASgJQgi6SAVyA7ABARIdCgtleGVyY2lzZV9pZBgCIAEoCUIIukgFcgOwAQEiFQoTQWRkRXhlcmNpc2VSZXNwb25zZSJUChVSZW1vdmVFeGVyY2lzZVJlcXVlc3QSHAoKcm91dGluZV9pZBgBIAEoCUIIukgFcgOwAQESHQoLZXhlcmNpc2VfaWQYAiABKAlCCLpIBXIDsAEBIhgKFlJlbW92ZUV4ZXJjaXNlUmVzcG9uc2UiWgoaVXBkYXRlRXhlcmNpc2VPcmRlclJlcXVlc3QSHAoKcm91dGluZV9pZBgBIAEoCUIIukgFcgOwAQESHgoMZXhlcmNpc2VfaWRzGAIgAygJQgi6SAWSAQIIASIdChtVcGRhdGVFeGVyY2lzZU9yZGVyUmVzcG9uc2UibwofQ3JlYXRlUm91dGluZUZyb21Xb3Jrb3V0UmVxdWVzdBIcCgp3b3Jrb3V0X2lkGAEgASgJQgi6SAVyA7ABARIVCgRuYW1lGAIgASgJQge6SARyAhABEhcKD2luY2x1ZGVfdGFyZ2V0cxgDIAEoCCIuCiBDcmVhdGVSb3V0aW5lRnJvbVdvcmtvdXRSZXNwb25zZRIKCgJpZBgBIAEoCSJuChNTaGFyZVJvdXRpbmVSZXF1ZXN0EhwKCnJvdXRpbmVfaWQYASABKAlCCLpIBXIDsAEBEjkKCnZpc2liaWxpdHkYAiABKA4yGS5hcGkudjEuUm91dGluZVZpc2liaWxpdHlCCrpIB4IBBBABIAAiOAoUU2hhcmVSb3V0aW5lUmVzcG9uc2USIAoHcm91dGluZRgBIAEoCzIPLmFwaS52MS5Sb3V0aW5lIlYKE0Nsb25lUm91dGluZVJlcXVlc3QSHAoKcm91dGluZV9pZBgBIAEoCUIIukgFcgOwAQESEwoLc2hhcmVfdG9rZW4YAiABKAkSDAoEbmFtZRgDIAEoCSIiChRDbG9uZVJvdXRpbmVSZXNwb25zZRIKCgJpZBgBIAEoCSJTChpMaXN0RGVsZXRlZFJvdXRpbmVzUmVxdWVzdBI1CgpwYWdpbmF0aW9uGAEgASgLMhkuYXBpLnYxLlBhZ2luYXRpb25SZXF1ZXN0Qga6SAPIAQEicAobTGlzdERlbGV0ZWRSb3V0aW5lc1Jlc3BvbnNlEiEKCHJvdXRpbmVzGAEgAygLMg8uYXBpLnYxLlJvdXRpbmUSLgoKcGFnaW5hdGlvbhgCIAEoCzIaLmFwaS52MS5QYWdpbmF0aW9uUmVzcG9uc2UiLQoVUmVzdG9yZVJvdXRpbmVSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASIYChZSZXN0b3JlUm91dGluZVJlc3BvbnNlIqECCgdSb3V0aW5lEhQKAmlkGAEgASgJQgi6SAVyA7ABARIVCgRuYW1lGAIgASgJQge6SARyAhABEi0KCWV4ZXJjaXNlcxgDIAMoCzIQLmFwaS52MS5FeGVyY2lzZUIIukgFkgECCAESMAoQZXhlcmNpc2VfdGFyZ2V0cxgEIAMoCzIWLmFwaS52MS5FeGVyY2lzZVRhcmdldBIPCgd1c2VyX2lkGAUgASgJEi0KCnZpc2liaWxpdHkYBiABKA4yGS5hcGkudjEuUm91dGluZVZpc2liaWxpdHkSEwoLc2hhcmVfdG9rZW4YByABKAkSHgoWY2xvbmVkX2Zyb21fcm91dGluZV9pZBgIIAEoCRITCgtjbG9uZV9jb3VudBgJIAEoBSJQCg5FeGVyY2lzZVRhcmdldBIdCgtleGVyY2lzZV9pZBgBIAEoCUIIukgFcgOwAQESHwoEc2V0cxgCIAMoCzIRLmFwaS52MS5UYXJnZXRTZXQiKQoJVGFyZ2V0U2V0Eg4KBndlaWdodBgBIAEoARIMCgRyZXBzGAIgASgFKpYBChFSb3V0aW5lVmlzaWJpbGl0eRIiCh5ST1VUSU5FX1ZJU0lCSUxJVFlfVU5TUEVDSUZJRUQQABIeChpST1VUSU5FX1ZJU0lCSUxJVFlfUFJJVkFURRABEiAKHFJPVVRJTkVfVklTSUJJTElUWV9GT0xMT1dFUlMQAhIbChdST1VUSU5FX1ZJU0lCSUxJVFlfTElOSxADMocJCg5Sb3V0aW5lU2VydmljZRJSCg1DcmVhdGVSb3V0aW5lEhwuYXBpLnYxLkNyZWF0ZVJvdXRpbmVSZXF1ZXN0Gh0uYXBpLnYxLkNyZWF0ZVJvdXRpbmVSZXNwb25zZSIEiLUYARJJCgpHZXRSb3V0aW5lEhkuYXBpLnYxLkdldFJvdXRpbmVSZXF1ZXN0GhouYXBpLnYxLkdldFJvdXRpbmVSZXNwb25zZSIEiLUYARJSCg1VcGRhdGVSb3V0aW5lEhwuYXBpLnYxLlVwZGF0ZVJvdXRpbmVSZXF1ZXN0Gh0uYXBpLnYxLlVwZGF0ZVJvdXRpbmVSZXNwb25zZSIEiLUYARJSCg1EZWxldGVSb3V0aW5lEhwuYXBpLnYxLkRlbGV0ZVJvdXRpbmVSZXF1ZXN0Gh0uYXBpLnYxLkRlbGV0ZVJvdXRpbmVSZXNwb25zZSIEiLUYARJPCgxMaXN0Um91dGluZXMSGy5hcGkudjEuTGlzdFJvdXRpbmVzUmVxdWVzdBocLmFwaS52MS5MaXN0Um91dGluZXNSZXNwb25zZSIEiLUYARJMCgtBZGRFeGVyY2lzZRIaLmFwaS52MS5BZGRFeGVyY2lzZVJlcXVlc3QaGy5hcGkudjEuQWRkRXhlcmNpc2VSZXNwb25zZSIEiLUYARJVCg5SZW1vdmVFeGVyY2lzZRIdLmFwaS52MS5SZW1vdmVFeGVyY2lzZVJlcXVlc3QaHi5hcGkudjEuUmVtb3ZlRXhlcmNpc2VSZXNwb25zZSIEiLUYARJkChNVcGRhdGVFeGVyY2lzZU9yZGVyEiIuYXBpLnYxLlVwZGF0ZUV4ZXJjaXNlT3JkZXJSZXF1ZXN0GiMuYXBpLnYxLlVwZGF0ZUV4ZXJjaXNlT3JkZXJSZXNwb25zZSIEiLUYARJzChhDcmVhdGVSb3V0aW5lRnJvbVdvcmtvdXQSJy5hcGkudjEuQ3JlYXRlUm91dGluZUZyb21Xb3Jrb3V0UmVxdWVzdBooLmFwaS52MS5DcmVhdGVSb3V0aW5lRnJvbVdvcmtvdXRSZXNwb25zZSIEiLUYARJPCgxTaGFyZVJvdXRpbmUSGy5hcGkudjEuU2hhcmVSb3V0aW5lUmVxdWVzdBocLmFwaS52MS5TaGFyZVJvdXRpbmVSZXNwb25zZSIEiLUYARJPCgxDbG9uZVJvdXRpbmUSGy5hcGkudjEuQ2xvbmVSb3V0aW5lUmVxdWVzdBocLmFwaS52MS5DbG9uZVJvdXRpbmVSZXNwb25zZSIEiLUYARJkChNMaXN0RGVsZXRlZFJvdXRpbmVzEiIuYXBpLnYxLkxpc3REZWxldGVkUm91dGluZXNSZXF1ZXN0GiMuYXBpLnYxLkxpc3REZWxldGVkUm91dGluZXNSZXNwb25zZSIEiLUYARJVCg5SZXN0b3JlUm91dGluZRIdLmFwaS52MS5SZXN0b3JlUm91dGluZVJlcXVlc3QaHi5hcGkudjEuUmVzdG9yZVJvdXRpbmVSZXNwb25zZSIEiLUYAUKXAQoKY29tLmFwaS52MUITUm91dGluZVNlcnZpY2VQcm90b1ABWjtnaXRodWIuY29tL2NybHNzbi9nZXRzdHJvbmdlci9zZXJ2ZXIvZ2VuL3Byb3RvL2FwaS92MTthcGl2MaICA0FYWKoCBkFwaS5WMcoCBkFwaVxWMeICEkFwaVxWMVxHUEJNZXRhZGF0YeoCB0FwaTo6VjFiBnByb3RvMw", [file_api_v1_options, file_api_v1_shared, file_buf_validate_validate]);
 
 /**
  * @generated from message api.v1.CreateRoutineRequest
@@ -63,6 +63,13 @@ export type GetRoutineRequest = Message<"api.v1.GetRoutineRequest"> & {
    * @generated from field: string id = 1;
    */
   id: string;
+
+  /**
+   * The share token is required to read another user's routine shared by link.
+   *
+   * @generated from field: string share_token = 2;
+   */
+  shareToken: string;
 };
 
 /**
@@ -302,6 +309,204 @@ export type UpdateExerciseOrderResponse = Message<"api.v1.UpdateExerciseOrderRes
 export const UpdateExerciseOrderResponseSchema: GenMessage<UpdateExerciseOrderResponse> = /*@__PURE__*/
   messageDesc(file_api_v1_routine_service, 15);
 
+/**
+ * @generated from message api.v1.CreateRoutineFromWorkoutRequest
+ */
+export type CreateRoutineFromWorkoutRequest = Message<"api.v1.CreateRoutineFromWorkoutRequest"> & {
+  /**
+   * @generated from field: string workout_id = 1;
+   */
+  workoutId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool include_targets = 3;
+   */
+  includeTargets: boolean;
+};
+
+/**
+ * Describes the message api.v1.CreateRoutineFromWorkoutRequest.
+ * Use `create(CreateRoutineFromWorkoutRequestSchema)` to create a new message.
+ */
+export const CreateRoutineFromWorkoutRequestSchema: GenMessage<CreateRoutineFromWorkoutRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_routine_service, 16);
+
+/**
+ * @generated from message api.v1.CreateRoutineFromWorkoutResponse
+ */
+export type CreateRoutineFromWorkoutResponse = Message<"api.v1.CreateRoutineFromWorkoutResponse"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message api.v1.CreateRoutineFromWorkoutResponse.
+ * Use `create(CreateRoutineFromWorkoutResponseSchema)` to create a new message.
+ */
+export const CreateRoutineFromWorkoutResponseSchema: GenMessage<CreateRoutineFromWorkoutResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_routine_service, 17);
+
+/**
+ * @generated from message api.v1.ShareRoutineRequest
+ */
+export type ShareRoutineRequest = Message<"api.v1.ShareRoutineRequest"> & {
+  /**
+   * @generated from field: string routine_id = 1;
+   */
+  routineId: string;
+
+  /**
+   * @generated from field: api.v1.RoutineVisibility visibility = 2;
+   */
+  visibility: RoutineVisibility;
+};
+
+/**
+ * Describes the message api.v1.ShareRoutineRequest.
+ * Use `create(ShareRoutineRequestSchema)` to create a new message.
+ */
+export const ShareRoutineRequestSchema: GenMessage<ShareRoutineRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_routine_service, 18);
+
+/**
+ * @generated from message api.v1.ShareRoutineResponse
+ */
+export type ShareRoutineResponse = Message<"api.v1.ShareRoutineResponse"> & {
+  /**
+   * @generated from field: api.v1.Routine routine = 1;
+   */
+  routine?: Routine;
+};
+
+/**
+ * Describes the message api.v1.ShareRoutineResponse.
+ * Use `create(ShareRoutineResponseSchema)` to create a new message.
+ */
+export const ShareRoutineResponseSchema: GenMessage<ShareRoutineResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_routine_service, 19);
+
+/**
+ * @generated from message api.v1.CloneRoutineRequest
+ */
+export type CloneRoutineRequest = Message<"api.v1.CloneRoutineRequest"> & {
+  /**
+   * @generated from field: string routine_id = 1;
+   */
+  routineId: string;
+
+  /**
+   * @generated from field: string share_token = 2;
+   */
+  shareToken: string;
+
+  /**
+   * The name defaults to the name of the cloned routine.
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message api.v1.CloneRoutineRequest.
+ * Use `create(CloneRoutineRequestSchema)` to create a new message.
+ */
+export const CloneRoutineRequestSchema: GenMessage<CloneRoutineRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_routine_service, 20);
+
+/**
+ * @generated from message api.v1.CloneRoutineResponse
+ */
+export type CloneRoutineResponse = Message<"api.v1.CloneRoutineResponse"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message api.v1.CloneRoutineResponse.
+ * Use `create(CloneRoutineResponseSchema)` to create a new message.
+ */
+export const CloneRoutineResponseSchema: GenMessage<CloneRoutineResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_routine_service, 21);
+
+/**
+ * @generated from message api.v1.ListDeletedRoutinesRequest
+ */
+export type ListDeletedRoutinesRequest = Message<"api.v1.ListDeletedRoutinesRequest"> & {
+  /**
+   * @generated from field: api.v1.PaginationRequest pagination = 1;
+   */
+  pagination?: PaginationRequest;
+};
+
+/**
+ * Describes the message api.v1.ListDeletedRoutinesRequest.
+ * Use `create(ListDeletedRoutinesRequestSchema)` to create a new message.
+ */
+export const ListDeletedRoutinesRequestSchema: GenMessage<ListDeletedRoutinesRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_routine_service, 22);
+
+/**
+ * @generated from message api.v1.ListDeletedRoutinesResponse
+ */
+export type ListDeletedRoutinesResponse = Message<"api.v1.ListDeletedRoutinesResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Routine routines = 1;
+   */
+  routines: Routine[];
+
+  /**
+   * @generated from field: api.v1.PaginationResponse pagination = 2;
+   */
+  pagination?: PaginationResponse;
+};
+
+/**
+ * Describes the message api.v1.ListDeletedRoutinesResponse.
+ * Use `create(ListDeletedRoutinesResponseSchema)` to create a new message.
+ */
+export const ListDeletedRoutinesResponseSchema: GenMessage<ListDeletedRoutinesResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_routine_service, 23);
+
+/**
+ * @generated from message api.v1.RestoreRoutineRequest
+ */
+export type RestoreRoutineRequest = Message<"api.v1.RestoreRoutineRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message api.v1.RestoreRoutineRequest.
+ * Use `create(RestoreRoutineRequestSchema)` to create a new message.
+ */
+export const RestoreRoutineRequestSchema: GenMessage<RestoreRoutineRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_routine_service, 24);
+
+/**
+ * @generated from message api.v1.RestoreRoutineResponse
+ */
+export type RestoreRoutineResponse = Message<"api.v1.RestoreRoutineResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RestoreRoutineResponse.
+ * Use `create(RestoreRoutineResponseSchema)` to create a new message.
+ */
+export const RestoreRoutineResponseSchema: GenMessage<RestoreRoutineResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_routine_service, 25);
+
 /**
  * @generated from message api.v1.Routine
  */
@@ -320,6 +525,38 @@ export type Routine = Message<"api.v1.Routine"> & {
    * @generated from field: repeated api.v1.Exercise exercises = 3;
    */
   exercises: Exercise[];
+
+  /**
+   * @generated from field: repeated api.v1.ExerciseTarget exercise_targets = 4;
+   */
+  exerciseTargets: ExerciseTarget[];
+
+  /**
+   * @generated from field: string user_id = 5;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: api.v1.RoutineVisibility visibility = 6;
+   */
+  visibility: RoutineVisibility;
+
+  /**
+   * The share token is only returned to the owner of the routine.
+   *
+   * @generated from field: string share_token = 7;
+   */
+  shareToken: string;
+
+  /**
+   * @generated from field: string cloned_from_routine_id = 8;
+   */
+  clonedFromRoutineId: string;
+
+  /**
+   * @generated from field: int32 clone_count = 9;
+   */
+  cloneCount: number;
 };
 
 /**
@@ -327,7 +564,82 @@ export type Routine = Message<"api.v1.Routine"> & {
  * Use `create(RoutineSchema)` to create a new message.
  */
 export const RoutineSchema: GenMessage<Routine> = /*@__PURE__*/
-  messageDesc(file_api_v1_routine_service, 16);
+  messageDesc(file_api_v1_routine_service, 26);
+
+/**
+ * @generated from message api.v1.ExerciseTarget
+ */
+export type ExerciseTarget = Message<"api.v1.ExerciseTarget"> & {
+  /**
+   * @generated from field: string exercise_id = 1;
+   */
+  exerciseId: string;
+
+  /**
+   * @generated from field: repeated api.v1.TargetSet sets = 2;
+   */
+  sets: TargetSet[];
+};
+
+/**
+ * Describes the message api.v1.ExerciseTarget.
+ * Use `create(ExerciseTargetSchema)` to create a new message.
+ */
+export const ExerciseTargetSchema: GenMessage<ExerciseTarget> = /*@__PURE__*/
+  messageDesc(file_api_v1_routine_service, 27);
+
+/**
+ * @generated from message api.v1.TargetSet
+ */
+export type TargetSet = Message<"api.v1.TargetSet"> & {
+  /**
+   * @generated from field: double weight = 1;
+   */
+  weight: number;
+
+  /**
+   * @generated from field: int32 reps = 2;
+   */
+  reps: number;
+};
+
+/**
+ * Describes the message api.v1.TargetSet.
+ * Use `create(TargetSetSchema)` to create a new message.
+ */
+export const TargetSetSchema: GenMessage<TargetSet> = /*@__PURE__*/
+  messageDesc(file_api_v1_routine_service, 28);
+
+/**
+ * @generated from enum api.v1.RoutineVisibility
+ */
+export enum RoutineVisibility {
+  /**
+   * @generated from enum value: ROUTINE_VISIBILITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ROUTINE_VISIBILITY_PRIVATE = 1;
+   */
+  PRIVATE = 1,
+
+  /**
+   * @generated from enum value: ROUTINE_VISIBILITY_FOLLOWERS = 2;
+   */
+  FOLLOWERS = 2,
+
+  /**
+   * @generated from enum value: ROUTINE_VISIBILITY_LINK = 3;
+   */
+  LINK = 3,
+}
+
+/**
+ * Describes the enum api.v1.RoutineVisibility.
+ */
+export const RoutineVisibilitySchema: GenEnum<RoutineVisibility> = /*@__PURE__*/
+  enumDesc(file_api_v1_routine_service, 0);
 
 /**
  * @generated from service api.v1.RoutineService
@@ -397,6 +709,46 @@ export const RoutineService: GenService<{
     input: typeof UpdateExerciseOrderRequestSchema;
     output: typeof UpdateExerciseOrderResponseSchema;
   },
+  /**
+   * @generated from rpc api.v1.RoutineService.CreateRoutineFromWorkout
+   */
+  createRoutineFromWorkout: {
+    methodKind: "unary";
+    input: typeof CreateRoutineFromWorkoutRequestSchema;
+    output: typeof CreateRoutineFromWorkoutResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.RoutineService.ShareRoutine
+   */
+  shareRoutine: {
+    methodKind: "unary";
+    input: typeof ShareRoutineRequestSchema;
+    output: typeof ShareRoutineResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.RoutineService.CloneRoutine
+   */
+  cloneRoutine: {
+    methodKind: "unary";
+    input: typeof CloneRoutineRequestSchema;
+    output: typeof CloneRoutineResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.RoutineService.ListDeletedRoutines
+   */
+  listDeletedRoutines: {
+    methodKind: "unary";
+    input: typeof ListDeletedRoutinesRequestSchema;
+    output: typeof ListDeletedRoutinesResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.RoutineService.RestoreRoutine
+   */
+  restoreRoutine: {
+    methodKind: "unary";
+    input: typeof RestoreRoutineRequestSchema;
+    output: typeof RestoreRoutineResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_routine_service, 0);
 
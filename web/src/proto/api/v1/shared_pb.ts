@@ -2,8 +2,8 @@
 // @generated from file api/v1/shared.proto (package api.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv1";
-import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv1";
+import type { GenEnum, GenFile, GenMessage } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv1";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
@@ -13,7 +13,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/shared.proto.
  */
 export const file_api_v1_shared: GenFile = /*@__PURE__*/
-  fileDesc("ChNhcGkvdjEvc2hhcmVkLnByb3RvEgZhcGkudjEiWwoLRXhlcmNpc2VTZXQSKgoIZXhlcmNpc2UYASABKAsyEC5hcGkudjEuRXhlcmNpc2VCBrpIA8gBARIgCgNzZXQYAiABKAsyCy5hcGkudjEuU2V0Qga6SAPIAQEiXwoMRXhlcmNpc2VTZXRzEioKCGV4ZXJjaXNlGAEgASgLMhAuYXBpLnYxLkV4ZXJjaXNlQga6SAPIAQESIwoEc2V0cxgCIAMoCzILLmFwaS52MS5TZXRCCLpIBZIBAggBIk4KCEV4ZXJjaXNlEhQKAmlkGAEgASgJQgi6SAVyA7ABARIPCgd1c2VyX2lkGAIgASgJEgwKBG5hbWUYAyABKAkSDQoFbGFiZWwYBCABKAkiXwoDU2V0EgoKAmlkGAEgASgJEg4KBndlaWdodBgCIAEoARIVCgRyZXBzGAMgASgFQge6SAQaAigBEiUKCG1ldGFkYXRhGAQgASgLMhMuYXBpLnYxLk1ldGFkYXRhU2V0InIKC01ldGFkYXRhU2V0EhwKCndvcmtvdXRfaWQYASABKAlCCLpIBXIDsAEBEi4KCmNyZWF0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDXBlcnNvbmFsX2Jlc3QYAyABKAgidgoEVXNlchIUCgJpZBgBIAEoCUIIukgFcgOwAQESGwoKZmlyc3RfbmFtZRgCIAEoCUIHukgEcgIQARIaCglsYXN0X25hbWUYAyABKAlCB7pIBHICEAESDQoFZW1haWwYBCABKAkSEAoIZm9sbG93ZWQYBSABKAgiRgoRUGFnaW5hdGlvblJlcXVlc3QSHQoKcGFnZV9saW1pdBgBIAEoBUIJukgGGgQYZCgBEhIKCnBhZ2VfdG9rZW4YAiABKAwiLQoSUGFnaW5hdGlvblJlc3BvbnNlEhcKD25leHRfcGFnZV90b2tlbhgBIAEoDEKPAQoKY29tLmFwaS52MUILU2hhcmVkUHJvdG9QAVo7Z2l0aHViLmNvbS9jcmxzc24vZ2V0c3Ryb25nZXIvc2VydmVyL2dlbi9wcm90by9hcGkvdjE7YXBpdjGiAgNBWFiqAgZBcGkuVjHKAgZBcGlcVjHiAhJBcGlcVjFcR1BCTWV0YWRhdGHqAgdBcGk6OlYxYgZwcm90bzM", [file_buf_validate_validate, file_google_protobuf_timestamp]);
+  fileDesc("ChNhcGkvdjEvc2hhcmVkLnByb3RvEgZhcGkudjEiWwoLRXhlcmNpc2VTZXQSKgoIZXhlcmNpc2UYASABKAsyEC5hcGkudjEuRXhlcmNpc2VCBrpIA8gBARIgCgNzZXQYAiABKAsyCy5hcGkudjEuU2V0Qga6SAPIAQEiXwoMRXhlcmNpc2VTZXRzEioKCGV4ZXJjaXNlGAEgASgLMhAuYXBpLnYxLkV4ZXJjaXNlQga6SAPIAQESIwoEc2V0cxgCIAMoCzILLmFwaS52MS5TZXRCCLpIBZIBAggBIoEBCghFeGVyY2lzZRIUCgJpZBgBIAEoCUIIukgFcgOwAQESDwoHdXNlcl9pZBgCIAEoCRIMCgRuYW1lGAMgASgJEg0KBWxhYmVsGAQgASgJEjEKEGNhdGFsb2dfZXhlcmNpc2UYBSABKA4yFy5hcGkudjEuQ2F0YWxvZ0V4ZXJjaXNlIl8KA1NldBIKCgJpZBgBIAEoCRIOCgZ3ZWlnaHQYAiABKAESFQoEcmVwcxgDIAEoBUIHukgEGgIoARIlCghtZXRhZGF0YRgEIAEoCzITLmFwaS52MS5NZXRhZGF0YVNldCJyCgtNZXRhZGF0YVNldBIcCgp3b3Jrb3V0X2lkGAEgASgJQgi6SAVyA7ABARIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIVCg1wZXJzb25hbF9iZXN0GAMgASgIIrIBCgRVc2VyEhQKAmlkGAEgASgJQgi6SAVyA7ABARIbCgpmaXJzdF9uYW1lGAIgASgJQge6SARyAhABEhoKCWxhc3RfbmFtZRgDIAEoCUIHukgEcgIQARINCgVlbWFpbBgEIAEoCRIQCghmb2xsb3dlZBgFIAEoCBIPCgdwcml2YXRlGAYgASgIEhgKEGZvbGxvd19yZXF1ZXN0ZWQYByABKAgSDwoHYmxvY2tlZBgIIAEoCCJGChFQYWdpbmF0aW9uUmVxdWVzdBIdCgpwYWdlX2xpbWl0GAEgASgFQgm6SAYaBBhkKAESEgoKcGFnZV90b2tlbhgCIAEoDCItChJQYWdpbmF0aW9uUmVzcG9uc2USFwoPbmV4dF9wYWdlX3Rva2VuGAEgASgMKpABCg9DYXRhbG9nRXhlcmNpc2USIAocQ0FUQUxPR19FWEVSQ0lTRV9VTlNQRUNJRklFRBAAEhoKFkNBVEFMT0dfRVhFUkNJU0VfU1FVQVQQARIgChxDQVRBTE9HX0VYRVJDSVNFX0JFTkNIX1BSRVNTEAISHQoZQ0FUQUxPR19FWEVSQ0lTRV9ERUFETElGVBADKpgBChFXb3Jrb3V0VmlzaWJpbGl0eRIiCh5XT1JLT1VUX1ZJU0lCSUxJVFlfVU5TUEVDSUZJRUQQABIdChlXT1JLT1VUX1ZJU0lCSUxJVFlfUFVCTElDEAESIAocV09SS09VVF9WSVNJQklMSVRZX0ZPTExPV0VSUxACEh4KGldPUktPVVRfVklTSUJJTElUWV9QUklWQVRFEANCjwEKCmNvbS5hcGkudjFCC1NoYXJlZFByb3RvUAFaO2dpdGh1Yi5jb20vY3Jsc3NuL2dldHN0cm9uZ2VyL3NlcnZlci9nZW4vcHJvdG8vYXBpL3YxO2FwaXYxogIDQVhYqgIGQXBpLlYxygIGQXBpXFYx4gISQXBpXFYxXEdQQk1ldGFkYXRh6gIHQXBpOjpWMWIGcHJvdG8z", [file_buf_validate_validate, file_google_protobuf_timestamp]);
 
 /**
  * @generated from message api.v1.ExerciseSet
@@ -82,6 +82,11 @@ export type Exercise = Message<"api.v1.Exercise"> & {
    * @generated from field: string label = 4;
    */
   label: string;
+
+  /**
+   * @generated from field: api.v1.CatalogExercise catalog_exercise = 5;
+   */
+  catalogExercise: CatalogExercise;
 };
 
 /**
@@ -180,6 +185,25 @@ export type User = Message<"api.v1.User"> & {
    * @generated from field: bool followed = 5;
    */
   followed: boolean;
+
+  /**
+   * @generated from field: bool private = 6;
+   */
+  private: boolean;
+
+  /**
+   * Set when the viewer has requested to follow the private user.
+   *
+   * @generated from field: bool follow_requested = 7;
+   */
+  followRequested: boolean;
+
+  /**
+   * Set when the viewer has blocked the user.
+   *
+   * @generated from field: bool blocked = 8;
+   */
+  blocked: boolean;
 };
 
 /**
@@ -228,3 +252,65 @@ export type PaginationResponse = Message<"api.v1.PaginationResponse"> & {
 export const PaginationResponseSchema: GenMessage<PaginationResponse> = /*@__PURE__*/
   messageDesc(file_api_v1_shared, 7);
 
+/**
+ * @generated from enum api.v1.CatalogExercise
+ */
+export enum CatalogExercise {
+  /**
+   * @generated from enum value: CATALOG_EXERCISE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: CATALOG_EXERCISE_SQUAT = 1;
+   */
+  SQUAT = 1,
+
+  /**
+   * @generated from enum value: CATALOG_EXERCISE_BENCH_PRESS = 2;
+   */
+  BENCH_PRESS = 2,
+
+  /**
+   * @generated from enum value: CATALOG_EXERCISE_DEADLIFT = 3;
+   */
+  DEADLIFT = 3,
+}
+
+/**
+ * Describes the enum api.v1.CatalogExercise.
+ */
+export const CatalogExerciseSchema: GenEnum<CatalogExercise> = /*@__PURE__*/
+  enumDesc(file_api_v1_shared, 0);
+
+/**
+ * @generated from enum api.v1.WorkoutVisibility
+ */
+export enum WorkoutVisibility {
+  /**
+   * @generated from enum value: WORKOUT_VISIBILITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: WORKOUT_VISIBILITY_PUBLIC = 1;
+   */
+  PUBLIC = 1,
+
+  /**
+   * @generated from enum value: WORKOUT_VISIBILITY_FOLLOWERS = 2;
+   */
+  FOLLOWERS = 2,
+
+  /**
+   * @generated from enum value: WORKOUT_VISIBILITY_PRIVATE = 3;
+   */
+  PRIVATE = 3,
+}
+
+/**
+ * Describes the enum api.v1.WorkoutVisibility.
+ */
+export const WorkoutVisibilitySchema: GenEnum<WorkoutVisibility> = /*@__PURE__*/
+  enumDesc(file_api_v1_shared, 1);
+
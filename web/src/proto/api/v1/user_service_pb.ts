@@ -2,11 +2,13 @@
 // @generated from file api/v1/user_service.proto (package api.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
 import { file_api_v1_options } from "./options_pb";
-import type { PaginationRequest, PaginationResponse, User } from "./shared_pb";
+import type { ExerciseSet, PaginationRequest, PaginationResponse, User, WorkoutVisibility } from "./shared_pb";
 import { file_api_v1_shared } from "./shared_pb";
+import type { FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_field_mask, file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
 import { file_buf_validate_validate } from "../../buf/validate/validate_pb";
 import type { Message } from "@bufbuild/protobuf";
 
@@ -14,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/user_service.proto.
  */
 export const file_api_v1_user_service: GenFile = /*@__PURE__*/
-  fileDesc("ChlhcGkvdjEvdXNlcl9zZXJ2aWNlLnByb3RvEgZhcGkudjEiJgoOR2V0VXNlclJlcXVlc3QSFAoCaWQYASABKAlCCLpIBXIDsAEBIi0KD0dldFVzZXJSZXNwb25zZRIaCgR1c2VyGAEgASgLMgwuYXBpLnYxLlVzZXIiMAoRRm9sbG93VXNlclJlcXVlc3QSGwoJZm9sbG93X2lkGAEgASgJQgi6SAVyA7ABASIUChJGb2xsb3dVc2VyUmVzcG9uc2UiNAoTVW5mb2xsb3dVc2VyUmVxdWVzdBIdCgt1bmZvbGxvd19pZBgBIAEoCUIIukgFcgOwAQEiFgoUVW5mb2xsb3dVc2VyUmVzcG9uc2UiNQoUTGlzdEZvbGxvd2Vyc1JlcXVlc3QSHQoLZm9sbG93ZXJfaWQYASABKAlCCLpIBXIDsAEBIjgKFUxpc3RGb2xsb3dlcnNSZXNwb25zZRIfCglmb2xsb3dlcnMYASADKAsyDC5hcGkudjEuVXNlciI1ChRMaXN0Rm9sbG93ZWVzUmVxdWVzdBIdCgtmb2xsb3dlZV9pZBgBIAEoCUIIukgFcgOwAQEiOAoVTGlzdEZvbGxvd2Vlc1Jlc3BvbnNlEh8KCWZvbGxvd2VlcxgBIAMoCzIMLmFwaS52MS5Vc2VyImMKElNlYXJjaFVzZXJzUmVxdWVzdBIWCgVxdWVyeRgBIAEoCUIHukgEcgIQAxI1CgpwYWdpbmF0aW9uGAIgASgLMhkuYXBpLnYxLlBhZ2luYXRpb25SZXF1ZXN0Qga6SAPIAQEiYgoTU2VhcmNoVXNlcnNSZXNwb25zZRIbCgV1c2VycxgBIAMoCzIMLmFwaS52MS5Vc2VyEi4KCnBhZ2luYXRpb24YAiABKAsyGi5hcGkudjEuUGFnaW5hdGlvblJlc3BvbnNlMuEDCgtVc2VyU2VydmljZRJACgdHZXRVc2VyEhYuYXBpLnYxLkdldFVzZXJSZXF1ZXN0GhcuYXBpLnYxLkdldFVzZXJSZXNwb25zZSIEiLUYARJJCgpGb2xsb3dVc2VyEhkuYXBpLnYxLkZvbGxvd1VzZXJSZXF1ZXN0GhouYXBpLnYxLkZvbGxvd1VzZXJSZXNwb25zZSIEiLUYARJPCgxVbmZvbGxvd1VzZXISGy5hcGkudjEuVW5mb2xsb3dVc2VyUmVxdWVzdBocLmFwaS52MS5VbmZvbGxvd1VzZXJSZXNwb25zZSIEiLUYARJSCg1MaXN0Rm9sbG93ZXJzEhwuYXBpLnYxLkxpc3RGb2xsb3dlcnNSZXF1ZXN0Gh0uYXBpLnYxLkxpc3RGb2xsb3dlcnNSZXNwb25zZSIEiLUYARJSCg1MaXN0Rm9sbG93ZWVzEhwuYXBpLnYxLkxpc3RGb2xsb3dlZXNSZXF1ZXN0Gh0uYXBpLnYxLkxpc3RGb2xsb3dlZXNSZXNwb25zZSIEiLUYARJMCgtTZWFyY2hVc2VycxIaLmFwaS52MS5TZWFyY2hVc2Vyc1JlcXVlc3QaGy5hcGkudjEuU2VhcmNoVXNlcnNSZXNwb25zZSIEiLUYAUKUAQoKY29tLmFwaS52MUIQVXNlclNlcnZpY2VQcm90b1ABWjtnaXRodWIuY29tL2NybHNzbi9nZXRzdHJvbmdlci9zZXJ2ZXIvZ2VuL3Byb3RvL2FwaS92MTthcGl2MaICA0FYWKoCBkFwaS5WMcoCBkFwaVxWMeICEkFwaVxWMVxHUEJNZXRhZGF0YeoCB0FwaTo6VjFiBnByb3RvMw", [file_api_v1_options, file_api_v1_shared, file_buf_validate_validate]);
+  fileDesc("ChlhcGkvdjEvdXNlcl9zZXJ2aWNlLnByb3RvEgZhcGkudjEiJgoOR2V0VXNlclJlcXVlc3QSFAoCaWQYASABKAlCCLpIBXIDsAEBIi0KD0dldFVzZXJSZXNwb25zZRIaCgR1c2VyGAEgASgLMgwuYXBpLnYxLlVzZXIiMAoRRm9sbG93VXNlclJlcXVlc3QSGwoJZm9sbG93X2lkGAEgASgJQgi6SAVyA7ABASInChJGb2xsb3dVc2VyUmVzcG9uc2USEQoJcmVxdWVzdGVkGAEgASgIIjQKE1VuZm9sbG93VXNlclJlcXVlc3QSHQoLdW5mb2xsb3dfaWQYASABKAlCCLpIBXIDsAEBIhYKFFVuZm9sbG93VXNlclJlc3BvbnNlIhsKGUxpc3RGb2xsb3dSZXF1ZXN0c1JlcXVlc3QiRQoaTGlzdEZvbGxvd1JlcXVlc3RzUmVzcG9uc2USJwoIcmVxdWVzdHMYASADKAsyFS5hcGkudjEuRm9sbG93UmVxdWVzdCI8ChtBcHByb3ZlRm9sbG93UmVxdWVzdFJlcXVlc3QSHQoLZm9sbG93ZXJfaWQYASABKAlCCLpIBXIDsAEBIh4KHEFwcHJvdmVGb2xsb3dSZXF1ZXN0UmVzcG9uc2UiOwoaUmVqZWN0Rm9sbG93UmVxdWVzdFJlcXVlc3QSHQoLZm9sbG93ZXJfaWQYASABKAlCCLpIBXIDsAEBIh0KG1JlamVjdEZvbGxvd1JlcXVlc3RSZXNwb25zZSItChBCbG9ja1VzZXJSZXF1ZXN0EhkKB3VzZXJfaWQYASABKAlCCLpIBXIDsAEBIhMKEUJsb2NrVXNlclJlc3BvbnNlIi8KElVuYmxvY2tVc2VyUmVxdWVzdBIZCgd1c2VyX2lkGAEgASgJQgi6SAVyA7ABASIVChNVbmJsb2NrVXNlclJlc3BvbnNlIhkKF0xpc3RCbG9ja2VkVXNlcnNSZXF1ZXN0IjcKGExpc3RCbG9ja2VkVXNlcnNSZXNwb25zZRIbCgV1c2VycxgBIAMoCzIMLmFwaS52MS5Vc2VyIjUKFExpc3RGb2xsb3dlcnNSZXF1ZXN0Eh0KC2ZvbGxvd2VyX2lkGAEgASgJQgi6SAVyA7ABASI4ChVMaXN0Rm9sbG93ZXJzUmVzcG9uc2USHwoJZm9sbG93ZXJzGAEgAygLMgwuYXBpLnYxLlVzZXIiNQoUTGlzdEZvbGxvd2Vlc1JlcXVlc3QSHQoLZm9sbG93ZWVfaWQYASABKAlCCLpIBXIDsAEBIjgKFUxpc3RGb2xsb3dlZXNSZXNwb25zZRIfCglmb2xsb3dlZXMYASADKAsyDC5hcGkudjEuVXNlciJjChJTZWFyY2hVc2Vyc1JlcXVlc3QSFgoFcXVlcnkYASABKAlCB7pIBHICEAMSNQoKcGFnaW5hdGlvbhgCIAEoCzIZLmFwaS52MS5QYWdpbmF0aW9uUmVxdWVzdEIGukgDyAEBImIKE1NlYXJjaFVzZXJzUmVzcG9uc2USGwoFdXNlcnMYASADKAsyDC5hcGkudjEuVXNlchIuCgpwYWdpbmF0aW9uGAIgASgLMhouYXBpLnYxLlBhZ2luYXRpb25SZXNwb25zZSITChFHZXRQcm9maWxlUmVxdWVzdCI2ChJHZXRQcm9maWxlUmVzcG9uc2USIAoHcHJvZmlsZRgBIAEoCzIPLmFwaS52MS5Qcm9maWxlInEKFFVwZGF0ZVByb2ZpbGVSZXF1ZXN0EigKB3Byb2ZpbGUYASABKAsyDy5hcGkudjEuUHJvZmlsZUIGukgDyAEBEi8KC3VwZGF0ZV9tYXNrGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLkZpZWxkTWFzayI5ChVVcGRhdGVQcm9maWxlUmVzcG9uc2USIAoHcHJvZmlsZRgBIAEoCzIPLmFwaS52MS5Qcm9maWxlIkcKGEdldFN0cmVuZ3RoU2NvcmVzUmVxdWVzdBIZCgd1c2VyX2lkGAEgASgJQgi6SAVyA7ABAUoECAIQA0oECAMQBEoECAQQBSJDChlHZXRTdHJlbmd0aFNjb3Jlc1Jlc3BvbnNlEiYKBnNjb3JlcxgBIAEoCzIWLmFwaS52MS5TdHJlbmd0aFNjb3JlcyJKChVHZXRZZWFyU3VtbWFyeVJlcXVlc3QSGQoHdXNlcl9pZBgBIAEoCUIIukgFcgOwAQESFgoEeWVhchgCIAEoBUIIukgFGgMo0A8iPgoWR2V0WWVhclN1bW1hcnlSZXNwb25zZRIkCgdzdW1tYXJ5GAEgASgLMhMuYXBpLnYxLlllYXJTdW1tYXJ5IhMKEUV4cG9ydERhdGFSZXF1ZXN0IjMKEkV4cG9ydERhdGFSZXNwb25zZRINCgVjaHVuaxgBIAEoDBIOCgZxdWV1ZWQYAiABKAgiNAoZRG93bmxvYWREYXRhRXhwb3J0UmVxdWVzdBIXCgV0b2tlbhgBIAEoCUIIukgFcgOwAQEiKwoaRG93bmxvYWREYXRhRXhwb3J0UmVzcG9uc2USDQoFY2h1bmsYASABKAwiMQoURGVsZXRlQWNjb3VudFJlcXVlc3QSGQoIcGFzc3dvcmQYASABKAlCB7pIBHICEAEiRwoVRGVsZXRlQWNjb3VudFJlc3BvbnNlEi4KCmRlbGV0ZXNfYXQYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIh4KHENhbmNlbEFjY291bnREZWxldGlvblJlcXVlc3QiHwodQ2FuY2VsQWNjb3VudERlbGV0aW9uUmVzcG9uc2Ui0QEKB1Byb2ZpbGUSGAoDc2V4GAEgASgOMgsuYXBpLnYxLlNleBIuCgpiaXJ0aF9kYXRlGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIiCgpib2R5d2VpZ2h0GAMgASgBQg66SAsSCSkAAAAAAAAAABIPCgdwcml2YXRlGAQgASgIEkcKGmRlZmF1bHRfd29ya291dF92aXNpYmlsaXR5GAUgASgOMhkuYXBpLnYxLldvcmtvdXRWaXNpYmlsaXR5Qgi6SAWCAQIQASJbCg1Gb2xsb3dSZXF1ZXN0EhoKBHVzZXIYASABKAsyDC5hcGkudjEuVXNlchIuCgpjcmVhdGVkX2F0GAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLVAQoOU3RyZW5ndGhTY29yZXMSIgoFc3F1YXQYASABKAsyEy5hcGkudjEuRXhlcmNpc2VTZXQSKAoLYmVuY2hfcHJlc3MYAiABKAsyEy5hcGkudjEuRXhlcmNpc2VTZXQSJQoIZGVhZGxpZnQYAyABKAsyEy5hcGkudjEuRXhlcmNpc2VTZXQSDQoFdG90YWwYBCABKAESEgoKYm9keXdlaWdodBgFIAEoARINCgV3aWxrcxgGIAEoARIMCgRkb3RzGAcgASgBEg4KBmlwZl9nbBgIIAEoASK9AwoLWWVhclN1bW1hcnkSCgoCaWQYASABKAkSGgoEdXNlchgCIAEoCzIMLmFwaS52MS5Vc2VyEgwKBHllYXIYAyABKAUSEAoIc2Vzc2lvbnMYBCABKAUSDwoHdG9ubmFnZRgFIAEoARI8ChZtb3N0X3RyYWluZWRfZXhlcmNpc2VzGAYgAygLMhwuYXBpLnYxLlllYXJTdW1tYXJ5LkV4ZXJjaXNlEhYKDnBlcnNvbmFsX2Jlc3RzGAcgASgFEhwKFGxvbmdlc3Rfc3RyZWFrX3dlZWtzGAggASgFEjoKFG1vc3RfYWN0aXZlX2ZvbGxvd2VlGAkgASgLMhwuYXBpLnYxLlllYXJTdW1tYXJ5LkZvbGxvd2VlEi4KCmNyZWF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wGjsKCEV4ZXJjaXNlEhMKC2V4ZXJjaXNlX2lkGAEgASgJEgwKBG5hbWUYAiABKAkSDAoEc2V0cxgDIAEoBRo4CghGb2xsb3dlZRIaCgR1c2VyGAEgASgLMgwuYXBpLnYxLlVzZXISEAoId29ya291dHMYAiABKAUqOAoDU2V4EhMKD1NFWF9VTlNQRUNJRklFRBAAEgwKCFNFWF9NQUxFEAESDgoKU0VYX0ZFTUFMRRACMs4NCgtVc2VyU2VydmljZRJACgdHZXRVc2VyEhYuYXBpLnYxLkdldFVzZXJSZXF1ZXN0GhcuYXBpLnYxLkdldFVzZXJSZXNwb25zZSIEiLUYARJJCgpGb2xsb3dVc2VyEhkuYXBpLnYxLkZvbGxvd1VzZXJSZXF1ZXN0GhouYXBpLnYxLkZvbGxvd1VzZXJSZXNwb25zZSIEiLUYARJPCgxVbmZvbGxvd1VzZXISGy5hcGkudjEuVW5mb2xsb3dVc2VyUmVxdWVzdBocLmFwaS52MS5VbmZvbGxvd1VzZXJSZXNwb25zZSIEiLUYARJhChJMaXN0Rm9sbG93UmVxdWVzdHMSIS5hcGkudjEuTGlzdEZvbGxvd1JlcXVlc3RzUmVxdWVzdBoiLmFwaS52MS5MaXN0Rm9sbG93UmVxdWVzdHNSZXNwb25zZSIEiLUYARJnChRBcHByb3ZlRm9sbG93UmVxdWVzdBIjLmFwaS52MS5BcHByb3ZlRm9sbG93UmVxdWVzdFJlcXVlc3QaJC5hcGkudjEuQXBwcm92ZUZvbGxvd1JlcXVlc3RSZXNwb25zZSIEiLUYARJkChNSZWplY3RGb2xsb3dSZXF1ZXN0EiIuYXBpLnYxLlJlamVjdEZvbGxvd1JlcXVlc3RSZXF1ZXN0GiMuYXBpLnYxLlJlamVjdEZvbGxvd1JlcXVlc3RSZXNwb25zZSIEiLUYARJGCglCbG9ja1VzZXISGC5hcGkudjEuQmxvY2tVc2VyUmVxdWVzdBoZLmFwaS52MS5CbG9ja1VzZXJSZXNwb25zZSIEiLUYARJMCgtVbmJsb2NrVXNlchIaLmFwaS52MS5VbmJsb2NrVXNlclJlcXVlc3QaGy5hcGkudjEuVW5ibG9ja1VzZXJSZXNwb25zZSIEiLUYARJbChBMaXN0QmxvY2tlZFVzZXJzEh8uYXBpLnYxLkxpc3RCbG9ja2VkVXNlcnNSZXF1ZXN0GiAuYXBpLnYxLkxpc3RCbG9ja2VkVXNlcnNSZXNwb25zZSIEiLUYARJSCg1MaXN0Rm9sbG93ZXJzEhwuYXBpLnYxLkxpc3RGb2xsb3dlcnNSZXF1ZXN0Gh0uYXBpLnYxLkxpc3RGb2xsb3dlcnNSZXNwb25zZSIEiLUYARJSCg1MaXN0Rm9sbG93ZWVzEhwuYXBpLnYxLkxpc3RGb2xsb3dlZXNSZXF1ZXN0Gh0uYXBpLnYxLkxpc3RGb2xsb3dlZXNSZXNwb25zZSIEiLUYARJMCgtTZWFyY2hVc2VycxIaLmFwaS52MS5TZWFyY2hVc2Vyc1JlcXVlc3QaGy5hcGkudjEuU2VhcmNoVXNlcnNSZXNwb25zZSIEiLUYARJJCgpHZXRQcm9maWxlEhkuYXBpLnYxLkdldFByb2ZpbGVSZXF1ZXN0GhouYXBpLnYxLkdldFByb2ZpbGVSZXNwb25zZSIEiLUYARJSCg1VcGRhdGVQcm9maWxlEhwuYXBpLnYxLlVwZGF0ZVByb2ZpbGVSZXF1ZXN0Gh0uYXBpLnYxLlVwZGF0ZVByb2ZpbGVSZXNwb25zZSIEiLUYARJeChFHZXRTdHJlbmd0aFNjb3JlcxIgLmFwaS52MS5HZXRTdHJlbmd0aFNjb3Jlc1JlcXVlc3QaIS5hcGkudjEuR2V0U3RyZW5ndGhTY29yZXNSZXNwb25zZSIEiLUYARJVCg5HZXRZZWFyU3VtbWFyeRIdLmFwaS52MS5HZXRZZWFyU3VtbWFyeVJlcXVlc3QaHi5hcGkudjEuR2V0WWVhclN1bW1hcnlSZXNwb25zZSIEiLUYARJLCgpFeHBvcnREYXRhEhkuYXBpLnYxLkV4cG9ydERhdGFSZXF1ZXN0GhouYXBpLnYxLkV4cG9ydERhdGFSZXNwb25zZSIEiLUYATABEmMKEkRvd25sb2FkRGF0YUV4cG9ydBIhLmFwaS52MS5Eb3dubG9hZERhdGFFeHBvcnRSZXF1ZXN0GiIuYXBpLnYxLkRvd25sb2FkRGF0YUV4cG9ydFJlc3BvbnNlIgSItRgBMAESUgoNRGVsZXRlQWNjb3VudBIcLmFwaS52MS5EZWxldGVBY2NvdW50UmVxdWVzdBodLmFwaS52MS5EZWxldGVBY2NvdW50UmVzcG9uc2UiBIi1GAESagoVQ2FuY2VsQWNjb3VudERlbGV0aW9uEiQuYXBpLnYxLkNhbmNlbEFjY291bnREZWxldGlvblJlcXVlc3QaJS5hcGkudjEuQ2FuY2VsQWNjb3VudERlbGV0aW9uUmVzcG9uc2UiBIi1GAFClAEKCmNvbS5hcGkudjFCEFVzZXJTZXJ2aWNlUHJvdG9QAVo7Z2l0aHViLmNvbS9jcmxzc24vZ2V0c3Ryb25nZXIvc2VydmVyL2dlbi9wcm90by9hcGkvdjE7YXBpdjGiAgNBWFiqAgZBcGkuVjHKAgZBcGlcVjHiAhJBcGlcVjFcR1BCTWV0YWRhdGHqAgdBcGk6OlYxYgZwcm90bzM", [file_api_v1_options, file_api_v1_shared, file_google_protobuf_field_mask, file_google_protobuf_timestamp, file_buf_validate_validate]);
 
 /**
  * @generated from message api.v1.GetUserRequest
@@ -71,6 +73,12 @@ export const FollowUserRequestSchema: GenMessage<FollowUserRequest> = /*@__PURE_
  * @generated from message api.v1.FollowUserResponse
  */
 export type FollowUserResponse = Message<"api.v1.FollowUserResponse"> & {
+  /**
+   * Set when the user is private and the follow is pending their approval.
+   *
+   * @generated from field: bool requested = 1;
+   */
+  requested: boolean;
 };
 
 /**
@@ -110,6 +118,186 @@ export type UnfollowUserResponse = Message<"api.v1.UnfollowUserResponse"> & {
 export const UnfollowUserResponseSchema: GenMessage<UnfollowUserResponse> = /*@__PURE__*/
   messageDesc(file_api_v1_user_service, 5);
 
+/**
+ * @generated from message api.v1.ListFollowRequestsRequest
+ */
+export type ListFollowRequestsRequest = Message<"api.v1.ListFollowRequestsRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.ListFollowRequestsRequest.
+ * Use `create(ListFollowRequestsRequestSchema)` to create a new message.
+ */
+export const ListFollowRequestsRequestSchema: GenMessage<ListFollowRequestsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 6);
+
+/**
+ * @generated from message api.v1.ListFollowRequestsResponse
+ */
+export type ListFollowRequestsResponse = Message<"api.v1.ListFollowRequestsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.FollowRequest requests = 1;
+   */
+  requests: FollowRequest[];
+};
+
+/**
+ * Describes the message api.v1.ListFollowRequestsResponse.
+ * Use `create(ListFollowRequestsResponseSchema)` to create a new message.
+ */
+export const ListFollowRequestsResponseSchema: GenMessage<ListFollowRequestsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 7);
+
+/**
+ * @generated from message api.v1.ApproveFollowRequestRequest
+ */
+export type ApproveFollowRequestRequest = Message<"api.v1.ApproveFollowRequestRequest"> & {
+  /**
+   * @generated from field: string follower_id = 1;
+   */
+  followerId: string;
+};
+
+/**
+ * Describes the message api.v1.ApproveFollowRequestRequest.
+ * Use `create(ApproveFollowRequestRequestSchema)` to create a new message.
+ */
+export const ApproveFollowRequestRequestSchema: GenMessage<ApproveFollowRequestRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 8);
+
+/**
+ * @generated from message api.v1.ApproveFollowRequestResponse
+ */
+export type ApproveFollowRequestResponse = Message<"api.v1.ApproveFollowRequestResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.ApproveFollowRequestResponse.
+ * Use `create(ApproveFollowRequestResponseSchema)` to create a new message.
+ */
+export const ApproveFollowRequestResponseSchema: GenMessage<ApproveFollowRequestResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 9);
+
+/**
+ * @generated from message api.v1.RejectFollowRequestRequest
+ */
+export type RejectFollowRequestRequest = Message<"api.v1.RejectFollowRequestRequest"> & {
+  /**
+   * @generated from field: string follower_id = 1;
+   */
+  followerId: string;
+};
+
+/**
+ * Describes the message api.v1.RejectFollowRequestRequest.
+ * Use `create(RejectFollowRequestRequestSchema)` to create a new message.
+ */
+export const RejectFollowRequestRequestSchema: GenMessage<RejectFollowRequestRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 10);
+
+/**
+ * @generated from message api.v1.RejectFollowRequestResponse
+ */
+export type RejectFollowRequestResponse = Message<"api.v1.RejectFollowRequestResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RejectFollowRequestResponse.
+ * Use `create(RejectFollowRequestResponseSchema)` to create a new message.
+ */
+export const RejectFollowRequestResponseSchema: GenMessage<RejectFollowRequestResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 11);
+
+/**
+ * @generated from message api.v1.BlockUserRequest
+ */
+export type BlockUserRequest = Message<"api.v1.BlockUserRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message api.v1.BlockUserRequest.
+ * Use `create(BlockUserRequestSchema)` to create a new message.
+ */
+export const BlockUserRequestSchema: GenMessage<BlockUserRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 12);
+
+/**
+ * @generated from message api.v1.BlockUserResponse
+ */
+export type BlockUserResponse = Message<"api.v1.BlockUserResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.BlockUserResponse.
+ * Use `create(BlockUserResponseSchema)` to create a new message.
+ */
+export const BlockUserResponseSchema: GenMessage<BlockUserResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 13);
+
+/**
+ * @generated from message api.v1.UnblockUserRequest
+ */
+export type UnblockUserRequest = Message<"api.v1.UnblockUserRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message api.v1.UnblockUserRequest.
+ * Use `create(UnblockUserRequestSchema)` to create a new message.
+ */
+export const UnblockUserRequestSchema: GenMessage<UnblockUserRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 14);
+
+/**
+ * @generated from message api.v1.UnblockUserResponse
+ */
+export type UnblockUserResponse = Message<"api.v1.UnblockUserResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.UnblockUserResponse.
+ * Use `create(UnblockUserResponseSchema)` to create a new message.
+ */
+export const UnblockUserResponseSchema: GenMessage<UnblockUserResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 15);
+
+/**
+ * @generated from message api.v1.ListBlockedUsersRequest
+ */
+export type ListBlockedUsersRequest = Message<"api.v1.ListBlockedUsersRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.ListBlockedUsersRequest.
+ * Use `create(ListBlockedUsersRequestSchema)` to create a new message.
+ */
+export const ListBlockedUsersRequestSchema: GenMessage<ListBlockedUsersRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 16);
+
+/**
+ * @generated from message api.v1.ListBlockedUsersResponse
+ */
+export type ListBlockedUsersResponse = Message<"api.v1.ListBlockedUsersResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.User users = 1;
+   */
+  users: User[];
+};
+
+/**
+ * Describes the message api.v1.ListBlockedUsersResponse.
+ * Use `create(ListBlockedUsersResponseSchema)` to create a new message.
+ */
+export const ListBlockedUsersResponseSchema: GenMessage<ListBlockedUsersResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 17);
+
 /**
  * @generated from message api.v1.ListFollowersRequest
  */
@@ -125,7 +313,7 @@ export type ListFollowersRequest = Message<"api.v1.ListFollowersRequest"> & {
  * Use `create(ListFollowersRequestSchema)` to create a new message.
  */
 export const ListFollowersRequestSchema: GenMessage<ListFollowersRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 6);
+  messageDesc(file_api_v1_user_service, 18);
 
 /**
  * @generated from message api.v1.ListFollowersResponse
@@ -142,7 +330,7 @@ export type ListFollowersResponse = Message<"api.v1.ListFollowersResponse"> & {
  * Use `create(ListFollowersResponseSchema)` to create a new message.
  */
 export const ListFollowersResponseSchema: GenMessage<ListFollowersResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 7);
+  messageDesc(file_api_v1_user_service, 19);
 
 /**
  * @generated from message api.v1.ListFolloweesRequest
@@ -159,7 +347,7 @@ export type ListFolloweesRequest = Message<"api.v1.ListFolloweesRequest"> & {
  * Use `create(ListFolloweesRequestSchema)` to create a new message.
  */
 export const ListFolloweesRequestSchema: GenMessage<ListFolloweesRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 8);
+  messageDesc(file_api_v1_user_service, 20);
 
 /**
  * @generated from message api.v1.ListFolloweesResponse
@@ -176,7 +364,7 @@ export type ListFolloweesResponse = Message<"api.v1.ListFolloweesResponse"> & {
  * Use `create(ListFolloweesResponseSchema)` to create a new message.
  */
 export const ListFolloweesResponseSchema: GenMessage<ListFolloweesResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 9);
+  messageDesc(file_api_v1_user_service, 21);
 
 /**
  * @generated from message api.v1.SearchUsersRequest
@@ -198,7 +386,7 @@ export type SearchUsersRequest = Message<"api.v1.SearchUsersRequest"> & {
  * Use `create(SearchUsersRequestSchema)` to create a new message.
  */
 export const SearchUsersRequestSchema: GenMessage<SearchUsersRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 10);
+  messageDesc(file_api_v1_user_service, 22);
 
 /**
  * @generated from message api.v1.SearchUsersResponse
@@ -220,7 +408,540 @@ export type SearchUsersResponse = Message<"api.v1.SearchUsersResponse"> & {
  * Use `create(SearchUsersResponseSchema)` to create a new message.
  */
 export const SearchUsersResponseSchema: GenMessage<SearchUsersResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_user_service, 11);
+  messageDesc(file_api_v1_user_service, 23);
+
+/**
+ * @generated from message api.v1.GetProfileRequest
+ */
+export type GetProfileRequest = Message<"api.v1.GetProfileRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.GetProfileRequest.
+ * Use `create(GetProfileRequestSchema)` to create a new message.
+ */
+export const GetProfileRequestSchema: GenMessage<GetProfileRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 24);
+
+/**
+ * @generated from message api.v1.GetProfileResponse
+ */
+export type GetProfileResponse = Message<"api.v1.GetProfileResponse"> & {
+  /**
+   * @generated from field: api.v1.Profile profile = 1;
+   */
+  profile?: Profile;
+};
+
+/**
+ * Describes the message api.v1.GetProfileResponse.
+ * Use `create(GetProfileResponseSchema)` to create a new message.
+ */
+export const GetProfileResponseSchema: GenMessage<GetProfileResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 25);
+
+/**
+ * @generated from message api.v1.UpdateProfileRequest
+ */
+export type UpdateProfileRequest = Message<"api.v1.UpdateProfileRequest"> & {
+  /**
+   * @generated from field: api.v1.Profile profile = 1;
+   */
+  profile?: Profile;
+
+  /**
+   * @generated from field: google.protobuf.FieldMask update_mask = 2;
+   */
+  updateMask?: FieldMask;
+};
+
+/**
+ * Describes the message api.v1.UpdateProfileRequest.
+ * Use `create(UpdateProfileRequestSchema)` to create a new message.
+ */
+export const UpdateProfileRequestSchema: GenMessage<UpdateProfileRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 26);
+
+/**
+ * @generated from message api.v1.UpdateProfileResponse
+ */
+export type UpdateProfileResponse = Message<"api.v1.UpdateProfileResponse"> & {
+  /**
+   * @generated from field: api.v1.Profile profile = 1;
+   */
+  profile?: Profile;
+};
+
+/**
+ * Describes the message api.v1.UpdateProfileResponse.
+ * Use `create(UpdateProfileResponseSchema)` to create a new message.
+ */
+export const UpdateProfileResponseSchema: GenMessage<UpdateProfileResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 27);
+
+/**
+ * @generated from message api.v1.GetStrengthScoresRequest
+ */
+export type GetStrengthScoresRequest = Message<"api.v1.GetStrengthScoresRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+};
+
+/**
+ * Describes the message api.v1.GetStrengthScoresRequest.
+ * Use `create(GetStrengthScoresRequestSchema)` to create a new message.
+ */
+export const GetStrengthScoresRequestSchema: GenMessage<GetStrengthScoresRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 28);
+
+/**
+ * @generated from message api.v1.GetStrengthScoresResponse
+ */
+export type GetStrengthScoresResponse = Message<"api.v1.GetStrengthScoresResponse"> & {
+  /**
+   * @generated from field: api.v1.StrengthScores scores = 1;
+   */
+  scores?: StrengthScores;
+};
+
+/**
+ * Describes the message api.v1.GetStrengthScoresResponse.
+ * Use `create(GetStrengthScoresResponseSchema)` to create a new message.
+ */
+export const GetStrengthScoresResponseSchema: GenMessage<GetStrengthScoresResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 29);
+
+/**
+ * @generated from message api.v1.GetYearSummaryRequest
+ */
+export type GetYearSummaryRequest = Message<"api.v1.GetYearSummaryRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: int32 year = 2;
+   */
+  year: number;
+};
+
+/**
+ * Describes the message api.v1.GetYearSummaryRequest.
+ * Use `create(GetYearSummaryRequestSchema)` to create a new message.
+ */
+export const GetYearSummaryRequestSchema: GenMessage<GetYearSummaryRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 30);
+
+/**
+ * @generated from message api.v1.GetYearSummaryResponse
+ */
+export type GetYearSummaryResponse = Message<"api.v1.GetYearSummaryResponse"> & {
+  /**
+   * @generated from field: api.v1.YearSummary summary = 1;
+   */
+  summary?: YearSummary;
+};
+
+/**
+ * Describes the message api.v1.GetYearSummaryResponse.
+ * Use `create(GetYearSummaryResponseSchema)` to create a new message.
+ */
+export const GetYearSummaryResponseSchema: GenMessage<GetYearSummaryResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 31);
+
+/**
+ * @generated from message api.v1.ExportDataRequest
+ */
+export type ExportDataRequest = Message<"api.v1.ExportDataRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.ExportDataRequest.
+ * Use `create(ExportDataRequestSchema)` to create a new message.
+ */
+export const ExportDataRequestSchema: GenMessage<ExportDataRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 32);
+
+/**
+ * @generated from message api.v1.ExportDataResponse
+ */
+export type ExportDataResponse = Message<"api.v1.ExportDataResponse"> & {
+  /**
+   * A chunk of the ZIP archive.
+   *
+   * @generated from field: bytes chunk = 1;
+   */
+  chunk: Uint8Array;
+
+  /**
+   * Set when the account is too large to be exported directly. A download
+   * link is emailed to the user once the archive is ready.
+   *
+   * @generated from field: bool queued = 2;
+   */
+  queued: boolean;
+};
+
+/**
+ * Describes the message api.v1.ExportDataResponse.
+ * Use `create(ExportDataResponseSchema)` to create a new message.
+ */
+export const ExportDataResponseSchema: GenMessage<ExportDataResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 33);
+
+/**
+ * @generated from message api.v1.DownloadDataExportRequest
+ */
+export type DownloadDataExportRequest = Message<"api.v1.DownloadDataExportRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message api.v1.DownloadDataExportRequest.
+ * Use `create(DownloadDataExportRequestSchema)` to create a new message.
+ */
+export const DownloadDataExportRequestSchema: GenMessage<DownloadDataExportRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 34);
+
+/**
+ * @generated from message api.v1.DownloadDataExportResponse
+ */
+export type DownloadDataExportResponse = Message<"api.v1.DownloadDataExportResponse"> & {
+  /**
+   * A chunk of the ZIP archive.
+   *
+   * @generated from field: bytes chunk = 1;
+   */
+  chunk: Uint8Array;
+};
+
+/**
+ * Describes the message api.v1.DownloadDataExportResponse.
+ * Use `create(DownloadDataExportResponseSchema)` to create a new message.
+ */
+export const DownloadDataExportResponseSchema: GenMessage<DownloadDataExportResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 35);
+
+/**
+ * @generated from message api.v1.DeleteAccountRequest
+ */
+export type DeleteAccountRequest = Message<"api.v1.DeleteAccountRequest"> & {
+  /**
+   * @generated from field: string password = 1;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message api.v1.DeleteAccountRequest.
+ * Use `create(DeleteAccountRequestSchema)` to create a new message.
+ */
+export const DeleteAccountRequestSchema: GenMessage<DeleteAccountRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 36);
+
+/**
+ * @generated from message api.v1.DeleteAccountResponse
+ */
+export type DeleteAccountResponse = Message<"api.v1.DeleteAccountResponse"> & {
+  /**
+   * The account is deleted at this time unless the deletion is cancelled.
+   *
+   * @generated from field: google.protobuf.Timestamp deletes_at = 1;
+   */
+  deletesAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.DeleteAccountResponse.
+ * Use `create(DeleteAccountResponseSchema)` to create a new message.
+ */
+export const DeleteAccountResponseSchema: GenMessage<DeleteAccountResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 37);
+
+/**
+ * @generated from message api.v1.CancelAccountDeletionRequest
+ */
+export type CancelAccountDeletionRequest = Message<"api.v1.CancelAccountDeletionRequest"> & {
+};
+
+/**
+ * Describes the message api.v1.CancelAccountDeletionRequest.
+ * Use `create(CancelAccountDeletionRequestSchema)` to create a new message.
+ */
+export const CancelAccountDeletionRequestSchema: GenMessage<CancelAccountDeletionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 38);
+
+/**
+ * @generated from message api.v1.CancelAccountDeletionResponse
+ */
+export type CancelAccountDeletionResponse = Message<"api.v1.CancelAccountDeletionResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.CancelAccountDeletionResponse.
+ * Use `create(CancelAccountDeletionResponseSchema)` to create a new message.
+ */
+export const CancelAccountDeletionResponseSchema: GenMessage<CancelAccountDeletionResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 39);
+
+/**
+ * @generated from message api.v1.Profile
+ */
+export type Profile = Message<"api.v1.Profile"> & {
+  /**
+   * @generated from field: api.v1.Sex sex = 1;
+   */
+  sex: Sex;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp birth_date = 2;
+   */
+  birthDate?: Timestamp;
+
+  /**
+   * kg
+   *
+   * @generated from field: double bodyweight = 3;
+   */
+  bodyweight: number;
+
+  /**
+   * Private users' workouts, sets and personal bests are only visible to
+   * followers they have approved.
+   *
+   * @generated from field: bool private = 4;
+   */
+  private: boolean;
+
+  /**
+   * @generated from field: api.v1.WorkoutVisibility default_workout_visibility = 5;
+   */
+  defaultWorkoutVisibility: WorkoutVisibility;
+};
+
+/**
+ * Describes the message api.v1.Profile.
+ * Use `create(ProfileSchema)` to create a new message.
+ */
+export const ProfileSchema: GenMessage<Profile> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 40);
+
+/**
+ * @generated from message api.v1.FollowRequest
+ */
+export type FollowRequest = Message<"api.v1.FollowRequest"> & {
+  /**
+   * @generated from field: api.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.FollowRequest.
+ * Use `create(FollowRequestSchema)` to create a new message.
+ */
+export const FollowRequestSchema: GenMessage<FollowRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 41);
+
+/**
+ * @generated from message api.v1.StrengthScores
+ */
+export type StrengthScores = Message<"api.v1.StrengthScores"> & {
+  /**
+   * @generated from field: api.v1.ExerciseSet squat = 1;
+   */
+  squat?: ExerciseSet;
+
+  /**
+   * @generated from field: api.v1.ExerciseSet bench_press = 2;
+   */
+  benchPress?: ExerciseSet;
+
+  /**
+   * @generated from field: api.v1.ExerciseSet deadlift = 3;
+   */
+  deadlift?: ExerciseSet;
+
+  /**
+   * @generated from field: double total = 4;
+   */
+  total: number;
+
+  /**
+   * @generated from field: double bodyweight = 5;
+   */
+  bodyweight: number;
+
+  /**
+   * @generated from field: double wilks = 6;
+   */
+  wilks: number;
+
+  /**
+   * @generated from field: double dots = 7;
+   */
+  dots: number;
+
+  /**
+   * @generated from field: double ipf_gl = 8;
+   */
+  ipfGl: number;
+};
+
+/**
+ * Describes the message api.v1.StrengthScores.
+ * Use `create(StrengthScoresSchema)` to create a new message.
+ */
+export const StrengthScoresSchema: GenMessage<StrengthScores> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 42);
+
+/**
+ * @generated from message api.v1.YearSummary
+ */
+export type YearSummary = Message<"api.v1.YearSummary"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: api.v1.User user = 2;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: int32 year = 3;
+   */
+  year: number;
+
+  /**
+   * @generated from field: int32 sessions = 4;
+   */
+  sessions: number;
+
+  /**
+   * @generated from field: double tonnage = 5;
+   */
+  tonnage: number;
+
+  /**
+   * @generated from field: repeated api.v1.YearSummary.Exercise most_trained_exercises = 6;
+   */
+  mostTrainedExercises: YearSummary_Exercise[];
+
+  /**
+   * @generated from field: int32 personal_bests = 7;
+   */
+  personalBests: number;
+
+  /**
+   * @generated from field: int32 longest_streak_weeks = 8;
+   */
+  longestStreakWeeks: number;
+
+  /**
+   * @generated from field: api.v1.YearSummary.Followee most_active_followee = 9;
+   */
+  mostActiveFollowee?: YearSummary_Followee;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.YearSummary.
+ * Use `create(YearSummarySchema)` to create a new message.
+ */
+export const YearSummarySchema: GenMessage<YearSummary> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 43);
+
+/**
+ * @generated from message api.v1.YearSummary.Exercise
+ */
+export type YearSummary_Exercise = Message<"api.v1.YearSummary.Exercise"> & {
+  /**
+   * @generated from field: string exercise_id = 1;
+   */
+  exerciseId: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: int32 sets = 3;
+   */
+  sets: number;
+};
+
+/**
+ * Describes the message api.v1.YearSummary.Exercise.
+ * Use `create(YearSummary_ExerciseSchema)` to create a new message.
+ */
+export const YearSummary_ExerciseSchema: GenMessage<YearSummary_Exercise> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 43, 0);
+
+/**
+ * @generated from message api.v1.YearSummary.Followee
+ */
+export type YearSummary_Followee = Message<"api.v1.YearSummary.Followee"> & {
+  /**
+   * @generated from field: api.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: int32 workouts = 2;
+   */
+  workouts: number;
+};
+
+/**
+ * Describes the message api.v1.YearSummary.Followee.
+ * Use `create(YearSummary_FolloweeSchema)` to create a new message.
+ */
+export const YearSummary_FolloweeSchema: GenMessage<YearSummary_Followee> = /*@__PURE__*/
+  messageDesc(file_api_v1_user_service, 43, 1);
+
+/**
+ * @generated from enum api.v1.Sex
+ */
+export enum Sex {
+  /**
+   * @generated from enum value: SEX_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SEX_MALE = 1;
+   */
+  MALE = 1,
+
+  /**
+   * @generated from enum value: SEX_FEMALE = 2;
+   */
+  FEMALE = 2,
+}
+
+/**
+ * Describes the enum api.v1.Sex.
+ */
+export const SexSchema: GenEnum<Sex> = /*@__PURE__*/
+  enumDesc(file_api_v1_user_service, 0);
 
 /**
  * @generated from service api.v1.UserService
@@ -250,6 +971,54 @@ export const UserService: GenService<{
     input: typeof UnfollowUserRequestSchema;
     output: typeof UnfollowUserResponseSchema;
   },
+  /**
+   * @generated from rpc api.v1.UserService.ListFollowRequests
+   */
+  listFollowRequests: {
+    methodKind: "unary";
+    input: typeof ListFollowRequestsRequestSchema;
+    output: typeof ListFollowRequestsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.UserService.ApproveFollowRequest
+   */
+  approveFollowRequest: {
+    methodKind: "unary";
+    input: typeof ApproveFollowRequestRequestSchema;
+    output: typeof ApproveFollowRequestResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.UserService.RejectFollowRequest
+   */
+  rejectFollowRequest: {
+    methodKind: "unary";
+    input: typeof RejectFollowRequestRequestSchema;
+    output: typeof RejectFollowRequestResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.UserService.BlockUser
+   */
+  blockUser: {
+    methodKind: "unary";
+    input: typeof BlockUserRequestSchema;
+    output: typeof BlockUserResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.UserService.UnblockUser
+   */
+  unblockUser: {
+    methodKind: "unary";
+    input: typeof UnblockUserRequestSchema;
+    output: typeof UnblockUserResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.UserService.ListBlockedUsers
+   */
+  listBlockedUsers: {
+    methodKind: "unary";
+    input: typeof ListBlockedUsersRequestSchema;
+    output: typeof ListBlockedUsersResponseSchema;
+  },
   /**
    * @generated from rpc api.v1.UserService.ListFollowers
    */
@@ -274,6 +1043,70 @@ export const UserService: GenService<{
     input: typeof SearchUsersRequestSchema;
     output: typeof SearchUsersResponseSchema;
   },
+  /**
+   * @generated from rpc api.v1.UserService.GetProfile
+   */
+  getProfile: {
+    methodKind: "unary";
+    input: typeof GetProfileRequestSchema;
+    output: typeof GetProfileResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.UserService.UpdateProfile
+   */
+  updateProfile: {
+    methodKind: "unary";
+    input: typeof UpdateProfileRequestSchema;
+    output: typeof UpdateProfileResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.UserService.GetStrengthScores
+   */
+  getStrengthScores: {
+    methodKind: "unary";
+    input: typeof GetStrengthScoresRequestSchema;
+    output: typeof GetStrengthScoresResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.UserService.GetYearSummary
+   */
+  getYearSummary: {
+    methodKind: "unary";
+    input: typeof GetYearSummaryRequestSchema;
+    output: typeof GetYearSummaryResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.UserService.ExportData
+   */
+  exportData: {
+    methodKind: "server_streaming";
+    input: typeof ExportDataRequestSchema;
+    output: typeof ExportDataResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.UserService.DownloadDataExport
+   */
+  downloadDataExport: {
+    methodKind: "server_streaming";
+    input: typeof DownloadDataExportRequestSchema;
+    output: typeof DownloadDataExportResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.UserService.DeleteAccount
+   */
+  deleteAccount: {
+    methodKind: "unary";
+    input: typeof DeleteAccountRequestSchema;
+    output: typeof DeleteAccountResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.UserService.CancelAccountDeletion
+   */
+  cancelAccountDeletion: {
+    methodKind: "unary";
+    input: typeof CancelAccountDeletionRequestSchema;
+    output: typeof CancelAccountDeletionResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_user_service, 0);
 
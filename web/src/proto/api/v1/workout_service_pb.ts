@@ -2,10 +2,10 @@
 // @generated from file api/v1/workout_service.proto (package api.v1, syntax proto3)
 /* eslint-disable */
 
-import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
-import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
 import { file_api_v1_options } from "./options_pb";
-import type { ExerciseSets, PaginationRequest, PaginationResponse, User } from "./shared_pb";
+import type { Exercise, ExerciseSets, PaginationRequest, PaginationResponse, Set, User, WorkoutVisibility } from "./shared_pb";
 import { file_api_v1_shared } from "./shared_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file api/v1/workout_service.proto.
  */
 export const file_api_v1_workout_service: GenFile = /*@__PURE__*/
-  fileDesc("ChxhcGkvdjEvd29ya291dF9zZXJ2aWNlLnByb3RvEgZhcGkudjEi6gEKFENyZWF0ZVdvcmtvdXRSZXF1ZXN0EhwKCnJvdXRpbmVfaWQYASABKAlCCLpIBXIDsAEBEjUKDWV4ZXJjaXNlX3NldHMYAiADKAsyFC5hcGkudjEuRXhlcmNpc2VTZXRzQgi6SAWSAQIIARI2CgpzdGFydGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBEjcKC2ZpbmlzaGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBEgwKBG5vdGUYBSABKAkiKwoVQ3JlYXRlV29ya291dFJlc3BvbnNlEhIKCndvcmtvdXRfaWQYASABKAkibwoTTGlzdFdvcmtvdXRzUmVxdWVzdBIhCgh1c2VyX2lkcxgBIAMoCUIPukgMkgEJCAEiBXIDsAEBEjUKCnBhZ2luYXRpb24YAiABKAsyGS5hcGkudjEuUGFnaW5hdGlvblJlcXVlc3RCBrpIA8gBASJpChRMaXN0V29ya291dHNSZXNwb25zZRIhCgh3b3Jrb3V0cxgBIAMoCzIPLmFwaS52MS5Xb3Jrb3V0Ei4KCnBhZ2luYXRpb24YAiABKAsyGi5hcGkudjEuUGFnaW5hdGlvblJlc3BvbnNlIikKEUdldFdvcmtvdXRSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASI2ChJHZXRXb3Jrb3V0UmVzcG9uc2USIAoHd29ya291dBgBIAEoCzIPLmFwaS52MS5Xb3Jrb3V0IiwKFERlbGV0ZVdvcmtvdXRSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASIXChVEZWxldGVXb3Jrb3V0UmVzcG9uc2UiTAoSUG9zdENvbW1lbnRSZXF1ZXN0EhwKCndvcmtvdXRfaWQYASABKAlCCLpIBXIDsAEBEhgKB2NvbW1lbnQYAiABKAlCB7pIBHICEAEiPgoTUG9zdENvbW1lbnRSZXNwb25zZRInCgdjb21tZW50GAEgASgLMhYuYXBpLnYxLldvcmtvdXRDb21tZW50IkAKFFVwZGF0ZVdvcmtvdXRSZXF1ZXN0EigKB3dvcmtvdXQYASABKAsyDy5hcGkudjEuV29ya291dEIGukgDyAEBIhcKFVVwZGF0ZVdvcmtvdXRSZXNwb25zZSLFAgoHV29ya291dBIUCgJpZBgBIAEoCUIIukgFcgOwAQESFQoEbmFtZRgCIAEoCUIHukgEcgIQARIiCgR1c2VyGAMgASgLMgwuYXBpLnYxLlVzZXJCBrpIA8gBARI1Cg1leGVyY2lzZV9zZXRzGAQgAygLMhQuYXBpLnYxLkV4ZXJjaXNlU2V0c0IIukgFkgECCAESKAoIY29tbWVudHMYBSADKAsyFi5hcGkudjEuV29ya291dENvbW1lbnQSLgoKc3RhcnRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNwoLZmluaXNoZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESEQoJaW50ZW5zaXR5GAggASgFEgwKBG5vdGUYCSABKAkinAEKDldvcmtvdXRDb21tZW50EhQKAmlkGAEgASgJQgi6SAVyA7ABARIiCgR1c2VyGAIgASgLMgwuYXBpLnYxLlVzZXJCBrpIA8gBARIYCgdjb21tZW50GAQgASgJQge6SARyAhABEjYKCmNyZWF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQEy9gMKDldvcmtvdXRTZXJ2aWNlElIKDUNyZWF0ZVdvcmtvdXQSHC5hcGkudjEuQ3JlYXRlV29ya291dFJlcXVlc3QaHS5hcGkudjEuQ3JlYXRlV29ya291dFJlc3BvbnNlIgSItRgBEkkKCkdldFdvcmtvdXQSGS5hcGkudjEuR2V0V29ya291dFJlcXVlc3QaGi5hcGkudjEuR2V0V29ya291dFJlc3BvbnNlIgSItRgBEk8KDExpc3RXb3Jrb3V0cxIbLmFwaS52MS5MaXN0V29ya291dHNSZXF1ZXN0GhwuYXBpLnYxLkxpc3RXb3Jrb3V0c1Jlc3BvbnNlIgSItRgBElIKDURlbGV0ZVdvcmtvdXQSHC5hcGkudjEuRGVsZXRlV29ya291dFJlcXVlc3QaHS5hcGkudjEuRGVsZXRlV29ya291dFJlc3BvbnNlIgSItRgBEkwKC1Bvc3RDb21tZW50EhouYXBpLnYxLlBvc3RDb21tZW50UmVxdWVzdBobLmFwaS52MS5Qb3N0Q29tbWVudFJlc3BvbnNlIgSItRgBElIKDVVwZGF0ZVdvcmtvdXQSHC5hcGkudjEuVXBkYXRlV29ya291dFJlcXVlc3QaHS5hcGkudjEuVXBkYXRlV29ya291dFJlc3BvbnNlIgSItRgBQpcBCgpjb20uYXBpLnYxQhNXb3Jrb3V0U2VydmljZVByb3RvUAFaO2dpdGh1Yi5jb20vY3Jsc3NuL2dldHN0cm9uZ2VyL3NlcnZlci9nZW4vcHJvdG8vYXBpL3YxO2FwaXYxogIDQVhYqgIGQXBpLlYxygIGQXBpXFYx4gISQXBpXFYxXEdQQk1ldGFkYXRh6gIHQXBpOjpWMWIGcHJvdG8z", [file_api_v1_options, file_api_v1_shared, file_google_protobuf_timestamp, file_buf_validate_validate]);
+  fileDesc("ChxhcGkvdjEvd29ya291dF9zZXJ2aWNlLnByb3RvEgZhcGkudjEiowIKFENyZWF0ZVdvcmtvdXRSZXF1ZXN0EhwKCnJvdXRpbmVfaWQYASABKAlCCLpIBXIDsAEBEjUKDWV4ZXJjaXNlX3NldHMYAiADKAsyFC5hcGkudjEuRXhlcmNpc2VTZXRzQgi6SAWSAQIIARI2CgpzdGFydGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBEjcKC2ZpbmlzaGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIGukgDyAEBEgwKBG5vdGUYBSABKAkSNwoKdmlzaWJpbGl0eRgGIAEoDjIZLmFwaS52MS5Xb3Jrb3V0VmlzaWJpbGl0eUIIukgFggECEAEiKwoVQ3JlYXRlV29ya291dFJlc3BvbnNlEhIKCndvcmtvdXRfaWQYASABKAkibwoTTGlzdFdvcmtvdXRzUmVxdWVzdBIhCgh1c2VyX2lkcxgBIAMoCUIPukgMkgEJCAEiBXIDsAEBEjUKCnBhZ2luYXRpb24YAiABKAsyGS5hcGkudjEuUGFnaW5hdGlvblJlcXVlc3RCBrpIA8gBASJpChRMaXN0V29ya291dHNSZXNwb25zZRIhCgh3b3Jrb3V0cxgBIAMoCzIPLmFwaS52MS5Xb3Jrb3V0Ei4KCnBhZ2luYXRpb24YAiABKAsyGi5hcGkudjEuUGFnaW5hdGlvblJlc3BvbnNlIikKEUdldFdvcmtvdXRSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASI2ChJHZXRXb3Jrb3V0UmVzcG9uc2USIAoHd29ya291dBgBIAEoCzIPLmFwaS52MS5Xb3Jrb3V0IiwKFERlbGV0ZVdvcmtvdXRSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASIXChVEZWxldGVXb3Jrb3V0UmVzcG9uc2UiLQoVUmVzdG9yZVdvcmtvdXRSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASIYChZSZXN0b3JlV29ya291dFJlc3BvbnNlIowBChJQb3N0Q29tbWVudFJlcXVlc3QSHAoKd29ya291dF9pZBgBIAEoCUIIukgFcgOwAQESGAoHY29tbWVudBgCIAEoCUIHukgEcgIQARIoChFwYXJlbnRfY29tbWVudF9pZBgDIAEoCUIIukgFcgOwAQFIAIgBAUIUChJfcGFyZW50X2NvbW1lbnRfaWQiPgoTUG9zdENvbW1lbnRSZXNwb25zZRInCgdjb21tZW50GAEgASgLMhYuYXBpLnYxLldvcmtvdXRDb21tZW50IkYKFFVwZGF0ZUNvbW1lbnRSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABARIYCgdjb21tZW50GAIgASgJQge6SARyAhABIkAKFVVwZGF0ZUNvbW1lbnRSZXNwb25zZRInCgdjb21tZW50GAEgASgLMhYuYXBpLnYxLldvcmtvdXRDb21tZW50IiwKFERlbGV0ZUNvbW1lbnRSZXF1ZXN0EhQKAmlkGAEgASgJQgi6SAVyA7ABASIXChVEZWxldGVDb21tZW50UmVzcG9uc2UiQAoUVXBkYXRlV29ya291dFJlcXVlc3QSKAoHd29ya291dBgBIAEoCzIPLmFwaS52MS5Xb3Jrb3V0Qga6SAPIAQEiFwoVVXBkYXRlV29ya291dFJlc3BvbnNlIloKFkNvbXBhcmVXb3Jrb3V0c1JlcXVlc3QSHAoKd29ya291dF9pZBgBIAEoCUIIukgFcgOwAQESIgoQb3RoZXJfd29ya291dF9pZBgCIAEoCUIIukgFcgOwAQEikgEKF0NvbXBhcmVXb3Jrb3V0c1Jlc3BvbnNlEiAKB3dvcmtvdXQYASABKAsyDy5hcGkudjEuV29ya291dBImCg1vdGhlcl93b3Jrb3V0GAIgASgLMg8uYXBpLnYxLldvcmtvdXQSLQoJZXhlcmNpc2VzGAMgAygLMhouYXBpLnYxLkV4ZXJjaXNlQ29tcGFyaXNvbiKIAQoVSW1wb3J0V29ya291dHNSZXF1ZXN0EjAKBmZvcm1hdBgBIAEoDjIULmFwaS52MS5JbXBvcnRGb3JtYXRCCrpIB4IBBBABIAASGgoEZmlsZRgCIAEoDEIMukgJegcQARiAgIAFEg8KB2RyeV9ydW4YAyABKAgSEAoIdGltZXpvbmUYBCABKAkiegoWSW1wb3J0V29ya291dHNSZXNwb25zZRIQCgh3b3Jrb3V0cxgBIAEoBRIMCgRzZXRzGAIgASgFEhUKDW5ld19leGVyY2lzZXMYAyADKAkSKQoJY29uZmxpY3RzGAQgAygLMhYuYXBpLnYxLkltcG9ydENvbmZsaWN0ImUKFVJlYWN0VG9Xb3Jrb3V0UmVxdWVzdBIcCgp3b3Jrb3V0X2lkGAEgASgJQgi6SAVyA7ABARIuCgR0eXBlGAIgASgOMhQuYXBpLnYxLlJlYWN0aW9uVHlwZUIKukgHggEEEAEgACIYChZSZWFjdFRvV29ya291dFJlc3BvbnNlIjUKFVJlbW92ZVJlYWN0aW9uUmVxdWVzdBIcCgp3b3Jrb3V0X2lkGAEgASgJQgi6SAVyA7ABASIYChZSZW1vdmVSZWFjdGlvblJlc3BvbnNlIjQKFExpc3RSZWFjdGlvbnNSZXF1ZXN0EhwKCndvcmtvdXRfaWQYASABKAlCCLpIBXIDsAEBIjwKFUxpc3RSZWFjdGlvbnNSZXNwb25zZRIjCglyZWFjdGlvbnMYASADKAsyEC5hcGkudjEuUmVhY3Rpb24i+wEKEkV4ZXJjaXNlQ29tcGFyaXNvbhIiCghleGVyY2lzZRgBIAEoCzIQLmFwaS52MS5FeGVyY2lzZRIoCg5vdGhlcl9leGVyY2lzZRgCIAEoCzIQLmFwaS52MS5FeGVyY2lzZRIjCgRzZXRzGAMgAygLMhUuYXBpLnYxLlNldENvbXBhcmlzb24SEgoKc2V0c19hZGRlZBgEIAEoBRIUCgxzZXRzX3JlbW92ZWQYBSABKAUSFAoMdm9sdW1lX2RlbHRhGAYgASgBEhUKDXBlcnNvbmFsX2Jlc3QYByABKAgSGwoTb3RoZXJfcGVyc29uYWxfYmVzdBgIIAEoCCJzCg1TZXRDb21wYXJpc29uEhgKA3NldBgBIAEoCzILLmFwaS52MS5TZXQSHgoJb3RoZXJfc2V0GAIgASgLMgsuYXBpLnYxLlNldBIUCgx3ZWlnaHRfZGVsdGEYAyABKAESEgoKcmVwc19kZWx0YRgEIAEoBSL+AwoHV29ya291dBIUCgJpZBgBIAEoCUIIukgFcgOwAQESFQoEbmFtZRgCIAEoCUIHukgEcgIQARIiCgR1c2VyGAMgASgLMgwuYXBpLnYxLlVzZXJCBrpIA8gBARI1Cg1leGVyY2lzZV9zZXRzGAQgAygLMhQuYXBpLnYxLkV4ZXJjaXNlU2V0c0IIukgFkgECCAESKAoIY29tbWVudHMYBSADKAsyFi5hcGkudjEuV29ya291dENvbW1lbnQSLgoKc3RhcnRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNwoLZmluaXNoZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQga6SAPIAQESEQoJaW50ZW5zaXR5GAggASgFEgwKBG5vdGUYCSABKAkSLgoPcmVhY3Rpb25fY291bnRzGAogAygLMhUuYXBpLnYxLlJlYWN0aW9uQ291bnQSJgoIcmVhY3Rpb24YCyABKA4yFC5hcGkudjEuUmVhY3Rpb25UeXBlEiYKDW5vdGVfbWVudGlvbnMYDCADKAsyDy5hcGkudjEuTWVudGlvbhI3Cgp2aXNpYmlsaXR5GA0gASgOMhkuYXBpLnYxLldvcmtvdXRWaXNpYmlsaXR5Qgi6SAWCAQIQASKyAgoOV29ya291dENvbW1lbnQSFAoCaWQYASABKAlCCLpIBXIDsAEBEiIKBHVzZXIYAiABKAsyDC5hcGkudjEuVXNlckIGukgDyAEBEhgKB2NvbW1lbnQYBCABKAlCB7pIBHICEAESNgoKY3JlYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCBrpIA8gBARItCgllZGl0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhkKEXBhcmVudF9jb21tZW50X2lkGAcgASgJEicKB3JlcGxpZXMYCCADKAsyFi5hcGkudjEuV29ya291dENvbW1lbnQSIQoIbWVudGlvbnMYCSADKAsyDy5hcGkudjEuTWVudGlvbiI2CgdNZW50aW9uEg8KB3VzZXJfaWQYASABKAkSDQoFc3RhcnQYAiABKAUSCwoDZW5kGAMgASgFIoQBCg5JbXBvcnRDb25mbGljdBIUCgx3b3Jrb3V0X25hbWUYASABKAkSLgoKc3RhcnRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoGcmVhc29uGAMgASgOMhwuYXBpLnYxLkltcG9ydENvbmZsaWN0UmVhc29uInoKCFJlYWN0aW9uEhoKBHVzZXIYASABKAsyDC5hcGkudjEuVXNlchIiCgR0eXBlGAIgASgOMhQuYXBpLnYxLlJlYWN0aW9uVHlwZRIuCgpjcmVhdGVkX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJCCg1SZWFjdGlvbkNvdW50EiIKBHR5cGUYASABKA4yFC5hcGkudjEuUmVhY3Rpb25UeXBlEg0KBWNvdW50GAIgASgFKl8KDEltcG9ydEZvcm1hdBIdChlJTVBPUlRfRk9STUFUX1VOU1BFQ0lGSUVEEAASGAoUSU1QT1JUX0ZPUk1BVF9TVFJPTkcQARIWChJJTVBPUlRfRk9STUFUX0hFVlkQAirBAQoUSW1wb3J0Q29uZmxpY3RSZWFzb24SJgoiSU1QT1JUX0NPTkZMSUNUX1JFQVNPTl9VTlNQRUNJRklFRBAAEisKJ0lNUE9SVF9DT05GTElDVF9SRUFTT05fQUxSRUFEWV9JTVBPUlRFRBABEisKJ0lNUE9SVF9DT05GTElDVF9SRUFTT05fT1ZFUkxBUFNfV09SS09VVBACEicKI0lNUE9SVF9DT05GTElDVF9SRUFTT05fV0lUSE9VVF9TRVRTEAMqpQEKDFJlYWN0aW9uVHlwZRIdChlSRUFDVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASGwoXUkVBQ1RJT05fVFlQRV9USFVNQlNfVVAQARIWChJSRUFDVElPTl9UWVBFX0ZJUkUQAhIfChtSRUFDVElPTl9UWVBFX0ZMRVhFRF9CSUNFUFMQAxIgChxSRUFDVElPTl9UWVBFX0NMQVBQSU5HX0hBTkRTEAQyqAkKDldvcmtvdXRTZXJ2aWNlElIKDUNyZWF0ZVdvcmtvdXQSHC5hcGkudjEuQ3JlYXRlV29ya291dFJlcXVlc3QaHS5hcGkudjEuQ3JlYXRlV29ya291dFJlc3BvbnNlIgSItRgBEkkKCkdldFdvcmtvdXQSGS5hcGkudjEuR2V0V29ya291dFJlcXVlc3QaGi5hcGkudjEuR2V0V29ya291dFJlc3BvbnNlIgSItRgBEk8KDExpc3RXb3Jrb3V0cxIbLmFwaS52MS5MaXN0V29ya291dHNSZXF1ZXN0GhwuYXBpLnYxLkxpc3RXb3Jrb3V0c1Jlc3BvbnNlIgSItRgBElIKDURlbGV0ZVdvcmtvdXQSHC5hcGkudjEuRGVsZXRlV29ya291dFJlcXVlc3QaHS5hcGkudjEuRGVsZXRlV29ya291dFJlc3BvbnNlIgSItRgBElUKDlJlc3RvcmVXb3Jrb3V0Eh0uYXBpLnYxLlJlc3RvcmVXb3Jrb3V0UmVxdWVzdBoeLmFwaS52MS5SZXN0b3JlV29ya291dFJlc3BvbnNlIgSItRgBEkwKC1Bvc3RDb21tZW50EhouYXBpLnYxLlBvc3RDb21tZW50UmVxdWVzdBobLmFwaS52MS5Qb3N0Q29tbWVudFJlc3BvbnNlIgSItRgBElIKDVVwZGF0ZUNvbW1lbnQSHC5hcGkudjEuVXBkYXRlQ29tbWVudFJlcXVlc3QaHS5hcGkudjEuVXBkYXRlQ29tbWVudFJlc3BvbnNlIgSItRgBElIKDURlbGV0ZUNvbW1lbnQSHC5hcGkudjEuRGVsZXRlQ29tbWVudFJlcXVlc3QaHS5hcGkudjEuRGVsZXRlQ29tbWVudFJlc3BvbnNlIgSItRgBElIKDVVwZGF0ZVdvcmtvdXQSHC5hcGkudjEuVXBkYXRlV29ya291dFJlcXVlc3QaHS5hcGkudjEuVXBkYXRlV29ya291dFJlc3BvbnNlIgSItRgBElgKD0NvbXBhcmVXb3Jrb3V0cxIeLmFwaS52MS5Db21wYXJlV29ya291dHNSZXF1ZXN0Gh8uYXBpLnYxLkNvbXBhcmVXb3Jrb3V0c1Jlc3BvbnNlIgSItRgBElUKDkltcG9ydFdvcmtvdXRzEh0uYXBpLnYxLkltcG9ydFdvcmtvdXRzUmVxdWVzdBoeLmFwaS52MS5JbXBvcnRXb3Jrb3V0c1Jlc3BvbnNlIgSItRgBElUKDlJlYWN0VG9Xb3Jrb3V0Eh0uYXBpLnYxLlJlYWN0VG9Xb3Jrb3V0UmVxdWVzdBoeLmFwaS52MS5SZWFjdFRvV29ya291dFJlc3BvbnNlIgSItRgBElUKDlJlbW92ZVJlYWN0aW9uEh0uYXBpLnYxLlJlbW92ZVJlYWN0aW9uUmVxdWVzdBoeLmFwaS52MS5SZW1vdmVSZWFjdGlvblJlc3BvbnNlIgSItRgBElIKDUxpc3RSZWFjdGlvbnMSHC5hcGkudjEuTGlzdFJlYWN0aW9uc1JlcXVlc3QaHS5hcGkudjEuTGlzdFJlYWN0aW9uc1Jlc3BvbnNlIgSItRgBQpcBCgpjb20uYXBpLnYxQhNXb3Jrb3V0U2VydmljZVByb3RvUAFaO2dpdGh1Yi5jb20vY3Jsc3NuL2dldHN0cm9uZ2VyL3NlcnZlci9nZW4vcHJvdG8vYXBpL3YxO2FwaXYxogIDQVhYqgIGQXBpLlYxygIGQXBpXFYx4gISQXBpXFYxXEdQQk1ldGFkYXRh6gIHQXBpOjpWMWIGcHJvdG8z", [file_api_v1_options, file_api_v1_shared, file_google_protobuf_timestamp, file_buf_validate_validate]);
 
 /**
  * @generated from message api.v1.CreateWorkoutRequest
@@ -46,6 +46,13 @@ export type CreateWorkoutRequest = Message<"api.v1.CreateWorkoutRequest"> & {
    * @generated from field: string note = 5;
    */
   note: string;
+
+  /**
+   * Defaults to the user's default workout visibility if unspecified.
+   *
+   * @generated from field: api.v1.WorkoutVisibility visibility = 6;
+   */
+  visibility: WorkoutVisibility;
 };
 
 /**
@@ -180,6 +187,36 @@ export type DeleteWorkoutResponse = Message<"api.v1.DeleteWorkoutResponse"> & {
 export const DeleteWorkoutResponseSchema: GenMessage<DeleteWorkoutResponse> = /*@__PURE__*/
   messageDesc(file_api_v1_workout_service, 7);
 
+/**
+ * @generated from message api.v1.RestoreWorkoutRequest
+ */
+export type RestoreWorkoutRequest = Message<"api.v1.RestoreWorkoutRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message api.v1.RestoreWorkoutRequest.
+ * Use `create(RestoreWorkoutRequestSchema)` to create a new message.
+ */
+export const RestoreWorkoutRequestSchema: GenMessage<RestoreWorkoutRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 8);
+
+/**
+ * @generated from message api.v1.RestoreWorkoutResponse
+ */
+export type RestoreWorkoutResponse = Message<"api.v1.RestoreWorkoutResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RestoreWorkoutResponse.
+ * Use `create(RestoreWorkoutResponseSchema)` to create a new message.
+ */
+export const RestoreWorkoutResponseSchema: GenMessage<RestoreWorkoutResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 9);
+
 /**
  * @generated from message api.v1.PostCommentRequest
  */
@@ -193,6 +230,13 @@ export type PostCommentRequest = Message<"api.v1.PostCommentRequest"> & {
    * @generated from field: string comment = 2;
    */
   comment: string;
+
+  /**
+   * The comment being replied to, if any. It must belong to the same workout.
+   *
+   * @generated from field: optional string parent_comment_id = 3;
+   */
+  parentCommentId?: string;
 };
 
 /**
@@ -200,7 +244,7 @@ export type PostCommentRequest = Message<"api.v1.PostCommentRequest"> & {
  * Use `create(PostCommentRequestSchema)` to create a new message.
  */
 export const PostCommentRequestSchema: GenMessage<PostCommentRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_workout_service, 8);
+  messageDesc(file_api_v1_workout_service, 10);
 
 /**
  * @generated from message api.v1.PostCommentResponse
@@ -217,7 +261,76 @@ export type PostCommentResponse = Message<"api.v1.PostCommentResponse"> & {
  * Use `create(PostCommentResponseSchema)` to create a new message.
  */
 export const PostCommentResponseSchema: GenMessage<PostCommentResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_workout_service, 9);
+  messageDesc(file_api_v1_workout_service, 11);
+
+/**
+ * @generated from message api.v1.UpdateCommentRequest
+ */
+export type UpdateCommentRequest = Message<"api.v1.UpdateCommentRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string comment = 2;
+   */
+  comment: string;
+};
+
+/**
+ * Describes the message api.v1.UpdateCommentRequest.
+ * Use `create(UpdateCommentRequestSchema)` to create a new message.
+ */
+export const UpdateCommentRequestSchema: GenMessage<UpdateCommentRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 12);
+
+/**
+ * @generated from message api.v1.UpdateCommentResponse
+ */
+export type UpdateCommentResponse = Message<"api.v1.UpdateCommentResponse"> & {
+  /**
+   * @generated from field: api.v1.WorkoutComment comment = 1;
+   */
+  comment?: WorkoutComment;
+};
+
+/**
+ * Describes the message api.v1.UpdateCommentResponse.
+ * Use `create(UpdateCommentResponseSchema)` to create a new message.
+ */
+export const UpdateCommentResponseSchema: GenMessage<UpdateCommentResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 13);
+
+/**
+ * @generated from message api.v1.DeleteCommentRequest
+ */
+export type DeleteCommentRequest = Message<"api.v1.DeleteCommentRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message api.v1.DeleteCommentRequest.
+ * Use `create(DeleteCommentRequestSchema)` to create a new message.
+ */
+export const DeleteCommentRequestSchema: GenMessage<DeleteCommentRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 14);
+
+/**
+ * @generated from message api.v1.DeleteCommentResponse
+ */
+export type DeleteCommentResponse = Message<"api.v1.DeleteCommentResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.DeleteCommentResponse.
+ * Use `create(DeleteCommentResponseSchema)` to create a new message.
+ */
+export const DeleteCommentResponseSchema: GenMessage<DeleteCommentResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 15);
 
 /**
  * @generated from message api.v1.UpdateWorkoutRequest
@@ -234,7 +347,7 @@ export type UpdateWorkoutRequest = Message<"api.v1.UpdateWorkoutRequest"> & {
  * Use `create(UpdateWorkoutRequestSchema)` to create a new message.
  */
 export const UpdateWorkoutRequestSchema: GenMessage<UpdateWorkoutRequest> = /*@__PURE__*/
-  messageDesc(file_api_v1_workout_service, 10);
+  messageDesc(file_api_v1_workout_service, 16);
 
 /**
  * @generated from message api.v1.UpdateWorkoutResponse
@@ -247,7 +360,310 @@ export type UpdateWorkoutResponse = Message<"api.v1.UpdateWorkoutResponse"> & {
  * Use `create(UpdateWorkoutResponseSchema)` to create a new message.
  */
 export const UpdateWorkoutResponseSchema: GenMessage<UpdateWorkoutResponse> = /*@__PURE__*/
-  messageDesc(file_api_v1_workout_service, 11);
+  messageDesc(file_api_v1_workout_service, 17);
+
+/**
+ * @generated from message api.v1.CompareWorkoutsRequest
+ */
+export type CompareWorkoutsRequest = Message<"api.v1.CompareWorkoutsRequest"> & {
+  /**
+   * @generated from field: string workout_id = 1;
+   */
+  workoutId: string;
+
+  /**
+   * @generated from field: string other_workout_id = 2;
+   */
+  otherWorkoutId: string;
+};
+
+/**
+ * Describes the message api.v1.CompareWorkoutsRequest.
+ * Use `create(CompareWorkoutsRequestSchema)` to create a new message.
+ */
+export const CompareWorkoutsRequestSchema: GenMessage<CompareWorkoutsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 18);
+
+/**
+ * @generated from message api.v1.CompareWorkoutsResponse
+ */
+export type CompareWorkoutsResponse = Message<"api.v1.CompareWorkoutsResponse"> & {
+  /**
+   * @generated from field: api.v1.Workout workout = 1;
+   */
+  workout?: Workout;
+
+  /**
+   * @generated from field: api.v1.Workout other_workout = 2;
+   */
+  otherWorkout?: Workout;
+
+  /**
+   * @generated from field: repeated api.v1.ExerciseComparison exercises = 3;
+   */
+  exercises: ExerciseComparison[];
+};
+
+/**
+ * Describes the message api.v1.CompareWorkoutsResponse.
+ * Use `create(CompareWorkoutsResponseSchema)` to create a new message.
+ */
+export const CompareWorkoutsResponseSchema: GenMessage<CompareWorkoutsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 19);
+
+/**
+ * @generated from message api.v1.ImportWorkoutsRequest
+ */
+export type ImportWorkoutsRequest = Message<"api.v1.ImportWorkoutsRequest"> & {
+  /**
+   * @generated from field: api.v1.ImportFormat format = 1;
+   */
+  format: ImportFormat;
+
+  /**
+   * @generated from field: bytes file = 2;
+   */
+  file: Uint8Array;
+
+  /**
+   * Previews the import without creating any workouts or exercises.
+   *
+   * @generated from field: bool dry_run = 3;
+   */
+  dryRun: boolean;
+
+  /**
+   * The IANA time zone the export's local times were recorded in, e.g.
+   * "Europe/Stockholm". Defaults to UTC.
+   *
+   * @generated from field: string timezone = 4;
+   */
+  timezone: string;
+};
+
+/**
+ * Describes the message api.v1.ImportWorkoutsRequest.
+ * Use `create(ImportWorkoutsRequestSchema)` to create a new message.
+ */
+export const ImportWorkoutsRequestSchema: GenMessage<ImportWorkoutsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 20);
+
+/**
+ * @generated from message api.v1.ImportWorkoutsResponse
+ */
+export type ImportWorkoutsResponse = Message<"api.v1.ImportWorkoutsResponse"> & {
+  /**
+   * @generated from field: int32 workouts = 1;
+   */
+  workouts: number;
+
+  /**
+   * @generated from field: int32 sets = 2;
+   */
+  sets: number;
+
+  /**
+   * @generated from field: repeated string new_exercises = 3;
+   */
+  newExercises: string[];
+
+  /**
+   * @generated from field: repeated api.v1.ImportConflict conflicts = 4;
+   */
+  conflicts: ImportConflict[];
+};
+
+/**
+ * Describes the message api.v1.ImportWorkoutsResponse.
+ * Use `create(ImportWorkoutsResponseSchema)` to create a new message.
+ */
+export const ImportWorkoutsResponseSchema: GenMessage<ImportWorkoutsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 21);
+
+/**
+ * @generated from message api.v1.ReactToWorkoutRequest
+ */
+export type ReactToWorkoutRequest = Message<"api.v1.ReactToWorkoutRequest"> & {
+  /**
+   * @generated from field: string workout_id = 1;
+   */
+  workoutId: string;
+
+  /**
+   * @generated from field: api.v1.ReactionType type = 2;
+   */
+  type: ReactionType;
+};
+
+/**
+ * Describes the message api.v1.ReactToWorkoutRequest.
+ * Use `create(ReactToWorkoutRequestSchema)` to create a new message.
+ */
+export const ReactToWorkoutRequestSchema: GenMessage<ReactToWorkoutRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 22);
+
+/**
+ * @generated from message api.v1.ReactToWorkoutResponse
+ */
+export type ReactToWorkoutResponse = Message<"api.v1.ReactToWorkoutResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.ReactToWorkoutResponse.
+ * Use `create(ReactToWorkoutResponseSchema)` to create a new message.
+ */
+export const ReactToWorkoutResponseSchema: GenMessage<ReactToWorkoutResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 23);
+
+/**
+ * @generated from message api.v1.RemoveReactionRequest
+ */
+export type RemoveReactionRequest = Message<"api.v1.RemoveReactionRequest"> & {
+  /**
+   * @generated from field: string workout_id = 1;
+   */
+  workoutId: string;
+};
+
+/**
+ * Describes the message api.v1.RemoveReactionRequest.
+ * Use `create(RemoveReactionRequestSchema)` to create a new message.
+ */
+export const RemoveReactionRequestSchema: GenMessage<RemoveReactionRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 24);
+
+/**
+ * @generated from message api.v1.RemoveReactionResponse
+ */
+export type RemoveReactionResponse = Message<"api.v1.RemoveReactionResponse"> & {
+};
+
+/**
+ * Describes the message api.v1.RemoveReactionResponse.
+ * Use `create(RemoveReactionResponseSchema)` to create a new message.
+ */
+export const RemoveReactionResponseSchema: GenMessage<RemoveReactionResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 25);
+
+/**
+ * @generated from message api.v1.ListReactionsRequest
+ */
+export type ListReactionsRequest = Message<"api.v1.ListReactionsRequest"> & {
+  /**
+   * @generated from field: string workout_id = 1;
+   */
+  workoutId: string;
+};
+
+/**
+ * Describes the message api.v1.ListReactionsRequest.
+ * Use `create(ListReactionsRequestSchema)` to create a new message.
+ */
+export const ListReactionsRequestSchema: GenMessage<ListReactionsRequest> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 26);
+
+/**
+ * @generated from message api.v1.ListReactionsResponse
+ */
+export type ListReactionsResponse = Message<"api.v1.ListReactionsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Reaction reactions = 1;
+   */
+  reactions: Reaction[];
+};
+
+/**
+ * Describes the message api.v1.ListReactionsResponse.
+ * Use `create(ListReactionsResponseSchema)` to create a new message.
+ */
+export const ListReactionsResponseSchema: GenMessage<ListReactionsResponse> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 27);
+
+/**
+ * Deltas are calculated as the other workout minus the workout.
+ *
+ * @generated from message api.v1.ExerciseComparison
+ */
+export type ExerciseComparison = Message<"api.v1.ExerciseComparison"> & {
+  /**
+   * @generated from field: api.v1.Exercise exercise = 1;
+   */
+  exercise?: Exercise;
+
+  /**
+   * @generated from field: api.v1.Exercise other_exercise = 2;
+   */
+  otherExercise?: Exercise;
+
+  /**
+   * @generated from field: repeated api.v1.SetComparison sets = 3;
+   */
+  sets: SetComparison[];
+
+  /**
+   * @generated from field: int32 sets_added = 4;
+   */
+  setsAdded: number;
+
+  /**
+   * @generated from field: int32 sets_removed = 5;
+   */
+  setsRemoved: number;
+
+  /**
+   * @generated from field: double volume_delta = 6;
+   */
+  volumeDelta: number;
+
+  /**
+   * @generated from field: bool personal_best = 7;
+   */
+  personalBest: boolean;
+
+  /**
+   * @generated from field: bool other_personal_best = 8;
+   */
+  otherPersonalBest: boolean;
+};
+
+/**
+ * Describes the message api.v1.ExerciseComparison.
+ * Use `create(ExerciseComparisonSchema)` to create a new message.
+ */
+export const ExerciseComparisonSchema: GenMessage<ExerciseComparison> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 28);
+
+/**
+ * @generated from message api.v1.SetComparison
+ */
+export type SetComparison = Message<"api.v1.SetComparison"> & {
+  /**
+   * @generated from field: api.v1.Set set = 1;
+   */
+  set?: Set;
+
+  /**
+   * @generated from field: api.v1.Set other_set = 2;
+   */
+  otherSet?: Set;
+
+  /**
+   * @generated from field: double weight_delta = 3;
+   */
+  weightDelta: number;
+
+  /**
+   * @generated from field: int32 reps_delta = 4;
+   */
+  repsDelta: number;
+};
+
+/**
+ * Describes the message api.v1.SetComparison.
+ * Use `create(SetComparisonSchema)` to create a new message.
+ */
+export const SetComparisonSchema: GenMessage<SetComparison> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 29);
 
 /**
  * @generated from message api.v1.Workout
@@ -299,6 +715,30 @@ export type Workout = Message<"api.v1.Workout"> & {
    * @generated from field: string note = 9;
    */
   note: string;
+
+  /**
+   * @generated from field: repeated api.v1.ReactionCount reaction_counts = 10;
+   */
+  reactionCounts: ReactionCount[];
+
+  /**
+   * The reaction of the requesting user, if any.
+   *
+   * @generated from field: api.v1.ReactionType reaction = 11;
+   */
+  reaction: ReactionType;
+
+  /**
+   * @generated from field: repeated api.v1.Mention note_mentions = 12;
+   */
+  noteMentions: Mention[];
+
+  /**
+   * Left unchanged by updates if unspecified.
+   *
+   * @generated from field: api.v1.WorkoutVisibility visibility = 13;
+   */
+  visibility: WorkoutVisibility;
 };
 
 /**
@@ -306,7 +746,7 @@ export type Workout = Message<"api.v1.Workout"> & {
  * Use `create(WorkoutSchema)` to create a new message.
  */
 export const WorkoutSchema: GenMessage<Workout> = /*@__PURE__*/
-  messageDesc(file_api_v1_workout_service, 12);
+  messageDesc(file_api_v1_workout_service, 30);
 
 /**
  * @generated from message api.v1.WorkoutComment
@@ -331,6 +771,29 @@ export type WorkoutComment = Message<"api.v1.WorkoutComment"> & {
    * @generated from field: google.protobuf.Timestamp created_at = 5;
    */
   createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp edited_at = 6;
+   */
+  editedAt?: Timestamp;
+
+  /**
+   * @generated from field: string parent_comment_id = 7;
+   */
+  parentCommentId: string;
+
+  /**
+   * Replies are nested up to a maximum depth. Deeper replies are flattened
+   * into the replies of their deepest ancestor within that depth.
+   *
+   * @generated from field: repeated api.v1.WorkoutComment replies = 8;
+   */
+  replies: WorkoutComment[];
+
+  /**
+   * @generated from field: repeated api.v1.Mention mentions = 9;
+   */
+  mentions: Mention[];
 };
 
 /**
@@ -338,7 +801,206 @@ export type WorkoutComment = Message<"api.v1.WorkoutComment"> & {
  * Use `create(WorkoutCommentSchema)` to create a new message.
  */
 export const WorkoutCommentSchema: GenMessage<WorkoutComment> = /*@__PURE__*/
-  messageDesc(file_api_v1_workout_service, 13);
+  messageDesc(file_api_v1_workout_service, 31);
+
+/**
+ * Mention is a reference to a user in a text. The offsets are in UTF-16 code
+ * units and the end is exclusive.
+ *
+ * @generated from message api.v1.Mention
+ */
+export type Mention = Message<"api.v1.Mention"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: int32 start = 2;
+   */
+  start: number;
+
+  /**
+   * @generated from field: int32 end = 3;
+   */
+  end: number;
+};
+
+/**
+ * Describes the message api.v1.Mention.
+ * Use `create(MentionSchema)` to create a new message.
+ */
+export const MentionSchema: GenMessage<Mention> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 32);
+
+/**
+ * @generated from message api.v1.ImportConflict
+ */
+export type ImportConflict = Message<"api.v1.ImportConflict"> & {
+  /**
+   * @generated from field: string workout_name = 1;
+   */
+  workoutName: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 2;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * @generated from field: api.v1.ImportConflictReason reason = 3;
+   */
+  reason: ImportConflictReason;
+};
+
+/**
+ * Describes the message api.v1.ImportConflict.
+ * Use `create(ImportConflictSchema)` to create a new message.
+ */
+export const ImportConflictSchema: GenMessage<ImportConflict> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 33);
+
+/**
+ * @generated from message api.v1.Reaction
+ */
+export type Reaction = Message<"api.v1.Reaction"> & {
+  /**
+   * @generated from field: api.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: api.v1.ReactionType type = 2;
+   */
+  type: ReactionType;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 3;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.Reaction.
+ * Use `create(ReactionSchema)` to create a new message.
+ */
+export const ReactionSchema: GenMessage<Reaction> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 34);
+
+/**
+ * @generated from message api.v1.ReactionCount
+ */
+export type ReactionCount = Message<"api.v1.ReactionCount"> & {
+  /**
+   * @generated from field: api.v1.ReactionType type = 1;
+   */
+  type: ReactionType;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message api.v1.ReactionCount.
+ * Use `create(ReactionCountSchema)` to create a new message.
+ */
+export const ReactionCountSchema: GenMessage<ReactionCount> = /*@__PURE__*/
+  messageDesc(file_api_v1_workout_service, 35);
+
+/**
+ * @generated from enum api.v1.ImportFormat
+ */
+export enum ImportFormat {
+  /**
+   * @generated from enum value: IMPORT_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: IMPORT_FORMAT_STRONG = 1;
+   */
+  STRONG = 1,
+
+  /**
+   * @generated from enum value: IMPORT_FORMAT_HEVY = 2;
+   */
+  HEVY = 2,
+}
+
+/**
+ * Describes the enum api.v1.ImportFormat.
+ */
+export const ImportFormatSchema: GenEnum<ImportFormat> = /*@__PURE__*/
+  enumDesc(file_api_v1_workout_service, 0);
+
+/**
+ * @generated from enum api.v1.ImportConflictReason
+ */
+export enum ImportConflictReason {
+  /**
+   * @generated from enum value: IMPORT_CONFLICT_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: IMPORT_CONFLICT_REASON_ALREADY_IMPORTED = 1;
+   */
+  ALREADY_IMPORTED = 1,
+
+  /**
+   * @generated from enum value: IMPORT_CONFLICT_REASON_OVERLAPS_WORKOUT = 2;
+   */
+  OVERLAPS_WORKOUT = 2,
+
+  /**
+   * @generated from enum value: IMPORT_CONFLICT_REASON_WITHOUT_SETS = 3;
+   */
+  WITHOUT_SETS = 3,
+}
+
+/**
+ * Describes the enum api.v1.ImportConflictReason.
+ */
+export const ImportConflictReasonSchema: GenEnum<ImportConflictReason> = /*@__PURE__*/
+  enumDesc(file_api_v1_workout_service, 1);
+
+/**
+ * @generated from enum api.v1.ReactionType
+ */
+export enum ReactionType {
+  /**
+   * @generated from enum value: REACTION_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: REACTION_TYPE_THUMBS_UP = 1;
+   */
+  THUMBS_UP = 1,
+
+  /**
+   * @generated from enum value: REACTION_TYPE_FIRE = 2;
+   */
+  FIRE = 2,
+
+  /**
+   * @generated from enum value: REACTION_TYPE_FLEXED_BICEPS = 3;
+   */
+  FLEXED_BICEPS = 3,
+
+  /**
+   * @generated from enum value: REACTION_TYPE_CLAPPING_HANDS = 4;
+   */
+  CLAPPING_HANDS = 4,
+}
+
+/**
+ * Describes the enum api.v1.ReactionType.
+ */
+export const ReactionTypeSchema: GenEnum<ReactionType> = /*@__PURE__*/
+  enumDesc(file_api_v1_workout_service, 2);
 
 /**
  * @generated from service api.v1.WorkoutService
@@ -376,6 +1038,14 @@ export const WorkoutService: GenService<{
     input: typeof DeleteWorkoutRequestSchema;
     output: typeof DeleteWorkoutResponseSchema;
   },
+  /**
+   * @generated from rpc api.v1.WorkoutService.RestoreWorkout
+   */
+  restoreWorkout: {
+    methodKind: "unary";
+    input: typeof RestoreWorkoutRequestSchema;
+    output: typeof RestoreWorkoutResponseSchema;
+  },
   /**
    * @generated from rpc api.v1.WorkoutService.PostComment
    */
@@ -384,6 +1054,22 @@ export const WorkoutService: GenService<{
     input: typeof PostCommentRequestSchema;
     output: typeof PostCommentResponseSchema;
   },
+  /**
+   * @generated from rpc api.v1.WorkoutService.UpdateComment
+   */
+  updateComment: {
+    methodKind: "unary";
+    input: typeof UpdateCommentRequestSchema;
+    output: typeof UpdateCommentResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.WorkoutService.DeleteComment
+   */
+  deleteComment: {
+    methodKind: "unary";
+    input: typeof DeleteCommentRequestSchema;
+    output: typeof DeleteCommentResponseSchema;
+  },
   /**
    * @generated from rpc api.v1.WorkoutService.UpdateWorkout
    */
@@ -392,6 +1078,46 @@ export const WorkoutService: GenService<{
     input: typeof UpdateWorkoutRequestSchema;
     output: typeof UpdateWorkoutResponseSchema;
   },
+  /**
+   * @generated from rpc api.v1.WorkoutService.CompareWorkouts
+   */
+  compareWorkouts: {
+    methodKind: "unary";
+    input: typeof CompareWorkoutsRequestSchema;
+    output: typeof CompareWorkoutsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.WorkoutService.ImportWorkouts
+   */
+  importWorkouts: {
+    methodKind: "unary";
+    input: typeof ImportWorkoutsRequestSchema;
+    output: typeof ImportWorkoutsResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.WorkoutService.ReactToWorkout
+   */
+  reactToWorkout: {
+    methodKind: "unary";
+    input: typeof ReactToWorkoutRequestSchema;
+    output: typeof ReactToWorkoutResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.WorkoutService.RemoveReaction
+   */
+  removeReaction: {
+    methodKind: "unary";
+    input: typeof RemoveReactionRequestSchema;
+    output: typeof RemoveReactionResponseSchema;
+  },
+  /**
+   * @generated from rpc api.v1.WorkoutService.ListReactions
+   */
+  listReactions: {
+    methodKind: "unary";
+    input: typeof ListReactionsRequestSchema;
+    output: typeof ListReactionsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_api_v1_workout_service, 0);
 